@@ -0,0 +1,200 @@
+package app
+
+import (
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// AnalyticsSchemaVersion version of analytics export tables, bumped whenever table or column is changed
+const AnalyticsSchemaVersion = 1
+
+// Analytics table names
+const (
+	AnalyticsTableCheckpoints      = "checkpoints"
+	AnalyticsTableAcks             = "acks"
+	AnalyticsTableNoAcks           = "no_acks"
+	AnalyticsTableValidators       = "validators"
+	AnalyticsTableStakingEvents    = "staking_events"
+	AnalyticsTableTopups           = "topups"
+	AnalyticsTableDividendAccounts = "dividend_accounts"
+)
+
+// Checkpoint status of checkpoints table
+const (
+	AnalyticsCheckpointAcked    = "acked"
+	AnalyticsCheckpointBuffered = "buffered"
+)
+
+// AnalyticsExport flat tables of checkpoint, staking and topup state at height, for offline analytics
+type AnalyticsExport struct {
+	SchemaVersion int              `json:"schema_version" yaml:"schema_version"`
+	Height        int64            `json:"height" yaml:"height"`
+	ChainID       string           `json:"chain_id" yaml:"chain_id"`
+	Tables        []AnalyticsTable `json:"tables" yaml:"tables"`
+}
+
+// AnalyticsTable table of analytics export, values are rendered as strings so every format stores them alike
+type AnalyticsTable struct {
+	Name    string     `json:"name" yaml:"name"`
+	Columns []string   `json:"columns" yaml:"columns"`
+	Rows    [][]string `json:"-" yaml:"-"`
+}
+
+// ExportAnalytics reads checkpoints, acks, no-acks, staking and topup state directly from keepers
+func (app *HeimdallApp) ExportAnalytics(ctx sdk.Context) AnalyticsExport {
+	rootChains := chainmanagerTypes.SortedRootChains()
+
+	return AnalyticsExport{
+		SchemaVersion: AnalyticsSchemaVersion,
+		Height:        ctx.BlockHeight(),
+		ChainID:       ctx.ChainID(),
+		Tables: []AnalyticsTable{
+			app.analyticsCheckpoints(ctx, rootChains),
+			app.analyticsAcks(ctx, rootChains),
+			app.analyticsNoAcks(ctx),
+			app.analyticsValidators(ctx),
+			analyticsSequences(AnalyticsTableStakingEvents, app.StakingKeeper.GetStakingSequences(ctx)),
+			analyticsSequences(AnalyticsTableTopups, app.TopupKeeper.GetTopupSequences(ctx)),
+			app.analyticsDividendAccounts(ctx),
+		},
+	}
+}
+
+// analyticsCheckpoints acked checkpoints and checkpoints waiting for ack in buffer queue
+func (app *HeimdallApp) analyticsCheckpoints(ctx sdk.Context, rootChains []string) AnalyticsTable {
+	table := AnalyticsTable{
+		Name:    AnalyticsTableCheckpoints,
+		Columns: []string{"root_chain", "number", "start_block", "end_block", "root_hash", "proposer", "bor_chain_id", "timestamp", "status"},
+	}
+
+	row := func(rootChain string, number uint64, checkpoint hmTypes.Checkpoint, status string) []string {
+		return []string{
+			rootChain,
+			formatUint(number),
+			formatUint(checkpoint.StartBlock),
+			formatUint(checkpoint.EndBlock),
+			checkpoint.RootHash.String(),
+			checkpoint.Proposer.String(),
+			checkpoint.BorChainID,
+			formatUint(checkpoint.TimeStamp),
+			status,
+		}
+	}
+
+	for _, rootChain := range rootChains {
+		ackCount := app.CheckpointKeeper.GetACKCount(ctx, rootChain)
+		for number := uint64(1); number <= ackCount; number++ {
+			checkpoint, err := app.CheckpointKeeper.GetCheckpointByNumber(ctx, number, rootChain)
+			if err != nil {
+				continue
+			}
+			table.Rows = append(table.Rows, row(rootChain, number, checkpoint, AnalyticsCheckpointAcked))
+		}
+
+		// buffered checkpoints get next numbers once acked
+		for i, checkpoint := range app.CheckpointKeeper.GetCheckpointBufferQueue(ctx, rootChain).Checkpoints {
+			table.Rows = append(table.Rows, row(rootChain, ackCount+uint64(i)+1, checkpoint, AnalyticsCheckpointBuffered))
+		}
+	}
+
+	return table
+}
+
+// analyticsAcks ack count and last acked checkpoint of every root chain
+func (app *HeimdallApp) analyticsAcks(ctx sdk.Context, rootChains []string) AnalyticsTable {
+	table := AnalyticsTable{
+		Name:    AnalyticsTableAcks,
+		Columns: []string{"root_chain", "ack_count", "last_end_block", "last_timestamp"},
+	}
+
+	for _, rootChain := range rootChains {
+		var lastEndBlock, lastTimestamp string
+		if checkpoint, err := app.CheckpointKeeper.GetLastCheckpoint(ctx, rootChain); err == nil {
+			lastEndBlock, lastTimestamp = formatUint(checkpoint.EndBlock), formatUint(checkpoint.TimeStamp)
+		}
+
+		table.Rows = append(table.Rows, []string{
+			rootChain,
+			formatUint(app.CheckpointKeeper.GetACKCount(ctx, rootChain)),
+			lastEndBlock,
+			lastTimestamp,
+		})
+	}
+
+	return table
+}
+
+// analyticsNoAcks accepted no-acks, store keeps their count and time of last one only
+func (app *HeimdallApp) analyticsNoAcks(ctx sdk.Context) AnalyticsTable {
+	return AnalyticsTable{
+		Name:    AnalyticsTableNoAcks,
+		Columns: []string{"no_ack_count", "last_no_ack"},
+		Rows: [][]string{{
+			formatUint(app.CheckpointKeeper.GetNoAckCount(ctx)),
+			formatUint(app.CheckpointKeeper.GetLastNoAck(ctx)),
+		}},
+	}
+}
+
+// analyticsValidators all validators, including exited ones
+func (app *HeimdallApp) analyticsValidators(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Name:    AnalyticsTableValidators,
+		Columns: []string{"validator_id", "signer", "voting_power", "start_epoch", "end_epoch", "nonce", "jailed", "last_updated"},
+	}
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	sort.Slice(validators, func(i, j int) bool { return validators[i].ID < validators[j].ID })
+
+	for _, validator := range validators {
+		table.Rows = append(table.Rows, []string{
+			formatUint(uint64(validator.ID)),
+			validator.Signer.String(),
+			strconv.FormatInt(validator.VotingPower, 10),
+			formatUint(validator.StartEpoch),
+			formatUint(validator.EndEpoch),
+			formatUint(validator.Nonce),
+			strconv.FormatBool(validator.Jailed),
+			validator.LastUpdated,
+		})
+	}
+
+	return table
+}
+
+// analyticsDividendAccounts fee balances of topped up accounts
+func (app *HeimdallApp) analyticsDividendAccounts(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Name:    AnalyticsTableDividendAccounts,
+		Columns: []string{"user", "fee_amount"},
+	}
+
+	for _, account := range app.TopupKeeper.GetAllDividendAccounts(ctx) {
+		table.Rows = append(table.Rows, []string{account.User.String(), account.FeeAmount})
+	}
+
+	return table
+}
+
+// analyticsSequences processed root chain events (block number and log index) of module
+func analyticsSequences(name string, sequences []string) AnalyticsTable {
+	table := AnalyticsTable{
+		Name:    name,
+		Columns: []string{"sequence"},
+	}
+
+	for _, sequence := range sequences {
+		table.Rows = append(table.Rows, []string{sequence})
+	}
+
+	return table
+}
+
+func formatUint(value uint64) string {
+	return strconv.FormatUint(value, 10)
+}
@@ -0,0 +1,55 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	app "github.com/maticnetwork/heimdall/app"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestExportAnalytics(t *testing.T) {
+	t.Parallel()
+
+	happ := app.Setup(false)
+	ctx := happ.NewContext(false, abci.Header{Height: 10, ChainID: "test-chain"})
+
+	acked := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("0x1"), hmTypes.HexToHeimdallAddress("0x2"), "15001", 100)
+	buffered := hmTypes.CreateBlock(256, 511, hmTypes.HexToHeimdallHash("0x3"), hmTypes.HexToHeimdallAddress("0x2"), "15001", 200)
+	require.NoError(t, happ.CheckpointKeeper.AddCheckpoint(ctx, 1, acked, hmTypes.RootChainTypeEth))
+	happ.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1, hmTypes.RootChainTypeEth)
+	require.NoError(t, happ.CheckpointKeeper.SetCheckpointBuffer(ctx, buffered, hmTypes.RootChainTypeEth))
+	happ.CheckpointKeeper.SetNoAckCount(ctx, 2)
+	happ.CheckpointKeeper.SetLastNoAck(ctx, 300)
+	happ.StakingKeeper.SetStakingSequence(ctx, "100001")
+	happ.TopupKeeper.SetTopupSequence(ctx, "200002")
+
+	export := happ.ExportAnalytics(ctx)
+	require.Equal(t, app.AnalyticsSchemaVersion, export.SchemaVersion)
+	require.Equal(t, int64(10), export.Height)
+	require.Equal(t, "test-chain", export.ChainID)
+
+	tables := make(map[string]app.AnalyticsTable)
+	for _, table := range export.Tables {
+		for _, row := range table.Rows {
+			require.Len(t, row, len(table.Columns), table.Name)
+		}
+		tables[table.Name] = table
+	}
+
+	checkpoints := tables[app.AnalyticsTableCheckpoints]
+	require.Equal(t, [][]string{
+		{hmTypes.RootChainTypeEth, "1", "0", "255", acked.RootHash.String(), acked.Proposer.String(), "15001", "100", app.AnalyticsCheckpointAcked},
+		{hmTypes.RootChainTypeEth, "2", "256", "511", buffered.RootHash.String(), buffered.Proposer.String(), "15001", "200", app.AnalyticsCheckpointBuffered},
+	}, checkpoints.Rows)
+
+	acks := tables[app.AnalyticsTableAcks]
+	require.Len(t, acks.Rows, len(hmTypes.GetRootChainIDMap()))
+	require.Contains(t, acks.Rows, []string{hmTypes.RootChainTypeEth, "1", "255", "100"})
+
+	require.Equal(t, [][]string{{"2", "300"}}, tables[app.AnalyticsTableNoAcks].Rows)
+	require.Equal(t, [][]string{{"100001"}}, tables[app.AnalyticsTableStakingEvents].Rows)
+	require.Equal(t, [][]string{{"200002"}}, tables[app.AnalyticsTableTopups].Rows)
+}
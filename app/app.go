@@ -3,11 +3,13 @@ package app
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
@@ -18,17 +20,22 @@ import (
 	"github.com/maticnetwork/heimdall/bank"
 	bankTypes "github.com/maticnetwork/heimdall/bank/types"
 	"github.com/maticnetwork/heimdall/bor"
+	borClient "github.com/maticnetwork/heimdall/bor/client"
 	borTypes "github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/chainmanager"
+	chainmanagerClient "github.com/maticnetwork/heimdall/chainmanager/client"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/clerk"
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/crisis"
 	gov "github.com/maticnetwork/heimdall/gov"
 	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/liveness"
+	livenessTypes "github.com/maticnetwork/heimdall/liveness/types"
 	"github.com/maticnetwork/heimdall/params"
 	paramsClient "github.com/maticnetwork/heimdall/params/client"
 	"github.com/maticnetwork/heimdall/params/subspace"
@@ -38,6 +45,7 @@ import (
 	"github.com/maticnetwork/heimdall/slashing"
 	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 	"github.com/maticnetwork/heimdall/staking"
+	stakingClient "github.com/maticnetwork/heimdall/staking/client"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	"github.com/maticnetwork/heimdall/supply"
 	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
@@ -45,6 +53,9 @@ import (
 	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmModule "github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/upgrade"
+	upgradeClient "github.com/maticnetwork/heimdall/upgrade/client"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -78,13 +89,24 @@ var (
 		clerk.AppModuleBasic{},
 		topup.AppModuleBasic{},
 		slashing.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsClient.ProposalHandler),
+		liveness.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
+		gov.NewAppModuleBasic(
+			paramsClient.ProposalHandler,
+			chainmanagerClient.ProposalHandler,
+			upgradeClient.ProposalHandler,
+			upgradeClient.CancelProposalHandler,
+			borClient.ProposalHandler,
+			stakingClient.ForceValidatorExitProposalHandler,
+			stakingClient.ForceUnjailProposalHandler,
+		),
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
 		authTypes.FeeCollectorName: nil,
 		govTypes.ModuleName:        {},
+		checkpointTypes.ModuleName: {},
 	}
 )
 
@@ -103,6 +125,12 @@ type HeimdallApp struct {
 	// side router
 	sideRouter types.SideRouter
 
+	// concurrent side-tx validation
+	sideTxPool *sideTxPool
+
+	// compact events, nil if disabled
+	eventCompactor *eventCompactor
+
 	// keepers
 	SidechannelKeeper sidechannel.Keeper
 	AccountKeeper     auth.AccountKeeper
@@ -116,6 +144,9 @@ type HeimdallApp struct {
 	ClerkKeeper       clerk.Keeper
 	TopupKeeper       topup.Keeper
 	SlashingKeeper    slashing.Keeper
+	LivenessKeeper    liveness.Keeper
+	UpgradeKeeper     upgrade.Keeper
+	CrisisKeeper      crisis.Keeper
 
 	// param keeper
 	ParamsKeeper params.Keeper
@@ -129,6 +160,12 @@ type HeimdallApp struct {
 	// the module manager
 	mm *module.Manager
 
+	// optional modules disabled in config
+	toggles moduleToggles
+
+	// store migrations of modules
+	configurator *hmModule.Configurator
+
 	// simulation module manager
 	sm *hmModule.SimulationManager
 }
@@ -149,6 +186,11 @@ func (d ModuleCommunicator) GetACKCount(ctx sdk.Context) uint64 {
 	return d.App.CheckpointKeeper.GetACKCount(ctx, types.RootChainTypeStake)
 }
 
+// SetACKCount sets ack count for root chain
+func (d ModuleCommunicator) SetACKCount(ctx sdk.Context, value uint64, rootChain string) {
+	d.App.CheckpointKeeper.UpdateACKCountWithValue(ctx, value, rootChain)
+}
+
 // IsCurrentValidatorByAddress check if validator is current validator
 func (d ModuleCommunicator) IsCurrentValidatorByAddress(ctx sdk.Context, address []byte) bool {
 	return d.App.StakingKeeper.IsCurrentValidatorByAddress(ctx, address)
@@ -164,6 +206,26 @@ func (d ModuleCommunicator) GetValidatorFromValID(ctx sdk.Context, valID types.V
 	return d.App.StakingKeeper.GetValidatorFromValID(ctx, valID)
 }
 
+// TrackDividendAccounts starts tracking changes of dividend accounts for account root hash
+func (d ModuleCommunicator) TrackDividendAccounts(ctx sdk.Context) {
+	d.App.TopupKeeper.TrackDividendAccounts(ctx)
+}
+
+// IsDividendAccountsDirty checks if dividend accounts changed since account root hash was computed
+func (d ModuleCommunicator) IsDividendAccountsDirty(ctx sdk.Context) bool {
+	return d.App.TopupKeeper.IsDividendAccountsDirty(ctx)
+}
+
+// ClearDividendAccountsDirty marks account root hash as computed from current dividend accounts
+func (d ModuleCommunicator) ClearDividendAccountsDirty(ctx sdk.Context) {
+	d.App.TopupKeeper.ClearDividendAccountsDirty(ctx)
+}
+
+// AddFeeToDividendAccount adds fee to dividend account of user in topup module
+func (d ModuleCommunicator) AddFeeToDividendAccount(ctx sdk.Context, user types.HeimdallAddress, fee *big.Int) sdk.Error {
+	return d.App.TopupKeeper.AddFeeToDividendAccount(ctx, user, fee)
+}
+
 // SetCoins sets coins
 func (d ModuleCommunicator) SetCoins(ctx sdk.Context, addr types.HeimdallAddress, amt sdk.Coins) sdk.Error {
 	return d.App.BankKeeper.SetCoins(ctx, addr, amt)
@@ -195,6 +257,9 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	cdc := MakeCodec()
 
 	// set prefix
+	if err := types.SetAddressPrefixes(helper.GetConfig().AddressPrefixes()); err != nil {
+		panic(err)
+	}
 	config := sdk.GetConfig()
 	config.Seal()
 
@@ -214,21 +279,24 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		chainmanagerTypes.StoreKey,
 		stakingTypes.StoreKey,
 		slashingTypes.StoreKey,
+		livenessTypes.StoreKey,
 		checkpointTypes.StoreKey,
 		borTypes.StoreKey,
 		clerkTypes.StoreKey,
 		topupTypes.StoreKey,
 		paramsTypes.StoreKey,
+		upgradeTypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramsTypes.TStoreKey)
 
 	// create heimdall app
 	var app = &HeimdallApp{
-		cdc:       cdc,
-		BaseApp:   bApp,
-		keys:      keys,
-		tkeys:     tkeys,
-		subspaces: make(map[string]subspace.Subspace),
+		cdc:        cdc,
+		BaseApp:    bApp,
+		keys:       keys,
+		tkeys:      tkeys,
+		subspaces:  make(map[string]subspace.Subspace),
+		sideTxPool: newSideTxPool(),
 	}
 
 	// init params keeper and subspaces
@@ -241,10 +309,19 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	app.subspaces[chainmanagerTypes.ModuleName] = app.ParamsKeeper.Subspace(chainmanagerTypes.DefaultParamspace)
 	app.subspaces[stakingTypes.ModuleName] = app.ParamsKeeper.Subspace(stakingTypes.DefaultParamspace)
 	app.subspaces[slashingTypes.ModuleName] = app.ParamsKeeper.Subspace(slashingTypes.DefaultParamspace)
+	app.subspaces[livenessTypes.ModuleName] = app.ParamsKeeper.Subspace(livenessTypes.DefaultParamspace)
 	app.subspaces[checkpointTypes.ModuleName] = app.ParamsKeeper.Subspace(checkpointTypes.DefaultParamspace)
 	app.subspaces[borTypes.ModuleName] = app.ParamsKeeper.Subspace(borTypes.DefaultParamspace)
 	app.subspaces[clerkTypes.ModuleName] = app.ParamsKeeper.Subspace(clerkTypes.DefaultParamspace)
 	app.subspaces[topupTypes.ModuleName] = app.ParamsKeeper.Subspace(topupTypes.DefaultParamspace)
+
+	// optional modules toggled off by operator
+	toggles, err := newModuleToggles(helper.GetConfig().DisabledModules)
+	if err != nil {
+		panic(err)
+	}
+	app.toggles = toggles
+
 	//
 	// Contract caller
 	//
@@ -254,6 +331,15 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		cmn.Exit(err.Error())
 	}
 
+	// journal contract calls of side-tx validation, set before caller is handed to keepers
+	if conf := helper.GetConfig(); conf.CallJournalEnabled {
+		contractCallerObj.Journal = helper.NewCallJournal(
+			helper.GetCallJournalDir(viper.GetString(helper.HomeFlag)),
+			conf.CallJournalMaxFileSizeMB<<20,
+			conf.CallJournalMaxFiles,
+		)
+	}
+
 	app.caller = contractCallerObj
 
 	//
@@ -309,6 +395,14 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.ChainKeeper,
 	)
 
+	app.LivenessKeeper = liveness.NewKeeper(
+		app.cdc,
+		keys[livenessTypes.StoreKey], // target store
+		app.subspaces[livenessTypes.ModuleName],
+		common.DefaultCodespace,
+		app.StakingKeeper,
+	)
+
 	// bank keeper
 	app.BankKeeper = bank.NewKeeper(
 		app.cdc,
@@ -329,11 +423,34 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.BankKeeper,
 	)
 
+	app.UpgradeKeeper = upgrade.NewKeeper(
+		app.cdc,
+		keys[upgradeTypes.StoreKey], // target store
+		common.DefaultCodespace,
+	)
+
+	// bor keeper handles producer set override proposals
+	app.BorKeeper = bor.NewKeeper(
+		app.cdc,
+		keys[borTypes.StoreKey], // target store
+		app.subspaces[borTypes.ModuleName],
+		common.DefaultCodespace,
+		app.ChainKeeper,
+		app.StakingKeeper,
+		app.caller,
+	)
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.
 		AddRoute(govTypes.RouterKey, govTypes.ProposalHandler).
-		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(chainmanagerTypes.RouterKey, chainmanager.NewAddRootChainProposalHandler(app.ChainKeeper, moduleCommunicator)).
+		AddRoute(upgradeTypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(stakingTypes.RouterKey, staking.NewValidatorReplacementProposalHandler(app.StakingKeeper))
+	if app.toggles.Enabled(borTypes.ModuleName) {
+		govRouter.AddRoute(borTypes.RouterKey, bor.NewProducerSetOverrideProposalHandler(app.BorKeeper))
+	}
 
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc,
@@ -352,18 +469,12 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		common.DefaultCodespace,
 		app.StakingKeeper,
 		app.ChainKeeper,
+		app.SupplyKeeper,
+		app.LivenessKeeper,
 		moduleCommunicator,
 	)
-
-	app.BorKeeper = bor.NewKeeper(
-		app.cdc,
-		keys[borTypes.StoreKey], // target store
-		app.subspaces[borTypes.ModuleName],
-		common.DefaultCodespace,
-		app.ChainKeeper,
-		app.StakingKeeper,
-		app.caller,
-	)
+	app.ChainKeeper.SetCheckpointReader(app.CheckpointKeeper)
+	app.BorKeeper.SetCheckpointReader(&app.CheckpointKeeper)
 
 	app.ClerkKeeper = clerk.NewKeeper(
 		app.cdc,
@@ -372,6 +483,9 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		common.DefaultCodespace,
 		app.ChainKeeper,
 	)
+	if helper.GetConfig().ClerkArchiveEnabled {
+		app.ClerkKeeper.SetArchive(clerk.NewRecordArchive(clerk.GetRecordArchiveDir(viper.GetString(helper.HomeFlag))))
+	}
 
 	// may be need signer
 	app.TopupKeeper = topup.NewKeeper(
@@ -382,11 +496,15 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.ChainKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
+		app.AccountKeeper,
 	)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
-	// must be passed by reference here.
-	app.mm = module.NewManager(
+	// must be passed by reference here. Stores of disabled modules stay mounted, only
+	// their handlers, queriers, blockers and genesis are left out.
+	app.mm = module.NewManager(app.toggles.filterModules(
+		// upgrade must be first, so upgrade is applied before begin blockers of other modules
+		upgrade.NewAppModule(app.UpgradeKeeper),
 		sidechannel.NewAppModule(app.SidechannelKeeper),
 		auth.NewAppModule(app.AccountKeeper, &app.caller, []authTypes.AccountProcessor{
 			supplyTypes.AccountProcessor,
@@ -397,15 +515,16 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		chainmanager.NewAppModule(app.ChainKeeper, &app.caller),
 		staking.NewAppModule(app.StakingKeeper, &app.caller),
 		slashing.NewAppModule(app.SlashingKeeper, app.StakingKeeper, &app.caller),
+		liveness.NewAppModule(app.LivenessKeeper),
 		checkpoint.NewAppModule(app.CheckpointKeeper, app.StakingKeeper, app.TopupKeeper, &app.caller),
 		bor.NewAppModule(app.BorKeeper, &app.caller),
 		clerk.NewAppModule(app.ClerkKeeper, &app.caller),
 		topup.NewAppModule(app.TopupKeeper, &app.caller),
-	)
+	)...)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
-	app.mm.SetOrderInitGenesis(
+	app.mm.SetOrderInitGenesis(app.toggles.filterNames(
 		sidechannelTypes.ModuleName,
 		authTypes.ModuleName,
 		bankTypes.ModuleName,
@@ -414,24 +533,42 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		supplyTypes.ModuleName,
 		stakingTypes.ModuleName,
 		slashingTypes.ModuleName,
+		livenessTypes.ModuleName,
 		checkpointTypes.ModuleName,
 		borTypes.ModuleName,
 		clerkTypes.ModuleName,
 		topupTypes.ModuleName,
-	)
+		upgradeTypes.ModuleName,
+	)...)
 
 	// register message routes and query routes
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	app.QueryRouter().AddRoute(EventsQuerierRoute, app.queryEvents)
+
+	// register invariants, asserted every invariant check period
+	app.CrisisKeeper = crisis.NewKeeper(helper.GetConfig().InvCheckPeriod)
+	app.mm.RegisterInvariants(&app.CrisisKeeper)
+
+	// store migrations and upgrade handlers
+	app.configurator = hmModule.NewConfigurator()
+	app.registerMigrations()
+	app.registerUpgradeHandlers()
 
 	// side router
 	app.sideRouter = types.NewSideRouter()
 	for _, m := range app.mm.Modules {
 		if m.Route() != "" {
 			if sm, ok := m.(hmModule.SideModule); ok {
-				app.sideRouter.AddRoute(m.Route(), &types.SideHandlers{
+				handlers := &types.SideHandlers{
 					SideTxHandler: sm.NewSideTxHandler(),
 					PostTxHandler: sm.NewPostTxHandler(),
-				})
+				}
+
+				if vm, ok := m.(hmModule.VoteExtensionModule); ok {
+					handlers.VoteExtension = vm.NewVoteExtension()
+				}
+
+				app.sideRouter.AddRoute(m.Route(), handlers)
 			}
 		}
 	}
@@ -470,6 +607,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 			app.SupplyKeeper,
 			&app.caller,
 			auth.DefaultSigVerificationGasConsumer,
+			app.TxPriority,
+			app.TxFilter,
 		),
 	)
 	// side-tx processor
@@ -523,6 +662,9 @@ func (app *HeimdallApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain)
 	// init genesis
 	app.mm.InitGenesis(ctx, genesisState)
 
+	// new chain starts at current consensus versions of modules
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, hmModule.GetVersionMap(app.mm))
+
 	stakingState := stakingTypes.GetGenesisStateFromAppState(genesisState)
 	checkpointState := checkpointTypes.GetGenesisStateFromAppState(genesisState)
 
@@ -551,6 +693,9 @@ func (app *HeimdallApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain)
 
 // BeginBlocker application updates every begin block
 func (app *HeimdallApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	// side-txs queued in previous block were validated already, or never will be while syncing
+	app.sideTxPool.reset()
+
 	app.AccountKeeper.SetBlockProposer(
 		ctx,
 		types.BytesToHeimdallAddress(req.Header.GetProposerAddress()),
@@ -622,6 +767,9 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 	// end block
 	app.mm.EndBlock(ctx, req)
 
+	// halt chain if any invariant is broken
+	crisis.EndBlocker(ctx, app.CrisisKeeper)
+
 	// send validator updates to peppermint
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: tmValUpdates,
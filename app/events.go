@@ -0,0 +1,133 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// EventsQuerierRoute query route of full event records of compact events
+	EventsQuerierRoute = "events"
+
+	// QueryEventRecord query of full event record by key
+	QueryEventRecord = "record"
+)
+
+// eventCompactor strips events down to essential and allowed attributes and keeps
+// full events in node local db. Events are not part of consensus, so compaction is node local.
+type eventCompactor struct {
+	db        dbm.DB
+	essential map[string]bool
+	allowlist map[string]map[string]bool // module -> allowed attributes
+}
+
+func newEventCompactor(db dbm.DB, allowlist map[string][]string) *eventCompactor {
+	c := &eventCompactor{
+		db:        db,
+		essential: make(map[string]bool),
+		allowlist: make(map[string]map[string]bool),
+	}
+
+	for _, key := range types.EssentialEventAttributes {
+		c.essential[key] = true
+	}
+
+	for module, keys := range allowlist {
+		c.allowlist[module] = make(map[string]bool)
+		for _, key := range keys {
+			c.allowlist[module][key] = true
+		}
+	}
+
+	return c
+}
+
+// getEventRecordKey returns key of full event record, scope is tx hash or block phase
+func getEventRecordKey(height int64, scope string, index int) string {
+	return fmt.Sprintf("%d/%s/%d", height, scope, index)
+}
+
+// compact strips attributes of events which are neither essential nor allowed for their module,
+// full events are stored and referenced by record key attribute
+func (c *eventCompactor) compact(height int64, scope string, events []abci.Event) []abci.Event {
+	batch := c.db.NewBatch()
+	defer batch.Close()
+
+	result := make([]abci.Event, 0, len(events))
+	for i, event := range events {
+		var module string
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == sdk.AttributeKeyModule {
+				module = string(attr.Value)
+			}
+		}
+
+		compacted := abci.Event{Type: event.Type}
+		for _, attr := range event.Attributes {
+			if c.essential[string(attr.Key)] || c.allowlist[module][string(attr.Key)] {
+				compacted.Attributes = append(compacted.Attributes, attr)
+			}
+		}
+
+		// nothing to strip
+		if len(compacted.Attributes) == len(event.Attributes) {
+			result = append(result, event)
+			continue
+		}
+
+		record, err := json.Marshal(sdk.StringifyEvent(event))
+		if err != nil {
+			result = append(result, event)
+			continue
+		}
+
+		key := getEventRecordKey(height, scope, i)
+		batch.Set([]byte(key), record)
+
+		compacted.Attributes = append(compacted.Attributes, sdk.NewAttribute(types.AttributeKeyEventRecord, key).ToKVPair())
+		result = append(result, compacted)
+	}
+
+	batch.Write()
+	return result
+}
+
+// SetEventRecordStore enables compact events, full events are stored in db
+func (app *HeimdallApp) SetEventRecordStore(db dbm.DB, allowlist map[string][]string) {
+	app.eventCompactor = newEventCompactor(db, allowlist)
+}
+
+// DeliverTx delivers tx, events of tx are compacted if compact events are enabled
+func (app *HeimdallApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+	if app.eventCompactor != nil {
+		txHash := types.BytesToHeimdallHash(tmhash.Sum(req.Tx)).Hex()
+		res.Events = app.eventCompactor.compact(app.LastBlockHeight()+1, txHash, res.Events)
+	}
+	return res
+}
+
+// queryEvents queries full event records of compact events
+func (app *HeimdallApp) queryEvents(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+	if len(path) == 0 || path[0] != QueryEventRecord {
+		return nil, sdk.ErrUnknownRequest("unknown events query endpoint")
+	}
+
+	if app.eventCompactor == nil {
+		return nil, sdk.ErrUnknownRequest("compact events are not enabled")
+	}
+
+	record := app.eventCompactor.db.Get(req.Data)
+	if record == nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("event record %s not found", req.Data))
+	}
+
+	return record, nil
+}
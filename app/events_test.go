@@ -0,0 +1,59 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/types"
+)
+
+func TestCompactEvents(t *testing.T) {
+	happ := Setup(false)
+	happ.SetEventRecordStore(dbm.NewMemDB(), map[string][]string{
+		"checkpoint": {"start-block"},
+	})
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			"checkpoint",
+			sdk.NewAttribute(sdk.AttributeKeyModule, "checkpoint"),
+			sdk.NewAttribute(types.AttributeKeyTxHash, "0x01"),
+			sdk.NewAttribute(types.AttributeKeyRootChain, types.RootChainTypeEth),
+			sdk.NewAttribute("start-block", "0"),
+			sdk.NewAttribute("end-block", "255"),
+		),
+		sdk.NewEvent(
+			"message",
+			sdk.NewAttribute(sdk.AttributeKeyModule, "checkpoint"),
+		),
+	}.ToABCIEvents()
+
+	compacted := happ.eventCompactor.compact(10, "side", events)
+	require.Len(t, compacted, 2)
+	require.Equal(t, events[1], compacted[1], "nothing to strip")
+
+	attrs := make(map[string]string)
+	for _, attr := range compacted[0].Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+	require.Equal(t, "0", attrs["start-block"], "allowed attribute")
+	require.NotContains(t, attrs, "end-block")
+	require.Equal(t, "10/side/0", attrs[types.AttributeKeyEventRecord])
+
+	// full event is queryable by record key
+	ctx := happ.BaseApp.NewContext(true, abci.Header{})
+	res, err := happ.queryEvents(ctx, []string{QueryEventRecord}, abci.RequestQuery{Data: []byte("10/side/0")})
+	require.NoError(t, err)
+
+	var record sdk.StringEvent
+	require.NoError(t, json.Unmarshal(res, &record))
+	require.Equal(t, sdk.StringifyEvent(events[0]), record)
+
+	_, err = happ.queryEvents(ctx, []string{QueryEventRecord}, abci.RequestQuery{Data: []byte("10/side/1")})
+	require.Error(t, err)
+}
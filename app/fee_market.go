@@ -0,0 +1,27 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// TxPriority returns fee market class of msg. Checkpoints and acks from current proposer are fee exempt,
+// so proposer never runs out of fees to submit them, topups are spam prone and pay fees scaled by recent
+// volume, which makes flooding mempool with them costly. Mempool ordering is not changed by class.
+func (app *HeimdallApp) TxPriority(ctx sdk.Context, signer types.HeimdallAddress, msg sdk.Msg) authTypes.TxPriority {
+	switch msg.(type) {
+	case checkpointTypes.MsgCheckpoint, checkpointTypes.MsgCheckpointAck:
+		proposer := app.StakingKeeper.GetValidatorSet(ctx).Proposer
+		if proposer != nil && proposer.Signer.Equals(signer) {
+			return authTypes.TxPriorityHigh
+		}
+	case topupTypes.MsgTopup:
+		return authTypes.TxPrioritySpamProne
+	}
+
+	return authTypes.TxPriorityNormal
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ModuleTron toggles tron checkpoints and checkpoint-sync of checkpoint module
+const ModuleTron = "tron"
+
+// optionalModules modules delivery runs without when they are listed in disabled_modules
+var optionalModules = map[string]bool{
+	borTypes.ModuleName:   true,
+	clerkTypes.ModuleName: true,
+	ModuleTron:            true,
+}
+
+// moduleToggles set of disabled optional modules. Disabled modules keep their stores mounted, so
+// app hash doesn't depend on toggles, but they aren't wired into module manager and their msgs are
+// kept out of mempool. Modules left out of module manager change state transitions, every validator
+// of network has to disable same ones.
+type moduleToggles map[string]bool
+
+// newModuleToggles returns toggles with modules disabled, only optional modules can be disabled
+func newModuleToggles(disabled []string) (moduleToggles, error) {
+	toggles := make(moduleToggles, len(disabled))
+	for _, name := range disabled {
+		if !optionalModules[name] {
+			return nil, fmt.Errorf("module %v can't be disabled", name)
+		}
+		toggles[name] = true
+	}
+	return toggles, nil
+}
+
+// Enabled returns true if module isn't disabled
+func (t moduleToggles) Enabled(name string) bool {
+	return !t[name]
+}
+
+// filterModules returns enabled modules
+func (t moduleToggles) filterModules(modules ...module.AppModule) []module.AppModule {
+	enabled := make([]module.AppModule, 0, len(modules))
+	for _, m := range modules {
+		if t.Enabled(m.Name()) {
+			enabled = append(enabled, m)
+		}
+	}
+	return enabled
+}
+
+// filterNames returns names of enabled modules
+func (t moduleToggles) filterNames(names ...string) []string {
+	enabled := make([]string, 0, len(names))
+	for _, name := range names {
+		if t.Enabled(name) {
+			enabled = append(enabled, name)
+		}
+	}
+	return enabled
+}
+
+// checkMsgEnabled rejects msgs of disabled modules, and checkpoint msgs of tron while it's disabled.
+// It's checked in CheckTx only, so block results never depend on local config.
+func (t moduleToggles) checkMsgEnabled(msg sdk.Msg) sdk.Error {
+	if !t.Enabled(msg.Route()) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("module %v is disabled", msg.Route()))
+	}
+
+	if t.Enabled(ModuleTron) {
+		return nil
+	}
+
+	rootChain := ""
+	switch msg := msg.(type) {
+	case checkpointTypes.MsgCheckpointSync, checkpointTypes.MsgCheckpointSyncAck:
+		rootChain = hmTypes.RootChainTypeTron
+	case checkpointTypes.MsgCheckpoint:
+		rootChain = msg.RootChainType
+	case checkpointTypes.MsgCheckpointAck:
+		rootChain = msg.RootChainType
+	case checkpointTypes.MsgCheckpointAdjust:
+		rootChain = msg.RootChainType
+	case checkpointTypes.MsgCheckpointBlsVote:
+		rootChain = msg.RootChainType
+	case checkpointTypes.MsgCheckpointAttestation:
+		rootChain = msg.RootChainType
+	}
+
+	if rootChain == hmTypes.RootChainTypeTron {
+		return sdk.ErrUnknownRequest("tron checkpoints are disabled")
+	}
+	return nil
+}
+
+// IsModuleEnabled returns true if optional module isn't disabled in config
+func (app *HeimdallApp) IsModuleEnabled(name string) bool {
+	return app.toggles.Enabled(name)
+}
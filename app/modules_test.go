@@ -0,0 +1,49 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestModuleToggles(t *testing.T) {
+	t.Parallel()
+
+	_, err := newModuleToggles([]string{stakingTypes.ModuleName})
+	require.Error(t, err, "required module can't be disabled")
+
+	toggles, err := newModuleToggles([]string{borTypes.ModuleName, ModuleTron})
+	require.NoError(t, err)
+	require.False(t, toggles.Enabled(borTypes.ModuleName))
+	require.True(t, toggles.Enabled(checkpointTypes.ModuleName))
+	require.Equal(t, []string{stakingTypes.ModuleName, checkpointTypes.ModuleName},
+		toggles.filterNames(stakingTypes.ModuleName, borTypes.ModuleName, checkpointTypes.ModuleName))
+
+	require.NotNil(t, toggles.checkMsgEnabled(checkpointTypes.MsgCheckpointSync{}))
+	require.NotNil(t, toggles.checkMsgEnabled(checkpointTypes.MsgCheckpoint{RootChainType: hmTypes.RootChainTypeTron}))
+	require.Nil(t, toggles.checkMsgEnabled(checkpointTypes.MsgCheckpoint{RootChainType: hmTypes.RootChainTypeEth}))
+
+	// nothing is disabled by default
+	toggles, err = newModuleToggles(nil)
+	require.NoError(t, err)
+	require.Nil(t, toggles.checkMsgEnabled(checkpointTypes.MsgCheckpointSync{}))
+}
+
+func TestTxFilterModuleToggles(t *testing.T) {
+	happ := Setup(false)
+
+	toggles, err := newModuleToggles([]string{ModuleTron})
+	require.NoError(t, err)
+	happ.toggles = toggles
+
+	// tron checkpoints are kept out of mempool only, block results don't depend on local config
+	msg := checkpointTypes.MsgCheckpointSync{}
+	require.NotNil(t, happ.TxFilter(happ.NewContext(true, abci.Header{}), msg))
+	require.Nil(t, happ.TxFilter(happ.NewContext(false, abci.Header{}), msg))
+}
@@ -0,0 +1,171 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// PendingDump portable bundle of in-flight checkpoint and side-tx state, used to restart
+// halted network on patched binaries without losing checkpoints waiting for ack
+type PendingDump struct {
+	Height      int64                `json:"height" yaml:"height"`
+	ChainID     string               `json:"chain_id" yaml:"chain_id"`
+	LastNoACK   uint64               `json:"last_no_ack" yaml:"last_no_ack"`
+	Checkpoints []PendingCheckpoints `json:"checkpoints" yaml:"checkpoints"`
+	SideTxs     []PendingSideTxs     `json:"side_txs" yaml:"side_txs"`
+}
+
+// PendingCheckpoints checkpoint buffer and sync buffer of root chain
+type PendingCheckpoints struct {
+	RootChain  string                                `json:"root_chain" yaml:"root_chain"`
+	Buffer     checkpointTypes.CheckpointBufferQueue `json:"buffer" yaml:"buffer"`
+	SyncBuffer *hmTypes.Checkpoint                   `json:"sync_buffer,omitempty" yaml:"sync_buffer"`
+}
+
+// PendingSideTxs side-txs delivered at height which weren't executed yet, with vote tally inputs.
+// Votes itself are part of next blocks, so tally holds power snapshot and quorum each tx needs.
+type PendingSideTxs struct {
+	Height     int64            `json:"height" yaml:"height"`
+	Validators []abci.Validator `json:"validators,omitempty" yaml:"validators"` // power snapshot, only while block is processed
+	TotalPower int64            `json:"total_power" yaml:"total_power"`
+	Txs        []PendingSideTx  `json:"txs" yaml:"txs"`
+}
+
+// PendingSideTx side-tx waiting for votes
+type PendingSideTx struct {
+	Hash      hmTypes.HeimdallHash `json:"hash" yaml:"hash"`
+	MsgType   string               `json:"msg_type" yaml:"msg_type"`
+	Quorum    string               `json:"quorum" yaml:"quorum"`
+	Threshold int64                `json:"threshold" yaml:"threshold"` // yes or no power required
+	Tx        tmTypes.Tx           `json:"tx" yaml:"tx"`
+}
+
+// DumpPending collects checkpoint buffers, sync buffers, last no-ack and pending side-txs of all root chains
+func (app *HeimdallApp) DumpPending(ctx sdk.Context) PendingDump {
+	dump := PendingDump{
+		Height:      ctx.BlockHeight(),
+		ChainID:     ctx.ChainID(),
+		LastNoACK:   app.CheckpointKeeper.GetLastNoAck(ctx),
+		Checkpoints: []PendingCheckpoints{},
+		SideTxs:     []PendingSideTxs{},
+	}
+
+	for _, rootChain := range chainmanagerTypes.SortedRootChains() {
+		pending := PendingCheckpoints{
+			RootChain: rootChain,
+			Buffer:    app.CheckpointKeeper.GetCheckpointBufferQueue(ctx, rootChain),
+		}
+		if syncBuffer, err := app.CheckpointKeeper.GetCheckpointSyncFromBuffer(ctx, rootChain); err == nil {
+			pending.SyncBuffer = syncBuffer
+		}
+
+		dump.Checkpoints = append(dump.Checkpoints, pending)
+	}
+
+	// collect heights with pending txs
+	var heights []int64
+	seen := make(map[int64]bool)
+	app.SidechannelKeeper.IterateTxsAndApplyFn(ctx, func(height int64, _ tmTypes.Tx) error {
+		if !seen[height] {
+			seen[height] = true
+			heights = append(heights, height)
+		}
+		return nil
+	})
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	validatorSet := app.StakingKeeper.GetValidatorSet(ctx)
+	decoder := authTypes.DefaultTxDecoder(app.cdc)
+
+	for _, height := range heights {
+		pending := PendingSideTxs{
+			Height:     height,
+			Validators: app.SidechannelKeeper.GetValidators(ctx, height),
+			TotalPower: validatorSet.TotalVotingPower(),
+			Txs:        []PendingSideTx{},
+		}
+
+		// prefer power snapshot of height if block is still processed
+		if len(pending.Validators) > 0 {
+			pending.TotalPower = 0
+			for _, v := range pending.Validators {
+				pending.TotalPower += v.Power
+			}
+		}
+
+		for _, tx := range app.SidechannelKeeper.GetTxs(ctx, height) {
+			msgType := ""
+			if decoded, err := decoder(tx); err == nil && len(decoded.GetMsgs()) > 0 {
+				msgType = decoded.GetMsgs()[0].Type()
+			}
+
+			quorum := app.SidechannelKeeper.GetQuorum(ctx, msgType)
+			pending.Txs = append(pending.Txs, PendingSideTx{
+				Hash:      hmTypes.BytesToHeimdallHash(tx.Hash()),
+				MsgType:   msgType,
+				Quorum:    quorum.String(),
+				Threshold: quorum.Threshold(pending.TotalPower),
+				Tx:        tx,
+			})
+		}
+
+		dump.SideTxs = append(dump.SideTxs, pending)
+	}
+
+	return dump
+}
+
+// RestorePending writes checkpoint buffers, sync buffers, last no-ack and pending side-txs of dump
+// to store. Buffers of root chains in dump are replaced, root chains missing in dump are left untouched.
+func (app *HeimdallApp) RestorePending(ctx sdk.Context, dump PendingDump) error {
+	if dump.ChainID != "" && ctx.ChainID() != "" && dump.ChainID != ctx.ChainID() {
+		return fmt.Errorf("dump of chain %v can't be restored on chain %v", dump.ChainID, ctx.ChainID())
+	}
+
+	for _, pending := range dump.Checkpoints {
+		if hmTypes.GetRootChainID(pending.RootChain) == 0 {
+			return fmt.Errorf("invalid root chain %v", pending.RootChain)
+		}
+
+		app.CheckpointKeeper.FlushCheckpointBuffer(ctx, pending.RootChain)
+		for _, checkpoint := range pending.Buffer.Checkpoints {
+			if err := app.CheckpointKeeper.PushCheckpointBuffer(ctx, checkpoint, pending.RootChain); err != nil {
+				return err
+			}
+		}
+
+		app.CheckpointKeeper.FlushCheckpointSyncBuffer(ctx, pending.RootChain)
+		if pending.SyncBuffer != nil {
+			if err := app.CheckpointKeeper.SetCheckpointSyncBuffer(ctx, *pending.SyncBuffer, pending.RootChain); err != nil {
+				return err
+			}
+		}
+	}
+
+	app.CheckpointKeeper.SetLastNoAck(ctx, dump.LastNoACK)
+
+	for _, pending := range dump.SideTxs {
+		for _, tx := range pending.Txs {
+			if !app.SidechannelKeeper.HasTx(ctx, pending.Height, tx.Tx.Hash()) {
+				app.SidechannelKeeper.SetTx(ctx, pending.Height, tx.Tx)
+			}
+		}
+
+		if len(pending.Validators) > 0 {
+			if err := app.SidechannelKeeper.SetValidators(ctx, pending.Height, pending.Validators); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
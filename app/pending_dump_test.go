@@ -0,0 +1,58 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	app "github.com/maticnetwork/heimdall/app"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestDumpRestorePending(t *testing.T) {
+	t.Parallel()
+
+	happ := app.Setup(false)
+	ctx := happ.NewContext(false, abci.Header{Height: 10, ChainID: "test-chain"})
+
+	buffered := hmTypes.CreateBlock(1, 10, hmTypes.HexToHeimdallHash("0x1"), hmTypes.HexToHeimdallAddress("0x2"), "15001", 100)
+	synced := hmTypes.CreateBlock(11, 20, hmTypes.HexToHeimdallHash("0x3"), hmTypes.HexToHeimdallAddress("0x2"), "15001", 200)
+	require.NoError(t, happ.CheckpointKeeper.SetCheckpointBuffer(ctx, buffered, hmTypes.RootChainTypeTron))
+	require.NoError(t, happ.CheckpointKeeper.SetCheckpointSyncBuffer(ctx, synced, hmTypes.RootChainTypeEth))
+	happ.CheckpointKeeper.SetLastNoAck(ctx, 300)
+
+	tx := tmTypes.Tx("pending-side-tx")
+	happ.SidechannelKeeper.SetTx(ctx, 9, tx)
+
+	dump := happ.DumpPending(ctx)
+	require.Equal(t, "test-chain", dump.ChainID)
+	require.Equal(t, uint64(300), dump.LastNoACK)
+	require.Len(t, dump.Checkpoints, len(hmTypes.GetRootChainIDMap()))
+	require.Len(t, dump.SideTxs, 1)
+	require.Equal(t, int64(9), dump.SideTxs[0].Height)
+	require.Len(t, dump.SideTxs[0].Txs, 1)
+	require.Equal(t, hmTypes.BytesToHeimdallHash(tx.Hash()), dump.SideTxs[0].Txs[0].Hash)
+
+	// restore on fresh store
+	restored := app.Setup(false)
+	restoredCtx := restored.NewContext(false, abci.Header{Height: 10, ChainID: "test-chain"})
+	require.NoError(t, restored.RestorePending(restoredCtx, dump))
+
+	checkpoint, err := restored.CheckpointKeeper.GetCheckpointFromBuffer(restoredCtx, hmTypes.RootChainTypeTron)
+	require.NoError(t, err)
+	require.Equal(t, buffered, *checkpoint)
+
+	checkpoint, err = restored.CheckpointKeeper.GetCheckpointSyncFromBuffer(restoredCtx, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, synced, *checkpoint)
+
+	require.Equal(t, uint64(300), restored.CheckpointKeeper.GetLastNoAck(restoredCtx))
+	require.True(t, restored.SidechannelKeeper.HasTx(restoredCtx, 9, tx.Hash()))
+	require.Equal(t, dump, restored.DumpPending(restoredCtx))
+
+	// dump of other chain is rejected
+	otherCtx := restored.NewContext(false, abci.Header{Height: 10, ChainID: "other-chain"})
+	require.Error(t, restored.RestorePending(otherCtx, dump))
+}
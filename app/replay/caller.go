@@ -0,0 +1,171 @@
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+)
+
+var (
+	callerType = reflect.TypeOf((*helper.IContractCaller)(nil)).Elem()
+	errorType  = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// ContractCall contract caller call made by side handler along with its results
+type ContractCall struct {
+	Method  string            `json:"method"`
+	Args    []json.RawMessage `json:"args,omitempty"` // informational, calls are replayed in recorded order
+	Returns []json.RawMessage `json:"returns"`        // results other than error
+	Error   string            `json:"error,omitempty"`
+}
+
+// RecordingCaller forwards contract calls to caller and records them with their results
+type RecordingCaller struct {
+	mocks.IContractCaller
+
+	mu    sync.Mutex
+	calls []ContractCall
+}
+
+// NewRecordingCaller returns caller recording calls made to caller
+func NewRecordingCaller(caller helper.IContractCaller) *RecordingCaller {
+	r := &RecordingCaller{}
+	target := reflect.ValueOf(caller)
+
+	for i := 0; i < callerType.NumMethod(); i++ {
+		method := callerType.Method(i)
+		fn := target.MethodByName(method.Name)
+
+		call := r.On(method.Name, anyArgs(method.Type.NumIn())...)
+		call.Run(func(args mock.Arguments) {
+			in := make([]reflect.Value, len(args))
+			for j, arg := range args {
+				if arg == nil {
+					in[j] = reflect.Zero(method.Type.In(j))
+				} else {
+					in[j] = reflect.ValueOf(arg)
+				}
+			}
+
+			out := fn.Call(in)
+			r.record(method.Name, args, out)
+
+			returns := make([]interface{}, len(out))
+			for j, v := range out {
+				returns[j] = v.Interface()
+			}
+			call.Return(returns...)
+		})
+	}
+
+	return r
+}
+
+// Calls returns recorded calls in call order
+func (r *RecordingCaller) Calls() []ContractCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]ContractCall{}, r.calls...)
+}
+
+func (r *RecordingCaller) record(method string, args []interface{}, out []reflect.Value) {
+	call := ContractCall{Method: method}
+	for _, arg := range args {
+		bz, err := json.Marshal(arg)
+		if err != nil {
+			bz = []byte(strconv.Quote(fmt.Sprintf("%v", arg)))
+		}
+		call.Args = append(call.Args, bz)
+	}
+
+	for _, v := range out {
+		if v.Type() == errorType {
+			if !v.IsNil() {
+				call.Error = v.Interface().(error).Error()
+			}
+			continue
+		}
+
+		bz, err := json.Marshal(v.Interface())
+		if err != nil {
+			helper.Logger.Error("Unable to record contract call result", "method", method, "error", err)
+			bz = []byte("null")
+		}
+		call.Returns = append(call.Returns, bz)
+	}
+
+	r.mu.Lock()
+	r.calls = append(r.calls, call)
+	r.mu.Unlock()
+}
+
+// NewReplayCaller returns caller answering calls with recorded results, in recorded order.
+// Calls beyond recorded ones panic.
+func NewReplayCaller(calls []ContractCall) (*mocks.IContractCaller, error) {
+	caller := &mocks.IContractCaller{}
+
+	for _, call := range calls {
+		method, ok := callerType.MethodByName(call.Method)
+		if !ok {
+			return nil, fmt.Errorf("unknown contract caller method %v", call.Method)
+		}
+
+		returns, err := decodeReturns(method.Type, call)
+		if err != nil {
+			return nil, err
+		}
+
+		caller.On(call.Method, anyArgs(method.Type.NumIn())...).Return(returns...).Once()
+	}
+
+	return caller, nil
+}
+
+// decodeReturns decodes recorded results into result types of method
+func decodeReturns(fnType reflect.Type, call ContractCall) ([]interface{}, error) {
+	returns := make([]interface{}, 0, fnType.NumOut())
+
+	next := 0
+	for i := 0; i < fnType.NumOut(); i++ {
+		out := fnType.Out(i)
+		if out == errorType {
+			if call.Error != "" {
+				returns = append(returns, errors.New(call.Error))
+			} else {
+				returns = append(returns, nil)
+			}
+			continue
+		}
+
+		if next >= len(call.Returns) {
+			return nil, fmt.Errorf("missing result %v of %v", i, call.Method)
+		}
+
+		v := reflect.New(out)
+		if err := json.Unmarshal(call.Returns[next], v.Interface()); err != nil {
+			return nil, fmt.Errorf("invalid result %v of %v: %v", i, call.Method, err)
+		}
+		next++
+
+		returns = append(returns, v.Elem().Interface())
+	}
+
+	return returns, nil
+}
+
+func anyArgs(n int) []interface{} {
+	args := make([]interface{}, n)
+	for i := range args {
+		args[i] = mock.Anything
+	}
+	return args
+}
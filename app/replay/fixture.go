@@ -0,0 +1,87 @@
+package replay
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Fixture side-tx recorded from live chain, replayed through side and post handlers
+type Fixture struct {
+	Name    string                     `json:"name"`
+	ChainID string                     `json:"chain_id"`
+	Height  int64                      `json:"height"`
+	Time    time.Time                  `json:"time"`
+	TxHash  string                     `json:"tx_hash"`
+	Tx      hmTypes.HexBytes           `json:"tx"`                // tx bytes as included in block
+	Genesis map[string]json.RawMessage `json:"genesis,omitempty"` // module genesis states replacing default ones
+	Calls   []ContractCall             `json:"calls"`             // contract calls of side handlers, in call order
+	Results []MsgResult                `json:"results"`           // expected results of side msgs of tx
+}
+
+// MsgResult results of side and post handler of side msg
+type MsgResult struct {
+	Msg  string     `json:"msg"`
+	Side SideResult `json:"side"`
+	Post PostResult `json:"post"`
+}
+
+// SideResult result of side handler, post handler is run with it
+type SideResult struct {
+	Result    string           `json:"result"`
+	Code      uint32           `json:"code"`
+	Codespace string           `json:"codespace,omitempty"`
+	Data      hmTypes.HexBytes `json:"data,omitempty"`
+}
+
+// PostResult result of post handler
+type PostResult struct {
+	Code      uint32           `json:"code"`
+	Codespace string           `json:"codespace,omitempty"`
+	Events    sdk.StringEvents `json:"events,omitempty"`
+}
+
+// LoadFixtures reads fixtures from json files in dir, sorted by file name
+func LoadFixtures(dir string) ([]Fixture, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	fixtures := make([]Fixture, 0, len(files))
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var fixture Fixture
+		if err := json.Unmarshal(data, &fixture); err != nil {
+			return nil, err
+		}
+
+		if fixture.Name == "" {
+			fixture.Name = filepath.Base(file)
+		}
+		fixtures = append(fixtures, fixture)
+	}
+
+	return fixtures, nil
+}
+
+// WriteFixture writes fixture as indented json
+func WriteFixture(path string, fixture Fixture) error {
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
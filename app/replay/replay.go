@@ -0,0 +1,179 @@
+package replay
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bor"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/chainmanager"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/clerk"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/topup"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Replay runs side msgs of fixture tx through side and post handlers of their modules on fresh app
+// initialized with fixture genesis. Contract calls of handlers are answered by caller.
+func Replay(fixture Fixture, caller helper.IContractCaller) (results []MsgResult, err error) {
+	// handlers panic on calls which weren't recorded
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("replay of %v panicked: %v", fixture.Name, r)
+		}
+	}()
+
+	hApp, err := newApp(fixture)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, sdkErr := authTypes.DefaultTxDecoder(hApp.Codec())(fixture.Tx)
+	if sdkErr != nil {
+		return nil, fmt.Errorf("unable to decode tx: %v", sdkErr)
+	}
+
+	ctx := hApp.NewContext(false, abci.Header{ChainID: fixture.ChainID, Height: fixture.Height, Time: fixture.Time}).WithTxBytes(fixture.Tx)
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(hmTypes.SideTxMsg); !ok {
+			continue
+		}
+
+		handlers := newSideHandlers(hApp, msg.Route(), caller)
+		if handlers == nil {
+			return nil, fmt.Errorf("no side handlers for route %v", msg.Route())
+		}
+
+		// side handlers are state-less, like during side-tx validation
+		sideCtx, _ := ctx.CacheContext()
+		side := handlers.SideTxHandler(sideCtx, msg)
+		post := handlers.PostTxHandler(ctx, msg, side.Result)
+
+		results = append(results, MsgResult{
+			Msg: msg.Route() + "/" + msg.Type(),
+			Side: SideResult{
+				Result:    side.Result.String(),
+				Code:      side.Code,
+				Codespace: side.Codespace,
+				Data:      side.Data,
+			},
+			Post: PostResult{
+				Code:      uint32(post.Code),
+				Codespace: string(post.Codespace),
+				Events:    sdk.StringifyEvents(post.Events.ToABCIEvents()),
+			},
+		})
+	}
+
+	return results, nil
+}
+
+// ReplayFixture replays fixture with its recorded contract calls, fails if handlers make other calls
+func ReplayFixture(fixture Fixture) ([]MsgResult, error) {
+	caller, err := NewReplayCaller(fixture.Calls)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := Replay(fixture, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(caller.Calls) != len(fixture.Calls) {
+		return nil, fmt.Errorf("handlers made %v contract calls, %v were recorded", len(caller.Calls), len(fixture.Calls))
+	}
+
+	for i, call := range caller.Calls {
+		if call.Method != fixture.Calls[i].Method {
+			return nil, fmt.Errorf("contract call %v is %v, %v was recorded", i, call.Method, fixture.Calls[i].Method)
+		}
+	}
+
+	return results, nil
+}
+
+// newApp creates app with default genesis, modules of fixture genesis are replaced
+func newApp(fixture Fixture) (*app.HeimdallApp, error) {
+	hApp := app.NewHeimdallApp(log.NewNopLogger(), dbm.NewMemDB())
+
+	genesisState := app.NewDefaultGenesisState()
+	for module, state := range fixture.Genesis {
+		if _, ok := genesisState[module]; !ok {
+			return nil, fmt.Errorf("unknown genesis module %v", module)
+		}
+		genesisState[module] = state
+	}
+
+	stateBytes, err := codec.MarshalJSONIndent(hApp.Codec(), genesisState)
+	if err != nil {
+		return nil, err
+	}
+
+	hApp.InitChain(abci.RequestInitChain{
+		ChainId:       fixture.ChainID,
+		Validators:    []abci.ValidatorUpdate{},
+		AppStateBytes: stateBytes,
+	})
+
+	return hApp, nil
+}
+
+// newSideHandlers returns side and post handlers of module route, built with caller
+func newSideHandlers(hApp *app.HeimdallApp, route string, caller helper.IContractCaller) *hmTypes.SideHandlers {
+	switch route {
+	case borTypes.RouterKey:
+		return &hmTypes.SideHandlers{
+			SideTxHandler: bor.NewSideTxHandler(hApp.BorKeeper, caller),
+			PostTxHandler: bor.NewPostTxHandler(hApp.BorKeeper, caller),
+		}
+	case chainmanagerTypes.RouterKey:
+		return &hmTypes.SideHandlers{
+			SideTxHandler: chainmanager.NewSideTxHandler(hApp.ChainKeeper, caller),
+			PostTxHandler: chainmanager.NewPostTxHandler(hApp.ChainKeeper, caller),
+		}
+	case checkpointTypes.RouterKey:
+		return &hmTypes.SideHandlers{
+			SideTxHandler: checkpoint.NewSideTxHandler(hApp.CheckpointKeeper, caller),
+			PostTxHandler: checkpoint.NewPostTxHandler(hApp.CheckpointKeeper, caller),
+		}
+	case clerkTypes.RouterKey:
+		return &hmTypes.SideHandlers{
+			SideTxHandler: clerk.NewSideTxHandler(hApp.ClerkKeeper, caller),
+			PostTxHandler: clerk.NewPostTxHandler(hApp.ClerkKeeper, caller),
+		}
+	case slashingTypes.RouterKey:
+		return &hmTypes.SideHandlers{
+			SideTxHandler: slashing.NewSideTxHandler(hApp.SlashingKeeper, caller),
+			PostTxHandler: slashing.NewPostTxHandler(hApp.SlashingKeeper, caller),
+		}
+	case stakingTypes.RouterKey:
+		return &hmTypes.SideHandlers{
+			SideTxHandler: staking.NewSideTxHandler(hApp.StakingKeeper, caller),
+			PostTxHandler: staking.NewPostTxHandler(hApp.StakingKeeper, caller),
+		}
+	case topupTypes.RouterKey:
+		return &hmTypes.SideHandlers{
+			SideTxHandler: topup.NewSideTxHandler(hApp.TopupKeeper, caller),
+			PostTxHandler: topup.NewPostTxHandler(hApp.TopupKeeper, caller),
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,40 @@
+package replay
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayFixtures(t *testing.T) {
+	fixtures, err := LoadFixtures("testdata")
+	require.NoError(t, err)
+	require.NotEmpty(t, fixtures)
+
+	for _, fixture := range fixtures {
+		fixture := fixture
+		t.Run(fixture.Name, func(t *testing.T) {
+			results, err := ReplayFixture(fixture)
+			require.NoError(t, err)
+
+			expected, err := json.Marshal(fixture.Results)
+			require.NoError(t, err)
+			actual, err := json.Marshal(results)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestReplayUnrecordedCall(t *testing.T) {
+	fixtures, err := LoadFixtures("testdata")
+	require.NoError(t, err)
+	require.NotEmpty(t, fixtures)
+
+	fixture := fixtures[0]
+	fixture.Calls = nil
+
+	_, err = ReplayFixture(fixture)
+	require.Error(t, err, "calls which weren't recorded should fail replay")
+}
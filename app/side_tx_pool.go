@@ -0,0 +1,182 @@
+package app
+
+import (
+	"encoding/hex"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+)
+
+// sideTxTask is pending external validation of side-tx
+type sideTxTask struct {
+	done     chan struct{}
+	res      abci.ResponseDeliverSideTx
+	panicked interface{}
+}
+
+// queuedSideTx is side-tx delivered in current block with context it was delivered on
+type queuedSideTx struct {
+	ctx     sdk.Context
+	txBytes []byte
+}
+
+// sideTxValidator validates side-tx on its own context
+type sideTxValidator func(ctx sdk.Context, txBytes []byte) abci.ResponseDeliverSideTx
+
+// sideTxPool validates side-txs of a block concurrently.
+// Side-txs are queued as they are delivered and the whole queue is validated
+// by a bounded worker pool on first DeliverSideTx of the block. Every side-tx
+// runs on its own (cache wrapped) context and results are handed out by tx hash,
+// so votes (and their order) are the same as with sequential validation.
+// Queue is reset at begin of every block, side-txs aren't executed while node is
+// syncing and their queue mustn't pile up. Running batch is stopped before queue is
+// reset or replaced, no validation outlives block it was queued in.
+type sideTxPool struct {
+	mu sync.Mutex
+
+	queued []queuedSideTx
+	tasks  map[string]*sideTxTask
+
+	// running batch, side-txs which haven't started validation are dropped on cancel
+	running sync.WaitGroup
+	cancel  chan struct{}
+}
+
+func newSideTxPool() *sideTxPool {
+	return &sideTxPool{
+		tasks: make(map[string]*sideTxTask),
+	}
+}
+
+// queue adds side-tx delivered on ctx to validation batch of current block
+func (p *sideTxPool) queue(ctx sdk.Context, txBytes []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.queued = append(p.queued, queuedSideTx{ctx: ctx, txBytes: txBytes})
+}
+
+// reset stops running batch and drops side-txs queued and results validated in previous block
+func (p *sideTxPool) reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stopBatch()
+	p.queued = nil
+	p.tasks = make(map[string]*sideTxTask)
+}
+
+// stopBatch cancels side-txs of running batch which haven't started validation and
+// waits for the rest to finish. Must be called with lock held.
+func (p *sideTxPool) stopBatch() {
+	if p.cancel != nil {
+		close(p.cancel)
+		p.cancel = nil
+	}
+
+	p.running.Wait()
+}
+
+// result returns validation result of side-tx, validating queued side-txs with
+// given number of workers first if needed. Returns false if tx was never queued.
+func (p *sideTxPool) result(txBytes []byte, workers int, validate sideTxValidator) (abci.ResponseDeliverSideTx, bool) {
+	key := hex.EncodeToString(tmTypes.Tx(txBytes).Hash())
+
+	p.mu.Lock()
+	task, ok := p.tasks[key]
+	if !ok && len(p.queued) > 0 {
+		p.startBatch(workers, validate)
+		task, ok = p.tasks[key]
+	}
+	if ok {
+		delete(p.tasks, key)
+	}
+	p.mu.Unlock()
+
+	if !ok {
+		return abci.ResponseDeliverSideTx{}, false
+	}
+
+	<-task.done
+
+	// surface handler panic to caller, same as sequential validation
+	if task.panicked != nil {
+		panic(task.panicked)
+	}
+
+	return task.res, true
+}
+
+// startBatch validates all queued side-txs, previous batch is stopped and its results
+// which were never requested are dropped. Must be called with lock held.
+func (p *sideTxPool) startBatch(workers int, validate sideTxValidator) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	p.stopBatch()
+
+	queued := p.queued
+	p.queued = nil
+	p.tasks = make(map[string]*sideTxTask, len(queued))
+
+	cancel := make(chan struct{})
+	p.cancel = cancel
+
+	sem := make(chan struct{}, workers)
+	for _, sideTx := range queued {
+		key := hex.EncodeToString(tmTypes.Tx(sideTx.txBytes).Hash())
+		if _, ok := p.tasks[key]; ok {
+			continue
+		}
+
+		task := &sideTxTask{done: make(chan struct{})}
+		p.tasks[key] = task
+
+		// workers never touch context of block, each side-tx is validated on its own snapshot
+		ctx, _ := sideTx.ctx.CacheContext()
+
+		p.running.Add(1)
+		go func(ctx sdk.Context, txBytes []byte, task *sideTxTask) {
+			defer p.running.Done()
+			defer close(task.done)
+
+			select {
+			case sem <- struct{}{}:
+			case <-cancel:
+				task.res = abci.ResponseDeliverSideTx{Result: abci.SideTxResultType_Skip}
+				return
+			}
+
+			defer func() {
+				if r := recover(); r != nil {
+					task.panicked = r
+				}
+				<-sem
+			}()
+
+			task.res = validate(ctx, txBytes)
+		}(ctx, sideTx.txBytes, task)
+	}
+}
+
+// validateSideTxBytes decodes and validates side-tx with side-tx handlers
+func (app *HeimdallApp) validateSideTxBytes(ctx sdk.Context, txBytes []byte) abci.ResponseDeliverSideTx {
+	tx, err := authTypes.DefaultTxDecoder(app.cdc)(txBytes)
+	if err != nil {
+		return abci.ResponseDeliverSideTx{
+			Code:      uint32(err.Code()),
+			Codespace: string(err.Codespace()),
+			Result:    abci.SideTxResultType_Skip,
+		}
+	}
+
+	// gas meter is not shared between workers
+	ctx = ctx.WithTxBytes(txBytes).WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	return app.validateSideTx(ctx, tx, txBytes)
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestSideTxPoolReset(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.NewContext(false, abci.Header{})
+
+	pool := newSideTxPool()
+	for i := byte(0); i < 4; i++ {
+		pool.queue(ctx, []byte{i})
+	}
+
+	var started, finished int32
+	release := make(chan struct{})
+	validate := func(ctx sdk.Context, txBytes []byte) abci.ResponseDeliverSideTx {
+		atomic.AddInt32(&started, 1)
+		<-release
+		atomic.AddInt32(&finished, 1)
+		return abci.ResponseDeliverSideTx{Result: abci.SideTxResultType_Yes}
+	}
+
+	// first tx starts the batch on one worker, request it in background
+	go pool.result([]byte{0}, 1, validate)
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&started) == 1 }, time.Second, time.Millisecond)
+
+	// reset waits for running validation and drops the rest of the batch
+	reset := make(chan struct{})
+	go func() {
+		pool.reset()
+		close(reset)
+	}()
+
+	select {
+	case <-reset:
+		require.Fail(t, "Reset should wait for running validation")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	<-reset
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&started), "Validations which didn't start should be cancelled")
+	require.Equal(t, int32(1), atomic.LoadInt32(&finished))
+
+	_, ok := pool.result([]byte{1}, 1, validate)
+	require.False(t, ok, "Results of dropped batch shouldn't be handed out")
+}
@@ -8,8 +8,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/helper"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
 	"github.com/maticnetwork/heimdall/types"
 )
 
@@ -32,11 +35,20 @@ func (app *HeimdallApp) PostDeliverTxHandler(ctx sdk.Context, tx sdk.Tx, result
 		// save tx bytes if any tx-msg is side-tx msg
 		if anySideMsg && ctx.TxBytes() != nil {
 			app.SidechannelKeeper.SetTx(ctx, ctx.BlockHeader().Height, ctx.TxBytes())
+
+			// queue for concurrent external validation
+			if helper.GetConfig().SideTxValidationWorkers > 1 && app.caller.Journal == nil {
+				app.sideTxPool.queue(ctx, ctx.TxBytes())
+			}
 		}
 	}
 }
 
-// BeginSideBlocker runs before side block
+// BeginSideBlocker runs before side block.
+// Side-txs of target height are executed in the order they were delivered in
+// that block (tx index), never in the order of side-tx results, so that
+// multiple side-txs touching same state (eg. checkpoints for same root chain)
+// are applied deterministically on every node.
 func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginSideBlock) (res abci.ResponseBeginSideBlock) {
 	height := ctx.BlockHeader().Height
 	if height <= 2 {
@@ -63,74 +75,67 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 		totalPower = totalPower + v.Power
 	}
 
-	// get empty events
-	events := sdk.EmptyEvents()
-
+	// collect votes per tx hash. Votes are only tallied below, side-txs are
+	// executed in their delivery order (tx index) at target height once tx order
+	// is enabled, hash order before, independent of the order of results in the request.
+	votes := make(map[string]abci.SideTxResult)
 	for _, sideTxResult := range req.SideTxResults {
-		txHash := sideTxResult.TxHash
-		// get tx from the store
-		tx := app.SidechannelKeeper.GetTx(ctx, targetHeight, txHash)
-		if tx != nil {
-			// remove tx to avoid duplicate execution
-			app.SidechannelKeeper.RemoveTx(ctx, targetHeight, txHash)
-
-			usedValidator := make(map[int]bool)
-
-			// signed power
-			signedPower := make(map[abci.SideTxResultType]int64)
-			signedPower[abci.SideTxResultType_Yes] = 0
-			signedPower[abci.SideTxResultType_Skip] = 0
-			signedPower[abci.SideTxResultType_No] = 0
-
-			for _, sigObj := range sideTxResult.Sigs {
-				// get validator by sig address
-				if i := getValidatorIndexByAddress(sigObj.Address, validators); i != -1 {
-					// check if validator already voted on tx
-					if _, ok := usedValidator[i]; !ok {
-						signedPower[sigObj.Result] = signedPower[sigObj.Result] + validators[i].Power
-						usedValidator[i] = true
-					}
-				}
-			}
+		txHash := hex.EncodeToString(sideTxResult.TxHash)
 
-			var result sdk.Result
-
-			// check vote majority
-			if signedPower[abci.SideTxResultType_Yes] >= (totalPower*2/3 + 1) {
-				// approved
-				logger.Debug("[sidechannel] Approved side-tx", "txHash", hex.EncodeToString(tx.Hash()))
-
-				// execute tx with `yes`
-				result = app.runTx(ctx, tx, abci.SideTxResultType_Yes)
-			} else if signedPower[abci.SideTxResultType_No] >= (totalPower*2/3 + 1) {
-				// rejected
-				logger.Debug("[sidechannel] Rejected side-tx", "txHash", hex.EncodeToString(tx.Hash()))
+		// first result for tx hash wins, duplicates are ignored
+		if _, ok := votes[txHash]; ok {
+			continue
+		}
 
-				// execute tx with `no`
-				result = app.runTx(ctx, tx, abci.SideTxResultType_No)
-			} else {
-				// skipped
-				logger.Debug("[sidechannel] Skipped side-tx", "txHash", hex.EncodeToString(tx.Hash()))
+		votes[txHash] = sideTxResult
+	}
 
-				// execute tx with `skip`
-				result = app.runTx(ctx, tx, abci.SideTxResultType_Skip)
-			}
+	// get decoder
+	decoder := authTypes.DefaultTxDecoder(app.cdc)
 
-			// add events
-			events = events.AppendEvents(result.Events)
-		}
-	}
+	// get empty events
+	events := sdk.EmptyEvents()
 
-	// remove all pending txs before exiting
+	// process all pending txs of target height in order of sidechannel keeper
 	txs := app.SidechannelKeeper.GetTxs(ctx, targetHeight)
 	for _, tx := range txs {
+		// remove tx to avoid duplicate execution
 		app.SidechannelKeeper.RemoveTx(ctx, targetHeight, tx.Hash())
 
-		// skipped
-		logger.Debug("[sidechannel] Skipped side-tx", "txHash", hex.EncodeToString(tx.Hash()))
+		txHash := hex.EncodeToString(tx.Hash())
+
+		// txs without result are skipped
+		sideTxResult := abci.SideTxResultType_Skip
+		var voters []types.SideTxVoter
+		if sideTxVotes, ok := votes[txHash]; ok {
+			var signedPower map[abci.SideTxResultType]int64
+			signedPower, voters = app.tallySideTxVotes(ctx, decoder, tx, sideTxVotes.Sigs, validators)
+
+			// check vote majority against quorum of tx msg type, skip votes are abstains if enough power participated.
+			// Side-tx which isn't approved or rejected leaves no state, so it can be proposed again.
+			threshold := app.getSideTxQuorum(ctx, decoder, tx).ThresholdWithAbstains(
+				app.SidechannelKeeper.GetMinParticipation(ctx),
+				totalPower,
+				signedPower[abci.SideTxResultType_Skip],
+			)
+			if signedPower[abci.SideTxResultType_Yes] >= threshold {
+				sideTxResult = abci.SideTxResultType_Yes
+			} else if signedPower[abci.SideTxResultType_No] >= threshold {
+				sideTxResult = abci.SideTxResultType_No
+			}
+		}
 
-		// execute tx with `skip`
-		result := app.runTx(ctx, tx, abci.SideTxResultType_Skip)
+		switch sideTxResult {
+		case abci.SideTxResultType_Yes:
+			logger.Debug("[sidechannel] Approved side-tx", "txHash", txHash)
+		case abci.SideTxResultType_No:
+			logger.Debug("[sidechannel] Rejected side-tx", "txHash", txHash)
+		default:
+			logger.Debug("[sidechannel] Skipped side-tx", "txHash", txHash)
+		}
+
+		// execute tx with result, post-tx handlers may read counted votes from context
+		result := app.runTx(types.WithSideTxVoters(ctx, voters), tx, sideTxResult)
 
 		// add events
 		events = events.AppendEvents(result.Events)
@@ -138,12 +143,32 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 
 	// set event to response
 	res.Events = events.ToABCIEvents()
+	if app.eventCompactor != nil {
+		res.Events = app.eventCompactor.compact(height, "side", res.Events)
+	}
 
 	return res
 }
 
-// DeliverSideTxHandler runs for each side tx
+// DeliverSideTxHandler runs for each side tx.
+// Side-txs delivered in block are validated concurrently (bounded by
+// side_tx_validation_workers), side-txs which were not queued are validated inline.
 func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abci.RequestDeliverSideTx) (res abci.ResponseDeliverSideTx) {
+	// calls can't be attributed to side-txs validated concurrently, journal validates sequentially
+	if workers := helper.GetConfig().SideTxValidationWorkers; workers > 1 && app.caller.Journal == nil {
+		if res, ok := app.sideTxPool.result(req.Tx, workers, app.validateSideTxBytes); ok {
+			return res
+		}
+	}
+
+	return app.validateSideTx(ctx, tx, req.Tx)
+}
+
+// validateSideTx runs side-tx handlers for all side msgs of tx
+func (app *HeimdallApp) validateSideTx(ctx sdk.Context, tx sdk.Tx, txBytes []byte) abci.ResponseDeliverSideTx {
+	// contract calls of side-tx handlers are journaled under tx
+	defer app.caller.Journal.Begin(ctx.BlockHeight(), types.BytesToHeimdallHash(tmhash.Sum(txBytes)).Hex())()
+
 	var code uint32
 	var codespace string
 
@@ -151,16 +176,20 @@ func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abc
 	data := make([]byte, 0)
 
 	for _, msg := range tx.GetMsgs() {
-		sideMsg, isSideTxMsg := msg.(types.SideTxMsg)
+		_, isSideTxMsg := msg.(types.SideTxMsg)
 
 		// match message route
 		msgRoute := msg.Route()
-		handlers := app.sideRouter.GetRoute(msgRoute)
-		if handlers != nil && handlers.SideTxHandler != nil && isSideTxMsg {
+		var ext types.VoteExtension
+		if handlers := app.sideRouter.GetRoute(msgRoute); handlers != nil {
+			ext = handlers.GetVoteExtension()
+		}
+
+		if ext != nil && isSideTxMsg {
 			// Create a new context based off of the existing context with a cache wrapped multi-store (for state-less execution)
-			runMsgCtx, _ := app.cacheTxContext(ctx, req.Tx)
-			// execute side-tx handler
-			msgResult := handlers.SideTxHandler(runMsgCtx, msg)
+			runMsgCtx, _ := app.cacheTxContext(ctx, txBytes)
+			// prepare vote with vote extension of msg route
+			msgResult := ext.PrepareVote(runMsgCtx, msg)
 
 			// stop execution and return on first failed message
 			if msgResult.Code != uint32(sdk.CodeOK) {
@@ -174,15 +203,27 @@ func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abc
 				break
 			}
 
+			// msg result is empty, use payload of msg which every node rebuilds to verify votes
+			payload := msgResult.Data
+			if len(payload) == 0 {
+				payload = ext.Payload(msg)
+			}
+
+			// encode payload with version of vote extension
+			msgData, err := types.EncodeVoteData(ext, msg, payload)
+			if err != nil {
+				data = make([]byte, 0)
+
+				code = uint32(sdk.CodeInternal)
+				codespace = string(sdk.CodespaceRoot)
+				result = abci.SideTxResultType_Skip
+				break
+			}
+
 			// Each message result's Data must be length prefixed in order to separate
 			// each result.
-			data = append(data, msgResult.Data...)
+			data = append(data, msgData...)
 			result = msgResult.Result
-
-			// msg result is empty, get side sign bytes and append into data
-			if len(msgResult.Data) == 0 {
-				data = append(data, sideMsg.GetSideSignBytes()...)
-			}
 		}
 	}
 
@@ -286,6 +327,141 @@ func (app *HeimdallApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Con
 // utils
 //
 
+// getSideTxQuorum returns vote quorum of side-tx, quorum of its first msg type
+func (app *HeimdallApp) getSideTxQuorum(ctx sdk.Context, decoder sdk.TxDecoder, txBytes []byte) sidechannelTypes.Quorum {
+	msgType := ""
+	if tx, err := decoder(txBytes); err == nil && len(tx.GetMsgs()) > 0 {
+		msgType = tx.GetMsgs()[0].Type()
+	}
+
+	return app.SidechannelKeeper.GetQuorum(ctx, msgType)
+}
+
+// tallySideTxVotes returns signed power of votes on side-tx per result along with counted votes. Each
+// validator is counted once. Once vote verification is enabled, votes not signed by their validators
+// or not verified by vote extensions of side msgs are not counted.
+func (app *HeimdallApp) tallySideTxVotes(ctx sdk.Context, decoder sdk.TxDecoder, txBytes []byte, sigs []abci.SideTxSig, validators []abci.Validator) (map[abci.SideTxResultType]int64, []types.SideTxVoter) {
+	signedPower := make(map[abci.SideTxResultType]int64)
+	signedPower[abci.SideTxResultType_Yes] = 0
+	signedPower[abci.SideTxResultType_Skip] = 0
+	signedPower[abci.SideTxResultType_No] = 0
+
+	var msgs []sdk.Msg
+	if tx, err := decoder(txBytes); err == nil {
+		msgs = tx.GetMsgs()
+	}
+
+	// rebuild vote data validators signed, with cache wrapped context per side msg shared by its votes
+	verifyVotes := app.SidechannelKeeper.IsVoteVerificationEnabled(ctx)
+	var data []byte
+	var msgVotes []sideMsgVote
+	if verifyVotes {
+		var err error
+		if data, msgVotes, err = app.getSideTxVoteData(ctx, msgs); err != nil {
+			app.Logger().Error("[sidechannel] Error while building side-tx vote data, no vote is counted", "error", err)
+			return signedPower, nil
+		}
+	}
+
+	var voters []types.SideTxVoter
+	usedValidator := make(map[int]bool)
+	for _, sigObj := range sigs {
+		// get validator by sig address, check if validator already voted on tx
+		i := getValidatorIndexByAddress(sigObj.Address, validators)
+		if i == -1 || usedValidator[i] {
+			continue
+		}
+		usedValidator[i] = true
+
+		if verifyVotes {
+			vote := types.ExtendedVote{
+				Result:    sigObj.Result,
+				Validator: sigObj.Address,
+				Signature: sigObj.Sig,
+			}
+			if err := verifySideTxVote(vote, data, msgVotes); err != nil {
+				app.Logger().Debug("[sidechannel] Ignoring unverified side-tx vote", "validator", hex.EncodeToString(sigObj.Address), "error", err)
+				continue
+			}
+		}
+
+		signedPower[sigObj.Result] = signedPower[sigObj.Result] + validators[i].Power
+		voters = append(voters, types.SideTxVoter{
+			Address: validators[i].Address,
+			Power:   validators[i].Power,
+			Result:  sigObj.Result,
+		})
+	}
+
+	return signedPower, voters
+}
+
+// sideMsgVote is side msg of tx along with its vote extension, payload signed by votes on it and
+// context its votes are verified on
+type sideMsgVote struct {
+	msg     sdk.Msg
+	ext     types.VoteExtension
+	payload []byte
+	ctx     sdk.Context
+}
+
+// getSideTxVoteData returns vote data of side msgs as validateSideTx builds it for yes and no votes,
+// along with side msgs whose vote extensions verify votes
+func (app *HeimdallApp) getSideTxVoteData(ctx sdk.Context, msgs []sdk.Msg) ([]byte, []sideMsgVote, error) {
+	data := make([]byte, 0)
+	var msgVotes []sideMsgVote
+
+	for _, msg := range msgs {
+		if _, ok := msg.(types.SideTxMsg); !ok || !app.sideRouter.HasRoute(msg.Route()) {
+			continue
+		}
+
+		ext := app.sideRouter.GetRoute(msg.Route()).GetVoteExtension()
+		if ext == nil {
+			continue
+		}
+
+		msgData, err := types.EncodeVoteData(ext, msg, ext.Payload(msg))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// extensions verify payload as it was signed
+		_, payload, err := types.DecodeVoteData(ext.Version(msg), msgData)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// votes on msg are verified on one cache wrapped context
+		verifyCtx, _ := ctx.CacheContext()
+		msgVotes = append(msgVotes, sideMsgVote{msg: msg, ext: ext, payload: payload, ctx: verifyCtx})
+		data = append(data, msgData...)
+	}
+
+	return data, msgVotes, nil
+}
+
+// verifySideTxVote verifies that vote is signed by its validator over vote data of tx, and verifies it
+// with vote extensions of all side msgs. Validators sign skip votes without data.
+func verifySideTxVote(vote types.ExtendedVote, data []byte, msgVotes []sideMsgVote) error {
+	if vote.Result == abci.SideTxResultType_Skip {
+		data = nil
+	}
+
+	if err := helper.VerifyExtendedVote(vote, data); err != nil {
+		return err
+	}
+
+	for _, msgVote := range msgVotes {
+		vote.Data = msgVote.payload
+		if err := msgVote.ext.VerifyVote(msgVote.ctx, msgVote.msg, vote); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func getValidatorIndexByAddress(address []byte, validators []abci.Validator) int {
 	for i, v := range validators {
 		if bytes.Equal(address, v.Address) {
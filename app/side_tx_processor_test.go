@@ -2,17 +2,23 @@ package app_test
 
 import (
 	"bytes"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	tmTypes "github.com/tendermint/tendermint/types"
 
 	app "github.com/maticnetwork/heimdall/app"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/helper"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -244,6 +250,146 @@ func (suite *SideTxProcessorTestSuite) TestDeliverSideTxHandler() {
 	})
 }
 
+func (suite *SideTxProcessorTestSuite) TestDeliverSideTxHandlerConcurrent() {
+	t, happ, ctx, encoder := suite.T(), suite.app, suite.ctx, suite.encoder
+
+	conf := helper.GetConfig()
+	defer helper.SetTestConfig(conf)
+
+	testConf := conf
+	testConf.SideTxValidationWorkers = 2
+	helper.SetTestConfig(testConf)
+
+	var (
+		mu          sync.Mutex
+		running     int
+		maxRunning  int
+		validations int
+		wrongCtx    int
+	)
+
+	router := hmTypes.NewSideRouter()
+	router.AddRoute(routeMsgSideCounter, &hmTypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			mu.Lock()
+			running++
+			validations++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+
+			// decoded txs carry pointer msgs
+			var counter int64
+			switch m := msg.(type) {
+			case msgSideCounter:
+				counter = m.Counter
+			case *msgSideCounter:
+				counter = m.Counter
+			}
+
+			// side-tx is validated on context it was delivered on
+			if delivered, ok := ctx.Value(sideCounterCtxKey{}).(int64); ok && delivered != counter {
+				mu.Lock()
+				wrongCtx++
+				mu.Unlock()
+			}
+
+			if counter%2 == 0 {
+				return abci.ResponseDeliverSideTx{Code: uint32(sdk.CodeInternal)}
+			}
+
+			return abci.ResponseDeliverSideTx{
+				Result: abci.SideTxResultType_Yes,
+				Data:   []byte{byte(counter)},
+			}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
+			return sdk.Result{}
+		},
+	})
+	happ.SetSideRouter(router)
+
+	// deliver side-txs
+	ctx = ctx.WithBlockHeight(20)
+	var txs []hmTypes.BaseTx
+	var txBytesList [][]byte
+	for i := int64(1); i <= 5; i++ {
+		tx := hmTypes.BaseTx{
+			Msg: msgSideCounter{Counter: i},
+		}
+		txBytes, err := encoder(tx)
+		require.Nil(t, err, "There should be no error while encoding tx")
+
+		happ.PostDeliverTxHandler(ctx.WithTxBytes(txBytes).WithValue(sideCounterCtxKey{}, i), tx, sdk.Result{})
+		txs = append(txs, tx)
+		txBytesList = append(txBytesList, txBytes)
+	}
+
+	// results are handed out per tx
+	for i, tx := range txs {
+		res := happ.DeliverSideTxHandler(ctx, tx, abci.RequestDeliverSideTx{
+			Tx: tmTypes.Tx(txBytesList[i]),
+		})
+
+		counter := int64(i + 1)
+		if counter%2 == 0 {
+			require.Equal(t, abci.SideTxResultType_Skip, res.GetResult(), "Failed side-tx should be skipped")
+			require.Equal(t, uint32(sdk.CodeInternal), res.Code)
+			require.Empty(t, res.Data)
+		} else {
+			require.Equal(t, abci.SideTxResultType_Yes, res.GetResult())
+			require.Equal(t, []byte{byte(counter)}, res.Data, "Result should belong to requested tx")
+		}
+	}
+
+	require.Equal(t, 5, validations, "Each side-tx should be validated once")
+	require.Zero(t, wrongCtx, "Side-txs should be validated on their own context")
+	require.LessOrEqual(t, maxRunning, 2, "Validations should be bounded by workers")
+	require.Greater(t, maxRunning, 1, "Validations should run concurrently")
+
+	// side-tx which was not delivered is validated inline
+	tx := hmTypes.BaseTx{
+		Msg: msgSideCounter{Counter: 7},
+	}
+	txBytes, err := encoder(tx)
+	require.Nil(t, err)
+
+	res := happ.DeliverSideTxHandler(ctx, tx, abci.RequestDeliverSideTx{
+		Tx: tmTypes.Tx(txBytes),
+	})
+	require.Equal(t, abci.SideTxResultType_Yes, res.GetResult())
+	require.Equal(t, 6, validations)
+
+	// side-txs delivered while syncing are never validated, queue is dropped at next block
+	var syncedTx hmTypes.BaseTx
+	var syncedTxBytes []byte
+	for _, counter := range []int64{9, 11} {
+		syncedTx = hmTypes.BaseTx{
+			Msg: msgSideCounter{Counter: counter},
+		}
+		syncedTxBytes, err = encoder(syncedTx)
+		require.Nil(t, err)
+		happ.PostDeliverTxHandler(ctx.WithTxBytes(syncedTxBytes), syncedTx, sdk.Result{})
+	}
+	happ.BeginBlocker(ctx.WithBlockHeight(21), abci.RequestBeginBlock{Header: abci.Header{Height: 21}})
+
+	res = happ.DeliverSideTxHandler(ctx, syncedTx, abci.RequestDeliverSideTx{
+		Tx: tmTypes.Tx(syncedTxBytes),
+	})
+	require.Equal(t, abci.SideTxResultType_Yes, res.GetResult())
+	require.Equal(t, 7, validations, "Side-txs queued in previous block shouldn't be validated")
+}
+
+// sideCounterCtxKey context key of counter of side-tx context was delivered for
+type sideCounterCtxKey struct{}
+
 func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 	t, happ, ctx, encoder := suite.T(), suite.app, suite.ctx, suite.encoder
 
@@ -273,10 +419,11 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 		var height int64 = 20
 		ctx = ctx.WithBlockHeight(height)
 
-		addr1 := []byte("hello-1")
-		addr2 := []byte("hello-2")
-		addr3 := []byte("hello-3")
-		addr4 := []byte("hello-4")
+		voters := testVoters{}
+		addr1 := voters.add()
+		addr2 := voters.add()
+		addr3 := voters.add()
+		addr4 := voters.add()
 		// set validators
 		happ.SidechannelKeeper.SetValidators(ctx, height, []abci.Validator{
 			{Address: addr1, Power: 10},
@@ -305,7 +452,7 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 				happ.SetSideRouter(router)
 
 				happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes) // set tx in the store for process
-				res = happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{
+				res = happ.BeginSideBlocker(ctx, voters.sign(t, abci.RequestBeginSideBlock{
 					SideTxResults: []abci.SideTxResult{
 						{
 							TxHash: txHash,
@@ -329,7 +476,7 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 							},
 						},
 					},
-				})
+				}, nil))
 				require.Equal(t, 0, len(res.Events), "It should have no event")
 				require.Nil(t, happ.SidechannelKeeper.GetTx(ctx, height-2, txHash), "Tx should not be present in store after begin block")
 			})
@@ -348,10 +495,11 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 		var height int64 = 20
 		ctx = ctx.WithBlockHeight(height)
 
-		addr1 := []byte("hello-1")
-		addr2 := []byte("hello-2")
-		addr3 := []byte("hello-3")
-		addr4 := []byte("hello-4")
+		voters := testVoters{}
+		addr1 := voters.add()
+		addr2 := voters.add()
+		addr3 := voters.add()
+		addr4 := voters.add()
 		// set validators
 		happ.SidechannelKeeper.SetValidators(ctx, height, []abci.Validator{
 			{Address: addr1, Power: 10},
@@ -385,6 +533,7 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 				},
 			},
 		}
+		req = voters.sign(t, req, nil)
 
 		// should save state on successful execution of  post-tx handler
 		{
@@ -503,10 +652,349 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 	})
 }
 
+func (suite *SideTxProcessorTestSuite) TestBeginSideBlockerDeliveryOrder() {
+	t, happ, ctx, encoder := suite.T(), suite.app, suite.ctx, suite.encoder
+
+	var height int64 = 30
+	ctx = ctx.WithBlockHeight(height)
+
+	voters := testVoters{}
+	addr1 := voters.add()
+	happ.SidechannelKeeper.SetValidators(ctx, height, []abci.Validator{
+		{Address: addr1, Power: 10},
+	})
+
+	// record order of post-tx execution
+	var executed []int64
+	var results []abci.SideTxResultType
+	router := hmTypes.NewSideRouter()
+	router.AddRoute(routeMsgSideCounter, &hmTypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			return abci.ResponseDeliverSideTx{}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
+			switch m := msg.(type) {
+			case msgSideCounter:
+				executed = append(executed, m.Counter)
+			case *msgSideCounter:
+				executed = append(executed, m.Counter)
+			}
+			results = append(results, sideTxResult)
+			return sdk.Result{}
+		},
+	})
+	happ.SetSideRouter(router)
+
+	// deliver txs in order 3, 1, 2
+	var sideTxResults []abci.SideTxResult
+	for _, counter := range []int64{3, 1, 2} {
+		txBytes, err := encoder(hmTypes.BaseTx{Msg: msgSideCounter{Counter: counter}})
+		require.Nil(t, err, "There should be no error while encoding tx")
+		happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+
+		// tx 1 gets no votes
+		if counter == 1 {
+			continue
+		}
+
+		// results are sent in reverse order
+		sideTxResults = append([]abci.SideTxResult{{
+			TxHash: tmTypes.Tx(txBytes).Hash(),
+			Sigs:   []abci.SideTxSig{{Result: abci.SideTxResultType_Yes, Address: addr1}},
+		}}, sideTxResults...)
+	}
+
+	happ.BeginSideBlocker(ctx, voters.sign(t, abci.RequestBeginSideBlock{SideTxResults: sideTxResults}, nil))
+
+	require.Equal(t, []int64{3, 1, 2}, executed, "Side-txs should be executed in delivery order")
+	require.Equal(t, []abci.SideTxResultType{
+		abci.SideTxResultType_Yes,
+		abci.SideTxResultType_Skip,
+		abci.SideTxResultType_Yes,
+	}, results)
+	require.Equal(t, 0, len(happ.SidechannelKeeper.GetTxs(ctx, height-2)), "All txs should be removed after begin block")
+}
+
+func (suite *SideTxProcessorTestSuite) TestBeginSideBlockerQuorum() {
+	t, happ, ctx, encoder := suite.T(), suite.app, suite.ctx, suite.encoder
+
+	var height int64 = 40
+	ctx = ctx.WithBlockHeight(height)
+
+	voters := testVoters{}
+	addr1 := voters.add()
+	addr2 := voters.add()
+	addr3 := voters.add()
+	happ.SidechannelKeeper.SetValidators(ctx, height, []abci.Validator{
+		{Address: addr1, Power: 30},
+		{Address: addr2, Power: 40},
+		{Address: addr3, Power: 30},
+	})
+
+	var results []abci.SideTxResultType
+	router := hmTypes.NewSideRouter()
+	router.AddRoute(routeMsgSideCounter, &hmTypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			return abci.ResponseDeliverSideTx{}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
+			results = append(results, sideTxResult)
+			return sdk.Result{}
+		},
+	})
+	happ.SetSideRouter(router)
+
+	txBytes, err := encoder(hmTypes.BaseTx{Msg: msgSideCounter{Counter: 1}})
+	require.Nil(t, err, "There should be no error while encoding tx")
+
+	// 70% of power votes yes
+	req := abci.RequestBeginSideBlock{
+		SideTxResults: []abci.SideTxResult{{
+			TxHash: tmTypes.Tx(txBytes).Hash(),
+			Sigs: []abci.SideTxSig{
+				{Result: abci.SideTxResultType_Yes, Address: addr1},
+				{Result: abci.SideTxResultType_Yes, Address: addr2},
+			},
+		}},
+	}
+	req = voters.sign(t, req, nil)
+
+	// default 2/3 quorum
+	happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, req)
+
+	// 3/4 quorum for msg type
+	params := sidechannelTypes.DefaultParams()
+	params.MsgQuorums = []sidechannelTypes.MsgQuorum{{MsgType: msgSideCounter{}.Type(), Quorum: sidechannelTypes.NewQuorum(3, 4)}}
+	happ.SidechannelKeeper.SetParams(ctx, params)
+	happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, req)
+
+	require.Equal(t, []abci.SideTxResultType{
+		abci.SideTxResultType_Yes,
+		abci.SideTxResultType_Skip,
+	}, results)
+}
+
+func (suite *SideTxProcessorTestSuite) TestBeginSideBlockerAbstains() {
+	t, happ, ctx, encoder := suite.T(), suite.app, suite.ctx, suite.encoder
+
+	var height int64 = 50
+	ctx = ctx.WithBlockHeight(height)
+
+	voters := testVoters{}
+	addr1 := voters.add()
+	addr2 := voters.add()
+	addr3 := voters.add()
+	addr4 := voters.add()
+	happ.SidechannelKeeper.SetValidators(ctx, height, []abci.Validator{
+		{Address: addr1, Power: 30},
+		{Address: addr2, Power: 30},
+		{Address: addr3, Power: 20},
+		{Address: addr4, Power: 20},
+	})
+
+	var results []abci.SideTxResultType
+	router := hmTypes.NewSideRouter()
+	router.AddRoute(routeMsgSideCounter, &hmTypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			return abci.ResponseDeliverSideTx{}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
+			results = append(results, sideTxResult)
+			return sdk.Result{}
+		},
+	})
+	happ.SetSideRouter(router)
+
+	txBytes, err := encoder(hmTypes.BaseTx{Msg: msgSideCounter{Counter: 1}})
+	require.Nil(t, err, "There should be no error while encoding tx")
+
+	vote := func(yes [][]byte, skip [][]byte, no [][]byte) abci.RequestBeginSideBlock {
+		var sigs []abci.SideTxSig
+		for _, addr := range yes {
+			sigs = append(sigs, abci.SideTxSig{Result: abci.SideTxResultType_Yes, Address: addr})
+		}
+		for _, addr := range skip {
+			sigs = append(sigs, abci.SideTxSig{Result: abci.SideTxResultType_Skip, Address: addr})
+		}
+		for _, addr := range no {
+			sigs = append(sigs, abci.SideTxSig{Result: abci.SideTxResultType_No, Address: addr})
+		}
+		return voters.sign(t, abci.RequestBeginSideBlock{
+			SideTxResults: []abci.SideTxResult{{TxHash: tmTypes.Tx(txBytes).Hash(), Sigs: sigs}},
+		}, nil)
+	}
+
+	// abstains count against quorum until min participation is set, 60 of 100 voted yes
+	happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, vote([][]byte{addr1, addr2}, [][]byte{addr3}, nil))
+
+	params := sidechannelTypes.DefaultParams()
+	params.MinParticipation = sidechannelTypes.NewQuorum(1, 2)
+	happ.SidechannelKeeper.SetParams(ctx, params)
+
+	// 60 of 80 participating voted yes
+	happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, vote([][]byte{addr1, addr2}, [][]byte{addr3}, nil))
+
+	// 60 of 80 participating voted no
+	happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, vote([][]byte{addr4}, [][]byte{addr3}, [][]byte{addr1, addr2}))
+
+	// 60 abstained, participation is below floor and 40 yes doesn't reach quorum of total power
+	happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, vote([][]byte{addr3, addr4}, [][]byte{addr1, addr2}, nil))
+
+	require.Equal(t, []abci.SideTxResultType{
+		abci.SideTxResultType_Skip,
+		abci.SideTxResultType_Yes,
+		abci.SideTxResultType_No,
+		abci.SideTxResultType_Skip,
+	}, results)
+}
+
+func (suite *SideTxProcessorTestSuite) TestVoteExtension() {
+	t, happ, ctx, encoder := suite.T(), suite.app, suite.ctx, suite.encoder
+
+	var height int64 = 60
+	ctx = ctx.WithBlockHeight(height)
+
+	voters := testVoters{}
+	addr1 := voters.add()
+	addr2 := voters.add()
+	addr3 := voters.add()
+	happ.SidechannelKeeper.SetValidators(ctx, height, []abci.Validator{
+		{Address: addr1, Power: 30},
+		{Address: addr2, Power: 40},
+		{Address: addr3, Power: 30},
+	})
+
+	var results []abci.SideTxResultType
+	ext := &testVoteExtension{payload: []byte("payload")}
+	router := hmTypes.NewSideRouter()
+	router.AddRoute(routeMsgSideCounter, &hmTypes.SideHandlers{
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
+			results = append(results, sideTxResult)
+			return sdk.Result{}
+		},
+		VoteExtension: ext,
+	})
+	happ.SetSideRouter(router)
+
+	tx := hmTypes.BaseTx{Msg: msgSideCounter{Counter: 1}}
+	txBytes, err := encoder(tx)
+	require.Nil(t, err, "There should be no error while encoding tx")
+
+	// vote is prepared by extension with versioned data
+	res := happ.DeliverSideTxHandler(ctx, tx, abci.RequestDeliverSideTx{Tx: tmTypes.Tx(txBytes)})
+	require.Equal(t, abci.SideTxResultType_Yes, res.GetResult())
+
+	name, payload, err := hmTypes.DecodeVoteData(hmTypes.VoteExtensionVersion1, res.Data)
+	require.NoError(t, err)
+	require.Equal(t, "counter", name)
+	require.Equal(t, ext.payload, payload)
+
+	// 70% of power votes yes, vote of addr1 fails verification
+	req := abci.RequestBeginSideBlock{
+		SideTxResults: []abci.SideTxResult{{
+			TxHash: tmTypes.Tx(txBytes).Hash(),
+			Sigs: []abci.SideTxSig{
+				{Result: abci.SideTxResultType_Yes, Address: addr1},
+				{Result: abci.SideTxResultType_Yes, Address: addr2},
+			},
+		}},
+	}
+
+	happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, voters.sign(t, req, res.Data))
+
+	ext.rejected = addr1
+	happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, voters.sign(t, req, res.Data))
+
+	// votes signed over other data aren't counted
+	ext.rejected = nil
+	happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, voters.sign(t, req, ext.payload))
+
+	// votes are counted as they are before verification is enabled
+	ctx.KVStore(happ.GetKey(sidechannelTypes.StoreKey)).Delete(sidechannelTypes.VoteVerificationKey)
+	happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, req)
+
+	require.Equal(t, []abci.SideTxResultType{
+		abci.SideTxResultType_Yes,
+		abci.SideTxResultType_Skip,
+		abci.SideTxResultType_Skip,
+		abci.SideTxResultType_Yes,
+	}, results)
+}
+
 //
 // utils
 //
 
+// testVoteExtension votes yes on payload and rejects votes of one validator
+type testVoteExtension struct {
+	payload  []byte
+	rejected []byte
+}
+
+func (e *testVoteExtension) Name() string             { return "counter" }
+func (e *testVoteExtension) Version(_ sdk.Msg) byte   { return hmTypes.VoteExtensionVersion1 }
+func (e *testVoteExtension) Payload(_ sdk.Msg) []byte { return e.payload }
+
+func (e *testVoteExtension) PrepareVote(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+	return abci.ResponseDeliverSideTx{Result: abci.SideTxResultType_Yes}
+}
+
+func (e *testVoteExtension) VerifyVote(ctx sdk.Context, msg sdk.Msg, vote hmTypes.ExtendedVote) error {
+	if bytes.Equal(vote.Validator, e.rejected) {
+		return errors.New("rejected vote")
+	}
+	if !bytes.Equal(vote.Data, e.payload) {
+		return errors.New("vote is not on payload")
+	}
+	return nil
+}
+
+// testVoters are validators of side-tx tests by address, they sign votes like validators sign
+// side-tx results in pre-commits
+type testVoters map[string]secp256k1.PrivKeySecp256k1
+
+// add adds validator with new key and returns its address
+func (v testVoters) add() []byte {
+	privKey := secp256k1.GenPrivKey()
+	address := privKey.PubKey().Address().Bytes()
+	v[string(address)] = privKey
+	return address
+}
+
+// sign returns request with votes of validators signed over vote data, skip votes are signed without data
+func (v testVoters) sign(t *testing.T, req abci.RequestBeginSideBlock, data []byte) abci.RequestBeginSideBlock {
+	signed := abci.RequestBeginSideBlock{}
+	for _, result := range req.SideTxResults {
+		sigs := make([]abci.SideTxSig, 0, len(result.Sigs))
+		for _, sigObj := range result.Sigs {
+			sideTxResult := tmTypes.SideTxResultWithData{
+				SideTxResult: tmTypes.SideTxResult{Result: int32(sigObj.Result)},
+			}
+			if sigObj.Result != abci.SideTxResultType_Skip {
+				sideTxResult.Data = data
+			}
+
+			sig, err := v[string(sigObj.Address)].Sign(sideTxResult.GetBytes())
+			require.NoError(t, err)
+
+			sigObj.Sig = sig
+			sigs = append(sigs, sigObj)
+		}
+		signed.SideTxResults = append(signed.SideTxResults, abci.SideTxResult{TxHash: result.TxHash, Sigs: sigs})
+	}
+	return signed
+}
+
 func registerTestCodec(cdc *codec.Codec) {
 	// register Tx, Msg
 	sdk.RegisterCodec(cdc)
@@ -0,0 +1,51 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+)
+
+// TxFilter rejects event records of root chain tx logs which are already synced or pending, redundant
+// bridges send same record. In CheckTx record is marked pending in check state too, so duplicates are
+// kept out of mempool before first one is included in block.
+//
+// In CheckTx checkpoints which don't continue tip, come from proposers outside of allowed window or
+// find buffer queue full are rejected too, before their side-tx makes every validator query root and
+// bor chains. DeliverTx leaves them to checkpoint handler, so block results don't change. Checkpoint
+// acks ahead of current header block of root chain contract (read in background) are rejected in CheckTx
+// likewise, as bridges race contract confirmation.
+//
+// Msgs of modules disabled in config are kept out of mempool in CheckTx only. DeliverTx doesn't depend
+// on local config, disabled modules have no route and tron checkpoints are left to checkpoint handler.
+func (app *HeimdallApp) TxFilter(ctx sdk.Context, msg sdk.Msg) sdk.Error {
+	if ctx.IsCheckTx() {
+		if err := app.toggles.checkMsgEnabled(msg); err != nil {
+			return err
+		}
+	}
+
+	switch msg := msg.(type) {
+	case clerkTypes.MsgEventRecord:
+		if err := app.ClerkKeeper.CheckEventRecordDuplicate(ctx, msg); err != nil {
+			return err
+		}
+
+		if ctx.IsCheckTx() {
+			app.ClerkKeeper.SetPendingEventRecord(ctx, msg)
+		}
+
+	case checkpointTypes.MsgCheckpoint:
+		if ctx.IsCheckTx() {
+			return app.CheckpointKeeper.CheckCheckpointAdmission(ctx, msg)
+		}
+
+	case checkpointTypes.MsgCheckpointAck:
+		if ctx.IsCheckTx() {
+			return app.CheckpointKeeper.CheckCheckpointAckAdmission(ctx, msg, &app.caller)
+		}
+	}
+
+	return nil
+}
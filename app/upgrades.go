@@ -0,0 +1,40 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// upgrades handled by this binary. Names must match upgrade plans scheduled by governance,
+// each of them runs store migrations of modules whose consensus version was bumped.
+var upgrades = []string{
+	"per-chain-child-block-interval",
+	"validator-liveness",
+	"standby-proposers",
+	"side-tx-delivery-order",
+	"side-tx-vote-verification",
+	"account-root-precompute",
+	"stake-update-nonce-check",
+}
+
+// registerMigrations collects store migrations of modules
+func (app *HeimdallApp) registerMigrations() {
+	for _, name := range app.mm.OrderInitGenesis {
+		if m, ok := app.mm.Modules[name].(hmModule.HasMigrations); ok {
+			if err := m.RegisterMigrations(app.configurator); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// registerUpgradeHandlers registers upgrade handlers which run store migrations
+func (app *HeimdallApp) registerUpgradeHandlers() {
+	for _, name := range upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(name, func(ctx sdk.Context, plan upgradeTypes.Plan, fromVM hmModule.VersionMap) (hmModule.VersionMap, error) {
+			return app.configurator.RunMigrations(ctx, app.mm, fromVM)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/maticnetwork/heimdall/app/helpers"
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types/module"
 	simTypes "github.com/maticnetwork/heimdall/types/simulation"
 )
@@ -26,6 +27,11 @@ func SetupSimulation(dirPrefix, dbName string) (simTypes.Config, dbm.DB, string,
 	config := NewConfigFromFlags()
 	config.ChainID = helpers.SimAppChainID
 
+	// crisis module asserts invariants (ack count, buffer, side-tx) every period blocks
+	conf := helper.GetConfig()
+	conf.InvCheckPeriod = uint64(FlagPeriodValue)
+	helper.SetTestConfig(conf)
+
 	var logger log.Logger
 	if FlagVerboseValue {
 		logger = log.TestingLogger()
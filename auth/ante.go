@@ -12,6 +12,7 @@ import (
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/chainmanager"
+	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
 )
@@ -69,6 +70,8 @@ func NewAnteHandler(
 	feeCollector FeeCollector,
 	contractCaller helper.IContractCaller,
 	sigGasConsumer SignatureVerificationGasConsumer,
+	txPriority authTypes.TxPriorityFunc,
+	txFilter authTypes.TxFilterFunc,
 ) sdk.AnteHandler {
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
 		// get module address
@@ -132,6 +135,18 @@ func NewAnteHandler(
 			return newCtx, res, true
 		}
 
+		// duplicates of pending side-tx msgs are rejected before they take side-tx round
+		if txFilter != nil {
+			if err := txFilter(newCtx, stdTx.Msg); err != nil {
+				return newCtx, err.Result(), true
+			}
+		}
+
+		// side-txs do external calls, their msgs are metered by type and capped per block
+		if res := ConsumeMsgWeight(newCtx, ak, stdTx.Msg, simulate); !res.IsOK() {
+			return newCtx, res, true
+		}
+
 		// stdSigs contains the sequence number, account number, and signatures.
 		// When simulating, this would just be a 0-length slice.
 		signerAddrs := stdTx.GetSigners()
@@ -152,6 +167,9 @@ func NewAnteHandler(
 			return newCtx, res, true
 		}
 
+		// fee market: priority txs are exempt from fees, spam prone ones pay more as their volume grows
+		feeForTx = GetFeeForTx(newCtx, ak, txPriority, signerAcc.GetAddress(), stdTx.Msg, feeForTx, simulate)
+
 		// deduct the fees
 		if !feeForTx.IsZero() {
 			res = DeductFees(feeCollector, newCtx, signerAcc, feeForTx)
@@ -194,6 +212,70 @@ func GetSignerAcc(
 	return nil, sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", addr)).Result()
 }
 
+// GetFeeForTx returns fees tx pays according to its priority. Spam prone txs are counted in recent volume
+// once delivered, so txs checked by mempool (or simulated) don't raise fees of txs which follow them.
+func GetFeeForTx(
+	ctx sdk.Context,
+	ak AccountKeeper,
+	txPriority authTypes.TxPriorityFunc,
+	signer types.HeimdallAddress,
+	msg sdk.Msg,
+	fees sdk.Coins,
+	simulate bool,
+) sdk.Coins {
+	if txPriority == nil {
+		return fees
+	}
+
+	switch txPriority(ctx, signer, msg) {
+	case authTypes.TxPriorityHigh:
+		return sdk.Coins{}
+	case authTypes.TxPrioritySpamProne:
+		multiplier := ak.GetFeeVolume(ctx).FeeMultiplier(ctx.BlockHeight())
+		if !simulate && !ctx.IsCheckTx() {
+			ak.IncrementFeeVolume(ctx)
+		}
+
+		scaled := make(sdk.Coins, len(fees))
+		for i, fee := range fees {
+			scaled[i] = sdk.NewCoin(fee.Denom, fee.Amount.Mul(sdk.NewIntFromUint64(multiplier)))
+		}
+
+		return scaled
+	default:
+		return fees
+	}
+}
+
+// ConsumeMsgWeight consumes gas configured for msg type and adds its weight to current block.
+// Block weight is capped only while delivering txs. Tendermint drops delivered txs from mempool whatever
+// their result, so txs over the cap fail with CodeBlockMsgWeightExceeded and are lost; bridge watches
+// its txs for that code and broadcasts their msgs again.
+func ConsumeMsgWeight(ctx sdk.Context, ak AccountKeeper, msg sdk.Msg, simulate bool) sdk.Result {
+	weight, ok := ak.GetMsgWeights(ctx).Get(msg.Type())
+	if !ok {
+		return sdk.Result{}
+	}
+
+	ctx.GasMeter().ConsumeGas(weight.Gas, "msg weight")
+
+	if weight.Weight == 0 || simulate || ctx.IsCheckTx() {
+		return sdk.Result{}
+	}
+
+	if maxWeight := ak.GetMaxBlockMsgWeight(ctx); maxWeight > 0 {
+		if included := ak.GetBlockMsgWeight(ctx); included+weight.Weight > maxWeight {
+			return common.ErrBlockMsgWeightExceeded(sdk.CodespaceRoot, fmt.Sprintf(
+				"block msg weight exceeded by %v: included %d, weight %d, max %d",
+				msg.Type(), included, weight.Weight, maxWeight,
+			)).Result()
+		}
+	}
+
+	ak.AddBlockMsgWeight(ctx, weight.Weight)
+	return sdk.Result{}
+}
+
 // ValidateMemo validates the memo size.
 func ValidateMemo(stdTx authTypes.StdTx, params authTypes.Params) sdk.Result {
 	memoLength := len(stdTx.GetMemo())
@@ -14,6 +14,7 @@ import (
 	"github.com/maticnetwork/heimdall/auth"
 	"github.com/maticnetwork/heimdall/auth/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/simulation"
@@ -44,6 +45,8 @@ func (suite *AnteTestSuite) SetupTest() {
 		suite.app.SupplyKeeper,
 		&caller,
 		auth.DefaultSigVerificationGasConsumer,
+		nil,
+		nil,
 	)
 }
 
@@ -386,6 +389,109 @@ func (suite *AnteTestSuite) TestFees() {
 	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFunds)
 }
 
+func (suite *AnteTestSuite) TestFeeMarket() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+	ctx = ctx.WithBlockHeight(1)
+
+	// keys and addresses
+	priv1, _, addr1 := sdkAuth.KeyTestPubAddr()
+	priv2, _, addr2 := sdkAuth.KeyTestPubAddr()
+
+	caller, err := helper.NewContractCaller()
+	require.NoError(t, err)
+
+	anteHandler := auth.NewAnteHandler(
+		happ.AccountKeeper,
+		happ.ChainKeeper,
+		happ.SupplyKeeper,
+		&caller,
+		auth.DefaultSigVerificationGasConsumer,
+		func(_ sdk.Context, signer hmTypes.HeimdallAddress, _ sdk.Msg) authTypes.TxPriority {
+			if signer.Equals(hmTypes.AccAddressToHeimdallAddress(addr1)) {
+				return authTypes.TxPriorityHigh
+			}
+			return authTypes.TxPrioritySpamProne
+		},
+		nil,
+	)
+
+	// priority tx doesn't need any fees
+	acc1 := happ.AccountKeeper.NewAccountWithAddress(ctx, hmTypes.AccAddressToHeimdallAddress(addr1))
+	happ.AccountKeeper.SetAccount(ctx, acc1)
+	tx := types.NewTestTx(ctx, sdkAuth.NewTestMsg(addr1), priv1, acc1.GetAccountNumber(), uint64(0))
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// spam prone tx pays more fees as volume grows
+	amt, _ := sdk.NewIntFromString(authTypes.DefaultTxFees)
+	acc2 := happ.AccountKeeper.NewAccountWithAddress(ctx, hmTypes.AccAddressToHeimdallAddress(addr2))
+	acc2.SetCoins(sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, amt.MulRaw(3))))
+	happ.AccountKeeper.SetAccount(ctx, acc2)
+
+	happ.AccountKeeper.SetFeeVolume(ctx, authTypes.FeeVolume{Height: 1, Volume: authTypes.FeeVolumeStep})
+	tx = types.NewTestTx(ctx, sdkAuth.NewTestMsg(addr2), priv2, acc2.GetAccountNumber(), uint64(0))
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.True(sdk.IntEq(t, happ.AccountKeeper.GetAccount(ctx, hmTypes.AccAddressToHeimdallAddress(addr2)).GetCoins().AmountOf(authTypes.FeeToken), amt))
+	require.Equal(t, authTypes.FeeVolumeStep+1, happ.AccountKeeper.GetFeeVolume(ctx).Volume)
+
+	// volume decays over blocks
+	ctx = ctx.WithBlockHeight(3)
+	tx = types.NewTestTx(ctx, sdkAuth.NewTestMsg(addr2), priv2, acc2.GetAccountNumber(), uint64(1))
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.True(t, happ.AccountKeeper.GetAccount(ctx, hmTypes.AccAddressToHeimdallAddress(addr2)).GetCoins().Empty())
+
+	// only delivered txs count in volume
+	volume := happ.AccountKeeper.GetFeeVolume(ctx)
+	fees := sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, amt))
+	msg := sdkAuth.NewTestMsg(addr2)
+	signer := hmTypes.AccAddressToHeimdallAddress(addr2)
+	spamProne := func(sdk.Context, hmTypes.HeimdallAddress, sdk.Msg) authTypes.TxPriority {
+		return authTypes.TxPrioritySpamProne
+	}
+
+	auth.GetFeeForTx(ctx.WithIsCheckTx(true), happ.AccountKeeper, spamProne, signer, msg, fees, false)
+	auth.GetFeeForTx(ctx, happ.AccountKeeper, spamProne, signer, msg, fees, true)
+	require.Equal(t, volume, happ.AccountKeeper.GetFeeVolume(ctx))
+
+	auth.GetFeeForTx(ctx, happ.AccountKeeper, spamProne, signer, msg, fees, false)
+	require.Equal(t, volume.At(3)+1, happ.AccountKeeper.GetFeeVolume(ctx).Volume)
+}
+
+func (suite *AnteTestSuite) TestMsgWeight() {
+	t, happ, ctx, anteHandler := suite.T(), suite.app, suite.ctx, suite.anteHandler
+	ctx = ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := sdkAuth.KeyTestPubAddr()
+	amt, _ := sdk.NewIntFromString(authTypes.DefaultTxFees)
+	acc1 := happ.AccountKeeper.NewAccountWithAddress(ctx, hmTypes.AccAddressToHeimdallAddress(addr1))
+	acc1.SetCoins(sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, amt.MulRaw(3))))
+	happ.AccountKeeper.SetAccount(ctx, acc1)
+
+	msg := sdkAuth.NewTestMsg(addr1)
+	happ.AccountKeeper.SetMsgWeights(ctx, authTypes.MsgWeights{{MsgType: msg.Type(), Gas: 5000, Weight: 2}})
+	happ.AccountKeeper.SetMaxBlockMsgWeight(ctx, 3)
+
+	// msg gas is consumed on top of signature gas
+	tx := types.NewTestTx(ctx, msg, priv1, acc1.GetAccountNumber(), uint64(0))
+	newCtx, _, _ := checkValidTx(t, anteHandler, ctx, tx, false)
+	require.True(t, newCtx.GasMeter().GasConsumed() > 5000)
+	require.Equal(t, uint64(2), happ.AccountKeeper.GetBlockMsgWeight(ctx))
+
+	// second msg doesn't fit into block
+	tx = types.NewTestTx(ctx, msg, priv1, acc1.GetAccountNumber(), uint64(1))
+	_, result, abort := anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, common.CodeBlockMsgWeightExceeded, result.Code)
+
+	// cap isn't applied while checking txs
+	checkValidTx(t, anteHandler, ctx.WithIsCheckTx(true), tx, false)
+
+	// weight resets in next block
+	ctx = ctx.WithBlockHeight(2)
+	require.Equal(t, uint64(0), happ.AccountKeeper.GetBlockMsgWeight(ctx))
+	tx = types.NewTestTx(ctx, msg, priv1, acc1.GetAccountNumber(), uint64(2))
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
+
 //
 // utils
 //
@@ -9,6 +9,8 @@ import (
 // InitGenesis - Init store state from genesis data
 func InitGenesis(ctx sdk.Context, ak AccountKeeper, processors []authTypes.AccountProcessor, data authTypes.GenesisState) {
 	ak.SetParams(ctx, data.Params)
+	ak.SetMsgWeights(ctx, data.MsgWeights)
+	ak.SetMaxBlockMsgWeight(ctx, data.MaxBlockMsgWeight)
 	data.Accounts = authTypes.SanitizeGenesisAccounts(data.Accounts)
 
 	for _, gacc := range data.Accounts {
@@ -41,5 +43,9 @@ func ExportGenesis(ctx sdk.Context, ak AccountKeeper) authTypes.GenesisState {
 		return false
 	})
 
-	return authTypes.NewGenesisState(params, genAccounts)
+	genesis := authTypes.NewGenesisState(params, genAccounts)
+	genesis.MsgWeights = ak.GetMsgWeights(ctx)
+	genesis.MaxBlockMsgWeight = ak.GetMaxBlockMsgWeight(ctx)
+
+	return genesis
 }
@@ -197,6 +197,83 @@ func (ak AccountKeeper) RemoveBlockProposer(ctx sdk.Context) {
 	store.Delete(types.ProposerKey())
 }
 
+//
+// fee volume
+//
+
+// GetFeeVolume returns recent volume of spam prone txs
+func (ak AccountKeeper) GetFeeVolume(ctx sdk.Context) (volume types.FeeVolume) {
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.FeeVolumeKey)
+	if bz != nil {
+		ak.cdc.MustUnmarshalBinaryBare(bz, &volume)
+	}
+
+	return volume
+}
+
+// SetFeeVolume sets recent volume of spam prone txs
+func (ak AccountKeeper) SetFeeVolume(ctx sdk.Context, volume types.FeeVolume) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.FeeVolumeKey, ak.cdc.MustMarshalBinaryBare(volume))
+}
+
+// IncrementFeeVolume adds spam prone tx to recent volume at current height
+func (ak AccountKeeper) IncrementFeeVolume(ctx sdk.Context) {
+	volume := ak.GetFeeVolume(ctx)
+	volume.Volume = volume.At(ctx.BlockHeight()) + 1
+	volume.Height = ctx.BlockHeight()
+	ak.SetFeeVolume(ctx, volume)
+}
+
+//
+// msg weight
+//
+
+// SetMsgWeights sets gas and block weight of msg types
+func (ak AccountKeeper) SetMsgWeights(ctx sdk.Context, weights types.MsgWeights) {
+	ak.paramSubspace.Set(ctx, types.KeyMsgWeights, weights)
+}
+
+// GetMsgWeights returns gas and block weight of msg types, empty if it was never set
+func (ak AccountKeeper) GetMsgWeights(ctx sdk.Context) (weights types.MsgWeights) {
+	ak.paramSubspace.GetIfExists(ctx, types.KeyMsgWeights, &weights)
+	return
+}
+
+// SetMaxBlockMsgWeight sets total weight of msgs included in block, 0 disables cap
+func (ak AccountKeeper) SetMaxBlockMsgWeight(ctx sdk.Context, weight uint64) {
+	ak.paramSubspace.Set(ctx, types.KeyMaxBlockMsgWeight, weight)
+}
+
+// GetMaxBlockMsgWeight returns total weight of msgs included in block, 0 if it was never set
+func (ak AccountKeeper) GetMaxBlockMsgWeight(ctx sdk.Context) (weight uint64) {
+	ak.paramSubspace.GetIfExists(ctx, types.KeyMaxBlockMsgWeight, &weight)
+	return
+}
+
+// GetBlockMsgWeight returns weight of msgs included in block at current height
+func (ak AccountKeeper) GetBlockMsgWeight(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.BlockMsgWeightKey)
+	if bz == nil {
+		return 0
+	}
+
+	var weight types.BlockMsgWeight
+	ak.cdc.MustUnmarshalBinaryBare(bz, &weight)
+	return weight.At(ctx.BlockHeight())
+}
+
+// AddBlockMsgWeight adds weight of msg to block at current height
+func (ak AccountKeeper) AddBlockMsgWeight(ctx sdk.Context, weight uint64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.BlockMsgWeightKey, ak.cdc.MustMarshalBinaryBare(types.BlockMsgWeight{
+		Height: ctx.BlockHeight(),
+		Weight: ak.GetBlockMsgWeight(ctx) + weight,
+	}))
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// TxPriority fee market class of tx. It only sets fees tx pays, mempool of tendermint fork heimdall
+// runs on is FIFO and reaps txs in order they were checked, whatever their class.
+type TxPriority int
+
+const (
+	// TxPriorityNormal pays flat tx fees
+	TxPriorityNormal TxPriority = iota
+	// TxPriorityHigh is exempt from fees (eg. checkpoint and ack from current proposer)
+	TxPriorityHigh
+	// TxPrioritySpamProne pays tx fees scaled by recent volume of spam prone txs (eg. topup)
+	TxPrioritySpamProne
+)
+
+// TxPriorityFunc returns fee market class of msg sent by signer
+type TxPriorityFunc func(ctx sdk.Context, signer hmTypes.HeimdallAddress, msg sdk.Msg) TxPriority
+
+const (
+	// FeeVolumeStep number of recent spam prone txs which adds one more tx fee
+	FeeVolumeStep uint64 = 10
+
+	// MaxFeeMultiplier caps fee of spam prone tx
+	MaxFeeMultiplier uint64 = 100
+)
+
+// FeeVolume recent volume of spam prone txs, volume halves every block
+type FeeVolume struct {
+	Height int64  `json:"height" yaml:"height"`
+	Volume uint64 `json:"volume" yaml:"volume"`
+}
+
+// At returns volume decayed till given height
+func (v FeeVolume) At(height int64) uint64 {
+	if height <= v.Height {
+		return v.Volume
+	}
+
+	blocks := height - v.Height
+	if blocks >= 64 {
+		return 0
+	}
+
+	return v.Volume >> uint64(blocks)
+}
+
+// FeeMultiplier returns multiplier of tx fees for spam prone tx at given height
+func (v FeeVolume) FeeMultiplier(height int64) uint64 {
+	multiplier := 1 + v.At(height)/FeeVolumeStep
+	if multiplier > MaxFeeMultiplier {
+		return MaxFeeMultiplier
+	}
+
+	return multiplier
+}
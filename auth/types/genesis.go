@@ -14,6 +14,9 @@ import (
 type GenesisState struct {
 	Params   Params          `json:"params" yaml:"params"`
 	Accounts GenesisAccounts `json:"accounts" yaml:"accounts"`
+
+	MsgWeights        MsgWeights `json:"msg_weights,omitempty" yaml:"msg_weights"`                   // gas and block weight of msg types
+	MaxBlockMsgWeight uint64     `json:"max_block_msg_weight,omitempty" yaml:"max_block_msg_weight"` // 0 means no cap
 }
 
 // NewGenesisState - Create a new genesis state
@@ -55,6 +58,10 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := data.MsgWeights.Validate(data.MaxBlockMsgWeight); err != nil {
+		return err
+	}
+
 	return ValidateGenAccounts(data.Accounts)
 }
 
@@ -39,6 +39,12 @@ var (
 
 	// GlobalAccountNumberKey param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
+
+	// FeeVolumeKey key for recent volume of spam prone txs
+	FeeVolumeKey = []byte("feeVolume")
+
+	// BlockMsgWeightKey key for weight of msgs included in current block
+	BlockMsgWeightKey = []byte("blockMsgWeight")
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
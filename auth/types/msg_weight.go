@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Param keys of per msg type metering. They're registered separately from Params,
+// so chains which never set them keep metering txs by signature and size only.
+var (
+	// KeyMsgWeights param key of gas and block weight of msg types doing external calls
+	KeyMsgWeights = []byte("MsgWeights")
+
+	// KeyMaxBlockMsgWeight param key of total weight of msgs included in block, 0 means no cap
+	KeyMaxBlockMsgWeight = []byte("MaxBlockMsgWeight")
+)
+
+// MsgWeight metering of msg type. Gas is consumed in ante handler on top of signature and
+// size gas, weight counts against block's max msg weight.
+type MsgWeight struct {
+	MsgType string `json:"msg_type" yaml:"msg_type"`
+	Gas     uint64 `json:"gas" yaml:"gas"`
+	Weight  uint64 `json:"weight" yaml:"weight"`
+}
+
+// MsgWeights metering of msg types
+type MsgWeights []MsgWeight
+
+// Get returns metering of msg type
+func (w MsgWeights) Get(msgType string) (MsgWeight, bool) {
+	for _, weight := range w {
+		if weight.MsgType == msgType {
+			return weight, true
+		}
+	}
+
+	return MsgWeight{}, false
+}
+
+// Validate checks msg types are unique and each msg fits into block
+func (w MsgWeights) Validate(maxBlockWeight uint64) error {
+	seen := make(map[string]bool)
+	for _, weight := range w {
+		if weight.MsgType == "" {
+			return fmt.Errorf("msg weight without msg type")
+		}
+
+		if seen[weight.MsgType] {
+			return fmt.Errorf("duplicate msg weight of %v", weight.MsgType)
+		}
+		seen[weight.MsgType] = true
+
+		if maxBlockWeight > 0 && weight.Weight > maxBlockWeight {
+			return fmt.Errorf("weight %v of %v exceeds max block msg weight %v", weight.Weight, weight.MsgType, maxBlockWeight)
+		}
+	}
+
+	return nil
+}
+
+// BlockMsgWeight total weight of msgs included in block at height
+type BlockMsgWeight struct {
+	Height int64  `json:"height" yaml:"height"`
+	Weight uint64 `json:"weight" yaml:"weight"`
+}
+
+// At returns weight included at given height
+func (w BlockMsgWeight) At(height int64) uint64 {
+	if height != w.Height {
+		return 0
+	}
+
+	return w.Weight
+}
@@ -73,7 +73,9 @@ func NewParams(
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().RegisterParamSet(&Params{}).
+		RegisterType(KeyMsgWeights, MsgWeights{}).
+		RegisterType(KeyMaxBlockMsgWeight, uint64(0))
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -0,0 +1,8 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxFilterFunc rejects msg before fees are deducted, eg. duplicate of side-tx msg which is already pending
+type TxFilterFunc func(ctx sdk.Context, msg sdk.Msg) sdk.Error
@@ -5,13 +5,14 @@ import (
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/keys"
 	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/crypto"
 	ethCrypto "github.com/maticnetwork/bor/crypto/secp256k1"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/client/keyring"
 )
 
 // TxBuilder implements a transaction context created in SDK modules.
@@ -61,7 +62,7 @@ func NewTxBuilder(
 // NewTxBuilderFromCLI returns a new initialized TxBuilder with parameters from
 // the command line using Viper.
 func NewTxBuilderFromCLI() TxBuilder {
-	kb, err := keys.NewKeyBaseFromHomeFlag()
+	kb, err := keyring.NewKeyBaseFromFlags()
 	if err != nil {
 		panic(err)
 	}
@@ -321,7 +322,7 @@ func MakeSignatureWithKeybase(
 	msg StdSignMsg,
 ) (sig StdSignature, err error) {
 	if keybase == nil {
-		keybase, err = keys.NewKeyBaseFromHomeFlag()
+		keybase, err = keyring.NewKeyBaseFromFlags()
 		if err != nil {
 			return
 		}
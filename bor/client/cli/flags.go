@@ -5,4 +5,6 @@ const (
 	FlagBorChainId      = "bor-chain-id"
 	FlagStartBlock      = "start-block"
 	FlagSpanId          = "span-id"
+	FlagValidatorID     = "validator-id"
+	FlagRootChain       = "root-chain"
 )
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+// ProducerSetOverrideProposalJSON defines producer set override proposal with deposit used
+// to parse proposal from a JSON file.
+type ProducerSetOverrideProposalJSON struct {
+	Title       string                `json:"title" yaml:"title"`
+	Description string                `json:"description" yaml:"description"`
+	SpanID      uint64                `json:"span_id" yaml:"span_id"`
+	ProducerIDs []hmTypes.ValidatorID `json:"producer_ids" yaml:"producer_ids"`
+	Deposit     sdk.Coins             `json:"deposit" yaml:"deposit"`
+}
+
+// GetCmdSubmitProducerSetOverrideProposal implements a command handler for submitting
+// producer set override proposal transaction.
+func GetCmdSubmitProducerSetOverrideProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "producer-set-override [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an emergency producer set override proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to replace producers of future span along with an initial deposit.
+Override is activated after a delay once proposal passes, and it's applied when span is proposed.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal producer-set-override <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Replace offline producers",
+  "description": "Selected producers of span 120 are offline",
+  "span_id": 120,
+  "producer_ids": [1, 4, 7],
+  "deposit": [
+    {
+      "denom": "btt",
+      "amount": "1000000000000000000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var proposal ProducerSetOverrideProposalJSON
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			from := helper.GetFromAddress(cliCtx)
+			content := types.NewProducerSetOverrideProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.SpanID,
+				proposal.ProducerIDs,
+			)
+
+			// create submit proposal
+			msg := govTypes.NewMsgSubmitProposal(content, proposal.Deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		cliLogger.Error("GetCmdSubmitProducerSetOverrideProposal | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
@@ -34,6 +34,9 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetSpan(cdc),
 			GetLatestSpan(cdc),
+			GetFutureSpans(cdc),
+			GetSpanCheckpoints(cdc),
+			GetCheckpointSpans(cdc),
 			GetQueryParams(cdc),
 		)...,
 	)
@@ -116,6 +119,100 @@ func GetLatestSpan(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetFutureSpans get precomputed spans
+func GetFutureSpans(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "future-spans",
+		Short: "show precomputed upcoming spans",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// fetch future spans
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFutureSpans), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetSpanCheckpoints get checkpoints covering span
+func GetSpanCheckpoints(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "span-checkpoints",
+		Short: "show acked checkpoints covering block range of span",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			spanID := viper.GetUint64(FlagSpanId)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanCheckpointsParams(spanID, viper.GetString(FlagRootChain)))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoints covering span
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpanCheckpoints), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSpanId, 0, "--span-id=<span ID here>")
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root chain, all root chains if empty>")
+	if err := cmd.MarkFlagRequired(FlagSpanId); err != nil {
+		cliLogger.Error("GetSpanCheckpoints | MarkFlagRequired | FlagSpanId", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCheckpointSpans get spans covering checkpoint
+func GetCheckpointSpans(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-spans [number]",
+		Short: "show spans covering block range of acked checkpoint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			number, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointSpansParams(number, viper.GetString(FlagRootChain)))
+			if err != nil {
+				return err
+			}
+
+			// fetch spans covering checkpoint
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointSpans), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root chain of checkpoint>")
+
+	return cmd
+}
+
 // GetQueryParams implements the params query command.
 func GetQueryParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
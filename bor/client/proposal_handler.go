@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/maticnetwork/heimdall/bor/client/cli"
+	"github.com/maticnetwork/heimdall/bor/client/rest"
+	govclient "github.com/maticnetwork/heimdall/gov/client"
+)
+
+// producer set override proposal handler
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProducerSetOverrideProposal, rest.ProposalRESTHandler)
@@ -0,0 +1,66 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	govRest "github.com/maticnetwork/heimdall/gov/client/rest"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+// ProducerSetOverrideProposalReq defines producer set override proposal request body
+type ProducerSetOverrideProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string                  `json:"title" yaml:"title"`
+	Description string                  `json:"description" yaml:"description"`
+	SpanID      uint64                  `json:"span_id" yaml:"span_id"`
+	ProducerIDs []hmTypes.ValidatorID   `json:"producer_ids" yaml:"producer_ids"`
+	Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+	Validator   hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the producer
+// set override REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "producer_set_override",
+		Handler:  postProducerSetOverrideProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProducerSetOverrideProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ProducerSetOverrideProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewProducerSetOverrideProposal(
+			req.Title,
+			req.Description,
+			req.SpanID,
+			req.ProducerIDs,
+		)
+
+		msg := govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
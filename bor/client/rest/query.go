@@ -20,7 +20,10 @@ import (
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bor/span/list", spanListHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bor/span/{id}", spanHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bor/span/{id}/checkpoints", spanCheckpointsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bor/checkpoint/{number}/spans", checkpointSpansHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bor/latest-span", latestSpanHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bor/future-spans", futureSpansHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bor/prepare-next-span", prepareNextSpanHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bor/next-span-seed", fetchNextSpanSeedHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bor/params", paramsHandlerFn(cliCtx)).Methods("GET")
@@ -140,6 +143,77 @@ func spanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func spanCheckpointsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		spanID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["id"])
+		if !ok {
+			return
+		}
+
+		// all root chains unless one is selected
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, "")
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanCheckpointsParams(spanID, rootChain))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// fetch checkpoints covering span
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpanCheckpoints), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		hmRest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func checkpointSpansHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["number"])
+		if !ok {
+			return
+		}
+
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, "")
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointSpansParams(number, rootChain))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// fetch spans covering checkpoint
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointSpans), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		hmRest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func latestSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -165,6 +239,26 @@ func latestSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func futureSpansHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// fetch precomputed spans
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFutureSpans), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		hmRest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func prepareNextSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -0,0 +1,160 @@
+package bor
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/heimdall/bor/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetFutureSpanKey appends prefix to span id
+func GetFutureSpanKey(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return append(append([]byte{}, FutureSpanPrefixKey...), b...)
+}
+
+// SetFutureSpanCount sets number of spans precomputed ahead of last span
+func (k *Keeper) SetFutureSpanCount(ctx sdk.Context, count uint64) {
+	k.paramSpace.Set(ctx, types.KeyFutureSpanCount, count)
+}
+
+// GetFutureSpanCount gets number of spans precomputed ahead of last span, 0 if it was never set
+func (k *Keeper) GetFutureSpanCount(ctx sdk.Context) (count uint64) {
+	k.paramSpace.GetIfExists(ctx, types.KeyFutureSpanCount, &count)
+	return
+}
+
+// SetFutureSpan stores precomputed span
+func (k *Keeper) SetFutureSpan(ctx sdk.Context, futureSpan types.FutureSpan) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetFutureSpanKey(futureSpan.Span.ID), k.cdc.MustMarshalBinaryBare(futureSpan))
+}
+
+// GetFutureSpan returns precomputed span by id
+func (k *Keeper) GetFutureSpan(ctx sdk.Context, id uint64) (futureSpan types.FutureSpan, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetFutureSpanKey(id))
+	if bz == nil {
+		return futureSpan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &futureSpan)
+	return futureSpan, true
+}
+
+// GetFutureSpans returns precomputed spans ordered by id
+func (k *Keeper) GetFutureSpans(ctx sdk.Context) (futureSpans []types.FutureSpan) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, FutureSpanPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var futureSpan types.FutureSpan
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &futureSpan)
+		futureSpans = append(futureSpans, futureSpan)
+	}
+
+	return futureSpans
+}
+
+// GetFutureSpanProducers returns producers of precomputed span if it matches proposed span
+// and eligible validators didn't change since it was computed
+func (k *Keeper) GetFutureSpanProducers(ctx sdk.Context, id uint64, startBlock uint64, endBlock uint64, borChainID string) ([]hmTypes.Validator, bool) {
+	futureSpan, ok := k.GetFutureSpan(ctx, id)
+	if !ok {
+		return nil, false
+	}
+
+	if futureSpan.Span.StartBlock != startBlock ||
+		futureSpan.Span.EndBlock != endBlock ||
+		futureSpan.Span.ChainID != borChainID ||
+		futureSpan.SelectionHash != k.getSelectionHash(ctx) {
+		return nil, false
+	}
+
+	return futureSpan.Span.SelectedProducers, true
+}
+
+// UpdateFutureSpans precomputes producers of spans following last span, so bor nodes can
+// fetch upcoming producer sets ahead of span boundary. Future spans are recomputed
+// only when eligible validators, producer count or span duration change.
+func (k *Keeper) UpdateFutureSpans(ctx sdk.Context) {
+	count := k.GetFutureSpanCount(ctx)
+
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		return
+	}
+
+	// remove spans which are already proposed or out of window
+	store := ctx.KVStore(k.storeKey)
+	for _, futureSpan := range k.GetFutureSpans(ctx) {
+		if futureSpan.Span.ID <= lastSpan.ID || futureSpan.Span.ID > lastSpan.ID+count {
+			store.Delete(GetFutureSpanKey(futureSpan.Span.ID))
+		}
+	}
+
+	if count == 0 {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	borChainID := k.chainKeeper.GetParams(ctx).ChainParams.BorChainID
+	selectionHash := k.getSelectionHash(ctx)
+
+	startBlock := lastSpan.EndBlock + 1
+	for id := lastSpan.ID + 1; id <= lastSpan.ID+count; id++ {
+		endBlock := startBlock + params.SpanDuration - 1
+
+		futureSpan, ok := k.GetFutureSpan(ctx, id)
+		if !ok ||
+			futureSpan.SelectionHash != selectionHash ||
+			futureSpan.Span.StartBlock != startBlock ||
+			futureSpan.Span.EndBlock != endBlock ||
+			futureSpan.Span.ChainID != borChainID {
+			producers, err := k.SelectNextProducers(ctx, getFutureSpanSeed(id, selectionHash))
+			if err != nil {
+				k.Logger(ctx).Error("Error selecting producers of future span", "id", id, "error", err)
+				return
+			}
+
+			k.SetFutureSpan(ctx, types.FutureSpan{
+				Span:          hmTypes.NewSpan(id, startBlock, endBlock, k.sk.GetValidatorSet(ctx), producers, borChainID),
+				SelectionHash: selectionHash,
+				ComputedAt:    ctx.BlockHeight(),
+			})
+
+			k.Logger(ctx).Debug("Precomputed future span", "id", id, "startBlock", startBlock, "endBlock", endBlock)
+		}
+
+		startBlock = endBlock + 1
+	}
+}
+
+// getSelectionHash returns hash of producer selection input: span eligible validators and producer count
+func (k *Keeper) getSelectionHash(ctx sdk.Context) hmTypes.HeimdallHash {
+	validators := k.sk.GetSpanEligibleValidators(ctx)
+
+	data := make([][]byte, 0, len(validators)+1)
+	for i := range validators {
+		data = append(data, validators[i].Bytes())
+	}
+
+	producerCount := make([]byte, 8)
+	binary.BigEndian.PutUint64(producerCount, k.GetParams(ctx).ProducerCount)
+	data = append(data, producerCount)
+
+	return hmTypes.BytesToHeimdallHash(crypto.Keccak256(data...))
+}
+
+// getFutureSpanSeed returns deterministic seed of future span
+func getFutureSpanSeed(id uint64, selectionHash hmTypes.HeimdallHash) common.Hash {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return crypto.Keccak256Hash(b, selectionHash.Bytes())
+}
@@ -0,0 +1,67 @@
+package bor_test
+
+import (
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestUpdateFutureSpans(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+	keeper := happ.BorKeeper
+
+	chSim.LoadValidatorSet(4, t, happ.StakingKeeper, ctx, false, 10)
+
+	borChainID := happ.ChainKeeper.GetParams(ctx).ChainParams.BorChainID
+	spanDuration := keeper.GetParams(ctx).SpanDuration
+
+	validatorSet := happ.StakingKeeper.GetValidatorSet(ctx)
+	lastSpan := hmTypes.NewSpan(1, 0, 255, validatorSet, happ.StakingKeeper.GetSpanEligibleValidators(ctx), borChainID)
+	require.Nil(t, keeper.AddNewSpan(ctx, lastSpan))
+
+	// disabled by default
+	keeper.UpdateFutureSpans(ctx)
+	require.Empty(t, keeper.GetFutureSpans(ctx))
+
+	keeper.SetFutureSpanCount(ctx, 2)
+	keeper.UpdateFutureSpans(ctx)
+
+	futureSpans := keeper.GetFutureSpans(ctx)
+	require.Len(t, futureSpans, 2)
+	require.Equal(t, uint64(2), futureSpans[0].Span.ID)
+	require.Equal(t, uint64(256), futureSpans[0].Span.StartBlock)
+	require.Equal(t, 256+spanDuration-1, futureSpans[0].Span.EndBlock)
+	require.Equal(t, uint64(3), futureSpans[1].Span.ID)
+	require.Equal(t, futureSpans[0].Span.EndBlock+1, futureSpans[1].Span.StartBlock)
+
+	// unchanged selection input keeps precomputed spans
+	keeper.UpdateFutureSpans(ctx.WithBlockHeight(11))
+	require.Equal(t, futureSpans, keeper.GetFutureSpans(ctx))
+
+	// proposed span uses precomputed producers
+	next := futureSpans[0].Span
+	require.Nil(t, keeper.FreezeSet(ctx, next.ID, next.StartBlock, next.EndBlock, borChainID, common.Hash{}))
+
+	span, err := keeper.GetSpan(ctx, next.ID)
+	require.Nil(t, err)
+	require.Equal(t, next.SelectedProducers, span.SelectedProducers)
+
+	// proposed span is removed and window moves forward
+	keeper.UpdateFutureSpans(ctx)
+	futureSpans = keeper.GetFutureSpans(ctx)
+	require.Len(t, futureSpans, 2)
+	require.Equal(t, uint64(3), futureSpans[0].Span.ID)
+	require.Equal(t, uint64(4), futureSpans[1].Span.ID)
+
+	// disabling removes precomputed spans
+	keeper.SetFutureSpanCount(ctx, 0)
+	keeper.UpdateFutureSpans(ctx)
+	require.Empty(t, keeper.GetFutureSpans(ctx))
+}
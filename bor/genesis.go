@@ -10,6 +10,7 @@ import (
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+	keeper.SetFutureSpanCount(ctx, data.FutureSpanCount)
 
 	if len(data.Spans) > 0 {
 		// sort data spans before inserting to ensure lastspanId fetched is correct
@@ -36,5 +37,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		params,
 		// TODO think better way to export all spans
 		allSpans,
+		keeper.GetFutureSpanCount(ctx),
 	)
 }
@@ -27,6 +27,8 @@ var (
 	SpanPrefixKey         = []byte{0x36} // prefix key to store span
 	SpanCacheKey          = []byte{0x37} // key to store Cache for span
 	LastProcessedEthBlock = []byte{0x38} // key to store last processed eth block for seed
+	FutureSpanPrefixKey   = []byte{0x39} // prefix key to store precomputed future span
+	ProducerOverrideKey   = []byte{0x3a} // prefix key to store producer set override by governance
 )
 
 // Keeper stores all related data
@@ -43,6 +45,15 @@ type Keeper struct {
 	contractCaller helper.ContractCaller
 	// chain manager keeper
 	chainKeeper chainmanager.Keeper
+	// checkpoint reader, set once checkpoint keeper is created
+	checkpointReader CheckpointReader
+}
+
+// CheckpointReader reads acked checkpoints of root chains. Checkpoint keeper is created after
+// bor keeper, so it is set after both are created.
+type CheckpointReader interface {
+	GetCheckpointByNumber(ctx sdk.Context, number uint64, rootChain string) (hmTypes.Checkpoint, error)
+	GetCheckpointByBorBlock(ctx sdk.Context, blockNumber uint64, rootChain string) (uint64, hmTypes.Checkpoint, error)
 }
 
 // NewKeeper create new keeper
@@ -68,6 +79,11 @@ func NewKeeper(
 	return keeper
 }
 
+// SetCheckpointReader sets reader of acked checkpoints
+func (k *Keeper) SetCheckpointReader(reader CheckpointReader) {
+	k.checkpointReader = reader
+}
+
 // Codespace returns the codespace
 func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
@@ -193,10 +209,17 @@ func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
 
 // FreezeSet freezes validator set for next span
 func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlock uint64, borChainID string, seed common.Hash) error {
-	// select next producers
-	newProducers, err := k.SelectNextProducers(ctx, seed)
-	if err != nil {
-		return err
+	// select next producers, governance override takes precedence and precomputed ones
+	// are used if selection input didn't change
+	newProducers, ok := k.ApplyProducerOverride(ctx, id)
+	if !ok {
+		newProducers, ok = k.GetFutureSpanProducers(ctx, id, startBlock, endBlock, borChainID)
+	}
+	if !ok {
+		var err error
+		if newProducers, err = k.SelectNextProducers(ctx, seed); err != nil {
+			return err
+		}
 	}
 
 	// increment last eth block
@@ -0,0 +1,126 @@
+package bor
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetProducerOverrideKey appends prefix to span id
+func GetProducerOverrideKey(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return append(append([]byte{}, ProducerOverrideKey...), b...)
+}
+
+// SetProducerOverrideDelay sets number of heimdall blocks override waits before it's activated
+func (k *Keeper) SetProducerOverrideDelay(ctx sdk.Context, delay uint64) {
+	k.paramSpace.Set(ctx, types.KeyProducerOverrideDelay, delay)
+}
+
+// GetProducerOverrideDelay gets number of heimdall blocks override waits before it's activated, default delay if it was never set
+func (k *Keeper) GetProducerOverrideDelay(ctx sdk.Context) uint64 {
+	var delay uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyProducerOverrideDelay, &delay)
+	if delay == 0 {
+		return types.DefaultProducerOverrideDelay
+	}
+
+	return delay
+}
+
+// SetProducerOverride stores producer set override of span
+func (k *Keeper) SetProducerOverride(ctx sdk.Context, override types.ProducerOverride) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetProducerOverrideKey(override.SpanID), k.cdc.MustMarshalBinaryBare(override))
+}
+
+// GetProducerOverride returns producer set override of span
+func (k *Keeper) GetProducerOverride(ctx sdk.Context, id uint64) (override types.ProducerOverride, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetProducerOverrideKey(id))
+	if bz == nil {
+		return override, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &override)
+	return override, true
+}
+
+// GetProducerOverrides returns pending producer set overrides ordered by span id
+func (k *Keeper) GetProducerOverrides(ctx sdk.Context) (overrides []types.ProducerOverride) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ProducerOverrideKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var override types.ProducerOverride
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &override)
+		overrides = append(overrides, override)
+	}
+
+	return overrides
+}
+
+// ApplyProducerOverride returns producers set by governance for span being proposed.
+// Override is consumed, and it's dropped if it's not active yet or any of its producers
+// is no longer span eligible, in which case producers are selected as usual.
+func (k *Keeper) ApplyProducerOverride(ctx sdk.Context, id uint64) ([]hmTypes.Validator, bool) {
+	override, ok := k.GetProducerOverride(ctx, id)
+	if !ok {
+		return nil, false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetProducerOverrideKey(id))
+
+	if ctx.BlockHeight() < override.ActivationHeight {
+		k.Logger(ctx).Error("Producer set override of span is not active yet, dropping it",
+			"span", id, "activationHeight", override.ActivationHeight)
+		return nil, false
+	}
+
+	eligible := make(map[hmTypes.ValidatorID]hmTypes.Validator)
+	for _, validator := range k.sk.GetSpanEligibleValidators(ctx) {
+		eligible[validator.ID] = validator
+	}
+
+	producers := make([]hmTypes.Validator, 0, len(override.ProducerIDs))
+	for _, producerID := range override.ProducerIDs {
+		validator, ok := eligible[producerID]
+		if !ok {
+			k.Logger(ctx).Error("Producer of override is not span eligible, dropping override",
+				"span", id, "producer", producerID)
+			return nil, false
+		}
+
+		producers = append(producers, validator)
+	}
+
+	k.Logger(ctx).Info("✅ Producer set override activated", "span", id, "producers", len(producers))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProducerSetOverrideActivated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyProducerIDs, producerIDsString(override.ProducerIDs)),
+		),
+	})
+
+	return producers, true
+}
+
+func producerIDsString(ids []hmTypes.ValidatorID) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = id.String()
+	}
+
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,68 @@
+package bor
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+)
+
+// NewProducerSetOverrideProposalHandler new producer set override proposal handler
+func NewProducerSetOverrideProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.ProducerSetOverrideProposal:
+			return handleProducerSetOverrideProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized bor proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+// handleProducerSetOverrideProposal schedules producer set override of future span.
+// Override is activated after delay, so bor nodes can prepare for new producers.
+func handleProducerSetOverrideProposal(ctx sdk.Context, k Keeper, p types.ProducerSetOverrideProposal) sdk.Error {
+	// only spans which are not proposed yet can be overridden
+	if lastSpan, err := k.GetLastSpan(ctx); err == nil && p.SpanID <= lastSpan.ID {
+		k.Logger(ctx).Error("Span is already proposed", "span", p.SpanID, "lastSpan", lastSpan.ID)
+		return hmCommon.ErrSpanNotInCountinuity(k.Codespace())
+	}
+
+	for _, producerID := range p.ProducerIDs {
+		if _, ok := k.sk.GetValidatorFromValID(ctx, producerID); !ok {
+			k.Logger(ctx).Error("Producer of override is not a validator", "producer", producerID)
+			return hmCommon.ErrNoValidator(k.Codespace())
+		}
+	}
+
+	override := types.ProducerOverride{
+		SpanID:           p.SpanID,
+		ProducerIDs:      p.ProducerIDs,
+		ActivationHeight: ctx.BlockHeight() + int64(k.GetProducerOverrideDelay(ctx)),
+	}
+	k.SetProducerOverride(ctx, override)
+
+	k.Logger(ctx).Info("✅ Producer set override scheduled by governance",
+		"span", override.SpanID,
+		"producers", len(override.ProducerIDs),
+		"activationHeight", override.ActivationHeight,
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProducerSetOverride,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(override.SpanID, 10)),
+			sdk.NewAttribute(types.AttributeKeyProducerIDs, producerIDsString(override.ProducerIDs)),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatInt(override.ActivationHeight, 10)),
+		),
+	})
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package bor_test
+
+import (
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bor"
+	"github.com/maticnetwork/heimdall/bor/types"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestProducerSetOverrideProposal(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+	keeper := happ.BorKeeper
+	handler := bor.NewProducerSetOverrideProposalHandler(keeper)
+
+	chSim.LoadValidatorSet(4, t, happ.StakingKeeper, ctx, false, 10)
+
+	borChainID := happ.ChainKeeper.GetParams(ctx).ChainParams.BorChainID
+	validatorSet := happ.StakingKeeper.GetValidatorSet(ctx)
+	lastSpan := hmTypes.NewSpan(1, 0, 255, validatorSet, happ.StakingKeeper.GetSpanEligibleValidators(ctx), borChainID)
+	require.Nil(t, keeper.AddNewSpan(ctx, lastSpan))
+
+	producer := validatorSet.Validators[0]
+	producerIDs := []hmTypes.ValidatorID{producer.ID}
+
+	// proposed span can't be overridden
+	err := handler(ctx, types.NewProducerSetOverrideProposal("title", "description", 1, producerIDs))
+	require.NotNil(t, err)
+
+	// unknown producer
+	err = handler(ctx, types.NewProducerSetOverrideProposal("title", "description", 2, []hmTypes.ValidatorID{hmTypes.NewValidatorID(1000)}))
+	require.NotNil(t, err)
+
+	keeper.SetProducerOverrideDelay(ctx, 5)
+	require.Nil(t, handler(ctx, types.NewProducerSetOverrideProposal("title", "description", 2, producerIDs)))
+
+	override, ok := keeper.GetProducerOverride(ctx, 2)
+	require.True(t, ok)
+	require.Equal(t, int64(15), override.ActivationHeight)
+	require.Equal(t, producerIDs, override.ProducerIDs)
+
+	// override is applied once it's active and consumed by span
+	ctx = ctx.WithBlockHeight(15)
+	require.Nil(t, keeper.FreezeSet(ctx, 2, 256, 511, borChainID, common.Hash{}))
+
+	span, err2 := keeper.GetSpan(ctx, 2)
+	require.Nil(t, err2)
+	require.Len(t, span.SelectedProducers, 1)
+	require.Equal(t, producer.ID, span.SelectedProducers[0].ID)
+	require.Empty(t, keeper.GetProducerOverrides(ctx))
+
+	// inactive override is dropped and producers are selected as usual
+	require.Nil(t, handler(ctx, types.NewProducerSetOverrideProposal("title", "description", 3, producerIDs)))
+	require.Nil(t, keeper.FreezeSet(ctx, 3, 512, 767, borChainID, common.Hash{}))
+
+	span, err2 = keeper.GetSpan(ctx, 3)
+	require.Nil(t, err2)
+	require.Equal(t, happ.StakingKeeper.GetSpanEligibleValidators(ctx), span.SelectedProducers)
+	require.Empty(t, keeper.GetProducerOverrides(ctx))
+}
@@ -30,6 +30,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryNextProducers(ctx, req, keeper)
 		case types.QueryNextSpanSeed:
 			return handlerQueryNextSpanSeed(ctx, req, keeper)
+		case types.QueryFutureSpans:
+			return handleQueryFutureSpans(ctx, req, keeper)
+		case types.QuerySpanCheckpoints:
+			return handleQuerySpanCheckpoints(ctx, req, keeper)
+		case types.QueryCheckpointSpans:
+			return handleQueryCheckpointSpans(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -181,3 +187,64 @@ func handlerQueryNextSpanSeed(ctx sdk.Context, req abci.RequestQuery, keeper Kee
 	}
 	return bz, nil
 }
+
+func handleQueryFutureSpans(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	futureSpans := keeper.GetFutureSpans(ctx)
+	if futureSpans == nil {
+		futureSpans = []types.FutureSpan{}
+	}
+
+	bz, err := json.Marshal(futureSpans)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySpanCheckpoints(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySpanCheckpointsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain != "" && hmTypes.GetRootChainID(params.RootChain) == 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid root chain %v", params.RootChain))
+	}
+
+	res, err := keeper.GetSpanCheckpoints(ctx, params.SpanID, params.RootChain)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoints of span %v", params.SpanID), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointSpans(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointSpansParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	if hmTypes.GetRootChainID(params.RootChain) == 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid root chain %v", params.RootChain))
+	}
+
+	res, err := keeper.GetCheckpointSpans(ctx, params.Number, params.RootChain)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch spans of checkpoint %v %v", params.Number, params.RootChain), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,106 @@
+package bor
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// rootChainsOrAll returns given root chain, or all root chains sorted by name if it's empty
+func rootChainsOrAll(rootChain string) []string {
+	if rootChain != "" {
+		return []string{rootChain}
+	}
+
+	return chainmanagerTypes.SortedRootChains()
+}
+
+// GetSpanCheckpoints returns acked checkpoints of root chains covering block range of span. Checkpoints of
+// a root chain are continuous, so they are walked from the one covering start block of span until the
+// span is covered or next block isn't checkpointed yet.
+func (k *Keeper) GetSpanCheckpoints(ctx sdk.Context, spanID uint64, rootChain string) (types.SpanCheckpoints, error) {
+	if k.checkpointReader == nil {
+		return types.SpanCheckpoints{}, errors.New("checkpoint reader is not set")
+	}
+
+	span, err := k.GetSpan(ctx, spanID)
+	if err != nil {
+		return types.SpanCheckpoints{}, err
+	}
+
+	result := types.SpanCheckpoints{
+		SpanID:     span.ID,
+		StartBlock: span.StartBlock,
+		EndBlock:   span.EndBlock,
+		RootChains: []types.RootChainSpanCheckpoints{},
+	}
+
+	for _, rootChain := range rootChainsOrAll(rootChain) {
+		rootChainCheckpoints := types.RootChainSpanCheckpoints{
+			RootChain:   rootChain,
+			Checkpoints: []types.CheckpointRef{},
+		}
+
+		for block := span.StartBlock; block <= span.EndBlock; {
+			number, checkpoint, err := k.checkpointReader.GetCheckpointByBorBlock(ctx, block, rootChain)
+			if err != nil {
+				break
+			}
+
+			rootChainCheckpoints.Checkpoints = append(rootChainCheckpoints.Checkpoints, types.NewCheckpointRef(number, checkpoint))
+			if checkpoint.EndBlock >= span.EndBlock {
+				rootChainCheckpoints.CheckpointedUpTo = span.EndBlock
+				rootChainCheckpoints.FullyCheckpointed = true
+				break
+			}
+
+			rootChainCheckpoints.CheckpointedUpTo = checkpoint.EndBlock
+			block = checkpoint.EndBlock + 1
+		}
+
+		result.RootChains = append(result.RootChains, rootChainCheckpoints)
+	}
+
+	return result, nil
+}
+
+// GetCheckpointSpans returns spans covering block range of acked checkpoint of root chain, sorted by id
+func (k *Keeper) GetCheckpointSpans(ctx sdk.Context, number uint64, rootChain string) (types.CheckpointSpans, error) {
+	if k.checkpointReader == nil {
+		return types.CheckpointSpans{}, errors.New("checkpoint reader is not set")
+	}
+
+	checkpoint, err := k.checkpointReader.GetCheckpointByNumber(ctx, number, rootChain)
+	if err != nil {
+		return types.CheckpointSpans{}, fmt.Errorf("checkpoint %v of %s not found", number, rootChain)
+	}
+
+	result := types.CheckpointSpans{
+		RootChain:  rootChain,
+		Checkpoint: types.NewCheckpointRef(number, checkpoint),
+		Spans:      []types.SpanRef{},
+	}
+
+	k.IterateSpansAndApplyFn(ctx, func(span hmTypes.Span) error {
+		if span.StartBlock <= checkpoint.EndBlock && span.EndBlock >= checkpoint.StartBlock {
+			result.Spans = append(result.Spans, types.SpanRef{
+				ID:         span.ID,
+				StartBlock: span.StartBlock,
+				EndBlock:   span.EndBlock,
+			})
+		}
+		return nil
+	})
+
+	sort.Slice(result.Spans, func(i, j int) bool {
+		return result.Spans[i].ID < result.Spans[j].ID
+	})
+
+	return result, nil
+}
@@ -0,0 +1,88 @@
+package bor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestSpanCheckpointCorrelation(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+	keeper := happ.BorKeeper
+
+	chSim.LoadValidatorSet(4, t, happ.StakingKeeper, ctx, false, 10)
+
+	borChainID := happ.ChainKeeper.GetParams(ctx).ChainParams.BorChainID
+	validatorSet := happ.StakingKeeper.GetValidatorSet(ctx)
+	producers := happ.StakingKeeper.GetSpanEligibleValidators(ctx)
+
+	// spans [0, 99], [100, 199], [200, 299]
+	for id := uint64(0); id < 3; id++ {
+		span := hmTypes.NewSpan(id, id*100, id*100+99, validatorSet, producers, borChainID)
+		require.Nil(t, keeper.AddNewSpan(ctx, span))
+	}
+
+	// eth checkpoints [0, 149], [150, 249], tron checkpoint [0, 99]
+	proposer := validatorSet.Proposer.Signer
+	ethCheckpoints := []hmTypes.Checkpoint{
+		hmTypes.CreateBlock(0, 149, hmTypes.BytesToHeimdallHash([]byte{1}), proposer, borChainID, 1),
+		hmTypes.CreateBlock(150, 249, hmTypes.BytesToHeimdallHash([]byte{2}), proposer, borChainID, 2),
+	}
+	for i, checkpoint := range ethCheckpoints {
+		require.Nil(t, happ.CheckpointKeeper.AddCheckpoint(ctx, uint64(i+1), checkpoint, hmTypes.RootChainTypeEth))
+	}
+	tronCheckpoint := hmTypes.CreateBlock(0, 99, hmTypes.BytesToHeimdallHash([]byte{3}), proposer, borChainID, 1)
+	require.Nil(t, happ.CheckpointKeeper.AddCheckpoint(ctx, 1, tronCheckpoint, hmTypes.RootChainTypeTron))
+
+	spanCheckpoints, err := keeper.GetSpanCheckpoints(ctx, 1, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), spanCheckpoints.StartBlock)
+	require.Len(t, spanCheckpoints.RootChains, len(hmTypes.GetRootChainIDMap()))
+
+	for _, rootChain := range spanCheckpoints.RootChains {
+		switch rootChain.RootChain {
+		case hmTypes.RootChainTypeEth:
+			require.Len(t, rootChain.Checkpoints, 2)
+			require.Equal(t, uint64(1), rootChain.Checkpoints[0].Number)
+			require.Equal(t, uint64(2), rootChain.Checkpoints[1].Number)
+			require.Equal(t, uint64(199), rootChain.CheckpointedUpTo)
+			require.True(t, rootChain.FullyCheckpointed)
+		default:
+			require.Empty(t, rootChain.Checkpoints, rootChain.RootChain)
+			require.False(t, rootChain.FullyCheckpointed, rootChain.RootChain)
+		}
+	}
+
+	// span 2 is only partly checkpointed on eth
+	spanCheckpoints, err = keeper.GetSpanCheckpoints(ctx, 2, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Len(t, spanCheckpoints.RootChains, 1)
+	require.Len(t, spanCheckpoints.RootChains[0].Checkpoints, 1)
+	require.Equal(t, uint64(249), spanCheckpoints.RootChains[0].CheckpointedUpTo)
+	require.False(t, spanCheckpoints.RootChains[0].FullyCheckpointed)
+
+	_, err = keeper.GetSpanCheckpoints(ctx, 5, "")
+	require.Error(t, err)
+
+	// vice versa
+	checkpointSpans, err := keeper.GetCheckpointSpans(ctx, 2, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), checkpointSpans.Checkpoint.StartBlock)
+	require.Len(t, checkpointSpans.Spans, 2)
+	require.Equal(t, uint64(1), checkpointSpans.Spans[0].ID)
+	require.Equal(t, uint64(2), checkpointSpans.Spans[1].ID)
+
+	checkpointSpans, err = keeper.GetCheckpointSpans(ctx, 1, hmTypes.RootChainTypeTron)
+	require.NoError(t, err)
+	require.Len(t, checkpointSpans.Spans, 1)
+	require.Equal(t, uint64(0), checkpointSpans.Spans[0].ID)
+
+	_, err = keeper.GetCheckpointSpans(ctx, 3, hmTypes.RootChainTypeEth)
+	require.Error(t, err)
+}
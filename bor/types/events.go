@@ -2,12 +2,16 @@ package types
 
 // staking module event types
 const (
-	EventTypeProposeSpan = "propose-span"
+	EventTypeProposeSpan                  = "propose-span"
+	EventTypeProducerSetOverride          = "producer-set-override"
+	EventTypeProducerSetOverrideActivated = "producer-set-override-activated"
 
-	AttributeKeySuccess        = "success"
-	AttributeKeySpanID         = "span-id"
-	AttributeKeySpanStartBlock = "start-block"
-	AttributeKeySpanEndBlock   = "end-block"
+	AttributeKeySuccess          = "success"
+	AttributeKeySpanID           = "span-id"
+	AttributeKeySpanStartBlock   = "start-block"
+	AttributeKeySpanEndBlock     = "end-block"
+	AttributeKeyProducerIDs      = "producer-ids"
+	AttributeKeyActivationHeight = "activation-height"
 
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// KeyFutureSpanCount param key of number of spans precomputed ahead of last span.
+// Precomputation is disabled while it's not set.
+var KeyFutureSpanCount = []byte("FutureSpanCount")
+
+// FutureSpan span precomputed in end block ahead of its proposal. Producers of future
+// span are used when span is proposed as long as selection input didn't change.
+type FutureSpan struct {
+	Span          hmTypes.Span         `json:"span" yaml:"span"`
+	SelectionHash hmTypes.HeimdallHash `json:"selection_hash" yaml:"selection_hash"` // hash of eligible validators and producer count
+	ComputedAt    int64                `json:"computed_at" yaml:"computed_at"`
+}
+
+// String returns human readable string
+func (fs FutureSpan) String() string {
+	return fmt.Sprintf(
+		"FutureSpan {%v %v %v %v %v}",
+		fs.Span.ID,
+		fs.Span.StartBlock,
+		fs.Span.EndBlock,
+		fs.SelectionHash.Hex(),
+		fs.ComputedAt,
+	)
+}
@@ -10,21 +10,23 @@ import (
 
 // GenesisState is the bor state that must be provided at genesis.
 type GenesisState struct {
-	Params Params          `json:"params" yaml:"params"`
-	Spans  []*hmTypes.Span `json:"spans" yaml:"spans"` // list of spans
+	Params          Params          `json:"params" yaml:"params"`
+	Spans           []*hmTypes.Span `json:"spans" yaml:"spans"`                         // list of spans
+	FutureSpanCount uint64          `json:"future_span_count" yaml:"future_span_count"` // number of spans precomputed ahead
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, spans []*hmTypes.Span) GenesisState {
+func NewGenesisState(params Params, spans []*hmTypes.Span, futureSpanCount uint64) GenesisState {
 	return GenesisState{
-		Params: params,
-		Spans:  spans,
+		Params:          params,
+		Spans:           spans,
+		FutureSpanCount: futureSpanCount,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams(), nil)
+	return NewGenesisState(DefaultParams(), nil, 0)
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
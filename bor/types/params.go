@@ -97,7 +97,7 @@ func (p Params) Validate() error {
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().RegisterParamSet(&Params{}).RegisterType(KeyFutureSpanCount, uint64(0)).RegisterType(KeyProducerOverrideDelay, uint64(0))
 }
 
 // DefaultParams returns a default set of parameters.
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// ProposalTypeProducerSetOverride defines the type for a ProducerSetOverrideProposal
+	ProposalTypeProducerSetOverride = "ProducerSetOverride"
+
+	// DefaultProducerOverrideDelay number of heimdall blocks between proposal execution and override activation
+	DefaultProducerOverrideDelay uint64 = 100
+)
+
+// KeyProducerOverrideDelay param key of number of heimdall blocks override waits before it's activated.
+// Default delay is used while it's not set.
+var KeyProducerOverrideDelay = []byte("ProducerOverrideDelay")
+
+// Assert ProducerSetOverrideProposal implements govTypes.Content at compile-time
+var _ govTypes.Content = ProducerSetOverrideProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeProducerSetOverride)
+	govTypes.RegisterProposalTypeCodec(ProducerSetOverrideProposal{}, "bor/ProducerSetOverrideProposal")
+}
+
+// ProducerSetOverrideProposal emergency governance proposal which replaces producers
+// of future span, eg. when selected producers are offline.
+type ProducerSetOverrideProposal struct {
+	Title       string                `json:"title" yaml:"title"`
+	Description string                `json:"description" yaml:"description"`
+	SpanID      uint64                `json:"span_id" yaml:"span_id"`
+	ProducerIDs []hmTypes.ValidatorID `json:"producer_ids" yaml:"producer_ids"`
+}
+
+// NewProducerSetOverrideProposal creates new producer set override proposal
+func NewProducerSetOverrideProposal(title, description string, spanID uint64, producerIDs []hmTypes.ValidatorID) ProducerSetOverrideProposal {
+	return ProducerSetOverrideProposal{
+		Title:       title,
+		Description: description,
+		SpanID:      spanID,
+		ProducerIDs: producerIDs,
+	}
+}
+
+// GetTitle returns the title of producer set override proposal
+func (p ProducerSetOverrideProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of producer set override proposal
+func (p ProducerSetOverrideProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of producer set override proposal
+func (p ProducerSetOverrideProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of producer set override proposal
+func (p ProducerSetOverrideProposal) ProposalType() string { return ProposalTypeProducerSetOverride }
+
+// ValidateBasic validates producer set override proposal
+func (p ProducerSetOverrideProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(hmCommon.DefaultCodespace, p); err != nil {
+		return err
+	}
+
+	if p.SpanID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid span id %v", p.SpanID)
+	}
+
+	if len(p.ProducerIDs) == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Producer set can't be empty")
+	}
+
+	seen := make(map[hmTypes.ValidatorID]bool, len(p.ProducerIDs))
+	for _, id := range p.ProducerIDs {
+		if seen[id] {
+			return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Duplicate producer %v", id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ProducerSetOverrideProposal) String() string {
+	ids := make([]string, len(p.ProducerIDs))
+	for i, id := range p.ProducerIDs {
+		ids[i] = id.String()
+	}
+
+	return fmt.Sprintf(`Producer Set Override Proposal:
+  Title:       %s
+  Description: %s
+  SpanID:      %d
+  ProducerIDs: %s
+`, p.Title, p.Description, p.SpanID, strings.Join(ids, ","))
+}
+
+// ProducerOverride producer set which replaces selected producers of span, once
+// governance executes override it's applied to span proposed after activation height
+type ProducerOverride struct {
+	SpanID           uint64                `json:"span_id" yaml:"span_id"`
+	ProducerIDs      []hmTypes.ValidatorID `json:"producer_ids" yaml:"producer_ids"`
+	ActivationHeight int64                 `json:"activation_height" yaml:"activation_height"`
+}
+
+// String returns human readable string
+func (o ProducerOverride) String() string {
+	return fmt.Sprintf("ProducerOverride {%v %v %v}", o.SpanID, o.ProducerIDs, o.ActivationHeight)
+}
@@ -9,6 +9,10 @@ const (
 	QueryNextSpan      = "next-span"
 	QueryNextProducers = "next-producers"
 	QueryNextSpanSeed  = "next-span-seed"
+	QueryFutureSpans   = "future-spans"
+
+	QuerySpanCheckpoints = "span-checkpoints"
+	QueryCheckpointSpans = "checkpoint-spans"
 
 	ParamSpan          = "span"
 	ParamSprint        = "sprint"
@@ -25,3 +29,26 @@ type QuerySpanParams struct {
 func NewQuerySpanParams(recordID uint64) QuerySpanParams {
 	return QuerySpanParams{RecordID: recordID}
 }
+
+// QuerySpanCheckpointsParams defines the params for querying checkpoints covering span, empty root chain
+// queries all root chains.
+type QuerySpanCheckpointsParams struct {
+	SpanID    uint64
+	RootChain string
+}
+
+// NewQuerySpanCheckpointsParams creates a new instance of QuerySpanCheckpointsParams.
+func NewQuerySpanCheckpointsParams(spanID uint64, rootChain string) QuerySpanCheckpointsParams {
+	return QuerySpanCheckpointsParams{SpanID: spanID, RootChain: rootChain}
+}
+
+// QueryCheckpointSpansParams defines the params for querying spans covering checkpoint of root chain.
+type QueryCheckpointSpansParams struct {
+	Number    uint64
+	RootChain string
+}
+
+// NewQueryCheckpointSpansParams creates a new instance of QueryCheckpointSpansParams.
+func NewQueryCheckpointSpansParams(number uint64, rootChain string) QueryCheckpointSpansParams {
+	return QueryCheckpointSpansParams{Number: number, RootChain: rootChain}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointRef acked checkpoint of root chain covering bor blocks
+type CheckpointRef struct {
+	Number     uint64                  `json:"number"`
+	StartBlock uint64                  `json:"start_block"`
+	EndBlock   uint64                  `json:"end_block"`
+	RootHash   hmTypes.HeimdallHash    `json:"root_hash"`
+	Proposer   hmTypes.HeimdallAddress `json:"proposer"`
+}
+
+// NewCheckpointRef creates reference to acked checkpoint
+func NewCheckpointRef(number uint64, checkpoint hmTypes.Checkpoint) CheckpointRef {
+	return CheckpointRef{
+		Number:     number,
+		StartBlock: checkpoint.StartBlock,
+		EndBlock:   checkpoint.EndBlock,
+		RootHash:   checkpoint.RootHash,
+		Proposer:   checkpoint.Proposer,
+	}
+}
+
+// RootChainSpanCheckpoints acked checkpoints of root chain covering block range of span. CheckpointedUpTo is
+// last block of span covered by continuous checkpoints from span start, zero if its start block isn't covered.
+type RootChainSpanCheckpoints struct {
+	RootChain         string          `json:"root_chain"`
+	Checkpoints       []CheckpointRef `json:"checkpoints"`
+	CheckpointedUpTo  uint64          `json:"checkpointed_up_to"`
+	FullyCheckpointed bool            `json:"fully_checkpointed"`
+}
+
+// SpanCheckpoints checkpoints of root chains covering block range of span
+type SpanCheckpoints struct {
+	SpanID     uint64                     `json:"span_id"`
+	StartBlock uint64                     `json:"start_block"`
+	EndBlock   uint64                     `json:"end_block"`
+	RootChains []RootChainSpanCheckpoints `json:"root_chains"`
+}
+
+// SpanRef span covering bor blocks
+type SpanRef struct {
+	ID         uint64 `json:"span_id"`
+	StartBlock uint64 `json:"start_block"`
+	EndBlock   uint64 `json:"end_block"`
+}
+
+// CheckpointSpans spans covering block range of acked checkpoint of root chain
+type CheckpointSpans struct {
+	RootChain  string        `json:"root_chain"`
+	Checkpoint CheckpointRef `json:"checkpoint"`
+	Spans      []SpanRef     `json:"spans"`
+}
@@ -4,7 +4,6 @@ import (
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/spf13/cobra"
-	"github.com/streadway/amqp"
 )
 
 // purgeCmd represents the reset of queue
@@ -19,21 +18,7 @@ var purgeCmd = &cobra.Command{
 
 func purgeQueue() {
 	var logger = helper.Logger.With("module", "bridge/cmd/")
-	dialer := helper.GetConfig().AmqpURL
-
-	// amqp dialer
-	conn, err := amqp.Dial(dialer)
-	if err != nil {
-		panic(err)
-	}
-
-	// initialize exchange
-	channel, err := conn.Channel()
-	if err != nil {
-		panic(err)
-	}
-
-	if _, err := channel.QueuePurge(queue.QueueName, false); err != nil {
+	if _, err := queue.PurgeQueue(helper.GetConfig().AmqpURL); err != nil {
 		logger.Error("purgeQueue | QueuePurge", "Error", err)
 	}
 }
@@ -19,8 +19,10 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
 	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/monitor"
 	"github.com/maticnetwork/heimdall/bridge/setu/processor"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 )
@@ -52,6 +54,8 @@ func GetStartCmd() *cobra.Command {
 			services = append(services,
 				listener.NewListenerService(cdc, _queueConnector, _httpClient),
 				processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster),
+				monitor.NewCheckpointMonitor(cdc),
+				scheduler.GetScheduler(),
 			)
 
 			// sync group
@@ -79,6 +83,11 @@ func GetStartCmd() *cobra.Command {
 					// stop db instance
 					util.CloseBridgeDBInstance()
 
+					// release duty locks
+					if err := util.GetDutyLock().Close(); err != nil {
+						logger.Error("GetStartCmd | DutyLock.Close", "Error", err)
+					}
+
 					// exit
 					os.Exit(1)
 				}
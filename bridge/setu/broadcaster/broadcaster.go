@@ -2,8 +2,8 @@ package broadcaster
 
 import (
 	"context"
-	"fmt"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
@@ -11,25 +11,28 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bor "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/core/types"
-	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
-
-	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
+const (
+	// weightCappedWaitTimeout time broadcasted tx is watched for inclusion
+	weightCappedWaitTimeout = 2 * time.Minute
+
+	// maxWeightCappedResubmits max number of re-broadcasts of msg which exceeded block msg weight
+	maxWeightCappedResubmits = 5
+)
+
 // TxBroadcaster uses to broadcast transaction to each chain
 type TxBroadcaster struct {
 	logger log.Logger
 
 	cliCtx cliContext.CLIContext
 
-	heimdallMutex sync.Mutex
-	maticMutex    sync.Mutex
+	maticMutex sync.Mutex
 
-	lastSeqNo uint64
-	accNum    uint64
+	heimdallBroadcaster *helper.RPCBroadcaster
 }
 
 // NewTxBroadcaster creates new broadcaster
@@ -38,19 +41,20 @@ func NewTxBroadcaster(cdc *codec.Codec) *TxBroadcaster {
 	cliCtx.BroadcastMode = client.BroadcastSync
 	cliCtx.TrustNode = true
 
-	// current address
-	address := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
-	account, err := util.GetAccount(cliCtx, address)
+	// broadcast directly to tendermint rpc
+	heimdallBroadcaster, err := helper.NewRPCBroadcaster(cliCtx, client.BroadcastSync)
 	if err != nil {
-		panic("Error connecting to rest-server, please start server before bridge.")
+		panic(err)
+	}
 
+	if err := heimdallBroadcaster.RefreshSequence(); err != nil {
+		panic("Error connecting to heimdall node, please start node before bridge.")
 	}
 
 	txBroadcaster := TxBroadcaster{
-		logger:    util.Logger().With("module", "txBroadcaster"),
-		cliCtx:    cliCtx,
-		lastSeqNo: account.GetSequence(),
-		accNum:    account.GetAccountNumber(),
+		logger:              util.Logger().With("module", "txBroadcaster"),
+		cliCtx:              cliCtx,
+		heimdallBroadcaster: heimdallBroadcaster,
 	}
 
 	return &txBroadcaster
@@ -58,46 +62,47 @@ func NewTxBroadcaster(cdc *codec.Codec) *TxBroadcaster {
 
 // BroadcastToHeimdall broadcast to heimdall
 func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
-	tb.heimdallMutex.Lock()
-	defer tb.heimdallMutex.Unlock()
-
-	// tx encoder
-	txEncoder := helper.GetTxEncoder(tb.cliCtx.Codec)
-	// chain id
-	chainID := helper.GetGenesisDoc().ChainID
-
-	// get account number and sequence
-	txBldr := authTypes.NewTxBuilderFromCLI().
-		WithTxEncoder(txEncoder).
-		WithAccountNumber(tb.accNum).
-		WithSequence(tb.lastSeqNo).
-		WithChainID(chainID)
-
-	txResponse, err := helper.BuildAndBroadcastMsgs(tb.cliCtx, txBldr, []sdk.Msg{msg})
-	tb.logger.Info("Tx sent on heimdall", "txHash", txResponse.TxHash, "accSeq", tb.lastSeqNo, "accNum", tb.accNum)
-	if err != nil || txResponse.Code != 0 {
+	return tb.broadcastToHeimdall(msg, 0)
+}
+
+func (tb *TxBroadcaster) broadcastToHeimdall(msg sdk.Msg, attempt int) error {
+	// sequence mismatches are retried with fresh sequence by broadcaster
+	txResponse, err := tb.heimdallBroadcaster.BroadcastMsgs([]sdk.Msg{msg})
+	tb.logger.Info("Tx sent on heimdall", "txHash", txResponse.TxHash, "accSeq", tb.heimdallBroadcaster.Sequence())
+	if err != nil {
 		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err, "txResponse", txResponse)
+		return err
+	}
 
-		// current address
-		address := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
+	tb.logger.Debug("Tx successful on heimdall", "txResponse", txResponse)
 
-		// fetch from APIs
-		account, errAcc := util.GetAccount(tb.cliCtx, address)
-		if errAcc != nil {
-			tb.logger.Error("Error fetching account from rest-api", "url", helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.AccountDetailsURL, helper.GetAddress())))
-			return errAcc
-		}
+	go tb.resubmitWeightCapped(msg, txResponse.TxHash, attempt)
+	return nil
+}
 
-		// update seqNo for safety
-		tb.lastSeqNo = account.GetSequence()
+// resubmitWeightCapped waits for tx to be included in block and broadcasts msg again if tx failed as msg
+// weight of block was used up. Tendermint drops delivered txs from mempool whatever their result, msg
+// would be lost otherwise.
+func (tb *TxBroadcaster) resubmitWeightCapped(msg sdk.Msg, txHash string, attempt int) {
+	txResponse, err := tb.heimdallBroadcaster.WaitForTx(txHash, weightCappedWaitTimeout)
+	if err != nil {
+		tb.logger.Debug("Unable to fetch result of heimdall transaction", "txHash", txHash, "error", err)
+		return
+	}
 
-		return err
+	if helper.ClassifyTxResponse(txResponse) != helper.TxRejectionMsgWeightExceeded {
+		return
 	}
 
-	tb.logger.Debug("Tx successful on heimdall", "txResponse", txResponse)
-	// increment account sequence
-	tb.lastSeqNo += 1
-	return nil
+	if attempt >= maxWeightCappedResubmits {
+		tb.logger.Error("Heimdall transaction exceeded block msg weight too often, dropping it", "txHash", txHash, "msgType", msg.Type(), "attempts", attempt+1)
+		return
+	}
+
+	tb.logger.Info("Heimdall transaction exceeded block msg weight, re-broadcasting", "txHash", txHash, "msgType", msg.Type(), "attempt", attempt+1)
+	if err := tb.broadcastToHeimdall(msg, attempt+1); err != nil {
+		tb.logger.Error("Unable to re-broadcast heimdall transaction", "msgType", msg.Type(), "error", err)
+	}
 }
 
 // BroadcastToMatic broadcast to matic
@@ -16,6 +16,7 @@ import (
 	"github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 
@@ -155,28 +156,24 @@ func (bl *BaseListener) StartHeaderProcess(ctx context.Context) {
 	}
 }
 
-// startPolling starts polling
+// StartPolling polls latest header with scheduler until ctx is done
 func (bl *BaseListener) StartPolling(ctx context.Context, pollInterval time.Duration) {
-	// How often to fire the passed in function in second
-	interval := pollInterval
-
-	// Setup the ticket and the channel to signal
-	// the ending of the interval
-	ticker := time.NewTicker(interval)
+	scheduler.GetScheduler().Run(ctx, scheduler.Task{
+		Name:     bl.name,
+		Interval: pollInterval,
+		Run:      bl.pollHeader,
+	})
+	bl.Logger.Info("Polling stopped")
+}
 
-	// start listening
-	for {
+// pollHeader sends latest header of chain to header channel
+func (bl *BaseListener) pollHeader(ctx context.Context) {
+	header, err := bl.chainClient.HeaderByNumber(ctx, nil)
+	if err == nil && header != nil {
+		// send data to channel
 		select {
-		case <-ticker.C:
-			header, err := bl.chainClient.HeaderByNumber(ctx, nil)
-			if err == nil && header != nil {
-				// send data to channel
-				bl.HeaderChannel <- header
-			}
+		case bl.HeaderChannel <- header:
 		case <-ctx.Done():
-			bl.Logger.Info("Polling stopped")
-			ticker.Stop()
-			return
 		}
 	}
 }
@@ -3,16 +3,17 @@ package listener
 import (
 	"context"
 	"encoding/json"
-	"strconv"
 	"time"
 
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
 	"github.com/maticnetwork/heimdall/helper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
@@ -25,6 +26,9 @@ const (
 // HeimdallListener - Listens to and process events from heimdall
 type HeimdallListener struct {
 	BaseListener
+
+	// subscriber of heimdall blocks
+	subscriber *ResumableSubscriber
 }
 
 // NewHeimdallListener - constructor func
@@ -46,8 +50,21 @@ func (hl *HeimdallListener) Start() error {
 		pollInterval = helper.GetConfig().CheckpointerPollInterval
 	}
 
-	hl.Logger.Info("Start polling for events", "pollInterval", pollInterval)
-	hl.StartPolling(headerCtx, pollInterval)
+	// resumes from last block processed by polling
+	hl.subscriber = NewResumableSubscriber(
+		hl.String(),
+		hl.httpClient,
+		hl.storageClient,
+		hl.Logger,
+		func(height int64) ([]abci.Event, error) {
+			return helper.GetBeginBlockEvents(hl.httpClient, height)
+		},
+		hl.processBlockEvents,
+		pollInterval,
+	).WithLegacyTokenKey([]byte(heimdallLastBlockKey))
+
+	hl.Logger.Info("Start subscription for events", "catchUpInterval", pollInterval)
+	go hl.subscriber.Run(headerCtx)
 	return nil
 }
 
@@ -58,121 +75,26 @@ func (hl *HeimdallListener) ProcessHeader(*types.Header) {
 
 // StartPolling - starts polling for heimdall events
 func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.Duration) {
-	// How often to fire the passed in function in second
-	interval := pollInterval
-
-	// Setup the ticket and the channel to signal
-	// the ending of the interval
-	ticker := time.NewTicker(interval)
-
-	// var eventTypes []string
-	// eventTypes = append(eventTypes, "message.action='checkpoint'")
-	// eventTypes = append(eventTypes, "message.action='event-record'")
-	// eventTypes = append(eventTypes, "message.action='tick'")
-	// ADD EVENT TYPE for SLASH-LIMIT
-
-	// start listening
-	for {
-		select {
-		case <-ticker.C:
-			fromBlock, toBlock, err := hl.fetchFromAndToBlock()
-			if err != nil {
-				hl.Logger.Error("Error fetching fromBlock and toBlock...skipping events query", "error", err)
-			} else if fromBlock < toBlock {
-
-				hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
-
-				// Querying and processing Begin events
-				for i := fromBlock; i <= toBlock; i++ {
-					events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
-					if err != nil {
-						hl.Logger.Error("Error fetching begin block events", "error", err)
-					}
-					for _, event := range events {
-						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
-					}
-				}
-
-				// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
-				/* 		for _, eventType := range eventTypes {
-					var query []string
-					query = append(query, eventType)
-					query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
-					query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
-
-					limit := 50
-					for page := 1; page > 0; {
-						searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
-						hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
-
-						if err != nil {
-							hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
-							break
-						}
-
-						for _, tx := range searchResult.Txs {
-							for _, log := range tx.Logs {
-								event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
-									return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
-								})
-								if event != nil {
-									hl.ProcessEvent(*event, tx)
-								}
-							}
-						}
-
-						if len(searchResult.Txs) == limit {
-							page = page + 1
-						} else {
-							page = 0
-						}
-					}
-				} */
-				// set last block to storage
-				if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil); err != nil {
-					hl.Logger.Error("hl.storageClient.Put", "Error", err)
-				}
-			}
-
-		case <-ctx.Done():
-			hl.Logger.Info("Polling stopped")
-			ticker.Stop()
-			return
-		}
-	}
+	scheduler.GetScheduler().Run(ctx, scheduler.Task{
+		Name:     hl.String(),
+		Interval: pollInterval,
+		Run:      hl.pollEvents,
+	})
+	hl.Logger.Info("Polling stopped")
 }
 
-func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
-	// toBlock - get latest blockheight from heimdall node
-	fromBlock := uint64(0)
-	toBlock := uint64(0)
-
-	nodeStatus, err := helper.GetNodeStatus(hl.cliCtx)
-	if err != nil {
-		hl.Logger.Error("Error while fetching heimdall node status", "error", err)
-		return fromBlock, toBlock, err
+// pollEvents - processes events of heimdall blocks since last processed block
+func (hl *HeimdallListener) pollEvents(ctx context.Context) {
+	if err := hl.subscriber.CatchUp(); err != nil {
+		hl.Logger.Error("Error while processing new heimdall blocks", "lastHeight", hl.subscriber.LastHeight(), "error", err)
 	}
-	toBlock = uint64(nodeStatus.SyncInfo.LatestBlockHeight)
-
-	// fromBlock - get last block from storage
-	hasLastBlock, _ := hl.storageClient.Has([]byte(heimdallLastBlockKey), nil)
-	if hasLastBlock {
-		lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
-		if err != nil {
-			hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
-			return fromBlock, toBlock, err
-		}
+}
 
-		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
-			hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
-			fromBlock = uint64(result) + 1
-		} else {
-			hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
-			toBlock = 0
-			return fromBlock, toBlock, err
-		}
+// processBlockEvents - processes begin block events of heimdall block
+func (hl *HeimdallListener) processBlockEvents(height int64, events []abci.Event) {
+	for _, event := range events {
+		hl.ProcessBlockEvent(sdk.StringifyEvent(event), height)
 	}
-	return fromBlock, toBlock, err
 }
 
 // ProcessBlockEvent - process Blockevents (BeginBlock, EndBlock events) from heimdall.
@@ -189,6 +111,10 @@ func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight
 		hl.sendBlockTask("sendCheckpointToRootchain", eventBytes, blockHeight)
 	case checkpointTypes.EventTypeCheckpointSync:
 		hl.sendBlockTask("sendCheckpointSyncToStakeChain", eventBytes, blockHeight)
+	case checkpointTypes.EventTypeCheckpointAck:
+		if len(helper.GetConfig().BorFinalityNotifyEndpoints) > 0 {
+			hl.sendBlockTask("notifyCheckpointFinality", eventBytes, blockHeight)
+		}
 	case slashingTypes.EventTypeSlashLimit:
 		hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight)
 	case slashingTypes.EventTypeTickConfirm:
@@ -201,6 +127,8 @@ func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight
 		hl.sendBlockTask("sendStakingSyncToHeimdall", eventBytes, blockHeight)
 	case stakingTypes.EventTypeStakingSync:
 		hl.sendBlockTask("sendStakingSyncToRootChain", eventBytes, blockHeight)
+	case stakingTypes.EventTypeValidatorSetSync:
+		hl.sendBlockTask("sendValidatorSetSyncToRootChain", eventBytes, blockHeight)
 	default:
 		hl.Logger.Debug("BlockEvent Type mismatch", "eventType", event.Type)
 	}
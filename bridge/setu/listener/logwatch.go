@@ -0,0 +1,88 @@
+package listener
+
+import (
+	"sync"
+
+	ethCommon "github.com/maticnetwork/bor/common"
+)
+
+// logWatch keeps blocks in which log subscription delivered logs of watched contracts. While subscription
+// is alive, ranges of blocks after it was set up without any delivered log have no events to query.
+type logWatch struct {
+	mu sync.Mutex
+
+	addresses []ethCommon.Address
+	from      uint64 // first block covered by live subscription, zero if there's none
+	blocks    map[uint64]struct{}
+}
+
+func newLogWatch() *logWatch {
+	return &logWatch{blocks: make(map[uint64]struct{})}
+}
+
+// start marks subscription to logs of addresses alive for blocks after latest
+func (w *logWatch) start(addresses []ethCommon.Address, latest uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.addresses = addresses
+	w.from = latest + 1
+	w.blocks = make(map[uint64]struct{})
+}
+
+// stop marks subscription dropped, ranges are queried again until it's back
+func (w *logWatch) stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.addresses = nil
+	w.from = 0
+	w.blocks = make(map[uint64]struct{})
+}
+
+// add records block in which log was delivered. Logs removed by reorg keep their block recorded,
+// so it's queried anyway.
+func (w *logWatch) add(block uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.from != 0 {
+		w.blocks[block] = struct{}{}
+	}
+}
+
+// isEmpty returns true if live subscription to logs of addresses covers blocks from-to and
+// delivered none of them. Blocks before from are forgotten.
+func (w *logWatch) isEmpty(addresses []ethCommon.Address, from, to uint64) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for block := range w.blocks {
+		if block < from {
+			delete(w.blocks, block)
+		}
+	}
+
+	if w.from == 0 || from < w.from || !equalAddresses(w.addresses, addresses) {
+		return false
+	}
+
+	for block := range w.blocks {
+		if block <= to {
+			return false
+		}
+	}
+	return true
+}
+
+func equalAddresses(a, b []ethCommon.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
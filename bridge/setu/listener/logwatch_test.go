@@ -0,0 +1,35 @@
+package listener
+
+import (
+	"testing"
+
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogWatch(t *testing.T) {
+	addresses := []ethCommon.Address{ethCommon.HexToAddress("0x1"), ethCommon.HexToAddress("0x2")}
+	w := newLogWatch()
+
+	// without subscription every range is queried
+	require.False(t, w.isEmpty(addresses, 1, 10))
+
+	w.start(addresses, 100)
+	require.False(t, w.isEmpty(addresses, 95, 110), "Range starting before subscription should be queried")
+	require.True(t, w.isEmpty(addresses, 101, 110))
+	require.False(t, w.isEmpty(addresses[:1], 101, 110), "Range of other contracts should be queried")
+
+	w.add(105)
+	require.False(t, w.isEmpty(addresses, 101, 110))
+	require.True(t, w.isEmpty(addresses, 101, 104))
+	require.True(t, w.isEmpty(addresses, 106, 110))
+	require.True(t, w.isEmpty(addresses, 101, 110), "Blocks before queried range should be forgotten")
+
+	w.add(120)
+	w.stop()
+	require.False(t, w.isEmpty(addresses, 121, 130), "Range should be queried once subscription dropped")
+
+	w.add(125)
+	w.start(addresses, 130)
+	require.True(t, w.isEmpty(addresses, 131, 140))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/maticnetwork/bor/accounts/abi"
 	ethCommon "github.com/maticnetwork/bor/common"
 	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/helper"
@@ -39,6 +40,9 @@ type RootChainListener struct {
 	maxQueryBlocks int64
 
 	stateSyncedCountWithDecay uint64
+	lastHeaderAt              time.Time // time last header was processed, decays busy counter
+
+	logWatch *logWatch // logs delivered by log subscription, empty ranges aren't queried
 }
 
 const (
@@ -46,6 +50,9 @@ const (
 	lastBscBlockKey = "bsc-last-block"
 
 	decayPerSecond = 30
+
+	// maxResubscribeInterval cap of backoff between attempts to resubscribe to root chain
+	maxResubscribeInterval = 2 * time.Minute
 )
 
 // NewRootChainListener - constructor func
@@ -63,6 +70,7 @@ func NewRootChainListener(rootChain string) *RootChainListener {
 		abis:           abis,
 		stakingInfoAbi: &contractCaller.StakingInfoABI,
 		rootChainType:  rootChain,
+		logWatch:       newLogWatch(),
 	}
 	switch rootChain {
 	case hmtypes.RootChainTypeEth:
@@ -102,16 +110,19 @@ func (rl *RootChainListener) Start() error {
 	// start header process
 	go rl.StartHeaderProcess(headerCtx)
 
-	// subscribe to new head
-	subscription, err := rl.chainClient.SubscribeNewHead(ctx, rl.HeaderChannel)
+	// subscribe to new head over websocket endpoint, if configured
+	subscription, err := rl.contractConnector.SubscribeNewHeads(ctx, rl.rootChainType, rl.HeaderChannel)
 	if err != nil {
 		// start go routine to poll for new header using client object
 		rl.Logger.Info("Start polling for root chain header blocks",
-			"root", rl.rootChainType, "pollInterval", rl.pollInterval)
+			"root", rl.rootChainType, "pollInterval", rl.pollInterval, "reason", err)
 		go rl.StartPolling(ctx, rl.pollInterval)
 	} else {
 		// start go routine to listen new header using subscription
 		go rl.StartSubscription(ctx, subscription)
+
+		// logs of watched contracts tell which ranges have events to query
+		go rl.StartLogSubscription(ctx)
 	}
 
 	// subscribed to new head
@@ -120,14 +131,143 @@ func (rl *RootChainListener) Start() error {
 	return nil
 }
 
+// StartPolling polls latest header of root chain, more often while checkpoints wait for ack
+func (rl *RootChainListener) StartPolling(ctx context.Context, pollInterval time.Duration) {
+	scheduler.GetScheduler().Run(ctx, scheduler.Task{
+		Name:      rl.String(),
+		RootChain: rl.rootChainType,
+		Interval:  pollInterval,
+		Lag:       rl.ackLag,
+		Run:       rl.pollHeader,
+	})
+	rl.Logger.Info("Polling stopped", "root", rl.rootChainType)
+}
+
+// StartSubscription waits while new heads are delivered by subscription. Once subscription drops
+// headers are polled, so events are still detected, while listener resubscribes with backoff.
+func (rl *RootChainListener) StartSubscription(ctx context.Context, subscription ethereum.Subscription) {
+	for {
+		select {
+		case err := <-subscription.Err():
+			subscription.Unsubscribe()
+			rl.Logger.Error("New head subscription dropped, polling until resubscribed",
+				"root", rl.rootChainType, "pollInterval", rl.pollInterval, "error", err)
+
+			pollCtx, stopPolling := context.WithCancel(ctx)
+			go rl.StartPolling(pollCtx, rl.pollInterval)
+
+			subscription = rl.resubscribe(ctx, "newHeads", func() (ethereum.Subscription, error) {
+				return rl.contractConnector.SubscribeNewHeads(ctx, rl.rootChainType, rl.HeaderChannel)
+			})
+			stopPolling()
+
+			if subscription == nil {
+				rl.Logger.Info("Subscription stopped", "root", rl.rootChainType)
+				return
+			}
+		case <-ctx.Done():
+			subscription.Unsubscribe()
+			rl.Logger.Info("Subscription stopped", "root", rl.rootChainType)
+			return
+		}
+	}
+}
+
+// StartLogSubscription records blocks with logs of watched contracts while log subscription is alive,
+// and resubscribes with backoff once it drops. Without live subscription every range is queried.
+func (rl *RootChainListener) StartLogSubscription(ctx context.Context) {
+	logs := make(chan ethTypes.Log)
+	subscribe := func() (ethereum.Subscription, error) {
+		rootchainContext, err := rl.getRootChainContext()
+		if err != nil {
+			return nil, err
+		}
+
+		addresses := watchedAddresses(rootchainContext)
+		subscription, latest, err := rl.contractConnector.SubscribeLogs(ctx, rl.rootChainType, ethereum.FilterQuery{Addresses: addresses}, logs)
+		if err != nil {
+			return nil, err
+		}
+
+		rl.logWatch.start(addresses, latest)
+		return subscription, nil
+	}
+
+	subscription, err := subscribe()
+	if err != nil {
+		rl.Logger.Error("Error while subscribing to logs", "root", rl.rootChainType, "error", err)
+		if subscription = rl.resubscribe(ctx, "logs", subscribe); subscription == nil {
+			return
+		}
+	}
+
+	for {
+		select {
+		case vLog := <-logs:
+			rl.logWatch.add(vLog.BlockNumber)
+		case err := <-subscription.Err():
+			subscription.Unsubscribe()
+			rl.logWatch.stop()
+			rl.Logger.Error("Log subscription dropped, querying every range until resubscribed", "root", rl.rootChainType, "error", err)
+
+			if subscription = rl.resubscribe(ctx, "logs", subscribe); subscription == nil {
+				return
+			}
+		case <-ctx.Done():
+			subscription.Unsubscribe()
+			rl.logWatch.stop()
+			return
+		}
+	}
+}
+
+// resubscribe subscribes again until it succeeds, doubling wait after every failed attempt.
+// Nil is returned once ctx is done.
+func (rl *RootChainListener) resubscribe(ctx context.Context, name string, subscribe func() (ethereum.Subscription, error)) ethereum.Subscription {
+	wait := DefaultResubscribeInterval
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+
+		subscription, err := subscribe()
+		if err == nil {
+			rl.Logger.Info("Resubscribed to root chain", "root", rl.rootChainType, "subscription", name)
+			return subscription
+		}
+
+		if wait *= 2; wait > maxResubscribeInterval {
+			wait = maxResubscribeInterval
+		}
+		rl.Logger.Error("Error while resubscribing to root chain", "root", rl.rootChainType, "subscription", name, "retryIn", wait, "error", err)
+	}
+}
+
+// ackLag returns number of checkpoints of root chain waiting for ack
+func (rl *RootChainListener) ackLag() (uint64, error) {
+	queue, err := util.GetCheckpointBufferQueue(rl.cliCtx, rl.rootChainType)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(queue.Checkpoints)), nil
+}
+
 // ProcessHeader - process headerblock from rootchain
 func (rl *RootChainListener) ProcessHeader(newHeader *ethTypes.Header) {
 	rl.Logger.Debug("New block detected", "root", rl.rootChainType, "blockNumber", newHeader.Number)
 
 	// check if heimdall is busy
 	if rl.busyLimit != 0 {
-		// event decay
-		decay := decayPerSecond * uint64(rl.pollInterval.Seconds())
+		// event decay, headers arrive every block if subscribed and every poll interval otherwise
+		elapsed := rl.pollInterval
+		if !rl.lastHeaderAt.IsZero() {
+			elapsed = time.Since(rl.lastHeaderAt)
+		}
+		rl.lastHeaderAt = time.Now()
+
+		decay := decayPerSecond * uint64(elapsed.Seconds())
 		if rl.stateSyncedCountWithDecay > decay {
 			rl.stateSyncedCountWithDecay -= decay
 		} else {
@@ -156,15 +296,28 @@ func (rl *RootChainListener) ProcessHeader(newHeader *ethTypes.Header) {
 	requiredConfirmations := rootchainContext.ChainmanagerParams.MainchainTxConfirmations
 	latestNumber := newHeader.Number
 
-	// confirmation
-	confirmationBlocks := big.NewInt(0).SetUint64(requiredConfirmations)
-
-	if latestNumber.Cmp(confirmationBlocks) <= 0 {
-		rl.Logger.Error("Block number less than Confirmations required",
-			"root", rl.rootChainType, "blockNumber", latestNumber.Uint64, "confirmationsRequired", confirmationBlocks.Uint64)
+	// finalized block if root chain has finality tag configured
+	finalized, err := rl.contractConnector.GetFinalizedCallOpts(rl.rootChainType)
+	if err != nil {
+		rl.Logger.Error("Error while fetching finalized block", "root", rl.rootChainType, "error", err)
 		return
 	}
-	latestNumber = latestNumber.Sub(latestNumber, confirmationBlocks)
+
+	if finalized != nil {
+		if finalized.BlockNumber.Cmp(latestNumber) < 0 {
+			latestNumber = new(big.Int).Set(finalized.BlockNumber)
+		}
+	} else {
+		// confirmation
+		confirmationBlocks := big.NewInt(0).SetUint64(requiredConfirmations)
+
+		if latestNumber.Cmp(confirmationBlocks) <= 0 {
+			rl.Logger.Error("Block number less than Confirmations required",
+				"root", rl.rootChainType, "blockNumber", latestNumber.Uint64, "confirmationsRequired", confirmationBlocks.Uint64)
+			return
+		}
+		latestNumber = latestNumber.Sub(latestNumber, confirmationBlocks)
+	}
 
 	// default fromBlock
 	fromBlock := latestNumber
@@ -205,15 +358,16 @@ func (rl *RootChainListener) ProcessHeader(newHeader *ethTypes.Header) {
 func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) {
 	rl.Logger.Info("Query rootchain event logs", "root", rl.rootChainType, "fromBlock", fromBlock, "toBlock", toBlock)
 
-	// get chain params
-	chainParams := rootchainContext.ChainmanagerParams.ChainParams
-
 	// draft a query
+	queryAddresses := watchedAddresses(rootchainContext)
 
-	queryAddresses := []ethCommon.Address{
-		chainParams.RootChainAddress.EthAddress(),
-		chainParams.StakingInfoAddress.EthAddress(),
-		chainParams.StateSenderAddress.EthAddress(),
+	// live log subscription delivered no logs of range, there is nothing to query
+	if rl.logWatch.isEmpty(queryAddresses, fromBlock.Uint64(), toBlock.Uint64()) {
+		rl.Logger.Debug("No logs delivered by subscription, skipping query", "root", rl.rootChainType, "fromBlock", fromBlock, "toBlock", toBlock)
+		if err := rl.storageClient.Put([]byte(rl.blockKey), []byte(toBlock.String()), nil); err != nil {
+			rl.Logger.Error("rl.storageClient.Put", "Error", err)
+		}
+		return
 	}
 
 	query := ethereum.FilterQuery{FromBlock: fromBlock, ToBlock: toBlock, Addresses: queryAddresses}
@@ -254,12 +408,27 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
 						rl.sendTaskWithDelay("sendStakingAckToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
+
+				case "Staked", "StakeUpdate", "SignerChange", "UnstakeInit":
+					// staking events on this chain are mirrors of stake chain events replayed by staking sync,
+					// they are processed from stake chain (tron) listener only
+					rl.Logger.Debug("Ignoring mirrored staking event", "eventname", selectedEvent.Name, "root", rl.rootChainType)
 				}
 			}
 		}
 	}
 }
 
+// watchedAddresses returns root chain contracts whose events are processed
+func watchedAddresses(rootchainContext *RootChainListenerContext) []ethCommon.Address {
+	chainParams := rootchainContext.ChainmanagerParams.ChainParams
+	return []ethCommon.Address{
+		chainParams.RootChainAddress.EthAddress(),
+		chainParams.StakingInfoAddress.EthAddress(),
+		chainParams.StateSenderAddress.EthAddress(),
+	}
+}
+
 func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string, logBytes []byte, delay time.Duration) {
 	signature := &tasks.Signature{
 		Name: taskName,
@@ -0,0 +1,236 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	subscriptionTokenPrefix = "subscription-last-height-" // storage key prefix of resume tokens
+
+	// DefaultResubscribeInterval wait before subscribing again after subscription failed
+	DefaultResubscribeInterval = 5 * time.Second
+
+	// subscriptionCapacity buffered events of subscription, ws client drops events once it's full
+	subscriptionCapacity = 100
+)
+
+// blockEventsClient tendermint client used by subscriber, implemented by rpc http client
+type blockEventsClient interface {
+	Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (<-chan ctypes.ResultEvent, error)
+	Unsubscribe(ctx context.Context, subscriber, query string) error
+	Status() (*ctypes.ResultStatus, error)
+}
+
+// ResumableSubscriber processes events of every heimdall block exactly once across reconnects and restarts.
+// Height of last processed block is stored as resume token of subscription. Live events only trigger
+// processing: blocks after the token are fetched and processed in order, so blocks missed while ws was
+// reconnecting are caught up and already processed blocks are skipped.
+type ResumableSubscriber struct {
+	name    string
+	query   string
+	client  blockEventsClient
+	storage *leveldb.DB
+	logger  log.Logger
+
+	// fetchEvents fetches events of block at height, processing stops at first failed block
+	fetchEvents func(height int64) ([]abci.Event, error)
+
+	// process processes events of block at height
+	process func(height int64, events []abci.Event)
+
+	// legacyTokenKey storage key of last processed height before resume tokens, read when token is missing
+	legacyTokenKey []byte
+
+	// catchUpInterval interval of catch-up with latest height when no events arrive
+	catchUpInterval time.Duration
+
+	// resubscribeInterval wait before subscribing again
+	resubscribeInterval time.Duration
+
+	lastHeight int64
+}
+
+// NewResumableSubscriber creates resumable subscriber of new block events
+func NewResumableSubscriber(
+	name string,
+	client blockEventsClient,
+	storage *leveldb.DB,
+	logger log.Logger,
+	fetchEvents func(height int64) ([]abci.Event, error),
+	process func(height int64, events []abci.Event),
+	catchUpInterval time.Duration,
+) *ResumableSubscriber {
+	return &ResumableSubscriber{
+		name:                name,
+		query:               tmTypes.QueryForEvent(tmTypes.EventNewBlock).String(),
+		client:              client,
+		storage:             storage,
+		logger:              logger.With("subscription", name),
+		fetchEvents:         fetchEvents,
+		process:             process,
+		catchUpInterval:     catchUpInterval,
+		resubscribeInterval: DefaultResubscribeInterval,
+	}
+}
+
+// WithLegacyTokenKey sets storage key of last processed height used before resume tokens
+func (s *ResumableSubscriber) WithLegacyTokenKey(key []byte) *ResumableSubscriber {
+	s.legacyTokenKey = key
+	return s
+}
+
+// Run subscribes and processes blocks until ctx is done, subscribing again whenever subscription fails
+func (s *ResumableSubscriber) Run(ctx context.Context) {
+	s.lastHeight = s.loadToken()
+	s.logger.Info("Starting resumable subscription", "lastHeight", s.lastHeight)
+
+	for {
+		if err := s.subscribe(ctx); err != nil {
+			s.logger.Error("Subscription failed, subscribing again", "lastHeight", s.lastHeight, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Subscription stopped", "lastHeight", s.lastHeight)
+			return
+		case <-time.After(s.resubscribeInterval):
+		}
+	}
+}
+
+// LastHeight returns height of last processed block
+func (s *ResumableSubscriber) LastHeight() int64 {
+	return s.lastHeight
+}
+
+// subscribe subscribes to new blocks, catches up with latest height and processes blocks of events until
+// ctx is done or processing fails
+func (s *ResumableSubscriber) subscribe(ctx context.Context) error {
+	subscriber := "bridge-" + s.name
+	eventCh, err := s.client.Subscribe(ctx, subscriber, s.query, subscriptionCapacity)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		unsubscribeCtx, cancel := context.WithTimeout(context.Background(), s.resubscribeInterval)
+		defer cancel()
+
+		if err := s.client.Unsubscribe(unsubscribeCtx, subscriber, s.query); err != nil {
+			s.logger.Debug("Error while unsubscribing", "error", err)
+		}
+	}()
+
+	// blocks produced while subscription was down
+	if err := s.CatchUp(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(s.catchUpInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-eventCh:
+			if !ok {
+				return errors.New("subscription closed")
+			}
+
+			if height := eventHeight(event); height > 0 {
+				if err := s.ProcessUpTo(height); err != nil {
+					return err
+				}
+			}
+
+		case <-ticker.C:
+			// ws client drops events while reconnecting, and when subscription buffer is full
+			if err := s.CatchUp(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// CatchUp processes blocks up to latest height of node
+func (s *ResumableSubscriber) CatchUp() error {
+	status, err := s.client.Status()
+	if err != nil {
+		return err
+	}
+
+	return s.ProcessUpTo(status.SyncInfo.LatestBlockHeight)
+}
+
+// ProcessUpTo processes blocks after last processed one up to height in order, recording resume token after
+// every block. Already processed heights are skipped.
+func (s *ResumableSubscriber) ProcessUpTo(height int64) error {
+	for next := s.lastHeight + 1; next <= height; next++ {
+		events, err := s.fetchEvents(next)
+		if err != nil {
+			return err
+		}
+
+		s.process(next, events)
+
+		s.lastHeight = next
+		if err := s.storage.Put(s.tokenKey(), []byte(strconv.FormatInt(next, 10)), nil); err != nil {
+			s.logger.Error("Error while storing resume token", "height", next, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *ResumableSubscriber) tokenKey() []byte {
+	return []byte(subscriptionTokenPrefix + s.name)
+}
+
+// loadToken returns last processed height from resume token, or from legacy key when token is missing
+func (s *ResumableSubscriber) loadToken() int64 {
+	for _, key := range [][]byte{s.tokenKey(), s.legacyTokenKey} {
+		if len(key) == 0 {
+			continue
+		}
+
+		value, err := s.storage.Get(key, nil)
+		if err != nil {
+			continue
+		}
+
+		height, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			s.logger.Error("Error parsing resume token", "key", string(key), "error", err)
+			continue
+		}
+
+		return height
+	}
+
+	return 0
+}
+
+// eventHeight returns block height of new block event
+func eventHeight(event ctypes.ResultEvent) int64 {
+	switch data := event.Data.(type) {
+	case tmTypes.EventDataNewBlock:
+		if data.Block != nil {
+			return data.Block.Height
+		}
+	case tmTypes.EventDataNewBlockHeader:
+		return data.Header.Height
+	}
+
+	return 0
+}
@@ -0,0 +1,136 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// testEventsClient serves latest height and a new event channel on every subscription
+type testEventsClient struct {
+	mu      sync.Mutex
+	latest  int64
+	eventCh chan ctypes.ResultEvent
+}
+
+func (c *testEventsClient) Subscribe(_ context.Context, _, _ string, _ ...int) (<-chan ctypes.ResultEvent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.eventCh = make(chan ctypes.ResultEvent, 10)
+	return c.eventCh, nil
+}
+
+func (c *testEventsClient) Unsubscribe(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (c *testEventsClient) Status() (*ctypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: c.latest}}, nil
+}
+
+// newBlock produces block at height and sends its event to current subscription
+func (c *testEventsClient) newBlock(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height > c.latest {
+		c.latest = height
+	}
+	c.eventCh <- ctypes.ResultEvent{Data: tmTypes.EventDataNewBlock{Block: &tmTypes.Block{Header: tmTypes.Header{Height: height}}}}
+}
+
+func TestResumableSubscriber(t *testing.T) {
+	t.Parallel()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// last block processed by polling
+	require.NoError(t, db.Put([]byte(heimdallLastBlockKey), []byte("3"), nil))
+
+	var mu sync.Mutex
+	var processed []int64
+	failed := false
+	fetchEvents := func(height int64) ([]abci.Event, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// block 6 fails once
+		if height == 6 && !failed {
+			failed = true
+			return nil, errors.New("block results not available")
+		}
+		return []abci.Event{{Type: "test"}}, nil
+	}
+	process := func(height int64, events []abci.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		require.Len(t, events, 1)
+		processed = append(processed, height)
+	}
+	processedHeights := func() []int64 {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]int64{}, processed...)
+	}
+
+	client := &testEventsClient{latest: 5}
+	newSubscriber := func() *ResumableSubscriber {
+		s := NewResumableSubscriber(HeimdallListenerStr, client, db, log.NewNopLogger(), fetchEvents, process, time.Hour).
+			WithLegacyTokenKey([]byte(heimdallLastBlockKey))
+		s.resubscribeInterval = time.Millisecond
+		return s
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		newSubscriber().Run(ctx)
+		close(done)
+	}()
+
+	// resumes after legacy last block
+	require.Eventually(t, func() bool {
+		return len(processedHeights()) == 2
+	}, time.Second, time.Millisecond)
+	require.Equal(t, []int64{4, 5}, processedHeights())
+
+	// missed blocks are caught up, failed block is retried after subscribing again
+	client.newBlock(8)
+	require.Eventually(t, func() bool {
+		return len(processedHeights()) == 5
+	}, time.Second, time.Millisecond)
+	require.Equal(t, []int64{4, 5, 6, 7, 8}, processedHeights())
+
+	// duplicate events are skipped
+	client.newBlock(7)
+	client.newBlock(9)
+	require.Eventually(t, func() bool {
+		return len(processedHeights()) == 6
+	}, time.Second, time.Millisecond)
+	require.Equal(t, []int64{4, 5, 6, 7, 8, 9}, processedHeights())
+
+	cancel()
+	<-done
+
+	// resume token survives restart
+	s := newSubscriber()
+	require.Equal(t, int64(9), s.loadToken())
+}
@@ -11,10 +11,13 @@ import (
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/maticnetwork/bor/accounts/abi"
 	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/contracts/erc20"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	"github.com/maticnetwork/heimdall/types"
 )
 
@@ -29,6 +32,7 @@ type TronListener struct {
 	// ABIs
 	abis           []*abi.ABI
 	stakingInfoAbi *abi.ABI
+	erc20Abi       *abi.ABI
 }
 
 // NewTronListener - constructor func
@@ -43,8 +47,10 @@ func NewTronListener() *TronListener {
 			&contractCaller.RootChainABI,
 			&contractCaller.StateSenderABI,
 			&contractCaller.StakingInfoABI,
+			&contractCaller.MaticTokenABI,
 		},
 		stakingInfoAbi: &contractCaller.StakingInfoABI,
+		erc20Abi:       &contractCaller.MaticTokenABI,
 	}
 
 	return TronListener
@@ -75,34 +81,39 @@ func (tl *TronListener) Start() error {
 	return nil
 }
 
-// startPolling starts polling
+// StartPolling polls latest block of tron, more often while checkpoints wait for ack
 func (tl *TronListener) StartPolling(ctx context.Context, pollInterval time.Duration) {
-	// How often to fire the passed in function in second
-	interval := pollInterval
-
-	// Setup the ticket and the channel to signal
-	// the ending of the interval
-	ticker := time.NewTicker(interval)
+	scheduler.GetScheduler().Run(ctx, scheduler.Task{
+		Name:      tl.String(),
+		RootChain: tl.rootChainType,
+		Interval:  pollInterval,
+		Lag:       tl.ackLag,
+		Run:       tl.pollHeader,
+	})
+	tl.Logger.Info("Polling stopped")
+}
 
-	// start listening
-	for {
+// pollHeader sends latest block number of tron to header channel
+func (tl *TronListener) pollHeader(ctx context.Context) {
+	headerNum, err := tl.contractConnector.GetTronLatestBlockNumber()
+	if err == nil {
+		// send data to channel
 		select {
-		case <-ticker.C:
-			headerNum, err := tl.contractConnector.GetTronLatestBlockNumber()
-			if err == nil {
-				// send data to channel
-				tl.HeaderChannel <- &(ethTypes.Header{
-					Number: big.NewInt(headerNum),
-				})
-			}
+		case tl.HeaderChannel <- &(ethTypes.Header{Number: big.NewInt(headerNum)}):
 		case <-ctx.Done():
-			tl.Logger.Info("Polling stopped")
-			ticker.Stop()
-			return
 		}
 	}
 }
 
+// ackLag returns number of checkpoints of tron waiting for ack
+func (tl *TronListener) ackLag() (uint64, error) {
+	queue, err := util.GetCheckpointBufferQueue(tl.cliCtx, tl.rootChainType)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(queue.Checkpoints)), nil
+}
+
 // ProcessHeader - process headerblock from rootchain
 func (tl *TronListener) ProcessHeader(newHeader *ethTypes.Header) {
 	tl.Logger.Debug("New block detected", "blockNumber", newHeader.Number)
@@ -175,9 +186,18 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 	tl.Logger.Info("Query tron event logs", "fromBlock", fromBlock, "toBlock", toBlock)
 
 	var tronContractAddresses []string
-	tronContractAddresses = append(tronContractAddresses, chainManagerParams.ChainParams.TronStateSenderAddress)
-	tronContractAddresses = append(tronContractAddresses, chainManagerParams.ChainParams.TronChainAddress)
-	tronContractAddresses = append(tronContractAddresses, chainManagerParams.ChainParams.TronStakingInfoAddress)
+	tronContractAddresses = append(tronContractAddresses, chainManagerParams.ChainParams.TronStateSenderAddress.Hex())
+	tronContractAddresses = append(tronContractAddresses, chainManagerParams.ChainParams.TronChainAddress.Hex())
+	tronContractAddresses = append(tronContractAddresses, chainManagerParams.ChainParams.TronStakingInfoAddress.Hex())
+	// whitelisted erc20 fee tokens, their transfers to deposit address are topups
+	feeTokens, err := util.GetERC20FeeTokens(tl.cliCtx)
+	if err != nil {
+		tl.Logger.Error("Error while fetching erc20 fee tokens", "error", err)
+		return
+	}
+	for _, feeToken := range feeTokens {
+		tronContractAddresses = append(tronContractAddresses, feeToken.Token.Hex())
+	}
 	// current public key
 	pubkeyBytes := helper.GetPubKey().Bytes()
 	logs, err := tl.contractConnector.GetTronEventsByContractAddress(tronContractAddresses, fromBlock.Int64(), toBlock.Int64())
@@ -209,6 +229,7 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 					event := new(stakinginfo.StakinginfoStaked)
 					if err := helper.UnpackLog(tl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
 						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
+						continue
 					}
 					if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
 						// topup has to be processed first before validator join. so adding delay.
@@ -220,20 +241,22 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 						tl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
-				//case "StakeUpdate":
-				//		event := new(stakinginfo.StakinginfoStakeUpdate)
-				//		if err := helper.UnpackLog(tl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
-				//			tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
-				//		}
-				//		if util.IsEventSender(tl.cliCtx, event.ValidatorId.Uint64()) {
-				//			tl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, 0)
-				//		} else if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
-				//			tl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, delay)
-				//		}
+				case "StakeUpdate":
+					event := new(stakinginfo.StakinginfoStakeUpdate)
+					if err := helper.UnpackLog(tl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
+						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
+						continue
+					}
+					if util.IsEventSender(tl.cliCtx, event.ValidatorId.Uint64()) {
+						tl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, 0)
+					} else if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
+						tl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, delay)
+					}
 				case "SignerChange":
 					event := new(stakinginfo.StakinginfoSignerChange)
 					if err := helper.UnpackLog(tl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
 						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
+						continue
 					}
 					if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
 						tl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, 0)
@@ -245,6 +268,7 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 					event := new(stakinginfo.StakinginfoUnstakeInit)
 					if err := helper.UnpackLog(tl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
 						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
+						continue
 					}
 					if util.IsEventSender(tl.cliCtx, event.ValidatorId.Uint64()) {
 						tl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, 0)
@@ -261,6 +285,7 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 					event := new(stakinginfo.StakinginfoTopUpFee)
 					if err := helper.UnpackLog(tl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
 						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
+						continue
 					}
 					if bytes.Equal(event.User.Bytes(), helper.GetAddress()) {
 						tl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, 0)
@@ -268,6 +293,25 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 						tl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
+				case "Transfer":
+					feeToken, ok := feeTokens.Get(types.EthToTronAddress(vLog.Address))
+					if !ok {
+						continue
+					}
+					event := new(erc20.Erc20Transfer)
+					if err := helper.UnpackLog(tl.erc20Abi, event, selectedEvent.Name, &vLog); err != nil {
+						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
+						continue
+					}
+					if !isERC20TopUp(feeToken, event) {
+						continue
+					}
+					if bytes.Equal(event.From.Bytes(), helper.GetAddress()) {
+						tl.sendTaskWithDelay("sendTopUpERC20ToHeimdall", selectedEvent.Name, logBytes, 0)
+					} else if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
+						tl.sendTaskWithDelay("sendTopUpERC20ToHeimdall", selectedEvent.Name, logBytes, delay)
+					}
+
 				case "Slashed":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
 						tl.sendTaskWithDelay("sendTickAckToHeimdall", selectedEvent.Name, logBytes, delay)
@@ -277,6 +321,7 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 					event := new(stakinginfo.StakinginfoUnJailed)
 					if err := helper.UnpackLog(tl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
 						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
+						continue
 					}
 					if util.IsEventSender(tl.cliCtx, event.ValidatorId.Uint64()) {
 						tl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, 0)
@@ -333,6 +378,11 @@ func (tl *TronListener) sendTaskWithDelay(taskName string, eventName string, eve
 // utils
 //
 
+// isERC20TopUp returns true if transfer of fee token deposits it for fee
+func isERC20TopUp(feeToken topupTypes.ERC20FeeToken, event *erc20.Erc20Transfer) bool {
+	return bytes.Equal(event.To.Bytes(), feeToken.DepositAddress.EthAddress().Bytes()) && event.Value != nil && event.Value.Sign() > 0
+}
+
 func (tl *TronListener) getChainManagerParams() (*chainmanagerTypes.Params, error) {
 	chainmanagerParams, err := util.GetChainmanagerParams(tl.cliCtx)
 	if err != nil {
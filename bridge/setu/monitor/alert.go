@@ -0,0 +1,125 @@
+package monitor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PagerDutyEventsURL pagerduty events v2 endpoint
+const PagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// alertTimeout timeout of webhook requests
+const alertTimeout = 10 * time.Second
+
+// Notifier posts divergence alerts
+type Notifier interface {
+	// Notify posts status change, status is either diverged or recovered
+	Notify(status CheckpointStatus) error
+}
+
+// alertText returns human readable alert for status
+func alertText(status CheckpointStatus) string {
+	if status.Diverged {
+		return fmt.Sprintf("[%v] checkpoint divergence: %v", status.RootChain, status.Reason)
+	}
+	return fmt.Sprintf("[%v] checkpoint divergence resolved, checkpoint %v matches root chain and bor", status.RootChain, status.HeimdallNumber)
+}
+
+func postJSON(client *http.Client, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %v returned status %v", url, resp.StatusCode)
+	}
+	return nil
+}
+
+//
+// Slack
+//
+
+// SlackNotifier posts alerts to slack incoming webhook
+type SlackNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewSlackNotifier creates slack notifier for webhook url
+func NewSlackNotifier(url string) *SlackNotifier {
+	return &SlackNotifier{
+		url:    url,
+		client: &http.Client{Timeout: alertTimeout},
+	}
+}
+
+// Notify implements Notifier
+func (n *SlackNotifier) Notify(status CheckpointStatus) error {
+	return postJSON(n.client, n.url, map[string]string{
+		"text": alertText(status),
+	})
+}
+
+//
+// PagerDuty
+//
+
+// PagerDutyNotifier triggers and resolves pagerduty incidents, one incident per root chain
+type PagerDutyNotifier struct {
+	url        string
+	routingKey string
+	client     *http.Client
+}
+
+// NewPagerDutyNotifier creates pagerduty notifier for routing key
+func NewPagerDutyNotifier(routingKey string) *PagerDutyNotifier {
+	return &PagerDutyNotifier{
+		url:        PagerDutyEventsURL,
+		routingKey: routingKey,
+		client:     &http.Client{Timeout: alertTimeout},
+	}
+}
+
+type pagerDutyPayload struct {
+	Summary  string `json:"summary"`
+	Source   string `json:"source"`
+	Severity string `json:"severity"`
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+// Notify implements Notifier
+func (n *PagerDutyNotifier) Notify(status CheckpointStatus) error {
+	event := pagerDutyEvent{
+		RoutingKey:  n.routingKey,
+		EventAction: "resolve",
+		DedupKey:    "checkpoint-divergence-" + status.RootChain,
+	}
+
+	if status.Diverged {
+		event.EventAction = "trigger"
+		event.Payload = &pagerDutyPayload{
+			Summary:  alertText(status),
+			Source:   "heimdall-bridge",
+			Severity: "critical",
+		}
+	}
+
+	return postJSON(n.client, n.url, event)
+}
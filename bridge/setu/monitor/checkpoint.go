@@ -0,0 +1,359 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/tendermint/tendermint/libs/common"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// CheckpointMonitorStr service name
+	CheckpointMonitorStr = "checkpoint-monitor"
+
+	// HealthCheckpointPath path of checkpoint health endpoint
+	HealthCheckpointPath = "/health/checkpoint"
+)
+
+// monitoredRootChains root chains compared by monitor
+var monitoredRootChains = []string{
+	hmTypes.RootChainTypeEth,
+	hmTypes.RootChainTypeBsc,
+	hmTypes.RootChainTypeTron,
+}
+
+// CheckpointStatus result of last check for root chain
+type CheckpointStatus struct {
+	RootChain      string               `json:"root_chain"`
+	Diverged       bool                 `json:"diverged"`
+	Reason         string               `json:"reason,omitempty"`
+	Error          string               `json:"error,omitempty"`
+	HeimdallNumber uint64               `json:"heimdall_number"`
+	ContractNumber uint64               `json:"contract_number"`
+	Checkpoint     *hmTypes.Checkpoint  `json:"checkpoint,omitempty"`
+	ContractHeader *HeaderBlock         `json:"contract_header,omitempty"`
+	BorRootHash    hmTypes.HeimdallHash `json:"bor_root_hash"`
+	CheckedAt      time.Time            `json:"checked_at"`
+}
+
+// HeaderBlock header block as stored in root chain contract
+type HeaderBlock struct {
+	RootHash   hmTypes.HeimdallHash `json:"root_hash"`
+	StartBlock uint64               `json:"start_block"`
+	EndBlock   uint64               `json:"end_block"`
+}
+
+// CheckpointMonitor compares last heimdall checkpoint of each root chain with
+// header block on root chain contract and bor root hash of the range, and
+// alerts on divergence
+type CheckpointMonitor struct {
+	// Base service
+	common.BaseService
+
+	cliCtx         cliContext.CLIContext
+	contractCaller helper.ContractCaller
+	interval       time.Duration
+	notifiers      []Notifier
+
+	server *http.Server
+	cancel context.CancelFunc
+
+	mu       sync.RWMutex
+	statuses map[string]CheckpointStatus
+}
+
+// NewCheckpointMonitor creates checkpoint divergence monitor from config
+func NewCheckpointMonitor(cdc *codec.Codec) *CheckpointMonitor {
+	logger := util.Logger().With("service", CheckpointMonitorStr)
+	conf := helper.GetConfig()
+
+	contractCaller, err := helper.NewContractCaller()
+	if err != nil {
+		logger.Error("Error while getting contract caller", "error", err)
+		panic(err)
+	}
+
+	cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
+	cliCtx.TrustNode = true
+
+	m := &CheckpointMonitor{
+		cliCtx:         cliCtx,
+		contractCaller: contractCaller,
+		interval:       conf.CheckpointMonitorInterval,
+		statuses:       make(map[string]CheckpointStatus),
+	}
+
+	if conf.CheckpointMonitorSlackWebhook != "" {
+		m.notifiers = append(m.notifiers, NewSlackNotifier(conf.CheckpointMonitorSlackWebhook))
+	}
+
+	if conf.CheckpointMonitorPagerDutyKey != "" {
+		m.notifiers = append(m.notifiers, NewPagerDutyNotifier(conf.CheckpointMonitorPagerDutyKey))
+	}
+
+	if conf.CheckpointMonitorListenAddr != "" {
+		mux := http.NewServeMux()
+		mux.HandleFunc(HealthCheckpointPath, m.healthHandler)
+		m.server = &http.Server{Addr: conf.CheckpointMonitorListenAddr, Handler: mux}
+	}
+
+	m.BaseService = *common.NewBaseService(logger, CheckpointMonitorStr, m)
+	return m
+}
+
+// OnStart starts periodic checks and health endpoint
+func (m *CheckpointMonitor) OnStart() error {
+	if err := m.BaseService.OnStart(); err != nil {
+		m.Logger.Error("OnStart | OnStart", "Error", err)
+	} // Always call the overridden method.
+
+	if m.interval <= 0 {
+		m.Logger.Info("Checkpoint monitor is disabled")
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	go m.loop(ctx)
+
+	if m.server != nil {
+		go func() {
+			m.Logger.Info("Starting checkpoint health endpoint", "addr", m.server.Addr, "path", HealthCheckpointPath)
+			if err := m.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				m.Logger.Error("Checkpoint health endpoint stopped", "error", err)
+			}
+		}()
+	}
+
+	return nil
+}
+
+// OnStop stops checks and health endpoint
+func (m *CheckpointMonitor) OnStop() {
+	m.BaseService.OnStop()
+
+	if m.cancel != nil {
+		m.cancel()
+	}
+
+	if m.server != nil {
+		if err := m.server.Close(); err != nil {
+			m.Logger.Error("OnStop | server.Close", "Error", err)
+		}
+	}
+}
+
+func (m *CheckpointMonitor) loop(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, rootChain := range monitoredRootChains {
+			m.update(m.check(rootChain))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Statuses returns result of last check of each root chain
+func (m *CheckpointMonitor) Statuses() []CheckpointStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	statuses := make([]CheckpointStatus, 0, len(m.statuses))
+	for _, rootChain := range monitoredRootChains {
+		if status, ok := m.statuses[rootChain]; ok {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+// update stores status and alerts when root chain diverges or recovers
+func (m *CheckpointMonitor) update(status CheckpointStatus) {
+	m.mu.Lock()
+	prev, ok := m.statuses[status.RootChain]
+
+	// failed check keeps divergence state of last successful check
+	if status.Error != "" && ok {
+		status.Diverged, status.Reason = prev.Diverged, prev.Reason
+	}
+	m.statuses[status.RootChain] = status
+	m.mu.Unlock()
+
+	if status.Error != "" {
+		m.Logger.Error("Checkpoint divergence check failed", "root", status.RootChain, "error", status.Error)
+		return
+	}
+
+	if status.Diverged == prev.Diverged {
+		return
+	}
+
+	if status.Diverged {
+		m.Logger.Error("Checkpoint divergence detected", "root", status.RootChain, "reason", status.Reason)
+	} else {
+		m.Logger.Info("Checkpoint divergence resolved", "root", status.RootChain)
+	}
+
+	for _, notifier := range m.notifiers {
+		if err := notifier.Notify(status); err != nil {
+			m.Logger.Error("Error while posting checkpoint divergence alert", "root", status.RootChain, "error", err)
+		}
+	}
+}
+
+// check compares heimdall checkpoint with root chain contract and bor
+func (m *CheckpointMonitor) check(rootChain string) (status CheckpointStatus) {
+	status.RootChain = rootChain
+	status.CheckedAt = time.Now().UTC()
+
+	fail := func(err error) CheckpointStatus {
+		status.Error = err.Error()
+		return status
+	}
+
+	chainParams, err := util.GetNewChainParams(m.cliCtx, rootChain)
+	if err != nil {
+		return fail(err)
+	}
+
+	checkpointParams, err := util.GetCheckpointParams(m.cliCtx)
+	if err != nil {
+		return fail(err)
+	}
+
+	if status.HeimdallNumber, err = util.GetCheckpointCount(m.cliCtx, rootChain); err != nil {
+		return fail(err)
+	}
+
+	// nothing to compare yet
+	if status.HeimdallNumber == 0 {
+		return status
+	}
+
+	if status.Checkpoint, err = util.GetlastestCheckpoint(m.cliCtx, rootChain); err != nil {
+		return fail(err)
+	}
+
+	// root chain contract
+	var header HeaderBlock
+	switch rootChain {
+	case hmTypes.RootChainTypeTron:
+		if chainParams.ChainParams.TronChainAddress.Empty() {
+			return status
+		}
+
+		if status.ContractNumber, err = m.contractCaller.TronChainRPC.CurrentHeaderBlock(chainParams.ChainParams.TronChainAddress.Hex(), chainParams.ChildBlockInterval); err != nil {
+			return fail(err)
+		}
+
+		if status.ContractNumber >= status.HeimdallNumber {
+			root, start, end, _, _, err := m.contractCaller.GetTronHeaderInfo(status.HeimdallNumber, chainParams.ChainParams.TronChainAddress.Hex(), chainParams.ChildBlockInterval)
+			if err != nil {
+				return fail(err)
+			}
+			header = HeaderBlock{hmTypes.BytesToHeimdallHash(root.Bytes()), start, end}
+		}
+	default:
+		if chainParams.ChainParams.RootChainAddress.Empty() {
+			return status
+		}
+
+		rootChainInstance, err := m.contractCaller.GetRootChainInstance(chainParams.ChainParams.RootChainAddress.EthAddress(), rootChain)
+		if err != nil {
+			return fail(err)
+		}
+
+		if status.ContractNumber, err = m.contractCaller.CurrentHeaderBlock(rootChainInstance, chainParams.ChildBlockInterval); err != nil {
+			return fail(err)
+		}
+
+		if status.ContractNumber >= status.HeimdallNumber {
+			root, start, end, _, _, err := m.contractCaller.GetHeaderInfo(status.HeimdallNumber, rootChainInstance, chainParams.ChildBlockInterval)
+			if err != nil {
+				return fail(err)
+			}
+			header = HeaderBlock{hmTypes.BytesToHeimdallHash(root.Bytes()), start, end}
+		}
+	}
+
+	if status.ContractNumber >= status.HeimdallNumber {
+		status.ContractHeader = &header
+	}
+
+	// bor canonical root hash of checkpoint range
+	borRootHash, err := m.contractCaller.GetRootHash(status.Checkpoint.StartBlock, status.Checkpoint.EndBlock, checkpointParams.MaxCheckpointLength)
+	if err != nil {
+		return fail(err)
+	}
+	status.BorRootHash = hmTypes.BytesToHeimdallHash(borRootHash)
+
+	status.Reason = findDivergence(status)
+	status.Diverged = status.Reason != ""
+
+	return status
+}
+
+// findDivergence returns reason of divergence between heimdall, root chain contract and bor, empty if they match
+func findDivergence(status CheckpointStatus) string {
+	if status.ContractNumber < status.HeimdallNumber || status.ContractHeader == nil {
+		return fmt.Sprintf("heimdall acked checkpoint %v but contract is at %v", status.HeimdallNumber, status.ContractNumber)
+	}
+
+	checkpoint, header := status.Checkpoint, status.ContractHeader
+	if checkpoint.StartBlock != header.StartBlock || checkpoint.EndBlock != header.EndBlock {
+		return fmt.Sprintf("checkpoint %v range [%v, %v] doesn't match contract range [%v, %v]",
+			status.HeimdallNumber, checkpoint.StartBlock, checkpoint.EndBlock, header.StartBlock, header.EndBlock)
+	}
+
+	if !checkpoint.RootHash.Equals(header.RootHash) {
+		return fmt.Sprintf("checkpoint %v root hash %v doesn't match contract root hash %v",
+			status.HeimdallNumber, checkpoint.RootHash.Hex(), header.RootHash.Hex())
+	}
+
+	if !checkpoint.RootHash.Equals(status.BorRootHash) {
+		return fmt.Sprintf("checkpoint %v root hash %v doesn't match bor root hash %v",
+			status.HeimdallNumber, checkpoint.RootHash.Hex(), status.BorRootHash.Hex())
+	}
+
+	return ""
+}
+
+// healthHandler serves statuses, responds with 503 if any root chain diverged
+func (m *CheckpointMonitor) healthHandler(w http.ResponseWriter, r *http.Request) {
+	statuses := m.Statuses()
+
+	code := http.StatusOK
+	for _, status := range statuses {
+		if status.Diverged {
+			code = http.StatusServiceUnavailable
+			break
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"healthy":     code == http.StatusOK,
+		"root_chains": statuses,
+	}); err != nil {
+		m.Logger.Error("Error while writing checkpoint health", "error", err)
+	}
+}
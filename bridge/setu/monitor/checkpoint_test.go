@@ -0,0 +1,137 @@
+package monitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+type testNotifier struct {
+	statuses []CheckpointStatus
+}
+
+func (n *testNotifier) Notify(status CheckpointStatus) error {
+	n.statuses = append(n.statuses, status)
+	return nil
+}
+
+func newTestMonitor(notifier Notifier) *CheckpointMonitor {
+	m := &CheckpointMonitor{
+		notifiers: []Notifier{notifier},
+		statuses:  make(map[string]CheckpointStatus),
+	}
+	m.BaseService = *common.NewBaseService(log.NewNopLogger(), CheckpointMonitorStr, m)
+	return m
+}
+
+func TestFindDivergence(t *testing.T) {
+	t.Parallel()
+
+	root := hmTypes.BytesToHeimdallHash([]byte("root"))
+	status := CheckpointStatus{
+		HeimdallNumber: 5,
+		ContractNumber: 5,
+		Checkpoint:     &hmTypes.Checkpoint{StartBlock: 100, EndBlock: 200, RootHash: root},
+		ContractHeader: &HeaderBlock{RootHash: root, StartBlock: 100, EndBlock: 200},
+		BorRootHash:    root,
+	}
+	require.Empty(t, findDivergence(status))
+
+	behind := status
+	behind.ContractNumber, behind.ContractHeader = 4, nil
+	require.Contains(t, findDivergence(behind), "contract is at 4")
+
+	rangeMismatch := status
+	rangeMismatch.ContractHeader = &HeaderBlock{RootHash: root, StartBlock: 100, EndBlock: 201}
+	require.Contains(t, findDivergence(rangeMismatch), "range")
+
+	rootMismatch := status
+	rootMismatch.ContractHeader = &HeaderBlock{RootHash: hmTypes.BytesToHeimdallHash([]byte("other")), StartBlock: 100, EndBlock: 200}
+	require.Contains(t, findDivergence(rootMismatch), "contract root hash")
+
+	borMismatch := status
+	borMismatch.BorRootHash = hmTypes.BytesToHeimdallHash([]byte("other"))
+	require.Contains(t, findDivergence(borMismatch), "bor root hash")
+}
+
+func TestUpdateAlertsOnTransition(t *testing.T) {
+	t.Parallel()
+
+	notifier := &testNotifier{}
+	m := newTestMonitor(notifier)
+
+	m.update(CheckpointStatus{RootChain: hmTypes.RootChainTypeEth})
+	require.Empty(t, notifier.statuses, "healthy chain shouldn't alert")
+
+	m.update(CheckpointStatus{RootChain: hmTypes.RootChainTypeEth, Diverged: true, Reason: "mismatch"})
+	m.update(CheckpointStatus{RootChain: hmTypes.RootChainTypeEth, Diverged: true, Reason: "mismatch"})
+	require.Len(t, notifier.statuses, 1, "divergence should alert once")
+
+	// failed check keeps divergence
+	m.update(CheckpointStatus{RootChain: hmTypes.RootChainTypeEth, Error: "rpc down"})
+	require.True(t, m.Statuses()[0].Diverged)
+	require.Len(t, notifier.statuses, 1)
+
+	m.update(CheckpointStatus{RootChain: hmTypes.RootChainTypeEth})
+	require.Len(t, notifier.statuses, 2, "recovery should alert")
+	require.False(t, notifier.statuses[1].Diverged)
+}
+
+func TestHealthHandler(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMonitor(&testNotifier{})
+	m.update(CheckpointStatus{RootChain: hmTypes.RootChainTypeEth})
+
+	serve := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		m.healthHandler(w, httptest.NewRequest("GET", HealthCheckpointPath, nil))
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, serve().Code)
+
+	m.update(CheckpointStatus{RootChain: hmTypes.RootChainTypeBsc, Diverged: true, Reason: "mismatch"})
+	w := serve()
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	var body struct {
+		Healthy    bool               `json:"healthy"`
+		RootChains []CheckpointStatus `json:"root_chains"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.False(t, body.Healthy)
+	require.Len(t, body.RootChains, 2)
+}
+
+func TestPagerDutyNotifier(t *testing.T) {
+	t.Parallel()
+
+	var events []pagerDutyEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event pagerDutyEvent
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		events = append(events, event)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	notifier := NewPagerDutyNotifier("key")
+	notifier.url = server.URL
+
+	require.NoError(t, notifier.Notify(CheckpointStatus{RootChain: hmTypes.RootChainTypeEth, Diverged: true, Reason: "mismatch"}))
+	require.NoError(t, notifier.Notify(CheckpointStatus{RootChain: hmTypes.RootChainTypeEth}))
+
+	require.Len(t, events, 2)
+	require.Equal(t, "trigger", events[0].EventAction)
+	require.Equal(t, "critical", events[0].Payload.Severity)
+	require.Equal(t, "resolve", events[1].EventAction)
+	require.Equal(t, events[0].DedupKey, events[1].DedupKey)
+}
@@ -0,0 +1,192 @@
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NotifyCheckpointFinalityMethod gRPC method bor nodes serve finality notifications on.
+// Messages are JSON encoded (content subtype "json"), so bor needs no generated heimdall types.
+const NotifyCheckpointFinalityMethod = "/heimdall.FinalityNotifier/NotifyCheckpointFinality"
+
+// retryBackoff wait before second attempt to endpoint, doubled after every failed attempt
+const retryBackoff = 500 * time.Millisecond
+
+// CheckpointFinality notification of checkpoint acked on root chain, bor blocks start..end are final
+type CheckpointFinality struct {
+	RootChain      string               `json:"root_chain"`
+	Number         uint64               `json:"number"`
+	StartBlock     uint64               `json:"start_block"`
+	EndBlock       uint64               `json:"end_block"`
+	RootHash       hmTypes.HeimdallHash `json:"root_hash"`
+	HeimdallHeight int64                `json:"heimdall_height"`
+}
+
+func (f CheckpointFinality) String() string {
+	return fmt.Sprintf("CheckpointFinality{%v %v: %v..%v}", f.RootChain, f.Number, f.StartBlock, f.EndBlock)
+}
+
+// finalityAck response of bor node, notification is accepted unless call fails
+type finalityAck struct{}
+
+// jsonCodec gRPC codec of notifications
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error { return json.Unmarshal(data, v) }
+func (jsonCodec) Name() string                               { return "json" }
+
+// Config config of finality notifier
+type Config struct {
+	Endpoints []string      // gRPC endpoints (host:port) of bor nodes
+	Token     string        // token sent as bearer authorization, empty sends none
+	TLS       bool          // use TLS transport
+	Retries   int           // attempts per endpoint and notification
+	Timeout   time.Duration // timeout of single attempt
+}
+
+// NewConfig creates notifier config from heimdall config
+func NewConfig(conf helper.Configuration) Config {
+	return Config{
+		Endpoints: conf.BorFinalityNotifyEndpoints,
+		Token:     conf.BorFinalityNotifyToken,
+		TLS:       conf.BorFinalityNotifyTLS,
+		Retries:   conf.BorFinalityNotifyRetries,
+		Timeout:   conf.BorFinalityNotifyTimeout,
+	}
+}
+
+// FinalityNotifier pushes acked checkpoints to bor nodes, so they don't have to poll heimdall
+type FinalityNotifier struct {
+	config Config
+	logger log.Logger
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+// NewFinalityNotifier creates notifier, connections are dialed on first notification
+func NewFinalityNotifier(config Config, logger log.Logger) *FinalityNotifier {
+	if config.Retries < 1 {
+		config.Retries = 1
+	}
+
+	return &FinalityNotifier{
+		config: config,
+		logger: logger,
+		conns:  make(map[string]*grpc.ClientConn),
+	}
+}
+
+// Notify pushes finality of checkpoint to every endpoint, retrying failed ones.
+// Error lists endpoints which didn't accept it, bor nodes should ignore repeated notifications.
+func (n *FinalityNotifier) Notify(ctx context.Context, finality CheckpointFinality) error {
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed []string
+	)
+
+	for _, endpoint := range n.config.Endpoints {
+		wg.Add(1)
+		go func(endpoint string) {
+			defer wg.Done()
+
+			if err := n.notifyEndpoint(ctx, endpoint, finality); err != nil {
+				n.logger.Error("Error notifying bor node of checkpoint finality", "endpoint", endpoint, "finality", finality, "error", err)
+
+				mu.Lock()
+				failed = append(failed, endpoint)
+				mu.Unlock()
+				return
+			}
+
+			n.logger.Debug("Notified bor node of checkpoint finality", "endpoint", endpoint, "finality", finality)
+		}(endpoint)
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return fmt.Errorf("checkpoint finality not accepted by %v", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func (n *FinalityNotifier) notifyEndpoint(ctx context.Context, endpoint string, finality CheckpointFinality) (err error) {
+	conn, err := n.conn(endpoint)
+	if err != nil {
+		return err
+	}
+
+	if n.config.Token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+n.config.Token)
+	}
+
+	backoff := retryBackoff
+	for attempt := 1; ; attempt++ {
+		callCtx, cancel := ctx, context.CancelFunc(func() {})
+		if n.config.Timeout > 0 {
+			callCtx, cancel = context.WithTimeout(ctx, n.config.Timeout)
+		}
+
+		err = conn.Invoke(callCtx, NotifyCheckpointFinalityMethod, &finality, &finalityAck{}, grpc.ForceCodec(jsonCodec{}))
+		cancel()
+		if err == nil || attempt >= n.config.Retries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+}
+
+// conn returns connection to endpoint, dialed once and reused
+func (n *FinalityNotifier) conn(endpoint string) (*grpc.ClientConn, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if conn, ok := n.conns[endpoint]; ok {
+		return conn, nil
+	}
+
+	transport := grpc.WithInsecure()
+	if n.config.TLS {
+		transport = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+	}
+
+	conn, err := grpc.Dial(endpoint, transport)
+	if err != nil {
+		return nil, err
+	}
+
+	n.conns[endpoint] = conn
+	return conn, nil
+}
+
+// Close closes connections to endpoints
+func (n *FinalityNotifier) Close() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for endpoint, conn := range n.conns {
+		_ = conn.Close()
+		delete(n.conns, endpoint)
+	}
+}
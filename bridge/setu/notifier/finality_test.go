@@ -0,0 +1,116 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// serverCodec json codec of test bor node
+type serverCodec struct{ jsonCodec }
+
+func (serverCodec) String() string { return "json" }
+
+// testBorNode accepts notifications after failing first calls
+type testBorNode struct {
+	mu       sync.Mutex
+	failures int
+	calls    int
+	tokens   []string
+	received []CheckpointFinality
+}
+
+func (b *testBorNode) handle(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	var finality CheckpointFinality
+	if err := dec(&finality); err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.calls++
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		b.tokens = append(b.tokens, md.Get("authorization")...)
+	}
+	if b.calls <= b.failures {
+		return nil, errors.New("not ready")
+	}
+
+	b.received = append(b.received, finality)
+	return &finalityAck{}, nil
+}
+
+func startTestBorNode(t *testing.T, node *testBorNode) (string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(grpc.CustomCodec(serverCodec{}))
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "heimdall.FinalityNotifier",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{
+			{MethodName: "NotifyCheckpointFinality", Handler: node.handle},
+		},
+	}, node)
+
+	go func() { _ = server.Serve(listener) }()
+
+	return listener.Addr().String(), server.Stop
+}
+
+func TestFinalityNotifierRetries(t *testing.T) {
+	t.Parallel()
+
+	node := &testBorNode{failures: 1}
+	endpoint, stop := startTestBorNode(t, node)
+	defer stop()
+
+	notifier := NewFinalityNotifier(Config{
+		Endpoints: []string{endpoint},
+		Token:     "secret",
+		Retries:   2,
+		Timeout:   5 * time.Second,
+	}, log.NewNopLogger())
+	defer notifier.Close()
+
+	finality := CheckpointFinality{
+		RootChain:  hmTypes.RootChainTypeEth,
+		Number:     7,
+		StartBlock: 256,
+		EndBlock:   511,
+		RootHash:   hmTypes.HexToHeimdallHash("123"),
+	}
+	require.NoError(t, notifier.Notify(context.Background(), finality))
+
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	require.Equal(t, 2, node.calls)
+	require.Equal(t, []CheckpointFinality{finality}, node.received)
+	require.Equal(t, []string{"Bearer secret", "Bearer secret"}, node.tokens)
+}
+
+func TestFinalityNotifierReportsFailedEndpoints(t *testing.T) {
+	t.Parallel()
+
+	node := &testBorNode{failures: 2}
+	endpoint, stop := startTestBorNode(t, node)
+	defer stop()
+
+	notifier := NewFinalityNotifier(Config{Endpoints: []string{endpoint}, Retries: 2}, log.NewNopLogger())
+	defer notifier.Close()
+
+	err := notifier.Notify(context.Background(), CheckpointFinality{RootChain: hmTypes.RootChainTypeEth, Number: 1})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), endpoint)
+}
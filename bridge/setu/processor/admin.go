@@ -0,0 +1,255 @@
+package processor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// AdminProcessorsPath lists running processors with their last task errors
+	AdminProcessorsPath = "/processors"
+	// AdminPipelinePausePath pauses checkpoint pipeline of root chain (?root=)
+	AdminPipelinePausePath = "/pipelines/pause"
+	// AdminPipelineResumePath resumes checkpoint pipeline of root chain (?root=)
+	AdminPipelineResumePath = "/pipelines/resume"
+	// AdminCheckpointResubmitPath submits buffered checkpoint of root chain (?root=) to root chain again
+	AdminCheckpointResubmitPath = "/checkpoints/resubmit"
+	// AdminQueueFlushPath drops pending tasks of local job queue
+	AdminQueueFlushPath = "/queue/flush"
+
+	// maxTaskErrors errors kept per processor
+	maxTaskErrors = 10
+
+	// checkpointEventKeyPrefix bridge db key of last checkpoint event of root chain
+	checkpointEventKeyPrefix = "checkpoint-event-"
+)
+
+// TaskError error returned by processor task
+type TaskError struct {
+	Task  string    `json:"task"`
+	Error string    `json:"error"`
+	Time  time.Time `json:"time"`
+}
+
+// ProcessorStatus state of running processor
+type ProcessorStatus struct {
+	Name       string      `json:"name"`
+	LastErrors []TaskError `json:"last_errors"`
+}
+
+// taskErrorLog keeps last errors of processor tasks, newest first
+type taskErrorLog struct {
+	mu     sync.Mutex
+	max    int
+	errors []TaskError
+}
+
+func newTaskErrorLog(max int) *taskErrorLog {
+	return &taskErrorLog{max: max}
+}
+
+func (l *taskErrorLog) add(task string, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.errors = append([]TaskError{{Task: task, Error: err.Error(), Time: time.Now()}}, l.errors...)
+	if len(l.errors) > l.max {
+		l.errors = l.errors[:l.max]
+	}
+}
+
+func (l *taskErrorLog) list() []TaskError {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]TaskError{}, l.errors...)
+}
+
+// checkpointEvent checkpoint confirmed by heimdall, kept to resubmit checkpoint without new event
+type checkpointEvent struct {
+	StartBlock uint64 `json:"start_block"`
+	EndBlock   uint64 `json:"end_block"`
+	TxHash     string `json:"tx_hash"`
+	Height     int64  `json:"height"`
+}
+
+func (cp *CheckpointProcessor) storeCheckpointEvent(rootChain string, event checkpointEvent) {
+	if cp.storageClient == nil {
+		return
+	}
+
+	bz, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	if err := cp.storageClient.Put([]byte(checkpointEventKeyPrefix+rootChain), bz, nil); err != nil {
+		cp.Logger.Error("cp.storageClient.Put", "Error", err)
+	}
+}
+
+func (cp *CheckpointProcessor) loadCheckpointEvent(rootChain string) (event checkpointEvent, err error) {
+	if cp.storageClient == nil {
+		return event, errors.New("bridge db is not available")
+	}
+
+	bz, err := cp.storageClient.Get([]byte(checkpointEventKeyPrefix+rootChain), nil)
+	if err != nil {
+		return event, fmt.Errorf("no checkpoint event of root chain %v: %v", rootChain, err)
+	}
+
+	err = json.Unmarshal(bz, &event)
+	return event, err
+}
+
+// resubmitBufferedCheckpoint submits buffered checkpoint to root chain regardless of proposer and duty,
+// checkpoint is not sent if root chain already has it
+func (cp *CheckpointProcessor) resubmitBufferedCheckpoint(rootChain string) (checkpointEvent, error) {
+	event, err := cp.loadCheckpointEvent(rootChain)
+	if err != nil {
+		return event, err
+	}
+
+	bufferQueue, err := util.GetCheckpointBufferQueue(cp.cliCtx, rootChain)
+	if err != nil {
+		return event, err
+	}
+
+	buffered := false
+	for _, checkpoint := range bufferQueue.Checkpoints {
+		if checkpoint.StartBlock == event.StartBlock && checkpoint.EndBlock == event.EndBlock {
+			buffered = true
+			break
+		}
+	}
+
+	if !buffered {
+		return event, fmt.Errorf("checkpoint %v-%v is not buffered anymore", event.StartBlock, event.EndBlock)
+	}
+
+	checkpointContext, err := cp.getCheckpointContext(rootChain)
+	if err != nil {
+		return event, err
+	}
+
+	cp.Logger.Info("Resubmitting buffered checkpoint by admin", "root", rootChain, "start", event.StartBlock, "end", event.EndBlock)
+	txHash := common.FromHex(event.TxHash)
+	if rootChain == hmTypes.RootChainTypeTron {
+		return event, cp.createAndSendCheckpointToTron(checkpointContext, event.StartBlock, event.EndBlock, event.Height, txHash)
+	}
+	return event, cp.createAndSendCheckpointToRootchain(checkpointContext, event.StartBlock, event.EndBlock, event.Height, txHash, rootChain)
+}
+
+// registerAdminRoutes serves processor operations on bridge admin endpoint
+func (processorService *ProcessorService) registerAdminRoutes(s *scheduler.Scheduler) {
+	s.HandleAdmin(AdminProcessorsPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			scheduler.WriteAdminError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		statuses := make([]ProcessorStatus, 0, len(processorService.processors))
+		for _, processor := range processorService.processors {
+			statuses = append(statuses, processor.Status())
+		}
+		scheduler.WriteAdminResponse(w, http.StatusOK, statuses)
+	})
+
+	s.HandleAdmin(AdminPipelinePausePath, adminRootChainHandler(func(rootChain string) (interface{}, error) {
+		util.PauseRootChain(rootChain)
+		for _, name := range s.TasksOf(rootChain) {
+			if err := s.Pause(name); err != nil {
+				return nil, err
+			}
+		}
+		processorService.Logger.Info("Root chain pipeline paused by admin", "root", rootChain)
+		return map[string][]string{"paused_root_chains": util.PausedRootChains()}, nil
+	}))
+
+	s.HandleAdmin(AdminPipelineResumePath, adminRootChainHandler(func(rootChain string) (interface{}, error) {
+		util.ResumeRootChain(rootChain)
+		for _, name := range s.TasksOf(rootChain) {
+			if err := s.Resume(name); err != nil {
+				return nil, err
+			}
+		}
+		processorService.Logger.Info("Root chain pipeline resumed by admin", "root", rootChain)
+		return map[string][]string{"paused_root_chains": util.PausedRootChains()}, nil
+	}))
+
+	s.HandleAdmin(AdminCheckpointResubmitPath, adminRootChainHandler(func(rootChain string) (interface{}, error) {
+		for _, processor := range processorService.processors {
+			if cp, ok := processor.(*CheckpointProcessor); ok {
+				return cp.resubmitBufferedCheckpoint(rootChain)
+			}
+		}
+		return nil, errors.New("checkpoint processor is not running")
+	}))
+
+	s.HandleAdmin(AdminNoAckMetricsPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			scheduler.WriteAdminError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		for _, processor := range processorService.processors {
+			if np, ok := processor.(*NoAckProcessor); ok {
+				w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+				_ = WriteNoAckMetrics(w, np.Metrics())
+				return
+			}
+		}
+		scheduler.WriteAdminError(w, http.StatusNotFound, errors.New("no-ack processor is not running"))
+	})
+
+	s.HandleAdmin(AdminQueueFlushPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			scheduler.WriteAdminError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		purged, err := queue.PurgeQueue(helper.GetConfig().AmqpURL)
+		if err != nil {
+			scheduler.WriteAdminError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		processorService.Logger.Info("Job queue flushed by admin", "tasks", purged)
+		scheduler.WriteAdminResponse(w, http.StatusOK, map[string]int{"purged_tasks": purged})
+	})
+}
+
+// adminRootChainHandler applies action to root chain named by root param
+func adminRootChainHandler(action func(rootChain string) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			scheduler.WriteAdminError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		rootChain := r.URL.Query().Get("root")
+		if hmTypes.GetRootChainID(rootChain) == 0 {
+			scheduler.WriteAdminError(w, http.StatusBadRequest, fmt.Errorf("'%s' is not a valid rootChain", rootChain))
+			return
+		}
+
+		res, err := action(rootChain)
+		if err != nil {
+			scheduler.WriteAdminError(w, http.StatusInternalServerError, err)
+			return
+		}
+		scheduler.WriteAdminResponse(w, http.StatusOK, res)
+	}
+}
@@ -1,6 +1,8 @@
 package processor
 
 import (
+	"reflect"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,6 +25,8 @@ type Processor interface {
 
 	String() string
 
+	Status() ProcessorStatus
+
 	Stop()
 }
 
@@ -51,6 +55,12 @@ type BaseProcessor struct {
 
 	// storage client
 	storageClient *leveldb.DB
+
+	// guard against double submission by redundant bridge instances
+	dutyLock util.DutyLock
+
+	// last errors of tasks, served by admin endpoint
+	taskErrors *taskErrorLog
 }
 
 // NewBaseProcessor creates a new BaseProcessor.
@@ -84,6 +94,8 @@ func NewBaseProcessor(cdc *codec.Codec, queueConnector *queue.QueueConnector, ht
 		txBroadcaster:     txBroadcaster,
 		httpClient:        httpClient,
 		storageClient:     util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+		dutyLock:          util.GetDutyLock(),
+		taskErrors:        newTaskErrorLog(maxTaskErrors),
 	}
 }
 
@@ -92,6 +104,42 @@ func (bp *BaseProcessor) String() string {
 	return bp.name
 }
 
+// registerTask registers task with machinery, errors returned by task are kept for admin endpoint
+func (bp *BaseProcessor) registerTask(name string, taskFunc interface{}) error {
+	fn := reflect.ValueOf(taskFunc)
+	if fn.Kind() != reflect.Func || fn.Type().IsVariadic() || fn.Type().NumOut() == 0 {
+		return bp.queueConnector.Server.RegisterTask(name, taskFunc)
+	}
+
+	wrapped := reflect.MakeFunc(fn.Type(), func(args []reflect.Value) []reflect.Value {
+		results := fn.Call(args)
+		if err, ok := results[len(results)-1].Interface().(error); ok && err != nil {
+			bp.taskErrors.add(name, err)
+		}
+		return results
+	})
+
+	return bp.queueConnector.Server.RegisterTask(name, wrapped.Interface())
+}
+
+// Status returns name and last task errors of processor
+func (bp *BaseProcessor) Status() ProcessorStatus {
+	return ProcessorStatus{
+		Name:       bp.name,
+		LastErrors: bp.taskErrors.list(),
+	}
+}
+
+// isDutyHolder returns true if this bridge instance should perform duty
+func (bp *BaseProcessor) isDutyHolder(duty string) bool {
+	if bp.dutyLock.IsHolder(duty) {
+		return true
+	}
+
+	bp.Logger.Info("Duty is held by another bridge instance. Ignoring", "duty", duty)
+	return false
+}
+
 // OnStop stops all necessary go routines
 func (bp *BaseProcessor) Stop() {
 	// override to stop any go-routines in individual processors
@@ -12,12 +12,16 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/RichardKnop/machinery/v1/tasks"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/notifier"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
+	"github.com/maticnetwork/heimdall/bridge/setu/txmanager"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
@@ -38,6 +42,12 @@ type CheckpointProcessor struct {
 	// Rootchain abi
 	rootchainAbi   *abi.ABI
 	stakingInfoAbi *abi.ABI
+
+	// tx managers of root chains, checkpoints are submitted directly if root chain has none
+	txManagers map[string]*txmanager.TxManager
+
+	// notifier of bor nodes, nil if bor nodes aren't notified of acked checkpoints
+	finalityNotifier *notifier.FinalityNotifier
 }
 
 // Result represents single req result
@@ -74,44 +84,44 @@ func (cp *CheckpointProcessor) Start() error {
 // RegisterTasks - Registers checkpoint related tasks with machinery
 func (cp *CheckpointProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering checkpoint tasks")
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
+	if err := cp.registerTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToHeimdall", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
+	if err := cp.registerTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToRootchain", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
+	if err := cp.registerTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointAckToHeimdall", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointSyncToStakeChain", cp.sendCheckpointSyncToStakeChain); err != nil {
+	if err := cp.registerTask("sendCheckpointSyncToStakeChain", cp.sendCheckpointSyncToStakeChain); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointSyncToStakeChain", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointSyncAckToHeimdall", cp.sendCheckpointSyncAckToHeimdall); err != nil {
+	if err := cp.registerTask("sendCheckpointSyncAckToHeimdall", cp.sendCheckpointSyncAckToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointSyncAckToHeimdall", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendAddNewChainToHeimdall", cp.sendAddNewChainToHeimdall); err != nil {
+	if err := cp.registerTask("sendAddNewChainToHeimdall", cp.sendAddNewChainToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendAddNewChainToHeimdall", "error", err)
 	}
+	if cp.finalityNotifier != nil {
+		if err := cp.registerTask("notifyCheckpointFinality", cp.notifyCheckpointFinality); err != nil {
+			cp.Logger.Error("RegisterTasks | notifyCheckpointFinality", "error", err)
+		}
+	}
 }
 
 func (cp *CheckpointProcessor) startPollingForNoAck(ctx context.Context, interval time.Duration) {
-	ticker := time.NewTicker(interval)
-	ticker1 := time.NewTicker(helper.GetConfig().CheckpointerPollInterval)
-	// stop ticker when everything done
-	defer ticker.Stop()
-	defer ticker1.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			go cp.handleCheckpointNoAck()
-		case <-ticker1.C:
-			go cp.handleCheckpointSync()
-		case <-ctx.Done():
-			cp.Logger.Info("No-ack Polling stopped")
-			ticker.Stop()
-			return
-		}
-	}
+	go scheduler.GetScheduler().Run(ctx, scheduler.Task{
+		Name:     "checkpoint-sync",
+		Interval: helper.GetConfig().CheckpointerPollInterval,
+		Run:      func(context.Context) { go cp.handleCheckpointSync() },
+	})
+
+	scheduler.GetScheduler().Run(ctx, scheduler.Task{
+		Name:     "checkpoint-no-ack",
+		Interval: interval,
+		Run:      func(context.Context) { go cp.handleCheckpointNoAck() },
+	})
+	cp.Logger.Info("No-ack Polling stopped")
 }
 
 // sendCheckpointToHeimdall - handles headerblock from maticchain
@@ -132,6 +142,50 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 		return err
 	}
 
+	// fallback to standby slot if primary proposer's grace window has elapsed
+	if !isProposer {
+		if isProposer, err = util.IsEligibleStandbyProposer(cp.cliCtx); err != nil {
+			cp.Logger.Error("Error checking standby proposer in HeaderBlock handler", "error", err)
+			return err
+		}
+
+		if isProposer {
+			cp.Logger.Info("Proposing checkpoint as standby proposer", "headerNumber", header.Number)
+		}
+	}
+
+	// in aggregation mode next proposers submit same range too
+	if !isProposer {
+		if isProposer, err = util.IsAggregationProposer(cp.cliCtx); err != nil {
+			cp.Logger.Error("Error checking aggregation proposer in HeaderBlock handler", "error", err)
+			return err
+		}
+
+		if isProposer {
+			cp.Logger.Info("Proposing checkpoint as aggregation proposer", "headerNumber", header.Number)
+		}
+	}
+
+	// backup proposers resubmit checkpoint whose buffer expired without ack
+	for root := range hmTypes.GetRootChainIDMap() {
+		if isProposer {
+			break
+		}
+
+		if isProposer, err = util.IsBackupProposer(cp.cliCtx, root); err != nil {
+			cp.Logger.Error("Error checking backup proposer in HeaderBlock handler", "root", root, "error", err)
+			return err
+		}
+
+		if isProposer {
+			cp.Logger.Info("Proposing expired checkpoint as backup proposer", "headerNumber", header.Number, "root", root)
+		}
+	}
+
+	if isProposer && !cp.isDutyHolder(util.DutyCheckpoint) {
+		return nil
+	}
+
 	if isProposer {
 		// fetch checkpoint context
 		checkpointContext, err := cp.getCheckpointContext(hmTypes.RootChainTypeEth)
@@ -148,10 +202,17 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 			return errors.New("no of blocks on childchain is less than confirmations required")
 		}
 
-		// tron send tron checkpoint
-		go cp.sendTronCheckpointToHeimdall(checkpointContext, latestConfirmedChildBlock)
+		// tron send tron checkpoint, with tron context as child block interval differs per root chain
+		if tronCheckpointContext, err := cp.getCheckpointContext(hmTypes.RootChainTypeTron); err == nil {
+			go cp.sendTronCheckpointToHeimdall(tronCheckpointContext, latestConfirmedChildBlock)
+		}
 
 		for _, root := range []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc} {
+			if util.IsRootChainPaused(root) {
+				cp.Logger.Debug("Root chain pipeline is paused", "root", root)
+				continue
+			}
+
 			activationHeight := cp.getCheckpointActivationHeight(cp.cliCtx, root)
 			if root != hmTypes.RootChainTypeEth {
 				if activationHeight == 0 || latestConfirmedChildBlock < activationHeight {
@@ -181,20 +242,17 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 			//
 			// Check checkpoint buffer
 			//
-			timeStamp := uint64(time.Now().Unix())
-			checkpointBufferTime := uint64(checkpointContext.CheckpointParams.CheckpointBufferTime.Seconds())
-
-			bufferedCheckpoint, err := util.GetBufferedCheckpoint(cp.cliCtx, root)
-			if err != nil {
-				cp.Logger.Debug("No buffered checkpoint", "root", root, "bufferedCheckpoint", bufferedCheckpoint)
+			queuedStart, queuedEnd, ok := cp.nextQueuedCheckpoint(checkpointContext, latestConfirmedChildBlock, root)
+			if !ok {
+				continue
 			}
 
-			if bufferedCheckpoint != nil && !(bufferedCheckpoint.TimeStamp == 0 || ((timeStamp > bufferedCheckpoint.TimeStamp) && timeStamp-bufferedCheckpoint.TimeStamp >= checkpointBufferTime)) {
-				cp.Logger.Info("Checkpoint already exits in buffer", "root", root, "Checkpoint", bufferedCheckpoint.String())
-				continue
+			queued := queuedStart != 0
+			if queued {
+				start, end = queuedStart, queuedEnd
 			}
 
-			if err := cp.createAndSendCheckpointToHeimdall(checkpointContext, start, end, root); err != nil {
+			if err := cp.createAndSendCheckpointToHeimdall(checkpointContext, start, end, root, queued); err != nil {
 				cp.Logger.Error("Error sending checkpoint to heimdall", "root", root, "error", err)
 				continue
 			}
@@ -252,12 +310,35 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 		}
 	}
 
+	if util.IsRootChainPaused(rootChain) {
+		cp.Logger.Info("Root chain pipeline is paused. Ignoring", "root", rootChain, "eventType", event.Type)
+		return nil
+	}
+
+	// kept for forced resubmission from admin endpoint
+	cp.storeCheckpointEvent(rootChain, checkpointEvent{StartBlock: startBlock, EndBlock: endBlock, TxHash: txHash, Height: blockHeight})
+
+	// every validator with registered BLS key votes for buffered checkpoint, proposer included
+	if err := cp.sendBlsVoteToHeimdall(rootChain, startBlock, endBlock); err != nil {
+		cp.Logger.Error("Error sending BLS vote of checkpoint to heimdall", "root", rootChain, "error", err)
+	}
+
 	isCurrentProposer := bytes.Equal(common.FromHex(proposer), helper.GetAddress())
 	if !isCurrentProposer {
 		cp.Logger.Info("I am not the current proposer. Ignoring", "root", rootChain, "eventType", event.Type)
 		return nil
 	}
 
+	if !cp.isDutyHolder(util.DutyCheckpointToRootchain) {
+		return nil
+	}
+
+	if helper.GetConfig().CheckpointAttestationEnabled {
+		if err := cp.sendAttestationToHeimdall(rootChain, startBlock, endBlock); err != nil {
+			cp.Logger.Error("Error sending checkpoint attestation to heimdall", "root", rootChain, "error", err)
+		}
+	}
+
 	checkpointContext, err := cp.getCheckpointContext(rootChain)
 	if err != nil {
 		return err
@@ -299,6 +380,84 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 	return nil
 }
 
+// sendBlsVoteToHeimdall signs buffered checkpoint with BLS key of validator and broadcasts vote,
+// so its signature is aggregated with signatures of other validators
+func (cp *CheckpointProcessor) sendBlsVoteToHeimdall(rootChain string, startBlock uint64, endBlock uint64) error {
+	validator, err := util.GetBlsVoter(cp.cliCtx)
+	if err != nil || validator == nil {
+		return err
+	}
+
+	// voter has local key matching registered one
+	sk, err := helper.GetBlsPrivKey()
+	if err != nil {
+		return err
+	}
+
+	queue, err := util.GetCheckpointBufferQueue(cp.cliCtx, rootChain)
+	if err != nil {
+		return err
+	}
+
+	for _, checkpoint := range queue.Checkpoints {
+		if checkpoint.StartBlock != startBlock || checkpoint.EndBlock != endBlock {
+			continue
+		}
+
+		signature := helper.BlsSign(sk, checkpointTypes.CheckpointBlsSignBytes(rootChain, checkpoint))
+		msg := checkpointTypes.NewMsgCheckpointBlsVote(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			checkpoint.StartBlock,
+			checkpoint.EndBlock,
+			checkpoint.RootHash,
+			rootChain,
+			signature,
+		)
+
+		cp.Logger.Info("✅ Sending BLS vote of checkpoint to heimdall", "root", rootChain, "validator", validator.ID, "start", startBlock, "end", endBlock)
+		return cp.txBroadcaster.BroadcastToHeimdall(msg)
+	}
+
+	cp.Logger.Debug("Checkpoint is not buffered anymore, skipping BLS vote", "root", rootChain, "start", startBlock, "end", endBlock)
+	return nil
+}
+
+// sendAttestationToHeimdall commits to merkle root of hashes of bor blocks covered by buffered checkpoint,
+// so light clients can audit blocks of checkpoint without replaying bor
+func (cp *CheckpointProcessor) sendAttestationToHeimdall(rootChain string, startBlock uint64, endBlock uint64) error {
+	queue, err := util.GetCheckpointBufferQueue(cp.cliCtx, rootChain)
+	if err != nil {
+		return err
+	}
+
+	for _, checkpoint := range queue.Checkpoints {
+		if checkpoint.StartBlock != startBlock || checkpoint.EndBlock != endBlock {
+			continue
+		}
+
+		blockHashesRoot, err := checkpointTypes.FetchBlockHashesRoot(context.Background(), helper.GetMaticRPCClient(), startBlock, endBlock, 0, nil)
+		if err != nil {
+			return err
+		}
+
+		msg := checkpointTypes.NewMsgCheckpointAttestation(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			checkpoint.StartBlock,
+			checkpoint.EndBlock,
+			checkpoint.RootHash,
+			rootChain,
+			hmTypes.BytesToHeimdallHash(blockHashesRoot),
+			"",
+		)
+
+		cp.Logger.Info("✅ Sending checkpoint attestation to heimdall", "root", rootChain, "start", startBlock, "end", endBlock, "blockHashesRoot", hex.EncodeToString(blockHashesRoot))
+		return cp.txBroadcaster.BroadcastToHeimdall(msg)
+	}
+
+	cp.Logger.Debug("Checkpoint is not buffered anymore, skipping attestation", "root", rootChain, "start", startBlock, "end", endBlock)
+	return nil
+}
+
 // sendCheckpointAckToHeimdall - handles checkpointAck event from rootchain
 // 1. create and broadcast checkpointAck msg to heimdall.
 func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, checkpointAckStr string, rootChain string) error {
@@ -318,7 +477,7 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 	if err := helper.UnpackLog(cp.rootchainAbi, event, eventName, &log); err != nil {
 		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
-		checkpointNumber := big.NewInt(0).Div(event.HeaderBlockId, big.NewInt(0).SetUint64(checkpointContext.CheckpointParams.ChildBlockInterval))
+		checkpointNumber := big.NewInt(0).Div(event.HeaderBlockId, big.NewInt(0).SetUint64(checkpointContext.ChainmanagerParams.ChildBlockInterval))
 
 		cp.Logger.Info(
 			"✅ Received task to send checkpoint-ack to heimdall",
@@ -342,6 +501,16 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 			return nil
 		}
 
+		if !cp.isDutyHolder(util.DutyCheckpointAck) {
+			return nil
+		}
+
+		// ack is retried once grace period has passed, unless proposer acked it by then
+		if wait := cp.ackGraceWait(rootChain, hmTypes.BytesToHeimdallAddress(event.Proposer.Bytes())); wait > 0 {
+			cp.Logger.Info("Waiting for checkpoint proposer to send checkpoint-ack", "root", rootChain, "checkpointNumber", checkpointNumber, "wait", wait)
+			return tasks.NewErrRetryTaskLater("checkpoint-ack grace period of proposer", wait)
+		}
+
 		// create msg checkpoint ack message
 		msg := checkpointTypes.NewMsgCheckpointAck(
 			helper.GetFromAddress(cp.cliCtx),
@@ -364,11 +533,39 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 	return nil
 }
 
+// ackGraceWait returns time left before node may ack buffered checkpoint of root chain. Proposers of
+// checkpoint, on heimdall or on root chain, may ack it right away, other validators once grace period has passed.
+func (cp *CheckpointProcessor) ackGraceWait(rootChain string, submitter hmTypes.HeimdallAddress) time.Duration {
+	gracePeriod, err := util.GetAckGracePeriod(cp.cliCtx)
+	if err != nil || gracePeriod <= 0 {
+		return 0
+	}
+
+	self := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
+	if submitter.Equals(self) {
+		return 0
+	}
+
+	// sender of ack is checked against buffered checkpoint only
+	buffer, err := util.GetBufferedCheckpoint(cp.cliCtx, rootChain)
+	if err != nil || buffer.Proposer.Equals(self) {
+		return 0
+	}
+
+	graceEnd := time.Unix(int64(checkpointTypes.AckGraceEnd(*buffer, gracePeriod)), 0)
+	return time.Until(graceEnd)
+}
+
 // handleCheckpointNoAck - Checkpoint No-Ack handler
 // 1. Fetch latest checkpoint time from rootchain
 // 2. check if elapsed time is more than NoAck Wait time.
 // 3. Send NoAck to heimdall if required.
 func (cp *CheckpointProcessor) handleCheckpointNoAck() {
+	// no-acks are sent by no-ack processor once buffer expired
+	if helper.GetConfig().AutoNoAckEnabled {
+		return
+	}
+
 	// fetch fresh checkpoint context
 	checkpointContext, err := cp.getCheckpointContext(hmTypes.RootChainTypeStake)
 	if err != nil {
@@ -392,7 +589,7 @@ func (cp *CheckpointProcessor) handleCheckpointNoAck() {
 		}
 
 		// if i am the proposer and NoAck is required, then propose No-Ack
-		if isProposer {
+		if isProposer && cp.isDutyHolder(util.DutyCheckpointNoAck) {
 			// send Checkpoint No-Ack to heimdall
 			if err := cp.proposeCheckpointNoAck(); err != nil {
 				cp.Logger.Error("Error proposing Checkpoint No-Ack ", "error", err)
@@ -406,6 +603,7 @@ func (cp *CheckpointProcessor) handleCheckpointNoAck() {
 func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *CheckpointContext, latestChildBlock uint64, rootChain string) (*ContractCheckpoint, error) {
 	chainmanagerParams := checkpointContext.ChainmanagerParams
 	checkpointParams := checkpointContext.CheckpointParams
+	childBlockInterval := checkpointContext.ChainmanagerParams.ChildBlockInterval
 
 	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chainmanagerParams.ChainParams.RootChainAddress.EthAddress(), rootChain)
 	if err != nil {
@@ -413,7 +611,7 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *Checkpo
 	}
 
 	// fetch current header block from mainchain contract
-	_currentHeaderBlock, err := cp.contractConnector.CurrentHeaderBlock(rootChainInstance, checkpointParams.ChildBlockInterval)
+	_currentHeaderBlock, err := cp.contractConnector.CurrentHeaderBlock(rootChainInstance, childBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number from rootchain", "root", rootChain, "error", err)
 		return nil, err
@@ -423,7 +621,7 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *Checkpo
 	currentHeaderBlockNumber := big.NewInt(0).SetUint64(_currentHeaderBlock)
 
 	// get header info
-	_, currentStart, currentEnd, lastCheckpointTime, _, err := cp.contractConnector.GetHeaderInfo(currentHeaderBlockNumber.Uint64(), rootChainInstance, checkpointParams.ChildBlockInterval)
+	_, currentStart, currentEnd, lastCheckpointTime, _, err := cp.contractConnector.GetHeaderInfo(currentHeaderBlockNumber.Uint64(), rootChainInstance, childBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block object from rootchain", "root", rootChain, "error", err)
 		return nil, err
@@ -490,7 +688,8 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *Checkpo
 }
 
 // sendCheckpointToHeimdall - creates checkpoint msg and broadcasts to heimdall
-func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointContext *CheckpointContext, start, end uint64, rootChain string) error {
+// queued checkpoint follows checkpoints in buffer instead of last acked one.
+func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointContext *CheckpointContext, start, end uint64, rootChain string, queued bool) error {
 	cp.Logger.Debug("Initiating checkpoint to Heimdall", "root", rootChain, "start", start, "end", end)
 
 	if end == 0 || start >= end {
@@ -500,7 +699,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointConte
 	// fetch latest checkpoint
 	latestCheckpoint, err := util.GetlastestCheckpoint(cp.cliCtx, rootChain)
 	// event checkpoint is older than or equal to latest checkpoint
-	if err == nil && latestCheckpoint != nil && latestCheckpoint.EndBlock+1 < start {
+	if !queued && err == nil && latestCheckpoint != nil && latestCheckpoint.EndBlock+1 < start {
 		cp.Logger.Debug("Need to resubmit Checkpoint ack first", "start", start, "last_end", latestCheckpoint.EndBlock)
 		err := cp.resubmitCheckpointAck(checkpointContext, rootChain)
 		if err != nil {
@@ -512,9 +711,10 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointConte
 
 	// get checkpoint params
 	checkpointParams := checkpointContext.CheckpointParams
+	chainParams := checkpointContext.ChainmanagerParams.ChainParams
 
 	// Get root hash
-	root, err := cp.contractConnector.GetRootHash(start, end, checkpointParams.MaxCheckpointLength)
+	root, err := cp.getRootHash(chainParams.BorChainID, start, end, checkpointParams.MaxCheckpointLength)
 	if err != nil {
 		return err
 	}
@@ -526,8 +726,6 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointConte
 		return err
 	}
 
-	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-
 	cp.Logger.Info("✅ Creating and broadcasting new checkpoint",
 		"proposer", hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
 		"start", start,
@@ -560,6 +758,26 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointConte
 	return nil
 }
 
+// getRootHash computes root hash of child chain blocks [start, end] with root hash algorithm of chain.
+// Bor serves it over rpc, root of other child chains is computed from their headers.
+func (cp *CheckpointProcessor) getRootHash(chainID string, start uint64, end uint64, checkpointLength uint64) ([]byte, error) {
+	builder, err := util.GetRootHashBuilder(cp.cliCtx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if builder.Name() == checkpointTypes.RootHashAlgorithmBor {
+		return cp.contractConnector.GetRootHash(start, end, checkpointLength)
+	}
+
+	headers, err := cp.contractConnector.GetBorHeadersBatch(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkpointTypes.BuildRootHash(builder, headers), nil
+}
+
 // createAndSendCheckpointToRootchain prepares the data required for rootchain checkpoint submission
 // and sends a transaction to rootchain
 func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(
@@ -606,6 +824,24 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(
 	if shouldSend {
 		// chain manager params
 		chainParams := checkpointContext.ChainmanagerParams.ChainParams
+
+		if txManager, ok := cp.txManagers[rootChain]; ok {
+			data, err := cp.rootchainAbi.Pack("submitCheckpoint", sideTxData, sigs)
+			if err != nil {
+				cp.Logger.Error("Unable to pack tx for submitCheckpoint", "error", err)
+				return err
+			}
+
+			submitTxHash, err := txManager.Submit(context.Background(), fmt.Sprintf("checkpoint-%v-%v", start, end), chainParams.RootChainAddress.EthAddress(), data)
+			if err != nil {
+				cp.Logger.Info("Error submitting checkpoint to rootchain through tx manager", "root", rootChain, "error", err)
+				return err
+			}
+
+			cp.Logger.Info("Checkpoint submitted through tx manager", "root", rootChain, "start", start, "end", end, "txHash", submitTxHash.Hex())
+			return nil
+		}
+
 		// root chain instance
 		rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chainParams.RootChainAddress.EthAddress(), rootChain)
 		if err != nil {
@@ -624,7 +860,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(
 // fetchDividendAccountRoot - fetches dividend accountroothash
 func (cp *CheckpointProcessor) fetchDividendAccountRoot() (accountroothash hmTypes.HeimdallHash, err error) {
 	cp.Logger.Info("Sending Rest call to Get Dividend AccountRootHash")
-	response, err := helper.FetchFromAPI(cp.cliCtx, helper.GetHeimdallServerEndpoint(util.DividendAccountRootURL))
+	response, err := helper.FetchFromAPI(cp.cliCtx, helper.GetHeimdallServerEndpoint(util.CheckpointAccountRootURL))
 	if err != nil {
 		cp.Logger.Error("Error Fetching accountroothash from HeimdallServer ", "error", err)
 		return accountroothash, err
@@ -641,7 +877,7 @@ func (cp *CheckpointProcessor) fetchDividendAccountRoot() (accountroothash hmTyp
 func (cp *CheckpointProcessor) resubmitCheckpointAck(checkpointContext *CheckpointContext, rootChain string) error {
 	// get chain params
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	checkpointParams := checkpointContext.CheckpointParams
+	childBlockInterval := checkpointContext.ChainmanagerParams.ChildBlockInterval
 
 	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chainParams.RootChainAddress.EthAddress(), rootChain)
 	if err != nil {
@@ -649,14 +885,14 @@ func (cp *CheckpointProcessor) resubmitCheckpointAck(checkpointContext *Checkpoi
 	}
 
 	// fetch last header number
-	lastHeaderNumber, err := cp.contractConnector.CurrentHeaderBlock(rootChainInstance, checkpointParams.ChildBlockInterval)
+	lastHeaderNumber, err := cp.contractConnector.CurrentHeaderBlock(rootChainInstance, childBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number", "error", err)
 		return err
 	}
 
 	// header block
-	root, start, end, _, proposer, err := cp.contractConnector.GetHeaderInfo(lastHeaderNumber, rootChainInstance, checkpointParams.ChildBlockInterval)
+	root, start, end, _, proposer, err := cp.contractConnector.GetHeaderInfo(lastHeaderNumber, rootChainInstance, childBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching header block object", "error", err)
 		return err
@@ -846,6 +1082,9 @@ func (cp *CheckpointProcessor) shouldSendCheckpoint(checkpointContext *Checkpoin
 		cp.Logger.Info("Start block does not match, checkpoint already sent", "commitedLastBlock", currentChildBlock, "startBlock", start)
 	} else if currentChildBlock > end {
 		cp.Logger.Info("Checkpoint already sent", "commitedLastBlock", currentChildBlock, "startBlock", start)
+	} else if cp.isQueuedCheckpoint(rootChain, start) {
+		cp.Logger.Info("Checkpoint is queued behind unacked checkpoint, retrying later", "commitedLastBlock", currentChildBlock, "startBlock", start)
+		return false, tasks.NewErrRetryTaskLater("checkpoint queued behind unacked checkpoint", util.RetryTaskDelay)
 	} else {
 		cp.Logger.Info("No need to send checkpoint")
 	}
@@ -923,6 +1162,10 @@ func (cp *CheckpointProcessor) sendAddNewChainToHeimdall(eventName string, newCh
 func (cp *CheckpointProcessor) Stop() {
 	// cancel No-Ack polling
 	cp.cancelNoACKPolling()
+
+	if cp.finalityNotifier != nil {
+		cp.finalityNotifier.Close()
+	}
 }
 
 //
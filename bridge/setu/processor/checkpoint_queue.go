@@ -0,0 +1,71 @@
+package processor
+
+import (
+	"time"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// nextQueuedCheckpoint returns range of checkpoint which can be queued behind checkpoints waiting for ack in buffer.
+// ok is false if checkpoint can't be proposed now. start is 0 if buffer is empty or expired, in which case
+// next checkpoint follows root chain contract.
+func (cp *CheckpointProcessor) nextQueuedCheckpoint(checkpointContext *CheckpointContext, latestChildBlock uint64, rootChain string) (start, end uint64, ok bool) {
+	queue, err := util.GetCheckpointBufferQueue(cp.cliCtx, rootChain)
+	if err != nil || len(queue.Checkpoints) == 0 {
+		return 0, 0, true
+	}
+
+	checkpointParams := checkpointContext.CheckpointParams
+
+	// expired buffer is flushed by next checkpoint
+	head := queue.Checkpoints[0]
+	timeStamp := uint64(time.Now().Unix())
+	checkpointBufferTime := uint64(checkpointParams.CheckpointBufferTime.Seconds())
+	if head.TimeStamp == 0 || ((timeStamp > head.TimeStamp) && timeStamp-head.TimeStamp >= checkpointBufferTime) {
+		return 0, 0, true
+	}
+
+	if queue.IsFull() {
+		cp.Logger.Info("Checkpoint already exits in buffer", "root", rootChain, "Checkpoint", head.String(), "queued", len(queue.Checkpoints))
+		return 0, 0, false
+	}
+
+	tail, _ := queue.Tail()
+	start = tail.EndBlock + 1
+	if latestChildBlock < start {
+		return 0, 0, false
+	}
+
+	// queued checkpoints are never force pushed, wait for full length
+	diff := latestChildBlock - start + 1
+	if diff < checkpointParams.AvgCheckpointLength {
+		cp.Logger.Debug("Waiting for blocks to queue checkpoint", "root", rootChain, "start", start, "latest", latestChildBlock)
+		return 0, 0, false
+	}
+
+	expectedDiff := diff - diff%checkpointParams.AvgCheckpointLength - 1
+	if expectedDiff > checkpointParams.MaxCheckpointLength-1 {
+		expectedDiff = checkpointParams.MaxCheckpointLength - 1
+	}
+
+	end = start + expectedDiff
+	cp.Logger.Debug("Queueing checkpoint behind buffered ones", "root", rootChain, "start", start, "end", end, "queued", len(queue.Checkpoints))
+
+	return start, end, true
+}
+
+// isQueuedCheckpoint checks if checkpoint starting at start block waits behind buffer head
+func (cp *CheckpointProcessor) isQueuedCheckpoint(rootChain string, start uint64) bool {
+	queue, err := util.GetCheckpointBufferQueue(cp.cliCtx, rootChain)
+	if err != nil {
+		return false
+	}
+
+	for i := 1; i < len(queue.Checkpoints); i++ {
+		if queue.Checkpoints[i].StartBlock == start {
+			return true
+		}
+	}
+
+	return false
+}
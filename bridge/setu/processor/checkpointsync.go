@@ -214,7 +214,7 @@ func (cp *CheckpointProcessor) sendCheckpointSyncAckToHeimdall(eventName string,
 func (cp *CheckpointProcessor) getHeaderBlock(checkpointContext *CheckpointContext, headerNumber uint64, rootChain string) (start, end uint64, proposer hmTypes.HeimdallAddress, err error) {
 	// get chain params
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	checkpointParams := checkpointContext.CheckpointParams
+	childBlockInterval := checkpointContext.ChainmanagerParams.ChildBlockInterval
 
 	switch rootChain {
 	case hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc:
@@ -222,14 +222,14 @@ func (cp *CheckpointProcessor) getHeaderBlock(checkpointContext *CheckpointConte
 		if err != nil {
 			return 0, 0, hmTypes.ZeroHeimdallAddress, err
 		}
-		_, start, end, _, proposer, err := cp.contractConnector.GetHeaderInfo(headerNumber, rootChainInstance, checkpointParams.ChildBlockInterval)
+		_, start, end, _, proposer, err := cp.contractConnector.GetHeaderInfo(headerNumber, rootChainInstance, childBlockInterval)
 		if err != nil {
 			cp.Logger.Error("Error while fetching header block", "root", rootChain, "error", err)
 			return 0, 0, hmTypes.ZeroHeimdallAddress, err
 		}
 		return start, end, proposer, nil
 	case hmTypes.RootChainTypeTron:
-		_, start, end, _, proposer, err := cp.contractConnector.GetTronHeaderInfo(headerNumber, chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+		_, start, end, _, proposer, err := cp.contractConnector.GetTronHeaderInfo(headerNumber, chainParams.TronChainAddress.Hex(), childBlockInterval)
 
 		if err != nil {
 			cp.Logger.Error("Error while fetching header block", "root", rootChain, "error", err)
@@ -243,7 +243,7 @@ func (cp *CheckpointProcessor) getHeaderBlock(checkpointContext *CheckpointConte
 // getLastSyncedCheckpointNumber - get last checkpoint header number from stake chain
 func (cp *CheckpointProcessor) getLastSyncedCheckpointNumber(checkpointContext *CheckpointContext, rootChain string) (uint64, error) {
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	syncedHeaderNumber, err := cp.contractConnector.GetSyncedCheckpointId(chainParams.TronStakingManagerAddress, rootChain)
+	syncedHeaderNumber, err := cp.contractConnector.GetSyncedCheckpointId(chainParams.TronStakingManagerAddress.Hex(), rootChain)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current synced header block number from stake chain", "error", err)
 		return 0, err
@@ -291,7 +291,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointSyncToTron(
 
 	// chain manager params
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	err = cp.contractConnector.SendCheckpointSyncToTron(sideTxData, sigs, chainParams.TronStakingManagerAddress)
+	err = cp.contractConnector.SendCheckpointSyncToTron(sideTxData, sigs, chainParams.TronStakingManagerAddress.Hex())
 	if err != nil {
 		cp.Logger.Error("Error submitting checkpoint sync to tron", "error", err)
 		return err
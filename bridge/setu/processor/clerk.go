@@ -1,12 +1,15 @@
 package processor
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
+	"time"
 
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
@@ -20,10 +23,16 @@ type ClerkContext struct {
 	ChainmanagerParams *chainmanagerTypes.Params
 }
 
+// minStateSyncAckRecords records processed by bor since last ack before new ack is sent
+const minStateSyncAckRecords = 100
+
 // ClerkProcessor - sync state/deposit events
 type ClerkProcessor struct {
 	BaseProcessor
 	stateSenderAbi *abi.ABI
+
+	// state sync ack polling subscription
+	cancelAckService context.CancelFunc
 }
 
 // NewClerkProcessor - add statesender abi to clerk processor
@@ -37,13 +46,28 @@ func NewClerkProcessor(stateSenderAbi *abi.ABI) *ClerkProcessor {
 // Start starts new block subscription
 func (cp *ClerkProcessor) Start() error {
 	cp.Logger.Info("Starting")
+
+	// create cancellable context
+	ackCtx, cancelAckService := context.WithCancel(context.Background())
+
+	cp.cancelAckService = cancelAckService
+
+	// start polling for records processed by bor
+	cp.Logger.Info("Start polling for state sync ack", "pollInterval", helper.GetConfig().ClerkPollInterval)
+	go cp.startPolling(ackCtx, helper.GetConfig().ClerkPollInterval)
 	return nil
 }
 
+// Stop stops all necessary go routines
+func (cp *ClerkProcessor) Stop() {
+	// cancel ack polling
+	cp.cancelAckService()
+}
+
 // RegisterTasks - Registers clerk related tasks with machinery
 func (cp *ClerkProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering clerk tasks")
-	if err := cp.queueConnector.Server.RegisterTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
+	if err := cp.registerTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendStateSyncedToHeimdall", "error", err)
 	}
 }
@@ -148,10 +172,84 @@ func (cp *ClerkProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, l
 	return status, nil
 }
 
+// startPolling - polls bor and acknowledges records it processed, so heimdall can prune them
+func (cp *ClerkProcessor) startPolling(ctx context.Context, interval time.Duration) {
+	scheduler.GetScheduler().Run(ctx, scheduler.Task{
+		Name:     "clerk-ack",
+		Interval: interval,
+		Run:      func(context.Context) { cp.checkAndAck() },
+	})
+	cp.Logger.Info("Polling stopped")
+}
+
+// checkAndAck - sends ack of last record processed by bor if current user is proposer
+func (cp *ClerkProcessor) checkAndAck() {
+	if isProposer, err := util.IsProposer(cp.cliCtx); err != nil || !isProposer {
+		return
+	}
+
+	status, err := cp.getPruneStatus()
+	if err != nil {
+		return
+	}
+
+	clerkContext, err := cp.getClerkContext()
+	if err != nil {
+		return
+	}
+	stateReceiverAddress := clerkContext.ChainmanagerParams.ChainParams.StateReceiverAddress.EthAddress()
+
+	// bor commits states in order, find last one committed by binary search
+	lo, hi := status.AckedID, status.LatestID
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		processed, err := cp.contractConnector.IsStateProcessed(mid, stateReceiverAddress)
+		if err != nil {
+			cp.Logger.Error("Unable to fetch state from bor", "stateID", mid, "error", err)
+			return
+		}
+
+		if processed {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	if lo < status.AckedID+minStateSyncAckRecords {
+		return
+	}
+
+	cp.Logger.Info("✅ Acknowledging state records processed by bor", "ackedID", status.AckedID, "stateID", lo)
+
+	msg := clerkTypes.NewMsgStateSyncAck(hmTypes.BytesToHeimdallAddress(helper.GetAddress()), lo)
+
+	// return broadcast to heimdall
+	if err := cp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+		cp.Logger.Error("Error while broadcasting state sync ack to heimdall", "stateID", lo, "error", err)
+	}
+}
+
 //
 // utils
 //
 
+func (cp *ClerkProcessor) getPruneStatus() (*clerkTypes.PruneStatus, error) {
+	res, err := helper.FetchFromAPI(cp.cliCtx, helper.GetHeimdallServerEndpoint(util.ClerkPruneStatusURL))
+	if err != nil {
+		cp.Logger.Error("Error fetching prune status", "url", util.ClerkPruneStatusURL, "error", err)
+		return nil, err
+	}
+
+	var status clerkTypes.PruneStatus
+	if err := json.Unmarshal(res.Result, &status); err != nil {
+		cp.Logger.Error("Error unmarshalling prune status received from Heimdall Server", "error", err)
+		return nil, err
+	}
+
+	return &status, nil
+}
+
 func (cp *ClerkProcessor) getClerkContext() (*ClerkContext, error) {
 	chainmanagerParams, err := util.GetChainmanagerParams(cp.cliCtx)
 	if err != nil {
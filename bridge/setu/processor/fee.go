@@ -7,6 +7,7 @@ import (
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/contracts/erc20"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
 	topupTypes "github.com/maticnetwork/heimdall/topup/types"
@@ -19,12 +20,14 @@ import (
 type FeeProcessor struct {
 	BaseProcessor
 	stakingInfoAbi *abi.ABI
+	erc20Abi       *abi.ABI
 }
 
 // NewFeeProcessor - add  abi to clerk processor
-func NewFeeProcessor(stakingInfoAbi *abi.ABI) *FeeProcessor {
+func NewFeeProcessor(stakingInfoAbi *abi.ABI, erc20Abi *abi.ABI) *FeeProcessor {
 	feeProcessor := &FeeProcessor{
 		stakingInfoAbi: stakingInfoAbi,
+		erc20Abi:       erc20Abi,
 	}
 	return feeProcessor
 }
@@ -38,9 +41,12 @@ func (fp *FeeProcessor) Start() error {
 // RegisterTasks - Registers clerk related tasks with machinery
 func (fp *FeeProcessor) RegisterTasks() {
 	fp.Logger.Info("Registering fee related tasks")
-	if err := fp.queueConnector.Server.RegisterTask("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
+	if err := fp.registerTask("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
 		fp.Logger.Error("RegisterTasks | sendTopUpFeeToHeimdall", "error", err)
 	}
+	if err := fp.registerTask("sendTopUpERC20ToHeimdall", fp.sendTopUpERC20ToHeimdall); err != nil {
+		fp.Logger.Error("RegisterTasks | sendTopUpERC20ToHeimdall", "error", err)
+	}
 }
 
 // processTopupFeeEvent - processes topup fee event
@@ -98,6 +104,66 @@ func (fp *FeeProcessor) sendTopUpFeeToHeimdall(eventName string, logBytes string
 	return nil
 }
 
+// sendTopUpERC20ToHeimdall - processes transfer of whitelisted erc20 fee token to its deposit address
+func (fp *FeeProcessor) sendTopUpERC20ToHeimdall(eventName string, logBytes string, rootChain string) error {
+	if rootChain != hmTypes.RootChainTypeStake {
+		fp.Logger.Error("There should be no messages from un-stake.", "root", rootChain)
+		return nil
+	}
+
+	var vLog = types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		fp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
+		return err
+	}
+
+	event := new(erc20.Erc20Transfer)
+	if err := helper.UnpackLog(fp.erc20Abi, event, eventName, &vLog); err != nil {
+		fp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+		return nil
+	}
+
+	// sequence is shared with native topups
+	if isOld, _ := fp.isOldTx(fp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld {
+		fp.Logger.Info("Ignoring task to send erc20 topup to heimdall as already processed",
+			"event", eventName,
+			"user", event.From,
+			"token", vLog.Address,
+			"amount", event.Value,
+			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+		return nil
+	}
+
+	fp.Logger.Info("✅ sending erc20 topup to heimdall",
+		"event", eventName,
+		"user", event.From,
+		"token", vLog.Address,
+		"amount", event.Value,
+		"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		"logIndex", uint64(vLog.Index),
+		"blockNumber", vLog.BlockNumber,
+	)
+
+	msg := topupTypes.NewMsgTopupERC20(helper.GetFromAddress(fp.cliCtx),
+		hmTypes.BytesToHeimdallAddress(event.From.Bytes()),
+		hmTypes.EthToTronAddress(vLog.Address),
+		sdk.NewIntFromBigInt(event.Value),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		vLog.BlockNumber)
+
+	// return broadcast to heimdall
+	if err := fp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+		fp.Logger.Error("Error while broadcasting TopupERC20 msg to heimdall", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 // isOldTx  checks if tx is already processed or not
 func (fp *FeeProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, logIndex uint64) (bool, error) {
 	queryParam := map[string]interface{}{
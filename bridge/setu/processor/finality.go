@@ -0,0 +1,64 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/notifier"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// notifyCheckpointFinality - handles checkpoint-ack event from heimdall.
+// Pushes blocks covered by acked checkpoint to configured bor nodes, every bridge notifies its own nodes.
+func (cp *CheckpointProcessor) notifyCheckpointFinality(eventBytes string, blockHeight int64) error {
+	var event = sdk.StringEvent{}
+	if err := json.Unmarshal([]byte(eventBytes), &event); err != nil {
+		cp.Logger.Error("Error unmarshalling event from heimdall", "error", err)
+		return err
+	}
+
+	var (
+		number    uint64
+		rootChain = hmTypes.RootChainTypeEth
+		accepted  = true
+	)
+
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case checkpointTypes.AttributeKeyHeaderIndex:
+			number, _ = strconv.ParseUint(attr.Value, 10, 64)
+		case checkpointTypes.AttributeKeyRootChain:
+			rootChain = attr.Value
+		case hmTypes.AttributeKeySideTxResult:
+			accepted = attr.Value == abci.SideTxResultType_Yes.String()
+		}
+	}
+
+	if !accepted || number == 0 {
+		return nil
+	}
+
+	checkpoint, err := util.GetCheckpointByNumber(cp.cliCtx, rootChain, number)
+	if err != nil {
+		cp.Logger.Error("Error fetching acked checkpoint", "root", rootChain, "number", number, "error", err)
+		return err
+	}
+
+	finality := notifier.CheckpointFinality{
+		RootChain:      rootChain,
+		Number:         number,
+		StartBlock:     checkpoint.StartBlock,
+		EndBlock:       checkpoint.EndBlock,
+		RootHash:       checkpoint.RootHash,
+		HeimdallHeight: blockHeight,
+	}
+
+	cp.Logger.Info("Notifying bor nodes of checkpoint finality", "finality", finality)
+	return cp.finalityNotifier.Notify(context.Background(), finality)
+}
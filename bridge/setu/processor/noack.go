@@ -0,0 +1,238 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// AdminNoAckMetricsPath serves metrics of automatic no-acks in prometheus text format
+const AdminNoAckMetricsPath = "/noack/metrics"
+
+// noAckRootChains root chains whose buffers are watched for expiry
+var noAckRootChains = []string{
+	hmTypes.RootChainTypeEth,
+	hmTypes.RootChainTypeBsc,
+	hmTypes.RootChainTypeTron,
+}
+
+// NoAckMetrics counters of automatic no-acks
+type NoAckMetrics struct {
+	Expired   uint64 `json:"expired"`   // expired buffers no-ack was scheduled for
+	Submitted uint64 `json:"submitted"` // no-acks sent by this bridge
+	Cancelled uint64 `json:"cancelled"` // scheduled no-acks dropped as no-ack of other validator was accepted first
+	Observed  uint64 `json:"observed"`  // no-acks accepted by heimdall, sent by any validator
+}
+
+// NoAckProcessor - watches buffer expiry of root chains and sends no-ack once buffered checkpoint expired.
+// Every validator waits random delay before sending, so one no-ack is usually sent instead of one per validator.
+type NoAckProcessor struct {
+	BaseProcessor
+
+	maxDelay time.Duration
+	rand     *rand.Rand
+	now      func() time.Time
+
+	cancelPolling context.CancelFunc
+
+	mu        sync.Mutex
+	lastNoAck uint64      // last accepted no-ack seen by processor
+	pending   *time.Timer // scheduled no-ack, nil if none
+	metrics   NoAckMetrics
+}
+
+// NewNoAckProcessor creates no-ack processor sending no-acks after random delay up to max delay
+func NewNoAckProcessor(maxDelay time.Duration) *NoAckProcessor {
+	return &NoAckProcessor{
+		maxDelay: maxDelay,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		now:      time.Now,
+	}
+}
+
+// Start starts polling buffers of root chains
+func (np *NoAckProcessor) Start() error {
+	np.Logger.Info("Starting")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	np.cancelPolling = cancel
+
+	interval := helper.GetConfig().AutoNoAckPollInterval
+	np.Logger.Info("Start polling for expired buffers", "pollInterval", interval, "maxDelay", np.maxDelay)
+	go scheduler.GetScheduler().Run(ctx, scheduler.Task{
+		Name:     "auto-no-ack",
+		Interval: interval,
+		Run:      func(context.Context) { np.checkBufferExpiry() },
+	})
+	return nil
+}
+
+// RegisterTasks - nil
+func (np *NoAckProcessor) RegisterTasks() {}
+
+// Stop stops polling and drops scheduled no-ack
+func (np *NoAckProcessor) Stop() {
+	if np.cancelPolling != nil {
+		np.cancelPolling()
+	}
+
+	np.mu.Lock()
+	defer np.mu.Unlock()
+	if np.pending != nil {
+		np.pending.Stop()
+		np.pending = nil
+	}
+}
+
+// Metrics returns counters of automatic no-acks
+func (np *NoAckProcessor) Metrics() NoAckMetrics {
+	np.mu.Lock()
+	defer np.mu.Unlock()
+	return np.metrics
+}
+
+// checkBufferExpiry schedules no-ack if buffered checkpoint of any root chain expired and heimdall accepts no-ack now
+func (np *NoAckProcessor) checkBufferExpiry() {
+	lastNoAck, err := util.GetLastNoAck(np.cliCtx)
+	if err != nil {
+		return
+	}
+	np.observeNoAck(lastNoAck)
+
+	params, err := util.GetCheckpointParams(np.cliCtx)
+	if err != nil {
+		return
+	}
+
+	now := np.now()
+	if !noAckAllowed(lastNoAck, params.CheckpointBufferTime, now) {
+		return
+	}
+
+	// heimdall rejects no-ack while last stake chain checkpoint is recent
+	if last, err := util.GetlastestCheckpoint(np.cliCtx, hmTypes.RootChainTypeStake); err == nil && !noAckAllowed(last.TimeStamp, params.CheckpointBufferTime, now) {
+		return
+	}
+
+	for _, rootChain := range noAckRootChains {
+		buffer, err := util.GetBufferedCheckpoint(np.cliCtx, rootChain)
+		if err != nil || buffer == nil || buffer.TimeStamp == 0 {
+			continue
+		}
+
+		if noAckAllowed(buffer.TimeStamp, params.CheckpointBufferTime, now) {
+			np.scheduleNoAck(rootChain, buffer, lastNoAck)
+			return
+		}
+	}
+}
+
+// noAckAllowed returns true if buffer time passed since given unix time
+func noAckAllowed(since uint64, bufferTime time.Duration, now time.Time) bool {
+	return since == 0 || !now.Before(time.Unix(int64(since), 0).Add(bufferTime))
+}
+
+// observeNoAck counts no-acks accepted since last poll, scheduled no-ack is dropped once one was accepted
+func (np *NoAckProcessor) observeNoAck(lastNoAck uint64) {
+	np.mu.Lock()
+	defer np.mu.Unlock()
+
+	if lastNoAck <= np.lastNoAck {
+		return
+	}
+
+	if np.lastNoAck != 0 {
+		np.metrics.Observed++
+	}
+	np.lastNoAck = lastNoAck
+
+	if np.pending != nil && np.pending.Stop() {
+		np.metrics.Cancelled++
+		np.Logger.Info("No-ack of other validator accepted, dropping scheduled no-ack", "lastNoAck", lastNoAck)
+	}
+	np.pending = nil
+}
+
+// scheduleNoAck sends no-ack after random delay, unless one is scheduled already
+func (np *NoAckProcessor) scheduleNoAck(rootChain string, buffer *hmTypes.Checkpoint, lastNoAck uint64) {
+	np.mu.Lock()
+	defer np.mu.Unlock()
+
+	if np.pending != nil {
+		return
+	}
+
+	var delay time.Duration
+	if np.maxDelay > 0 {
+		delay = time.Duration(np.rand.Int63n(int64(np.maxDelay)))
+	}
+
+	np.metrics.Expired++
+	np.Logger.Info("Buffered checkpoint expired, scheduling no-ack",
+		"root", rootChain, "start", buffer.StartBlock, "end", buffer.EndBlock, "delay", delay)
+
+	np.pending = time.AfterFunc(delay, func() { np.sendNoAck(lastNoAck) })
+}
+
+// sendNoAck sends no-ack if no other no-ack was accepted since it was scheduled
+func (np *NoAckProcessor) sendNoAck(scheduledAt uint64) {
+	np.mu.Lock()
+	np.pending = nil
+	np.mu.Unlock()
+
+	lastNoAck, err := util.GetLastNoAck(np.cliCtx)
+	if err != nil {
+		return
+	}
+
+	if lastNoAck != scheduledAt {
+		np.observeNoAck(lastNoAck)
+		np.mu.Lock()
+		np.metrics.Cancelled++
+		np.mu.Unlock()
+		return
+	}
+
+	if !np.isDutyHolder(util.DutyCheckpointNoAck) {
+		return
+	}
+
+	msg := checkpointTypes.NewMsgCheckpointNoAck(hmTypes.BytesToHeimdallAddress(helper.GetAddress()))
+	if err := np.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+		np.Logger.Error("Error while broadcasting checkpoint-no-ack to heimdall", "error", err)
+		return
+	}
+
+	np.mu.Lock()
+	np.metrics.Submitted++
+	np.mu.Unlock()
+	np.Logger.Info("No-ack transaction sent successfully")
+}
+
+// WriteNoAckMetrics writes metrics of automatic no-acks in prometheus text format
+func WriteNoAckMetrics(w io.Writer, metrics NoAckMetrics) error {
+	for _, m := range []struct {
+		name  string
+		help  string
+		value uint64
+	}{
+		{"bridge_noack_expired_total", "Expired buffers no-ack was scheduled for", metrics.Expired},
+		{"bridge_noack_submitted_total", "No-acks sent by this bridge", metrics.Submitted},
+		{"bridge_noack_cancelled_total", "Scheduled no-acks dropped as no-ack of other validator was accepted first", metrics.Cancelled},
+		{"bridge_noack_observed_total", "No-acks accepted by heimdall", metrics.Observed},
+	} {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n%s %d\n", m.name, m.help, m.name, m.name, m.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,13 +1,18 @@
 package processor
 
 import (
+	"context"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/common"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/notifier"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
+	"github.com/maticnetwork/heimdall/bridge/setu/txmanager"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 )
@@ -25,6 +30,10 @@ type ProcessorService struct {
 	queueConnector *queue.QueueConnector
 
 	processors []Processor
+
+	// tx managers of root chain submissions
+	txManagers      map[string]*txmanager.TxManager
+	cancelTxManager context.CancelFunc
 }
 
 // NewProcessorService returns new service object for processing queue msg
@@ -55,8 +64,17 @@ func NewProcessorService(
 	checkpointProcessor := NewCheckpointProcessor(&contractCaller.RootChainABI, &contractCaller.StakingInfoABI)
 	checkpointProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "checkpoint", checkpointProcessor)
 
+	if helper.GetConfig().TxManagerEnabled {
+		processorService.txManagers = txmanager.NewRootChainTxManagers(logger)
+		checkpointProcessor.txManagers = processorService.txManagers
+	}
+
+	if conf := helper.GetConfig(); len(conf.BorFinalityNotifyEndpoints) > 0 {
+		checkpointProcessor.finalityNotifier = notifier.NewFinalityNotifier(notifier.NewConfig(conf), logger.With("service", "finality-notifier"))
+	}
+
 	// initialize fee processor
-	feeProcessor := NewFeeProcessor(&contractCaller.StakingInfoABI)
+	feeProcessor := NewFeeProcessor(&contractCaller.StakingInfoABI, &contractCaller.MaticTokenABI)
 	feeProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "fee", feeProcessor)
 
 	// initialize staking processor
@@ -75,6 +93,13 @@ func NewProcessorService(
 	slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
 	slashingProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "slashing", slashingProcessor)
 
+	// initialize no-ack processor
+	var noAckProcessor *NoAckProcessor
+	if helper.GetConfig().AutoNoAckEnabled {
+		noAckProcessor = NewNoAckProcessor(helper.GetConfig().AutoNoAckMaxDelay)
+		noAckProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "noack", noAckProcessor)
+	}
+
 	//
 	// Select processors
 	//
@@ -92,6 +117,9 @@ func NewProcessorService(
 			spanProcessor,
 			slashingProcessor,
 		)
+		if noAckProcessor != nil {
+			processorService.processors = append(processorService.processors, noAckProcessor)
+		}
 	} else {
 		for _, service := range onlyServices {
 			switch service {
@@ -107,6 +135,10 @@ func NewProcessorService(
 				processorService.processors = append(processorService.processors, spanProcessor)
 			case "slashing":
 				processorService.processors = append(processorService.processors, slashingProcessor)
+			case "noack":
+				if noAckProcessor != nil {
+					processorService.processors = append(processorService.processors, noAckProcessor)
+				}
 			}
 		}
 	}
@@ -130,6 +162,17 @@ func (processorService *ProcessorService) OnStart() error {
 		go processor.Start()
 	}
 
+	if len(processorService.txManagers) > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		processorService.cancelTxManager = cancel
+		for _, txManager := range processorService.txManagers {
+			go txManager.Run(ctx, helper.GetConfig().TxManagerCheckInterval)
+		}
+		txmanager.RegisterAdminRoutes(scheduler.GetScheduler(), processorService.txManagers)
+	}
+
+	processorService.registerAdminRoutes(scheduler.GetScheduler())
+
 	processorService.Logger.Info("all processors Started")
 	return nil
 }
@@ -142,5 +185,9 @@ func (processorService *ProcessorService) OnStop() {
 		processor.Stop()
 	}
 
+	if processorService.cancelTxManager != nil {
+		processorService.cancelTxManager()
+	}
+
 	processorService.Logger.Info("all processors stopped")
 }
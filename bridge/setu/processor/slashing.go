@@ -49,10 +49,10 @@ func (sp *SlashingProcessor) Start() error {
 // RegisterTasks - Registers slashing related tasks with machinery
 func (sp *SlashingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering slashing related tasks")
-	sp.queueConnector.Server.RegisterTask("sendTickToHeimdall", sp.sendTickToHeimdall)
-	sp.queueConnector.Server.RegisterTask("sendTickToRootchain", sp.sendTickToRootchain)
-	sp.queueConnector.Server.RegisterTask("sendTickAckToHeimdall", sp.sendTickAckToHeimdall)
-	sp.queueConnector.Server.RegisterTask("sendUnjailToHeimdall", sp.sendUnjailToHeimdall)
+	sp.registerTask("sendTickToHeimdall", sp.sendTickToHeimdall)
+	sp.registerTask("sendTickToRootchain", sp.sendTickToRootchain)
+	sp.registerTask("sendTickAckToHeimdall", sp.sendTickAckToHeimdall)
+	sp.registerTask("sendUnjailToHeimdall", sp.sendUnjailToHeimdall)
 
 }
 
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 
@@ -47,20 +48,12 @@ func (sp *SpanProcessor) RegisterTasks() {
 
 // startPolling - polls heimdall and checks if new span needs to be proposed
 func (sp *SpanProcessor) startPolling(ctx context.Context, interval time.Duration) {
-	ticker := time.NewTicker(interval)
-	// stop ticker when everything done
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			sp.checkAndPropose()
-		case <-ctx.Done():
-			sp.Logger.Info("Polling stopped")
-			ticker.Stop()
-			return
-		}
-	}
+	scheduler.GetScheduler().Run(ctx, scheduler.Task{
+		Name:     "span",
+		Interval: interval,
+		Run:      func(context.Context) { sp.checkAndPropose() },
+	})
+	sp.Logger.Info("Polling stopped")
 }
 
 // checkAndPropose - will check if current user is span proposer and proposes the span
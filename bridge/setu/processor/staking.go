@@ -14,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
@@ -53,6 +54,11 @@ func (sp *StakingProcessor) Start() error {
 	sp.cancelStakingService = cancelStakingService
 	sp.Logger.Info("Start polling for staking sync", "pollInterval", helper.GetConfig().StakingPollInterval)
 	go sp.startPolling(ackCtx, helper.GetConfig().StakingPollInterval)
+
+	if interval := helper.GetConfig().ConfigHashInterval; interval > 0 {
+		sp.Logger.Info("Start publishing config hash", "interval", interval)
+		go sp.startConfigHashPublishing(ackCtx, interval)
+	}
 	return nil
 }
 
@@ -64,27 +70,30 @@ func (sp *StakingProcessor) Stop() {
 // RegisterTasks - Registers staking tasks with machinery
 func (sp *StakingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering staking related tasks")
-	if err := sp.queueConnector.Server.RegisterTask("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
+	if err := sp.registerTask("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendValidatorJoinToHeimdall", "error", err)
 	}
-	if err := sp.queueConnector.Server.RegisterTask("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
+	if err := sp.registerTask("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendUnstakeInitToHeimdall", "error", err)
 	}
-	//if err := sp.queueConnector.Server.RegisterTask("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
-	//	sp.Logger.Error("RegisterTasks | sendStakeUpdateToHeimdall", "error", err)
-	//}
-	if err := sp.queueConnector.Server.RegisterTask("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
+	if err := sp.registerTask("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
+		sp.Logger.Error("RegisterTasks | sendStakeUpdateToHeimdall", "error", err)
+	}
+	if err := sp.registerTask("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendSignerChangeToHeimdall", "error", err)
 	}
-	if err := sp.queueConnector.Server.RegisterTask("sendStakingSyncToHeimdall", sp.sendStakingSyncToHeimdall); err != nil {
+	if err := sp.registerTask("sendStakingSyncToHeimdall", sp.sendStakingSyncToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendStakingSyncToRootChain", "error", err)
 	}
-	if err := sp.queueConnector.Server.RegisterTask("sendStakingSyncToRootChain", sp.sendStakingSyncToRootchain); err != nil {
+	if err := sp.registerTask("sendStakingSyncToRootChain", sp.sendStakingSyncToRootchain); err != nil {
 		sp.Logger.Error("RegisterTasks | sendStakingSyncToRootChain", "error", err)
 	}
-	if err := sp.queueConnector.Server.RegisterTask("sendStakingAckToHeimdall", sp.sendStakingAckToHeimdall); err != nil {
+	if err := sp.registerTask("sendStakingAckToHeimdall", sp.sendStakingAckToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendStakingAckToHeimdall", "error", err)
 	}
+	if err := sp.registerTask("sendValidatorSetSyncToRootChain", sp.sendValidatorSetSyncToRootChain); err != nil {
+		sp.Logger.Error("RegisterTasks | sendValidatorSetSyncToRootChain", "error", err)
+	}
 }
 
 func (sp *StakingProcessor) sendValidatorJoinToHeimdall(eventName string, logBytes string, rootChain string) error {
@@ -107,7 +116,7 @@ func (sp *StakingProcessor) sendValidatorJoinToHeimdall(eventName string, logByt
 		if len(signerPubKey) == 64 {
 			signerPubKey = util.AppendPrefix(signerPubKey)
 		}
-		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld {
+		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld || sp.isProcessedNonce(event.ValidatorId.Uint64(), event.Nonce.Uint64()) {
 			sp.Logger.Info("Ignoring task to send validatorjoin to heimdall as already processed",
 				"event", eventName,
 				"validatorID", event.ValidatorId,
@@ -185,7 +194,7 @@ func (sp *StakingProcessor) sendUnstakeInitToHeimdall(eventName string, logBytes
 	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
 		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
-		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld {
+		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld || sp.isProcessedNonce(event.ValidatorId.Uint64(), event.Nonce.Uint64()) {
 			sp.Logger.Info("Ignoring task to send unstakeinit to heimdall as already processed",
 				"event", eventName,
 				"validator", event.User,
@@ -233,64 +242,64 @@ func (sp *StakingProcessor) sendUnstakeInitToHeimdall(eventName string, logBytes
 	return nil
 }
 
-//func (sp *StakingProcessor) sendStakeUpdateToHeimdall(eventName string, logBytes string, rootChain string) error {
-//	if rootChain != hmTypes.RootChainTypeStake {
-//		sp.Logger.Error("There should be no messages from un-stake.", "root", rootChain)
-//		return nil
-//	}
-//
-//	var vLog = types.Log{}
-//	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
-//		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
-//		return err
-//	}
-//
-//	event := new(stakinginfo.StakinginfoStakeUpdate)
-//	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
-//		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
-//	} else {
-//		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld {
-//			sp.Logger.Info("Ignoring task to send unstakeinit to heimdall as already processed",
-//				"event", eventName,
-//				"validatorID", event.ValidatorId,
-//				"nonce", event.Nonce,
-//				"newAmount", event.NewAmount,
-//				"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-//				"logIndex", uint64(vLog.Index),
-//				"blockNumber", vLog.BlockNumber,
-//			)
-//			return nil
-//		}
-//		sp.Logger.Info(
-//			"✅ Received task to send stake-update to heimdall",
-//			"event", eventName,
-//			"validatorID", event.ValidatorId,
-//			"nonce", event.Nonce,
-//			"newAmount", event.NewAmount,
-//			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-//			"logIndex", uint64(vLog.Index),
-//			"blockNumber", vLog.BlockNumber,
-//		)
-//
-//		// msg validator exit
-//		msg := stakingTypes.NewMsgStakeUpdate(
-//			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-//			event.ValidatorId.Uint64(),
-//			sdk.NewIntFromBigInt(event.NewAmount),
-//			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-//			uint64(vLog.Index),
-//			vLog.BlockNumber,
-//			event.Nonce.Uint64(),
-//		)
-//
-//		// return broadcast to heimdall
-//		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
-//			sp.Logger.Error("Error while broadcasting stakeupdate to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
-//			return err
-//		}
-//	}
-//	return nil
-//}
+func (sp *StakingProcessor) sendStakeUpdateToHeimdall(eventName string, logBytes string, rootChain string) error {
+	if rootChain != hmTypes.RootChainTypeStake {
+		sp.Logger.Error("There should be no messages from un-stake.", "root", rootChain)
+		return nil
+	}
+
+	var vLog = types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
+		return err
+	}
+
+	event := new(stakinginfo.StakinginfoStakeUpdate)
+	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+	} else {
+		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld || sp.isProcessedNonce(event.ValidatorId.Uint64(), event.Nonce.Uint64()) {
+			sp.Logger.Info("Ignoring task to send stake-update to heimdall as already processed",
+				"event", eventName,
+				"validatorID", event.ValidatorId,
+				"nonce", event.Nonce,
+				"newAmount", event.NewAmount,
+				"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				"logIndex", uint64(vLog.Index),
+				"blockNumber", vLog.BlockNumber,
+			)
+			return nil
+		}
+		sp.Logger.Info(
+			"✅ Received task to send stake-update to heimdall",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"nonce", event.Nonce,
+			"newAmount", event.NewAmount,
+			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+
+		// msg stake update
+		msg := stakingTypes.NewMsgStakeUpdate(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			sdk.NewIntFromBigInt(event.NewAmount),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+			event.Nonce.Uint64(),
+		)
+
+		// return broadcast to heimdall
+		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+			sp.Logger.Error("Error while broadcasting stakeupdate to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
+			return err
+		}
+	}
+	return nil
+}
 
 func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logBytes string, rootChain string) error {
 	if rootChain != hmTypes.RootChainTypeStake {
@@ -313,7 +322,7 @@ func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logByte
 			newSignerPubKey = util.AppendPrefix(newSignerPubKey)
 		}
 
-		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld {
+		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld || sp.isProcessedNonce(event.ValidatorId.Uint64(), event.Nonce.Uint64()) {
 			sp.Logger.Info("Ignoring task to send unstakeinit to heimdall as already processed",
 				"event", eventName,
 				"validatorID", event.ValidatorId,
@@ -389,22 +398,76 @@ func (sp *StakingProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string,
 	return status, nil
 }
 
+// isProcessedNonce checks if staking event with validator nonce is already applied on heimdall.
+// Same event may be delivered by more than one pipeline (eg. mirrored by staking sync on other root chains),
+// tx hash and log index differ between them but validator nonce doesn't.
+func (sp *StakingProcessor) isProcessedNonce(validatorID uint64, nonce uint64) bool {
+	validator, err := util.GetValidator(sp.cliCtx, validatorID)
+	if err != nil || validator.ID.Uint64() != validatorID {
+		return false
+	}
+
+	return validator.Nonce >= nonce
+}
+
 func (sp *StakingProcessor) startPolling(ctx context.Context, interval time.Duration) {
+	scheduler.GetScheduler().Run(ctx, scheduler.Task{
+		Name:     "staking-sync-ack",
+		Interval: interval,
+		Run:      func(context.Context) { go sp.checkStakingSyncAck() },
+	})
+	sp.Logger.Info("No-ack Polling stopped")
+}
+
+// startConfigHashPublishing - periodically publishes consensus config hash of validator
+func (sp *StakingProcessor) startConfigHashPublishing(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
-	// stop ticker when everything done
 	defer ticker.Stop()
+
 	for {
+		sp.publishConfigHash(ctx)
+
 		select {
 		case <-ticker.C:
-			go sp.checkStakingSyncAck()
 		case <-ctx.Done():
-			sp.Logger.Info("No-ack Polling stopped")
-			ticker.Stop()
+			sp.Logger.Info("Config hash publishing stopped")
 			return
 		}
 	}
 }
 
+// publishConfigHash - sends hash of consensus config to heimdall if we are validator
+func (sp *StakingProcessor) publishConfigHash(ctx context.Context) {
+	validator, err := util.GetValidatorBySigner(sp.cliCtx, hmTypes.BytesToHeimdallAddress(helper.GetAddress()))
+	if err != nil {
+		sp.Logger.Debug("Not a validator, skipping config hash", "error", err)
+		return
+	}
+
+	config, err := util.GetConsensusConfig(ctx, sp.cliCtx)
+	if err != nil {
+		sp.Logger.Error("Error while building consensus config", "error", err)
+		return
+	}
+
+	hash, err := config.Hash()
+	if err != nil {
+		sp.Logger.Error("Error while hashing consensus config", "error", err)
+		return
+	}
+
+	msg := stakingTypes.NewMsgConfigHash(
+		helper.GetFromAddress(sp.cliCtx),
+		validator.ID,
+		hmTypes.BytesToHeimdallHash(hash),
+	)
+
+	sp.Logger.Info("Publishing config hash", "validatorID", validator.ID, "hash", msg.Hash.Hex())
+	if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+		sp.Logger.Error("Error while broadcasting config hash to heimdall", "error", err)
+	}
+}
+
 // checkStakingSyncAck - Staking Ack handler
 // 1. Fetch latest validator nonce from rootchain
 // 2. check if nonce == queue_nonce.
@@ -430,6 +493,9 @@ func (sp *StakingProcessor) checkStakingSyncAck() {
 		if err != nil {
 			return
 		}
+		// mirror validator set of new stake chain epoch
+		sp.checkValidatorSetSync(stakingContext, rootChain)
+
 		// fetch next staking record from queue
 		res, err := util.GetNextStakingRecord(sp.cliCtx, rootChain)
 		if err != nil || res.Nonce == 0 {
@@ -494,7 +560,7 @@ func (sp *StakingProcessor) getNonceFromRootChain(stakingContext *StakingContext
 		stakingManagerInstance, _ := sp.contractConnector.GetStakeManagerInstance(stakingManagerAddress, rootChain)
 		return sp.contractConnector.GetMainStakingSyncNonce(validatorID, stakingManagerInstance)
 	case hmTypes.RootChainTypeTron:
-		stakingManagerAddress := stakingContext.ChainmanagerParams.ChainParams.TronStakingManagerAddress
+		stakingManagerAddress := stakingContext.ChainmanagerParams.ChainParams.TronStakingManagerAddress.Hex()
 		return sp.contractConnector.GetTronStakingSyncNonce(validatorID, stakingManagerAddress)
 	}
 	return 0
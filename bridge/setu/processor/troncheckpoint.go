@@ -4,6 +4,8 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/RichardKnop/machinery/v1/tasks"
+
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -15,6 +17,11 @@ import (
 )
 
 func (cp *CheckpointProcessor) sendTronCheckpointToHeimdall(checkpointContext *CheckpointContext, latestConfirmedChildBlock uint64) {
+	if util.IsRootChainPaused(hmTypes.RootChainTypeTron) {
+		cp.Logger.Debug("Root chain pipeline is paused", "root", hmTypes.RootChainTypeTron)
+		return
+	}
+
 	expectedCheckpointState, err := cp.nextExpectedTronCheckpoint(checkpointContext, latestConfirmedChildBlock)
 	if err != nil {
 		cp.Logger.Error("Error while calculate next expected checkpoint[tron]", "error", err)
@@ -26,20 +33,17 @@ func (cp *CheckpointProcessor) sendTronCheckpointToHeimdall(checkpointContext *C
 	//
 	// Check checkpoint buffer
 	//
-	timeStamp := uint64(time.Now().Unix())
-	checkpointBufferTime := uint64(checkpointContext.CheckpointParams.CheckpointBufferTime.Seconds())
-
-	bufferedCheckpoint, err := util.GetBufferedCheckpoint(cp.cliCtx, hmTypes.RootChainTypeTron)
-	if err != nil {
-		cp.Logger.Debug("No buffered checkpoint[tron]", "bufferedCheckpoint", bufferedCheckpoint)
+	queuedStart, queuedEnd, ok := cp.nextQueuedCheckpoint(checkpointContext, latestConfirmedChildBlock, hmTypes.RootChainTypeTron)
+	if !ok {
+		return
 	}
 
-	if bufferedCheckpoint != nil && !(bufferedCheckpoint.TimeStamp == 0 || ((timeStamp > bufferedCheckpoint.TimeStamp) && timeStamp-bufferedCheckpoint.TimeStamp >= checkpointBufferTime)) {
-		cp.Logger.Info("Checkpoint[tron] already exists in buffer", "Checkpoint", bufferedCheckpoint.String())
-		return
+	queued := queuedStart != 0
+	if queued {
+		start, end = queuedStart, queuedEnd
 	}
 
-	if err := cp.createAndSendTronCheckpointToHeimdall(checkpointContext, start, end); err != nil {
+	if err := cp.createAndSendTronCheckpointToHeimdall(checkpointContext, start, end, queued); err != nil {
 		cp.Logger.Error("Error sending checkpoint[tron] to heimdall", "error", err)
 		return
 	}
@@ -49,9 +53,10 @@ func (cp *CheckpointProcessor) sendTronCheckpointToHeimdall(checkpointContext *C
 func (cp *CheckpointProcessor) nextExpectedTronCheckpoint(checkpointContext *CheckpointContext, latestChildBlock uint64) (*ContractCheckpoint, error) {
 	chainManagerParams := checkpointContext.ChainmanagerParams
 	checkpointParams := checkpointContext.CheckpointParams
+	childBlockInterval := checkpointContext.ChainmanagerParams.ChildBlockInterval
 
 	// fetch current header block from tron contract
-	_currentHeaderBlock, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainManagerParams.ChainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	_currentHeaderBlock, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainManagerParams.ChainParams.TronChainAddress.Hex(), childBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number from tron", "error", err)
 		return nil, err
@@ -62,7 +67,7 @@ func (cp *CheckpointProcessor) nextExpectedTronCheckpoint(checkpointContext *Che
 
 	// get header info
 	_, currentStart, currentEnd, lastCheckpointTime, _, err := cp.contractConnector.GetTronHeaderInfo(
-		currentHeaderBlockNumber.Uint64(), chainManagerParams.ChainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+		currentHeaderBlockNumber.Uint64(), chainManagerParams.ChainParams.TronChainAddress.Hex(), childBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block object from tron", "error", err)
 		return nil, err
@@ -126,7 +131,8 @@ func (cp *CheckpointProcessor) nextExpectedTronCheckpoint(checkpointContext *Che
 }
 
 // sendCheckpointToHeimdall - creates checkpoint msg and broadcasts to heimdall
-func (cp *CheckpointProcessor) createAndSendTronCheckpointToHeimdall(checkpointContext *CheckpointContext, start uint64, end uint64) error {
+// queued checkpoint follows checkpoints in buffer instead of last acked one.
+func (cp *CheckpointProcessor) createAndSendTronCheckpointToHeimdall(checkpointContext *CheckpointContext, start uint64, end uint64, queued bool) error {
 	cp.Logger.Debug("Initiating checkpoint[tron] to Heimdall", "start", start, "end", end)
 
 	if end == 0 || start >= end {
@@ -136,7 +142,7 @@ func (cp *CheckpointProcessor) createAndSendTronCheckpointToHeimdall(checkpointC
 	// fetch latest checkpoint
 	latestCheckpoint, err := util.GetlastestCheckpoint(cp.cliCtx, hmTypes.RootChainTypeTron)
 	// event checkpoint is older than or equal to latest checkpoint
-	if err == nil && latestCheckpoint != nil && latestCheckpoint.EndBlock+1 < start {
+	if !queued && err == nil && latestCheckpoint != nil && latestCheckpoint.EndBlock+1 < start {
 		cp.Logger.Debug("Need to resubmit Checkpoint ack first", "start", start, "last_end", latestCheckpoint.EndBlock)
 		err := cp.resubmitTronCheckpointAck(checkpointContext)
 		if err != nil {
@@ -200,7 +206,7 @@ func (cp *CheckpointProcessor) shouldSendTronCheckpoint(checkpointContext *Check
 	chainManagerParams := checkpointContext.ChainmanagerParams
 
 	// current child block from contract
-	currentChildBlock, err := cp.contractConnector.TronChainRPC.GetLastChildBlock(chainManagerParams.ChainParams.TronChainAddress)
+	currentChildBlock, err := cp.contractConnector.TronChainRPC.GetLastChildBlock(chainManagerParams.ChainParams.TronChainAddress.Hex())
 	if err != nil {
 		cp.Logger.Error("Error fetching tron current child block", "currentChildBlock", currentChildBlock, "error", err)
 		return false, err
@@ -219,6 +225,9 @@ func (cp *CheckpointProcessor) shouldSendTronCheckpoint(checkpointContext *Check
 		cp.Logger.Info("Start block does not match, checkpoint[tron] already sent", "commitedLastBlock", currentChildBlock, "startBlock", start)
 	} else if currentChildBlock > end {
 		cp.Logger.Info("Checkpoint[tron] already sent", "commitedLastBlock", currentChildBlock, "startBlock", start)
+	} else if cp.isQueuedCheckpoint(hmTypes.RootChainTypeTron, start) {
+		cp.Logger.Info("Checkpoint[tron] is queued behind unacked checkpoint, retrying later", "commitedLastBlock", currentChildBlock, "startBlock", start)
+		return false, tasks.NewErrRetryTaskLater("checkpoint queued behind unacked checkpoint", util.RetryTaskDelay)
 	} else {
 		cp.Logger.Info("No need to send checkpoint[tron]")
 	}
@@ -270,7 +279,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToTron(checkpointContext *
 	if shouldSend {
 		// chain manager params
 		chainParams := checkpointContext.ChainmanagerParams.ChainParams
-		err := cp.contractConnector.SendTronCheckpoint(sideTxData, sigs, chainParams.TronChainAddress)
+		err := cp.contractConnector.SendTronCheckpoint(sideTxData, sigs, chainParams.TronChainAddress.Hex())
 		if err != nil {
 			cp.Logger.Error("Error submitting checkpoint[tron] to rootchain", "error", err)
 			return err
@@ -285,17 +294,17 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToTron(checkpointContext *
 func (cp *CheckpointProcessor) getLatestTronCheckpointTime(checkpointContext *CheckpointContext) (int64, error) {
 	// get chain params
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	checkpointParams := checkpointContext.CheckpointParams
+	childBlockInterval := checkpointContext.ChainmanagerParams.ChildBlockInterval
 
 	// fetch last header number
-	lastHeaderNumber, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	lastHeaderNumber, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainParams.TronChainAddress.Hex(), childBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number", "error", err)
 		return 0, err
 	}
 
 	// header block
-	_, _, _, createdAt, _, err := cp.contractConnector.GetTronHeaderInfo(lastHeaderNumber, chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	_, _, _, createdAt, _, err := cp.contractConnector.GetTronHeaderInfo(lastHeaderNumber, chainParams.TronChainAddress.Hex(), childBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching header block object", "error", err)
 		return 0, err
@@ -307,17 +316,17 @@ func (cp *CheckpointProcessor) getLatestTronCheckpointTime(checkpointContext *Ch
 func (cp *CheckpointProcessor) resubmitTronCheckpointAck(checkpointContext *CheckpointContext) error {
 	// get chain params
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	checkpointParams := checkpointContext.CheckpointParams
+	childBlockInterval := checkpointContext.ChainmanagerParams.ChildBlockInterval
 
 	// fetch last header number
-	lastHeaderNumber, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	lastHeaderNumber, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainParams.TronChainAddress.Hex(), childBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number", "error", err)
 		return err
 	}
 
 	// header block
-	root, start, end, _, proposer, err := cp.contractConnector.GetTronHeaderInfo(lastHeaderNumber, chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	root, start, end, _, proposer, err := cp.contractConnector.GetTronHeaderInfo(lastHeaderNumber, chainParams.TronChainAddress.Hex(), childBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching header block object", "error", err)
 		return err
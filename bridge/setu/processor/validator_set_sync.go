@@ -0,0 +1,178 @@
+package processor
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// checkValidatorSetSync - mirrors validator set to secondary root chain
+// 1. send ack to heimdall if pending validator set is synced on root chain.
+// 2. send validator set sync to heimdall if stake chain moved to new epoch.
+func (sp *StakingProcessor) checkValidatorSetSync(stakingContext *StakingContext, rootChain string) {
+	if rootChain == hmTypes.RootChainTypeStake {
+		return
+	}
+
+	record, err := util.GetValidatorSetSyncRecord(sp.cliCtx, rootChain)
+	if err != nil {
+		return
+	}
+
+	stakingManagerAddress := stakingContext.ChainmanagerParams.ChainParams.StakingManagerAddress.EthAddress()
+	stakingManagerInstance, err := sp.contractConnector.GetStakeManagerInstance(stakingManagerAddress, rootChain)
+	if err != nil {
+		sp.Logger.Error("Error while creating staking instance", "root", rootChain, "error", err)
+		return
+	}
+	currentNonce := sp.contractConnector.GetValidatorSetSyncNonce(stakingManagerInstance)
+
+	if record.HasPending() && currentNonce >= record.PendingNonce {
+		msg := stakingTypes.NewMsgValidatorSetSyncAck(
+			helper.GetFromAddress(sp.cliCtx),
+			rootChain,
+			record.PendingNonce,
+		)
+
+		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+			sp.Logger.Error("Error while broadcasting validator-set-sync-ack to heimdall", "error", err)
+		}
+		return
+	}
+
+	// stake chain epoch
+	epoch, err := util.GetCheckpointCount(sp.cliCtx, hmTypes.RootChainTypeStake)
+	if err != nil {
+		return
+	}
+
+	if record.Nonce > 0 && epoch <= record.Epoch {
+		return
+	}
+
+	validatorSet, err := util.GetCurrentValidatorSet(sp.cliCtx)
+	if err != nil || len(validatorSet.Validators) == 0 {
+		return
+	}
+
+	sp.Logger.Info("Validator set changed on stake chain, syncing to root chain",
+		"root", rootChain, "nonce", record.Nonce+1, "epoch", epoch, "lastEpoch", record.Epoch)
+
+	msg := stakingTypes.NewMsgValidatorSetSync(
+		helper.GetFromAddress(sp.cliCtx),
+		rootChain,
+		record.Nonce+1,
+		epoch,
+		stakingTypes.NewSyncValidators(validatorSet.Validators),
+	)
+
+	if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+		sp.Logger.Error("Error while broadcasting validator-set-sync to heimdall", "error", err)
+	}
+}
+
+// sendValidatorSetSyncToRootChain - handles validator set sync confirmation event from heimdall.
+// 1. check if i am the current proposer.
+// 2. check if validator set is not synced on root chain yet.
+// 3. if so, submit validator set with signatures of validators to root chain.
+func (sp *StakingProcessor) sendValidatorSetSyncToRootChain(eventBytes string, blockHeight int64) error {
+	sp.Logger.Info("Received sendValidatorSetSyncToRootChain request", "eventBytes", eventBytes, "blockHeight", blockHeight)
+
+	isCurrentProposer, err := util.IsCurrentProposer(sp.cliCtx)
+	if err != nil {
+		sp.Logger.Error("Error checking isCurrentProposer in validator set sync handler", "error", err)
+		return nil
+	}
+	if !isCurrentProposer {
+		sp.Logger.Info("I am not the current proposer. Ignoring")
+		return nil
+	}
+
+	var event = sdk.StringEvent{}
+	if err := json.Unmarshal([]byte(eventBytes), &event); err != nil {
+		sp.Logger.Error("Error unmarshalling event from heimdall", "error", err)
+		return err
+	}
+
+	var (
+		rootChain string
+		txHash    string
+		nonce     uint64
+	)
+
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case stakingTypes.AttributeKeyRootChain:
+			rootChain = attr.Value
+		case hmTypes.AttributeKeyTxHash:
+			txHash = attr.Value
+		case stakingTypes.AttributeKeyValidatorSetNonce:
+			nonce, _ = strconv.ParseUint(attr.Value, 10, 64)
+		}
+	}
+
+	stakingContext, err := sp.getStakingContext(rootChain)
+	if err != nil {
+		return err
+	}
+
+	chainParams := stakingContext.ChainmanagerParams.ChainParams
+	stakingManagerAddress := chainParams.StakingManagerAddress.EthAddress()
+	stakingManagerInstance, err := sp.contractConnector.GetStakeManagerInstance(stakingManagerAddress, rootChain)
+	if err != nil {
+		sp.Logger.Error("Error while creating staking instance", "root", rootChain, "error", err)
+		return err
+	}
+
+	if currentNonce := sp.contractConnector.GetValidatorSetSyncNonce(stakingManagerInstance); currentNonce >= nonce {
+		sp.Logger.Info("Validator set is already synced on root chain", "root", rootChain, "nonce", nonce, "currentNonce", currentNonce)
+		return nil
+	}
+
+	// proof
+	tx, err := helper.QueryTxWithProof(sp.cliCtx, common.FromHex(txHash))
+	if err != nil {
+		sp.Logger.Error("Error querying validator set sync tx proof", "txHash", txHash, "error", err)
+		return err
+	}
+
+	// fetch side txs sigs
+	decoder := helper.GetTxDecoder(authTypes.ModuleCdc)
+	stdTx, err := decoder(tx.Tx)
+	if err != nil {
+		sp.Logger.Error("Error while decoding validator set sync tx", "txHash", tx.Tx.Hash(), "error", err)
+		return err
+	}
+
+	sideMsg, ok := stdTx.GetMsgs()[0].(hmTypes.SideTxMsg)
+	if !ok {
+		sp.Logger.Error("Invalid side-tx msg", "txHash", tx.Tx.Hash())
+		return errors.New("invalid side-tx msg")
+	}
+
+	// side-tx data
+	sideTxData := sideMsg.GetSideSignBytes()
+
+	// get sigs
+	sigs, err := helper.FetchSideTxSigs(sp.httpClient, blockHeight, tx.Tx.Hash(), sideTxData)
+	if err != nil {
+		sp.Logger.Error("Error fetching votes for validator set sync tx", "height", blockHeight, "error", err)
+		return err
+	}
+
+	if err := sp.contractConnector.SendValidatorSetSync(sideTxData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain); err != nil {
+		sp.Logger.Error("Error submitting validator set sync to root chain", "root", rootChain, "error", err)
+		return err
+	}
+
+	return nil
+}
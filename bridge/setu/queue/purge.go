@@ -0,0 +1,22 @@
+package queue
+
+import (
+	"github.com/streadway/amqp"
+)
+
+// PurgeQueue drops pending tasks of machinery queue, returns number of dropped tasks
+func PurgeQueue(dialer string) (int, error) {
+	conn, err := amqp.Dial(dialer)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	channel, err := conn.Channel()
+	if err != nil {
+		return 0, err
+	}
+	defer channel.Close()
+
+	return channel.QueuePurge(QueueName, false)
+}
@@ -0,0 +1,131 @@
+package scheduler
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// AdminTasksPath lists scheduled tasks
+	AdminTasksPath = "/scheduler/tasks"
+	// AdminPausePath pauses task (?task=) or all tasks of root chain (?root=)
+	AdminPausePath = "/scheduler/pause"
+	// AdminResumePath resumes task (?task=) or all tasks of root chain (?root=)
+	AdminResumePath = "/scheduler/resume"
+	// AdminIntervalPath changes interval (?interval=) of task (?task=) or all tasks of root chain (?root=)
+	AdminIntervalPath = "/scheduler/interval"
+
+	// AdminKeyHeader header which carries admin key
+	AdminKeyHeader = "X-Admin-Key"
+)
+
+var (
+	errTaskNotFound    = errors.New("task not found")
+	errInvalidInterval = errors.New("interval should be greater than zero")
+)
+
+// HandleAdmin registers handler of other bridge services on admin endpoint
+func (s *Scheduler) HandleAdmin(pattern string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.adminRoutes[pattern] = handler
+}
+
+// AdminHandler returns http handler of admin endpoint, requests need admin key if one is set
+func (s *Scheduler) AdminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(AdminTasksPath, s.tasksHandler)
+	mux.HandleFunc(AdminPausePath, s.actionHandler(func(name string, _ *http.Request) error {
+		return s.Pause(name)
+	}))
+	mux.HandleFunc(AdminResumePath, s.actionHandler(func(name string, _ *http.Request) error {
+		return s.Resume(name)
+	}))
+	mux.HandleFunc(AdminIntervalPath, s.actionHandler(func(name string, r *http.Request) error {
+		interval, err := time.ParseDuration(r.URL.Query().Get("interval"))
+		if err != nil {
+			return fmt.Errorf("invalid interval: %v", err)
+		}
+		return s.SetInterval(name, interval)
+	}))
+
+	// routes are resolved per request, services register them once they start
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.adminKey != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(AdminKeyHeader)), []byte(s.adminKey)) != 1 {
+			WriteAdminError(w, http.StatusUnauthorized, errors.New("missing or invalid admin key"))
+			return
+		}
+
+		s.mu.Lock()
+		handler, ok := s.adminRoutes[r.URL.Path]
+		s.mu.Unlock()
+
+		if ok {
+			handler(w, r)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (s *Scheduler) tasksHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteAdminError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	WriteAdminResponse(w, http.StatusOK, s.Statuses())
+}
+
+// actionHandler applies action to task named by task param, or all tasks of root chain named by root param
+func (s *Scheduler) actionHandler(action func(name string, r *http.Request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			WriteAdminError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		var names []string
+		if name := r.URL.Query().Get("task"); name != "" {
+			names = append(names, name)
+		} else if root := r.URL.Query().Get("root"); root != "" {
+			names = s.TasksOf(root)
+		}
+
+		if len(names) == 0 {
+			WriteAdminError(w, http.StatusNotFound, errTaskNotFound)
+			return
+		}
+
+		for _, name := range names {
+			if err := action(name, r); err != nil {
+				status := http.StatusBadRequest
+				if err == errTaskNotFound {
+					status = http.StatusNotFound
+				}
+				WriteAdminError(w, status, err)
+				return
+			}
+			s.Logger.Info("Task updated by admin", "task", name, "path", r.URL.Path)
+		}
+
+		WriteAdminResponse(w, http.StatusOK, s.Statuses())
+	}
+}
+
+// WriteAdminResponse writes json response of admin endpoint
+func WriteAdminResponse(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// WriteAdminError writes error response of admin endpoint
+func WriteAdminError(w http.ResponseWriter, status int, err error) {
+	WriteAdminResponse(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,318 @@
+package scheduler
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/common"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// SchedulerStr service name
+const SchedulerStr = "scheduler"
+
+// LagFunc returns how far behind ack pipeline of root chain is, eg. checkpoints waiting for ack
+type LagFunc func() (uint64, error)
+
+// Task periodic bridge task
+type Task struct {
+	Name      string        // unique name, used by admin endpoint
+	RootChain string        // root chain task polls, empty if task isn't bound to root chain
+	Interval  time.Duration // cadence of task
+	Lag       LagFunc       // optional, interval is shortened while root chain is behind
+	Run       func(ctx context.Context)
+}
+
+// TaskStatus state of scheduled task
+type TaskStatus struct {
+	Name         string        `json:"name"`
+	RootChain    string        `json:"root_chain,omitempty"`
+	BaseInterval time.Duration `json:"base_interval"`
+	Interval     time.Duration `json:"interval"`
+	Lag          uint64        `json:"lag"`
+	Paused       bool          `json:"paused"`
+	LastRun      time.Time     `json:"last_run"`
+}
+
+type scheduledTask struct {
+	status TaskStatus
+
+	// reset wakes up task when its interval changes
+	reset chan struct{}
+}
+
+// Scheduler runs periodic tasks of bridge with jittered start, per task cadence
+// which can be paused, resumed or changed at runtime, and interval shortened
+// while ack pipeline of task's root chain is behind
+type Scheduler struct {
+	// Base service
+	common.BaseService
+
+	maxJitter   time.Duration
+	minInterval time.Duration
+
+	mu    sync.Mutex
+	tasks map[string]*scheduledTask
+	rand  *rand.Rand
+
+	server      *http.Server
+	adminKey    string
+	adminRoutes map[string]http.HandlerFunc
+}
+
+var scheduler *Scheduler
+var schedulerOnce sync.Once
+
+// GetScheduler returns scheduler singleton configured from bridge config
+func GetScheduler() *Scheduler {
+	schedulerOnce.Do(func() {
+		conf := helper.GetConfig()
+		scheduler = NewScheduler(conf.SchedulerMaxJitter, conf.SchedulerMinInterval)
+		scheduler.adminKey = conf.BridgeAdminAPIKey
+
+		if conf.BridgeAdminListenAddr != "" {
+			scheduler.server = &http.Server{Addr: conf.BridgeAdminListenAddr, Handler: scheduler.AdminHandler()}
+		}
+	})
+
+	return scheduler
+}
+
+// NewScheduler creates scheduler, minInterval 0 disables interval adjustment
+func NewScheduler(maxJitter time.Duration, minInterval time.Duration) *Scheduler {
+	s := &Scheduler{
+		maxJitter:   maxJitter,
+		minInterval: minInterval,
+		tasks:       make(map[string]*scheduledTask),
+		adminRoutes: make(map[string]http.HandlerFunc),
+		rand:        rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+
+	s.BaseService = *common.NewBaseService(util.Logger().With("service", SchedulerStr), SchedulerStr, s)
+	return s
+}
+
+// OnStart starts admin endpoint, tasks are run by their owners
+func (s *Scheduler) OnStart() error {
+	if err := s.BaseService.OnStart(); err != nil {
+		s.Logger.Error("OnStart | OnStart", "Error", err)
+	} // Always call the overridden method.
+
+	if s.server != nil {
+		go func() {
+			s.Logger.Info("Starting bridge admin endpoint", "addr", s.server.Addr)
+			if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				s.Logger.Error("Bridge admin endpoint stopped", "error", err)
+			}
+		}()
+	}
+
+	return nil
+}
+
+// OnStop stops admin endpoint
+func (s *Scheduler) OnStop() {
+	s.BaseService.OnStop()
+
+	if s.server != nil {
+		if err := s.server.Close(); err != nil {
+			s.Logger.Error("OnStop | server.Close", "Error", err)
+		}
+	}
+}
+
+// Run runs task every interval until ctx is done. First run is delayed by
+// interval plus random offset, so tasks and bridge instances don't poll in lockstep.
+func (s *Scheduler) Run(ctx context.Context, task Task) {
+	st := s.register(task)
+	defer s.unregister(task.Name, st)
+
+	s.Logger.Info("Scheduled task", "task", task.Name, "root", task.RootChain, "interval", task.Interval)
+
+	timer := time.NewTimer(s.startOffset(task.Interval) + task.Interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.Logger.Info("Task stopped", "task", task.Name)
+			return
+		case <-st.reset:
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(s.currentInterval(task.Name))
+		case <-timer.C:
+			if !s.isPaused(task.Name) {
+				task.Run(ctx)
+				s.markRun(task.Name)
+			}
+			timer.Reset(s.nextInterval(task))
+		}
+	}
+}
+
+// Pause skips runs of task until it's resumed
+func (s *Scheduler) Pause(name string) error {
+	return s.update(name, func(st *scheduledTask) { st.status.Paused = true })
+}
+
+// Resume resumes paused task
+func (s *Scheduler) Resume(name string) error {
+	return s.update(name, func(st *scheduledTask) { st.status.Paused = false })
+}
+
+// SetInterval changes cadence of task, next run is scheduled with new interval
+func (s *Scheduler) SetInterval(name string, interval time.Duration) error {
+	if interval <= 0 {
+		return errInvalidInterval
+	}
+
+	return s.update(name, func(st *scheduledTask) {
+		st.status.BaseInterval = interval
+		st.status.Interval = s.adjust(interval, st.status.Lag)
+		select {
+		case st.reset <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// TasksOf returns names of tasks polling root chain
+func (s *Scheduler) TasksOf(rootChain string) (names []string) {
+	for _, status := range s.Statuses() {
+		if status.RootChain == rootChain {
+			names = append(names, status.Name)
+		}
+	}
+	return names
+}
+
+// Statuses returns state of scheduled tasks sorted by name
+func (s *Scheduler) Statuses() []TaskStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make([]TaskStatus, 0, len(s.tasks))
+	for _, st := range s.tasks {
+		statuses = append(statuses, st.status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+func (s *Scheduler) register(task Task) *scheduledTask {
+	st := &scheduledTask{
+		status: TaskStatus{
+			Name:         task.Name,
+			RootChain:    task.RootChain,
+			BaseInterval: task.Interval,
+			Interval:     task.Interval,
+		},
+		reset: make(chan struct{}, 1),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tasks[task.Name] = st
+
+	return st
+}
+
+func (s *Scheduler) unregister(name string, st *scheduledTask) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tasks[name] == st {
+		delete(s.tasks, name)
+	}
+}
+
+func (s *Scheduler) update(name string, fn func(st *scheduledTask)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.tasks[name]
+	if !ok {
+		return errTaskNotFound
+	}
+
+	fn(st)
+	return nil
+}
+
+func (s *Scheduler) isPaused(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.tasks[name]
+	return ok && st.status.Paused
+}
+
+func (s *Scheduler) markRun(name string) {
+	_ = s.update(name, func(st *scheduledTask) { st.status.LastRun = time.Now() })
+}
+
+func (s *Scheduler) currentInterval(name string) (interval time.Duration) {
+	_ = s.update(name, func(st *scheduledTask) { interval = st.status.Interval })
+	return interval
+}
+
+// nextInterval refreshes lag of task's root chain and returns interval until next run
+func (s *Scheduler) nextInterval(task Task) time.Duration {
+	var lag uint64
+	if task.Lag != nil && s.minInterval > 0 {
+		var err error
+		if lag, err = task.Lag(); err != nil {
+			s.Logger.Debug("Error fetching lag of task, using base interval", "task", task.Name, "error", err)
+			lag = 0
+		}
+	}
+
+	interval := task.Interval
+	_ = s.update(task.Name, func(st *scheduledTask) {
+		st.status.Lag = lag
+		st.status.Interval = s.adjust(st.status.BaseInterval, lag)
+		interval = st.status.Interval
+	})
+	return interval
+}
+
+// adjust divides interval by lag + 1, down to min interval
+func (s *Scheduler) adjust(interval time.Duration, lag uint64) time.Duration {
+	if s.minInterval <= 0 || lag == 0 || interval <= s.minInterval {
+		return interval
+	}
+
+	adjusted := interval / time.Duration(lag+1)
+	if adjusted < s.minInterval {
+		return s.minInterval
+	}
+	return adjusted
+}
+
+// startOffset returns random offset up to max jitter, capped by interval
+func (s *Scheduler) startOffset(interval time.Duration) time.Duration {
+	jitter := s.maxJitter
+	if jitter > interval {
+		jitter = interval
+	}
+	if jitter <= 0 {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return time.Duration(s.rand.Int63n(int64(jitter)))
+}
@@ -0,0 +1,223 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func newTestScheduler(maxJitter time.Duration, minInterval time.Duration) *Scheduler {
+	s := NewScheduler(maxJitter, minInterval)
+	s.SetLogger(log.NewNopLogger())
+	return s
+}
+
+func waitForTask(t *testing.T, s *Scheduler, name string) {
+	require.Eventually(t, func() bool {
+		for _, status := range s.Statuses() {
+			if status.Name == name {
+				return true
+			}
+		}
+		return false
+	}, time.Second, time.Millisecond)
+}
+
+func TestAdjust(t *testing.T) {
+	t.Parallel()
+
+	s := newTestScheduler(0, 2*time.Second)
+	require.Equal(t, 10*time.Second, s.adjust(10*time.Second, 0))
+	require.Equal(t, 5*time.Second, s.adjust(10*time.Second, 1))
+	require.Equal(t, 2*time.Second, s.adjust(10*time.Second, 9))
+	// interval below bound isn't changed
+	require.Equal(t, time.Second, s.adjust(time.Second, 3))
+
+	// adjustment is disabled without min interval
+	s = newTestScheduler(0, 0)
+	require.Equal(t, 10*time.Second, s.adjust(10*time.Second, 9))
+}
+
+func TestStartOffset(t *testing.T) {
+	t.Parallel()
+
+	s := newTestScheduler(time.Second, 0)
+	for i := 0; i < 100; i++ {
+		offset := s.startOffset(time.Minute)
+		require.True(t, offset >= 0 && offset < time.Second)
+
+		// capped by interval
+		offset = s.startOffset(10 * time.Millisecond)
+		require.True(t, offset >= 0 && offset < 10*time.Millisecond)
+	}
+
+	require.Equal(t, time.Duration(0), newTestScheduler(0, 0).startOffset(time.Minute))
+}
+
+func TestRunPauseResume(t *testing.T) {
+	t.Parallel()
+
+	s := newTestScheduler(0, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var runs int64
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx, Task{
+			Name:     "test",
+			Interval: 5 * time.Millisecond,
+			Run:      func(context.Context) { atomic.AddInt64(&runs, 1) },
+		})
+		close(done)
+	}()
+	waitForTask(t, s, "test")
+
+	require.Eventually(t, func() bool { return atomic.LoadInt64(&runs) > 0 }, time.Second, time.Millisecond)
+
+	// paused task isn't run
+	require.NoError(t, s.Pause("test"))
+	paused := atomic.LoadInt64(&runs)
+	time.Sleep(50 * time.Millisecond)
+	require.True(t, atomic.LoadInt64(&runs) <= paused+1)
+
+	require.NoError(t, s.Resume("test"))
+	resumed := atomic.LoadInt64(&runs)
+	require.Eventually(t, func() bool { return atomic.LoadInt64(&runs) > resumed+1 }, time.Second, time.Millisecond)
+
+	require.Equal(t, errTaskNotFound, s.Pause("unknown"))
+
+	// task is removed once stopped
+	cancel()
+	<-done
+	require.Empty(t, s.Statuses())
+}
+
+func TestRunLag(t *testing.T) {
+	t.Parallel()
+
+	s := newTestScheduler(0, 10*time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lag := errors.New("lag unavailable")
+	var lagCalls int64
+	go s.Run(ctx, Task{
+		Name:      "eth",
+		RootChain: "eth",
+		Interval:  40 * time.Millisecond,
+		Lag: func() (uint64, error) {
+			// first lag fails, base interval is used
+			if atomic.AddInt64(&lagCalls, 1) == 1 {
+				return 0, lag
+			}
+			return 3, nil
+		},
+		Run: func(context.Context) {},
+	})
+	waitForTask(t, s, "eth")
+
+	require.Eventually(t, func() bool {
+		statuses := s.Statuses()
+		return len(statuses) == 1 && statuses[0].Lag == 3 && statuses[0].Interval == 10*time.Millisecond
+	}, time.Second, time.Millisecond)
+
+	// new base interval is adjusted by last lag
+	require.NoError(t, s.SetInterval("eth", 80*time.Millisecond))
+	status := s.Statuses()[0]
+	require.Equal(t, 80*time.Millisecond, status.BaseInterval)
+	require.Equal(t, 20*time.Millisecond, status.Interval)
+
+	require.Equal(t, errInvalidInterval, s.SetInterval("eth", 0))
+}
+
+func TestAdminHandler(t *testing.T) {
+	t.Parallel()
+
+	s := newTestScheduler(0, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	noop := func(context.Context) {}
+	go s.Run(ctx, Task{Name: "rootchain", RootChain: "eth", Interval: time.Hour, Run: noop})
+	go s.Run(ctx, Task{Name: "span", Interval: time.Hour, Run: noop})
+	waitForTask(t, s, "rootchain")
+	waitForTask(t, s, "span")
+
+	handler := s.AdminHandler()
+	serve := func(method string, target string) (*httptest.ResponseRecorder, []TaskStatus) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+
+		var statuses []TaskStatus
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &statuses))
+		}
+		return rec, statuses
+	}
+
+	rec, statuses := serve(http.MethodGet, AdminTasksPath)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, statuses, 2)
+
+	// pause all tasks of root chain
+	rec, statuses = serve(http.MethodPost, AdminPausePath+"?root=eth")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, statuses[0].Paused)
+	require.False(t, statuses[1].Paused)
+
+	rec, statuses = serve(http.MethodPost, AdminResumePath+"?task=rootchain")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.False(t, statuses[0].Paused)
+
+	rec, statuses = serve(http.MethodPost, AdminIntervalPath+"?task=span&interval=30s")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 30*time.Second, statuses[1].BaseInterval)
+
+	rec, _ = serve(http.MethodPost, AdminIntervalPath+"?task=span&interval=soon")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, _ = serve(http.MethodPost, AdminPausePath+"?task=unknown")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, _ = serve(http.MethodGet, AdminPausePath+"?task=span")
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestAdminHandlerAuth(t *testing.T) {
+	t.Parallel()
+
+	s := newTestScheduler(0, 0)
+	s.adminKey = "secret"
+	s.HandleAdmin("/processors", func(w http.ResponseWriter, r *http.Request) {
+		WriteAdminResponse(w, http.StatusOK, []string{"checkpoint"})
+	})
+
+	handler := s.AdminHandler()
+	serve := func(target string, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if key != "" {
+			req.Header.Set(AdminKeyHeader, key)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.Equal(t, http.StatusUnauthorized, serve(AdminTasksPath, "").Code)
+	require.Equal(t, http.StatusUnauthorized, serve("/processors", "wrong").Code)
+	require.Equal(t, http.StatusOK, serve(AdminTasksPath, "secret").Code)
+
+	rec := serve("/processors", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `["checkpoint"]`, rec.Body.String())
+}
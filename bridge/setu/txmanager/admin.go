@@ -0,0 +1,68 @@
+package txmanager
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/scheduler"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// AdminPendingPath lists pending submissions, spend and metrics of tx managers (?root= for single root chain)
+	AdminPendingPath = "/txmanager/pending"
+	// AdminMetricsPath serves metrics of tx managers in prometheus text format
+	AdminMetricsPath = "/txmanager/metrics"
+)
+
+// NewRootChainTxManagers creates tx managers of eth and bsc, signing with key of validator
+func NewRootChainTxManagers(logger log.Logger) map[string]*TxManager {
+	config := NewConfig(helper.GetConfig())
+
+	return map[string]*TxManager{
+		hmTypes.RootChainTypeEth: NewTxManager(hmTypes.RootChainTypeEth, helper.GetMainClient(), helper.GetECDSAPrivKey(), config, logger),
+		hmTypes.RootChainTypeBsc: NewTxManager(hmTypes.RootChainTypeBsc, helper.GetBscClient(), helper.GetECDSAPrivKey(), config, logger),
+	}
+}
+
+// RegisterAdminRoutes serves pending submissions and metrics of tx managers on bridge admin endpoint
+func RegisterAdminRoutes(s *scheduler.Scheduler, managers map[string]*TxManager) {
+	statuses := func(rootChain string) []Status {
+		statuses := make([]Status, 0, len(managers))
+		for root, manager := range managers {
+			if rootChain == "" || root == rootChain {
+				statuses = append(statuses, manager.Status())
+			}
+		}
+		sort.Slice(statuses, func(i, j int) bool { return statuses[i].RootChain < statuses[j].RootChain })
+		return statuses
+	}
+
+	s.HandleAdmin(AdminPendingPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			scheduler.WriteAdminError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		rootChain := r.URL.Query().Get("root")
+		if _, ok := managers[rootChain]; rootChain != "" && !ok {
+			scheduler.WriteAdminError(w, http.StatusNotFound, errors.New("no tx manager for root chain"))
+			return
+		}
+		scheduler.WriteAdminResponse(w, http.StatusOK, statuses(rootChain))
+	})
+
+	s.HandleAdmin(AdminMetricsPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			scheduler.WriteAdminError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_ = WriteMetrics(w, statuses(""))
+	})
+}
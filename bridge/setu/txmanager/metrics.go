@@ -0,0 +1,71 @@
+package txmanager
+
+import (
+	"fmt"
+	"io"
+)
+
+// Metrics counters and gauges of tx manager
+type Metrics struct {
+	Submitted    uint64 `json:"submitted"`      // submissions sent
+	Replaced     uint64 `json:"replaced"`       // txs replaced with bumped gas price
+	Confirmed    uint64 `json:"confirmed"`      // submissions mined
+	Reverted     uint64 `json:"reverted"`       // submissions mined but reverted
+	Dropped      uint64 `json:"dropped"`        // submissions whose nonce was used by other tx
+	GapsFilled   uint64 `json:"gaps_filled"`    // nonce gaps filled with transfers to self
+	SpendCapHits uint64 `json:"spend_cap_hits"` // txs not sent because of spend cap
+	Pending      uint64 `json:"pending"`        // submissions waiting to be mined
+	Stuck        uint64 `json:"stuck"`          // pending submissions which can't be bumped anymore
+}
+
+// metric prometheus metric of tx manager
+type metric struct {
+	name  string
+	kind  string
+	help  string
+	value func(status Status) string
+}
+
+func counter(name, help string, value func(metrics Metrics) uint64) metric {
+	return metric{name: name, kind: "counter", help: help, value: func(status Status) string {
+		return fmt.Sprint(value(status.Metrics))
+	}}
+}
+
+func gauge(name, help string, value func(metrics Metrics) uint64) metric {
+	return metric{name: name, kind: "gauge", help: help, value: func(status Status) string {
+		return fmt.Sprint(value(status.Metrics))
+	}}
+}
+
+var metrics = []metric{
+	counter("bridge_txmanager_submitted_total", "Submissions sent to root chain", func(m Metrics) uint64 { return m.Submitted }),
+	counter("bridge_txmanager_replaced_total", "Pending txs replaced with bumped gas price", func(m Metrics) uint64 { return m.Replaced }),
+	counter("bridge_txmanager_confirmed_total", "Submissions mined on root chain", func(m Metrics) uint64 { return m.Confirmed }),
+	counter("bridge_txmanager_reverted_total", "Submissions mined but reverted", func(m Metrics) uint64 { return m.Reverted }),
+	counter("bridge_txmanager_dropped_total", "Submissions whose nonce was used by other tx", func(m Metrics) uint64 { return m.Dropped }),
+	counter("bridge_txmanager_gaps_filled_total", "Nonce gaps filled with transfers to self", func(m Metrics) uint64 { return m.GapsFilled }),
+	counter("bridge_txmanager_spend_cap_hits_total", "Txs not sent because of spend cap", func(m Metrics) uint64 { return m.SpendCapHits }),
+	gauge("bridge_txmanager_pending", "Submissions waiting to be mined", func(m Metrics) uint64 { return m.Pending }),
+	gauge("bridge_txmanager_stuck", "Pending submissions which can't be bumped anymore", func(m Metrics) uint64 { return m.Stuck }),
+	{name: "bridge_txmanager_spent_wei", kind: "gauge", help: "Fees of mined submissions since start", value: func(status Status) string {
+		return status.Spent.String()
+	}},
+}
+
+// WriteMetrics writes metrics of tx managers in prometheus text format
+func WriteMetrics(w io.Writer, statuses []Status) error {
+	for _, m := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.kind); err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			if _, err := fmt.Fprintf(w, "%s{root_chain=%q} %s\n", m.name, status.RootChain, m.value(status)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
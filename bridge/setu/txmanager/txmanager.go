@@ -0,0 +1,436 @@
+package txmanager
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// gapFillerGasLimit gas of plain transfer sent to fill nonce gap
+const gapFillerGasLimit = 21000
+
+var (
+	// ErrSpendCapExceeded returned when fees of submission would exceed spend cap of root chain
+	ErrSpendCapExceeded = errors.New("spend cap of root chain exceeded")
+
+	gwei = big.NewInt(1e9)
+)
+
+// Backend root chain client used by tx manager, implemented by ethclient
+type Backend interface {
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// Config of tx manager
+type Config struct {
+	ResubmitTimeout time.Duration // time submission waits to be mined before it's replaced
+	GasBumpPercent  uint64        // gas price increase of replacement
+	MaxGasPrice     *big.Int      // max gas price of submissions, nil for no limit
+	SpendCap        *big.Int      // max fees spent since start, nil for no limit
+	GasLimit        uint64        // gas limit of submissions which fail gas estimation
+}
+
+// NewConfig creates tx manager config from heimdall config
+func NewConfig(conf helper.Configuration) Config {
+	config := Config{
+		ResubmitTimeout: conf.TxManagerResubmitTimeout,
+		GasBumpPercent:  conf.TxManagerGasBumpPercent,
+		GasLimit:        conf.MainchainGasLimit,
+	}
+
+	if conf.TxManagerMaxGasPriceGwei > 0 {
+		config.MaxGasPrice = new(big.Int).Mul(new(big.Int).SetUint64(conf.TxManagerMaxGasPriceGwei), gwei)
+	}
+
+	if conf.TxManagerSpendCapGwei > 0 {
+		config.SpendCap = new(big.Int).Mul(new(big.Int).SetUint64(conf.TxManagerSpendCapGwei), gwei)
+	}
+
+	return config
+}
+
+// Attempt tx sent for submission, replacements share nonce of submission
+type Attempt struct {
+	Hash     common.Hash `json:"hash"`
+	GasPrice *big.Int    `json:"gas_price"`
+	SentAt   time.Time   `json:"sent_at"`
+}
+
+// PendingTx submission waiting to be mined
+type PendingTx struct {
+	Key      string         `json:"key"` // submission key, e.g. checkpoint range, empty for gap fillers
+	Nonce    uint64         `json:"nonce"`
+	To       common.Address `json:"to"`
+	Data     []byte         `json:"-"`
+	GasLimit uint64         `json:"gas_limit"`
+	Attempts []Attempt      `json:"attempts"`
+	Stuck    bool           `json:"stuck"` // gas price can't be bumped anymore, because of max gas price or spend cap
+}
+
+// lastAttempt returns latest tx of submission
+func (p *PendingTx) lastAttempt() Attempt {
+	return p.Attempts[len(p.Attempts)-1]
+}
+
+// maxFee returns fee of submission if whole gas limit is used
+func (p *PendingTx) maxFee() *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(p.GasLimit), p.lastAttempt().GasPrice)
+}
+
+// Status state of tx manager served by admin endpoint
+type Status struct {
+	RootChain string         `json:"root_chain"`
+	From      common.Address `json:"from"`
+	NextNonce uint64         `json:"next_nonce"`
+	Spent     *big.Int       `json:"spent"`
+	SpendCap  *big.Int       `json:"spend_cap,omitempty"`
+	Pending   []PendingTx    `json:"pending"`
+	Metrics   Metrics        `json:"metrics"`
+}
+
+// TxManager sends submissions of bridge to root chain and makes sure they're mined: submissions which
+// aren't mined within resubmit timeout are replaced with same nonce and bumped gas price, gaps in nonces
+// of sender are filled, and fees are kept under spend cap.
+type TxManager struct {
+	rootChain string
+	backend   Backend
+	from      common.Address
+	signer    bind.SignerFn
+	config    Config
+	logger    log.Logger
+
+	// now returns current time, replaced in tests
+	now func() time.Time
+
+	mu        sync.Mutex
+	nextNonce uint64
+	pending   map[uint64]*PendingTx
+	spent     *big.Int
+	metrics   Metrics
+}
+
+// NewTxManager creates tx manager of root chain sending txs signed by key
+func NewTxManager(rootChain string, backend Backend, key *ecdsa.PrivateKey, config Config, logger log.Logger) *TxManager {
+	auth := bind.NewKeyedTransactor(key)
+
+	return &TxManager{
+		rootChain: rootChain,
+		backend:   backend,
+		from:      auth.From,
+		signer:    auth.Signer,
+		config:    config,
+		logger:    logger.With("root", rootChain),
+		now:       time.Now,
+		pending:   make(map[uint64]*PendingTx),
+		spent:     big.NewInt(0),
+	}
+}
+
+// Submit sends tx with data to contract, unless submission with same key is pending already.
+// Returns hash of latest tx of submission.
+func (m *TxManager) Submit(ctx context.Context, key string, to common.Address, data []byte) (common.Hash, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if key != "" {
+		for _, p := range m.pending {
+			if p.Key == key {
+				m.logger.Info("Submission is pending already", "key", key, "nonce", p.Nonce, "txHash", p.lastAttempt().Hash.Hex())
+				return p.lastAttempt().Hash, nil
+			}
+		}
+	}
+
+	gasLimit, err := m.backend.EstimateGas(ctx, ethereum.CallMsg{From: m.from, To: &to, Data: data})
+	if err != nil {
+		m.logger.Error("Unable to estimate gas, setting custom gas limit", "key", key, "gasLimit", m.config.GasLimit, "error", err)
+		gasLimit = m.config.GasLimit
+	}
+
+	gasPrice, err := m.suggestGasPrice(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	nonce, err := m.nonce(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	p := &PendingTx{Key: key, Nonce: nonce, To: to, Data: data, GasLimit: gasLimit}
+	if err := m.send(ctx, p, gasPrice); err != nil {
+		return common.Hash{}, err
+	}
+
+	m.pending[nonce] = p
+	m.nextNonce = nonce + 1
+	m.metrics.Submitted++
+
+	m.logger.Info("Submitted tx to root chain", "key", key, "nonce", nonce, "gasPrice", gasPrice, "txHash", p.lastAttempt().Hash.Hex())
+	return p.lastAttempt().Hash, nil
+}
+
+// Check resolves mined submissions, fills nonce gaps and replaces submissions pending longer than
+// resubmit timeout with bumped gas price
+func (m *TxManager) Check(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	confirmedNonce, err := m.backend.NonceAt(ctx, m.from, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, nonce := range m.pendingNonces() {
+		if nonce < confirmedNonce {
+			m.resolve(ctx, m.pending[nonce])
+			delete(m.pending, nonce)
+		}
+	}
+
+	if m.nextNonce < confirmedNonce {
+		m.nextNonce = confirmedNonce
+	}
+
+	if err := m.fillNonceGaps(ctx); err != nil {
+		m.logger.Error("Error while filling nonce gaps", "error", err)
+	}
+
+	for _, nonce := range m.pendingNonces() {
+		p := m.pending[nonce]
+		if m.now().Sub(p.lastAttempt().SentAt) < m.config.ResubmitTimeout {
+			continue
+		}
+
+		if err := m.bump(ctx, p); err != nil {
+			m.logger.Error("Error while replacing pending tx", "key", p.Key, "nonce", nonce, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// Run checks pending submissions every interval until ctx is done
+func (m *TxManager) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.Check(ctx); err != nil {
+				m.logger.Error("Error while checking pending txs", "error", err)
+			}
+		}
+	}
+}
+
+// Status returns pending submissions, spend and metrics of tx manager
+func (m *TxManager) Status() Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status := Status{
+		RootChain: m.rootChain,
+		From:      m.from,
+		NextNonce: m.nextNonce,
+		Spent:     new(big.Int).Set(m.spent),
+		SpendCap:  m.config.SpendCap,
+		Pending:   make([]PendingTx, 0, len(m.pending)),
+		Metrics:   m.metrics,
+	}
+
+	for _, nonce := range m.pendingNonces() {
+		p := *m.pending[nonce]
+		p.Attempts = append([]Attempt{}, p.Attempts...)
+		status.Pending = append(status.Pending, p)
+		if p.Stuck {
+			status.Metrics.Stuck++
+		}
+	}
+	status.Metrics.Pending = uint64(len(status.Pending))
+
+	return status
+}
+
+// nonce returns nonce of next submission, node may not know about txs sent but dropped already
+func (m *TxManager) nonce(ctx context.Context) (uint64, error) {
+	pendingNonce, err := m.backend.PendingNonceAt(ctx, m.from)
+	if err != nil {
+		return 0, err
+	}
+
+	if m.nextNonce > pendingNonce {
+		return m.nextNonce, nil
+	}
+	return pendingNonce, nil
+}
+
+// suggestGasPrice returns gas price suggested by node, capped at max gas price
+func (m *TxManager) suggestGasPrice(ctx context.Context) (*big.Int, error) {
+	gasPrice, err := m.backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.config.MaxGasPrice != nil && gasPrice.Cmp(m.config.MaxGasPrice) > 0 {
+		return new(big.Int).Set(m.config.MaxGasPrice), nil
+	}
+	return gasPrice, nil
+}
+
+// fillNonceGaps sends transfers to self for nonces below lowest pending submission which node doesn't
+// have txs for, submissions after gap are never mined otherwise
+func (m *TxManager) fillNonceGaps(ctx context.Context) error {
+	nonces := m.pendingNonces()
+	if len(nonces) == 0 {
+		return nil
+	}
+
+	pendingNonce, err := m.backend.PendingNonceAt(ctx, m.from)
+	if err != nil {
+		return err
+	}
+
+	for nonce := pendingNonce; nonce < nonces[0]; nonce++ {
+		gasPrice, err := m.suggestGasPrice(ctx)
+		if err != nil {
+			return err
+		}
+
+		p := &PendingTx{Nonce: nonce, To: m.from, GasLimit: gapFillerGasLimit}
+		if err := m.send(ctx, p, gasPrice); err != nil {
+			return err
+		}
+
+		m.pending[nonce] = p
+		m.metrics.GapsFilled++
+		m.logger.Info("Filled nonce gap", "nonce", nonce, "txHash", p.lastAttempt().Hash.Hex())
+	}
+
+	return nil
+}
+
+// bump replaces latest tx of submission with same tx of higher gas price
+func (m *TxManager) bump(ctx context.Context, p *PendingTx) error {
+	last := p.lastAttempt().GasPrice
+
+	gasPrice := new(big.Int).Mul(last, new(big.Int).SetUint64(100+m.config.GasBumpPercent))
+	gasPrice.Div(gasPrice, big.NewInt(100))
+
+	suggested, err := m.backend.SuggestGasPrice(ctx)
+	if err == nil && suggested.Cmp(gasPrice) > 0 {
+		gasPrice = suggested
+	}
+
+	if m.config.MaxGasPrice != nil && gasPrice.Cmp(m.config.MaxGasPrice) > 0 {
+		gasPrice = new(big.Int).Set(m.config.MaxGasPrice)
+	}
+
+	if gasPrice.Cmp(last) <= 0 {
+		if !p.Stuck {
+			m.logger.Error("Pending tx reached max gas price", "key", p.Key, "nonce", p.Nonce, "gasPrice", last)
+		}
+		p.Stuck = true
+		return nil
+	}
+
+	if err := m.send(ctx, p, gasPrice); err != nil {
+		if err == ErrSpendCapExceeded {
+			p.Stuck = true
+		}
+		return err
+	}
+
+	p.Stuck = false
+	m.metrics.Replaced++
+	m.logger.Info("Replaced pending tx", "key", p.Key, "nonce", p.Nonce, "gasPrice", gasPrice, "txHash", p.lastAttempt().Hash.Hex())
+	return nil
+}
+
+// send signs and sends tx of submission with gas price, if fees stay under spend cap
+func (m *TxManager) send(ctx context.Context, p *PendingTx, gasPrice *big.Int) error {
+	if m.config.SpendCap != nil {
+		committed := new(big.Int).Set(m.spent)
+		for nonce, pending := range m.pending {
+			if nonce != p.Nonce {
+				committed.Add(committed, pending.maxFee())
+			}
+		}
+		committed.Add(committed, new(big.Int).Mul(new(big.Int).SetUint64(p.GasLimit), gasPrice))
+
+		if committed.Cmp(m.config.SpendCap) > 0 {
+			m.metrics.SpendCapHits++
+			m.logger.Error("Spend cap of root chain exceeded", "key", p.Key, "nonce", p.Nonce, "spent", m.spent, "cap", m.config.SpendCap)
+			return ErrSpendCapExceeded
+		}
+	}
+
+	tx, err := m.signer(types.HomesteadSigner{}, m.from, types.NewTransaction(p.Nonce, p.To, big.NewInt(0), p.GasLimit, gasPrice, p.Data))
+	if err != nil {
+		return err
+	}
+
+	if err := m.backend.SendTransaction(ctx, tx); err != nil {
+		return fmt.Errorf("error sending tx with nonce %v: %v", p.Nonce, err)
+	}
+
+	p.Attempts = append(p.Attempts, Attempt{Hash: tx.Hash(), GasPrice: gasPrice, SentAt: m.now()})
+	return nil
+}
+
+// resolve records outcome of submission whose nonce is used already
+func (m *TxManager) resolve(ctx context.Context, p *PendingTx) {
+	for i := len(p.Attempts) - 1; i >= 0; i-- {
+		attempt := p.Attempts[i]
+		receipt, err := m.backend.TransactionReceipt(ctx, attempt.Hash)
+		if err != nil || receipt == nil {
+			continue
+		}
+
+		m.spent.Add(m.spent, new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), attempt.GasPrice))
+		if receipt.Status == types.ReceiptStatusFailed {
+			m.metrics.Reverted++
+			m.logger.Error("Submission reverted on root chain", "key", p.Key, "nonce", p.Nonce, "txHash", attempt.Hash.Hex())
+			return
+		}
+
+		m.metrics.Confirmed++
+		m.logger.Info("Submission mined on root chain", "key", p.Key, "nonce", p.Nonce, "txHash", attempt.Hash.Hex(), "attempts", len(p.Attempts))
+		return
+	}
+
+	// nonce was used by tx not sent by tx manager
+	m.metrics.Dropped++
+	m.logger.Error("Submission dropped, nonce used by other tx", "key", p.Key, "nonce", p.Nonce)
+}
+
+// pendingNonces returns nonces of pending submissions in ascending order
+func (m *TxManager) pendingNonces() []uint64 {
+	nonces := make([]uint64, 0, len(m.pending))
+	for nonce := range m.pending {
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	return nonces
+}
@@ -0,0 +1,190 @@
+package txmanager
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// testBackend root chain which mines txs on demand
+type testBackend struct {
+	gasPrice *big.Int
+	nonce    uint64 // confirmed nonce
+	pool     map[uint64]*types.Transaction
+	receipts map[common.Hash]*types.Receipt
+}
+
+func newTestBackend() *testBackend {
+	return &testBackend{
+		gasPrice: big.NewInt(100),
+		pool:     make(map[uint64]*types.Transaction),
+		receipts: make(map[common.Hash]*types.Receipt),
+	}
+}
+
+func (b *testBackend) SuggestGasPrice(_ context.Context) (*big.Int, error) {
+	return new(big.Int).Set(b.gasPrice), nil
+}
+
+func (b *testBackend) EstimateGas(_ context.Context, _ ethereum.CallMsg) (uint64, error) {
+	return 50000, nil
+}
+
+func (b *testBackend) PendingNonceAt(_ context.Context, _ common.Address) (uint64, error) {
+	nonce := b.nonce
+	for b.pool[nonce] != nil {
+		nonce++
+	}
+	return nonce, nil
+}
+
+func (b *testBackend) NonceAt(_ context.Context, _ common.Address, _ *big.Int) (uint64, error) {
+	return b.nonce, nil
+}
+
+func (b *testBackend) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	if tx.Nonce() < b.nonce {
+		return errors.New("nonce too low")
+	}
+	if old := b.pool[tx.Nonce()]; old != nil && tx.GasPrice().Cmp(old.GasPrice()) <= 0 {
+		return errors.New("replacement transaction underpriced")
+	}
+	b.pool[tx.Nonce()] = tx
+	return nil
+}
+
+func (b *testBackend) TransactionReceipt(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if receipt, ok := b.receipts[txHash]; ok {
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+// mine mines executable txs of pool
+func (b *testBackend) mine() {
+	for tx := b.pool[b.nonce]; tx != nil; tx = b.pool[b.nonce] {
+		b.receipts[tx.Hash()] = &types.Receipt{Status: types.ReceiptStatusSuccessful, GasUsed: tx.Gas()}
+		delete(b.pool, b.nonce)
+		b.nonce++
+	}
+}
+
+func newTestTxManager(t *testing.T, backend *testBackend, config Config) (*TxManager, *time.Time) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	now := time.Unix(1000, 0)
+	m := NewTxManager("eth", backend, key, config, log.NewNopLogger())
+	m.now = func() time.Time { return now }
+	return m, &now
+}
+
+func TestTxManagerBumpsStuckSubmission(t *testing.T) {
+	t.Parallel()
+
+	backend := newTestBackend()
+	m, now := newTestTxManager(t, backend, Config{ResubmitTimeout: time.Minute, GasBumpPercent: 10, MaxGasPrice: big.NewInt(125)})
+	ctx := context.Background()
+	to := common.HexToAddress("0x1")
+
+	hash, err := m.Submit(ctx, "checkpoint-0-255", to, []byte{1})
+	require.NoError(t, err)
+
+	// same submission isn't sent twice
+	again, err := m.Submit(ctx, "checkpoint-0-255", to, []byte{1})
+	require.NoError(t, err)
+	require.Equal(t, hash, again)
+	require.Len(t, backend.pool, 1)
+
+	// not bumped before timeout
+	require.NoError(t, m.Check(ctx))
+	require.Equal(t, hash, backend.pool[0].Hash())
+
+	// bumped by 10 percent after timeout
+	*now = now.Add(time.Minute)
+	require.NoError(t, m.Check(ctx))
+	require.Equal(t, big.NewInt(110), backend.pool[0].GasPrice())
+	require.True(t, bytes.Equal([]byte{1}, backend.pool[0].Data()))
+
+	// capped at max gas price, then stuck
+	*now = now.Add(time.Minute)
+	require.NoError(t, m.Check(ctx))
+	require.Equal(t, big.NewInt(121), backend.pool[0].GasPrice())
+
+	*now = now.Add(time.Minute)
+	require.NoError(t, m.Check(ctx))
+	require.Equal(t, big.NewInt(125), backend.pool[0].GasPrice())
+
+	*now = now.Add(time.Minute)
+	require.NoError(t, m.Check(ctx))
+	status := m.Status()
+	require.Equal(t, uint64(1), status.Metrics.Stuck)
+	require.Equal(t, uint64(3), status.Metrics.Replaced)
+
+	// fees of mined replacement are spent
+	backend.mine()
+	require.NoError(t, m.Check(ctx))
+	status = m.Status()
+	require.Empty(t, status.Pending)
+	require.Equal(t, uint64(1), status.Metrics.Confirmed)
+	require.Equal(t, big.NewInt(50000*125), status.Spent)
+
+	var metrics bytes.Buffer
+	require.NoError(t, WriteMetrics(&metrics, []Status{status}))
+	require.Contains(t, metrics.String(), `bridge_txmanager_confirmed_total{root_chain="eth"} 1`)
+}
+
+func TestTxManagerFillsNonceGap(t *testing.T) {
+	t.Parallel()
+
+	backend := newTestBackend()
+	m, _ := newTestTxManager(t, backend, Config{ResubmitTimeout: time.Minute, GasBumpPercent: 10})
+	ctx := context.Background()
+
+	_, err := m.Submit(ctx, "checkpoint-0-255", common.HexToAddress("0x1"), nil)
+	require.NoError(t, err)
+	_, err = m.Submit(ctx, "checkpoint-256-511", common.HexToAddress("0x1"), nil)
+	require.NoError(t, err)
+
+	// first submission is dropped by node, second one can't be mined
+	delete(backend.pool, 0)
+	m.mu.Lock()
+	delete(m.pending, 0)
+	m.mu.Unlock()
+
+	require.NoError(t, m.Check(ctx))
+	require.NotNil(t, backend.pool[0])
+	require.Equal(t, m.from, *backend.pool[0].To())
+	require.Equal(t, uint64(1), m.Status().Metrics.GapsFilled)
+
+	backend.mine()
+	require.NoError(t, m.Check(ctx))
+	require.Empty(t, m.Status().Pending)
+	require.Equal(t, uint64(2), backend.nonce)
+}
+
+func TestTxManagerSpendCap(t *testing.T) {
+	t.Parallel()
+
+	backend := newTestBackend()
+	m, _ := newTestTxManager(t, backend, Config{ResubmitTimeout: time.Minute, GasBumpPercent: 10, SpendCap: big.NewInt(50000 * 150)})
+	ctx := context.Background()
+
+	_, err := m.Submit(ctx, "checkpoint-0-255", common.HexToAddress("0x1"), nil)
+	require.NoError(t, err)
+
+	// max fee of pending submission counts towards cap
+	_, err = m.Submit(ctx, "checkpoint-256-511", common.HexToAddress("0x1"), nil)
+	require.Equal(t, ErrSpendCapExceeded, err)
+	require.Equal(t, uint64(1), m.Status().Metrics.SpendCapHits)
+}
@@ -26,6 +26,7 @@ import (
 	chainManagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmtypes "github.com/maticnetwork/heimdall/types"
 )
@@ -33,6 +34,9 @@ import (
 const (
 	AccountDetailsURL         = "/auth/accounts/%v"
 	LastNoAckURL              = "/checkpoints/last-no-ack"
+	StandbyProposersURL       = "/checkpoints/standby-proposers"
+	AggregationProposersURL   = "/checkpoints/aggregation-proposers"
+	CheckpointFailoverURL     = "/checkpoints/failover/%v"
 	CurrentEpochURL           = "/checkpoints/epoch"
 	CheckpointParamsURL       = "/checkpoints/params"
 	CheckpointActivationURL   = "/checkpoints/activation-height/%v"
@@ -40,19 +44,31 @@ const (
 	ChainNewParamsURL         = "/chainmanager/newparams/%v" //for new eth forkChain such as bsc, replace address of params
 	ProposersURL              = "/staking/proposer/%v"
 	BufferedCheckpointURL     = "/checkpoints/buffer/%v"
+	CheckpointBufferQueueURL  = "/checkpoints/buffer-queue/%v"
 	BufferedCheckpointSyncURL = "/checkpoints/sync/%v"
 	LatestCheckpointURL       = "/checkpoints/latest/%v"
+	CheckpointCountURL        = "/checkpoints/count/%v"
+	CheckpointByNumberURL     = "/checkpoints/%v/%v"
 	CurrentProposerURL        = "/staking/current-proposer"
 	LatestSpanURL             = "/bor/latest-span"
 	NextSpanInfoURL           = "/bor/prepare-next-span"
 	NextSpanSeedURL           = "/bor/next-span-seed"
 	DividendAccountRootURL    = "/topup/dividend-account-root"
+	CheckpointAccountRootURL  = "/checkpoints/account-root"
 	ValidatorURL              = "/staking/validator/%v"
+	ValidatorBySignerURL      = "/staking/signer/%v"
+	ValidatorBlsKeyURL        = "/staking/bls-key/%v"
+	BlsAggregationURL         = "/checkpoints/bls-aggregation"
+	AckGracePeriodURL         = "/checkpoints/ack-grace-period"
+	RootHashAlgorithmsURL     = "/checkpoints/root-hash-algorithms"
 	CurrentValidatorSetURL    = "staking/validator-set"
 	StakingTxStatusURL        = "/staking/isoldtx"
 	NextStakingRecordURL      = "/staking/next/%v"
+	ValidatorSetSyncURL       = "/staking/validator-set-sync/%v"
 	TopupTxStatusURL          = "/topup/isoldtx"
+	ERC20FeeTokensURL         = "/topup/erc20-fee-tokens"
 	ClerkTxStatusURL          = "/clerk/isoldtx"
+	ClerkPruneStatusURL       = "/clerk/prune-status"
 	LatestSlashInfoBytesURL   = "/slashing/latest_slash_info_bytes"
 	TickSlashInfoListURL      = "/slashing/tick_slash_infos"
 	SlashingTxStatusURL       = "/slashing/isoldtx"
@@ -140,6 +156,81 @@ func IsInProposerList(cliCtx cliContext.CLIContext, count uint64) (bool, error)
 	return false, nil
 }
 
+// IsEligibleStandbyProposer checks if we are standby proposer whose grace window has elapsed as of latest
+// heimdall block. Heimdall checks eligibility against block time, which local clock may run ahead of.
+func IsEligibleStandbyProposer(cliCtx cliContext.CLIContext) (bool, error) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(StandbyProposersURL))
+	if err != nil {
+		logger.Error("Unable to send request for standby proposers", "url", StandbyProposersURL, "error", err)
+		return false, err
+	}
+
+	var standbys []checkpointTypes.StandbyProposer
+	if err := json.Unmarshal(response.Result, &standbys); err != nil {
+		logger.Error("Error unmarshalling standby proposers", "error", err)
+		return false, err
+	}
+
+	status, err := helper.GetNodeStatus(cliCtx)
+	if err != nil {
+		logger.Error("Unable to fetch latest heimdall block time", "error", err)
+		return false, err
+	}
+
+	now := uint64(status.SyncInfo.LatestBlockTime.Unix())
+	for _, standby := range standbys {
+		if bytes.Equal(standby.Validator.Signer.Bytes(), helper.GetAddress()) {
+			return now >= standby.EligibleAt, nil
+		}
+	}
+	return false, nil
+}
+
+// IsBackupProposer checks if we are backup proposer of expired checkpoint of root chain whose window is open
+func IsBackupProposer(cliCtx cliContext.CLIContext, rootChain string) (bool, error) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fmt.Sprintf(CheckpointFailoverURL, rootChain)))
+	if err != nil {
+		logger.Error("Unable to send request for checkpoint failover", "url", CheckpointFailoverURL, "root", rootChain, "error", err)
+		return false, err
+	}
+
+	var status checkpointTypes.FailoverStatus
+	if err := json.Unmarshal(response.Result, &status); err != nil {
+		logger.Error("Error unmarshalling checkpoint failover", "error", err)
+		return false, err
+	}
+
+	now := uint64(time.Now().UTC().Unix())
+	for _, backup := range status.Backups {
+		if bytes.Equal(backup.Validator.Signer.Bytes(), helper.GetAddress()) {
+			return backup.InWindow(now), nil
+		}
+	}
+	return false, nil
+}
+
+// IsAggregationProposer checks if we are expected to submit next checkpoint in aggregation mode
+func IsAggregationProposer(cliCtx cliContext.CLIContext) (bool, error) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(AggregationProposersURL))
+	if err != nil {
+		logger.Error("Unable to send request for aggregation proposers", "url", AggregationProposersURL, "error", err)
+		return false, err
+	}
+
+	var proposers []hmtypes.Validator
+	if err := json.Unmarshal(response.Result, &proposers); err != nil {
+		logger.Error("Error unmarshalling aggregation proposers", "error", err)
+		return false, err
+	}
+
+	for _, proposer := range proposers {
+		if bytes.Equal(proposer.Signer.Bytes(), helper.GetAddress()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // CalculateTaskDelay calculates delay required for current validator to propose the tx
 // It solves for multiple validators sending same transaction.
 func CalculateTaskDelay(cliCtx cliContext.CLIContext) (bool, time.Duration) {
@@ -317,6 +408,27 @@ func GetChainmanagerParams(cliCtx cliContext.CLIContext) (*chainManagerTypes.Par
 	return &params, nil
 }
 
+// GetERC20FeeTokens return erc20 tokens accepted as fee
+func GetERC20FeeTokens(cliCtx cliContext.CLIContext) (topupTypes.ERC20FeeTokens, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(ERC20FeeTokensURL),
+	)
+
+	if err != nil {
+		logger.Error("Error fetching erc20 fee tokens", "err", err)
+		return nil, err
+	}
+
+	var tokens topupTypes.ERC20FeeTokens
+	if err := json.Unmarshal(response.Result, &tokens); err != nil {
+		logger.Error("Error unmarshalling erc20 fee tokens", "url", ERC20FeeTokensURL, "err", err)
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
 // GetNewChainParams return new chain params
 func GetNewChainParams(cliCtx cliContext.CLIContext, rootChain string) (*chainManagerTypes.Params, error) {
 	response, err := helper.FetchFromAPI(
@@ -359,6 +471,59 @@ func GetCheckpointParams(cliCtx cliContext.CLIContext) (*checkpointTypes.Params,
 	return &params, nil
 }
 
+// GetLastNoAck returns time of last accepted no-ack, 0 if there was none
+func GetLastNoAck(cliCtx cliContext.CLIContext) (uint64, error) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(LastNoAckURL))
+	if err != nil {
+		logger.Debug("Error fetching last no-ack", "err", err)
+		return 0, err
+	}
+
+	var lastNoAck struct {
+		Result uint64 `json:"result"`
+	}
+	if err := json.Unmarshal(response.Result, &lastNoAck); err != nil {
+		logger.Error("Error unmarshalling last no-ack", "url", LastNoAckURL, "err", err)
+		return 0, err
+	}
+
+	return lastNoAck.Result, nil
+}
+
+// GetAckGracePeriod returns time only checkpoint proposer may ack buffered checkpoint
+func GetAckGracePeriod(cliCtx cliContext.CLIContext) (time.Duration, error) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(AckGracePeriodURL))
+	if err != nil {
+		logger.Error("Error fetching checkpoint ack grace period", "err", err)
+		return 0, err
+	}
+
+	var gracePeriod time.Duration
+	if err := json.Unmarshal(response.Result, &gracePeriod); err != nil {
+		logger.Error("Error unmarshalling checkpoint ack grace period", "url", AckGracePeriodURL, "err", err)
+		return 0, err
+	}
+
+	return gracePeriod, nil
+}
+
+// GetRootHashBuilder returns root hash builder of child chain, as heimdall validates its checkpoints with
+func GetRootHashBuilder(cliCtx cliContext.CLIContext, chainID string) (checkpointTypes.RootHashBuilder, error) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(RootHashAlgorithmsURL))
+	if err != nil {
+		logger.Error("Error fetching root hash algorithms", "err", err)
+		return nil, err
+	}
+
+	var algorithms []checkpointTypes.RootHashAlgorithm
+	if err := json.Unmarshal(response.Result, &algorithms); err != nil {
+		logger.Error("Error unmarshalling root hash algorithms", "url", RootHashAlgorithmsURL, "err", err)
+		return nil, err
+	}
+
+	return checkpointTypes.GetRootHashBuilder(checkpointTypes.GetChainRootHashAlgorithm(algorithms, chainID))
+}
+
 // GetBufferedCheckpoint return checkpoint from bueffer
 func GetBufferedCheckpoint(cliCtx cliContext.CLIContext, rootChain string) (*hmtypes.Checkpoint, error) {
 	response, err := helper.FetchFromAPI(
@@ -380,6 +545,27 @@ func GetBufferedCheckpoint(cliCtx cliContext.CLIContext, rootChain string) (*hmt
 	return &checkpoint, nil
 }
 
+// GetCheckpointBufferQueue return checkpoints waiting for ack in buffer, head first
+func GetCheckpointBufferQueue(cliCtx cliContext.CLIContext, rootChain string) (*checkpointTypes.CheckpointBufferQueue, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(CheckpointBufferQueueURL, rootChain)),
+	)
+
+	if err != nil {
+		logger.Debug("Error fetching checkpoint buffer queue", "root", rootChain, "err", err)
+		return nil, err
+	}
+
+	var queue checkpointTypes.CheckpointBufferQueue
+	if err := json.Unmarshal(response.Result, &queue); err != nil {
+		logger.Error("Error unmarshalling checkpoint buffer queue", "url", CheckpointBufferQueueURL, "err", err)
+		return nil, err
+	}
+
+	return &queue, nil
+}
+
 // GetBufferedCheckpointSync return checkpoint sync from buffer
 func GetBufferedCheckpointSync(cliCtx cliContext.CLIContext, rootChain string) (*hmtypes.Checkpoint, error) {
 	response, err := helper.FetchFromAPI(
@@ -422,6 +608,50 @@ func GetlastestCheckpoint(cliCtx cliContext.CLIContext, rootChain string) (*hmty
 	return &checkpoint, nil
 }
 
+// GetCheckpointCount return number of acked checkpoints
+func GetCheckpointCount(cliCtx cliContext.CLIContext, rootChain string) (uint64, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(CheckpointCountURL, rootChain)),
+	)
+
+	if err != nil {
+		logger.Debug("Error fetching checkpoint count", "root", rootChain, "err", err)
+		return 0, err
+	}
+
+	var count struct {
+		Result uint64 `json:"result"`
+	}
+	if err := json.Unmarshal(response.Result, &count); err != nil {
+		logger.Error("Error unmarshalling checkpoint count", "root", rootChain, "url", CheckpointCountURL, "err", err)
+		return 0, err
+	}
+
+	return count.Result, nil
+}
+
+// GetCheckpointByNumber return acked checkpoint of root chain by number
+func GetCheckpointByNumber(cliCtx cliContext.CLIContext, rootChain string, number uint64) (*hmtypes.Checkpoint, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(CheckpointByNumberURL, rootChain, number)),
+	)
+
+	if err != nil {
+		logger.Debug("Error fetching checkpoint by number", "root", rootChain, "number", number, "err", err)
+		return nil, err
+	}
+
+	var checkpoint hmtypes.Checkpoint
+	if err := json.Unmarshal(response.Result, &checkpoint); err != nil {
+		logger.Error("Error unmarshalling checkpoint", "root", rootChain, "number", number, "err", err)
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
 // AppendPrefix returns publickey in uncompressed format
 func AppendPrefix(signerPubKey []byte) []byte {
 	// append prefix - "0x04" as heimdall uses publickey in uncompressed format. Refer below link
@@ -453,3 +683,133 @@ func GetNextStakingRecord(cliCtx cliContext.CLIContext, rootChain string) (*stak
 
 	return &stakingRecord, nil
 }
+
+// GetValidatorBySigner return validator of signer address
+func GetValidatorBySigner(cliCtx cliContext.CLIContext, signer hmtypes.HeimdallAddress) (*hmtypes.Validator, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ValidatorBySignerURL, signer.String())),
+	)
+
+	if err != nil {
+		logger.Debug("Error fetching validator by signer", "signer", signer, "err", err)
+		return nil, err
+	}
+
+	var validator hmtypes.Validator
+	if err := json.Unmarshal(response.Result, &validator); err != nil {
+		logger.Error("Error unmarshalling validator", "url", ValidatorBySignerURL, "signer", signer, "err", err)
+		return nil, err
+	}
+
+	return &validator, nil
+}
+
+// GetBlsVoter returns validator of node if BLS aggregation of checkpoints is enabled
+// and validator registered BLS key of node, nil otherwise
+func GetBlsVoter(cliCtx cliContext.CLIContext) (*hmtypes.Validator, error) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(BlsAggregationURL))
+	if err != nil {
+		logger.Debug("Error fetching BLS aggregation", "err", err)
+		return nil, err
+	}
+
+	var enabled bool
+	if err := json.Unmarshal(response.Result, &enabled); err != nil {
+		logger.Error("Error unmarshalling BLS aggregation", "url", BlsAggregationURL, "err", err)
+		return nil, err
+	}
+
+	if !enabled {
+		return nil, nil
+	}
+
+	validator, err := GetValidatorBySigner(cliCtx, hmtypes.BytesToHeimdallAddress(helper.GetAddress()))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err = helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fmt.Sprintf(ValidatorBlsKeyURL, validator.ID)))
+	if err != nil {
+		// no key registered
+		return nil, nil
+	}
+
+	var blsKey stakingTypes.ValidatorBlsKey
+	if err := json.Unmarshal(response.Result, &blsKey); err != nil {
+		logger.Error("Error unmarshalling validator BLS key", "url", ValidatorBlsKeyURL, "err", err)
+		return nil, err
+	}
+
+	// node has no BLS key, or registered key was replaced by key of another node
+	sk, err := helper.GetBlsPrivKey()
+	if err != nil {
+		logger.Debug("No local BLS key", "err", err)
+		return nil, nil
+	}
+
+	if !bytes.Equal(blsKey.PubKey, helper.BlsPubKey(sk)) {
+		return nil, nil
+	}
+
+	return validator, nil
+}
+
+// GetValidator return validator of validator id
+func GetValidator(cliCtx cliContext.CLIContext, validatorID uint64) (*hmtypes.Validator, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ValidatorURL, strconv.FormatUint(validatorID, 10))),
+	)
+
+	if err != nil {
+		logger.Debug("Error fetching validator", "validatorID", validatorID, "err", err)
+		return nil, err
+	}
+
+	var validator hmtypes.Validator
+	if err := json.Unmarshal(response.Result, &validator); err != nil {
+		logger.Error("Error unmarshalling validator", "url", ValidatorURL, "validatorID", validatorID, "err", err)
+		return nil, err
+	}
+
+	return &validator, nil
+}
+
+// GetValidatorSetSyncRecord return validator set sync state of root chain
+func GetValidatorSetSyncRecord(cliCtx cliContext.CLIContext, rootChain string) (*stakingTypes.ValidatorSetSyncRecord, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ValidatorSetSyncURL, rootChain)),
+	)
+
+	if err != nil {
+		logger.Debug("Error fetching validator set sync record", "root", rootChain, "err", err)
+		return nil, err
+	}
+
+	var record stakingTypes.ValidatorSetSyncRecord
+	if err := json.Unmarshal(response.Result, &record); err != nil {
+		logger.Error("Error unmarshalling validator set sync record", "url", ValidatorSetSyncURL, "root", rootChain, "err", err)
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// GetCurrentValidatorSet return current validator set
+func GetCurrentValidatorSet(cliCtx cliContext.CLIContext) (*hmtypes.ValidatorSet, error) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(CurrentValidatorSetURL))
+	if err != nil {
+		logger.Error("Unable to send request for current validatorset", "url", CurrentValidatorSetURL, "error", err)
+		return nil, err
+	}
+
+	var validatorSet hmtypes.ValidatorSet
+	if err := json.Unmarshal(response.Result, &validatorSet); err != nil {
+		logger.Error("Error unmarshalling current validatorset data ", "error", err)
+		return nil, err
+	}
+
+	return &validatorSet, nil
+}
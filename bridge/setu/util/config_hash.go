@@ -0,0 +1,57 @@
+package util
+
+import (
+	"context"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetConsensusConfig builds consensus-critical config of this node: networks of
+// configured rpc endpoints and root chain params as seen through configured
+// heimdall endpoint
+func GetConsensusConfig(ctx context.Context, cliCtx cliContext.CLIContext) (config helper.ConsensusConfig, err error) {
+	config.ChainID = helper.GetGenesisDoc().ChainID
+
+	if config.BorNetwork, err = helper.GetNetworkID(ctx, helper.GetMaticClient()); err != nil {
+		return config, err
+	}
+
+	for _, rootChain := range []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc, hmTypes.RootChainTypeTron} {
+		params, err := GetNewChainParams(cliCtx, rootChain)
+		if err != nil {
+			return config, err
+		}
+
+		rootChainConfig := helper.RootChainConsensusConfig{RootChain: rootChain}
+		switch rootChain {
+		case hmTypes.RootChainTypeTron:
+			rootChainConfig.RootChainAddress = params.ChainParams.TronChainAddress.Hex()
+			rootChainConfig.StakingManagerAddress = params.ChainParams.TronStakingManagerAddress.Hex()
+			rootChainConfig.StakingInfoAddress = params.ChainParams.TronStakingInfoAddress.Hex()
+			rootChainConfig.StateSenderAddress = params.ChainParams.TronStateSenderAddress.Hex()
+			rootChainConfig.TxConfirmations = params.TronchainTxConfirmations
+		default:
+			client := helper.GetMainClient()
+			if rootChain == hmTypes.RootChainTypeBsc {
+				client = helper.GetBscClient()
+			}
+
+			if rootChainConfig.Network, err = helper.GetNetworkID(ctx, client); err != nil {
+				return config, err
+			}
+
+			rootChainConfig.RootChainAddress = params.ChainParams.RootChainAddress.String()
+			rootChainConfig.StakingManagerAddress = params.ChainParams.StakingManagerAddress.String()
+			rootChainConfig.StakingInfoAddress = params.ChainParams.StakingInfoAddress.String()
+			rootChainConfig.StateSenderAddress = params.ChainParams.StateSenderAddress.String()
+			rootChainConfig.TxConfirmations = params.MainchainTxConfirmations
+		}
+
+		config.RootChains = append(config.RootChains, rootChainConfig)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,149 @@
+package util
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Duties guarded against double submission by redundant bridge instances
+const (
+	DutyCheckpoint            = "checkpoint"
+	DutyCheckpointToRootchain = "checkpoint-rootchain"
+	DutyCheckpointAck         = "checkpoint-ack"
+	DutyCheckpointNoAck       = "checkpoint-no-ack"
+)
+
+// DutyLock makes sure only one of redundant bridge instances performs a duty
+type DutyLock interface {
+	// IsHolder returns true if this instance holds (or just acquired) lock for duty
+	IsHolder(duty string) bool
+	// Close releases all locks
+	Close() error
+}
+
+var dutyLock DutyLock
+var dutyLockOnce sync.Once
+
+// GetDutyLock returns duty lock singleton configured from bridge config
+func GetDutyLock() DutyLock {
+	dutyLockOnce.Do(func() {
+		conf := helper.GetConfig()
+		if conf.BridgeDutyLockURL == "" {
+			dutyLock = noopDutyLock{}
+			return
+		}
+
+		owner := hmTypes.BytesToHeimdallAddress(helper.GetAddress()).String()
+		dutyLock = NewAMQPDutyLock(conf.BridgeDutyLockURL, conf.BridgeDutyLockHeartbeat, owner)
+	})
+
+	return dutyLock
+}
+
+// noopDutyLock is used when guard is disabled (single instance)
+type noopDutyLock struct{}
+
+func (noopDutyLock) IsHolder(string) bool { return true }
+
+func (noopDutyLock) Close() error { return nil }
+
+// AMQPDutyLock uses exclusive amqp queues as locks. Exclusive queue can only be
+// declared by single connection and broker deletes it once that connection is gone,
+// so lock fails over automatically when holder misses heartbeats.
+type AMQPDutyLock struct {
+	mu sync.Mutex
+
+	url       string
+	heartbeat time.Duration
+	owner     string
+	logger    log.Logger
+
+	conn *amqp.Connection
+	held map[string]*amqp.Channel
+}
+
+// NewAMQPDutyLock creates amqp duty lock, locks are scoped to owner (validator address)
+func NewAMQPDutyLock(url string, heartbeat time.Duration, owner string) *AMQPDutyLock {
+	return &AMQPDutyLock{
+		url:       url,
+		heartbeat: heartbeat,
+		owner:     strings.ToLower(owner),
+		logger:    Logger().With("module", "dutyLock"),
+		held:      make(map[string]*amqp.Channel),
+	}
+}
+
+// IsHolder returns true if this instance holds lock for duty, tries to acquire it otherwise
+func (l *AMQPDutyLock) IsHolder(duty string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.connect(); err != nil {
+		l.logger.Error("Unable to connect to duty lock broker", "error", err)
+		return false
+	}
+
+	name := l.queueName(duty)
+	if ch, ok := l.held[duty]; ok {
+		// channel is closed if queue was lost
+		if _, err := ch.QueueInspect(name); err == nil {
+			return true
+		}
+		delete(l.held, duty)
+		l.logger.Info("Lost duty lock", "duty", duty)
+	}
+
+	ch, err := l.conn.Channel()
+	if err != nil {
+		l.logger.Error("Unable to open duty lock channel", "error", err)
+		return false
+	}
+
+	// fails with RESOURCE_LOCKED (and closes channel) if other instance holds the queue
+	if _, err := ch.QueueDeclare(name, false, false, true, false, nil); err != nil {
+		l.logger.Debug("Duty lock is held by other instance", "duty", duty, "error", err)
+		return false
+	}
+
+	l.held[duty] = ch
+	l.logger.Info("Acquired duty lock", "duty", duty)
+	return true
+}
+
+// Close closes connection, which releases all held locks
+func (l *AMQPDutyLock) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.held = make(map[string]*amqp.Channel)
+	if l.conn == nil || l.conn.IsClosed() {
+		return nil
+	}
+	return l.conn.Close()
+}
+
+// connect (re)dials broker, locks held by dead connection are gone
+func (l *AMQPDutyLock) connect() error {
+	if l.conn != nil && !l.conn.IsClosed() {
+		return nil
+	}
+
+	l.held = make(map[string]*amqp.Channel)
+	conn, err := amqp.DialConfig(l.url, amqp.Config{Heartbeat: l.heartbeat})
+	if err != nil {
+		return err
+	}
+	l.conn = conn
+	return nil
+}
+
+func (l *AMQPDutyLock) queueName(duty string) string {
+	return "bridge-duty-lock." + l.owner + "." + duty
+}
@@ -0,0 +1,49 @@
+package util
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	pausedRootChainsMu sync.RWMutex
+	pausedRootChains   = map[string]bool{}
+)
+
+// PauseRootChain stops processors from proposing checkpoints of root chain and submitting them to
+// root chain. Acks are still relayed, so paused pipeline doesn't fall behind contract.
+func PauseRootChain(rootChain string) {
+	pausedRootChainsMu.Lock()
+	defer pausedRootChainsMu.Unlock()
+
+	pausedRootChains[rootChain] = true
+}
+
+// ResumeRootChain resumes paused root chain pipeline
+func ResumeRootChain(rootChain string) {
+	pausedRootChainsMu.Lock()
+	defer pausedRootChainsMu.Unlock()
+
+	delete(pausedRootChains, rootChain)
+}
+
+// IsRootChainPaused returns true if root chain pipeline is paused
+func IsRootChainPaused(rootChain string) bool {
+	pausedRootChainsMu.RLock()
+	defer pausedRootChainsMu.RUnlock()
+
+	return pausedRootChains[rootChain]
+}
+
+// PausedRootChains returns sorted paused root chains
+func PausedRootChains() []string {
+	pausedRootChainsMu.RLock()
+	defer pausedRootChainsMu.RUnlock()
+
+	roots := make([]string, 0, len(pausedRootChains))
+	for root := range pausedRootChains {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+	return roots
+}
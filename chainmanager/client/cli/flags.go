@@ -1,3 +1,5 @@
 package cli
 
-const ()
+const (
+	FlagValidatorID = "validator-id"
+)
@@ -26,6 +26,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		client.GetCommands(
 			GetQueryParams(cdc),
+			GetQueryFeatureFlags(cdc),
+			GetQueryTopology(cdc),
 		)...,
 	)
 	return txCmd
@@ -63,3 +65,51 @@ $ %s query chainmanager params
 		},
 	}
 }
+
+// GetQueryFeatureFlags implements the feature flags query command.
+func GetQueryFeatureFlags(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "feature-flags",
+		Args:  cobra.NoArgs,
+		Short: "show feature flags with activation heights and whether they are enabled",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeatureFlags)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var statuses []types.FeatureFlagStatus
+			if err = json.Unmarshal(bz, &statuses); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(statuses)
+		},
+	}
+}
+
+// GetQueryTopology implements the chain topology query command.
+func GetQueryTopology(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "topology",
+		Args:  cobra.NoArgs,
+		Short: "show root chains with their contracts, confirmations, last checkpoint and enabled features",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTopology)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var topology types.ChainTopology
+			if err = json.Unmarshal(bz, &topology); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(topology)
+		},
+	}
+}
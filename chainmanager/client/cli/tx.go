@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/heimdall/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var logger = helper.Logger.With("module", "chainmanager/client/cli")
+
+// AddRootChainProposalJSON defines add root chain proposal with deposit used
+// to parse proposal from a JSON file.
+type AddRootChainProposalJSON struct {
+	Title                 string                  `json:"title" yaml:"title"`
+	Description           string                  `json:"description" yaml:"description"`
+	RootChainType         string                  `json:"root_chain_type" yaml:"root_chain_type"`
+	ActivationHeight      uint64                  `json:"activation_height" yaml:"activation_height"`
+	TxConfirmations       uint64                  `json:"tx_confirmations" yaml:"tx_confirmations"`
+	RootChainAddress      hmTypes.HeimdallAddress `json:"root_chain_address" yaml:"root_chain_address"`
+	StateSenderAddress    hmTypes.HeimdallAddress `json:"state_sender_address" yaml:"state_sender_address"`
+	StakingManagerAddress hmTypes.HeimdallAddress `json:"staking_manager_address" yaml:"staking_manager_address"`
+	StakingInfoAddress    hmTypes.HeimdallAddress `json:"staking_info_address" yaml:"staking_info_address"`
+	InitialAckCount       uint64                  `json:"initial_ack_count" yaml:"initial_ack_count"`
+	Deposit               sdk.Coins               `json:"deposit" yaml:"deposit"`
+}
+
+// GetCmdSubmitAddRootChainProposal implements a command handler for submitting
+// add root chain proposal transaction.
+func GetCmdSubmitAddRootChainProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-root-chain [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an add root chain proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add new root chain along with an initial deposit.
+Activation height, contract addresses and initial ack count are applied at once
+when proposal passes. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal add-root-chain <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Add BSC",
+  "description": "Enable checkpoints on bsc",
+  "root_chain_type": "bsc",
+  "activation_height": 1000000,
+  "tx_confirmations": 15,
+  "root_chain_address": "0x...",
+  "state_sender_address": "0x...",
+  "staking_manager_address": "0x...",
+  "staking_info_address": "0x...",
+  "initial_ack_count": 0,
+  "deposit": [
+    {
+      "denom": "btt",
+      "amount": "1000000000000000000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var proposal AddRootChainProposalJSON
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			from := helper.GetFromAddress(cliCtx)
+			content := types.NewMsgAddRootChain(
+				proposal.Title,
+				proposal.Description,
+				proposal.RootChainType,
+				proposal.ActivationHeight,
+				proposal.TxConfirmations,
+				proposal.RootChainAddress,
+				proposal.StateSenderAddress,
+				proposal.StakingManagerAddress,
+				proposal.StakingInfoAddress,
+				proposal.InitialAckCount,
+			)
+
+			// create submit proposal
+			msg := govTypes.NewMsgSubmitProposal(content, proposal.Deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("GetCmdSubmitAddRootChainProposal | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/maticnetwork/heimdall/chainmanager/client/cli"
+	"github.com/maticnetwork/heimdall/chainmanager/client/rest"
+	govclient "github.com/maticnetwork/heimdall/gov/client"
+)
+
+// add root chain proposal handler
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitAddRootChainProposal, rest.ProposalRESTHandler)
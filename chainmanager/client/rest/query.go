@@ -9,6 +9,7 @@ import (
 	"github.com/gorilla/mux"
 
 	chainTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
 )
 
 // HTTP request handler to query the auth params values
@@ -37,12 +38,9 @@ func queryNewParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		if !ok {
 			return
 		}
-		// get checkpoint number
-		vars := mux.Vars(r)
-		rootChain, ok := vars["root"]
+		// get root chain
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
 		if !ok {
-			err := fmt.Errorf("'%s' is not a valid rootChain", vars["root"])
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
@@ -63,3 +61,82 @@ func queryNewParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func queryParamsAtHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+
+		// get height
+		paramsHeight, ok := rest.ParseInt64OrReturnBadRequest(w, vars["height"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(chainTypes.NewQueryChainParamsAt(rootChain, paramsHeight))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", chainTypes.QuerierRoute, chainTypes.QueryChainParamsAt)
+		res, height, err := cliCtx.QueryWithData(route, queryParams)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query feature flags and their state
+func featureFlagsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", chainTypes.QuerierRoute, chainTypes.QueryFeatureFlags)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query topology of bor chain and its root chains
+func topologyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", chainTypes.QuerierRoute, chainTypes.QueryTopology)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
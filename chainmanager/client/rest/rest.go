@@ -10,4 +10,9 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/chainmanager/params", paramsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/chainmanager/newparams/{root}", queryNewParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/newparams", queryNewParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/params/{root}/{height}", queryParamsAtHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/params/{height:[0-9]+}", queryParamsAtHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/feature-flags", featureFlagsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/topology", topologyHandlerFn(cliCtx)).Methods("GET")
 }
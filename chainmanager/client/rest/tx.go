@@ -0,0 +1,78 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	govRest "github.com/maticnetwork/heimdall/gov/client/rest"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+// AddRootChainProposalReq defines add root chain proposal request body
+type AddRootChainProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title                 string                  `json:"title" yaml:"title"`
+	Description           string                  `json:"description" yaml:"description"`
+	RootChainType         string                  `json:"root_chain_type" yaml:"root_chain_type"`
+	ActivationHeight      uint64                  `json:"activation_height" yaml:"activation_height"`
+	TxConfirmations       uint64                  `json:"tx_confirmations" yaml:"tx_confirmations"`
+	RootChainAddress      hmTypes.HeimdallAddress `json:"root_chain_address" yaml:"root_chain_address"`
+	StateSenderAddress    hmTypes.HeimdallAddress `json:"state_sender_address" yaml:"state_sender_address"`
+	StakingManagerAddress hmTypes.HeimdallAddress `json:"staking_manager_address" yaml:"staking_manager_address"`
+	StakingInfoAddress    hmTypes.HeimdallAddress `json:"staking_info_address" yaml:"staking_info_address"`
+	InitialAckCount       uint64                  `json:"initial_ack_count" yaml:"initial_ack_count"`
+	Proposer              hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	Deposit               sdk.Coins               `json:"deposit" yaml:"deposit"`
+	Validator             hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the add root
+// chain REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "add_root_chain",
+		Handler:  postAddRootChainProposalHandlerFn(cliCtx),
+	}
+}
+
+func postAddRootChainProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddRootChainProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewMsgAddRootChain(
+			req.Title,
+			req.Description,
+			req.RootChainType,
+			req.ActivationHeight,
+			req.TxConfirmations,
+			req.RootChainAddress,
+			req.StateSenderAddress,
+			req.StakingManagerAddress,
+			req.StakingInfoAddress,
+			req.InitialAckCount,
+		)
+
+		msg := govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
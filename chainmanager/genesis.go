@@ -9,9 +9,14 @@ import (
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+	keeper.SetFeatureFlags(ctx, data.FeatureFlags)
+	keeper.SetChildBlockIntervals(ctx, data.ChildBlockIntervals)
 	for _, chainInfo := range data.ChainInfos {
 		keeper.AddNewChainParams(ctx, chainInfo)
 	}
+
+	// snapshot initial chain params
+	keeper.SnapshotChainParams(ctx)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -20,5 +25,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	return types.NewGenesisState(
 		params,
 		keeper.GetNewChainParamsList(ctx),
+		keeper.GetFeatureFlags(ctx),
+		keeper.GetChildBlockIntervals(ctx),
 	)
 }
@@ -1,6 +1,7 @@
 package chainmanager
 
 import (
+	"bytes"
 	"errors"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,7 +15,8 @@ import (
 )
 
 var (
-	NewChainParamsKey = []byte{0x11} // prefix key for when storing state
+	NewChainParamsKey      = []byte{0x11} // prefix key for when storing state
+	ChainParamsSnapshotKey = []byte{0x12} // prefix key for height-indexed chain params snapshots
 )
 
 // Keeper stores all related data
@@ -28,6 +30,14 @@ type Keeper struct {
 	paramSpace subspace.Subspace
 	// contract caller
 	contractCaller helper.ContractCaller
+	// checkpoint reader, set once checkpoint keeper is created
+	checkpointReader CheckpointReader
+}
+
+// CheckpointReader reads acked checkpoints of root chains. Checkpoint keeper depends on
+// chain manager keeper, so it is set after both are created.
+type CheckpointReader interface {
+	GetACKCount(ctx sdk.Context, rootChain string) uint64
 }
 
 // NewKeeper create new keeper
@@ -54,6 +64,11 @@ func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
 }
 
+// SetCheckpointReader sets reader of acked checkpoints
+func (k *Keeper) SetCheckpointReader(reader CheckpointReader) {
+	k.checkpointReader = reader
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -136,6 +151,199 @@ func (k *Keeper) GetChainActivationHeight(ctx sdk.Context, rootChain string) uin
 	return res
 }
 
+// GetEffectiveParams returns params in effect for root chain, with addresses replaced for new eth fork
+// and child block interval of root chain contract
+func (k *Keeper) GetEffectiveParams(ctx sdk.Context, rootChain string) (types.Params, error) {
+	params := k.GetParams(ctx)
+	params.ChildBlockInterval = k.GetChildBlockInterval(ctx, rootChain)
+	if rootChain != hmTypes.RootChainTypeBsc {
+		return params, nil
+	}
+
+	// addresses are zeroed if chain is not added yet
+	newChainParams, err := k.GetChainParams(ctx, rootChain)
+	params.MainchainTxConfirmations = newChainParams.TxConfirmations
+	params.ChainParams.RootChainAddress = newChainParams.RootChainAddress
+	params.ChainParams.StateSenderAddress = newChainParams.StateSenderAddress
+	params.ChainParams.StakingInfoAddress = newChainParams.StakingInfoAddress
+	params.ChainParams.StakingManagerAddress = newChainParams.StakingManagerAddress
+	return params, err
+}
+
+// GetChainTopology returns bor chain contracts and every root chain in state, with its contracts,
+// confirmations, last acked checkpoint and features enabled by its contracts. Eth and tron are
+// configured through module params, other root chains are listed once added by governance.
+func (k *Keeper) GetChainTopology(ctx sdk.Context) types.ChainTopology {
+	params := k.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	topology := types.ChainTopology{
+		BorChainID:           chainParams.BorChainID,
+		StateReceiverAddress: addressString(chainParams.StateReceiverAddress),
+		ValidatorSetAddress:  addressString(chainParams.ValidatorSetAddress),
+		RootChains:           []types.RootChainTopology{},
+	}
+
+	for _, rootChain := range types.SortedRootChains() {
+		rootChainTopology := types.RootChainTopology{
+			RootChainType:      rootChain,
+			ChildBlockInterval: k.GetChildBlockInterval(ctx, rootChain),
+		}
+
+		switch rootChain {
+		case hmTypes.RootChainTypeEth:
+			rootChainTopology.TxConfirmations = params.MainchainTxConfirmations
+			rootChainTopology.Contracts = types.RootChainContracts{
+				RootChainAddress:      addressString(chainParams.RootChainAddress),
+				StateSenderAddress:    addressString(chainParams.StateSenderAddress),
+				StakingManagerAddress: addressString(chainParams.StakingManagerAddress),
+				StakingInfoAddress:    addressString(chainParams.StakingInfoAddress),
+			}
+		case hmTypes.RootChainTypeTron:
+			rootChainTopology.TxConfirmations = params.TronchainTxConfirmations
+			rootChainTopology.Contracts = types.RootChainContracts{
+				RootChainAddress:      chainParams.TronChainAddress.Hex(),
+				StateSenderAddress:    chainParams.TronStateSenderAddress.Hex(),
+				StakingManagerAddress: chainParams.TronStakingManagerAddress.Hex(),
+				StakingInfoAddress:    chainParams.TronStakingInfoAddress.Hex(),
+			}
+		default:
+			chainInfo, err := k.GetChainParams(ctx, rootChain)
+			if err != nil {
+				// chain is not added yet
+				continue
+			}
+
+			rootChainTopology.ActivationHeight = chainInfo.ActivationHeight
+			rootChainTopology.TxConfirmations = chainInfo.TxConfirmations
+			rootChainTopology.Contracts = types.RootChainContracts{
+				RootChainAddress:      addressString(chainInfo.RootChainAddress),
+				StateSenderAddress:    addressString(chainInfo.StateSenderAddress),
+				StakingManagerAddress: addressString(chainInfo.StakingManagerAddress),
+				StakingInfoAddress:    addressString(chainInfo.StakingInfoAddress),
+			}
+		}
+
+		if k.checkpointReader != nil {
+			rootChainTopology.LastCheckpointNumber = k.checkpointReader.GetACKCount(ctx, rootChain)
+		}
+		rootChainTopology.Features = types.NewRootChainFeatures(rootChainTopology.Contracts)
+
+		topology.RootChains = append(topology.RootChains, rootChainTopology)
+	}
+
+	return topology
+}
+
+// addressString returns hex of address, empty if address is not set
+func addressString(address hmTypes.HeimdallAddress) string {
+	if address.Empty() {
+		return ""
+	}
+	return address.String()
+}
+
+//
+// Chain params snapshots
+//
+
+func getChainParamsSnapshotPrefix(rootChain string) []byte {
+	return append(ChainParamsSnapshotKey, hmTypes.GetRootChainID(rootChain))
+}
+
+// GetChainParamsSnapshotKey returns snapshot key for root chain at height
+func GetChainParamsSnapshotKey(rootChain string, height int64) []byte {
+	return append(getChainParamsSnapshotPrefix(rootChain), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// SetChainParamsSnapshot stores chain params snapshot
+func (k *Keeper) SetChainParamsSnapshot(ctx sdk.Context, snapshot types.ChainParamsSnapshot) error {
+	store := ctx.KVStore(k.storeKey)
+
+	value, err := k.cdc.MarshalBinaryBare(snapshot)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling chain params snapshot", "root", snapshot.RootChainType, "error", err)
+		return err
+	}
+
+	store.Set(GetChainParamsSnapshotKey(snapshot.RootChainType, snapshot.Height), value)
+	return nil
+}
+
+// GetChainParamsSnapshotAt returns latest snapshot written at or before height
+func (k *Keeper) GetChainParamsSnapshotAt(ctx sdk.Context, rootChain string, height int64) (snapshot types.ChainParamsSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	// iterate backwards from height (inclusive)
+	iterator := store.ReverseIterator(
+		getChainParamsSnapshotPrefix(rootChain),
+		GetChainParamsSnapshotKey(rootChain, height+1),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return snapshot, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &snapshot); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling chain params snapshot", "root", rootChain, "error", err)
+		return snapshot, false
+	}
+
+	return snapshot, true
+}
+
+// GetChainParamsSnapshots returns all snapshots for root chain ordered by height
+func (k *Keeper) GetChainParamsSnapshots(ctx sdk.Context, rootChain string) (snapshots []types.ChainParamsSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, getChainParamsSnapshotPrefix(rootChain))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.ChainParamsSnapshot
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &snapshot); err == nil {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots
+}
+
+// SnapshotChainParams writes snapshot for every root chain whose effective params changed
+func (k *Keeper) SnapshotChainParams(ctx sdk.Context) {
+	for _, rootChain := range types.SortedRootChains() {
+		params, err := k.GetEffectiveParams(ctx, rootChain)
+		if err != nil {
+			// chain is not added yet
+			continue
+		}
+
+		if latest, found := k.GetChainParamsSnapshotAt(ctx, rootChain, ctx.BlockHeight()); found {
+			if bytes.Equal(k.cdc.MustMarshalBinaryBare(latest.Params), k.cdc.MustMarshalBinaryBare(params)) {
+				continue
+			}
+		}
+
+		if err := k.SetChainParamsSnapshot(ctx, types.NewChainParamsSnapshot(rootChain, ctx.BlockHeight(), params)); err == nil {
+			k.Logger(ctx).Debug("Chain params snapshot stored", "root", rootChain, "height", ctx.BlockHeight())
+		}
+	}
+}
+
+// GetChainParamsAt returns params in effect for root chain at height.
+// Current params are returned for current (or future) heights and for
+// heights before the first snapshot was written.
+func (k *Keeper) GetChainParamsAt(ctx sdk.Context, rootChain string, height int64) (types.Params, error) {
+	if height >= ctx.BlockHeight() {
+		return k.GetEffectiveParams(ctx, rootChain)
+	}
+
+	if snapshot, found := k.GetChainParamsSnapshotAt(ctx, rootChain, height); found {
+		return snapshot.Params, nil
+	}
+
+	return k.GetEffectiveParams(ctx, rootChain)
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -149,3 +357,47 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return
 }
+
+// -----------------------------------------------------------------------------
+// Feature flags
+
+// SetFeatureFlags sets feature flags
+func (k Keeper) SetFeatureFlags(ctx sdk.Context, flags []types.FeatureFlag) {
+	k.paramSpace.Set(ctx, types.KeyFeatureFlags, flags)
+}
+
+// GetFeatureFlags gets feature flags, features are disabled if flags were never set
+func (k Keeper) GetFeatureFlags(ctx sdk.Context) (flags []types.FeatureFlag) {
+	k.paramSpace.GetIfExists(ctx, types.KeyFeatureFlags, &flags)
+	return
+}
+
+// IsFeatureEnabled returns true if feature is enabled at current height
+func (k Keeper) IsFeatureEnabled(ctx sdk.Context, name string) bool {
+	for _, flag := range k.GetFeatureFlags(ctx) {
+		if flag.Name == name {
+			return flag.IsEnabled(ctx.BlockHeight())
+		}
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
+// Child block intervals
+
+// SetChildBlockIntervals sets child block interval of root chains
+func (k Keeper) SetChildBlockIntervals(ctx sdk.Context, intervals []types.ChildBlockInterval) {
+	k.paramSpace.Set(ctx, types.KeyChildBlockIntervals, intervals)
+}
+
+// GetChildBlockIntervals gets child block interval of root chains
+func (k Keeper) GetChildBlockIntervals(ctx sdk.Context) (intervals []types.ChildBlockInterval) {
+	k.paramSpace.GetIfExists(ctx, types.KeyChildBlockIntervals, &intervals)
+	return
+}
+
+// GetChildBlockInterval returns child block interval of root chain contract,
+// default interval is returned if it was never set for root chain
+func (k Keeper) GetChildBlockInterval(ctx sdk.Context, rootChain string) uint64 {
+	return types.GetChildBlockInterval(k.GetChildBlockIntervals(ctx), rootChain)
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -37,3 +38,95 @@ func (suite *KeeperTestSuite) TestParamsGetterSetter() {
 
 	require.Equal(t, params, actualParams)
 }
+
+func (suite *KeeperTestSuite) TestChainParamsSnapshots() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.ChainKeeper
+
+	params := types.DefaultParams()
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(10)
+	keeper.SnapshotChainParams(ctx)
+	count := len(keeper.GetChainParamsSnapshots(ctx, hmTypes.RootChainTypeEth))
+
+	// unchanged params are not snapshotted again
+	ctx = ctx.WithBlockHeight(20)
+	keeper.SnapshotChainParams(ctx)
+	require.Len(t, keeper.GetChainParamsSnapshots(ctx, hmTypes.RootChainTypeEth), count)
+
+	// change params
+	updated := params
+	updated.MainchainTxConfirmations = params.MainchainTxConfirmations + 1
+	keeper.SetParams(ctx, updated)
+
+	ctx = ctx.WithBlockHeight(30)
+	keeper.SnapshotChainParams(ctx)
+	require.Len(t, keeper.GetChainParamsSnapshots(ctx, hmTypes.RootChainTypeEth), count+1)
+
+	ctx = ctx.WithBlockHeight(40)
+
+	old, err := keeper.GetChainParamsAt(ctx, hmTypes.RootChainTypeEth, 25)
+	require.NoError(t, err)
+	require.Equal(t, params.MainchainTxConfirmations, old.MainchainTxConfirmations)
+
+	latest, err := keeper.GetChainParamsAt(ctx, hmTypes.RootChainTypeEth, 35)
+	require.NoError(t, err)
+	require.Equal(t, updated.MainchainTxConfirmations, latest.MainchainTxConfirmations)
+
+	current, err := keeper.GetChainParamsAt(ctx, hmTypes.RootChainTypeEth, 40)
+	require.NoError(t, err)
+	// params in effect carry child block interval of root chain
+	updated.ChildBlockInterval = keeper.GetChildBlockInterval(ctx, hmTypes.RootChainTypeEth)
+	require.Equal(t, updated, current)
+
+	// bsc is not added, no snapshot
+	require.Empty(t, keeper.GetChainParamsSnapshots(ctx, hmTypes.RootChainTypeBsc))
+}
+
+func (suite *KeeperTestSuite) TestFeatureFlags() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.ChainKeeper
+
+	require.False(t, keeper.IsFeatureEnabled(ctx, types.FeatureSyncRootVerification))
+
+	flags := []types.FeatureFlag{types.NewFeatureFlag(types.FeatureSyncRootVerification, 100)}
+	require.NoError(t, types.ValidateFeatureFlags(flags))
+	keeper.SetFeatureFlags(ctx, flags)
+	require.Equal(t, flags, keeper.GetFeatureFlags(ctx))
+
+	require.False(t, keeper.IsFeatureEnabled(ctx.WithBlockHeight(99), types.FeatureSyncRootVerification))
+	require.True(t, keeper.IsFeatureEnabled(ctx.WithBlockHeight(100), types.FeatureSyncRootVerification))
+	require.False(t, keeper.IsFeatureEnabled(ctx.WithBlockHeight(100), "unknown"))
+
+	// duplicate and zero height flags are invalid
+	require.Error(t, types.ValidateFeatureFlags(append(flags, flags[0])))
+	require.Error(t, types.ValidateFeatureFlags([]types.FeatureFlag{types.NewFeatureFlag("feature", 0)}))
+}
+
+func (suite *KeeperTestSuite) TestChildBlockIntervals() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.ChainKeeper
+
+	intervals := []types.ChildBlockInterval{
+		types.NewChildBlockInterval(hmTypes.RootChainTypeEth, 10000),
+		types.NewChildBlockInterval(hmTypes.RootChainTypeTron, 1),
+	}
+	require.NoError(t, types.ValidateChildBlockIntervals(intervals))
+	keeper.SetChildBlockIntervals(ctx, intervals)
+	require.Equal(t, intervals, keeper.GetChildBlockIntervals(ctx))
+
+	require.Equal(t, uint64(10000), keeper.GetChildBlockInterval(ctx, hmTypes.RootChainTypeEth))
+	require.Equal(t, uint64(1), keeper.GetChildBlockInterval(ctx, hmTypes.RootChainTypeTron))
+	require.Equal(t, types.DefaultChildBlockInterval, keeper.GetChildBlockInterval(ctx, hmTypes.RootChainTypeBsc))
+
+	// params in effect for root chain carry its interval
+	params, err := keeper.GetEffectiveParams(ctx, hmTypes.RootChainTypeTron)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), params.ChildBlockInterval)
+
+	// unknown root chain, duplicate and zero intervals are invalid
+	require.Error(t, types.ValidateChildBlockIntervals([]types.ChildBlockInterval{types.NewChildBlockInterval("unknown", 1)}))
+	require.Error(t, types.ValidateChildBlockIntervals(append(intervals, intervals[0])))
+	require.Error(t, types.ValidateChildBlockIntervals([]types.ChildBlockInterval{types.NewChildBlockInterval(hmTypes.RootChainTypeEth, 0)}))
+}
@@ -26,9 +26,14 @@ var (
 	_ module.AppModule             = AppModule{}
 	_ module.AppModuleBasic        = AppModuleBasic{}
 	_ hmModule.HeimdallModuleBasic = AppModule{}
+	_ hmModule.HasConsensusVersion = AppModule{}
+	_ hmModule.HasMigrations       = AppModule{}
 	// _ module.AppModuleSimulation = AppModule{}
 )
 
+// ConsensusVersion store layout version of the module
+const ConsensusVersion uint64 = 1
+
 // AppModuleBasic defines the basic application module used by the auth module.
 type AppModuleBasic struct{}
 
@@ -125,6 +130,18 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// ConsensusVersion returns store layout version of the chainmanager module
+func (AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+// RegisterMigrations registers store migrations of the chainmanager module.
+// Bump ConsensusVersion and register migration from previous version
+// whenever key layout of the module changes.
+func (am AppModule) RegisterMigrations(cfg *hmModule.Configurator) error {
+	return nil
+}
+
 // InitGenesis performs genesis initialization for the auth module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
@@ -146,7 +163,9 @@ func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock returns the end blocker for the auth module. It returns no validator
 // updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	// snapshot chain params on change
+	am.keeper.SnapshotChainParams(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,82 @@
+package chainmanager
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ModuleCommunicator manager to access other modules' state
+type ModuleCommunicator interface {
+	SetACKCount(ctx sdk.Context, value uint64, rootChain string)
+}
+
+// NewAddRootChainProposalHandler new add root chain proposal handler
+func NewAddRootChainProposalHandler(k Keeper, moduleCommunicator ModuleCommunicator) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.MsgAddRootChain:
+			return handleMsgAddRootChain(ctx, k, moduleCommunicator, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized chainmanager proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+// handleMsgAddRootChain stores chain info and initial ack count for new root chain.
+// Proposal handlers run on cached context, so both are written or neither.
+func handleMsgAddRootChain(ctx sdk.Context, k Keeper, moduleCommunicator ModuleCommunicator, msg types.MsgAddRootChain) sdk.Error {
+	if err := k.ValidateNewRootChain(ctx, msg); err != nil {
+		return err
+	}
+
+	if err := k.AddNewChainParams(ctx, msg.GetChainInfo(uint64(ctx.BlockTime().Unix()))); err != nil {
+		k.Logger(ctx).Error("Unable to add new chain to state", "error", err, "root", msg.RootChainType)
+		return hmCommon.ErrChainPamramsExist(k.Codespace())
+	}
+
+	moduleCommunicator.SetACKCount(ctx, msg.InitialAckCount, msg.RootChainType)
+
+	k.Logger(ctx).Info("✅ New root chain added by governance",
+		"root", msg.RootChainType,
+		"activationHeight", msg.ActivationHeight,
+		"initialAckCount", msg.InitialAckCount,
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddRootChain,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatUint(msg.ActivationHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyInitialAckCount, strconv.FormatUint(msg.InitialAckCount, 10)),
+		),
+	})
+
+	return nil
+}
+
+// ValidateNewRootChain checks root chain can be added to state
+func (k *Keeper) ValidateNewRootChain(ctx sdk.Context, msg types.MsgAddRootChain) sdk.Error {
+	// eth and tron are configured through module params
+	if hmTypes.GetRootChainID(msg.RootChainType) == 0 ||
+		msg.RootChainType == hmTypes.RootChainTypeEth ||
+		msg.RootChainType == hmTypes.RootChainTypeTron {
+		k.Logger(ctx).Error("Wrong root chain type", "root", msg.RootChainType)
+		return hmCommon.ErrWrongRootChain(k.Codespace())
+	}
+
+	if _, err := k.GetChainParams(ctx, msg.RootChainType); err == nil {
+		return hmCommon.ErrChainPamramsExist(k.Codespace())
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package chainmanager_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/chainmanager"
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+type ProposalHandlerTestSuite struct {
+	suite.Suite
+
+	app     *app.HeimdallApp
+	ctx     sdk.Context
+	handler govTypes.Handler
+}
+
+func (suite *ProposalHandlerTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+	suite.handler = chainmanager.NewAddRootChainProposalHandler(suite.app.ChainKeeper, app.ModuleCommunicator{App: suite.app})
+}
+
+func TestProposalHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalHandlerTestSuite))
+}
+
+func (suite *ProposalHandlerTestSuite) TestAddRootChain() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	msg := types.NewMsgAddRootChain(
+		"Add BSC",
+		"Enable checkpoints on bsc",
+		hmTypes.RootChainTypeBsc,
+		1000,
+		15,
+		hmTypes.HexToHeimdallAddress("0x01"),
+		hmTypes.HexToHeimdallAddress("0x02"),
+		hmTypes.HexToHeimdallAddress("0x03"),
+		hmTypes.HexToHeimdallAddress("0x04"),
+		5,
+	)
+	require.Nil(t, msg.ValidateBasic())
+
+	err := suite.handler(ctx, msg)
+	require.Nil(t, err)
+
+	chainInfo, cErr := app.ChainKeeper.GetChainParams(ctx, hmTypes.RootChainTypeBsc)
+	require.NoError(t, cErr)
+	require.Equal(t, uint64(1000), chainInfo.ActivationHeight)
+	require.Equal(t, msg.RootChainAddress, chainInfo.RootChainAddress)
+	require.Equal(t, uint64(5), app.CheckpointKeeper.GetACKCount(ctx, hmTypes.RootChainTypeBsc))
+
+	// chain can be added only once
+	err = suite.handler(ctx, msg)
+	require.NotNil(t, err)
+
+	// eth and tron are configured by params
+	msg.RootChainType = hmTypes.RootChainTypeEth
+	err = suite.handler(ctx, msg)
+	require.NotNil(t, err)
+}
@@ -3,8 +3,6 @@ package chainmanager
 import (
 	"encoding/json"
 
-	hmTpyes "github.com/maticnetwork/heimdall/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -19,6 +17,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryParams(ctx, req, keeper)
 		case types.QueryNewChainParam:
 			return queryNewChainParams(ctx, req, keeper)
+		case types.QueryChainParamsAt:
+			return queryChainParamsAt(ctx, req, keeper)
+		case types.QueryFeatureFlags:
+			return queryFeatureFlags(ctx, req, keeper)
+		case types.QueryTopology:
+			return queryTopology(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown chainmanager query endpoint")
 		}
@@ -39,18 +43,55 @@ func queryNewChainParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
 	}
-	response := keeper.GetParams(ctx)
-	newChainParams, _ := keeper.GetChainParams(ctx, params.RootChain)
-	if params.RootChain == hmTpyes.RootChainTypeBsc {
-		response.MainchainTxConfirmations = newChainParams.TxConfirmations
-		response.ChainParams.RootChainAddress = newChainParams.RootChainAddress
-		response.ChainParams.StateSenderAddress = newChainParams.StateSenderAddress
-		response.ChainParams.StakingInfoAddress = newChainParams.StakingInfoAddress
-		response.ChainParams.StakingManagerAddress = newChainParams.StakingManagerAddress
+	response, _ := keeper.GetEffectiveParams(ctx, params.RootChain)
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query for params in effect for root chain at given height
+func queryChainParamsAt(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryChainParamsAt
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	response, err := keeper.GetChainParamsAt(ctx, params.RootChain, params.Height)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch chain params", err.Error()))
 	}
+
 	bz, err := json.Marshal(response)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
 	return bz, nil
 }
+
+// query for feature flags and their state at current height
+func queryFeatureFlags(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	statuses := []types.FeatureFlagStatus{}
+	for _, flag := range keeper.GetFeatureFlags(ctx) {
+		statuses = append(statuses, types.FeatureFlagStatus{
+			FeatureFlag: flag,
+			Enabled:     flag.IsEnabled(ctx.BlockHeight()),
+		})
+	}
+
+	bz, err := json.Marshal(statuses)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query for topology of bor chain and its root chains
+func queryTopology(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetChainTopology(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
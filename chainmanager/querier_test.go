@@ -9,6 +9,7 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/stretchr/testify/suite"
@@ -90,3 +91,57 @@ func (suite *QuerierTestSuite) TestQueryParams() {
 		})
 	}
 }
+
+// TestQueryTopology queries topology of root chains
+func (suite *QuerierTestSuite) TestQueryTopology() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	params := types.DefaultParams()
+	params.ChainParams.RootChainAddress = hmTypes.HexToHeimdallAddress("0x01")
+	params.ChainParams.StateSenderAddress = hmTypes.HexToHeimdallAddress("0x02")
+	app.ChainKeeper.SetParams(ctx, params)
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 7, hmTypes.RootChainTypeEth)
+
+	query := func() (topology types.ChainTopology) {
+		res, err := querier(ctx, []string{types.QueryTopology}, abci.RequestQuery{})
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(res, &topology))
+		return
+	}
+
+	// bsc is not added yet
+	topology := query()
+	require.Equal(t, params.ChainParams.BorChainID, topology.BorChainID)
+	require.Len(t, topology.RootChains, 2)
+
+	eth := topology.RootChains[0]
+	require.Equal(t, hmTypes.RootChainTypeEth, eth.RootChainType)
+	require.Equal(t, params.MainchainTxConfirmations, eth.TxConfirmations)
+	require.Equal(t, uint64(7), eth.LastCheckpointNumber)
+	require.Equal(t, params.ChainParams.RootChainAddress.String(), eth.Contracts.RootChainAddress)
+	require.Equal(t, types.RootChainFeatures{Checkpoint: true, StateSync: true}, eth.Features)
+
+	tron := topology.RootChains[1]
+	require.Equal(t, hmTypes.RootChainTypeTron, tron.RootChainType)
+	require.Equal(t, params.TronchainTxConfirmations, tron.TxConfirmations)
+	require.Equal(t, types.RootChainFeatures{}, tron.Features)
+
+	// bsc is listed once added
+	chainInfo := types.ChainInfo{
+		RootChainType:         hmTypes.RootChainTypeBsc,
+		ActivationHeight:      100,
+		TxConfirmations:       12,
+		RootChainAddress:      hmTypes.HexToHeimdallAddress("0x03"),
+		StakingManagerAddress: hmTypes.HexToHeimdallAddress("0x04"),
+	}
+	require.NoError(t, app.ChainKeeper.AddNewChainParams(ctx, chainInfo))
+
+	topology = query()
+	require.Len(t, topology.RootChains, 3)
+
+	bsc := topology.RootChains[0]
+	require.Equal(t, hmTypes.RootChainTypeBsc, bsc.RootChainType)
+	require.Equal(t, chainInfo.ActivationHeight, bsc.ActivationHeight)
+	require.Equal(t, chainInfo.TxConfirmations, bsc.TxConfirmations)
+	require.Equal(t, types.RootChainFeatures{Checkpoint: true, StakingSync: true}, bsc.Features)
+}
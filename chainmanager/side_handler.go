@@ -51,9 +51,9 @@ func SideHandleMsgNewChain(ctx sdk.Context, msg types.MsgNewChain, k Keeper, con
 	// get event log on tron
 	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
 	if err != nil || receipt == nil {
-		return common.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return common.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
 	}
-	contractAddress = hmTypes.HexToTronAddress(chainParams.TronChainAddress)
+	contractAddress = chainParams.TronChainAddress.EthAddress()
 	// decode validator join event
 	eventLog, err := contractCaller.DecodeNewChainEvent(contractAddress, receipt, msg.LogIndex)
 	if err != nil || eventLog == nil {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/maticnetwork/heimdall/types"
 )
@@ -25,3 +26,14 @@ func (s *ChainInfo) String() string {
 		s.RootChainType, s.ActivationHeight, s.TxConfirmations, s.RootChainAddress, s.StateSenderAddress, s.StakingManagerAddress, s.StakingInfoAddress,
 	)
 }
+
+// SortedRootChains returns all root chains sorted by name, so they're iterated in same order on every node
+func SortedRootChains() []string {
+	rootChains := make([]string, 0, len(types.GetRootChainIDMap()))
+	for rootChain := range types.GetRootChainIDMap() {
+		rootChains = append(rootChains, rootChain)
+	}
+	sort.Strings(rootChains)
+
+	return rootChains
+}
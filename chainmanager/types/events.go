@@ -2,10 +2,12 @@ package types
 
 // Checkpoint tags
 var (
-	EventTypeNewChain = "new-chain"
+	EventTypeNewChain     = "new-chain"
+	EventTypeAddRootChain = "add-root-chain"
 
 	AttributeKeyActivationHeight = "activation-height"
 	AttributeKeyRootChain        = "root-chain"
+	AttributeKeyInitialAckCount  = "initial-ack-count"
 
 	AttributeValueCategory = ModuleName
 )
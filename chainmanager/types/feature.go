@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Feature flags gating new behaviors, features ship disabled and are activated
+// at a height through governance so whole validator set switches at once
+const (
+	// FeatureSyncRootVerification checkpoint sync side-tx verifies root hash of
+	// header on root chain against checkpoint acked in heimdall
+	FeatureSyncRootVerification = "sync-root-verification"
+)
+
+// KeyFeatureFlags param key of feature flags
+var KeyFeatureFlags = []byte("FeatureFlags")
+
+// FeatureFlag enables feature from height
+type FeatureFlag struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+}
+
+// NewFeatureFlag creates new feature flag
+func NewFeatureFlag(name string, height int64) FeatureFlag {
+	return FeatureFlag{
+		Name:   name,
+		Height: height,
+	}
+}
+
+// IsEnabled returns true if feature is enabled at height
+func (f FeatureFlag) IsEnabled(height int64) bool {
+	return f.Height > 0 && height >= f.Height
+}
+
+// FeatureFlagStatus feature flag with its state at queried height
+type FeatureFlagStatus struct {
+	FeatureFlag
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+// String returns string representation of feature flag
+func (f FeatureFlag) String() string {
+	return fmt.Sprintf("FeatureFlag{%v %v}", f.Name, f.Height)
+}
+
+// ValidateFeatureFlags checks names are set and unique and heights are positive
+func ValidateFeatureFlags(flags []FeatureFlag) error {
+	names := make(map[string]bool, len(flags))
+	for _, flag := range flags {
+		if flag.Name == "" {
+			return fmt.Errorf("feature flag name is empty")
+		}
+
+		if names[flag.Name] {
+			return fmt.Errorf("duplicate feature flag %v", flag.Name)
+		}
+		names[flag.Name] = true
+
+		if flag.Height <= 0 {
+			return fmt.Errorf("invalid height %v of feature flag %v", flag.Height, flag.Name)
+		}
+	}
+
+	return nil
+}
@@ -13,25 +13,35 @@ type GenesisState struct {
 	Params Params `json:"params" yaml:"params"`
 
 	ChainInfos []ChainInfo `json:"chain_infos" yaml:"chain_infos"`
+
+	FeatureFlags []FeatureFlag `json:"feature_flags" yaml:"feature_flags"`
+
+	ChildBlockIntervals []ChildBlockInterval `json:"child_block_intervals" yaml:"child_block_intervals"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params Params, chainInfos []ChainInfo) GenesisState {
+func NewGenesisState(params Params, chainInfos []ChainInfo, featureFlags []FeatureFlag, childBlockIntervals []ChildBlockInterval) GenesisState {
 	return GenesisState{
-		Params:     params,
-		ChainInfos: chainInfos,
+		Params:              params,
+		ChainInfos:          chainInfos,
+		FeatureFlags:        featureFlags,
+		ChildBlockIntervals: childBlockIntervals,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams(), []ChainInfo{})
+	return NewGenesisState(DefaultParams(), []ChainInfo{}, []FeatureFlag{}, DefaultChildBlockIntervals())
 }
 
 // ValidateGenesis performs basic validation of auth genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
-	return nil
+	if err := ValidateFeatureFlags(data.FeatureFlags); err != nil {
+		return err
+	}
+
+	return ValidateChildBlockIntervals(data.ChildBlockIntervals)
 }
 
 // GetGenesisStateFromAppState returns staking GenesisState given raw application genesis state
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// DefaultChildBlockInterval interval of root chain contracts which were deployed with default constant
+const DefaultChildBlockInterval uint64 = 10000
+
+// KeyChildBlockIntervals param key of child block interval per root chain
+var KeyChildBlockIntervals = []byte("ChildBlockIntervals")
+
+// ChildBlockInterval is distance between header block ids of consecutive checkpoints
+// in root chain contract, it is a constant of the deployed contract
+type ChildBlockInterval struct {
+	RootChainType string `json:"root_chain_type" yaml:"root_chain_type"`
+	Interval      uint64 `json:"interval" yaml:"interval"`
+}
+
+// NewChildBlockInterval creates child block interval of root chain
+func NewChildBlockInterval(rootChain string, interval uint64) ChildBlockInterval {
+	return ChildBlockInterval{
+		RootChainType: rootChain,
+		Interval:      interval,
+	}
+}
+
+// String returns string representation of child block interval
+func (i ChildBlockInterval) String() string {
+	return fmt.Sprintf("ChildBlockInterval{%v %v}", i.RootChainType, i.Interval)
+}
+
+// NewChildBlockIntervals returns same interval for every root chain, ordered by root chain
+func NewChildBlockIntervals(interval uint64) []ChildBlockInterval {
+	rootChains := SortedRootChains()
+	intervals := make([]ChildBlockInterval, 0, len(rootChains))
+	for _, rootChain := range rootChains {
+		intervals = append(intervals, NewChildBlockInterval(rootChain, interval))
+	}
+	return intervals
+}
+
+// DefaultChildBlockIntervals returns default interval for every root chain
+func DefaultChildBlockIntervals() []ChildBlockInterval {
+	return NewChildBlockIntervals(DefaultChildBlockInterval)
+}
+
+// GetChildBlockInterval returns interval of root chain, default interval is returned if root chain has none
+func GetChildBlockInterval(intervals []ChildBlockInterval, rootChain string) uint64 {
+	for _, interval := range intervals {
+		if interval.RootChainType == rootChain {
+			return interval.Interval
+		}
+	}
+	return DefaultChildBlockInterval
+}
+
+// ValidateChildBlockIntervals checks root chains are known and unique and intervals are non-zero
+func ValidateChildBlockIntervals(intervals []ChildBlockInterval) error {
+	rootChains := make(map[string]bool, len(intervals))
+	for _, interval := range intervals {
+		if hmTypes.GetRootChainID(interval.RootChainType) == 0 {
+			return fmt.Errorf("unknown root chain %v of child block interval", interval.RootChainType)
+		}
+
+		if rootChains[interval.RootChainType] {
+			return fmt.Errorf("duplicate child block interval of root chain %v", interval.RootChainType)
+		}
+		rootChains[interval.RootChainType] = true
+
+		if interval.Interval == 0 {
+			return fmt.Errorf("child block interval of root chain %v should be greater than zero", interval.RootChainType)
+		}
+	}
+
+	return nil
+}
@@ -45,10 +45,10 @@ type ChainParams struct {
 	StateSenderAddress    hmTypes.HeimdallAddress `json:"state_sender_address" yaml:"state_sender_address"`
 
 	// tron
-	TronChainAddress          string `json:"tron_chain_address" yaml:"tron_chain_address"`
-	TronStateSenderAddress    string `json:"tron_state_sender_address" yaml:"tron_state_sender_address"`
-	TronStakingManagerAddress string `json:"tron_staking_manager_address" yaml:"tron_staking_manager_address"`
-	TronStakingInfoAddress    string `json:"tron_state_info_address" yaml:"tron_state_info_address"`
+	TronChainAddress          hmTypes.TronAddress `json:"tron_chain_address" yaml:"tron_chain_address"`
+	TronStateSenderAddress    hmTypes.TronAddress `json:"tron_state_sender_address" yaml:"tron_state_sender_address"`
+	TronStakingManagerAddress hmTypes.TronAddress `json:"tron_staking_manager_address" yaml:"tron_staking_manager_address"`
+	TronStakingInfoAddress    hmTypes.TronAddress `json:"tron_state_info_address" yaml:"tron_state_info_address"`
 
 	// Bor Chain Contracts
 	StateReceiverAddress hmTypes.HeimdallAddress `json:"state_receiver_address" yaml:"state_receiver_address"`
@@ -75,6 +75,10 @@ type Params struct {
 	MaticchainTxConfirmations uint64      `json:"maticchain_tx_confirmations" yaml:"maticchain_tx_confirmations"`
 	TronchainTxConfirmations  uint64      `json:"tronchain_tx_confirmations" yaml:"tronchain_tx_confirmations"`
 	ChainParams               ChainParams `json:"chain_params" yaml:"chain_params"`
+
+	// ChildBlockInterval is not a param of its own, it is filled in params in effect
+	// for root chain from KeyChildBlockIntervals
+	ChildBlockInterval uint64 `json:"child_block_interval,omitempty" yaml:"child_block_interval,omitempty"`
 }
 
 // NewParams creates a new Params object
@@ -150,6 +154,36 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	// tron contracts are optional
+	if err := validateTronAddress("tron_chain_address", p.ChainParams.TronChainAddress); err != nil {
+		return err
+	}
+
+	if err := validateTronAddress("tron_state_sender_address", p.ChainParams.TronStateSenderAddress); err != nil {
+		return err
+	}
+
+	if err := validateTronAddress("tron_staking_manager_address", p.ChainParams.TronStakingManagerAddress); err != nil {
+		return err
+	}
+
+	if err := validateTronAddress("tron_state_info_address", p.ChainParams.TronStakingInfoAddress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTronAddress validates tron contract address, tron contracts are optional
+func validateTronAddress(key string, value hmTypes.TronAddress) error {
+	if value.Empty() {
+		return nil
+	}
+
+	if err := value.Validate(); err != nil {
+		return fmt.Errorf("Invalid value %s in chain_params: %v", key, err)
+	}
+
 	return nil
 }
 
@@ -167,7 +201,8 @@ func validateHeimdallAddress(key string, value hmTypes.HeimdallAddress) error {
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().RegisterParamSet(&Params{}).RegisterType(KeyFeatureFlags, []FeatureFlag{}).
+		RegisterType(KeyChildBlockIntervals, []ChildBlockInterval{})
 }
 
 // DefaultParams returns a default set of parameters.
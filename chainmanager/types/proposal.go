@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// ProposalTypeAddRootChain defines the type for a MsgAddRootChain
+	ProposalTypeAddRootChain = "AddRootChain"
+)
+
+// Assert MsgAddRootChain implements govTypes.Content at compile-time
+var _ govTypes.Content = MsgAddRootChain{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeAddRootChain)
+	govTypes.RegisterProposalTypeCodec(MsgAddRootChain{}, "chainmanager/MsgAddRootChain")
+}
+
+// MsgAddRootChain governance proposal which adds new root chain with
+// activation height, contract addresses and initial ack count at once.
+type MsgAddRootChain struct {
+	Title                 string                  `json:"title" yaml:"title"`
+	Description           string                  `json:"description" yaml:"description"`
+	RootChainType         string                  `json:"root_chain_type" yaml:"root_chain_type"`
+	ActivationHeight      uint64                  `json:"activation_height" yaml:"activation_height"`
+	TxConfirmations       uint64                  `json:"tx_confirmations" yaml:"tx_confirmations"`
+	RootChainAddress      hmTypes.HeimdallAddress `json:"root_chain_address" yaml:"root_chain_address"`
+	StateSenderAddress    hmTypes.HeimdallAddress `json:"state_sender_address" yaml:"state_sender_address"`
+	StakingManagerAddress hmTypes.HeimdallAddress `json:"staking_manager_address" yaml:"staking_manager_address"`
+	StakingInfoAddress    hmTypes.HeimdallAddress `json:"staking_info_address" yaml:"staking_info_address"`
+	InitialAckCount       uint64                  `json:"initial_ack_count" yaml:"initial_ack_count"`
+}
+
+// NewMsgAddRootChain creates new add root chain proposal
+func NewMsgAddRootChain(
+	title string,
+	description string,
+	rootChain string,
+	activationHeight uint64,
+	txConfirmations uint64,
+	rootChainAddress hmTypes.HeimdallAddress,
+	stateSenderAddress hmTypes.HeimdallAddress,
+	stakingManagerAddress hmTypes.HeimdallAddress,
+	stakingInfoAddress hmTypes.HeimdallAddress,
+	initialAckCount uint64,
+) MsgAddRootChain {
+	return MsgAddRootChain{
+		Title:                 title,
+		Description:           description,
+		RootChainType:         rootChain,
+		ActivationHeight:      activationHeight,
+		TxConfirmations:       txConfirmations,
+		RootChainAddress:      rootChainAddress,
+		StateSenderAddress:    stateSenderAddress,
+		StakingManagerAddress: stakingManagerAddress,
+		StakingInfoAddress:    stakingInfoAddress,
+		InitialAckCount:       initialAckCount,
+	}
+}
+
+// GetTitle returns the title of add root chain proposal
+func (msg MsgAddRootChain) GetTitle() string { return msg.Title }
+
+// GetDescription returns the description of add root chain proposal
+func (msg MsgAddRootChain) GetDescription() string { return msg.Description }
+
+// ProposalRoute returns the routing key of add root chain proposal
+func (msg MsgAddRootChain) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of add root chain proposal
+func (msg MsgAddRootChain) ProposalType() string { return ProposalTypeAddRootChain }
+
+// ValidateBasic validates add root chain proposal
+func (msg MsgAddRootChain) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(hmCommon.DefaultCodespace, msg); err != nil {
+		return err
+	}
+
+	if hmTypes.GetRootChainID(msg.RootChainType) == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root chain type %v", msg.RootChainType)
+	}
+
+	if msg.ActivationHeight == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid activation height %v", msg.ActivationHeight)
+	}
+
+	if msg.TxConfirmations == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid tx confirmations %v", msg.TxConfirmations)
+	}
+
+	if msg.RootChainAddress.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root address %v", msg.RootChainAddress.String())
+	}
+
+	if msg.StateSenderAddress.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid state send address %v", msg.StateSenderAddress.String())
+	}
+
+	if msg.StakingManagerAddress.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid staking manager address %v", msg.StakingManagerAddress.String())
+	}
+
+	if msg.StakingInfoAddress.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid staking info address %v", msg.StakingInfoAddress.String())
+	}
+
+	return nil
+}
+
+// GetChainInfo returns chain info to be stored for root chain
+func (msg MsgAddRootChain) GetChainInfo(timestamp uint64) ChainInfo {
+	return ChainInfo{
+		RootChainType:         msg.RootChainType,
+		ActivationHeight:      msg.ActivationHeight,
+		TxConfirmations:       msg.TxConfirmations,
+		RootChainAddress:      msg.RootChainAddress,
+		StateSenderAddress:    msg.StateSenderAddress,
+		StakingManagerAddress: msg.StakingManagerAddress,
+		StakingInfoAddress:    msg.StakingInfoAddress,
+		TimeStamp:             timestamp,
+	}
+}
+
+// String implements the Stringer interface.
+func (msg MsgAddRootChain) String() string {
+	return fmt.Sprintf(`Add Root Chain Proposal:
+  Title:                 %s
+  Description:           %s
+  RootChainType:         %s
+  ActivationHeight:      %d
+  TxConfirmations:       %d
+  RootChainAddress:      %s
+  StateSenderAddress:    %s
+  StakingManagerAddress: %s
+  StakingInfoAddress:    %s
+  InitialAckCount:       %d
+`, msg.Title, msg.Description, msg.RootChainType, msg.ActivationHeight, msg.TxConfirmations,
+		msg.RootChainAddress, msg.StateSenderAddress, msg.StakingManagerAddress, msg.StakingInfoAddress,
+		msg.InitialAckCount)
+}
@@ -4,6 +4,9 @@ package types
 const (
 	QueryParams        = "params"
 	QueryNewChainParam = "chain-params"
+	QueryChainParamsAt = "chain-params-at"
+	QueryFeatureFlags  = "feature-flags"
+	QueryTopology      = "topology"
 )
 
 // QueryChainParams defines the params for querying accounts.
@@ -17,3 +20,17 @@ func NewQueryChainParams(rootChain string) QueryChainParams {
 		RootChain: rootChain,
 	}
 }
+
+// QueryChainParamsAt defines the params for querying chain params at height.
+type QueryChainParamsAt struct {
+	RootChain string
+	Height    int64
+}
+
+// NewQueryChainParamsAt creates a new instance of QueryChainParamsAt.
+func NewQueryChainParamsAt(rootChain string, height int64) QueryChainParamsAt {
+	return QueryChainParamsAt{
+		RootChain: rootChain,
+		Height:    height,
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ChainParamsSnapshot represents params in effect for a root chain starting at height
+type ChainParamsSnapshot struct {
+	RootChainType string `json:"root_chain_type" yaml:"root_chain_type"`
+	Height        int64  `json:"height" yaml:"height"`
+	Params        Params `json:"params" yaml:"params"`
+}
+
+// NewChainParamsSnapshot creates new chain params snapshot
+func NewChainParamsSnapshot(rootChain string, height int64, params Params) ChainParamsSnapshot {
+	return ChainParamsSnapshot{
+		RootChainType: rootChain,
+		Height:        height,
+		Params:        params,
+	}
+}
+
+// String returns the string representation of snapshot
+func (s ChainParamsSnapshot) String() string {
+	return fmt.Sprintf("ChainParamsSnapshot{%v %v %v}", s.RootChainType, s.Height, s.Params.String())
+}
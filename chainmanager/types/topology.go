@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ChainTopology is topology of bor chain and root chains it checkpoints to, bridges and
+// explorers can configure themselves from it
+type ChainTopology struct {
+	BorChainID           string              `json:"bor_chain_id" yaml:"bor_chain_id"`
+	StateReceiverAddress string              `json:"state_receiver_address" yaml:"state_receiver_address"`
+	ValidatorSetAddress  string              `json:"validator_set_address" yaml:"validator_set_address"`
+	RootChains           []RootChainTopology `json:"root_chains" yaml:"root_chains"`
+}
+
+// RootChainTopology is configuration and sync state of root chain
+type RootChainTopology struct {
+	RootChainType        string             `json:"root_chain_type" yaml:"root_chain_type"`
+	ActivationHeight     uint64             `json:"activation_height" yaml:"activation_height"`
+	TxConfirmations      uint64             `json:"tx_confirmations" yaml:"tx_confirmations"`
+	ChildBlockInterval   uint64             `json:"child_block_interval" yaml:"child_block_interval"`
+	Contracts            RootChainContracts `json:"contracts" yaml:"contracts"`
+	LastCheckpointNumber uint64             `json:"last_checkpoint_number" yaml:"last_checkpoint_number"`
+	Features             RootChainFeatures  `json:"features" yaml:"features"`
+}
+
+// RootChainContracts addresses of root chain contracts, empty if contract is not deployed.
+// Tron addresses are in hex, same as the ones of chain params.
+type RootChainContracts struct {
+	RootChainAddress      string `json:"root_chain_address" yaml:"root_chain_address"`
+	StateSenderAddress    string `json:"state_sender_address" yaml:"state_sender_address"`
+	StakingManagerAddress string `json:"staking_manager_address" yaml:"staking_manager_address"`
+	StakingInfoAddress    string `json:"staking_info_address" yaml:"staking_info_address"`
+}
+
+// RootChainFeatures features enabled for root chain, feature is enabled when its contract is set
+type RootChainFeatures struct {
+	Checkpoint  bool `json:"checkpoint" yaml:"checkpoint"`
+	StakingSync bool `json:"staking_sync" yaml:"staking_sync"`
+	StateSync   bool `json:"state_sync" yaml:"state_sync"`
+}
+
+// NewRootChainFeatures returns features enabled by contracts of root chain
+func NewRootChainFeatures(contracts RootChainContracts) RootChainFeatures {
+	return RootChainFeatures{
+		Checkpoint:  contracts.RootChainAddress != "",
+		StakingSync: contracts.StakingManagerAddress != "",
+		StateSync:   contracts.StateSenderAddress != "",
+	}
+}
+
+// String returns string representation of root chain topology
+func (t RootChainTopology) String() string {
+	return fmt.Sprintf("RootChainTopology{%v activation: %v confirmations: %v lastCheckpoint: %v features: %+v}",
+		t.RootChainType, t.ActivationHeight, t.TxConfirmations, t.LastCheckpointNumber, t.Features)
+}
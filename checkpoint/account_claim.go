@@ -0,0 +1,147 @@
+package checkpoint
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetAccountSnapshotKey returns key of dividend accounts of account root hash
+func GetAccountSnapshotKey(accountRoot []byte) []byte {
+	return append(append([]byte{}, AccountSnapshotKey...), accountRoot...)
+}
+
+// GetCheckpointAccountRootKey returns key of account root hash of checkpoint starting at start block
+func GetCheckpointAccountRootKey(rootChain string, startBlock uint64) []byte {
+	key := append(append([]byte{}, AccountRootKey...), hmTypes.GetRootChainID(rootChain))
+	return append(key, sdk.Uint64ToBigEndian(startBlock)...)
+}
+
+// SetCheckpointAccountRoot records account root hash of checkpoint buffered for root chain,
+// as stored checkpoints don't carry it
+func (k *Keeper) SetCheckpointAccountRoot(ctx sdk.Context, rootChain string, startBlock uint64, accountRoot hmTypes.HeimdallHash) {
+	ctx.KVStore(k.storeKey).Set(GetCheckpointAccountRootKey(rootChain, startBlock), accountRoot.Bytes())
+}
+
+// GetCheckpointAccountRoot returns account root hash of checkpoint starting at start block
+func (k *Keeper) GetCheckpointAccountRoot(ctx sdk.Context, rootChain string, startBlock uint64) (hmTypes.HeimdallHash, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetCheckpointAccountRootKey(rootChain, startBlock))
+	if bz == nil {
+		return hmTypes.HeimdallHash{}, false
+	}
+	return hmTypes.BytesToHeimdallHash(bz), true
+}
+
+// SnapshotAccountRoot records dividend accounts account root of checkpoint was computed from,
+// once per distinct root. Accounts precomputed along with root at end of last block are used,
+// current ones only if root was computed from them.
+func (k *Keeper) SnapshotAccountRoot(ctx sdk.Context, accountRoot []byte) error {
+	store := ctx.KVStore(k.storeKey)
+
+	key := GetAccountSnapshotKey(accountRoot)
+	if store.Has(key) {
+		return nil
+	}
+
+	if bytes.Equal(store.Get(AccountRootHashKey), accountRoot) && store.Has(AccountsKey) {
+		store.Set(key, store.Get(AccountsKey))
+		return nil
+	}
+
+	dividendAccounts := k.moduleCommunicator.GetAllDividendAccounts(ctx)
+	root, err := types.GetAccountRootHash(dividendAccounts)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(root, accountRoot) {
+		return fmt.Errorf("dividend accounts of account root %v not found", hmTypes.BytesToHeimdallHash(accountRoot))
+	}
+
+	out, err := k.marshalPayload(ctx, types.AccountRootSnapshot{
+		AccountRootHash: hmTypes.BytesToHeimdallHash(accountRoot),
+		Accounts:        dividendAccounts,
+	})
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, out)
+	return nil
+}
+
+// GetAccountRootSnapshot returns dividend accounts of account root hash
+func (k *Keeper) GetAccountRootSnapshot(ctx sdk.Context, accountRoot []byte) (snapshot types.AccountRootSnapshot, err error) {
+	bz := ctx.KVStore(k.storeKey).Get(GetAccountSnapshotKey(accountRoot))
+	if bz == nil {
+		return snapshot, fmt.Errorf("dividend accounts of account root %v not found", hmTypes.BytesToHeimdallHash(accountRoot))
+	}
+
+	err = k.unmarshalPayload(bz, &snapshot)
+	return snapshot, err
+}
+
+// GetDividendAccountClaim returns merkle proof of dividend account of user against account root hash
+// of checkpoint, along with claimFee call data of stake manager. Only checkpoints proposed after
+// accounts were recorded can be claimed against.
+func (k *Keeper) GetDividendAccountClaim(ctx sdk.Context, number uint64, rootChain string, user hmTypes.HeimdallAddress) (claim types.DividendAccountClaim, err error) {
+	checkpoint, err := k.GetCheckpointByNumber(ctx, number, rootChain)
+	if err != nil {
+		return claim, err
+	}
+
+	accountRoot, ok := k.GetCheckpointAccountRoot(ctx, rootChain, checkpoint.StartBlock)
+	if !ok {
+		return claim, fmt.Errorf("account root hash of checkpoint %v not recorded", number)
+	}
+
+	snapshot, err := k.GetAccountRootSnapshot(ctx, accountRoot.Bytes())
+	if err != nil {
+		return claim, err
+	}
+
+	var account hmTypes.DividendAccount
+	found := false
+	for _, dividendAccount := range snapshot.Accounts {
+		if dividendAccount.User.Equals(user) {
+			account, found = dividendAccount, true
+			break
+		}
+	}
+
+	if !found {
+		return claim, fmt.Errorf("no dividend account of %v in checkpoint %v", user, number)
+	}
+
+	proof, index, err := types.GetAccountProof(snapshot.Accounts, user)
+	if err != nil {
+		return claim, err
+	}
+
+	feeAmount, ok := big.NewInt(0).SetString(account.FeeAmount, 10)
+	if !ok {
+		return claim, errors.New("invalid fee amount of dividend account")
+	}
+
+	calldata, err := types.PackClaimFee(feeAmount, index, proof)
+	if err != nil {
+		return claim, err
+	}
+
+	return types.DividendAccountClaim{
+		Number:          number,
+		RootChain:       rootChain,
+		AccountRootHash: accountRoot,
+		User:            user,
+		FeeAmount:       account.FeeAmount,
+		Index:           index,
+		Proof:           proof,
+		Calldata:        calldata,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package checkpoint
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SetAckGracePeriod sets time only checkpoint proposer may ack buffered checkpoint
+func (k *Keeper) SetAckGracePeriod(ctx sdk.Context, gracePeriod time.Duration) {
+	k.paramSpace.Set(ctx, types.KeyAckGracePeriod, gracePeriod)
+}
+
+// GetAckGracePeriod returns ack grace period, zero if it was never set
+func (k *Keeper) GetAckGracePeriod(ctx sdk.Context) (gracePeriod time.Duration) {
+	k.paramSpace.GetIfExists(ctx, types.KeyAckGracePeriod, &gracePeriod)
+	return gracePeriod
+}
+
+// IsCheckpointAcked returns true if checkpoint number of root chain is already acked
+func (k *Keeper) IsCheckpointAcked(ctx sdk.Context, number uint64, rootChain string) bool {
+	_, err := k.GetCheckpointByNumber(ctx, number, rootChain)
+	return err == nil
+}
+
+// validateAckSender checks sender may ack buffered checkpoint. Proposer of checkpoint, on heimdall or
+// on root chain, may ack it right away, other active validators once grace period has passed.
+func validateAckSender(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck, buffer hmTypes.Checkpoint) sdk.Error {
+	gracePeriod := k.GetAckGracePeriod(ctx)
+	if gracePeriod <= 0 || msg.From.Equals(buffer.Proposer) || msg.From.Equals(msg.Proposer) {
+		return nil
+	}
+
+	if !k.sk.IsCurrentValidatorByAddress(ctx, msg.From.Bytes()) {
+		return common.ErrNoValidator(k.Codespace())
+	}
+
+	if graceEnd := types.AckGraceEnd(buffer, gracePeriod); uint64(ctx.BlockTime().Unix()) < graceEnd {
+		return common.ErrAckGracePeriod(k.Codespace(), graceEnd)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package checkpoint
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// CheckCheckpointAdmission checks checkpoint can be accepted on top of current state: buffer queue of
+// root chain has room, start block continues tip and proposer is allowed to propose now. It doesn't
+// modify state, so it's used to keep checkpoints which handler would reject out of mempool before
+// they reach side-tx round and make validators query root and bor chains.
+func (k *Keeper) CheckCheckpointAdmission(ctx sdk.Context, msg types.MsgCheckpoint) sdk.Error {
+	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
+	if err == nil {
+		timeStamp := uint64(ctx.BlockTime().Unix())
+		checkpointBufferTime := uint64(k.GetParams(ctx).CheckpointBufferTime.Seconds())
+
+		// expired buffer is flushed by handler, tip and proposers change after that
+		if checkpointBuffer.TimeStamp == 0 || ((timeStamp > checkpointBuffer.TimeStamp) && timeStamp-checkpointBuffer.TimeStamp >= checkpointBufferTime) {
+			return nil
+		}
+
+		if k.GetCheckpointBufferQueue(ctx, msg.RootChainType).IsFull() {
+			return common.ErrNoACK(k.Codespace(), checkpointBuffer.TimeStamp+checkpointBufferTime)
+		}
+	}
+
+	if err := k.validateCheckpointTip(ctx, msg); err != nil {
+		return err
+	}
+
+	return k.validateCheckpointProposer(ctx, msg)
+}
+
+// CheckCheckpointAckAdmission checks ack doesn't refer checkpoint beyond current header block of root chain
+// contract. Bridges race contract confirmation, so premature acks are rejected before they cost side-tx
+// vote round. It runs in CheckTx, so root chain is never called: header block is read by background loop
+// with verifier of check state and ack is admitted (and left to side-tx round) while no recent header block
+// was read with verifier of current check state.
+func (k *Keeper) CheckCheckpointAckAdmission(ctx sdk.Context, msg types.MsgCheckpointAck, contractCaller helper.IContractCaller) sdk.Error {
+	logger := k.Logger(ctx)
+	if k.headerBlocks == nil {
+		return nil
+	}
+
+	// verifier reads chain params only, header block is read with it by background loop
+	verifier, err := k.GetRootChainVerifier(ctx, msg.RootChainType, contractCaller)
+	if err != nil {
+		return nil
+	}
+	k.headerBlocks.track(msg.RootChainType, verifier, ctx.BlockHeight(), logger)
+
+	currentHeaderBlock, ok := k.headerBlocks.get(msg.RootChainType, ctx.BlockHeight(), time.Now())
+	if !ok {
+		// header block of current check state is read right away, ack is left to side-tx round meanwhile
+		k.headerBlocks.requestRefresh()
+		return nil
+	}
+
+	if msg.Number > currentHeaderBlock {
+		logger.Debug("Checkpoint ack is ahead of root chain contract",
+			"root", msg.RootChainType,
+			"number", msg.Number,
+			"currentHeaderBlock", currentHeaderBlock,
+		)
+
+		// checkpoint might have been submitted since last read, resubmitted ack is checked against fresh one
+		k.headerBlocks.requestRefresh()
+		return common.ErrBadAck(k.Codespace())
+	}
+
+	return nil
+}
+
+// RefreshCurrentHeaderBlocks reads current header blocks of root chains acks were checked for,
+// same as background loop does
+func (k *Keeper) RefreshCurrentHeaderBlocks() {
+	if k.headerBlocks != nil {
+		k.headerBlocks.refreshAll()
+	}
+}
+
+// validateCheckpointProposer checks msg proposer is in allowed proposer window: current proposer or new
+// signer of pending rotation, backup proposer of expired range, standby proposer after grace window of
+// primary, or aggregation proposer which hasn't submitted range yet
+func (k *Keeper) validateCheckpointProposer(ctx sdk.Context, msg types.MsgCheckpoint) sdk.Error {
+	logger := k.Logger(ctx)
+
+	// Check proposer in message
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	if validatorSet.Proposer == nil {
+		logger.Error("No proposer in validator set", "msgProposer", msg.Proposer.String())
+		return common.ErrInvalidMsg(k.Codespace(), "No proposer in stored validator set")
+	}
+
+	if k.GetCheckpointAggregation(ctx).Enabled() {
+		// each aggregation proposer submits same range once
+		if !k.IsAggregationProposer(ctx, msg.Proposer) {
+			logger.Error("Invalid aggregation proposer in msg", "msgProposer", msg.Proposer.String())
+			return common.ErrInvalidMsg(k.Codespace(), "Invalid proposer in msg")
+		}
+
+		if k.HasAggregationVote(ctx, msg.RootChainType, msg.Proposer, msg.StartBlock) {
+			logger.Error("Aggregation proposer already submitted checkpoint",
+				"msgProposer", msg.Proposer.String(), "startBlock", msg.StartBlock, "root", msg.RootChainType)
+			return common.ErrInvalidMsg(k.Codespace(), "Checkpoint already submitted by proposer")
+		}
+	} else if expiry, ok := k.GetActiveBufferExpiry(ctx, msg.RootChainType, msg.StartBlock); ok &&
+		!k.sk.IsValidatorSigner(ctx, *validatorSet.Proposer, msg.Proposer) {
+		// expired range is resubmitted by backup proposers within their windows
+		backup, allowed := k.GetBackupProposer(ctx, *expiry, msg.Proposer)
+		if !allowed {
+			logger.Error(
+				"Invalid backup proposer in msg",
+				"proposer", validatorSet.Proposer.Signer.String(),
+				"msgProposer", msg.Proposer.String(),
+				"expiredAt", expiry.ExpiredAt,
+			)
+			return common.ErrInvalidMsg(k.Codespace(), "Invalid proposer in msg")
+		}
+
+		logger.Info(
+			"Accepting expired checkpoint from backup proposer",
+			"proposer", validatorSet.Proposer.Signer.String(),
+			"backup", msg.Proposer.String(),
+			"position", backup.Position,
+		)
+	} else if !k.sk.IsValidatorSigner(ctx, *validatorSet.Proposer, msg.Proposer) { // new signer of pending rotation proposes too
+		// accept standby proposer once primary's grace window has elapsed
+		standby, eligible := k.GetEligibleStandbyProposer(ctx, msg.Proposer)
+		if !eligible {
+			logger.Error(
+				"Invalid proposer in msg",
+				"proposer", validatorSet.Proposer.Signer.String(),
+				"msgProposer", msg.Proposer.String(),
+			)
+			return common.ErrInvalidMsg(k.Codespace(), "Invalid proposer in msg")
+		}
+
+		logger.Info(
+			"Accepting checkpoint from standby proposer",
+			"proposer", validatorSet.Proposer.Signer.String(),
+			"standby", msg.Proposer.String(),
+			"position", standby.Position,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package checkpoint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func getAggregationVotePrefix(rootID byte) []byte {
+	return append(append([]byte{}, AggregationVoteKey...), rootID)
+}
+
+// getAggregationVoteKey returns key of checkpoint submitted by proposer, single vote is kept per proposer
+func getAggregationVoteKey(rootID byte, proposer hmTypes.HeimdallAddress) []byte {
+	return append(getAggregationVotePrefix(rootID), proposer.Bytes()...)
+}
+
+// SetCheckpointAggregation sets multi-proposer checkpoint aggregation mode
+func (k *Keeper) SetCheckpointAggregation(ctx sdk.Context, aggregation types.CheckpointAggregation) {
+	k.paramSpace.Set(ctx, types.KeyCheckpointAggregation, aggregation)
+}
+
+// GetCheckpointAggregation gets multi-proposer checkpoint aggregation mode, disabled if it was never set
+func (k *Keeper) GetCheckpointAggregation(ctx sdk.Context) (aggregation types.CheckpointAggregation) {
+	k.paramSpace.GetIfExists(ctx, types.KeyCheckpointAggregation, &aggregation)
+	return aggregation
+}
+
+// GetAggregationProposers returns current proposer followed by next live proposers in rotation,
+// up to number of aggregation proposers. Empty list is returned while aggregation is disabled.
+func (k *Keeper) GetAggregationProposers(ctx sdk.Context) (proposers []hmTypes.Validator) {
+	aggregation := k.GetCheckpointAggregation(ctx)
+	if !aggregation.Enabled() {
+		return proposers
+	}
+
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	if validatorSet.Proposer == nil {
+		return proposers
+	}
+
+	proposers = append(proposers, *validatorSet.Proposer.Copy())
+	seen := map[hmTypes.ValidatorID]bool{validatorSet.Proposer.ID: true}
+
+	// follow proposer rotation on a copy
+	vs := validatorSet.Copy()
+	for i := 0; i < len(vs.Validators) && uint64(len(proposers)) < aggregation.Proposers; i++ {
+		vs.IncrementProposerPriority(1)
+		proposer := vs.GetProposer()
+		if proposer == nil || seen[proposer.ID] {
+			continue
+		}
+		seen[proposer.ID] = true
+
+		// offline validators won't submit checkpoint
+		if !k.lk.IsLive(ctx, proposer.ID) {
+			continue
+		}
+
+		proposers = append(proposers, *proposer.Copy())
+	}
+
+	return proposers
+}
+
+// IsAggregationProposer returns true if signer is one of aggregation proposers
+func (k *Keeper) IsAggregationProposer(ctx sdk.Context, signer hmTypes.HeimdallAddress) bool {
+	for _, proposer := range k.GetAggregationProposers(ctx) {
+		if proposer.Signer.Equals(signer) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAggregationQuorum returns number of identical checkpoints required to buffer range.
+// It's capped by number of aggregation proposers, so few live validators can't stall checkpoints.
+func (k *Keeper) GetAggregationQuorum(ctx sdk.Context) uint64 {
+	quorum := k.GetCheckpointAggregation(ctx).Quorum
+	if proposers := uint64(len(k.GetAggregationProposers(ctx))); proposers < quorum {
+		quorum = proposers
+	}
+
+	if quorum == 0 {
+		return 1
+	}
+	return quorum
+}
+
+// GetAggregationVotes returns checkpoints submitted by aggregation proposers for root chain
+func (k *Keeper) GetAggregationVotes(ctx sdk.Context, rootChain string) (votes []hmTypes.Checkpoint) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, getAggregationVotePrefix(hmTypes.GetRootChainID(rootChain)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote hmTypes.Checkpoint
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &vote); err == nil {
+			votes = append(votes, vote)
+		}
+	}
+
+	return votes
+}
+
+// HasAggregationVote returns true if proposer already submitted checkpoint starting at start block
+func (k *Keeper) HasAggregationVote(ctx sdk.Context, rootChain string, proposer hmTypes.HeimdallAddress, startBlock uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(getAggregationVoteKey(hmTypes.GetRootChainID(rootChain), proposer))
+	if bz == nil {
+		return false
+	}
+
+	var vote hmTypes.Checkpoint
+	if err := k.cdc.UnmarshalBinaryBare(bz, &vote); err != nil {
+		return false
+	}
+	return vote.StartBlock == startBlock
+}
+
+// AggregateCheckpoint records checkpoint as vote of its proposer and returns checkpoint to buffer once
+// quorum of votes agree on range and root hash. Votes for other start blocks are stale and dropped.
+// Agreed checkpoint is earliest matching vote, all votes are cleared once it's returned.
+func (k *Keeper) AggregateCheckpoint(ctx sdk.Context, rootChain string, checkpoint hmTypes.Checkpoint) (agreed hmTypes.Checkpoint, votes uint64, reached bool) {
+	for _, vote := range k.GetAggregationVotes(ctx, rootChain) {
+		if vote.StartBlock != checkpoint.StartBlock {
+			k.deleteAggregationVote(ctx, rootChain, vote.Proposer)
+		}
+	}
+
+	rootID := hmTypes.GetRootChainID(rootChain)
+	if err := k.addCheckpoint(ctx, getAggregationVoteKey(rootID, checkpoint.Proposer), checkpoint); err != nil {
+		k.Logger(ctx).Error("Error while storing aggregation vote", "root", rootChain, "error", err)
+		return agreed, 0, false
+	}
+
+	all := k.GetAggregationVotes(ctx, rootChain)
+	votes = types.CountMatchingVotes(all, checkpoint)
+	if votes < k.GetAggregationQuorum(ctx) {
+		return agreed, votes, false
+	}
+
+	agreed = checkpoint
+	for _, vote := range all {
+		if types.CountMatchingVotes([]hmTypes.Checkpoint{vote}, checkpoint) == 1 && vote.TimeStamp < agreed.TimeStamp {
+			agreed = vote
+		}
+	}
+
+	k.FlushAggregationVotes(ctx, rootChain)
+
+	return agreed, votes, true
+}
+
+// FlushAggregationVotes removes all aggregation votes of root chain
+func (k *Keeper) FlushAggregationVotes(ctx sdk.Context, rootChain string) {
+	for _, vote := range k.GetAggregationVotes(ctx, rootChain) {
+		k.deleteAggregationVote(ctx, rootChain, vote.Proposer)
+	}
+}
+
+func (k *Keeper) deleteAggregationVote(ctx sdk.Context, rootChain string, proposer hmTypes.HeimdallAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getAggregationVoteKey(hmTypes.GetRootChainID(rootChain), proposer))
+}
@@ -0,0 +1,66 @@
+package checkpoint
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// getAttestationKey returns key of attestation of checkpoint starting at start block
+func getAttestationKey(rootID byte, startBlock uint64) []byte {
+	key := append(append([]byte{}, AttestationKey...), rootID)
+	return append(key, sdk.Uint64ToBigEndian(startBlock)...)
+}
+
+// SetCheckpointAttestation stores block hash commitment attested by checkpoint proposer
+func (k *Keeper) SetCheckpointAttestation(ctx sdk.Context, attestation types.CheckpointAttestation) {
+	store := ctx.KVStore(k.storeKey)
+	key := getAttestationKey(hmTypes.GetRootChainID(attestation.RootChain), attestation.StartBlock)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(attestation))
+}
+
+// GetCheckpointAttestation returns attestation of checkpoint of root chain starting at start block
+func (k *Keeper) GetCheckpointAttestation(ctx sdk.Context, rootChain string, startBlock uint64) (*types.CheckpointAttestation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(getAttestationKey(hmTypes.GetRootChainID(rootChain), startBlock))
+	if bz == nil {
+		return nil, false
+	}
+
+	var attestation types.CheckpointAttestation
+	if err := k.cdc.UnmarshalBinaryBare(bz, &attestation); err != nil {
+		k.Logger(ctx).Error("Unable to unmarshal checkpoint attestation", "root", rootChain, "startBlock", startBlock, "error", err)
+		return nil, false
+	}
+
+	return &attestation, true
+}
+
+// GetCheckpointAttestationByNumber returns attestation of acked checkpoint with number, or of buffered
+// checkpoint if number is zero. Attestations of checkpoints replaced in buffer are not returned.
+func (k *Keeper) GetCheckpointAttestationByNumber(ctx sdk.Context, rootChain string, number uint64) (types.CheckpointAttestation, error) {
+	var checkpoint hmTypes.Checkpoint
+	if number == 0 {
+		buffer, err := k.GetCheckpointFromBuffer(ctx, rootChain)
+		if err != nil {
+			return types.CheckpointAttestation{}, err
+		}
+		checkpoint = *buffer
+	} else {
+		acked, err := k.GetCheckpointByNumber(ctx, number, rootChain)
+		if err != nil {
+			return types.CheckpointAttestation{}, err
+		}
+		checkpoint = acked
+	}
+
+	attestation, ok := k.GetCheckpointAttestation(ctx, rootChain, checkpoint.StartBlock)
+	if !ok || !attestation.Matches(checkpoint) {
+		return types.CheckpointAttestation{}, errors.New("no attestation of checkpoint")
+	}
+
+	return *attestation, nil
+}
@@ -0,0 +1,160 @@
+package checkpoint
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// getBlsAggregateKey returns key of aggregated BLS signature of checkpoint starting at start block
+func getBlsAggregateKey(rootID byte, startBlock uint64) []byte {
+	key := append(append([]byte{}, BlsAggregateKey...), rootID)
+	return append(key, sdk.Uint64ToBigEndian(startBlock)...)
+}
+
+// SetBlsAggregation enables or disables BLS signature aggregation of checkpoints
+func (k *Keeper) SetBlsAggregation(ctx sdk.Context, enabled bool) {
+	k.paramSpace.Set(ctx, types.KeyBlsAggregation, enabled)
+}
+
+// GetBlsAggregation returns true if BLS signatures of checkpoints are aggregated, disabled if it was never set
+func (k *Keeper) GetBlsAggregation(ctx sdk.Context) (enabled bool) {
+	k.paramSpace.GetIfExists(ctx, types.KeyBlsAggregation, &enabled)
+	return enabled
+}
+
+// SetBlsAggregate stores aggregated BLS signature of checkpoint
+func (k *Keeper) SetBlsAggregate(ctx sdk.Context, aggregate types.BlsAggregate) {
+	store := ctx.KVStore(k.storeKey)
+	key := getBlsAggregateKey(hmTypes.GetRootChainID(aggregate.RootChain), aggregate.StartBlock)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(aggregate))
+}
+
+// GetBlsAggregate returns aggregated BLS signature of checkpoint of root chain starting at start block
+func (k *Keeper) GetBlsAggregate(ctx sdk.Context, rootChain string, startBlock uint64) (*types.BlsAggregate, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(getBlsAggregateKey(hmTypes.GetRootChainID(rootChain), startBlock))
+	if bz == nil {
+		return nil, false
+	}
+
+	var aggregate types.BlsAggregate
+	if err := k.cdc.UnmarshalBinaryBare(bz, &aggregate); err != nil {
+		k.Logger(ctx).Error("Unable to unmarshal BLS aggregate", "root", rootChain, "startBlock", startBlock, "error", err)
+		return nil, false
+	}
+
+	return &aggregate, true
+}
+
+// GetBlsAggregates returns aggregated BLS signatures of all checkpoints of root chain
+func (k *Keeper) GetBlsAggregates(ctx sdk.Context, rootChain string) (aggregates []types.BlsAggregate) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := append(append([]byte{}, BlsAggregateKey...), hmTypes.GetRootChainID(rootChain))
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var aggregate types.BlsAggregate
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &aggregate); err == nil {
+			aggregates = append(aggregates, aggregate)
+		}
+	}
+
+	return aggregates
+}
+
+// getBufferedCheckpoint returns buffered (or queued) checkpoint of root chain starting at start block
+func (k *Keeper) getBufferedCheckpoint(ctx sdk.Context, rootChain string, startBlock uint64) (hmTypes.Checkpoint, bool) {
+	for _, checkpoint := range k.GetCheckpointBufferQueue(ctx, rootChain).Checkpoints {
+		if checkpoint.StartBlock == startBlock {
+			return checkpoint, true
+		}
+	}
+	return hmTypes.Checkpoint{}, false
+}
+
+// AddBlsVote verifies BLS signature of validator for buffered checkpoint and adds it to checkpoint's aggregate.
+// Aggregate of previously buffered checkpoint with same start block but other content is replaced.
+func (k *Keeper) AddBlsVote(ctx sdk.Context, rootChain string, checkpoint hmTypes.Checkpoint, validator hmTypes.Validator, sig []byte) (types.BlsAggregate, error) {
+	blsKey, err := k.sk.GetValidatorBlsKey(ctx, validator.ID)
+	if err != nil {
+		return types.BlsAggregate{}, err
+	}
+
+	if !helper.BlsVerify(blsKey.PubKey, types.CheckpointBlsSignBytes(rootChain, checkpoint), sig) {
+		return types.BlsAggregate{}, errors.New("invalid BLS signature")
+	}
+
+	aggregate, ok := k.GetBlsAggregate(ctx, rootChain, checkpoint.StartBlock)
+	if !ok || aggregate.EndBlock != checkpoint.EndBlock || !aggregate.RootHash.Equals(checkpoint.RootHash) {
+		aggregate = &types.BlsAggregate{
+			RootChain:  rootChain,
+			StartBlock: checkpoint.StartBlock,
+			EndBlock:   checkpoint.EndBlock,
+			RootHash:   checkpoint.RootHash,
+			Signers:    []hmTypes.ValidatorID{},
+		}
+	}
+
+	if aggregate.HasSigner(validator.ID) {
+		return *aggregate, fmt.Errorf("validator %v already voted", validator.ID)
+	}
+
+	// public key of signers is aggregated along, so later key updates don't invalidate aggregate
+	signature, pubKey := sig, []byte(blsKey.PubKey)
+	if len(aggregate.Signature) > 0 {
+		if signature, err = helper.BlsAggregateSignatures(aggregate.Signature, sig); err != nil {
+			return *aggregate, err
+		}
+
+		if pubKey, err = helper.BlsAggregatePubKeys(aggregate.PubKey, blsKey.PubKey); err != nil {
+			return *aggregate, err
+		}
+	}
+
+	aggregate.Signature = signature
+	aggregate.PubKey = pubKey
+	aggregate.Signers = append(aggregate.Signers, validator.ID)
+	aggregate.Power += validator.VotingPower
+	k.SetBlsAggregate(ctx, *aggregate)
+
+	return *aggregate, nil
+}
+
+// GetCheckpointBlsSignature returns aggregated BLS signature of acked checkpoint with number,
+// or of buffered checkpoint if number is zero
+func (k *Keeper) GetCheckpointBlsSignature(ctx sdk.Context, rootChain string, number uint64) (types.CheckpointBlsSignature, error) {
+	var checkpoint hmTypes.Checkpoint
+	if number == 0 {
+		buffer, err := k.GetCheckpointFromBuffer(ctx, rootChain)
+		if err != nil {
+			return types.CheckpointBlsSignature{}, err
+		}
+		checkpoint = *buffer
+	} else {
+		acked, err := k.GetCheckpointByNumber(ctx, number, rootChain)
+		if err != nil {
+			return types.CheckpointBlsSignature{}, err
+		}
+		checkpoint = acked
+	}
+
+	aggregate, ok := k.GetBlsAggregate(ctx, rootChain, checkpoint.StartBlock)
+	if !ok || aggregate.EndBlock != checkpoint.EndBlock || !aggregate.RootHash.Equals(checkpoint.RootHash) {
+		return types.CheckpointBlsSignature{}, errors.New("no BLS signature of checkpoint")
+	}
+
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	return types.CheckpointBlsSignature{
+		Number:     number,
+		Aggregate:  *aggregate,
+		SignBytes:  types.CheckpointBlsSignBytes(rootChain, checkpoint),
+		TotalPower: validatorSet.TotalVotingPower(),
+	}, nil
+}
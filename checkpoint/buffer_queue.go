@@ -0,0 +1,177 @@
+package checkpoint
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func getBufferQueuePrefix(rootID byte) []byte {
+	return append(append([]byte{}, BufferQueueKey...), rootID)
+}
+
+// getBufferQueueKey returns key of queued checkpoint, start block is big endian encoded to keep queue ordered
+func getBufferQueueKey(rootID byte, startBlock uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, startBlock)
+	return append(getBufferQueuePrefix(rootID), b...)
+}
+
+// SetCheckpointBufferDepth sets max number of checkpoints buffered per root chain
+func (k *Keeper) SetCheckpointBufferDepth(ctx sdk.Context, depth uint64) {
+	k.paramSpace.Set(ctx, types.KeyCheckpointBufferDepth, depth)
+}
+
+// GetCheckpointBufferDepth gets max number of checkpoints buffered per root chain, single checkpoint if it was never set
+func (k *Keeper) GetCheckpointBufferDepth(ctx sdk.Context) uint64 {
+	var depth uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyCheckpointBufferDepth, &depth)
+	if depth == 0 {
+		return types.DefaultCheckpointBufferDepth
+	}
+
+	return depth
+}
+
+// GetCheckpointBufferQueue returns checkpoint in buffer followed by checkpoints queued behind it
+func (k *Keeper) GetCheckpointBufferQueue(ctx sdk.Context, rootChain string) types.CheckpointBufferQueue {
+	queue := types.CheckpointBufferQueue{
+		RootChain:   rootChain,
+		Depth:       k.GetCheckpointBufferDepth(ctx),
+		Checkpoints: []hmTypes.Checkpoint{},
+	}
+
+	head, err := k.GetCheckpointFromBuffer(ctx, rootChain)
+	if err != nil {
+		return queue
+	}
+
+	queue.Checkpoints = append(queue.Checkpoints, *head)
+	queue.Checkpoints = append(queue.Checkpoints, k.getQueuedCheckpoints(ctx, rootChain)...)
+
+	return queue
+}
+
+// PushCheckpointBuffer adds checkpoint to buffer if it's empty, or queues it behind buffered ones otherwise.
+// Caller must make sure that queue is not full and checkpoint follows its tail.
+func (k *Keeper) PushCheckpointBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpoint, rootChain string) error {
+	if _, err := k.GetCheckpointFromBuffer(ctx, rootChain); err != nil {
+		return k.SetCheckpointBuffer(ctx, checkpoint, rootChain)
+	}
+
+	return k.addCheckpoint(ctx, getBufferQueueKey(hmTypes.GetRootChainID(rootChain), checkpoint.StartBlock), checkpoint)
+}
+
+// PopCheckpointBuffer removes acked checkpoint from buffer and promotes next queued checkpoint to buffer.
+// Queued checkpoint is promoted only if it starts right after acked end block, otherwise queue is dropped.
+// Promoted checkpoint gets new timestamp so buffer timeout runs from the moment it reaches buffer.
+func (k *Keeper) PopCheckpointBuffer(ctx sdk.Context, rootChain string, ackedEndBlock uint64) *hmTypes.Checkpoint {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getCheckpointBufferKey(hmTypes.GetRootChainID(rootChain)))
+	k.deleteCheckpointBufferHeights(ctx, rootChain)
+
+	queued := k.getQueuedCheckpoints(ctx, rootChain)
+	if len(queued) == 0 {
+		return nil
+	}
+
+	next := queued[0]
+	if next.StartBlock != ackedEndBlock+1 {
+		k.Logger(ctx).Info("Dropping checkpoint buffer queue not continuous with acked checkpoint",
+			"root", rootChain, "queueStart", next.StartBlock, "ackedEnd", ackedEndBlock, "dropped", len(queued))
+		k.flushBufferQueue(ctx, rootChain)
+		return nil
+	}
+
+	store.Delete(getBufferQueueKey(hmTypes.GetRootChainID(rootChain), next.StartBlock))
+
+	next.TimeStamp = uint64(ctx.BlockTime().Unix())
+	if err := k.SetCheckpointBuffer(ctx, next, rootChain); err != nil {
+		k.Logger(ctx).Error("Error while promoting queued checkpoint to buffer", "root", rootChain, "error", err)
+		k.flushBufferQueue(ctx, rootChain)
+		return nil
+	}
+
+	k.Logger(ctx).Debug("Queued checkpoint promoted to buffer",
+		"root", rootChain, "startBlock", next.StartBlock, "endBlock", next.EndBlock)
+
+	return &next
+}
+
+// getQueuedCheckpoints returns checkpoints queued behind checkpoint in buffer ordered by start block
+func (k *Keeper) getQueuedCheckpoints(ctx sdk.Context, rootChain string) (checkpoints []hmTypes.Checkpoint) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, getBufferQueuePrefix(hmTypes.GetRootChainID(rootChain)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var checkpoint hmTypes.Checkpoint
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &checkpoint); err == nil {
+			checkpoints = append(checkpoints, checkpoint)
+		}
+	}
+
+	return checkpoints
+}
+
+// flushBufferQueue removes checkpoints queued behind checkpoint in buffer
+func (k *Keeper) flushBufferQueue(ctx sdk.Context, rootChain string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, getBufferQueuePrefix(hmTypes.GetRootChainID(rootChain)))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func getCheckpointBufferHeightPrefix(rootID byte) []byte {
+	return append(append([]byte{}, BufferHeightKey...), rootID)
+}
+
+// getCheckpointBufferHeightKey returns key of height checkpoint was buffered at
+func getCheckpointBufferHeightKey(rootID byte, startBlock uint64) []byte {
+	return append(getCheckpointBufferHeightPrefix(rootID), sdk.Uint64ToBigEndian(startBlock)...)
+}
+
+// GetCheckpointBufferHeight returns heimdall height checkpoint in buffer was buffered at,
+// false if checkpoint starting at start block is not in buffer
+func (k *Keeper) GetCheckpointBufferHeight(ctx sdk.Context, rootChain string, startBlock uint64) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := getCheckpointBufferHeightKey(hmTypes.GetRootChainID(rootChain), startBlock)
+	if !store.Has(key) {
+		return 0, false
+	}
+
+	return int64(binary.BigEndian.Uint64(store.Get(key))), true
+}
+
+func (k *Keeper) setCheckpointBufferHeight(ctx sdk.Context, rootChain string, startBlock uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := getCheckpointBufferHeightKey(hmTypes.GetRootChainID(rootChain), startBlock)
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// deleteCheckpointBufferHeights removes buffer heights of root chain, buffer holds single checkpoint
+func (k *Keeper) deleteCheckpointBufferHeights(ctx sdk.Context, rootChain string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, getCheckpointBufferHeightPrefix(hmTypes.GetRootChainID(rootChain)))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
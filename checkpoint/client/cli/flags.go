@@ -13,4 +13,7 @@ const (
 	FlagAutoConfigure      = "auto-configure"
 	FlagEpoch              = "epoch"
 	FlagRootChain          = "root-chain"
+	FlagRoot               = "root"
+	FlagAllChains          = "all-chains"
+	FlagSideTxResult       = "side-tx-result"
 )
@@ -4,17 +4,27 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/heimdall/checkpoint/client/utils"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/helper"
+	paramsUtils "github.com/maticnetwork/heimdall/params/client/utils"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -34,9 +44,22 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetQueryParams(cdc),
 			GetCheckpointBuffer(cdc),
+			GetCheckpointBufferQueue(cdc),
 			GetLastNoACK(cdc),
+			GetStandbyProposers(cdc),
+			GetAggregationProposers(cdc),
+			GetAggregationVotes(cdc),
+			GetCheckpointAdjustments(cdc),
+			GetProposerDeposit(cdc),
+			GetProposerDeposits(cdc),
+			GetAccountClaim(cdc),
 			GetHeaderFromIndex(cdc),
 			GetCheckpointCount(cdc),
+			GetCheckpointBundle(cdc),
+			GetCheckpointSubmission(cdc),
+			GetCheckpointStatus(cdc),
+			GetParamChangeEffects(cdc),
+			GetSimulateAck(cdc),
 		)...,
 	)
 
@@ -71,11 +94,111 @@ $ %s query checkpoint params
 			if err := json.Unmarshal(bz, &params); err != nil {
 				return nil
 			}
-			return cliCtx.PrintOutput(params)
+			return hmClient.PrintOutput(cliCtx, params)
 		},
 	}
 }
 
+// GetParamChangeEffects reports effects of param change proposal on checkpoint delivery
+func GetParamChangeEffects(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "param-change-effects [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show effects of param change proposal on checkpoint delivery",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Evaluate proposed checkpoint params against current state: checkpoint calldata size,
+expected ack cadence and buffered checkpoints which would violate proposed params.
+Proposal file has the format of param change proposal.
+
+Example:
+$ %s query checkpoint param-change-effects proposal.json
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := paramsUtils.ParseParamChangeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryParamChangeParams(proposal.Changes.ToParamChanges()))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParamChangeEffects), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var effects types.ParamChangeEffects
+			if err := json.Unmarshal(res, &effects); err != nil {
+				return err
+			}
+			return hmClient.PrintOutput(cliCtx, effects)
+		},
+	}
+}
+
+// GetSimulateAck dry-runs checkpoint ack through post-tx handler against current state
+func GetSimulateAck(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-ack [msg-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show result checkpoint ack would produce, without broadcasting it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Run candidate checkpoint ack through post-tx handler against current state and show
+its result, eg. buffer mismatch, discontinuity or stale ack. Msg file has the JSON format of MsgCheckpointAck.
+
+Example:
+$ %s query checkpoint simulate-ack ack.json --side-tx-result yes
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg types.MsgCheckpointAck
+			if err := cdc.UnmarshalJSON(contents, &msg); err != nil {
+				return err
+			}
+
+			sideTxResult, err := hmTypes.ParseSideTxResult(viper.GetString(FlagSideTxResult))
+			if err != nil {
+				return err
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySimulateAckParams(msg, sideTxResult))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySimulateAck), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var result sdk.Result
+			if err := json.Unmarshal(res, &result); err != nil {
+				return err
+			}
+			return hmClient.PrintOutput(cliCtx, result)
+		},
+	}
+
+	cmd.Flags().String(FlagSideTxResult, "yes", "--side-tx-result=<yes|no|skip>")
+	return cmd
+}
+
 // GetCheckpointBuffer get checkpoint present in buffer
 func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -98,8 +221,7 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 				return errors.New("No checkpoint buffer found")
 			}
 
-			fmt.Printf(string(res))
-			return nil
+			return hmClient.PrintRawOutput(cliCtx, res)
 		},
 	}
 	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain>")
@@ -109,6 +231,38 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCheckpointBufferQueue get checkpoints waiting for ack in buffer
+func GetCheckpointBufferQueue(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-buffer-queue",
+		Short: "show checkpoints waiting for ack in buffer, head first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			rootChain := viper.GetString(FlagRootChain)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+			if err != nil {
+				return errors.New("rootChain Error :" + rootChain)
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBufferQueue), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var queue types.CheckpointBufferQueue
+			if err := json.Unmarshal(res, &queue); err != nil {
+				return err
+			}
+			return hmClient.PrintOutput(cliCtx, queue)
+		},
+	}
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain>")
+	if err := cmd.MarkFlagRequired(FlagRootChain); err != nil {
+		logger.Error("GetCheckpointBufferQueue | MarkFlagRequired | FlagRootChain", "Error", err)
+	}
+	return cmd
+}
+
 // GetLastNoACK get last no ack time
 func GetLastNoACK(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -131,11 +285,241 @@ func GetLastNoACK(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			fmt.Printf("LastNoACK received at %v", time.Unix(int64(lastNoAck), 0))
-			return nil
+			return hmClient.PrintTextOrOutput(cliCtx,
+				fmt.Sprintf("LastNoACK received at %v", time.Unix(int64(lastNoAck), 0)),
+				map[string]uint64{"last_no_ack": lastNoAck},
+			)
+		},
+	}
+
+	return cmd
+}
+
+// GetStandbyProposers get standby proposers for current checkpoint slot
+func GetStandbyProposers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "standby-proposers",
+		Short: "show ordered standby proposers for current checkpoint slot",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStandbyProposers), nil)
+			if err != nil {
+				return err
+			}
+
+			var standbys []types.StandbyProposer
+			if err := json.Unmarshal(res, &standbys); err != nil {
+				return err
+			}
+
+			return hmClient.PrintOutput(cliCtx, standbys)
+		},
+	}
+
+	return cmd
+}
+
+// GetAggregationProposers get proposers expected to submit next checkpoint in aggregation mode
+func GetAggregationProposers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregation-proposers",
+		Short: "show proposers expected to submit next checkpoint in aggregation mode",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAggregationProposers), nil)
+			if err != nil {
+				return err
+			}
+
+			var proposers []hmTypes.Validator
+			if err := json.Unmarshal(res, &proposers); err != nil {
+				return err
+			}
+
+			return hmClient.PrintOutput(cliCtx, proposers)
+		},
+	}
+
+	return cmd
+}
+
+// GetAggregationVotes get checkpoints submitted by aggregation proposers for next range
+func GetAggregationVotes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregation-votes",
+		Short: "show checkpoints submitted by aggregation proposers for next range",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			rootChain := viper.GetString(FlagRootChain)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+			if err != nil {
+				return errors.New("rootChain Error :" + rootChain)
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAggregationVotes), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var votes types.AggregationVotes
+			if err := json.Unmarshal(res, &votes); err != nil {
+				return err
+			}
+			return hmClient.PrintOutput(cliCtx, votes)
+		},
+	}
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain>")
+	if err := cmd.MarkFlagRequired(FlagRootChain); err != nil {
+		logger.Error("GetAggregationVotes | MarkFlagRequired | FlagRootChain", "Error", err)
+	}
+	return cmd
+}
+
+// GetCheckpointAdjustments returns adjustment records of checkpoints
+func GetCheckpointAdjustments(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "adjustments",
+		Short: "show adjustment records of checkpoints, all checkpoints of root chain unless header is given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(viper.GetUint64(FlagHeaderNumber), viper.GetString(FlagRootChain)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAdjustments), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var adjustments []types.CheckpointAdjustment
+			if err := json.Unmarshal(res, &adjustments); err != nil {
+				return err
+			}
+
+			return hmClient.PrintOutput(cliCtx, adjustments)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<header-index>")
+	cmd.Flags().String(FlagRootChain, hmTypes.RootChainTypeEth, "--root-chain=<root-chain-type>")
+
+	return cmd
+}
+
+// GetProposerDeposit returns deposit held by checkpoint module account for proposer
+func GetProposerDeposit(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [proposer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show deposit held by checkpoint module account for proposer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid proposer address %v", args[0])
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerDepositParams(hmTypes.HexToHeimdallAddress(args[0])))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerDeposit), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var deposit types.ProposerDeposit
+			if err := json.Unmarshal(res, &deposit); err != nil {
+				return err
+			}
+
+			return hmClient.PrintOutput(cliCtx, deposit)
+		},
+	}
+
+	return cmd
+}
+
+// GetProposerDeposits returns deposits held by checkpoint module account for all proposers
+func GetProposerDeposits(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits",
+		Args:  cobra.NoArgs,
+		Short: "show deposits held by checkpoint module account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerDeposits), nil)
+			if err != nil {
+				return err
+			}
+
+			var deposits []types.ProposerDeposit
+			if err := json.Unmarshal(res, &deposits); err != nil {
+				return err
+			}
+
+			return hmClient.PrintOutput(cliCtx, deposits)
+		},
+	}
+
+	return cmd
+}
+
+// GetAccountClaim returns merkle proof of dividend account against account root hash of checkpoint
+func GetAccountClaim(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-claim [number] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "show fee claim proof of dividend account against checkpoint",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Show merkle proof of dividend account against account root hash of checkpoint,
+along with abi encoded claimFee call data of stake manager contract.
+
+Example:
+$ %s query checkpoint account-claim 100 0x8C0E6f0fD3F0e5B6Ba4d8bFAa0A5E0B7B0C8f1e2 --root eth
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			number, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("invalid user address %v", args[1])
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryAccountClaimParams(number, viper.GetString(FlagRoot), hmTypes.HexToHeimdallAddress(args[1])))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccountClaim), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var claim types.DividendAccountClaim
+			if err := json.Unmarshal(res, &claim); err != nil {
+				return err
+			}
+
+			return hmClient.PrintOutput(cliCtx, claim)
 		},
 	}
 
+	cmd.Flags().String(FlagRoot, hmTypes.RootChainTypeEth, "--root=<root-chain>")
+
 	return cmd
 }
 
@@ -160,8 +544,7 @@ func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			fmt.Printf(string(res))
-			return nil
+			return hmClient.PrintRawOutput(cliCtx, res)
 		},
 	}
 
@@ -195,10 +578,169 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			fmt.Printf("Total number of checkpoint so far : %v", ackCount)
+			return hmClient.PrintTextOrOutput(cliCtx,
+				fmt.Sprintf("Total number of checkpoint so far : %v", ackCount),
+				map[string]uint64{"ack_count": ackCount},
+			)
+		},
+	}
+
+	return cmd
+}
+
+// GetCheckpointBundle exports checkpoint with everything needed to verify it offline
+func GetCheckpointBundle(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle [number]",
+		Args:  cobra.ExactArgs(1),
+		Short: "export checkpoint with its proof bundle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export checkpoint with header block from root chain contract, validator signatures,
+validator set snapshot and merkle parameters as self-contained JSON bundle.
+
+Example:
+$ %s query checkpoint bundle 100 --root eth
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			number, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := utils.QueryCheckpointBundle(cliCtx, number, viper.GetString(FlagRoot))
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintOutput(cliCtx, bundle)
+		},
+	}
+
+	cmd.Flags().String(FlagRoot, hmTypes.RootChainTypeEth, "--root=<root-chain>")
+
+	return cmd
+}
+
+// GetCheckpointSubmission exports checkpoint in buffer with aggregated signatures for root chain submission
+func GetCheckpointSubmission(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submission",
+		Args:  cobra.NoArgs,
+		Short: "export checkpoint in buffer with signatures for root chain submission",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export checkpoint in buffer with side-tx vote bytes and validator signatures
+sorted by signer address, along with abi encoded submitCheckpoint call data.
+
+Example:
+$ %s query checkpoint submission --root eth
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			submission, err := utils.QueryCheckpointSubmission(cliCtx, viper.GetString(FlagRoot))
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintOutput(cliCtx, submission)
+		},
+	}
+
+	cmd.Flags().String(FlagRoot, hmTypes.RootChainTypeEth, "--root=<root-chain>")
+
+	return cmd
+}
+
+// GetCheckpointStatus shows checkpoint status dashboard of root chains
+func GetCheckpointStatus(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Args:  cobra.NoArgs,
+		Short: "show checkpoint status of root chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Show last acked checkpoint, buffer with expiry countdown, last no-ack,
+current proposer and current header block of root chain contract.
+
+Example:
+$ %s query checkpoint status --all-chains
+$ %s query checkpoint status --root eth --output json
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			rootChains := []string{viper.GetString(FlagRoot)}
+			if viper.GetBool(FlagAllChains) {
+				rootChains = []string{hmTypes.RootChainTypeTron, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc}
+			}
+
+			contractCaller, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			statuses := make([]types.CheckpointStatus, 0, len(rootChains))
+			for _, rootChain := range rootChains {
+				status, err := utils.QueryCheckpointStatus(cliCtx, contractCaller, rootChain)
+				if err != nil {
+					return err
+				}
+				statuses = append(statuses, status)
+			}
+
+			if cliCtx.OutputFormat == "json" {
+				return hmClient.PrintOutput(cliCtx, statuses)
+			}
+
+			printCheckpointStatuses(statuses)
 			return nil
 		},
 	}
 
+	cmd.Flags().String(FlagRoot, hmTypes.RootChainTypeEth, "--root=<root-chain>")
+	cmd.Flags().Bool(FlagAllChains, false, "--all-chains show status of all root chains")
+
 	return cmd
 }
+
+// printCheckpointStatuses prints statuses as table
+func printCheckpointStatuses(statuses []types.CheckpointStatus) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ROOT\tACKED\tBUFFER\tEXPIRES IN\tLAST NO-ACK\tPROPOSER\tCONTRACT")
+
+	for _, status := range statuses {
+		buffer, expiresIn := "-", "-"
+		if status.Buffer != nil {
+			buffer = fmt.Sprintf("[%v, %v]", status.Buffer.StartBlock, status.Buffer.EndBlock)
+			expiresIn = "expired"
+			if status.BufferExpiresIn > 0 {
+				expiresIn = (time.Duration(status.BufferExpiresIn) * time.Second).String()
+			}
+		}
+
+		lastNoAck := "-"
+		if status.LastNoAck > 0 {
+			lastNoAck = time.Unix(int64(status.LastNoAck), 0).UTC().Format(time.RFC3339)
+		}
+
+		contract := strconv.FormatUint(status.ContractHeaderBlock, 10)
+		if status.ContractError != "" {
+			contract = "error: " + status.ContractError
+		}
+
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\t%v\n",
+			status.RootChain, status.AckCount, buffer, expiresIn, lastNoAck, status.Proposer.String(), contract)
+	}
+
+	w.Flush()
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/checkpoint/client/utils"
 	types "github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
 	"github.com/maticnetwork/heimdall/helper"
@@ -41,6 +42,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 			SendCheckpointTx(cdc),
 			SendCheckpointACKTx(cdc),
 			SendCheckpointNoACKTx(cdc),
+			SendCheckpointAdjustTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -243,7 +245,7 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 				if err != nil || receipt == nil {
 					return errors.New("transaction is not confirmed yet. Please wait for sometime and try again")
 				}
-				rootChainAddress = hmTypes.HexToTronAddress(chainmanagerParams.ChainParams.TronChainAddress)
+				rootChainAddress = chainmanagerParams.ChainParams.TronChainAddress.EthAddress()
 			default:
 				return fmt.Errorf("wrong root chain %v", rootChain)
 			}
@@ -320,3 +322,54 @@ func SendCheckpointNoACKTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
 	return cmd
 }
+
+// SendCheckpointAdjustTx send adjustment of latest checkpoint to header block on root chain contract
+func SendCheckpointAdjustTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-adjust",
+		Short: "adjust latest checkpoint to match header block on root chain contract",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get sender
+			from := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if from.Empty() {
+				from = helper.GetFromAddress(cliCtx)
+			}
+
+			headerBlock := viper.GetUint64(FlagHeaderNumber)
+			rootChain := viper.GetString(FlagRootChain)
+
+			// header block as stored on root chain contract
+			header, err := utils.QueryHeaderBlock(cliCtx, headerBlock, rootChain)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCheckpointAdjust(
+				from,
+				headerBlock,
+				header.Proposer,
+				header.StartBlock,
+				header.EndBlock,
+				header.RootHash,
+				rootChain,
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<header-index>")
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain-type>")
+
+	if err := cmd.MarkFlagRequired(FlagHeaderNumber); err != nil {
+		logger.Error("SendCheckpointAdjustTx | MarkFlagRequired | FlagHeaderNumber", "Error", err)
+	}
+	if err := cmd.MarkFlagRequired(FlagRootChain); err != nil {
+		logger.Error("SendCheckpointAdjustTx | MarkFlagRequired | FlagRootChain", "Error", err)
+	}
+	return cmd
+}
@@ -0,0 +1,108 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+// MaxVerifyProofsBatchSize max number of proofs verified in one request
+const MaxVerifyProofsBatchSize = 100
+
+type (
+	// BlockProof child block inclusion proof against checkpoint root
+	BlockProof struct {
+		BlockNumber uint64           `json:"block_number"`
+		StartBlock  uint64           `json:"start_block"`
+		Proof       hmTypes.HexBytes `json:"proof"`
+		Root        hmTypes.HexBytes `json:"root"`
+	}
+
+	// VerifyProofsReq verify proofs request object
+	VerifyProofsReq struct {
+		Proofs []BlockProof `json:"proofs"`
+	}
+
+	// BlockProofResult verification result of block proof
+	BlockProofResult struct {
+		BlockNumber uint64 `json:"block_number"`
+		Valid       bool   `json:"valid"`
+		Error       string `json:"error,omitempty"`
+	}
+)
+
+// Verifies child block inclusion proofs in batch
+func verifyProofsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req VerifyProofsReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		if len(req.Proofs) == 0 {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "no proofs to verify")
+			return
+		}
+
+		if len(req.Proofs) > MaxVerifyProofsBatchSize {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("too many proofs, max %d allowed", MaxVerifyProofsBatchSize))
+			return
+		}
+
+		contractCallerObj, err := helper.NewContractCaller()
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		results := make([]BlockProofResult, 0, len(req.Proofs))
+		for _, blockProof := range req.Proofs {
+			results = append(results, verifyBlockProof(&contractCallerObj, blockProof))
+		}
+
+		result, err := json.Marshal(results)
+		if err != nil {
+			RestLogger.Error("Error while marshalling resposne to Json", "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+func verifyBlockProof(contractCaller helper.IContractCaller, blockProof BlockProof) BlockProofResult {
+	result := BlockProofResult{BlockNumber: blockProof.BlockNumber}
+
+	if blockProof.BlockNumber < blockProof.StartBlock {
+		result.Error = "block number is before start block"
+		return result
+	}
+
+	// fetch child block header to build leaf
+	header, err := contractCaller.GetMaticChainBlock(new(big.Int).SetUint64(blockProof.BlockNumber))
+	if err != nil {
+		RestLogger.Error("Unable to fetch child block", "block", blockProof.BlockNumber, "error", err)
+		result.Error = "unable to fetch child block"
+		return result
+	}
+
+	leaf := types.GetBlockHeaderLeaf(header.Number.Uint64(), header.Time, header.TxHash, header.ReceiptHash)
+	valid, err := types.VerifyBlockProof(leaf, blockProof.BlockNumber-blockProof.StartBlock, blockProof.Root, blockProof.Proof)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Valid = valid
+	return result
+}
@@ -11,40 +11,108 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/maticnetwork/bor/common"
 	ethcmn "github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/heimdall/checkpoint/client/utils"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	paramsUtils "github.com/maticnetwork/heimdall/params/client/utils"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmRest "github.com/maticnetwork/heimdall/types/rest"
 )
 
+// registerQueryRoutes registers query routes. Routes of root chain state also have root-less form, which
+// selects root chain with ?root-chain= query param and defaults to eth.
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/checkpoints/params", paramsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/params/effects", paramChangeEffectsHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/checkpoints/simulate-ack", simulateAckHandlerFn(cliCtx)).Methods("POST")
 
 	r.HandleFunc("/overview", overviewHandlerFn(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/buffer", checkpointBufferHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/buffer/submission", checkpointSubmissionHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/checkpoints/buffer/{root}", checkpointBufferHandlerFn(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/buffer-queue/{root}", checkpointBufferQueueHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/buffer-queue", checkpointBufferQueueHandlerFn(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/sync/{root}", checkpointSyncBufferHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/sync", checkpointSyncBufferHandlerFn(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/count/{root}", checkpointCountHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/count", checkpointCountHandlerFn(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/prepare", prepareCheckpointHandlerFn(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/latest/{root}", latestCheckpointHandlerFunc(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/latest", latestCheckpointHandlerFunc(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/last-no-ack", noackHandlerFn(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/standby-proposers", standbyProposersHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/aggregation-proposers", aggregationProposersHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/aggregation-votes/{root}", aggregationVotesHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/aggregation-votes", aggregationVotesHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/failover/{root}", failoverHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/failover", failoverHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/bls-aggregation", blsAggregationHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/ack-grace-period", ackGracePeriodHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/root-hash-algorithms", rootHashAlgorithmsHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/bls-signature/{root}/{number}", blsSignatureHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/bls-signature/{number}", blsSignatureHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/attestation/{root}/{number}", attestationHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/attestation/{number}", attestationHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/account-claim/{root}/{number}/{address}", accountClaimHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/account-claim/{number}/{address}", accountClaimHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/account-root", accountRootHashHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/adjustments/{root}", checkpointAdjustmentsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/adjustments", checkpointAdjustmentsHandlerFn(cliCtx)).Methods("GET")
+
+	// Get ack and submission latency of latest checkpoints
+	r.HandleFunc("/checkpoints/timing/{root}", checkpointTimingHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/timing", checkpointTimingHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/deposits", proposerDepositsHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/deposits/{address}", proposerDepositHandlerFn(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/list", checkpointListhandlerFn(cliCtx)).Methods("GET")
+	// Get aggregated checkpoint stats of all root chains
+	r.HandleFunc("/checkpoints/stats", checkpointStatsHandlerFn(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/epoch", currentEpochHandlerFunc(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/activation-height/{root}", checkpointActivationHeightHandlerFunc(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/activation-height", checkpointActivationHeightHandlerFunc(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/{root}/{number}", checkpointByNumberHandlerFunc(cliCtx)).Methods("GET")
+	r.HandleFunc("/checkpoints/{number:[0-9]+}", checkpointByNumberHandlerFunc(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoint/verify-proofs", verifyProofsHandlerFn(cliCtx)).Methods("POST")
+
+	r.HandleFunc("/checkpoint/by-bor-block/{number}", checkpointByBorBlockHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoint/verify-tx/{txHash}", verifyTxHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoint/bundle/{number}", checkpointBundleHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/buffer/{root}/submission", checkpointSubmissionHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/cost", checkpointCostHandlerFn(cliCtx)).Methods("GET")
 }
 
 // HTTP request handler to query the auth params values
@@ -74,12 +142,9 @@ func checkpointBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		// get checkpoint number
-		vars := mux.Vars(r)
-		rootChain, ok := vars["root"]
+		// get root chain
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
 		if !ok {
-			err := fmt.Errorf("'%s' is not a valid rootChain", vars["root"])
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
@@ -101,22 +166,50 @@ func checkpointBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-func checkpointSyncBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+func checkpointBufferQueueHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		// get checkpoint number
-		vars := mux.Vars(r)
-		rootChain, ok := vars["root"]
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
 		if !ok {
-			err := fmt.Errorf("'%s' is not a valid rootChain", vars["root"])
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
+		// fetch buffered checkpoints
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBufferQueue), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+func checkpointSyncBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get root chain
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
 		// get query params
 		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
 		if err != nil {
@@ -142,12 +235,9 @@ func checkpointCountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		// get checkpoint number
-		vars := mux.Vars(r)
-		rootChain, ok := vars["root"]
+		// get root chain
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
 		if !ok {
-			err := fmt.Errorf("'%s' is not a valid rootChain", vars["root"])
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
@@ -321,249 +411,913 @@ func noackHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-type stateDump struct {
-	ACKCount         uint64               `json:"ack_count"`
-	CheckpointBuffer *hmTypes.Checkpoint  `json:"checkpoint_buffer"`
-	ValidatorCount   int                  `json:"validator_count"`
-	ValidatorSet     hmTypes.ValidatorSet `json:"validator_set"`
-	LastNoACK        time.Time            `json:"last_noack_time"`
-}
-
-// get all state-dump of heimdall
-func overviewHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+func standbyProposersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		//
-		// Ack acount
-		//
-
-		var ackCountInt uint64
-		ackCountBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEpoch), nil)
-		if err == nil {
-			// check content
-			if ok := hmRest.ReturnNotFoundIfNoContent(w, ackCountBytes, "No ack count found"); ok {
-				if err := json.Unmarshal(ackCountBytes, &ackCountInt); err != nil {
-					// log and ignore
-					RestLogger.Error("Error while unmarshing no-ack count", "error", err)
-				}
-			}
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStandbyProposers), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		}
 
-		//
-		// Checkpoint buffer
-		//
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
 
-		var _checkpoint *hmTypes.Checkpoint
-		checkpointBufferBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
-		if err == nil {
-			if len(checkpointBufferBytes) != 0 {
-				_checkpoint = new(hmTypes.Checkpoint)
-				if err = json.Unmarshal(checkpointBufferBytes, _checkpoint); err != nil {
-					// log and ignore
-					RestLogger.Error("Error while unmarshing checkpoint header", "error", err)
-				}
-			}
+func aggregationProposersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
 		}
 
-		//
-		// Current validator set
-		//
-
-		var validatorSet hmTypes.ValidatorSet
-		validatorSetBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", stakingTypes.QuerierRoute, stakingTypes.QueryCurrentValidatorSet), nil)
-		if err == nil {
-			if err := json.Unmarshal(validatorSetBytes, &validatorSet); err != nil {
-				// log and ignore
-				RestLogger.Error("Error while unmarshing validator set", "error", err)
-			}
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAggregationProposers), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		}
 
-		// validator count
-		validatorCount := len(validatorSet.Validators)
-
-		//
-		// Last no-ack
-		//
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
 
-		// last no ack
-		var lastNoACKTime uint64
-		lastNoACKBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), nil)
-		if err == nil {
-			// check content
-			if ok := hmRest.ReturnNotFoundIfNoContent(w, lastNoACKBytes, "No last-no-ack count found"); ok {
-				if err := json.Unmarshal(lastNoACKBytes, &lastNoACKTime); err != nil {
-					// log and ignore
-					RestLogger.Error("Error while unmarshing last no-ack time", "error", err)
-				}
-			}
+// aggregationVotesHandlerFn returns checkpoints submitted by aggregation proposers for next range of root chain
+func aggregationVotesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
 		}
 
-		//
-		// State dump
-		//
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
 
-		state := stateDump{
-			ACKCount:         ackCountInt,
-			CheckpointBuffer: _checkpoint,
-			ValidatorCount:   validatorCount,
-			ValidatorSet:     validatorSet,
-			LastNoACK:        time.Unix(int64(lastNoACKTime), 0),
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		}
 
-		result, err := json.Marshal(state)
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAggregationVotes), queryParams)
 		if err != nil {
-			RestLogger.Error("Error while marshalling resposne to Json", "error", err)
-			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, result)
 	}
 }
 
-// get last checkpoint from store
-func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+// failoverHandlerFn returns expired checkpoint of root chain and backup proposers allowed to resubmit it
+func failoverHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		// get checkpoint number
-		vars := mux.Vars(r)
-		rootChain, ok := vars["root"]
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
 		if !ok {
-			err := fmt.Errorf("'%s' is not a valid rootChain", vars["root"])
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		// get query params
 		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
 		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		//
-		// Get ack count
-		//
 
-		ackcountBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), queryParams)
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFailover), queryParams)
 		if err != nil {
-			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		// check content
-		if ok := hmRest.ReturnNotFoundIfNoContent(w, ackcountBytes, "No ack count found"); !ok {
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// blsAggregationHandlerFn returns true if BLS signatures of checkpoints are aggregated
+func blsAggregationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
 			return
 		}
 
-		var ackCount uint64
-		if err := json.Unmarshal(ackcountBytes, &ackCount); err != nil {
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlsAggregation), nil)
+		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		//
-		// Last checkpoint key
-		//
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
 
-		RestLogger.Debug("ACK Count fetched", "ackCount", ackCount)
-		lastCheckpointKey := ackCount
-		RestLogger.Debug("Last checkpoint key generated", "lastCheckpointKey", lastCheckpointKey)
+// ParamChangeEffectsReq proposed param changes, in format of param change proposal
+type ParamChangeEffectsReq struct {
+	Changes paramsUtils.ParamChangesJSON `json:"changes"`
+}
 
-		// get query params
-		queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(lastCheckpointKey, rootChain))
-		if err != nil {
+// paramChangeEffectsHandlerFn returns effects of proposed checkpoint params on checkpoint delivery
+func paramChangeEffectsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ParamChangeEffectsReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
 			return
 		}
 
-		//
-		// Get checkpoint
-		//
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
 
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryParamChangeParams(req.Changes.ToParamChanges()))
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		// error if no checkpoint found
-		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint found"); !ok {
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParamChangeEffects), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
 
-// get checkpoint by checkppint number from store
-func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
+// SimulateAckReq candidate checkpoint ack with side-tx result to dry-run it with, yes if not set
+type SimulateAckReq struct {
+	Msg          types.MsgCheckpointAck `json:"msg"`
+	SideTxResult string                 `json:"side_tx_result"`
+}
 
-		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
-		if !ok {
+// simulateAckHandlerFn returns result post-tx handler would produce for candidate ack, nothing is broadcast
+func simulateAckHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SimulateAckReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
 			return
 		}
 
-		// get checkpoint number
-		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		rootChain, ok := vars["root"]
-		if !ok {
-			err := fmt.Errorf("'%s' is not a valid rootChain", vars["root"])
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number, rootChain))
-		if err != nil {
-			return
+		sideTxResult := abci.SideTxResultType_Yes
+		if req.SideTxResult != "" {
+			var err error
+			if sideTxResult, err = hmTypes.ParseSideTxResult(req.SideTxResult); err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
 		}
 
-		// query checkpoint
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySimulateAckParams(req.Msg, sideTxResult))
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		// check content
-		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint found"); !ok {
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySimulateAck), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
 
-func checkpointListhandlerFn(
-	cliCtx context.CLIContext,
-) http.HandlerFunc {
+// ackGracePeriodHandlerFn returns time only checkpoint proposer may ack buffered checkpoint
+func ackGracePeriodHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := r.URL.Query()
-
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		// get page
-		root := vars.Get("root")
-		if hmTypes.GetRootChainID(root) == 0 {
-			err := fmt.Errorf("valid root chain %v", root)
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckGracePeriod), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// rootHashAlgorithmsHandlerFn returns root hash algorithms of child chains which aren't checkpointed with bor root hash
+func rootHashAlgorithmsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRootHashAlgorithms), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// blsSignatureHandlerFn returns aggregated BLS signature of checkpoint, buffered checkpoint's if number is 0
+func blsSignatureHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number, rootChain))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlsSignature), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// attestationHandlerFn returns block hash commitment of checkpoint, buffered checkpoint's if number is 0
+func attestationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number, rootChain))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAttestation), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// accountClaimHandlerFn returns merkle proof of dividend account against account root hash of checkpoint,
+// with claimFee call data of stake manager
+func accountClaimHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		if !ethcmn.IsHexAddress(vars["address"]) {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid user address %v", vars["address"]))
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryAccountClaimParams(number, rootChain, hmTypes.HexToHeimdallAddress(vars["address"])))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccountClaim), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// checkpointAdjustmentsHandlerFn returns adjustment records of root chain checkpoints, filtered by optional number
+func checkpointAdjustmentsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		root, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		var number uint64
+		if numberStr := r.URL.Query().Get("number"); numberStr != "" {
+			if number, ok = rest.ParseUint64OrReturnBadRequest(w, numberStr); !ok {
+				return
+			}
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number, root))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAdjustments), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// checkpointTimingHandlerFn returns ack and submission latency distributions of latest checkpoints of root chain
+func checkpointTimingHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		root, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		var limit uint64
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			if limit, ok = rest.ParseUint64OrReturnBadRequest(w, limitStr); !ok {
+				return
+			}
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointTimingParams(root, limit))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointTiming), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// accountRootHashHandlerFn returns account root hash precomputed for next checkpoint
+func accountRootHashHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccountRootHash), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// proposerDepositsHandlerFn returns deposits held by checkpoint module account for all proposers
+func proposerDepositsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerDeposits), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// checkpointStatsHandlerFn returns aggregated checkpoint stats of all root chains
+func checkpointStatsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStats), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// proposerDepositHandlerFn returns deposit held by checkpoint module account for proposer
+func proposerDepositHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		if !ethcmn.IsHexAddress(vars["address"]) {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid proposer address %v", vars["address"]))
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerDepositParams(hmTypes.HexToHeimdallAddress(vars["address"])))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerDeposit), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+type stateDump struct {
+	ACKCount         uint64               `json:"ack_count"`
+	CheckpointBuffer *hmTypes.Checkpoint  `json:"checkpoint_buffer"`
+	ValidatorCount   int                  `json:"validator_count"`
+	ValidatorSet     hmTypes.ValidatorSet `json:"validator_set"`
+	LastNoACK        time.Time            `json:"last_noack_time"`
+}
+
+// get all state-dump of heimdall
+func overviewHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		//
+		// Ack acount
+		//
+
+		var ackCountInt uint64
+		ackCountBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEpoch), nil)
+		if err == nil {
+			// check content
+			if ok := hmRest.ReturnNotFoundIfNoContent(w, ackCountBytes, "No ack count found"); ok {
+				if err := json.Unmarshal(ackCountBytes, &ackCountInt); err != nil {
+					// log and ignore
+					RestLogger.Error("Error while unmarshing no-ack count", "error", err)
+				}
+			}
+		}
+
+		//
+		// Checkpoint buffer
+		//
+
+		var _checkpoint *hmTypes.Checkpoint
+		checkpointBufferBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+		if err == nil {
+			if len(checkpointBufferBytes) != 0 {
+				_checkpoint = new(hmTypes.Checkpoint)
+				if err = json.Unmarshal(checkpointBufferBytes, _checkpoint); err != nil {
+					// log and ignore
+					RestLogger.Error("Error while unmarshing checkpoint header", "error", err)
+				}
+			}
+		}
+
+		//
+		// Current validator set
+		//
+
+		var validatorSet hmTypes.ValidatorSet
+		validatorSetBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", stakingTypes.QuerierRoute, stakingTypes.QueryCurrentValidatorSet), nil)
+		if err == nil {
+			if err := json.Unmarshal(validatorSetBytes, &validatorSet); err != nil {
+				// log and ignore
+				RestLogger.Error("Error while unmarshing validator set", "error", err)
+			}
+		}
+
+		// validator count
+		validatorCount := len(validatorSet.Validators)
+
+		//
+		// Last no-ack
+		//
+
+		// last no ack
+		var lastNoACKTime uint64
+		lastNoACKBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), nil)
+		if err == nil {
+			// check content
+			if ok := hmRest.ReturnNotFoundIfNoContent(w, lastNoACKBytes, "No last-no-ack count found"); ok {
+				if err := json.Unmarshal(lastNoACKBytes, &lastNoACKTime); err != nil {
+					// log and ignore
+					RestLogger.Error("Error while unmarshing last no-ack time", "error", err)
+				}
+			}
+		}
+
+		//
+		// State dump
+		//
+
+		state := stateDump{
+			ACKCount:         ackCountInt,
+			CheckpointBuffer: _checkpoint,
+			ValidatorCount:   validatorCount,
+			ValidatorSet:     validatorSet,
+			LastNoACK:        time.Unix(int64(lastNoACKTime), 0),
+		}
+
+		result, err := json.Marshal(state)
+		if err != nil {
+			RestLogger.Error("Error while marshalling resposne to Json", "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// get last checkpoint from store
+func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get root chain
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+		if err != nil {
+			return
+		}
+		//
+		// Get ack count
+		//
+
+		ackcountBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, ackcountBytes, "No ack count found"); !ok {
+			return
+		}
+
+		var ackCount uint64
+		if err := json.Unmarshal(ackcountBytes, &ackCount); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		//
+		// Last checkpoint key
+		//
+
+		RestLogger.Debug("ACK Count fetched", "ackCount", ackCount)
+		lastCheckpointKey := ackCount
+		RestLogger.Debug("Last checkpoint key generated", "lastCheckpointKey", lastCheckpointKey)
+
+		// get query params
+		queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(lastCheckpointKey, rootChain))
+		if err != nil {
+			return
+		}
+
+		//
+		// Get checkpoint
+		//
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no checkpoint found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint found"); !ok {
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// get checkpoint by checkppint number from store
+func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get checkpoint number
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number, rootChain))
+		if err != nil {
+			return
+		}
+
+		// query checkpoint
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint found"); !ok {
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// checkpointByBorBlockHandlerFn returns checkpoint which covers bor block, root chain defaults to stake chain
+func checkpointByBorBlockHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get bor block number
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		// all root chains unless one is selected
+		root, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, "")
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(number, root))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBorBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint found"); !ok {
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// checkpointBundleHandlerFn returns checkpoint with its proof bundle, root chain defaults to eth
+func checkpointBundleHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get checkpoint number
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		root, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		bundle, err := utils.QueryCheckpointBundle(cliCtx, number, root)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		result, err := json.Marshal(bundle)
+		if err != nil {
+			RestLogger.Error("Error while marshalling resposne to Json", "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// checkpointSubmissionHandlerFn returns checkpoint in buffer with signatures in submitCheckpoint layout
+func checkpointSubmissionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		submission, err := utils.QueryCheckpointSubmission(cliCtx, rootChain)
+		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
+		result, err := json.Marshal(submission)
+		if err != nil {
+			RestLogger.Error("Error while marshalling resposne to Json", "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// checkpointCostHandlerFn estimates root chain cost of submitting checkpoints in buffer, for all root chains
+// or only for selected root chain
+func checkpointCostHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, "")
+		if !ok {
+			return
+		}
+
+		rootChains := []string{hmTypes.RootChainTypeTron, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc}
+		if rootChain != "" {
+			rootChains = []string{rootChain}
+		}
+
+		contractCallerObj, err := helper.NewContractCaller()
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		estimates := make([]types.CheckpointCostEstimate, 0, len(rootChains))
+		for _, rootChain := range rootChains {
+			estimate, err := utils.QueryCheckpointCost(cliCtx, &contractCallerObj, rootChain)
+			if err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			estimates = append(estimates, estimate)
+		}
+
+		result, err := json.Marshal(estimates)
+		if err != nil {
+			RestLogger.Error("Error while marshalling resposne to Json", "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+func checkpointListhandlerFn(
+	cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get root chain
+		root, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
 		// get page
 		page, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("page"))
 		if !ok {
@@ -636,16 +1390,12 @@ func currentEpochHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 // checkpointActivationHeightHandlerFunc get activation height from store
 func checkpointActivationHeightHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
-		rootChain, ok := vars["root"]
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
 		if !ok {
-			err := fmt.Errorf("'%s' is not a valid rootChain", vars["root"])
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 		// get query params
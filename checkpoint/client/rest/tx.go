@@ -20,6 +20,7 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	).Methods("POST")
 	r.HandleFunc("/checkpoint/ack", newCheckpointACKHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/checkpoint/no-ack", newCheckpointNoACKHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/checkpoint/adjust", newCheckpointAdjustHandler(cliCtx)).Methods("POST")
 }
 
 type (
@@ -53,6 +54,19 @@ type (
 		RootChain   string                  `json:"root_chain"`
 	}
 
+	// HeaderAdjustReq struct for adjusting stored checkpoint to root chain contract
+	HeaderAdjustReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		From        hmTypes.HeimdallAddress `json:"from"`
+		HeaderBlock uint64                  `json:"header_block"`
+		StartBlock  uint64                  `json:"start_block"`
+		EndBlock    uint64                  `json:"end_block"`
+		Proposer    hmTypes.HeimdallAddress `json:"proposer"`
+		RootHash    hmTypes.HeimdallHash    `json:"root_hash"`
+		RootChain   string                  `json:"root_chain"`
+	}
+
 	// HeaderNoACKReq struct for sending no-ack for a new headers
 	HeaderNoACKReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
@@ -141,3 +155,31 @@ func newCheckpointNoACKHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func newCheckpointAdjustHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req HeaderAdjustReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// draft a message and send response
+		msg := types.NewMsgCheckpointAdjust(
+			req.From,
+			req.HeaderBlock,
+			req.Proposer,
+			req.StartBlock,
+			req.EndBlock,
+			req.RootHash,
+			req.RootChain,
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
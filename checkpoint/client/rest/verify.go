@@ -0,0 +1,170 @@
+package rest
+
+import (
+	"bytes"
+	stdContext "context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/maticnetwork/bor/common"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+const (
+	// MaxVerifyTxCheckpointLength max number of blocks of checkpoint inclusion of tx is verified in,
+	// bor headers of whole checkpoint are fetched to rebuild its merkle tree
+	MaxVerifyTxCheckpointLength uint64 = 2048
+
+	// verifyTxLeavesCacheSize number of checkpoints whose leaves are kept for verification of txs
+	verifyTxLeavesCacheSize = 64
+)
+
+var (
+	verifyTxOnce        sync.Once
+	verifyTxCaller      helper.ContractCaller
+	verifyTxCallerErr   error
+	verifyTxLeavesCache *lru.Cache
+)
+
+// getVerifyTxCaller returns contract caller and checkpoint leaves cache shared by verify-tx requests
+func getVerifyTxCaller() (*helper.ContractCaller, *lru.Cache, error) {
+	verifyTxOnce.Do(func() {
+		if verifyTxCaller, verifyTxCallerErr = helper.NewContractCaller(); verifyTxCallerErr != nil {
+			return
+		}
+		verifyTxLeavesCache, verifyTxCallerErr = lru.New(verifyTxLeavesCacheSize)
+	})
+
+	return &verifyTxCaller, verifyTxLeavesCache, verifyTxCallerErr
+}
+
+// Verifies inclusion of bor tx in acked checkpoint and returns attestation, verified attestations are
+// signed by attestation key of node if it has one. Root chain defaults to stake chain, it can be
+// selected with ?root-chain= query param.
+func verifyTxHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		txHash := common.HexToHash(mux.Vars(r)["txHash"])
+		if txHash == (common.Hash{}) {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "invalid tx hash")
+			return
+		}
+
+		root, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmTypes.RootChainTypeStake)
+		if !ok {
+			return
+		}
+
+		contractCallerObj, leavesCache, err := getVerifyTxCaller()
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// locate block of tx on bor
+		receipt, err := contractCallerObj.GetMaticTxReceipt(txHash)
+		if err != nil || receipt == nil || receipt.BlockNumber == nil {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("tx %v not found on bor", txHash.Hex()))
+			return
+		}
+		blockNumber := receipt.BlockNumber.Uint64()
+
+		// locate acked checkpoint covering block
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(blockNumber, root))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBorBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("block %v is not checkpointed on %v yet: %v", blockNumber, root, err))
+			return
+		}
+
+		var borBlockCheckpoint types.BorBlockCheckpoint
+		if err := json.Unmarshal(res, &borBlockCheckpoint); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		checkpoint := borBlockCheckpoint.Checkpoint
+		if blockNumber < checkpoint.StartBlock || blockNumber > checkpoint.EndBlock {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("block %v is not in checkpoint %v", blockNumber, borBlockCheckpoint.Number))
+			return
+		}
+		if checkpoint.EndBlock < checkpoint.StartBlock || checkpoint.EndBlock-checkpoint.StartBlock+1 > MaxVerifyTxCheckpointLength {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("checkpoint %v is longer than %v blocks", borBlockCheckpoint.Number, MaxVerifyTxCheckpointLength))
+			return
+		}
+
+		// recompute merkle branch of block from bor headers, leaves of checkpoint are fetched once
+		cacheKey := fmt.Sprintf("%v:%v:%v", checkpoint.StartBlock, checkpoint.EndBlock, checkpoint.RootHash.Hex())
+		var leaves [][]byte
+		if cached, ok := leavesCache.Get(cacheKey); ok {
+			leaves = cached.([][]byte)
+		} else {
+			leaves, err = types.FetchBlockLeaves(stdContext.Background(), helper.GetMaticRPCClient(), checkpoint.StartBlock, checkpoint.EndBlock, 0)
+			if err != nil {
+				RestLogger.Error("Unable to compute block proof", "block", blockNumber, "error", err)
+				hmRest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("unable to compute block proof: %v", err))
+				return
+			}
+			leavesCache.Add(cacheKey, leaves)
+		}
+
+		index := blockNumber - checkpoint.StartBlock
+		rootHash, proof := types.GetBlockProof(leaves, index)
+		valid, err := types.VerifyBlockProof(leaves[index], index, checkpoint.RootHash.Bytes(), proof)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		attestation := types.InclusionAttestation{
+			TxHash:           hmTypes.BytesToHeimdallHash(txHash.Bytes()),
+			BlockNumber:      blockNumber,
+			RootChain:        root,
+			CheckpointNumber: borBlockCheckpoint.Number,
+			StartBlock:       checkpoint.StartBlock,
+			EndBlock:         checkpoint.EndBlock,
+			RootHash:         checkpoint.RootHash,
+			Proof:            proof,
+			Verified:         valid && bytes.Equal(rootHash, checkpoint.RootHash.Bytes()),
+			Height:           height,
+			Timestamp:        time.Now().UTC().Unix(),
+		}
+
+		// validator key never signs on public route, only verified inclusion is attested
+		if privKey := helper.GetAttestationPrivKey(); privKey != nil && attestation.Verified {
+			if err := attestation.Sign(privKey); err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
+
+		result, err := json.Marshal(attestation)
+		if err != nil {
+			RestLogger.Error("Error while marshalling resposne to Json", "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
@@ -0,0 +1,206 @@
+package utils
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	defaultPage  = 1
+	defaultLimit = 30 // should be consistent with tendermint/tendermint/rpc/core/pipe.go:19
+)
+
+// QueryCheckpointBundle builds self-contained bundle of checkpoint: checkpoint,
+// header block from root chain contract, side-tx with validator signatures,
+// validator set which signed it and merkle tree parameters.
+func QueryCheckpointBundle(cliCtx context.CLIContext, number uint64, rootChain string) (bundle types.CheckpointBundle, err error) {
+	if hmTypes.GetRootChainID(rootChain) == 0 {
+		return bundle, fmt.Errorf("invalid root chain %v", rootChain)
+	}
+
+	bundle.Number = number
+	bundle.RootChain = rootChain
+
+	// checkpoint
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number, rootChain))
+	if err != nil {
+		return bundle, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+	if err != nil {
+		return bundle, err
+	}
+
+	if len(res) == 0 {
+		return bundle, errors.New("checkpoint not found")
+	}
+
+	if err := json.Unmarshal(res, &bundle.Checkpoint); err != nil {
+		return bundle, err
+	}
+
+	// chain params of root chain
+	chainParams, err := queryChainParams(cliCtx, rootChain)
+	if err != nil {
+		return bundle, err
+	}
+
+	// side-tx and signatures
+	msg, _, err := queryCheckpointSideTx(cliCtx, bundle.Checkpoint, rootChain, &bundle.SideTx)
+	if err != nil {
+		return bundle, err
+	}
+
+	// validator set which voted on side-tx
+	res, _, err = cliCtx.WithHeight(bundle.SideTx.Height+1).QueryWithData(fmt.Sprintf("custom/%s/%s", stakingTypes.QuerierRoute, stakingTypes.QueryCurrentValidatorSet), nil)
+	if err != nil {
+		return bundle, err
+	}
+
+	if err := json.Unmarshal(res, &bundle.ValidatorSet); err != nil {
+		return bundle, err
+	}
+
+	// header block on root chain
+	if bundle.HeaderBlock, err = QueryHeaderBlock(cliCtx, number, rootChain); err != nil {
+		return bundle, err
+	}
+
+	bundle.Merkle = types.NewMerkleParams(bundle.Checkpoint.StartBlock, bundle.Checkpoint.EndBlock, chainParams.ChildBlockInterval, msg.AccountRootHash)
+
+	return bundle, nil
+}
+
+// queryCheckpointSideTx finds approved checkpoint side-tx and fetches validator signatures of it,
+// signatures and their signers are sorted by signer address
+func queryCheckpointSideTx(cliCtx context.CLIContext, checkpoint hmTypes.Checkpoint, rootChain string, sideTx *types.BundleSideTx) (msg types.MsgCheckpoint, signers []hmTypes.HeimdallAddress, err error) {
+	events := []string{
+		fmt.Sprintf("%s.%s='%s'", types.EventTypeCheckpoint, types.AttributeKeyRootHash, checkpoint.RootHash.String()),
+		fmt.Sprintf("%s.%s='%s'", types.EventTypeCheckpoint, types.AttributeKeyStartBlock, strconv.FormatUint(checkpoint.StartBlock, 10)),
+	}
+
+	searchResult, err := helper.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
+	if err != nil {
+		return msg, nil, err
+	}
+
+	decoder := helper.GetTxDecoder(authTypes.ModuleCdc)
+
+	// latest submission is the one which got approved
+	for i := len(searchResult.Txs) - 1; i >= 0; i-- {
+		hash, err := hex.DecodeString(searchResult.Txs[i].TxHash)
+		if err != nil {
+			continue
+		}
+
+		tx, err := helper.QueryTxWithProof(cliCtx, hash)
+		if err != nil {
+			continue
+		}
+
+		stdTx, err := decoder(tx.Tx)
+		if err != nil {
+			continue
+		}
+
+		var ok bool
+		if msg, ok = stdTx.GetMsgs()[0].(types.MsgCheckpoint); !ok || msg.RootChainType != rootChain {
+			continue
+		}
+
+		// side-tx takes 2 blocks to process
+		blockDetails, err := helper.GetBlock(cliCtx, tx.Height+2)
+		if err != nil {
+			continue
+		}
+
+		sideTxData := msg.GetSideSignBytes()
+		signers, sigs, err := helper.GetSideTxSigsWithSigners(tx.Tx.Hash(), sideTxData, blockDetails.Block.LastCommit.Precommits)
+		if err != nil || len(sigs) == 0 {
+			continue
+		}
+
+		sideTx.TxHash = hmTypes.BytesToHeimdallHash(tx.Tx.Hash()).Hex()
+		sideTx.Height = tx.Height
+		sideTx.Tx = hex.EncodeToString(tx.Tx)
+		sideTx.Data = hex.EncodeToString(sideTxData)
+		for _, s := range sigs {
+			sideTx.Sigs = append(sideTx.Sigs, [3]string{s[0].String(), s[1].String(), s[2].String()})
+		}
+
+		return msg, signers, nil
+	}
+
+	return msg, nil, errors.New("no signed checkpoint tx found")
+}
+
+// QueryHeaderBlock reads header block of checkpoint from root chain contract
+func QueryHeaderBlock(cliCtx context.CLIContext, number uint64, rootChain string) (header types.BundleHeaderBlock, err error) {
+	chainParams, err := queryChainParams(cliCtx, rootChain)
+	if err != nil {
+		return header, err
+	}
+	childBlockInterval := chainParams.ChildBlockInterval
+
+	contractCaller, err := helper.NewContractCaller()
+	if err != nil {
+		return header, err
+	}
+
+	header.HeaderID = number * childBlockInterval
+
+	switch rootChain {
+	case hmTypes.RootChainTypeTron:
+		header.Contract = chainParams.ChainParams.TronChainAddress.Hex()
+		root, start, end, createdAt, proposer, err := contractCaller.GetTronHeaderInfo(number, header.Contract, childBlockInterval)
+		if err != nil {
+			return header, err
+		}
+
+		header.RootHash, header.StartBlock, header.EndBlock, header.CreatedAt, header.Proposer = hmTypes.BytesToHeimdallHash(root.Bytes()), start, end, createdAt, proposer
+	default:
+		header.Contract = chainParams.ChainParams.RootChainAddress.String()
+		rootChainInstance, err := contractCaller.GetRootChainInstance(chainParams.ChainParams.RootChainAddress.EthAddress(), rootChain)
+		if err != nil {
+			return header, err
+		}
+
+		root, start, end, createdAt, proposer, err := contractCaller.GetHeaderInfo(number, rootChainInstance, childBlockInterval)
+		if err != nil {
+			return header, err
+		}
+
+		header.RootHash, header.StartBlock, header.EndBlock, header.CreatedAt, header.Proposer = hmTypes.BytesToHeimdallHash(root.Bytes()), start, end, createdAt, proposer
+	}
+
+	return header, nil
+}
+
+// queryChainParams fetches chain manager params of root chain
+func queryChainParams(cliCtx context.CLIContext, rootChain string) (chainParams chainmanagerTypes.Params, err error) {
+	queryParams, err := cliCtx.Codec.MarshalJSON(chainmanagerTypes.NewQueryChainParams(rootChain))
+	if err != nil {
+		return chainParams, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", chainmanagerTypes.QuerierRoute, chainmanagerTypes.QueryNewChainParam), queryParams)
+	if err != nil {
+		return chainParams, err
+	}
+
+	err = json.Unmarshal(res, &chainParams)
+	return chainParams, err
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// QueryCheckpointCost estimates root chain cost of submitting checkpoint in buffer with its current
+// signatures by simulating submitCheckpoint call, along with current proposer.
+// Missing buffer and root chain errors are reported in estimate instead of failing the query.
+func QueryCheckpointCost(cliCtx context.CLIContext, contractCaller helper.IContractCaller, rootChain string) (estimate types.CheckpointCostEstimate, err error) {
+	if hmTypes.GetRootChainID(rootChain) == 0 {
+		return estimate, fmt.Errorf("invalid root chain %v", rootChain)
+	}
+
+	estimate.RootChain = rootChain
+
+	if estimate.Proposer, err = queryCurrentProposer(cliCtx); err != nil {
+		return estimate, err
+	}
+
+	submission, err := QueryCheckpointSubmission(cliCtx, rootChain)
+	if err != nil {
+		estimate.Error = err.Error()
+		return estimate, nil
+	}
+
+	estimate.Checkpoint = &submission.Checkpoint
+	estimate.Signers = len(submission.Signers)
+
+	sideTxData, sigs, err := decodeSubmission(submission.Data, submission.Sigs)
+	if err != nil {
+		return estimate, err
+	}
+
+	chainParams, err := queryChainParams(cliCtx, rootChain)
+	if err != nil {
+		return estimate, err
+	}
+
+	switch rootChain {
+	case hmTypes.RootChainTypeTron:
+		feeLimit, err := contractCaller.SimulateTronCheckpoint(sideTxData, sigs, chainParams.ChainParams.TronChainAddress.Hex())
+		if err != nil {
+			estimate.Error = err.Error()
+			return estimate, nil
+		}
+
+		estimate.Cost = new(big.Int).SetUint64(feeLimit).String()
+	default:
+		gasLimit, gasPrice, err := contractCaller.EstimateCheckpointGas(sideTxData, sigs, chainParams.ChainParams.RootChainAddress.EthAddress(), rootChain)
+		if err != nil {
+			estimate.Error = err.Error()
+			return estimate, nil
+		}
+
+		estimate.GasLimit = gasLimit
+		estimate.GasPrice = gasPrice.String()
+		estimate.Cost = new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), gasPrice).String()
+	}
+
+	return estimate, nil
+}
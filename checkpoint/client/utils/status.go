@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// QueryCheckpointStatus aggregates checkpoint state of root chain: ack count, buffer with its expiry,
+// last no-ack, current proposer and current header block of root chain contract.
+// Contract errors are reported in status instead of failing the query.
+func QueryCheckpointStatus(cliCtx context.CLIContext, contractCaller helper.ContractCaller, rootChain string) (status types.CheckpointStatus, err error) {
+	if hmTypes.GetRootChainID(rootChain) == 0 {
+		return status, fmt.Errorf("invalid root chain %v", rootChain)
+	}
+
+	status.RootChain = rootChain
+
+	// checkpoint params
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+	if err != nil {
+		return status, err
+	}
+
+	var params types.Params
+	if err := json.Unmarshal(res, &params); err != nil {
+		return status, err
+	}
+
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+	if err != nil {
+		return status, err
+	}
+
+	// ack count
+	res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), queryParams)
+	if err != nil {
+		return status, err
+	}
+
+	if err := json.Unmarshal(res, &status.AckCount); err != nil {
+		return status, err
+	}
+
+	// buffer, empty buffer is returned as error
+	if res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), queryParams); err == nil && len(res) != 0 {
+		var buffer hmTypes.Checkpoint
+		if err := json.Unmarshal(res, &buffer); err != nil {
+			return status, err
+		}
+
+		status.Buffer = &buffer
+		status.BufferExpiresAt = buffer.TimeStamp + uint64(params.CheckpointBufferTime.Seconds())
+		status.BufferExpiresIn = int64(status.BufferExpiresAt) - time.Now().Unix()
+	}
+
+	// last no-ack
+	res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), nil)
+	if err != nil {
+		return status, err
+	}
+
+	if err := json.Unmarshal(res, &status.LastNoAck); err != nil {
+		return status, err
+	}
+
+	// current proposer
+	if status.Proposer, err = queryCurrentProposer(cliCtx); err != nil {
+		return status, err
+	}
+
+	// root chain contract
+	if status.ContractHeaderBlock, err = queryCurrentHeaderBlock(cliCtx, contractCaller, rootChain); err != nil {
+		status.ContractError = err.Error()
+	}
+
+	return status, nil
+}
+
+// queryCurrentHeaderBlock reads current header block from root chain contract
+func queryCurrentHeaderBlock(cliCtx context.CLIContext, contractCaller helper.ContractCaller, rootChain string) (uint64, error) {
+	chainParams, err := queryChainParams(cliCtx, rootChain)
+	if err != nil {
+		return 0, err
+	}
+	childBlockInterval := chainParams.ChildBlockInterval
+
+	switch rootChain {
+	case hmTypes.RootChainTypeTron:
+		return contractCaller.TronChainRPC.CurrentHeaderBlock(chainParams.ChainParams.TronChainAddress.Hex(), childBlockInterval)
+	default:
+		rootChainInstance, err := contractCaller.GetRootChainInstance(chainParams.ChainParams.RootChainAddress.EthAddress(), rootChain)
+		if err != nil {
+			return 0, err
+		}
+
+		return contractCaller.CurrentHeaderBlock(rootChainInstance, childBlockInterval)
+	}
+}
+
+// queryCurrentProposer returns signer of current checkpoint proposer
+func queryCurrentProposer(cliCtx context.CLIContext) (hmTypes.HeimdallAddress, error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StakingQuerierRoute, types.QueryCurrentProposer), nil)
+	if err != nil {
+		return hmTypes.HeimdallAddress{}, err
+	}
+
+	var proposer hmTypes.Validator
+	if err := json.Unmarshal(res, &proposer); err != nil {
+		return hmTypes.HeimdallAddress{}, err
+	}
+
+	return proposer.Signer, nil
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/maticnetwork/bor/accounts/abi"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// QueryCheckpointSubmission returns checkpoint in buffer of root chain along with aggregated
+// validator signatures of its side-tx, so submitter tooling outside bridge can build root chain tx
+func QueryCheckpointSubmission(cliCtx context.CLIContext, rootChain string) (submission types.CheckpointSubmission, err error) {
+	if hmTypes.GetRootChainID(rootChain) == 0 {
+		return submission, fmt.Errorf("invalid root chain %v", rootChain)
+	}
+
+	submission.RootChain = rootChain
+
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+	if err != nil {
+		return submission, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), queryParams)
+	if err != nil {
+		return submission, err
+	}
+
+	if len(res) == 0 {
+		return submission, errors.New("no checkpoint in buffer")
+	}
+
+	if err := json.Unmarshal(res, &submission.Checkpoint); err != nil {
+		return submission, err
+	}
+
+	var sideTx types.BundleSideTx
+	_, signers, err := queryCheckpointSideTx(cliCtx, submission.Checkpoint, rootChain, &sideTx)
+	if err != nil {
+		return submission, err
+	}
+
+	submission.TxHash = sideTx.TxHash
+	submission.Height = sideTx.Height
+	submission.Data = sideTx.Data
+	submission.Signers = signers
+	submission.Sigs = sideTx.Sigs
+
+	callData, err := packSubmitCheckpoint(sideTx.Data, sideTx.Sigs)
+	if err != nil {
+		return submission, err
+	}
+
+	submission.CallData = hex.EncodeToString(callData)
+	return submission, nil
+}
+
+// packSubmitCheckpoint abi encodes submitCheckpoint call of root chain contract
+func packSubmitCheckpoint(data string, sigs [][3]string) ([]byte, error) {
+	rootChainABI, err := abi.JSON(strings.NewReader(rootchain.RootchainABI))
+	if err != nil {
+		return nil, err
+	}
+
+	sideTxData, values, err := decodeSubmission(data, sigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return rootChainABI.Pack("submitCheckpoint", sideTxData, values)
+}
+
+// decodeSubmission decodes hex side-tx data and decimal signature values of submission
+func decodeSubmission(data string, sigs [][3]string) ([]byte, [][3]*big.Int, error) {
+	sideTxData, err := hex.DecodeString(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := make([][3]*big.Int, len(sigs))
+	for i, sig := range sigs {
+		for j := range sig {
+			v, ok := new(big.Int).SetString(sig[j], 10)
+			if !ok {
+				return nil, nil, fmt.Errorf("invalid signature value %v", sig[j])
+			}
+			values[i][j] = v
+		}
+	}
+
+	return sideTxData, values, nil
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+)
+
+func TestPackSubmitCheckpoint(t *testing.T) {
+	rootChainABI, err := abi.JSON(strings.NewReader(rootchain.RootchainABI))
+	require.NoError(t, err)
+
+	sigs := [][3]string{{"1", "2", "27"}, {"3", "4", "28"}}
+	callData, err := packSubmitCheckpoint("0102", sigs)
+	require.NoError(t, err)
+
+	inputs := make(map[string]interface{})
+	require.NoError(t, rootChainABI.Methods["submitCheckpoint"].Inputs.UnpackIntoMap(inputs, callData[4:]))
+	require.Equal(t, []byte{0x01, 0x02}, inputs["data"])
+
+	_, err = packSubmitCheckpoint("zz", sigs)
+	require.Error(t, err, "Invalid hex data should fail")
+
+	_, err = packSubmitCheckpoint("0102", [][3]string{{"1", "x", "27"}})
+	require.Error(t, err, "Invalid signature value should fail")
+}
@@ -0,0 +1,40 @@
+package checkpoint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+// SetPayloadCompression sets compression of bulk auxiliary checkpoint data stored from now on
+func (k *Keeper) SetPayloadCompression(ctx sdk.Context, compression string) {
+	k.paramSpace.Set(ctx, types.KeyPayloadCompression, compression)
+}
+
+// GetPayloadCompression returns compression of bulk auxiliary checkpoint data, none if it was never set
+func (k *Keeper) GetPayloadCompression(ctx sdk.Context) string {
+	compression := ""
+	k.paramSpace.GetIfExists(ctx, types.KeyPayloadCompression, &compression)
+	if compression == "" {
+		return types.PayloadCompressionNone
+	}
+	return compression
+}
+
+// marshalPayload encodes bulk payload compressed as selected in params
+func (k *Keeper) marshalPayload(ctx sdk.Context, payload interface{}) ([]byte, error) {
+	bz, err := k.cdc.MarshalBinaryBare(payload)
+	if err != nil {
+		return nil, err
+	}
+	return types.CompressPayload(k.GetPayloadCompression(ctx), bz)
+}
+
+// unmarshalPayload decodes bulk payload, compressed or not
+func (k *Keeper) unmarshalPayload(stored []byte, payload interface{}) error {
+	bz, err := types.DecompressPayload(stored)
+	if err != nil {
+		return err
+	}
+	return k.cdc.UnmarshalBinaryBare(bz, payload)
+}
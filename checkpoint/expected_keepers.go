@@ -0,0 +1,24 @@
+package checkpoint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SupplyKeeper defines the supply Keeper for checkpoint module account
+type SupplyKeeper interface {
+	GetModuleAddress(name string) hmTypes.HeimdallAddress
+	GetModuleAccount(ctx sdk.Context, name string) supplyTypes.ModuleAccountInterface
+	SetModuleAccount(sdk.Context, supplyTypes.ModuleAccountInterface)
+
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr hmTypes.HeimdallAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr hmTypes.HeimdallAddress, recipientModule string, amt sdk.Coins) sdk.Error
+}
+
+// LivenessKeeper defines the liveness Keeper used to skip offline validators as proposers
+type LivenessKeeper interface {
+	IsLive(ctx sdk.Context, id hmTypes.ValidatorID) bool
+}
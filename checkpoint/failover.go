@@ -0,0 +1,178 @@
+package checkpoint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func getBufferExpiryKey(rootID byte) []byte {
+	return append(append([]byte{}, BufferExpiryKey...), rootID)
+}
+
+// SetCheckpointFailover sets checkpoint submission failover to backup proposers
+func (k *Keeper) SetCheckpointFailover(ctx sdk.Context, failover types.CheckpointFailover) {
+	k.paramSpace.Set(ctx, types.KeyCheckpointFailover, failover)
+}
+
+// GetCheckpointFailover gets checkpoint submission failover, disabled if it was never set
+func (k *Keeper) GetCheckpointFailover(ctx sdk.Context) (failover types.CheckpointFailover) {
+	k.paramSpace.GetIfExists(ctx, types.KeyCheckpointFailover, &failover)
+	return failover
+}
+
+// SetBufferExpiry records checkpoint of root chain flushed from buffer without ack
+func (k *Keeper) SetBufferExpiry(ctx sdk.Context, expiry types.BufferExpiry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getBufferExpiryKey(hmTypes.GetRootChainID(expiry.RootChain)), k.cdc.MustMarshalBinaryBare(expiry))
+}
+
+// GetBufferExpiry returns last checkpoint of root chain flushed from buffer without ack
+func (k *Keeper) GetBufferExpiry(ctx sdk.Context, rootChain string) (*types.BufferExpiry, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(getBufferExpiryKey(hmTypes.GetRootChainID(rootChain)))
+	if bz == nil {
+		return nil, false
+	}
+
+	var expiry types.BufferExpiry
+	if err := k.cdc.UnmarshalBinaryBare(bz, &expiry); err != nil {
+		k.Logger(ctx).Error("Unable to unmarshal buffer expiry", "root", rootChain, "error", err)
+		return nil, false
+	}
+
+	return &expiry, true
+}
+
+// FlushBufferExpiry removes expiry record of root chain, failover ends with it
+func (k *Keeper) FlushBufferExpiry(ctx sdk.Context, rootChain string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getBufferExpiryKey(hmTypes.GetRootChainID(rootChain)))
+}
+
+// FlushBufferExpiries removes expiry records of all root chains
+func (k *Keeper) FlushBufferExpiries(ctx sdk.Context) {
+	for rootChain := range hmTypes.GetRootChainIDMap() {
+		k.FlushBufferExpiry(ctx, rootChain)
+	}
+}
+
+// ExpireCheckpointBuffer flushes expired buffer of root chain. Expiry of buffered checkpoint is
+// recorded while failover is enabled, so backup proposers may submit same range again.
+func (k *Keeper) ExpireCheckpointBuffer(ctx sdk.Context, rootChain string, buffer hmTypes.Checkpoint) {
+	k.FlushCheckpointBuffer(ctx, rootChain)
+
+	if buffer.TimeStamp == 0 || !k.GetCheckpointFailover(ctx).Enabled() {
+		return
+	}
+
+	k.SetBufferExpiry(ctx, types.BufferExpiry{
+		RootChain:  rootChain,
+		StartBlock: buffer.StartBlock,
+		EndBlock:   buffer.EndBlock,
+		Proposer:   buffer.Proposer,
+		ExpiredAt:  buffer.TimeStamp + uint64(k.GetParams(ctx).CheckpointBufferTime.Seconds()),
+	})
+}
+
+// GetActiveBufferExpiry returns expiry record of root chain if checkpoint starting at start block
+// resubmits expired range. Other ranges are not covered by failover.
+func (k *Keeper) GetActiveBufferExpiry(ctx sdk.Context, rootChain string, startBlock uint64) (*types.BufferExpiry, bool) {
+	if !k.GetCheckpointFailover(ctx).Enabled() {
+		return nil, false
+	}
+
+	expiry, ok := k.GetBufferExpiry(ctx, rootChain)
+	if !ok || expiry.StartBlock != startBlock {
+		return nil, false
+	}
+
+	return expiry, true
+}
+
+// GetBackupProposers returns next live proposers in rotation after current proposer, up to number of
+// backup proposers. Backup at position N may submit expired checkpoint within N-th window after expiry.
+func (k *Keeper) GetBackupProposers(ctx sdk.Context, expiry types.BufferExpiry) (backups []types.BackupProposer) {
+	failover := k.GetCheckpointFailover(ctx)
+	if !failover.Enabled() {
+		return backups
+	}
+
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	if validatorSet.Proposer == nil {
+		return backups
+	}
+
+	// follow proposer rotation on a copy
+	vs := validatorSet.Copy()
+	seen := map[hmTypes.ValidatorID]bool{validatorSet.Proposer.ID: true}
+	window := uint64(failover.Window.Seconds())
+
+	for i := 0; i < len(vs.Validators) && uint64(len(backups)) < failover.BackupProposers; i++ {
+		vs.IncrementProposerPriority(1)
+		proposer := vs.GetProposer()
+		if proposer == nil || seen[proposer.ID] {
+			continue
+		}
+		seen[proposer.ID] = true
+
+		// offline validators are not backups
+		if !k.lk.IsLive(ctx, proposer.ID) {
+			continue
+		}
+
+		position := uint64(len(backups)) + 1
+		windowStart := expiry.ExpiredAt + (position-1)*window
+		backups = append(backups, types.BackupProposer{
+			Validator:   *proposer.Copy(),
+			Position:    position,
+			WindowStart: windowStart,
+			WindowEnd:   windowStart + window,
+		})
+	}
+
+	return backups
+}
+
+// GetBackupProposer returns backup entry for signer and whether its window is open at block time
+func (k *Keeper) GetBackupProposer(ctx sdk.Context, expiry types.BufferExpiry, signer hmTypes.HeimdallAddress) (types.BackupProposer, bool) {
+	now := uint64(ctx.BlockTime().Unix())
+	for _, backup := range k.GetBackupProposers(ctx, expiry) {
+		if backup.Validator.Signer.Equals(signer) {
+			return backup, backup.InWindow(now)
+		}
+	}
+	return types.BackupProposer{}, false
+}
+
+// GetFailoverStatus returns expired checkpoint of root chain along with its backup proposers
+func (k *Keeper) GetFailoverStatus(ctx sdk.Context, rootChain string) types.FailoverStatus {
+	status := types.FailoverStatus{Backups: []types.BackupProposer{}}
+
+	expiry, ok := k.GetBufferExpiry(ctx, rootChain)
+	if !ok && k.GetCheckpointFailover(ctx).Enabled() {
+		// buffer is flushed by next checkpoint tx only, report expiry it is going to record
+		if buffer, err := k.GetCheckpointFromBuffer(ctx, rootChain); err == nil {
+			expiredAt := buffer.TimeStamp + uint64(k.GetParams(ctx).CheckpointBufferTime.Seconds())
+			if uint64(ctx.BlockTime().Unix()) >= expiredAt {
+				expiry, ok = &types.BufferExpiry{
+					RootChain:  rootChain,
+					StartBlock: buffer.StartBlock,
+					EndBlock:   buffer.EndBlock,
+					Proposer:   buffer.Proposer,
+					ExpiredAt:  expiredAt,
+				}, true
+			}
+		}
+	}
+
+	if ok {
+		status.Expiry = expiry
+		if backups := k.GetBackupProposers(ctx, *expiry); len(backups) > 0 {
+			status.Backups = backups
+		}
+	}
+
+	return status
+}
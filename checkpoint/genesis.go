@@ -2,6 +2,7 @@ package checkpoint
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -13,11 +14,50 @@ import (
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 
+	if data.BufferDepth > 0 {
+		keeper.SetCheckpointBufferDepth(ctx, data.BufferDepth)
+	}
+
+	if data.Aggregation != nil {
+		keeper.SetCheckpointAggregation(ctx, *data.Aggregation)
+	}
+
+	if data.Failover != nil {
+		keeper.SetCheckpointFailover(ctx, *data.Failover)
+	}
+
+	if data.BlsAggregation {
+		keeper.SetBlsAggregation(ctx, true)
+	}
+
+	if data.AckGracePeriod > 0 {
+		keeper.SetAckGracePeriod(ctx, data.AckGracePeriod)
+	}
+
+	if data.SignerReward != nil && data.SignerReward.IsPositive() {
+		keeper.SetSignerReward(ctx, *data.SignerReward)
+	}
+
+	if len(data.RootHashAlgorithms) > 0 {
+		keeper.SetRootHashAlgorithms(ctx, data.RootHashAlgorithms)
+	}
+
+	if data.PayloadCompression != "" {
+		keeper.SetPayloadCompression(ctx, data.PayloadCompression)
+	}
+
+	// new chains precompute account root hash from genesis
+	keeper.enableAccountRootPrecompute(ctx)
+
 	// Set last no-ack
 	if data.LastNoACK > 0 {
 		keeper.SetLastNoAck(ctx, data.LastNoACK)
 	}
 
+	if data.NoACKCount > 0 {
+		keeper.SetNoAckCount(ctx, data.NoACKCount)
+	}
+
 	// Add finalised checkpoints to state
 	if len(data.Checkpoints) != 0 {
 		// check if we are provided all the headers
@@ -62,6 +102,26 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		}
 	}
 	keeper.UpdateACKCountWithValue(ctx, data.TronAckCount, hmTypes.RootChainTypeTron)
+
+	// check if the deposits account exists
+	moduleAcc := keeper.GetCheckpointAccount(ctx)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	var totalDeposits sdk.Coins
+	for _, deposit := range data.Deposits {
+		keeper.setProposerDeposit(ctx, deposit)
+		totalDeposits = totalDeposits.Add(deposit.Amount)
+	}
+
+	// add coins if not provided on genesis
+	if moduleAcc.GetCoins().IsZero() {
+		if err := moduleAcc.SetCoins(totalDeposits); err != nil {
+			panic(err)
+		}
+		keeper.supplyKeeper.SetModuleAccount(ctx, moduleAcc)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -69,7 +129,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeEth)
-	return types.NewGenesisState(
+	genesis := types.NewGenesisState(
 		params,
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
@@ -77,5 +137,29 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		hmTypes.SortHeaders(keeper.GetCheckpoints(ctx)),
 		keeper.GetACKCount(ctx, hmTypes.RootChainTypeTron),
 		hmTypes.SortHeaders(keeper.GetOtherCheckpoints(ctx, hmTypes.RootChainTypeTron)),
+		keeper.GetProposerDeposits(ctx),
 	)
+	genesis.BufferDepth = keeper.GetCheckpointBufferDepth(ctx)
+	genesis.NoACKCount = keeper.GetNoAckCount(ctx)
+	genesis.BlsAggregation = keeper.GetBlsAggregation(ctx)
+	genesis.AckGracePeriod = keeper.GetAckGracePeriod(ctx)
+	genesis.RootHashAlgorithms = keeper.GetRootHashAlgorithms(ctx)
+
+	if compression := keeper.GetPayloadCompression(ctx); compression != types.PayloadCompressionNone {
+		genesis.PayloadCompression = compression
+	}
+
+	if reward := keeper.GetSignerReward(ctx); reward.IsPositive() {
+		genesis.SignerReward = &reward
+	}
+
+	if aggregation := keeper.GetCheckpointAggregation(ctx); aggregation.Enabled() {
+		genesis.Aggregation = &aggregation
+	}
+
+	if failover := keeper.GetCheckpointFailover(ctx); failover.Enabled() {
+		genesis.Failover = &failover
+	}
+
+	return genesis
 }
@@ -71,6 +71,7 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 		checkpoints,
 		uint64(ackCount),
 		checkpoints,
+		nil,
 	)
 
 	checkpoint.InitGenesis(ctx, app.CheckpointKeeper, genesisState)
@@ -24,10 +24,16 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 			return handleMsgCheckpointAck(ctx, msg, k, contractCaller)
 		case types.MsgCheckpointNoAck:
 			return handleMsgCheckpointNoAck(ctx, msg, k)
+		case types.MsgCheckpointAdjust:
+			return handleMsgCheckpointAdjust(ctx, msg, k)
 		case types.MsgCheckpointSync:
 			return handleMsgCheckpointSync(ctx, msg, k)
 		case types.MsgCheckpointSyncAck:
 			return handleMsgCheckpointSyncAck(ctx, msg, k)
+		case types.MsgCheckpointBlsVote:
+			return handleMsgCheckpointBlsVote(ctx, msg, k)
+		case types.MsgCheckpointAttestation:
+			return handleMsgCheckpointAttestation(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in checkpoint module").Result()
 		}
@@ -51,10 +57,10 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 
 		if checkpointBuffer.TimeStamp == 0 || ((timeStamp > checkpointBuffer.TimeStamp) && timeStamp-checkpointBuffer.TimeStamp >= checkpointBufferTime) {
 			logger.Debug("Checkpoint has been timed out. Flushing buffer.", "root", msg.RootChainType, "checkpointTimestamp", timeStamp, "prevCheckpointTimestamp", checkpointBuffer.TimeStamp)
-			k.FlushCheckpointBuffer(ctx, msg.RootChainType)
-		} else {
+			k.ExpireCheckpointBuffer(ctx, msg.RootChainType, *checkpointBuffer)
+		} else if bufferQueue := k.GetCheckpointBufferQueue(ctx, msg.RootChainType); bufferQueue.IsFull() {
 			expiryTime := checkpointBuffer.TimeStamp + checkpointBufferTime
-			logger.Error("Checkpoint already exits in buffer", "root", msg.RootChainType, "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
+			logger.Error("Checkpoint already exits in buffer", "root", msg.RootChainType, "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime, "depth", bufferQueue.Depth)
 			return common.ErrNoACK(k.Codespace(), expiryTime).Result()
 		}
 	}
@@ -62,34 +68,8 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	//
 	// Validate last checkpoint
 	//
-	lastCheckpoint, err := k.GetLastCheckpoint(ctx, msg.RootChainType)
-
-	// fetch last checkpoint from store
-	if err == nil {
-		// make sure new checkpoint is after tip
-		if lastCheckpoint.EndBlock > msg.StartBlock {
-			logger.Error("Checkpoint already exists",
-				"currentTip", lastCheckpoint.EndBlock,
-				"startBlock", msg.StartBlock,
-				"root", msg.RootChainType,
-			)
-			return common.ErrOldCheckpoint(k.Codespace()).Result()
-		}
-
-		// check if new checkpoint's start block start from current tip
-		if lastCheckpoint.EndBlock+1 != msg.StartBlock {
-			logger.Error("Checkpoint not in countinuity",
-				"currentTip", lastCheckpoint.EndBlock,
-				"startBlock", msg.StartBlock, "root", msg.RootChainType)
-			return common.ErrDisCountinuousCheckpoint(k.Codespace()).Result()
-		}
-	} else if err.Error() == common.ErrNoCheckpointFound(k.Codespace()).Error() {
-		activation := k.ck.GetChainActivationHeight(ctx, msg.RootChainType)
-		if activation != msg.StartBlock {
-			logger.Error("First checkpoint to start from block active height",
-				"activation", activation, "start", msg.StartBlock, "root", msg.RootChainType)
-			return common.ErrBadBlockDetails(k.Codespace()).Result()
-		}
+	if err := k.validateCheckpointTip(ctx, msg); err != nil {
+		return err.Result()
 	}
 
 	//
@@ -97,12 +77,8 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	//
 
 	// Make sure latest AccountRootHash matches
-	// Calculate new account root hash
-	dividendAccounts := k.moduleCommunicator.GetAllDividendAccounts(ctx)
-	logger.Debug("DividendAccounts of all validators", "dividendAccountsLength", len(dividendAccounts))
-
-	// Get account root has from dividend accounts
-	accountRoot, err := types.GetAccountRootHash(dividendAccounts)
+	// Get account root hash precomputed at end of last block
+	accountRoot, err := k.GetAccountRootHash(ctx)
 	if err != nil {
 		logger.Error("Error while fetching account root hash", "error", err)
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
@@ -122,21 +98,8 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	//
 	// Validate proposer
 	//
-
-	// Check proposer in message
-	validatorSet := k.sk.GetValidatorSet(ctx)
-	if validatorSet.Proposer == nil {
-		logger.Error("No proposer in validator set", "msgProposer", msg.Proposer.String())
-		return common.ErrInvalidMsg(k.Codespace(), "No proposer in stored validator set").Result()
-	}
-
-	if !bytes.Equal(msg.Proposer.Bytes(), validatorSet.Proposer.Signer.Bytes()) {
-		logger.Error(
-			"Invalid proposer in msg",
-			"proposer", validatorSet.Proposer.Signer.String(),
-			"msgProposer", msg.Proposer.String(),
-		)
-		return common.ErrInvalidMsg(k.Codespace(), "Invalid proposer in msg").Result()
+	if err := k.validateCheckpointProposer(ctx, msg); err != nil {
+		return err.Result()
 	}
 
 	//
@@ -148,6 +111,11 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 		return common.ErrInvalidMsg(k.Codespace(), "No proposer in stored validator set").Result()
 	}
 
+	// keep dividend accounts of account root, validators prove their fees against checkpoint with them
+	if err := k.SnapshotAccountRoot(ctx, accountRoot); err != nil {
+		logger.Error("Error while recording dividend accounts of account root", "error", err)
+	}
+
 	// Emit event for checkpoint
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -177,6 +145,13 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 		"start", msg.StartBlock,
 		"end", msg.EndBlock,
 	)
+
+	// ack of same checkpoint might be submitted by several validators
+	if k.IsCheckpointAcked(ctx, msg.Number, msg.RootChainType) {
+		logger.Debug("Checkpoint is already acked", "root", msg.RootChainType, "number", msg.Number)
+		return common.ErrAckAlreadySubmitted(k.Codespace(), msg.RootChainType, msg.Number).Result()
+	}
+
 	headerBlock, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
 
 	if err == nil {
@@ -197,6 +172,14 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 			)
 			return common.ErrBadAck(k.Codespace()).Result()
 		}
+
+		if err := validateAckSender(ctx, k, msg, *headerBlock); err != nil {
+			logger.Error("Sender may not ack checkpoint yet", "root", msg.RootChainType, "from", msg.From, "error", err)
+			return err.Result()
+		}
+
+		// first ack of next checkpoint marks its submission on root chain
+		k.RecordAckSubmission(ctx, msg.RootChainType, msg.Number)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -245,14 +228,18 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	// Set new last no-ack
 	newLastNoAck := uint64(currentTime.Unix())
 	k.SetLastNoAck(ctx, newLastNoAck)
+	k.SetNoAckCount(ctx, k.GetNoAckCount(ctx)+1)
+
+	// new proposer takes over expired ranges
+	k.FlushBufferExpiries(ctx)
 	logger.Debug("Last No-ACK time set", "lastNoAck", newLastNoAck)
 
 	//
 	// Update to new proposer
 	//
 
-	// Increment accum (selects new proposer)
-	k.sk.IncrementAccum(ctx, 1)
+	// Increment accum (selects new live proposer)
+	k.RotateProposer(ctx)
 
 	// Get new proposer
 	vs := k.sk.GetValidatorSet(ctx)
@@ -278,6 +265,65 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	}
 }
 
+// handleMsgCheckpointAdjust Validates adjustment of stored checkpoint
+func handleMsgCheckpointAdjust(ctx sdk.Context, msg types.MsgCheckpointAdjust, k Keeper) sdk.Result {
+	logger := k.Logger(ctx)
+
+	logger.Debug("✅ Validating checkpoint adjust",
+		"root", msg.RootChainType,
+		"number", msg.Number,
+		"start", msg.StartBlock,
+		"end", msg.EndBlock,
+	)
+
+	// only validators can adjust checkpoints
+	if !k.sk.IsCurrentValidatorByAddress(ctx, msg.From.Bytes()) {
+		logger.Error("Checkpoint adjust from non-validator", "from", msg.From.String())
+		return common.ErrInvalidMsg(k.Codespace(), "Checkpoint adjust from non-validator %v", msg.From.String()).Result()
+	}
+
+	if err := validateCheckpointAdjust(ctx, k, msg); err != nil {
+		logger.Error("Invalid checkpoint adjust", "error", err, "root", msg.RootChainType, "number", msg.Number)
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCheckpointAdjust,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
+			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// validateCheckpointAdjust checks that msg adjusts latest stored checkpoint and changes it
+func validateCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAdjust) sdk.Error {
+	// only latest checkpoint can be adjusted, keeps continuity with next checkpoints
+	if msg.Number != k.GetACKCount(ctx, msg.RootChainType) {
+		return common.ErrInvalidMsg(k.Codespace(), "Only latest checkpoint can be adjusted")
+	}
+
+	checkpoint, err := k.GetCheckpointByNumber(ctx, msg.Number, msg.RootChainType)
+	if err != nil {
+		return common.ErrNoCheckpointFound(k.Codespace())
+	}
+
+	if checkpoint.StartBlock != msg.StartBlock {
+		return common.ErrBadBlockDetails(k.Codespace())
+	}
+
+	if checkpoint.EndBlock == msg.EndBlock && checkpoint.RootHash.Equals(msg.RootHash) && checkpoint.Proposer.Equals(msg.Proposer) {
+		return common.ErrInvalidMsg(k.Codespace(), "Checkpoint %v already matches", msg.Number)
+	}
+
+	return nil
+}
+
 // handleMsgCheckpointSync Validates if checkpoint sync submitted on chain is valid
 func handleMsgCheckpointSync(ctx sdk.Context, msg types.MsgCheckpointSync, k Keeper) sdk.Result {
 	logger := k.Logger(ctx)
@@ -353,3 +399,118 @@ func handleMsgCheckpointSyncAck(ctx sdk.Context, msg types.MsgCheckpointSyncAck,
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// handleMsgCheckpointBlsVote Aggregates BLS signature of validator for buffered checkpoint
+func handleMsgCheckpointBlsVote(ctx sdk.Context, msg types.MsgCheckpointBlsVote, k Keeper) sdk.Result {
+	logger := k.Logger(ctx)
+
+	if !k.GetBlsAggregation(ctx) {
+		return common.ErrInvalidMsg(k.Codespace(), "BLS aggregation of checkpoints is disabled").Result()
+	}
+
+	if !k.sk.IsCurrentValidatorByAddress(ctx, msg.From.Bytes()) {
+		logger.Error("BLS vote from non-validator", "from", msg.From.String())
+		return common.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	validator, err := k.sk.GetValidatorInfo(ctx, msg.From.Bytes())
+	if err != nil {
+		return common.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	checkpoint, ok := k.getBufferedCheckpoint(ctx, msg.RootChainType, msg.StartBlock)
+	if !ok {
+		logger.Error("No buffered checkpoint for BLS vote", "root", msg.RootChainType, "startBlock", msg.StartBlock)
+		return common.ErrNoCheckpointBufferFound(k.Codespace()).Result()
+	}
+
+	if checkpoint.EndBlock != msg.EndBlock || !checkpoint.RootHash.Equals(msg.RootHash) {
+		logger.Error("BLS vote doesn't match buffered checkpoint",
+			"root", msg.RootChainType,
+			"startBlock", msg.StartBlock,
+			"endBlock", msg.EndBlock,
+			"bufferedEndBlock", checkpoint.EndBlock,
+		)
+		return common.ErrBadBlockDetails(k.Codespace()).Result()
+	}
+
+	aggregate, err := k.AddBlsVote(ctx, msg.RootChainType, checkpoint, validator, msg.Signature)
+	if err != nil {
+		logger.Error("Unable to add BLS vote", "validator", validator.ID, "error", err)
+		return common.ErrInvalidBlsSignature(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCheckpointBlsVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyBlsSigners, strconv.Itoa(len(aggregate.Signers))),
+			sdk.NewAttribute(types.AttributeKeyBlsPower, strconv.FormatInt(aggregate.Power, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgCheckpointAttestation Stores block hash commitment of proposer of buffered checkpoint
+func handleMsgCheckpointAttestation(ctx sdk.Context, msg types.MsgCheckpointAttestation, k Keeper) sdk.Result {
+	logger := k.Logger(ctx)
+
+	checkpoint, ok := k.getBufferedCheckpoint(ctx, msg.RootChainType, msg.StartBlock)
+	if !ok {
+		logger.Error("No buffered checkpoint for attestation", "root", msg.RootChainType, "startBlock", msg.StartBlock)
+		return common.ErrNoCheckpointBufferFound(k.Codespace()).Result()
+	}
+
+	if checkpoint.EndBlock != msg.EndBlock || !checkpoint.RootHash.Equals(msg.RootHash) {
+		logger.Error("Attestation doesn't match buffered checkpoint",
+			"root", msg.RootChainType,
+			"startBlock", msg.StartBlock,
+			"endBlock", msg.EndBlock,
+			"bufferedEndBlock", checkpoint.EndBlock,
+		)
+		return common.ErrBadBlockDetails(k.Codespace()).Result()
+	}
+
+	// only proposer of checkpoint attests to blocks it covers
+	if !bytes.Equal(checkpoint.Proposer.Bytes(), msg.Proposer.Bytes()) {
+		logger.Error("Attestation sender is not checkpoint proposer", "proposer", checkpoint.Proposer.String(), "sender", msg.Proposer.String())
+		return common.ErrBadProposerDetails(k.Codespace(), checkpoint.Proposer).Result()
+	}
+
+	if attestation, ok := k.GetCheckpointAttestation(ctx, msg.RootChainType, msg.StartBlock); ok && attestation.Matches(checkpoint) {
+		logger.Error("Checkpoint is already attested", "root", msg.RootChainType, "startBlock", msg.StartBlock)
+		return common.ErrInvalidMsg(k.Codespace(), "Checkpoint is already attested").Result()
+	}
+
+	k.SetCheckpointAttestation(ctx, types.CheckpointAttestation{
+		RootChain:       msg.RootChainType,
+		StartBlock:      msg.StartBlock,
+		EndBlock:        msg.EndBlock,
+		RootHash:        msg.RootHash,
+		Proposer:        msg.Proposer,
+		BlockHashesRoot: msg.BlockHashesRoot,
+		BlobReference:   msg.BlobReference,
+		AttestedAt:      ctx.BlockTime().Unix(),
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCheckpointAttest,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyBlockHashesRoot, msg.BlockHashesRoot.String()),
+			sdk.NewAttribute(types.AttributeKeyBlobReference, msg.BlobReference),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
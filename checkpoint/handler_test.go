@@ -14,7 +14,9 @@ import (
 	"github.com/maticnetwork/heimdall/checkpoint"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -233,6 +235,163 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointExistInBuffer() {
 	require.True(t, !got.IsOK(), errs.CodeToDefaultMsg(got.Code))
 }
 
+func (suite *HandlerTestSuite) TestCheckpointTxFilter() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	params := keeper.GetParams(ctx)
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+	header.Proposer = stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+
+	newMsg := func(proposer hmTypes.HeimdallAddress, start uint64) types.MsgCheckpoint {
+		return types.NewMsgCheckpointBlock(proposer, start, start+header.EndBlock, header.RootHash, header.RootHash, "1234", 1, hmTypes.RootChainTypeStake)
+	}
+	checkCtx := ctx.WithIsCheckTx(true).WithBlockTime(time.Unix(1050, 0))
+
+	suite.Run("Allowed", func() {
+		require.Nil(t, app.TxFilter(checkCtx, newMsg(header.Proposer, 0)))
+	})
+
+	suite.Run("Invalid proposer", func() {
+		require.NotNil(t, app.TxFilter(checkCtx, newMsg(hmTypes.HexToHeimdallAddress("1234"), 0)))
+
+		// deliver tx leaves checkpoint to handler
+		require.Nil(t, app.TxFilter(ctx, newMsg(hmTypes.HexToHeimdallAddress("1234"), 0)))
+	})
+
+	suite.Run("Not in continuity", func() {
+		require.NotNil(t, app.TxFilter(checkCtx, newMsg(header.Proposer, 1)))
+	})
+
+	suite.Run("Buffer full", func() {
+		header.TimeStamp = 1000
+		require.NoError(t, keeper.SetCheckpointBuffer(ctx, header, hmTypes.RootChainTypeStake))
+		require.NotNil(t, app.TxFilter(checkCtx, newMsg(header.Proposer, header.EndBlock+1)))
+	})
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointBlsVote() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	params := keeper.GetParams(ctx)
+	rootChain := hmTypes.RootChainTypeStake
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	validators := stakingKeeper.GetCurrentValidators(ctx)
+
+	sks := make([]*big.Int, len(validators))
+	for i, validator := range validators {
+		sks[i] = helper.BlsPrivKeyFromSeed(validator.Signer.Bytes())
+		err := stakingKeeper.SetValidatorBlsKey(ctx, stakingTypes.NewValidatorBlsKey(validator.ID, helper.BlsPubKey(sks[i]), 0))
+		require.NoError(t, err)
+	}
+
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+	header.Proposer = validators[0].Signer
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, header, rootChain))
+
+	signBytes := types.CheckpointBlsSignBytes(rootChain, header)
+	vote := func(i int, sig []byte) sdk.Result {
+		msg := types.NewMsgCheckpointBlsVote(validators[i].Signer, header.StartBlock, header.EndBlock, header.RootHash, rootChain, sig)
+		return suite.handler(ctx, msg)
+	}
+
+	suite.Run("Disabled", func() {
+		got := vote(0, helper.BlsSign(sks[0], signBytes))
+		require.False(t, got.IsOK(), "expected BLS vote to fail while aggregation is disabled")
+	})
+
+	keeper.SetBlsAggregation(ctx, true)
+
+	suite.Run("Invalid signature", func() {
+		got := vote(0, helper.BlsSign(sks[1], signBytes))
+		require.False(t, got.IsOK(), "expected BLS vote with other key to fail")
+		require.Equal(t, errs.CodeInvalidBlsSignature, got.Code)
+	})
+
+	suite.Run("Success", func() {
+		for i := range validators {
+			got := vote(i, helper.BlsSign(sks[i], signBytes))
+			require.True(t, got.IsOK(), "expected BLS vote to be ok, got %v", got)
+		}
+
+		res, err := keeper.GetCheckpointBlsSignature(ctx, rootChain, 0)
+		require.NoError(t, err)
+		require.Len(t, res.Aggregate.Signers, len(validators))
+		require.Equal(t, res.TotalPower, res.Aggregate.Power)
+		require.True(t, helper.BlsVerify(res.Aggregate.PubKey, res.SignBytes, res.Aggregate.Signature))
+	})
+
+	suite.Run("Duplicate vote", func() {
+		got := vote(0, helper.BlsSign(sks[0], signBytes))
+		require.False(t, got.IsOK(), "expected duplicate BLS vote to fail")
+		require.Equal(t, errs.CodeInvalidBlsSignature, got.Code)
+	})
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointAttestation() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	params := keeper.GetParams(ctx)
+	rootChain := hmTypes.RootChainTypeStake
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	validators := stakingKeeper.GetCurrentValidators(ctx)
+
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+	header.Proposer = validators[0].Signer
+
+	blockHashesRoot := hmTypes.HexToHeimdallHash("0x1234")
+	attest := func(proposer hmTypes.HeimdallAddress, endBlock uint64) sdk.Result {
+		msg := types.NewMsgCheckpointAttestation(proposer, header.StartBlock, endBlock, header.RootHash, rootChain, blockHashesRoot, "ipfs://blockhashes")
+		return suite.handler(ctx, msg)
+	}
+
+	suite.Run("No buffered checkpoint", func() {
+		got := attest(header.Proposer, header.EndBlock)
+		require.False(t, got.IsOK(), "expected attestation without buffered checkpoint to fail")
+	})
+
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, header, rootChain))
+
+	suite.Run("Not proposer", func() {
+		got := attest(validators[1].Signer, header.EndBlock)
+		require.False(t, got.IsOK(), "expected attestation of other validator to fail")
+		require.Equal(t, errs.CodeInvalidProposerInput, got.Code)
+	})
+
+	suite.Run("Block mismatch", func() {
+		got := attest(header.Proposer, header.EndBlock+1)
+		require.False(t, got.IsOK(), "expected attestation of other range to fail")
+		require.Equal(t, errs.CodeInvalidBlockInput, got.Code)
+	})
+
+	suite.Run("Success", func() {
+		got := attest(header.Proposer, header.EndBlock)
+		require.True(t, got.IsOK(), "expected attestation to be ok, got %v", got)
+
+		res, err := keeper.GetCheckpointAttestationByNumber(ctx, rootChain, 0)
+		require.NoError(t, err)
+		require.Equal(t, blockHashesRoot, res.BlockHashesRoot)
+		require.Equal(t, "ipfs://blockhashes", res.BlobReference)
+		require.True(t, res.Matches(header))
+	})
+
+	suite.Run("Duplicate", func() {
+		got := attest(header.Proposer, header.EndBlock)
+		require.False(t, got.IsOK(), "expected duplicate attestation to fail")
+	})
+}
+
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.CheckpointKeeper
@@ -323,6 +482,70 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 	})
 }
 
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointAckGracePeriod() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	params := keeper.GetParams(ctx)
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+	validatorSet := stakingKeeper.GetValidatorSet(ctx)
+	proposer := validatorSet.Proposer.Signer
+
+	var validator hmTypes.HeimdallAddress
+	for _, v := range validatorSet.Validators {
+		if !v.Signer.Equals(proposer) {
+			validator = v.Signer
+			break
+		}
+	}
+	require.False(t, validator.Empty())
+
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+	header.Proposer = proposer
+	header.TimeStamp = 1000
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, header, hmTypes.RootChainTypeStake))
+
+	keeper.SetAckGracePeriod(ctx, 100*time.Second)
+	require.Equal(t, 100*time.Second, keeper.GetAckGracePeriod(ctx))
+
+	newAck := func(from hmTypes.HeimdallAddress) types.MsgCheckpointAck {
+		return types.NewMsgCheckpointAck(
+			from,
+			1,
+			proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			hmTypes.HexToHeimdallHash("123123"),
+			uint64(1),
+			hmTypes.RootChainTypeStake,
+		)
+	}
+
+	inGrace := ctx.WithBlockTime(time.Unix(1050, 0))
+	afterGrace := ctx.WithBlockTime(time.Unix(1100, 0))
+
+	result := suite.handler(inGrace, newAck(proposer))
+	require.True(t, result.IsOK(), "proposer should ack within grace period, got %v", result)
+
+	result = suite.handler(inGrace, newAck(validator))
+	require.Equal(t, errs.CodeAckGracePeriod, result.Code)
+
+	result = suite.handler(afterGrace, newAck(validator))
+	require.True(t, result.IsOK(), "validator should ack after grace period, got %v", result)
+
+	result = suite.handler(afterGrace, newAck(hmTypes.HexToHeimdallAddress("123")))
+	require.Equal(t, errs.CodeNoValidator, result.Code)
+
+	// acked checkpoint
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, header, hmTypes.RootChainTypeStake))
+	result = suite.handler(afterGrace, newAck(proposer))
+	require.Equal(t, errs.CodeAckAlreadySubmitted, result.Code)
+}
+
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAck() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.CheckpointKeeper
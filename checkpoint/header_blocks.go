@@ -0,0 +1,131 @@
+package checkpoint
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// headerBlockRefreshInterval interval current header blocks of root chain contracts are refreshed at
+	headerBlockRefreshInterval = 5 * time.Second
+
+	// headerBlockMaxAge age after which refreshed header block isn't used anymore
+	headerBlockMaxAge = 30 * time.Second
+)
+
+// headerBlock current header block of root chain contract, time it was read at and height of check state
+// verifier it was read with was built at
+type headerBlock struct {
+	number    uint64
+	updatedAt time.Time
+	height    int64
+}
+
+// trackedVerifier verifier of root chain and height of check state it was built at
+type trackedVerifier struct {
+	verifier RootChainVerifier
+	height   int64
+}
+
+// headerBlockTracker keeps current header blocks of root chain contracts, refreshed by background loop.
+// CheckTx runs under ABCI client lock, so it only reads header blocks tracked here and never calls root
+// chain itself. Root chains are tracked once CheckTx asked for them, with verifier it built. Header blocks
+// are versioned by check state height of their verifier, so once block is committed ones read with
+// verifier of previous state aren't used until they're read again with verifier of new state.
+type headerBlockTracker struct {
+	mu           sync.RWMutex
+	verifiers    map[string]trackedVerifier
+	headerBlocks map[string]headerBlock
+
+	refreshing sync.Mutex
+	start      sync.Once
+	refresh    chan struct{}
+	logger     log.Logger
+}
+
+func newHeaderBlockTracker() *headerBlockTracker {
+	return &headerBlockTracker{
+		verifiers:    make(map[string]trackedVerifier),
+		headerBlocks: make(map[string]headerBlock),
+		refresh:      make(chan struct{}, 1),
+	}
+}
+
+// get returns current header block of root chain read with verifier of check state at height, false if
+// it wasn't read yet or is too old
+func (t *headerBlockTracker) get(rootChain string, height int64, now time.Time) (uint64, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	headerBlock, ok := t.headerBlocks[rootChain]
+	if !ok || headerBlock.height != height || now.Sub(headerBlock.updatedAt) > headerBlockMaxAge {
+		return 0, false
+	}
+	return headerBlock.number, true
+}
+
+// track sets verifier of check state at height current header block of root chain is read with and starts
+// refresh loop. Verifier of newer state replaces tracked one.
+func (t *headerBlockTracker) track(rootChain string, verifier RootChainVerifier, height int64, logger log.Logger) {
+	t.mu.Lock()
+	if tracked, ok := t.verifiers[rootChain]; !ok || height > tracked.height {
+		t.verifiers[rootChain] = trackedVerifier{verifier: verifier, height: height}
+	}
+	t.mu.Unlock()
+
+	t.start.Do(func() {
+		t.logger = logger
+		go t.loop()
+	})
+}
+
+// requestRefresh asks refresh loop to refresh header blocks right away, it never blocks
+func (t *headerBlockTracker) requestRefresh() {
+	select {
+	case t.refresh <- struct{}{}:
+	default:
+	}
+}
+
+func (t *headerBlockTracker) loop() {
+	ticker := time.NewTicker(headerBlockRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-t.refresh:
+		}
+
+		t.refreshAll()
+	}
+}
+
+// refreshAll reads current header blocks of all tracked root chains, calls are made without lock held
+func (t *headerBlockTracker) refreshAll() {
+	t.refreshing.Lock()
+	defer t.refreshing.Unlock()
+
+	t.mu.RLock()
+	verifiers := make(map[string]trackedVerifier, len(t.verifiers))
+	for rootChain, tracked := range t.verifiers {
+		verifiers[rootChain] = tracked
+	}
+	t.mu.RUnlock()
+
+	for rootChain, tracked := range verifiers {
+		number, err := tracked.verifier.CurrentHeaderBlock()
+		if err != nil {
+			if t.logger != nil {
+				t.logger.Debug("Unable to refresh current header block", "root", rootChain, "error", err)
+			}
+			continue
+		}
+
+		t.mu.Lock()
+		t.headerBlocks[rootChain] = headerBlock{number: number, updatedAt: time.Now(), height: tracked.height}
+		t.mu.Unlock()
+	}
+}
@@ -27,7 +27,7 @@ func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, context.CLICo
 
 	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
 
-	params := types.NewParams(5*time.Second, 256, 1024, 10000)
+	params := types.NewParams(5*time.Second, 256, 1024, types.DefaultStandbyProposerCount, types.DefaultProposerGraceWindow)
 
 	Checkpoints := make([]hmTypes.Checkpoint, 0)
 
@@ -43,6 +43,7 @@ func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, context.CLICo
 		types.DefaultGenesisState().Checkpoints,
 		types.DefaultGenesisState().AckCount,
 		types.DefaultGenesisState().Checkpoints,
+		types.DefaultGenesisState().Deposits,
 	)
 
 	genesisState[types.ModuleName] = app.Codec().MustMarshalJSON(checkpointGenesis)
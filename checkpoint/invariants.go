@@ -0,0 +1,135 @@
+package checkpoint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// invariant root chains, in fixed order so broken invariant messages are deterministic
+var invariantRootChains = []string{hmTypes.RootChainTypeTron, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc}
+
+// RegisterInvariants registers all checkpoint invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "ack-count", AckCountInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "buffer", BufferInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the checkpoint module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountInvariant(keeper)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = AckCountInvariant(keeper)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return BufferInvariant(keeper)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the module account coins reflects the sum of
+// proposer deposits held on store
+func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedDeposits sdk.Coins
+
+		keeper.IterateProposerDeposits(ctx, func(deposit types.ProposerDeposit) bool {
+			expectedDeposits = expectedDeposits.Add(deposit.Amount)
+			return false
+		})
+
+		macc := keeper.GetCheckpointAccount(ctx)
+		broken := !macc.GetCoins().IsEqual(expectedDeposits)
+
+		return sdk.FormatInvariant(types.ModuleName, "deposits",
+			fmt.Sprintf("\tcheckpoint ModuleAccount coins: %s\n\tsum of proposer deposits:      %s\n",
+				macc.GetCoins(), expectedDeposits)), broken
+	}
+}
+
+// AckCountInvariant checks that ack count of every root chain equals number of stored
+// checkpoints, last checkpoint is stored under ack count and ack count never decreases
+// between checks, so checkpoint numbering can't silently diverge from contract
+func AckCountInvariant(keeper Keeper) sdk.Invariant {
+	lastAckCounts := make(map[string]uint64)
+
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, rootChain := range invariantRootChains {
+			ackCount := keeper.GetACKCount(ctx, rootChain)
+
+			if count := keeper.GetCheckpointCount(ctx, rootChain); count != ackCount {
+				broken = true
+				msg += fmt.Sprintf("\t%v ack count %d doesn't match %d stored checkpoints\n", rootChain, ackCount, count)
+			}
+
+			if ackCount > 0 {
+				if _, err := keeper.GetCheckpointByNumber(ctx, ackCount, rootChain); err != nil {
+					broken = true
+					msg += fmt.Sprintf("\t%v checkpoint %d of ack count is missing\n", rootChain, ackCount)
+				}
+			}
+
+			if last, ok := lastAckCounts[rootChain]; ok && ackCount < last {
+				broken = true
+				msg += fmt.Sprintf("\t%v ack count decreased from %d to %d\n", rootChain, last, ackCount)
+			}
+			lastAckCounts[rootChain] = ackCount
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "ack-count", msg), broken
+	}
+}
+
+// BufferInvariant checks that checkpoint buffer and checkpoint sync buffer of root chain don't
+// hold conflicting checkpoints: buffer always holds next checkpoint after last ack, checkpoints
+// queued behind it are continuous, and checkpoint sync buffer never holds checkpoint ahead of it
+func BufferInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, rootChain := range invariantRootChains {
+			buffer, err := keeper.GetCheckpointFromBuffer(ctx, rootChain)
+			if err != nil || buffer == nil {
+				continue
+			}
+
+			if last, err := keeper.GetLastCheckpoint(ctx, rootChain); err == nil && buffer.StartBlock <= last.EndBlock {
+				broken = true
+				msg += fmt.Sprintf("\t%v buffered checkpoint [%d, %d] overlaps last acked checkpoint [%d, %d]\n",
+					rootChain, buffer.StartBlock, buffer.EndBlock, last.StartBlock, last.EndBlock)
+			}
+
+			queue := keeper.GetCheckpointBufferQueue(ctx, rootChain)
+			for i := 1; i < len(queue.Checkpoints); i++ {
+				prev, cur := queue.Checkpoints[i-1], queue.Checkpoints[i]
+				if cur.StartBlock != prev.EndBlock+1 {
+					broken = true
+					msg += fmt.Sprintf("\t%v queued checkpoint [%d, %d] doesn't follow [%d, %d]\n",
+						rootChain, cur.StartBlock, cur.EndBlock, prev.StartBlock, prev.EndBlock)
+				}
+			}
+
+			syncBuffer, err := keeper.GetCheckpointSyncFromBuffer(ctx, rootChain)
+			if err == nil && syncBuffer != nil && syncBuffer.StartBlock > buffer.StartBlock {
+				broken = true
+				msg += fmt.Sprintf("\t%v checkpoint sync buffer [%d, %d] is ahead of checkpoint buffer [%d, %d]\n",
+					rootChain, syncBuffer.StartBlock, syncBuffer.EndBlock, buffer.StartBlock, buffer.EndBlock)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "buffer", msg), broken
+	}
+}
@@ -1,18 +1,25 @@
 package checkpoint
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
+	"fmt"
+	"math/big"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/staking"
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -25,15 +32,39 @@ var (
 	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
 	EthCheckpointKey    = []byte{0x13} // prefix key for when storing checkpoint after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+	AccountRootHashKey  = []byte{0x15} // key to store precomputed account root hash
+	AdjustmentKey       = []byte{0x16} // prefix key for checkpoint adjustment records
+	BufferQueueKey      = []byte{0x17} // prefix key for checkpoints queued behind checkpoint in buffer
+	AggregationVoteKey  = []byte{0x18} // prefix key for checkpoints submitted by aggregation proposers
+	NoACKCountKey       = []byte{0x19} // key to store number of accepted no-acks
+	BufferExpiryKey     = []byte{0x1a} // prefix key for checkpoints flushed from buffer without ack
+	BlsAggregateKey     = []byte{0x1b} // prefix key for aggregated BLS signatures of checkpoints
+	AttestationKey      = []byte{0x1c} // prefix key for block hash commitments attested by checkpoint proposers
+	SignersKey          = []byte{0x1d} // prefix key for signers of buffered checkpoints
+	AccountsKey         = []byte{0x1e} // key to store dividend accounts of precomputed account root hash
+	AccountSnapshotKey  = []byte{0x1f} // prefix key for dividend accounts by account root hash of checkpoints
 
 	TronCheckpointKey = []byte{0x21} // prefix key for when storing checkpoint after ACK
 	BscCheckpointKey  = []byte{0x22} // prefix key for when storing checkpoint after ACK
 
+	BorBlockIndexKey = []byte{0x31} // prefix key for bor start block -> checkpoint number index
+	AccountRootKey   = []byte{0x32} // prefix key for bor start block -> account root hash of checkpoint
+	TimingKey        = []byte{0x33} // prefix key for timing of checkpoint delivery
+	BufferHeightKey  = []byte{0x34} // prefix key for heimdall height checkpoint in buffer was buffered at
+
+	AccountRootPrecomputeKey = []byte{0x35} // key set once account root hash is precomputed at end of block
+
+	ProposerDepositKey = []byte{0x41} // prefix key for proposer -> deposit held by module account
+
 )
 
 // ModuleCommunicator manages different module interaction
 type ModuleCommunicator interface {
 	GetAllDividendAccounts(ctx sdk.Context) []hmTypes.DividendAccount
+	TrackDividendAccounts(ctx sdk.Context)
+	IsDividendAccountsDirty(ctx sdk.Context) bool
+	ClearDividendAccountsDirty(ctx sdk.Context)
+	AddFeeToDividendAccount(ctx sdk.Context, user hmTypes.HeimdallAddress, fee *big.Int) sdk.Error
 }
 
 // Keeper stores all related data
@@ -42,6 +73,10 @@ type Keeper struct {
 	// staking keeper
 	sk staking.Keeper
 	ck chainmanager.Keeper
+	// supply keeper
+	supplyKeeper SupplyKeeper
+	// liveness keeper
+	lk LivenessKeeper
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 	// codespace
@@ -51,6 +86,10 @@ type Keeper struct {
 
 	// module communicator
 	moduleCommunicator ModuleCommunicator
+	// stats of last queried version
+	statsCache *statsCache
+	// current header blocks of root chain contracts, read in background for CheckTx
+	headerBlocks *headerBlockTracker
 }
 
 // NewKeeper create new keeper
@@ -61,8 +100,15 @@ func NewKeeper(
 	codespace sdk.CodespaceType,
 	stakingKeeper staking.Keeper,
 	chainKeeper chainmanager.Keeper,
+	supplyKeeper SupplyKeeper,
+	livenessKeeper LivenessKeeper,
 	moduleCommunicator ModuleCommunicator,
 ) Keeper {
+	// ensure checkpoint module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr.Empty() {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
 	keeper := Keeper{
 		cdc:                cdc,
 		storeKey:           storeKey,
@@ -70,7 +116,11 @@ func NewKeeper(
 		codespace:          codespace,
 		sk:                 stakingKeeper,
 		ck:                 chainKeeper,
+		supplyKeeper:       supplyKeeper,
+		lk:                 livenessKeeper,
 		moduleCommunicator: moduleCommunicator,
+		statsCache:         &statsCache{},
+		headerBlocks:       newHeaderBlockTracker(),
 	}
 	return keeper
 }
@@ -92,6 +142,7 @@ func (k *Keeper) AddCheckpoint(ctx sdk.Context, checkpointNumber uint64, checkpo
 	if err != nil {
 		return err
 	}
+	k.setBorBlockIndex(ctx, rootChain, checkpoint.StartBlock, checkpointNumber)
 	k.Logger(ctx).Info("Adding good checkpoint to state",
 		"root", rootChain, "checkpoint", checkpoint, "checkpointNumber", checkpointNumber)
 	return nil
@@ -108,6 +159,7 @@ func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpo
 	if err != nil {
 		return err
 	}
+	k.setCheckpointBufferHeight(ctx, rootChain, checkpoint.StartBlock)
 	return nil
 }
 
@@ -203,32 +255,98 @@ func (k *Keeper) GetLastCheckpoint(ctx sdk.Context, rootChain string) (hmTypes.C
 	return _checkpoint, cmn.ErrNoCheckpointFound(k.Codespace())
 }
 
-// GetCheckpointKey appends prefix to checkpointNumber
-func GetCheckpointKey(checkpointNumber uint64, rootChain string) []byte {
-	var key []byte
+// validateCheckpointTip checks checkpoint starts right after tip of root chain, which is buffer tail when
+// checkpoints are queued, or at activation height of root chain for first checkpoint
+func (k *Keeper) validateCheckpointTip(ctx sdk.Context, msg types.MsgCheckpoint) sdk.Error {
+	logger := k.Logger(ctx)
+
+	lastCheckpoint, err := k.GetLastCheckpoint(ctx, msg.RootChainType)
+
+	// checkpoint queued behind buffered ones has to follow buffer tail
+	if tail, ok := k.GetCheckpointBufferQueue(ctx, msg.RootChainType).Tail(); ok {
+		lastCheckpoint, err = tail, nil
+	}
+
+	// fetch last checkpoint from store
+	if err == nil {
+		// make sure new checkpoint is after tip
+		if lastCheckpoint.EndBlock > msg.StartBlock {
+			logger.Error("Checkpoint already exists",
+				"currentTip", lastCheckpoint.EndBlock,
+				"startBlock", msg.StartBlock,
+				"root", msg.RootChainType,
+			)
+			return cmn.ErrOldCheckpoint(k.Codespace())
+		}
+
+		// check if new checkpoint's start block start from current tip
+		if lastCheckpoint.EndBlock+1 != msg.StartBlock {
+			logger.Error("Checkpoint not in countinuity",
+				"currentTip", lastCheckpoint.EndBlock,
+				"startBlock", msg.StartBlock, "root", msg.RootChainType)
+			return cmn.ErrDisCountinuousCheckpoint(k.Codespace())
+		}
+	} else if err.Error() == cmn.ErrNoCheckpointFound(k.Codespace()).Error() {
+		activation := k.ck.GetChainActivationHeight(ctx, msg.RootChainType)
+		if activation != msg.StartBlock {
+			logger.Error("First checkpoint to start from block active height",
+				"activation", activation, "start", msg.StartBlock, "root", msg.RootChainType)
+			return cmn.ErrBadBlockDetails(k.Codespace())
+		}
+	}
+
+	return nil
+}
+
+// getCheckpointPrefix returns prefix key of acked checkpoints of root chain
+func getCheckpointPrefix(rootChain string) []byte {
 	switch rootChain {
 	case hmTypes.RootChainTypeEth:
-		key = EthCheckpointKey
+		return EthCheckpointKey
 	case hmTypes.RootChainTypeTron:
-		key = TronCheckpointKey
+		return TronCheckpointKey
 	case hmTypes.RootChainTypeBsc:
-		key = BscCheckpointKey
+		return BscCheckpointKey
 	}
+	return nil
+}
+
+// GetCheckpointKey appends prefix to checkpointNumber
+func GetCheckpointKey(checkpointNumber uint64, rootChain string) []byte {
+	key := getCheckpointPrefix(rootChain)
 	checkpointNumberBytes := []byte(strconv.FormatUint(checkpointNumber, 10))
 	return append(key, checkpointNumberBytes...)
 }
 
+// GetCheckpointCount returns number of acked checkpoints stored for root chain
+func (k *Keeper) GetCheckpointCount(ctx sdk.Context, rootChain string) (count uint64) {
+	prefix := getCheckpointPrefix(rootChain)
+	if prefix == nil {
+		return 0
+	}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
 // HasStoreValue check if value exists in store or not
 func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(key)
 }
 
-// FlushCheckpointBuffer flushes Checkpoint Buffer
+// FlushCheckpointBuffer flushes Checkpoint Buffer along with checkpoints queued behind it
 func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context, rootChain string) {
 	store := ctx.KVStore(k.storeKey)
 	key := getCheckpointBufferKey(hmTypes.GetRootChainID(rootChain))
 	store.Delete(key)
+	k.deleteCheckpointBufferHeights(ctx, rootChain)
+	k.flushBufferQueue(ctx, rootChain)
 }
 
 // GetCheckpointFromBuffer gets checkpoint in buffer
@@ -359,6 +477,118 @@ func (k *Keeper) GetOtherCheckpoints(ctx sdk.Context, rootChain string) []hmType
 	return headers
 }
 
+//
+// Checkpoint adjustments
+//
+
+func getAdjustmentPrefix(rootChain string, number uint64) []byte {
+	return append(append(AdjustmentKey, hmTypes.GetRootChainID(rootChain)), sdk.Uint64ToBigEndian(number)...)
+}
+
+// GetAdjustmentKey returns key of adjustment record of checkpoint at height
+func GetAdjustmentKey(rootChain string, number uint64, height int64) []byte {
+	return append(getAdjustmentPrefix(rootChain, number), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// AddCheckpointAdjustment stores adjustment record of checkpoint
+func (k *Keeper) AddCheckpointAdjustment(ctx sdk.Context, adjustment types.CheckpointAdjustment) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetAdjustmentKey(adjustment.RootChain, adjustment.Number, adjustment.Height)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(adjustment))
+}
+
+// GetCheckpointAdjustments returns adjustment records of checkpoint, all checkpoints of root chain if number is 0
+func (k *Keeper) GetCheckpointAdjustments(ctx sdk.Context, rootChain string, number uint64) (adjustments []types.CheckpointAdjustment) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := append(AdjustmentKey, hmTypes.GetRootChainID(rootChain))
+	if number != 0 {
+		prefix = getAdjustmentPrefix(rootChain, number)
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var adjustment types.CheckpointAdjustment
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &adjustment)
+		adjustments = append(adjustments, adjustment)
+	}
+	return adjustments
+}
+
+//
+// Account root hash
+//
+
+// IsAccountRootPrecomputed returns true if account root hash is precomputed at end of block. It's
+// enabled at genesis of new chains and by store migration of existing ones, before that account
+// root hash is computed from dividend accounts whenever it's needed.
+func (k *Keeper) IsAccountRootPrecomputed(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(AccountRootPrecomputeKey)
+}
+
+// enableAccountRootPrecompute starts tracking changes of dividend accounts and precomputing
+// account root hash from them
+func (k *Keeper) enableAccountRootPrecompute(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Set(AccountRootPrecomputeKey, DefaultValue)
+	k.moduleCommunicator.TrackDividendAccounts(ctx)
+}
+
+// UpdateAccountRootHash recomputes account root hash of dividend accounts and stores it if changed.
+// Tree is rebuilt only if dividend accounts changed since it was computed last time.
+func (k *Keeper) UpdateAccountRootHash(ctx sdk.Context) error {
+	if !k.IsAccountRootPrecomputed(ctx) {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if !k.moduleCommunicator.IsDividendAccountsDirty(ctx) && store.Has(AccountRootHashKey) {
+		return nil
+	}
+
+	dividendAccounts := k.moduleCommunicator.GetAllDividendAccounts(ctx)
+	if len(dividendAccounts) == 0 {
+		store.Delete(AccountRootHashKey)
+		store.Delete(AccountsKey)
+		k.moduleCommunicator.ClearDividendAccountsDirty(ctx)
+		return nil
+	}
+
+	accountRoot, err := types.GetAccountRootHash(dividendAccounts)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(store.Get(AccountRootHashKey), accountRoot) {
+		// keep accounts root was computed from, checkpoint carrying it snapshots them for fee claims
+		accounts, err := k.marshalPayload(ctx, types.AccountRootSnapshot{
+			AccountRootHash: hmTypes.BytesToHeimdallHash(accountRoot),
+			Accounts:        dividendAccounts,
+		})
+		if err != nil {
+			return err
+		}
+
+		store.Set(AccountRootHashKey, accountRoot)
+		store.Set(AccountsKey, accounts)
+	}
+
+	k.moduleCommunicator.ClearDividendAccountsDirty(ctx)
+	return nil
+}
+
+// GetAccountRootHash returns account root hash of current dividend accounts. Hash precomputed at
+// end of last block is used unless dividend accounts changed since, eg. by txs earlier in the block.
+func (k *Keeper) GetAccountRootHash(ctx sdk.Context) ([]byte, error) {
+	if k.IsAccountRootPrecomputed(ctx) && !k.moduleCommunicator.IsDividendAccountsDirty(ctx) {
+		if accountRoot := ctx.KVStore(k.storeKey).Get(AccountRootHashKey); accountRoot != nil {
+			return accountRoot, nil
+		}
+	}
+	return types.GetAccountRootHash(k.moduleCommunicator.GetAllDividendAccounts(ctx))
+}
+
 //
 // Ack count
 //
@@ -412,6 +642,331 @@ func (k Keeper) UpdateACKCount(ctx sdk.Context, rootChain string) {
 
 }
 
+//
+// Bor block index
+//
+
+func getBorBlockIndexPrefix(rootChain string) []byte {
+	return append(BorBlockIndexKey, hmTypes.GetRootChainID(rootChain))
+}
+
+// GetBorBlockIndexKey returns index key for checkpoint starting at bor block
+func GetBorBlockIndexKey(rootChain string, startBlock uint64) []byte {
+	return append(getBorBlockIndexPrefix(rootChain), sdk.Uint64ToBigEndian(startBlock)...)
+}
+
+func (k *Keeper) setBorBlockIndex(ctx sdk.Context, rootChain string, startBlock uint64, checkpointNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetBorBlockIndexKey(rootChain, startBlock), sdk.Uint64ToBigEndian(checkpointNumber))
+}
+
+// GetCheckpointByBorBlock returns number and checkpoint which covers bor block
+func (k *Keeper) GetCheckpointByBorBlock(ctx sdk.Context, blockNumber uint64, rootChain string) (uint64, hmTypes.Checkpoint, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// closest checkpoint starting at or before block
+	iterator := store.ReverseIterator(
+		getBorBlockIndexPrefix(rootChain),
+		GetBorBlockIndexKey(rootChain, blockNumber+1),
+	)
+	defer iterator.Close()
+
+	if iterator.Valid() {
+		number := binary.BigEndian.Uint64(iterator.Value())
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number, rootChain)
+		if err == nil && checkpoint.EndBlock >= blockNumber {
+			return number, checkpoint, nil
+		}
+	}
+
+	// checkpoints added before index existed
+	return k.searchCheckpointByBorBlock(ctx, blockNumber, rootChain)
+}
+
+// searchCheckpointByBorBlock binary searches acked checkpoints for bor block
+func (k *Keeper) searchCheckpointByBorBlock(ctx sdk.Context, blockNumber uint64, rootChain string) (uint64, hmTypes.Checkpoint, error) {
+	low, high := uint64(1), k.GetACKCount(ctx, rootChain)
+	for low <= high {
+		mid := low + (high-low)/2
+		checkpoint, err := k.GetCheckpointByNumber(ctx, mid, rootChain)
+		if err != nil {
+			break
+		}
+
+		switch {
+		case blockNumber < checkpoint.StartBlock:
+			high = mid - 1
+		case blockNumber > checkpoint.EndBlock:
+			low = mid + 1
+		default:
+			return mid, checkpoint, nil
+		}
+	}
+
+	return 0, hmTypes.Checkpoint{}, cmn.ErrNoCheckpointFound(k.Codespace())
+}
+
+// GetConflictingCheckpoint returns acked checkpoint of other root chain which covers same bor range
+// with different root hash. Root hashes are comparable only for identical ranges, and since
+// checkpoints of a root chain are continuous only the one covering start block can match.
+func (k *Keeper) GetConflictingCheckpoint(ctx sdk.Context, checkpoint hmTypes.Checkpoint, rootChain string) (string, uint64, hmTypes.Checkpoint, bool) {
+	// fixed order to keep result deterministic
+	for _, otherChain := range []string{hmTypes.RootChainTypeTron, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc} {
+		if otherChain == rootChain {
+			continue
+		}
+
+		number, other, err := k.GetCheckpointByBorBlock(ctx, checkpoint.StartBlock, otherChain)
+		if err != nil || other.StartBlock != checkpoint.StartBlock || other.EndBlock != checkpoint.EndBlock {
+			continue
+		}
+
+		if !other.RootHash.Equals(checkpoint.RootHash) {
+			return otherChain, number, other, true
+		}
+	}
+
+	return "", 0, hmTypes.Checkpoint{}, false
+}
+
+//
+// Standby proposers
+//
+
+// GetProposerSlotStart returns time checkpoint of current proposer slot is due. It is due once bor
+// produced avg checkpoint length of blocks since last ack, or right away after no-ack. While checkpoint
+// is in buffer, primary proposer already proposed, so slot starts once buffer expires.
+func (k *Keeper) GetProposerSlotStart(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+
+	var slotStart uint64
+	if lastCheckpoint, err := k.GetLastCheckpoint(ctx, hmTypes.RootChainTypeStake); err == nil {
+		interval := time.Duration(params.AvgCheckpointLength) * k.estimateBorBlockTime(ctx, hmTypes.RootChainTypeStake)
+		slotStart = lastCheckpoint.TimeStamp + uint64(interval.Seconds())
+	}
+
+	if lastNoAck := k.GetLastNoAck(ctx); lastNoAck > slotStart {
+		slotStart = lastNoAck
+	}
+
+	if checkpoint, err := k.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeStake); err == nil {
+		if expiry := checkpoint.TimeStamp + uint64(params.CheckpointBufferTime.Seconds()); expiry > slotStart {
+			slotStart = expiry
+		}
+	}
+
+	return slotStart
+}
+
+// GetStandbyProposers returns ordered list of backup proposers for current checkpoint slot.
+// Standby at position N may propose once N grace windows elapsed since slot start.
+func (k *Keeper) GetStandbyProposers(ctx sdk.Context) (standbys []types.StandbyProposer) {
+	params := k.GetParams(ctx)
+	if params.StandbyProposerCount == 0 {
+		return standbys
+	}
+
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	if validatorSet.Proposer == nil {
+		return standbys
+	}
+
+	// follow proposer rotation on a copy
+	vs := validatorSet.Copy()
+	primary := validatorSet.Proposer.ID
+	seen := map[hmTypes.ValidatorID]bool{primary: true}
+
+	slotStart := k.GetProposerSlotStart(ctx)
+	graceWindow := uint64(params.ProposerGraceWindow.Seconds())
+
+	for i := 0; i < len(vs.Validators) && uint64(len(standbys)) < params.StandbyProposerCount; i++ {
+		vs.IncrementProposerPriority(1)
+		proposer := vs.GetProposer()
+		if proposer == nil || seen[proposer.ID] {
+			continue
+		}
+		seen[proposer.ID] = true
+
+		// offline validators are not standbys
+		if !k.lk.IsLive(ctx, proposer.ID) {
+			continue
+		}
+
+		position := uint64(len(standbys)) + 1
+		standbys = append(standbys, types.NewStandbyProposer(*proposer.Copy(), position, slotStart+position*graceWindow))
+	}
+
+	return standbys
+}
+
+// RotateProposer increments accum (selects new proposer), skipping validators which liveness module
+// considers offline. If every validator is offline, proposer is rotated once as usual.
+func (k *Keeper) RotateProposer(ctx sdk.Context) {
+	validatorSet := k.sk.GetValidatorSet(ctx)
+
+	// follow proposer rotation on a copy until live proposer is found
+	vs := validatorSet.Copy()
+	for i := 0; i < len(vs.Validators); i++ {
+		vs.IncrementProposerPriority(1)
+		if proposer := vs.GetProposer(); proposer != nil && k.lk.IsLive(ctx, proposer.ID) {
+			if i > 0 {
+				k.Logger(ctx).Info("Skipped offline validators as proposer", "skipped", i, "proposer", proposer.Signer.String())
+			}
+
+			if err := k.sk.UpdateValidatorSetInStore(ctx, *vs); err != nil {
+				k.Logger(ctx).Error("RotateProposer | UpdateValidatorSetInStore", "error", err)
+			}
+			return
+		}
+	}
+
+	k.sk.IncrementAccum(ctx, 1)
+}
+
+// GetEligibleStandbyProposer returns standby entry for signer if its grace window has elapsed
+func (k *Keeper) GetEligibleStandbyProposer(ctx sdk.Context, signer hmTypes.HeimdallAddress) (types.StandbyProposer, bool) {
+	now := uint64(ctx.BlockTime().Unix())
+	for _, standby := range k.GetStandbyProposers(ctx) {
+		if standby.Validator.Signer.Equals(signer) {
+			return standby, now >= standby.EligibleAt
+		}
+	}
+	return types.StandbyProposer{}, false
+}
+
+//
+// Proposer deposits
+//
+
+// GetProposerDepositKey returns deposit key of proposer
+func GetProposerDepositKey(proposer hmTypes.HeimdallAddress) []byte {
+	return append(ProposerDepositKey, proposer.Bytes()...)
+}
+
+// GetCheckpointAccount returns checkpoint module account
+func (k Keeper) GetCheckpointAccount(ctx sdk.Context) supplyTypes.ModuleAccountInterface {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// GetProposerDeposit returns deposit held for proposer
+func (k Keeper) GetProposerDeposit(ctx sdk.Context, proposer hmTypes.HeimdallAddress) (deposit types.ProposerDeposit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetProposerDepositKey(proposer))
+	if bz == nil {
+		return deposit, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &deposit)
+	return deposit, true
+}
+
+func (k Keeper) setProposerDeposit(ctx sdk.Context, deposit types.ProposerDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetProposerDepositKey(deposit.Proposer)
+	if deposit.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(deposit))
+}
+
+// IterateProposerDeposits iterates over all proposer deposits and performs a callback function
+func (k Keeper) IterateProposerDeposits(ctx sdk.Context, cb func(deposit types.ProposerDeposit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ProposerDepositKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.ProposerDeposit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &deposit)
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// GetProposerDeposits returns all proposer deposits
+func (k Keeper) GetProposerDeposits(ctx sdk.Context) (deposits []types.ProposerDeposit) {
+	k.IterateProposerDeposits(ctx, func(deposit types.ProposerDeposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+	return deposits
+}
+
+// AddProposerDeposit moves amount from proposer account to checkpoint module account
+func (k Keeper) AddProposerDeposit(ctx sdk.Context, proposer hmTypes.HeimdallAddress, amount sdk.Coins) sdk.Error {
+	if !amount.IsValid() {
+		return sdk.ErrInvalidCoins(amount.String())
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, proposer, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	deposit, found := k.GetProposerDeposit(ctx, proposer)
+	if !found {
+		deposit = types.NewProposerDeposit(proposer, sdk.Coins{})
+	}
+	deposit.Amount = deposit.Amount.Add(amount)
+	k.setProposerDeposit(ctx, deposit)
+
+	k.Logger(ctx).Debug("Added proposer deposit", "proposer", proposer, "amount", amount, "total", deposit.Amount)
+	return nil
+}
+
+// RefundProposerDeposit returns amount of deposit back to proposer
+func (k Keeper) RefundProposerDeposit(ctx sdk.Context, proposer hmTypes.HeimdallAddress, amount sdk.Coins) sdk.Error {
+	deposit, err := k.subProposerDeposit(ctx, proposer, amount)
+	if err != nil {
+		return err
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposer, amount); err != nil {
+		return err
+	}
+	k.setProposerDeposit(ctx, deposit)
+
+	k.Logger(ctx).Debug("Refunded proposer deposit", "proposer", proposer, "amount", amount, "remaining", deposit.Amount)
+	return nil
+}
+
+// ForfeitProposerDeposit moves amount of deposit to fee collector
+func (k Keeper) ForfeitProposerDeposit(ctx sdk.Context, proposer hmTypes.HeimdallAddress, amount sdk.Coins) sdk.Error {
+	deposit, err := k.subProposerDeposit(ctx, proposer, amount)
+	if err != nil {
+		return err
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authTypes.FeeCollectorName, amount); err != nil {
+		return err
+	}
+	k.setProposerDeposit(ctx, deposit)
+
+	k.Logger(ctx).Info("Forfeited proposer deposit", "proposer", proposer, "amount", amount, "remaining", deposit.Amount)
+	return nil
+}
+
+// subProposerDeposit returns deposit of proposer reduced by amount
+func (k Keeper) subProposerDeposit(ctx sdk.Context, proposer hmTypes.HeimdallAddress, amount sdk.Coins) (types.ProposerDeposit, sdk.Error) {
+	if !amount.IsValid() {
+		return types.ProposerDeposit{}, sdk.ErrInvalidCoins(amount.String())
+	}
+
+	deposit, found := k.GetProposerDeposit(ctx, proposer)
+	if !found {
+		return deposit, sdk.ErrUnknownRequest(fmt.Sprintf("no deposit found for proposer %s", proposer))
+	}
+
+	remaining, hasNeg := deposit.Amount.SafeSub(amount)
+	if hasNeg {
+		return deposit, sdk.ErrInsufficientCoins(fmt.Sprintf("deposit of proposer %s is %s, requested %s", proposer, deposit.Amount, amount))
+	}
+	deposit.Amount = remaining
+
+	return deposit, nil
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
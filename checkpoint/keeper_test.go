@@ -1,13 +1,23 @@
 package checkpoint_test
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	livenessTypes "github.com/maticnetwork/heimdall/liveness/types"
+	"github.com/maticnetwork/heimdall/topup"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -114,3 +124,647 @@ func (suite *KeeperTestSuite) TestFlushCheckpointBuffer() {
 	result := keeper.HasStoreValue(ctx, key)
 	require.False(t, result)
 }
+
+func (suite *KeeperTestSuite) TestRotateProposerSkipsOfflineValidators() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	chSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
+
+	// next proposer goes offline
+	next := app.StakingKeeper.GetNextProposer(ctx)
+	liveness := livenessTypes.NewValidatorLiveness(next.ID)
+	liveness.MissedWindows = app.LivenessKeeper.GetParams(ctx).MaxMissedWindows
+	app.LivenessKeeper.SetValidatorLiveness(ctx, liveness)
+
+	keeper.RotateProposer(ctx)
+
+	proposer := app.StakingKeeper.GetCurrentProposer(ctx)
+	require.NotEqual(t, next.ID, proposer.ID, "offline validator must be skipped")
+	require.True(t, app.LivenessKeeper.IsLive(ctx, proposer.ID))
+
+	for _, standby := range keeper.GetStandbyProposers(ctx) {
+		require.NotEqual(t, next.ID, standby.Validator.ID, "offline validator must not be standby")
+	}
+}
+
+func (suite *KeeperTestSuite) TestStandbyProposers() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	chSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
+
+	params := keeper.GetParams(ctx)
+	params.StandbyProposerCount = 2
+	params.ProposerGraceWindow = 100 * time.Second
+	keeper.SetParams(ctx, params)
+
+	slotStart := uint64(1000)
+	keeper.SetLastNoAck(ctx, slotStart)
+
+	primary := app.StakingKeeper.GetValidatorSet(ctx).Proposer
+	standbys := keeper.GetStandbyProposers(ctx)
+	require.Len(t, standbys, 2)
+
+	for i, standby := range standbys {
+		position := uint64(i) + 1
+		require.Equal(t, position, standby.Position)
+		require.Equal(t, slotStart+position*100, standby.EligibleAt)
+		require.NotEqual(t, primary.ID, standby.Validator.ID, "primary must not be standby")
+	}
+	require.NotEqual(t, standbys[0].Validator.ID, standbys[1].Validator.ID)
+
+	// first standby is not eligible before grace window
+	ctx = ctx.WithBlockTime(time.Unix(int64(slotStart+99), 0))
+	_, eligible := keeper.GetEligibleStandbyProposer(ctx, standbys[0].Validator.Signer)
+	require.False(t, eligible)
+
+	// first standby is eligible after grace window, second one is not yet
+	ctx = ctx.WithBlockTime(time.Unix(int64(slotStart+100), 0))
+	_, eligible = keeper.GetEligibleStandbyProposer(ctx, standbys[0].Validator.Signer)
+	require.True(t, eligible)
+	_, eligible = keeper.GetEligibleStandbyProposer(ctx, standbys[1].Validator.Signer)
+	require.False(t, eligible)
+
+	// primary is never a standby
+	_, eligible = keeper.GetEligibleStandbyProposer(ctx.WithBlockTime(time.Unix(int64(slotStart+1000), 0)), primary.Signer)
+	require.False(t, eligible)
+
+	// primary proposed, slot starts once checkpoint in buffer expires
+	bufferedAt := slotStart + 50
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, hmTypes.Checkpoint{EndBlock: 255, TimeStamp: bufferedAt}, hmTypes.RootChainTypeStake))
+	bufferExpiry := bufferedAt + uint64(params.CheckpointBufferTime.Seconds())
+	require.Equal(t, bufferExpiry, keeper.GetProposerSlotStart(ctx))
+	require.Equal(t, bufferExpiry+100, keeper.GetStandbyProposers(ctx)[0].EligibleAt)
+	keeper.FlushCheckpointBuffer(ctx, hmTypes.RootChainTypeStake)
+
+	// after ack, next checkpoint is due once bor produced avg checkpoint length of blocks
+	ackedAt := slotStart + 500
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, hmTypes.Checkpoint{EndBlock: 255, TimeStamp: ackedAt}, hmTypes.RootChainTypeStake))
+	keeper.UpdateACKCount(ctx, hmTypes.RootChainTypeStake)
+	due := ackedAt + params.AvgCheckpointLength*uint64(types.DefaultBorBlockTime.Seconds())
+	require.Equal(t, due, keeper.GetProposerSlotStart(ctx))
+
+	// disabled standby list
+	params.StandbyProposerCount = 0
+	keeper.SetParams(ctx, params)
+	require.Empty(t, keeper.GetStandbyProposers(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetCheckpointByBorBlock() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	for i := uint64(1); i <= 3; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			(i-1)*256,
+			i*256-1,
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+		require.NoError(t, keeper.AddCheckpoint(ctx, i, checkpoint, hmTypes.RootChainTypeEth))
+	}
+
+	number, checkpoint, err := keeper.GetCheckpointByBorBlock(ctx, 0, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+	require.Equal(t, uint64(0), checkpoint.StartBlock)
+
+	number, _, err = keeper.GetCheckpointByBorBlock(ctx, 511, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+
+	number, _, err = keeper.GetCheckpointByBorBlock(ctx, 600, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), number)
+
+	_, _, err = keeper.GetCheckpointByBorBlock(ctx, 768, hmTypes.RootChainTypeEth)
+	require.Error(t, err, "block is not checkpointed yet")
+
+	_, _, err = keeper.GetCheckpointByBorBlock(ctx, 0, hmTypes.RootChainTypeBsc)
+	require.Error(t, err, "index is per root chain")
+}
+
+func (suite *KeeperTestSuite) TestProposerDeposits() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, proposer)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 100))))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	require.NoError(t, keeper.AddProposerDeposit(ctx, proposer, sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 60))))
+	require.NoError(t, keeper.AddProposerDeposit(ctx, proposer, sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 20))))
+	require.Error(t, keeper.AddProposerDeposit(ctx, proposer, sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 50))), "insufficient balance")
+
+	deposit, found := keeper.GetProposerDeposit(ctx, proposer)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 80)), deposit.Amount)
+	require.Equal(t, deposit.Amount, keeper.GetCheckpointAccount(ctx).GetCoins())
+
+	_, broken := checkpoint.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	require.Error(t, keeper.RefundProposerDeposit(ctx, proposer, sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 90))), "more than deposit")
+	require.NoError(t, keeper.RefundProposerDeposit(ctx, proposer, sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 30))))
+	require.NoError(t, keeper.ForfeitProposerDeposit(ctx, proposer, sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 50))))
+
+	_, found = keeper.GetProposerDeposit(ctx, proposer)
+	require.False(t, found)
+	require.Empty(t, keeper.GetProposerDeposits(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 50)), app.AccountKeeper.GetAccount(ctx, proposer).GetCoins())
+
+	_, broken = checkpoint.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// coins sent to module account outside of deposits break invariant
+	macc := keeper.GetCheckpointAccount(ctx)
+	require.NoError(t, macc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 1))))
+	app.SupplyKeeper.SetModuleAccount(ctx, macc)
+
+	_, broken = checkpoint.AllInvariants(keeper)(ctx)
+	require.True(t, broken)
+}
+
+func (suite *KeeperTestSuite) TestAccountRootHash() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	require.NoError(t, keeper.UpdateAccountRootHash(ctx), "no dividend accounts")
+
+	account := hmTypes.NewDividendAccount(hmTypes.HexToHeimdallAddress("123"), "100")
+	require.NoError(t, app.TopupKeeper.AddDividendAccount(ctx, account))
+	require.NoError(t, keeper.UpdateAccountRootHash(ctx))
+
+	expected, err := types.GetAccountRootHash(app.TopupKeeper.GetAllDividendAccounts(ctx))
+	require.NoError(t, err)
+
+	accountRoot, err := keeper.GetAccountRootHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, accountRoot)
+
+	// accounts changed since last update are hashed again
+	account = hmTypes.NewDividendAccount(hmTypes.HexToHeimdallAddress("456"), "200")
+	require.NoError(t, app.TopupKeeper.AddDividendAccount(ctx, account))
+	require.True(t, app.TopupKeeper.IsDividendAccountsDirty(ctx))
+
+	current, err := types.GetAccountRootHash(app.TopupKeeper.GetAllDividendAccounts(ctx))
+	require.NoError(t, err)
+	accountRoot, err = keeper.GetAccountRootHash(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, expected, accountRoot)
+	require.Equal(t, current, accountRoot)
+
+	require.NoError(t, keeper.UpdateAccountRootHash(ctx))
+	require.False(t, app.TopupKeeper.IsDividendAccountsDirty(ctx))
+	accountRoot, err = keeper.GetAccountRootHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, current, accountRoot)
+
+	// unchanged accounts are not hashed again
+	expected = accountRoot
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Set(checkpoint.AccountRootHashKey, []byte("stale"))
+	require.NoError(t, keeper.UpdateAccountRootHash(ctx))
+	accountRoot, err = keeper.GetAccountRootHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []byte("stale"), accountRoot)
+
+	// fee added to dividend account marks accounts dirty
+	require.Nil(t, app.TopupKeeper.AddFeeToDividendAccount(ctx, hmTypes.HexToHeimdallAddress("123"), big.NewInt(1)))
+	require.NoError(t, keeper.UpdateAccountRootHash(ctx))
+	accountRoot, err = keeper.GetAccountRootHash(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, expected, accountRoot)
+	require.NotEqual(t, []byte("stale"), accountRoot)
+}
+
+func (suite *KeeperTestSuite) TestAccountRootPrecomputeMigration() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	require.True(t, keeper.IsAccountRootPrecomputed(ctx), "New chains precompute account root hash from genesis")
+
+	// chain started before precompute computes account root hash whenever it's needed
+	checkpointStore := ctx.KVStore(app.GetKey(types.StoreKey))
+	checkpointStore.Delete(checkpoint.AccountRootPrecomputeKey)
+	checkpointStore.Delete(checkpoint.AccountRootHashKey)
+	checkpointStore.Delete(checkpoint.AccountsKey)
+	topupStore := ctx.KVStore(app.GetKey(topupTypes.StoreKey))
+	topupStore.Delete(topup.DividendAccountsTrackedKey)
+	topupStore.Delete(topup.DividendAccountsDirtyKey)
+
+	// neither dividend accounts nor end block write precompute state
+	require.NoError(t, app.TopupKeeper.AddDividendAccount(ctx, hmTypes.NewDividendAccount(hmTypes.HexToHeimdallAddress("123"), "100")))
+	require.False(t, app.TopupKeeper.IsDividendAccountsDirty(ctx))
+	require.NoError(t, keeper.UpdateAccountRootHash(ctx))
+	require.False(t, checkpointStore.Has(checkpoint.AccountRootHashKey))
+
+	expected, err := types.GetAccountRootHash(app.TopupKeeper.GetAllDividendAccounts(ctx))
+	require.NoError(t, err)
+	accountRoot, err := keeper.GetAccountRootHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, accountRoot)
+
+	// migration precomputes root of current accounts and tracks them from now on
+	cfg := hmModule.NewConfigurator()
+	require.NoError(t, checkpoint.NewAppModule(keeper, app.StakingKeeper, app.TopupKeeper, &mocks.IContractCaller{}).RegisterMigrations(cfg))
+	fromVM := hmModule.GetVersionMap(app.GetModuleManager())
+	fromVM[types.ModuleName] = 3
+	_, err = cfg.RunMigrations(ctx, app.GetModuleManager(), fromVM)
+	require.NoError(t, err)
+
+	require.True(t, keeper.IsAccountRootPrecomputed(ctx))
+	require.Equal(t, expected, checkpointStore.Get(checkpoint.AccountRootHashKey))
+	require.False(t, app.TopupKeeper.IsDividendAccountsDirty(ctx))
+
+	require.Nil(t, app.TopupKeeper.AddFeeToDividendAccount(ctx, hmTypes.HexToHeimdallAddress("123"), big.NewInt(1)))
+	require.True(t, app.TopupKeeper.IsDividendAccountsDirty(ctx), "Fees should mark tracked accounts dirty")
+}
+
+func (suite *KeeperTestSuite) TestAckCountInvariants() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeEth
+
+	ackCountInvariant := checkpoint.AckCountInvariant(keeper)
+	_, broken := ackCountInvariant(ctx)
+	require.False(t, broken)
+
+	checkpoint1 := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 1)
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpoint1, rootChain))
+
+	_, broken = ackCountInvariant(ctx)
+	require.True(t, broken, "checkpoint stored without ack")
+
+	keeper.UpdateACKCount(ctx, rootChain)
+	_, broken = ackCountInvariant(ctx)
+	require.False(t, broken)
+
+	// buffered checkpoint must follow last acked one
+	checkpoint2 := hmTypes.CreateBlock(256, 511, hmTypes.HexToHeimdallHash("456"), hmTypes.HexToHeimdallAddress("123"), "1234", 2)
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, checkpoint2, rootChain))
+	_, broken = checkpoint.BufferInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, keeper.SetCheckpointSyncBuffer(ctx, hmTypes.Checkpoint{StartBlock: 512, EndBlock: 767}, rootChain))
+	_, broken = checkpoint.BufferInvariant(keeper)(ctx)
+	require.True(t, broken, "sync buffer ahead of buffer")
+	keeper.FlushCheckpointSyncBuffer(ctx, rootChain)
+
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, checkpoint1, rootChain))
+	_, broken = checkpoint.BufferInvariant(keeper)(ctx)
+	require.True(t, broken, "buffer overlaps acked checkpoint")
+	keeper.FlushCheckpointBuffer(ctx, rootChain)
+
+	// ack count never decreases
+	keeper.UpdateACKCountWithValue(ctx, 0, rootChain)
+	_, broken = ackCountInvariant(ctx)
+	require.True(t, broken)
+
+	_, broken = checkpoint.AllInvariants(keeper)(ctx)
+	require.True(t, broken)
+}
+
+func (suite *KeeperTestSuite) TestCheckpointBufferQueue() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeEth
+
+	// single slot unless depth is set
+	require.Equal(t, types.DefaultCheckpointBufferDepth, keeper.GetCheckpointBufferDepth(ctx))
+	keeper.SetCheckpointBufferDepth(ctx, 3)
+
+	checkpoint1 := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 1)
+	checkpoint2 := hmTypes.CreateBlock(256, 511, hmTypes.HexToHeimdallHash("456"), hmTypes.HexToHeimdallAddress("123"), "1234", 2)
+	checkpoint3 := hmTypes.CreateBlock(512, 767, hmTypes.HexToHeimdallHash("789"), hmTypes.HexToHeimdallAddress("123"), "1234", 3)
+
+	// queued in order of start block, head is buffered checkpoint
+	require.NoError(t, keeper.PushCheckpointBuffer(ctx, checkpoint1, rootChain))
+	require.NoError(t, keeper.PushCheckpointBuffer(ctx, checkpoint3, rootChain))
+	require.NoError(t, keeper.PushCheckpointBuffer(ctx, checkpoint2, rootChain))
+
+	queue := keeper.GetCheckpointBufferQueue(ctx, rootChain)
+	require.True(t, queue.IsFull())
+	require.Equal(t, []hmTypes.Checkpoint{checkpoint1, checkpoint2, checkpoint3}, queue.Checkpoints)
+
+	head, err := keeper.GetCheckpointFromBuffer(ctx, rootChain)
+	require.NoError(t, err)
+	require.Equal(t, checkpoint1, *head)
+
+	_, broken := checkpoint.BufferInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// ack promotes next checkpoint with new timestamp
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+	next := keeper.PopCheckpointBuffer(ctx, rootChain, checkpoint1.EndBlock)
+	require.NotNil(t, next)
+	require.Equal(t, checkpoint2.StartBlock, next.StartBlock)
+	require.Equal(t, uint64(100), next.TimeStamp)
+	require.Len(t, keeper.GetCheckpointBufferQueue(ctx, rootChain).Checkpoints, 2)
+
+	// adjusted ack breaks continuity and drops queue
+	require.Nil(t, keeper.PopCheckpointBuffer(ctx, rootChain, checkpoint2.EndBlock-10))
+	require.Empty(t, keeper.GetCheckpointBufferQueue(ctx, rootChain).Checkpoints)
+
+	// flush removes queued checkpoints as well
+	require.NoError(t, keeper.PushCheckpointBuffer(ctx, checkpoint1, rootChain))
+	require.NoError(t, keeper.PushCheckpointBuffer(ctx, checkpoint2, rootChain))
+	keeper.FlushCheckpointBuffer(ctx, rootChain)
+	require.Empty(t, keeper.GetCheckpointBufferQueue(ctx, rootChain).Checkpoints)
+}
+
+func (suite *KeeperTestSuite) TestCheckpointAggregation() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeEth
+
+	chSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
+
+	// disabled unless set
+	require.False(t, keeper.GetCheckpointAggregation(ctx).Enabled())
+	require.Empty(t, keeper.GetAggregationProposers(ctx))
+
+	keeper.SetCheckpointAggregation(ctx, types.NewCheckpointAggregation(3, 2))
+
+	primary := app.StakingKeeper.GetValidatorSet(ctx).Proposer
+	proposers := keeper.GetAggregationProposers(ctx)
+	require.Len(t, proposers, 3)
+	require.Equal(t, primary.ID, proposers[0].ID, "current proposer goes first")
+	require.Equal(t, uint64(2), keeper.GetAggregationQuorum(ctx))
+
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	vote1 := hmTypes.CreateBlock(0, 255, rootHash, proposers[0].Signer, "1234", 1)
+	vote2 := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("456"), proposers[1].Signer, "1234", 2)
+	vote3 := hmTypes.CreateBlock(0, 255, rootHash, proposers[2].Signer, "1234", 3)
+
+	_, votes, reached := keeper.AggregateCheckpoint(ctx, rootChain, vote1)
+	require.False(t, reached)
+	require.Equal(t, uint64(1), votes)
+	require.True(t, keeper.HasAggregationVote(ctx, rootChain, proposers[0].Signer, 0))
+	require.False(t, keeper.HasAggregationVote(ctx, rootChain, proposers[0].Signer, 256))
+
+	// different root hash doesn't count towards quorum
+	_, votes, reached = keeper.AggregateCheckpoint(ctx, rootChain, vote2)
+	require.False(t, reached)
+	require.Equal(t, uint64(1), votes)
+	require.Len(t, keeper.GetAggregationVotes(ctx, rootChain), 2)
+
+	// earliest matching vote is agreed once quorum is reached
+	agreed, votes, reached := keeper.AggregateCheckpoint(ctx, rootChain, vote3)
+	require.True(t, reached)
+	require.Equal(t, uint64(2), votes)
+	require.Equal(t, vote1, agreed)
+	require.Empty(t, keeper.GetAggregationVotes(ctx, rootChain))
+
+	// votes for stale range are dropped
+	keeper.AggregateCheckpoint(ctx, rootChain, vote1)
+	next := hmTypes.CreateBlock(256, 511, rootHash, proposers[1].Signer, "1234", 4)
+	keeper.AggregateCheckpoint(ctx, rootChain, next)
+	require.Equal(t, []hmTypes.Checkpoint{next}, keeper.GetAggregationVotes(ctx, rootChain))
+
+	// quorum is capped by available proposers
+	keeper.SetCheckpointAggregation(ctx, types.NewCheckpointAggregation(10, 8))
+	require.Equal(t, uint64(4), keeper.GetAggregationQuorum(ctx))
+}
+
+func (suite *KeeperTestSuite) TestRebuildCheckpoints() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeEth
+	borChainID := app.ChainKeeper.GetParams(ctx).ChainParams.BorChainID
+
+	fakeRootChain := mocks.NewFakeRootChain()
+	checkpoints := make([]hmTypes.Checkpoint, 3)
+	for i := range checkpoints {
+		start := uint64(i) * 256
+		checkpoints[i] = hmTypes.CreateBlock(start, start+255, hmTypes.BytesToHeimdallHash([]byte{byte(i + 1)}), hmTypes.HexToHeimdallAddress("123"), borChainID, uint64(i+1))
+		fakeRootChain.SubmitCheckpoint(rootChain, uint64(i+1), mocks.NewHeaderInfo(
+			checkpoints[i].RootHash, checkpoints[i].StartBlock, checkpoints[i].EndBlock, checkpoints[i].TimeStamp, checkpoints[i].Proposer))
+	}
+
+	// checkpoint 2 is missing and checkpoint 3 has wrong root hash
+	wrong := checkpoints[2]
+	wrong.RootHash = hmTypes.HexToHeimdallHash("456")
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpoints[0], rootChain))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 3, wrong, rootChain))
+	keeper.UpdateACKCountWithValue(ctx, 3, rootChain)
+
+	_, err := keeper.RebuildCheckpoints(ctx, rootChain, 2, 4, fakeRootChain, true)
+	require.Error(t, err, "range beyond ack count")
+
+	_, err = keeper.RebuildCheckpoints(ctx, rootChain, 0, 2, fakeRootChain, true)
+	require.Error(t, err, "checkpoint numbers start at 1")
+
+	// dry run doesn't write
+	rebuilds, err := keeper.RebuildCheckpoints(ctx, rootChain, 1, 3, fakeRootChain, true)
+	require.NoError(t, err)
+	require.Len(t, rebuilds, 3)
+	require.Equal(t, types.RebuildActionKeep, rebuilds[0].Action)
+	require.Equal(t, types.RebuildActionAdd, rebuilds[1].Action)
+	require.Nil(t, rebuilds[1].Stored)
+	require.Equal(t, types.RebuildActionReplace, rebuilds[2].Action)
+	require.Equal(t, wrong, *rebuilds[2].Stored)
+
+	_, err = keeper.GetCheckpointByNumber(ctx, 2, rootChain)
+	require.Error(t, err)
+
+	_, err = keeper.RebuildCheckpoints(ctx, rootChain, 1, 3, fakeRootChain, false)
+	require.NoError(t, err)
+
+	for i, expected := range checkpoints {
+		stored, err := keeper.GetCheckpointByNumber(ctx, uint64(i+1), rootChain)
+		require.NoError(t, err)
+		require.Equal(t, expected, stored)
+	}
+
+	// store matches root chain after rebuild
+	rebuilds, err = keeper.RebuildCheckpoints(ctx, rootChain, 1, 3, fakeRootChain, true)
+	require.NoError(t, err)
+	for _, rebuild := range rebuilds {
+		require.False(t, rebuild.Changed())
+	}
+}
+
+func (suite *KeeperTestSuite) TestCheckCheckpointAckAdmission() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeEth
+
+	fakeRootChain := mocks.NewFakeRootChain()
+	fakeRootChain.SubmitCheckpoint(rootChain, 1, mocks.NewHeaderInfo(hmTypes.HexToHeimdallHash("123"), 0, 255, 1, hmTypes.HexToHeimdallAddress("123")))
+
+	newAck := func(number uint64) types.MsgCheckpointAck {
+		return types.NewMsgCheckpointAck(hmTypes.HexToHeimdallAddress("123"), number, hmTypes.HexToHeimdallAddress("123"),
+			0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallHash("456"), 1, rootChain)
+	}
+
+	// header block isn't read in CheckTx, acks are admitted until background loop read it
+	require.Nil(t, keeper.CheckCheckpointAckAdmission(ctx, newAck(2), fakeRootChain))
+	keeper.RefreshCurrentHeaderBlocks()
+
+	require.Nil(t, keeper.CheckCheckpointAckAdmission(ctx, newAck(1), fakeRootChain))
+
+	// ack of checkpoint not submitted to contract yet
+	err := keeper.CheckCheckpointAckAdmission(ctx, newAck(2), fakeRootChain)
+	require.NotNil(t, err)
+	require.Equal(t, common.CodeInvalidACK, err.Code())
+
+	// header block read with verifier of previous check state isn't used once block is committed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.Nil(t, keeper.CheckCheckpointAckAdmission(ctx, newAck(2), fakeRootChain))
+	keeper.RefreshCurrentHeaderBlocks()
+	require.NotNil(t, keeper.CheckCheckpointAckAdmission(ctx, newAck(2), fakeRootChain))
+}
+
+func (suite *KeeperTestSuite) TestGetCheckpointStats() {
+	t, app := suite.T(), suite.app
+	keeper := app.CheckpointKeeper
+
+	now := time.Now()
+	ctx := suite.ctx.WithBlockTime(now).WithBlockHeight(10)
+	proposer1 := hmTypes.HexToHeimdallAddress("123")
+	proposer2 := hmTypes.HexToHeimdallAddress("456")
+
+	// checkpoints of 100 blocks each, 20 hours apart, last two within 24h by proposer2
+	rootChain := hmTypes.RootChainTypeStake
+	for i := uint64(1); i <= 4; i++ {
+		proposer := proposer1
+		if i > 2 {
+			proposer = proposer2
+		}
+
+		timestamp := uint64(now.Add(-time.Duration(4-i) * 20 * time.Hour).Unix())
+		checkpoint := hmTypes.CreateBlock((i-1)*100, i*100-1, hmTypes.HexToHeimdallHash("123"), proposer, "1234", timestamp)
+		require.NoError(t, keeper.AddCheckpoint(ctx, i, checkpoint, rootChain))
+		keeper.UpdateACKCount(ctx, rootChain)
+	}
+	keeper.SetNoAckCount(ctx, 3)
+
+	stats := keeper.GetCheckpointStats(ctx, 10)
+	require.Equal(t, int64(10), stats.Height)
+	require.Equal(t, uint64(3), stats.NoAckCount)
+
+	var rootStats *types.RootChainCheckpointStats
+	for i := range stats.RootChains {
+		if stats.RootChains[i].RootChain == rootChain {
+			rootStats = &stats.RootChains[i]
+		}
+	}
+	require.NotNil(t, rootStats)
+	require.Equal(t, uint64(4), rootStats.TotalCheckpoints)
+	require.Equal(t, uint64(20*time.Hour/time.Second), rootStats.AvgInterval)
+	require.Equal(t, uint64(100), rootStats.AvgBlocksPerCheckpoint)
+	require.Equal(t, uint64(2), rootStats.Last24hCount)
+	require.Equal(t, proposer2, rootStats.CurrentProposer)
+	require.Equal(t, uint64(2), rootStats.CurrentProposerStreak)
+
+	// stats are cached for same version and block
+	keeper.SetNoAckCount(ctx, 4)
+	require.Equal(t, uint64(3), keeper.GetCheckpointStats(ctx, 10).NoAckCount)
+	require.Equal(t, uint64(4), keeper.GetCheckpointStats(ctx.WithBlockHeight(11), 11).NoAckCount)
+}
+
+func (suite *KeeperTestSuite) TestCheckpointTiming() {
+	t, app := suite.T(), suite.app
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeStake
+
+	bufferedAt := time.Unix(1000, 0)
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(bufferedAt.Unix()))
+
+	// first ack after 60 seconds, accepted 30 seconds later
+	ctx := suite.ctx.WithBlockTime(bufferedAt.Add(60 * time.Second)).WithEventManager(sdk.NewEventManager())
+	keeper.RecordAckSubmission(ctx, rootChain, 1)
+	keeper.RecordAckSubmission(ctx.WithBlockTime(bufferedAt.Add(80*time.Second)), rootChain, 1)
+	require.Empty(t, keeper.GetCheckpointTimings(ctx, rootChain, types.DefaultTimingQueryLimit))
+
+	// ack of other than next checkpoint isn't recorded
+	keeper.RecordAckSubmission(ctx, rootChain, 5)
+	_, ok := keeper.GetCheckpointTiming(ctx, rootChain, 5)
+	require.False(t, ok)
+
+	keeper.SetAckLatencySLA(ctx, 120*time.Second)
+	timing := keeper.RecordAckTiming(ctx.WithBlockTime(bufferedAt.Add(90*time.Second)), rootChain, 1, checkpoint)
+	require.Equal(t, uint64(90), timing.AckLatency())
+	require.Equal(t, uint64(60), timing.SubmissionLatency())
+	require.Empty(t, ctx.EventManager().Events())
+
+	// ack without recorded submission, breaching sla
+	checkpoint.StartBlock, checkpoint.EndBlock = 256, 511
+	timing = keeper.RecordAckTiming(ctx.WithBlockTime(bufferedAt.Add(150*time.Second)), rootChain, 2, checkpoint)
+	require.Equal(t, uint64(150), timing.SubmissionLatency())
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeCheckpointSLA, ctx.EventManager().Events()[0].Type)
+
+	timings := keeper.GetCheckpointTimings(ctx, rootChain, types.DefaultTimingQueryLimit)
+	require.Len(t, timings, 2)
+	require.Equal(t, uint64(2), timings[0].Number)
+	require.Len(t, keeper.GetCheckpointTimings(ctx, rootChain, 1), 1)
+	require.Empty(t, keeper.GetCheckpointTimings(ctx, hmTypes.RootChainTypeEth, types.DefaultTimingQueryLimit))
+
+	summary := types.NewCheckpointTimings(rootChain, keeper.GetAckLatencySLA(ctx), timings)
+	require.Equal(t, uint64(1), summary.SLABreaches)
+	require.Equal(t, types.LatencyDistribution{Count: 2, Min: 90, Max: 150, Mean: 120, P50: 90, P90: 150, P99: 150}, summary.AckLatency)
+	require.Equal(t, types.LatencyDistribution{Count: 2, Min: 60, Max: 150, Mean: 105, P50: 60, P90: 150, P99: 150}, summary.SubmissionLatency)
+
+	// record of checkpoint falling out of window is pruned
+	keeper.RecordAckTiming(ctx, rootChain, 1+types.MaxTimingQueryLimit, checkpoint)
+	_, ok = keeper.GetCheckpointTiming(ctx, rootChain, 1)
+	require.False(t, ok)
+	_, ok = keeper.GetCheckpointTiming(ctx, rootChain, 2)
+	require.True(t, ok)
+	require.Len(t, keeper.GetCheckpointTimings(ctx, rootChain, types.MaxTimingQueryLimit), 2)
+
+	// all records falling out of window are pruned, even if checkpoints were skipped
+	keeper.RecordAckTiming(ctx, rootChain, 5+2*types.MaxTimingQueryLimit, checkpoint)
+	timings = keeper.GetCheckpointTimings(ctx, rootChain, types.MaxTimingQueryLimit)
+	require.Len(t, timings, 1)
+	require.Equal(t, 5+2*types.MaxTimingQueryLimit, timings[0].Number)
+}
+
+func (suite *KeeperTestSuite) TestGetDividendAccountClaim() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	user1 := hmTypes.HexToHeimdallAddress("123")
+	user2 := hmTypes.HexToHeimdallAddress("456")
+	require.NoError(t, app.TopupKeeper.AddDividendAccount(ctx, hmTypes.NewDividendAccount(user1, "100")))
+	require.NoError(t, app.TopupKeeper.AddDividendAccount(ctx, hmTypes.NewDividendAccount(user2, "200")))
+	require.NoError(t, keeper.UpdateAccountRootHash(ctx))
+
+	accountRoot, err := keeper.GetAccountRootHash(ctx)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SnapshotAccountRoot(ctx, accountRoot))
+
+	// fees accrued after checkpoint don't change its proof, accounts stored before compression are still read
+	keeper.SetPayloadCompression(ctx, types.PayloadCompressionSnappy)
+	require.NoError(t, app.TopupKeeper.AddDividendAccount(ctx, hmTypes.NewDividendAccount(user2, "300")))
+	require.NoError(t, keeper.UpdateAccountRootHash(ctx))
+
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), user1, "1234", uint64(time.Now().Unix()))
+	keeper.SetCheckpointAccountRoot(ctx, hmTypes.RootChainTypeEth, checkpoint.StartBlock, hmTypes.BytesToHeimdallHash(accountRoot))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpoint, hmTypes.RootChainTypeEth))
+
+	claim, err := keeper.GetDividendAccountClaim(ctx, 1, hmTypes.RootChainTypeEth, user2)
+	require.NoError(t, err)
+	require.Equal(t, "200", claim.FeeAmount)
+	require.Equal(t, hmTypes.BytesToHeimdallHash(accountRoot), claim.AccountRootHash)
+
+	proof, index, err := types.GetAccountProof([]hmTypes.DividendAccount{
+		hmTypes.NewDividendAccount(user1, "100"),
+		hmTypes.NewDividendAccount(user2, "200"),
+	}, user2)
+	require.NoError(t, err)
+	require.Equal(t, index, claim.Index)
+	require.Equal(t, hmTypes.HexBytes(proof), claim.Proof)
+
+	calldata, err := types.PackClaimFee(big.NewInt(200), index, proof)
+	require.NoError(t, err)
+	require.Equal(t, hmTypes.HexBytes(calldata), claim.Calldata)
+
+	_, err = keeper.GetDividendAccountClaim(ctx, 1, hmTypes.RootChainTypeEth, hmTypes.HexToHeimdallAddress("789"))
+	require.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package checkpoint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+// migrateChildBlockInterval moves child block interval from checkpoint params to per root chain
+// chainmanager params (v1 -> v2). Every root chain keeps interval which was in effect before.
+func migrateChildBlockInterval(ctx sdk.Context, k Keeper) error {
+	var interval uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyChildBlockInterval, &interval)
+	if interval == 0 {
+		interval = chainmanagerTypes.DefaultChildBlockInterval
+	}
+
+	k.ck.SetChildBlockIntervals(ctx, chainmanagerTypes.NewChildBlockIntervals(interval))
+	k.Logger(ctx).Info("Moved child block interval to chainmanager params", "interval", interval)
+	return nil
+}
+
+// migrateStandbyProposerParams sets default standby proposer params (v2 -> v3), params which were
+// set already are kept. Params are read as param set, which panics if any of them is missing.
+func migrateStandbyProposerParams(ctx sdk.Context, k Keeper) error {
+	if !k.paramSpace.Has(ctx, types.KeyStandbyProposerCount) {
+		k.paramSpace.Set(ctx, types.KeyStandbyProposerCount, types.DefaultStandbyProposerCount)
+	}
+
+	if !k.paramSpace.Has(ctx, types.KeyProposerGraceWindow) {
+		k.paramSpace.Set(ctx, types.KeyProposerGraceWindow, types.DefaultProposerGraceWindow)
+	}
+
+	params := k.GetParams(ctx)
+	k.Logger(ctx).Info("Set standby proposer params", "count", params.StandbyProposerCount, "graceWindow", params.ProposerGraceWindow)
+	return nil
+}
+
+// migrateAccountRootPrecompute starts precomputing account root hash at end of block (v3 -> v4).
+// Root of current dividend accounts is computed right away, accounts are tracked from now on.
+func migrateAccountRootPrecompute(ctx sdk.Context, k Keeper) error {
+	k.enableAccountRootPrecompute(ctx)
+	if err := k.UpdateAccountRootHash(ctx); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("Enabled account root hash precompute")
+	return nil
+}
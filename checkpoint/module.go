@@ -31,9 +31,14 @@ var (
 	_ module.AppModule             = AppModule{}
 	_ module.AppModuleBasic        = AppModuleBasic{}
 	_ hmModule.HeimdallModuleBasic = AppModule{}
+	_ hmModule.HasConsensusVersion = AppModule{}
+	_ hmModule.HasMigrations       = AppModule{}
 	// _ module.AppModuleSimulation = AppModule{}
 )
 
+// ConsensusVersion store layout version of the module
+const ConsensusVersion uint64 = 4
+
 // AppModuleBasic defines the basic application module used by the auth module.
 type AppModuleBasic struct{}
 
@@ -123,8 +128,10 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the checkpoint module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the auth module.
 func (AppModule) Route() string {
@@ -146,6 +153,35 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper, am.stakingKeeper, am.topupKeeper, am.contractCaller)
 }
 
+// ConsensusVersion returns store layout version of the checkpoint module
+func (AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+// RegisterMigrations registers store migrations of the checkpoint module.
+// Bump ConsensusVersion and register migration from previous version
+// whenever key layout of the module changes.
+func (am AppModule) RegisterMigrations(cfg *hmModule.Configurator) error {
+	// v1 -> v2: child block interval moved to chainmanager
+	if err := cfg.RegisterMigration(types.ModuleName, 1, func(ctx sdk.Context) error {
+		return migrateChildBlockInterval(ctx, am.keeper)
+	}); err != nil {
+		return err
+	}
+
+	// v2 -> v3: standby proposer params added
+	if err := cfg.RegisterMigration(types.ModuleName, 2, func(ctx sdk.Context) error {
+		return migrateStandbyProposerParams(ctx, am.keeper)
+	}); err != nil {
+		return err
+	}
+
+	// v3 -> v4: account root hash precomputed at end of block
+	return cfg.RegisterMigration(types.ModuleName, 3, func(ctx sdk.Context) error {
+		return migrateAccountRootPrecompute(ctx, am.keeper)
+	})
+}
+
 // InitGenesis performs genesis initialization for the auth module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
@@ -165,9 +201,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 // BeginBlock returns the begin blocker for the auth module.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the auth module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock precomputes account root hash for next checkpoint once dividend accounts changed.
+// It returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if err := am.keeper.UpdateAccountRootHash(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("EndBlock | UpdateAccountRootHash", "error", err)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -205,9 +244,15 @@ func (AppModule) RegisterStoreDecoder(sdr hmModule.StoreDecoderRegistry) {
 	return
 }
 
-// WeightedOperations doesn't return any chainmanager module operation.
-func (AppModule) WeightedOperations(_ hmModule.SimulationState) []simTypes.WeightedOperation {
-	return nil
+// WeightedOperations returns checkpoint and checkpoint ack operations with randomized side-tx votes.
+func (am AppModule) WeightedOperations(simState hmModule.SimulationState) []simTypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState,
+		&am.keeper,
+		&am.stakingKeeper,
+		NewHandler(am.keeper, am.contractCaller),
+		NewPostTxHandler(am.keeper, am.contractCaller),
+	)
 }
 
 //
@@ -218,7 +263,7 @@ func verifyGenesis(state types.GenesisState, chainManagerState chainmanagerTypes
 	if err != nil {
 		return err
 	}
-	childBlockInterval := state.Params.ChildBlockInterval
+	childBlockInterval := chainmanagerTypes.GetChildBlockInterval(chainManagerState.ChildBlockIntervals, hmTypes.RootChainTypeEth)
 	rootChainAddress := chainManagerState.Params.ChainParams.RootChainAddress.EthAddress()
 	rootChainInstance, _ := contractCaller.GetRootChainInstance(rootChainAddress, hmTypes.RootChainTypeEth)
 
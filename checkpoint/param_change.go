@@ -0,0 +1,93 @@
+package checkpoint
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SimulateParamChanges applies proposed changes of checkpoint params on cached context, nothing is written,
+// and reports their effects on checkpoint delivery against current state
+func (k *Keeper) SimulateParamChanges(ctx sdk.Context, changes []paramsTypes.ParamChange) (types.ParamChangeEffects, error) {
+	if err := paramsTypes.ValidateChanges(changes); err != nil {
+		return types.ParamChangeEffects{}, err
+	}
+
+	keys := make(map[string]bool)
+	for _, pair := range (&types.Params{}).ParamSetPairs() {
+		keys[string(pair.Key)] = true
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	for _, change := range changes {
+		if change.Subspace != types.DefaultParamspace || !keys[change.Key] {
+			return types.ParamChangeEffects{}, fmt.Errorf("param %v/%v can't be simulated", change.Subspace, change.Key)
+		}
+
+		if err := k.paramSpace.Update(cacheCtx, []byte(change.Key), []byte(change.Value)); err != nil {
+			return types.ParamChangeEffects{}, fmt.Errorf("invalid value of %v: %v", change.Key, err)
+		}
+	}
+
+	effects := types.ParamChangeEffects{
+		Current:  k.GetParams(ctx),
+		Proposed: k.GetParams(cacheCtx),
+		Buffers:  []types.BufferEffect{},
+		Warnings: []string{},
+	}
+	proposed := effects.Proposed
+
+	if err := proposed.Validate(); err != nil {
+		effects.Error = err.Error()
+	}
+
+	effects.ValidatorCount = uint64(len(k.sk.GetCurrentValidators(ctx)))
+	effects.MaxCalldataSize = types.CheckpointCalldataSize(effects.ValidatorCount)
+
+	effects.BlockTime = k.estimateBorBlockTime(ctx, hmTypes.RootChainTypeStake)
+
+	effects.ExpectedAckInterval = time.Duration(proposed.AvgCheckpointLength) * effects.BlockTime
+	effects.MaxCheckpointDuration = time.Duration(proposed.MaxCheckpointLength) * effects.BlockTime
+	// bridge assumes 2 second blocks for force push
+	effects.ForcePushInterval = time.Duration(proposed.MaxCheckpointLength) * 2 * time.Second
+
+	if effects.ExpectedAckInterval >= proposed.CheckpointBufferTime {
+		effects.Warnings = append(effects.Warnings, fmt.Sprintf("expected ack interval %v is not shorter than checkpoint buffer time %v", effects.ExpectedAckInterval, proposed.CheckpointBufferTime))
+	}
+
+	now := uint64(ctx.BlockTime().Unix())
+	for _, rootChain := range chainmanagerTypes.SortedRootChains() {
+		for i, checkpoint := range k.GetCheckpointBufferQueue(ctx, rootChain).Checkpoints {
+			buffer := types.BufferEffect{
+				RootChain:  rootChain,
+				StartBlock: checkpoint.StartBlock,
+				EndBlock:   checkpoint.EndBlock,
+			}
+			buffer.ExceedsMaxLength = buffer.Length() > proposed.MaxCheckpointLength
+
+			// queued checkpoints start expiring once they become head of buffer
+			if i == 0 && checkpoint.TimeStamp > 0 {
+				buffer.ExpiresAt = checkpoint.TimeStamp + uint64(proposed.CheckpointBufferTime.Seconds())
+				buffer.Expired = buffer.ExpiresAt <= now
+			}
+
+			if buffer.ExceedsMaxLength {
+				effects.Warnings = append(effects.Warnings, fmt.Sprintf("buffered checkpoint %v-%v of %v is longer than max checkpoint length %v", buffer.StartBlock, buffer.EndBlock, rootChain, proposed.MaxCheckpointLength))
+			}
+
+			if buffer.Expired {
+				effects.Warnings = append(effects.Warnings, fmt.Sprintf("buffered checkpoint %v-%v of %v expires with checkpoint buffer time %v", buffer.StartBlock, buffer.EndBlock, rootChain, proposed.CheckpointBufferTime))
+			}
+
+			effects.Buffers = append(effects.Buffers, buffer)
+		}
+	}
+
+	return effects, nil
+}
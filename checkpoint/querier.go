@@ -28,6 +28,8 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryCheckpoint(ctx, req, keeper)
 		case types.QueryCheckpointBuffer:
 			return handleQueryCheckpointBuffer(ctx, req, keeper)
+		case types.QueryCheckpointBufferQueue:
+			return handleQueryCheckpointBufferQueue(ctx, req, keeper)
 		case types.QueryCheckpointSyncBuffer:
 			return handleQueryCheckpointSyncBuffer(ctx, req, keeper)
 		case types.QueryLastNoAck:
@@ -38,6 +40,44 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryNextCheckpoint(ctx, req, keeper, stakingKeeper, topupKeeper, contractCaller)
 		case types.QueryCheckpointActivation:
 			return handleQueryCheckpointActivation(ctx, req, keeper)
+		case types.QueryStandbyProposers:
+			return handleQueryStandbyProposers(ctx, req, keeper)
+		case types.QueryAggregationProposers:
+			return handleQueryAggregationProposers(ctx, req, keeper)
+		case types.QueryAggregationVotes:
+			return handleQueryAggregationVotes(ctx, req, keeper)
+		case types.QueryCheckpointByBorBlock:
+			return handleQueryCheckpointByBorBlock(ctx, req, keeper)
+		case types.QueryAdjustments:
+			return handleQueryAdjustments(ctx, req, keeper)
+		case types.QueryAccountRootHash:
+			return handleQueryAccountRootHash(ctx, req, keeper)
+		case types.QueryProposerDeposit:
+			return handleQueryProposerDeposit(ctx, req, keeper)
+		case types.QueryProposerDeposits:
+			return handleQueryProposerDeposits(ctx, req, keeper)
+		case types.QueryStats:
+			return handleQueryStats(ctx, req, keeper)
+		case types.QueryFailover:
+			return handleQueryFailover(ctx, req, keeper)
+		case types.QueryBlsSignature:
+			return handleQueryBlsSignature(ctx, req, keeper)
+		case types.QueryBlsAggregation:
+			return handleQueryBlsAggregation(ctx, req, keeper)
+		case types.QueryAttestation:
+			return handleQueryAttestation(ctx, req, keeper)
+		case types.QueryAckGracePeriod:
+			return handleQueryAckGracePeriod(ctx, req, keeper)
+		case types.QueryRootHashAlgorithms:
+			return handleQueryRootHashAlgorithms(ctx, req, keeper)
+		case types.QueryAccountClaim:
+			return handleQueryAccountClaim(ctx, req, keeper)
+		case types.QueryParamChangeEffects:
+			return handleQueryParamChangeEffects(ctx, req, keeper)
+		case types.QuerySimulateAck:
+			return handleQuerySimulateAck(ctx, req, keeper, contractCaller)
+		case types.QueryCheckpointTiming:
+			return handleQueryCheckpointTiming(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -112,6 +152,95 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	return bz, nil
 }
 
+func handleQueryCheckpointByBorBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBorBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	number, checkpoint, err := keeper.GetCheckpointByBorBlock(ctx, params.BlockNumber, params.RootChain)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(
+			fmt.Sprintf("could not fetch checkpoint for bor block %v %v", params.BlockNumber, params.RootChain), err.Error()))
+	}
+
+	bz, err := json.Marshal(types.BorBlockCheckpoint{Number: number, RootChain: params.RootChain, Checkpoint: checkpoint})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAdjustments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	adjustments := keeper.GetCheckpointAdjustments(ctx, params.RootChain, params.Number)
+	if adjustments == nil {
+		adjustments = []types.CheckpointAdjustment{}
+	}
+
+	bz, err := json.Marshal(adjustments)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAccountRootHash(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	accountRoot, err := keeper.GetAccountRootHash(ctx)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch account root hash", err.Error()))
+	}
+
+	bz, err := json.Marshal(hmTypes.BytesToHeimdallHash(accountRoot))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryProposerDeposit(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposerDepositParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	deposit, found := keeper.GetProposerDeposit(ctx, params.Proposer)
+	if !found {
+		deposit = types.NewProposerDeposit(params.Proposer, sdk.Coins{})
+	}
+
+	bz, err := json.Marshal(deposit)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryProposerDeposits(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	deposits := keeper.GetProposerDeposits(ctx)
+	if deposits == nil {
+		deposits = []types.ProposerDeposit{}
+	}
+
+	bz, err := json.Marshal(deposits)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryCheckpointParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
@@ -134,6 +263,23 @@ func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper
 	return bz, nil
 }
 
+func handleQueryCheckpointBufferQueue(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if hmTypes.GetRootChainID(params.RootChain) == 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid root chain %v", params.RootChain))
+	}
+
+	bz, err := json.Marshal(keeper.GetCheckpointBufferQueue(ctx, params.RootChain))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCheckpointSyncBuffer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryCheckpointParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
@@ -168,6 +314,14 @@ func handleQueryLastNoAck(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	return bz, nil
 }
 
+func handleQueryStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetCheckpointStats(ctx, req.Height))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params hmTypes.QueryPaginationParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
@@ -216,8 +370,7 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch roothash for start:%v end:%v error:%v", start, end, err), err.Error()))
 	}
 
-	accs := tk.GetAllDividendAccounts(ctx)
-	accRootHash, err := types.GetAccountRootHash(accs)
+	accRootHash, err := keeper.GetAccountRootHash(ctx)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not get generate account root hash. Error:%v", err), err.Error()))
 	}
@@ -254,3 +407,220 @@ func handleQueryCheckpointActivation(ctx sdk.Context, req abci.RequestQuery, kee
 	}
 	return bz, nil
 }
+
+func handleQueryStandbyProposers(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// get standby proposers for current slot
+	res := keeper.GetStandbyProposers(ctx)
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAggregationProposers(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// get proposers expected to submit next checkpoint in aggregation mode
+	res := keeper.GetAggregationProposers(ctx)
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryFailover(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if hmTypes.GetRootChainID(params.RootChain) == 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid root chain %v", params.RootChain))
+	}
+
+	// get expired checkpoint and backup proposers allowed to resubmit it
+	bz, err := json.Marshal(keeper.GetFailoverStatus(ctx, params.RootChain))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryBlsAggregation(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetBlsAggregation(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAckGracePeriod(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetAckGracePeriod(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryRootHashAlgorithms(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetRootHashAlgorithms(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAccountClaim(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAccountClaimParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if hmTypes.GetRootChainID(params.RootChain) == 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid root chain %v", params.RootChain))
+	}
+
+	res, err := keeper.GetDividendAccountClaim(ctx, params.Number, params.RootChain, params.UserAddress)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(
+			fmt.Sprintf("could not fetch account proof of checkpoint %v %v", params.Number, params.RootChain), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryParamChangeEffects(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryParamChangeParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	effects, err := keeper.SimulateParamChanges(ctx, params.Changes)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	bz, err := json.Marshal(effects)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// handleQuerySimulateAck returns result post-tx handler would produce for candidate ack against current state
+func handleQuerySimulateAck(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	var params types.QuerySimulateAckParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if err := params.Msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	result := hmTypes.SimulatePostTx(ctx, NewPostTxHandler(keeper, contractCaller), params.Msg, params.SideTxResult)
+
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryBlsSignature(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if hmTypes.GetRootChainID(params.RootChain) == 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid root chain %v", params.RootChain))
+	}
+
+	// number zero queries aggregated signature of buffered checkpoint
+	res, err := keeper.GetCheckpointBlsSignature(ctx, params.RootChain, params.Number)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(
+			fmt.Sprintf("could not fetch BLS signature of checkpoint %v %v", params.Number, params.RootChain), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAttestation(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if hmTypes.GetRootChainID(params.RootChain) == 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid root chain %v", params.RootChain))
+	}
+
+	// number zero queries attestation of buffered checkpoint
+	res, err := keeper.GetCheckpointAttestationByNumber(ctx, params.RootChain, params.Number)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(
+			fmt.Sprintf("could not fetch attestation of checkpoint %v %v", params.Number, params.RootChain), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAggregationVotes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if hmTypes.GetRootChainID(params.RootChain) == 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid root chain %v", params.RootChain))
+	}
+
+	res := types.AggregationVotes{
+		RootChain: params.RootChain,
+		Quorum:    keeper.GetAggregationQuorum(ctx),
+		Votes:     keeper.GetAggregationVotes(ctx, params.RootChain),
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointTiming(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointTimingParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+	if params.Limit == 0 {
+		params.Limit = types.DefaultTimingQueryLimit
+	} else if params.Limit > types.MaxTimingQueryLimit {
+		params.Limit = types.MaxTimingQueryLimit
+	}
+
+	timings := keeper.GetCheckpointTimings(ctx, params.RootChain, params.Limit)
+
+	bz, err := json.Marshal(types.NewCheckpointTimings(params.RootChain, keeper.GetAckLatencySLA(ctx), timings))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
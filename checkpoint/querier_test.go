@@ -14,7 +14,9 @@ import (
 	"github.com/maticnetwork/heimdall/checkpoint"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
+	errs "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper/mocks"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -307,3 +309,121 @@ func (suite *QuerierTestSuite) TestQueryNextCheckpoint() {
 	require.Equal(t, checkpointBlock.RootHash, actualRes.RootHash)
 	require.Equal(t, checkpointBlock.BorChainID, actualRes.BorChainID)
 }
+
+func (suite *QuerierTestSuite) TestQueryParamChangeEffects() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	ctx = ctx.WithBlockTime(time.Unix(1500, 0))
+
+	path := []string{types.QueryParamChangeEffects}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParamChangeEffects)
+
+	checkpointBlock := hmTypes.CreateBlock(
+		0,
+		1023,
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		1000,
+	)
+	require.NoError(t, app.CheckpointKeeper.SetCheckpointBuffer(ctx, checkpointBlock, hmTypes.RootChainTypeEth))
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryParamChangeParams([]paramsTypes.ParamChange{
+			paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyMaxCheckpointLength), `"512"`),
+			paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyCheckpointBufferTime), `"300000000000"`),
+		})),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var effects types.ParamChangeEffects
+	require.NoError(t, json.Unmarshal(res, &effects))
+
+	require.Equal(t, types.DefaultMaxCheckpointLength, effects.Current.MaxCheckpointLength)
+	require.Equal(t, uint64(512), effects.Proposed.MaxCheckpointLength)
+	require.Equal(t, 300*time.Second, effects.Proposed.CheckpointBufferTime)
+	require.Empty(t, effects.Error)
+	require.Equal(t, types.DefaultBorBlockTime, effects.BlockTime)
+	require.Equal(t, 256*types.DefaultBorBlockTime, effects.ExpectedAckInterval)
+
+	require.Len(t, effects.Buffers, 1)
+	require.Equal(t, hmTypes.RootChainTypeEth, effects.Buffers[0].RootChain)
+	require.True(t, effects.Buffers[0].ExceedsMaxLength)
+	require.Equal(t, uint64(1300), effects.Buffers[0].ExpiresAt)
+	require.True(t, effects.Buffers[0].Expired)
+	require.Len(t, effects.Warnings, 3)
+
+	// simulation doesn't change params
+	require.Equal(t, types.DefaultMaxCheckpointLength, app.CheckpointKeeper.GetParams(ctx).MaxCheckpointLength)
+
+	// params of other modules
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryParamChangeParams([]paramsTypes.ParamChange{
+		paramsTypes.NewParamChange("staking", "MaxCheckpointLength", `"512"`),
+	}))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
+}
+
+func (suite *QuerierTestSuite) TestQuerySimulateAck() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QuerySimulateAck}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySimulateAck)
+
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	app.StakingKeeper.IncrementAccum(ctx, 1)
+
+	checkpointBlock := hmTypes.CreateBlock(
+		0,
+		255,
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	require.NoError(t, app.CheckpointKeeper.SetCheckpointBuffer(ctx, checkpointBlock, hmTypes.RootChainTypeEth))
+
+	simulate := func(msg types.MsgCheckpointAck, sideTxResult abci.SideTxResultType) (result sdk.Result) {
+		req := abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQuerySimulateAckParams(msg, sideTxResult)),
+		}
+		res, err := querier(ctx, path, req)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(res, &result))
+		return result
+	}
+
+	newAck := func(startBlock uint64) types.MsgCheckpointAck {
+		return types.NewMsgCheckpointAck(
+			hmTypes.HexToHeimdallAddress("123"),
+			1,
+			checkpointBlock.Proposer,
+			startBlock,
+			checkpointBlock.EndBlock,
+			checkpointBlock.RootHash,
+			hmTypes.HexToHeimdallHash("123123"),
+			1,
+			hmTypes.RootChainTypeEth,
+		)
+	}
+
+	// buffer mismatch
+	result := simulate(newAck(1), abci.SideTxResultType_Yes)
+	require.Equal(t, errs.CodeBadAck, result.Code)
+
+	// rejected side-tx
+	result = simulate(newAck(0), abci.SideTxResultType_No)
+	require.False(t, result.IsOK())
+
+	result = simulate(newAck(0), abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected simulated ack to be ok, got %v", result)
+	require.NotEmpty(t, result.Events)
+
+	// simulation doesn't change state
+	require.Equal(t, uint64(0), app.CheckpointKeeper.GetACKCount(ctx, hmTypes.RootChainTypeEth))
+	buffer, err := app.CheckpointKeeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, checkpointBlock.StartBlock, buffer.StartBlock)
+}
@@ -0,0 +1,81 @@
+package checkpoint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// RebuildCheckpoints compares acked checkpoints from..to of root chain with headers on root chain contract
+// and returns diff. Missing or different checkpoints are rewritten from root chain headers unless dryRun is set.
+// It's meant for offline repair of store (eg. after failed migration), not for use in block execution.
+func (k *Keeper) RebuildCheckpoints(ctx sdk.Context, rootChain string, from uint64, to uint64, contractCaller helper.IContractCaller, dryRun bool) ([]types.CheckpointRebuild, error) {
+	if from == 0 || from > to {
+		return nil, fmt.Errorf("invalid checkpoint range %v-%v", from, to)
+	}
+
+	if ackCount := k.GetACKCount(ctx, rootChain); to > ackCount {
+		return nil, fmt.Errorf("checkpoint %v is not acked yet, ack count %v", to, ackCount)
+	}
+
+	verifier, err := k.GetRootChainVerifier(ctx, rootChain, contractCaller)
+	if err != nil {
+		return nil, err
+	}
+
+	borChainID := k.ck.GetParams(ctx).ChainParams.BorChainID
+
+	rebuilds := make([]types.CheckpointRebuild, 0, to-from+1)
+	for number := from; number <= to; number++ {
+		header, err := verifier.GetHeader(number)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching header %v from root chain: %v", number, err)
+		}
+
+		rebuild := types.CheckpointRebuild{
+			Number:    number,
+			RootChain: rootChain,
+			Action:    types.RebuildActionAdd,
+			Rebuilt: hmTypes.CreateBlock(
+				header.StartBlock,
+				header.EndBlock,
+				header.RootHash,
+				header.Proposer,
+				borChainID,
+				header.CreatedAt,
+			),
+		}
+
+		if stored, err := k.GetCheckpointByNumber(ctx, number, rootChain); err == nil {
+			rebuild.Stored = &stored
+			rebuild.Action = types.RebuildActionReplace
+			if VerifyRootChainHeader(header, stored.StartBlock, stored.EndBlock, stored.Proposer, &stored.RootHash) == nil {
+				// keep data root chain doesn't have
+				rebuild.Action = types.RebuildActionKeep
+				rebuild.Rebuilt = stored
+			}
+		}
+
+		rebuilds = append(rebuilds, rebuild)
+	}
+
+	if dryRun {
+		return rebuilds, nil
+	}
+
+	for _, rebuild := range rebuilds {
+		if !rebuild.Changed() {
+			continue
+		}
+
+		if err := k.AddCheckpoint(ctx, rebuild.Number, rebuild.Rebuilt, rootChain); err != nil {
+			return nil, err
+		}
+	}
+
+	return rebuilds, nil
+}
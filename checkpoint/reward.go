@@ -0,0 +1,156 @@
+package checkpoint
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// getSignersKey returns key of signers of checkpoint starting at start block
+func getSignersKey(rootID byte, startBlock uint64) []byte {
+	key := append(append([]byte{}, SignersKey...), rootID)
+	return append(key, sdk.Uint64ToBigEndian(startBlock)...)
+}
+
+// SetSignerReward sets reward split among signers of acked checkpoint
+func (k *Keeper) SetSignerReward(ctx sdk.Context, reward sdk.Int) {
+	k.paramSpace.Set(ctx, types.KeySignerReward, reward)
+}
+
+// GetSignerReward returns reward of checkpoint signers, zero if it was never set
+func (k *Keeper) GetSignerReward(ctx sdk.Context) sdk.Int {
+	reward := sdk.ZeroInt()
+	k.paramSpace.GetIfExists(ctx, types.KeySignerReward, &reward)
+	return reward
+}
+
+// SetCheckpointSigners stores signers of buffered checkpoint
+func (k *Keeper) SetCheckpointSigners(ctx sdk.Context, signers types.CheckpointSigners) {
+	store := ctx.KVStore(k.storeKey)
+	key := getSignersKey(hmTypes.GetRootChainID(signers.RootChain), signers.StartBlock)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(signers))
+}
+
+// GetCheckpointSigners returns signers of checkpoint of root chain starting at start block
+func (k *Keeper) GetCheckpointSigners(ctx sdk.Context, rootChain string, startBlock uint64) (*types.CheckpointSigners, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(getSignersKey(hmTypes.GetRootChainID(rootChain), startBlock))
+	if bz == nil {
+		return nil, false
+	}
+
+	var signers types.CheckpointSigners
+	if err := k.cdc.UnmarshalBinaryBare(bz, &signers); err != nil {
+		k.Logger(ctx).Error("Unable to unmarshal checkpoint signers", "root", rootChain, "startBlock", startBlock, "error", err)
+		return nil, false
+	}
+
+	return &signers, true
+}
+
+// DeleteCheckpointSigners removes signers of checkpoint of root chain starting at start block
+func (k *Keeper) DeleteCheckpointSigners(ctx sdk.Context, rootChain string, startBlock uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getSignersKey(hmTypes.GetRootChainID(rootChain), startBlock))
+}
+
+// recordCheckpointSigners stores validators whose yes votes buffered checkpoint
+func (k *Keeper) recordCheckpointSigners(ctx sdk.Context, rootChain string, checkpoint hmTypes.Checkpoint) {
+	voters := hmTypes.GetSideTxSigners(ctx)
+	if len(voters) == 0 {
+		return
+	}
+
+	signers := types.CheckpointSigners{
+		RootChain:  rootChain,
+		StartBlock: checkpoint.StartBlock,
+		EndBlock:   checkpoint.EndBlock,
+		RootHash:   checkpoint.RootHash,
+	}
+	for _, voter := range voters {
+		signers.Signers = append(signers.Signers, types.CheckpointSigner{
+			Signer: hmTypes.BytesToHeimdallAddress(voter.Address),
+			Power:  voter.Power,
+		})
+	}
+
+	k.SetCheckpointSigners(ctx, signers)
+}
+
+// DistributeSignerReward credits reward to dividend accounts of signers of acked checkpoint, weighted
+// by their power. Reward is paid out of fee collector, which is debited first, and is capped at its
+// balance. Signers are removed once rewarded, checkpoints adjusted to range already submitted on
+// root chain were signed by other validators and don't reward anyone.
+func (k *Keeper) DistributeSignerReward(ctx sdk.Context, rootChain string, checkpoint hmTypes.Checkpoint) {
+	signers, ok := k.GetCheckpointSigners(ctx, rootChain, checkpoint.StartBlock)
+	if !ok {
+		return
+	}
+	k.DeleteCheckpointSigners(ctx, rootChain, checkpoint.StartBlock)
+
+	reward := k.GetSignerReward(ctx)
+	if !reward.IsPositive() || !signers.Matches(checkpoint) || len(signers.Signers) == 0 {
+		return
+	}
+
+	// debit fee collector like fee withdraw debits user, dividend accounts are backed by burnt fee tokens
+	feeCollector := k.supplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
+	if feeCollector == nil {
+		k.Logger(ctx).Error("Fee collector account doesn't exist, skipping checkpoint signer reward", "root", rootChain)
+		return
+	}
+
+	balance := feeCollector.GetCoins().AmountOf(authTypes.FeeToken)
+	if balance.LT(reward) {
+		k.Logger(ctx).Info("Fee collector balance is lower than checkpoint signer reward", "root", rootChain, "reward", reward, "balance", balance)
+		reward = balance
+	}
+	if !reward.IsPositive() {
+		return
+	}
+
+	if err := feeCollector.SetCoins(feeCollector.GetCoins().Sub(sdk.Coins{sdk.NewCoin(authTypes.FeeToken, reward)})); err != nil {
+		k.Logger(ctx).Error("Unable to debit checkpoint signer reward from fee collector", "root", rootChain, "error", err)
+		return
+	}
+	k.supplyKeeper.SetModuleAccount(ctx, feeCollector)
+
+	unpaid := reward
+	shares := types.SplitSignerReward(reward, signers.Signers)
+	for i, signer := range signers.Signers {
+		if shares[i].Sign() == 0 {
+			continue
+		}
+
+		if err := k.moduleCommunicator.AddFeeToDividendAccount(ctx, signer.Signer, shares[i]); err != nil {
+			k.Logger(ctx).Error("Unable to credit checkpoint signer reward", "signer", signer.Signer, "root", rootChain, "error", err)
+			continue
+		}
+		unpaid = unpaid.Sub(sdk.NewIntFromBigInt(shares[i]))
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCheckpointReward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySigner, signer.Signer.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, shares[i].String()),
+			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(checkpoint.StartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyRootChain, rootChain),
+		))
+	}
+
+	// shares which couldn't be credited go back to fee collector
+	if unpaid.IsPositive() {
+		feeCollector = k.supplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
+		if err := feeCollector.SetCoins(feeCollector.GetCoins().Add(sdk.Coins{sdk.NewCoin(authTypes.FeeToken, unpaid)})); err != nil {
+			k.Logger(ctx).Error("Unable to refund checkpoint signer reward to fee collector", "root", rootChain, "error", err)
+		} else {
+			k.supplyKeeper.SetModuleAccount(ctx, feeCollector)
+		}
+	}
+
+	k.Logger(ctx).Debug("Checkpoint signer reward distributed", "root", rootChain, "reward", reward, "signers", len(signers.Signers))
+}
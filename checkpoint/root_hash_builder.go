@@ -0,0 +1,23 @@
+package checkpoint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+// SetRootHashAlgorithms sets root hash algorithms of child chains
+func (k *Keeper) SetRootHashAlgorithms(ctx sdk.Context, algorithms []types.RootHashAlgorithm) {
+	k.paramSpace.Set(ctx, types.KeyRootHashAlgorithms, algorithms)
+}
+
+// GetRootHashAlgorithms gets root hash algorithms of child chains, empty if they were never set
+func (k *Keeper) GetRootHashAlgorithms(ctx sdk.Context) (algorithms []types.RootHashAlgorithm) {
+	k.paramSpace.GetIfExists(ctx, types.KeyRootHashAlgorithms, &algorithms)
+	return algorithms
+}
+
+// GetRootHashBuilder returns root hash builder of child chain, bor builder if chain has no algorithm in params
+func (k *Keeper) GetRootHashBuilder(ctx sdk.Context, chainID string) (types.RootHashBuilder, error) {
+	return types.GetRootHashBuilder(types.GetChainRootHashAlgorithm(k.GetRootHashAlgorithms(ctx), chainID))
+}
@@ -1,14 +1,13 @@
 package checkpoint
 
 import (
-	"bytes"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	ethCommon "github.com/maticnetwork/bor/common"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmTypes "github.com/tendermint/tendermint/types"
 
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
@@ -25,6 +24,8 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 			return SideHandleMsgCheckpoint(ctx, k, msg, contractCaller)
 		case types.MsgCheckpointAck:
 			return SideHandleMsgCheckpointAck(ctx, k, msg, contractCaller)
+		case types.MsgCheckpointAdjust:
+			return SideHandleMsgCheckpointAdjust(ctx, k, msg, contractCaller)
 		case types.MsgCheckpointSync:
 			return SideHandleMsgCheckpointSync(ctx, k, msg, contractCaller)
 		case types.MsgCheckpointSyncAck:
@@ -45,8 +46,14 @@ func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	// logger
 	logger := k.Logger(ctx)
 
-	// validate checkpoint
-	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash, params.MaxCheckpointLength, contractCaller)
+	// validate checkpoint with root hash algorithm of child chain
+	builder, err := k.GetRootHashBuilder(ctx, msg.BorChainID)
+	if err != nil {
+		logger.Error("Error getting root hash builder", "error", err, "borChainID", msg.BorChainID)
+		return common.ErrorSideTxCause(k.Codespace(), common.CodeInvalidBlockInput, err)
+	}
+
+	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash, params.MaxCheckpointLength, builder, contractCaller)
 	if err != nil {
 		logger.Error("Error validating checkpoint",
 			"error", err,
@@ -66,15 +73,11 @@ func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 		"rootHash", msg.RootHash,
 	)
 
-	return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBlockInput)
+	return common.ErrorSideTxCause(k.Codespace(), common.CodeInvalidBlockInput, err)
 }
 
 // SideHandleMsgCheckpointAck handles MsgCheckpointAck message for external call
 func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
-	if msg.RootChainType == hmTypes.RootChainTypeTron {
-		return SideHandleMsgTronCheckpointAck(ctx, k, msg, contractCaller)
-	}
-
 	logger := k.Logger(ctx)
 	logger.Debug("✅ Validating External call for checkpoint ack msg",
 		"root", msg.RootChainType,
@@ -83,43 +86,31 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		"number", msg.Number,
 	)
 
-	params := k.GetParams(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
-
-	//
-	// Validate data from root chain
-	//
-	var rootChainAddress ethCommon.Address
-	switch msg.RootChainType {
-	case hmTypes.RootChainTypeEth:
-		rootChainAddress = chainParams.RootChainAddress.EthAddress()
-	case hmTypes.RootChainTypeBsc:
-		bscChain, err := k.ck.GetChainParams(ctx, msg.RootChainType)
-		if err != nil {
-			k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, "does not match bsc")
-			return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
-		}
-		rootChainAddress = bscChain.RootChainAddress.EthAddress()
+	// checkpoint was submitted to root chain contracts in effect when it was buffered,
+	// which may have changed since then
+	height, ok := k.GetCheckpointBufferHeight(ctx, msg.RootChainType, msg.StartBlock)
+	if !ok {
+		height = ctx.BlockHeight()
 	}
-	rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress, msg.RootChainType)
+
+	verifier, err := k.GetRootChainVerifierAt(ctx, msg.RootChainType, height, contractCaller)
 	if err != nil {
-		logger.Error("Unable to fetch rootchain contract instance", "error", err)
-		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
+		logger.Error("Unable to get root chain verifier", "root", msg.RootChainType, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 	}
 
-	root, start, end, _, proposer, err := contractCaller.GetHeaderInfo(msg.Number, rootChainInstance, params.ChildBlockInterval)
+	//
+	// Validate data from root chain, as of block which included ack tx
+	//
+	header, err := verifier.GetHeaderAtTx(msg.Number, msg.TxHash)
 	if err != nil {
-		logger.Error("Unable to fetch checkpoint from rootchain", "error", err, "checkpointNumber", msg.Number)
-		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
+		logger.Error("Unable to fetch checkpoint from rootchain", "root", msg.RootChainType, "error", err, "checkpointNumber", msg.Number, "txHash", msg.TxHash)
+		return common.ErrorSideTxCause(k.Codespace(), common.CodeInvalidACK, err)
 	}
 
 	// check if message data matches with contract data
-	if msg.StartBlock != start ||
-		msg.EndBlock != end ||
-		!msg.Proposer.Equals(proposer) ||
-		!bytes.Equal(msg.RootHash.Bytes(), root.Bytes()) {
-
-		logger.Error("Invalid message. It doesn't match with contract state", "error", err, "checkpointNumber", msg.Number)
+	if err := VerifyRootChainHeader(header, msg.StartBlock, msg.EndBlock, msg.Proposer, &msg.RootHash); err != nil {
+		logger.Error("Invalid message. It doesn't match with contract state", "root", msg.RootChainType, "error", err, "checkpointNumber", msg.Number)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
 	}
 
@@ -129,38 +120,41 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	return
 }
 
-// SideHandleMsgTronCheckpointAck handles MsgCheckpointAck message for external call
-func SideHandleMsgTronCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+// SideHandleMsgCheckpointAdjust handles MsgCheckpointAdjust message for external call
+func SideHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAdjust, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
 	logger := k.Logger(ctx)
+	logger.Debug("✅ Validating External call for checkpoint adjust msg",
+		"root", msg.RootChainType,
+		"start", msg.StartBlock,
+		"end", msg.EndBlock,
+		"number", msg.Number,
+	)
 
-	params := k.GetParams(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
+	verifier, err := k.GetRootChainVerifier(ctx, msg.RootChainType, contractCaller)
+	if err != nil {
+		logger.Error("Unable to get root chain verifier", "root", msg.RootChainType, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
+	}
 
 	//
 	// Validate data from root chain
 	//
-	if msg.RootChainType == hmTypes.RootChainTypeTron {
-		root, start, end, _, proposer, err := contractCaller.GetTronHeaderInfo(msg.Number, chainParams.TronChainAddress, params.ChildBlockInterval)
-		if err != nil {
-			logger.Error("Unable to fetch checkpoint from tron", "error", err, "checkpointNumber", msg.Number)
-			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
-		}
-
-		// check if message data matches with contract data
-		if msg.StartBlock != start ||
-			msg.EndBlock != end ||
-			!msg.Proposer.Equals(proposer) ||
-			!bytes.Equal(msg.RootHash.Bytes(), root.Bytes()) {
+	header, err := verifier.GetHeader(msg.Number)
+	if err != nil {
+		logger.Error("Unable to fetch checkpoint from rootchain", "root", msg.RootChainType, "error", err, "checkpointNumber", msg.Number)
+		return common.ErrorSideTxCause(k.Codespace(), common.CodeInvalidBlockInput, err)
+	}
 
-			logger.Error("Invalid message. It doesn't match with contract state", "error", err, "checkpointNumber", msg.Number)
-			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
-		}
-		// say `yes`
-		result.Result = abci.SideTxResultType_Yes
-		return
+	// adjusted checkpoint must match contract data
+	if err := VerifyRootChainHeader(header, msg.StartBlock, msg.EndBlock, msg.Proposer, &msg.RootHash); err != nil {
+		logger.Error("Invalid checkpoint adjust message. It doesn't match with contract state", "root", msg.RootChainType, "error", err, "checkpointNumber", msg.Number)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBlockInput)
 	}
 
-	return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
+	// say `yes`
+	result.Result = abci.SideTxResultType_Yes
+
+	return
 }
 
 // SideHandleMsgCheckpointSync handles MsgCheckpointSync message for external call
@@ -172,46 +166,36 @@ func SideHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpo
 		"number", msg.Number,
 	)
 
-	params := k.GetParams(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
+	verifier, err := k.GetRootChainVerifier(ctx, msg.RootChainType, contractCaller)
+	if err != nil {
+		logger.Error("Unable to get root chain verifier", "root", msg.RootChainType, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
+	}
 
 	//
 	// Validate data from root chain
 	//
-	var (
-		start, end uint64
-		proposer   hmTypes.HeimdallAddress
-		err        error
-	)
-
-	var rootChainAddress ethCommon.Address
-	switch msg.RootChainType {
-	case hmTypes.RootChainTypeEth:
-		rootChainAddress = chainParams.RootChainAddress.EthAddress()
-	case hmTypes.RootChainTypeBsc:
-		bscChain, err := k.ck.GetChainParams(ctx, msg.RootChainType)
-		if err != nil {
-			k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, "does not  match bsc")
-			return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
-		}
-		rootChainAddress = bscChain.RootChainAddress.EthAddress()
-	}
-	rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress, msg.RootChainType)
-	if err != nil {
-		logger.Error("Unable to fetch rootchain contract instance", "root", msg.RootChainType, "error", err)
-		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
-	}
-	_, start, end, _, proposer, err = contractCaller.GetHeaderInfo(msg.Number, rootChainInstance, params.ChildBlockInterval)
+	header, err := verifier.GetHeader(msg.Number)
 	if err != nil {
 		logger.Error("Unable to fetch checkpoint from rootchain",
 			"root", msg.RootChainType, "error", err, "checkpointNumber", msg.Number)
-		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
+		return common.ErrorSideTxCause(k.Codespace(), common.CodeInvalidACK, err)
+	}
+
+	// root hash on root chain must match checkpoint acked in heimdall
+	var rootHash *hmTypes.HeimdallHash
+	if k.ck.IsFeatureEnabled(ctx, chainmanagerTypes.FeatureSyncRootVerification) {
+		checkpoint, err := k.GetCheckpointByNumber(ctx, msg.Number, msg.RootChainType)
+		if err != nil {
+			logger.Error("Unable to get checkpoint to verify sync root hash",
+				"root", msg.RootChainType, "error", err, "checkpointNumber", msg.Number)
+			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
+		}
+		rootHash = &checkpoint.RootHash
 	}
 
 	// check if message data matches with contract data
-	if msg.StartBlock != start ||
-		msg.EndBlock != end ||
-		!msg.Proposer.Equals(proposer) {
+	if err := VerifyRootChainHeader(header, msg.StartBlock, msg.EndBlock, msg.Proposer, rootHash); err != nil {
 		logger.Error("Invalid checkpoint sync message. It doesn't match with contract state",
 			"root", msg.RootChainType, "error", err, "checkpointNumber", msg.Number)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
@@ -237,10 +221,10 @@ func SideHandleMsgCheckpointSyncAck(ctx sdk.Context, k Keeper, msg types.MsgChec
 	//
 	// Validate data from root chain
 	//
-	currentNumber, err := contractCaller.GetSyncedCheckpointId(chainParams.TronStakingManagerAddress, msg.RootChainType)
+	currentNumber, err := contractCaller.GetSyncedCheckpointId(chainParams.TronStakingManagerAddress.Hex(), msg.RootChainType)
 	if err != nil {
 		logger.Error("Unable to fetch checkpoint from rootchain", "error", err, "checkpointNumber", msg.Number)
-		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
+		return common.ErrorSideTxCause(k.Codespace(), common.CodeInvalidACK, err)
 	}
 	if msg.Number > currentNumber {
 		logger.Error("Invalid message. It doesn't match with contract state", "error", err, "checkpointNumber", msg.Number)
@@ -267,6 +251,8 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 			return PostHandleMsgCheckpoint(ctx, k, msg, sideTxResult)
 		case types.MsgCheckpointAck:
 			return PostHandleMsgCheckpointAck(ctx, k, msg, sideTxResult)
+		case types.MsgCheckpointAdjust:
+			return PostHandleMsgCheckpointAdjust(ctx, k, msg, sideTxResult)
 		case types.MsgCheckpointSync:
 			return PostHandleMsgCheckpointSync(ctx, k, msg, sideTxResult)
 		case types.MsgCheckpointSyncAck:
@@ -288,70 +274,101 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	}
 
 	//
-	// Validate last checkpoint
+	// Check checkpoint buffer
 	//
-	lastCheckpoint, err := k.GetLastCheckpoint(ctx, msg.RootChainType)
-
-	// fetch last checkpoint from store
-	if err == nil {
-		// make sure new checkpoint is after tip
-		if lastCheckpoint.EndBlock > msg.StartBlock {
-			logger.Error("Checkpoint already exists",
-				"currentTip", lastCheckpoint.EndBlock,
-				"startBlock", msg.StartBlock,
-			)
-			return common.ErrOldCheckpoint(k.Codespace()).Result()
-		}
+	bufferQueue := k.GetCheckpointBufferQueue(ctx, msg.RootChainType)
+	if bufferQueue.IsFull() {
+		logger.Debug("Checkpoint buffer is full", "depth", bufferQueue.Depth)
 
-		// check if new checkpoint's start block start from current tip
-		if lastCheckpoint.EndBlock+1 != msg.StartBlock {
-			logger.Error("Checkpoint not in countinuity",
-				"currentTip", lastCheckpoint.EndBlock,
-				"startBlock", msg.StartBlock,
-				"root", msg.RootChainType)
-			return common.ErrDisCountinuousCheckpoint(k.Codespace()).Result()
-		}
-	} else if err.Error() == common.ErrNoCheckpointFound(k.Codespace()).Error() {
-		activation := k.ck.GetChainActivationHeight(ctx, msg.RootChainType)
-		if activation != msg.StartBlock {
-			logger.Error("First checkpoint to start from block active height",
-				"activation", activation, "start", msg.StartBlock, "root", msg.RootChainType)
-			return common.ErrBadBlockDetails(k.Codespace()).Result()
-		}
+		// get checkpoint buffer time from params
+		params := k.GetParams(ctx)
+		expiryTime := bufferQueue.Checkpoints[0].TimeStamp + uint64(params.CheckpointBufferTime.Seconds())
+
+		// return with error (ack is required)
+		return common.ErrNoACK(k.Codespace(), expiryTime).Result()
 	}
 
 	//
-	// Save checkpoint to buffer store
+	// Validate last checkpoint
 	//
-	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
-	if err == nil && checkpointBuffer != nil {
-		logger.Debug("Checkpoint already exists in buffer")
+	if err := k.validateCheckpointTip(ctx, msg); err != nil {
+		return err.Result()
+	}
 
-		// get checkpoint buffer time from params
-		params := k.GetParams(ctx)
-		expiryTime := checkpointBuffer.TimeStamp + uint64(params.CheckpointBufferTime.Seconds())
+	//
+	// Validate against checkpoints of other root chains
+	//
+	if res, conflict := checkCheckpointConflict(ctx, k, msg); conflict {
+		return res
+	}
 
-		// return with error (ack is required)
-		return common.ErrNoACK(k.Codespace(), expiryTime).Result()
+	//
+	// Validate backup proposer of expired range
+	//
+	if res, ok := checkBackupProposer(ctx, k, msg); !ok {
+		return res
 	}
 
+	//
+	// Save checkpoint to buffer store
+	//
 	timeStamp := uint64(ctx.BlockTime().Unix())
-
-	// Add checkpoint to buffer with root hash and account hash
-	k.SetCheckpointBuffer(ctx, hmTypes.Checkpoint{
+	checkpoint := hmTypes.Checkpoint{
 		StartBlock: msg.StartBlock,
 		EndBlock:   msg.EndBlock,
 		RootHash:   msg.RootHash,
 		Proposer:   msg.Proposer,
 		BorChainID: msg.BorChainID,
 		TimeStamp:  timeStamp,
-	}, msg.RootChainType)
+	}
+
+	// in aggregation mode range is buffered only once quorum of proposers submitted same root hash
+	if k.GetCheckpointAggregation(ctx).Enabled() {
+		agreed, votes, reached := k.AggregateCheckpoint(ctx, msg.RootChainType, checkpoint)
+		if !reached {
+			logger.Debug("Checkpoint vote recorded, waiting for aggregation quorum",
+				"proposer", msg.Proposer, "startBlock", msg.StartBlock, "endBlock", msg.EndBlock,
+				"rootHash", msg.RootHash, "votes", votes, "root", msg.RootChainType)
+
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeCheckpointVote,
+					sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+					sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+					sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+					sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
+					sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+					sdk.NewAttribute(types.AttributeKeyAggregationVotes, strconv.FormatUint(votes, 10)),
+				),
+			})
+
+			return sdk.Result{
+				Events: ctx.EventManager().Events(),
+			}
+		}
+
+		logger.Info("Checkpoint aggregation quorum reached",
+			"proposer", agreed.Proposer, "startBlock", agreed.StartBlock, "endBlock", agreed.EndBlock,
+			"votes", votes, "root", msg.RootChainType)
+		checkpoint = agreed
+	}
+
+	// Add checkpoint to buffer with root hash and account hash, behind buffered ones if any
+	if err := k.PushCheckpointBuffer(ctx, checkpoint, msg.RootChainType); err != nil {
+		logger.Error("Error while adding checkpoint to buffer", "error", err, "root", msg.RootChainType)
+		return common.ErrSetCheckpointBuffer(k.Codespace()).Result()
+	}
+	k.recordCheckpointSigners(ctx, msg.RootChainType, checkpoint)
+	k.SetCheckpointAccountRoot(ctx, msg.RootChainType, checkpoint.StartBlock, msg.AccountRootHash)
 
 	logger.Debug("New checkpoint into buffer stored",
 		"startBlock", msg.StartBlock,
 		"endBlock", msg.EndBlock,
 		"rootHash", msg.RootHash,
 		"rootChain", msg.RootChainType,
+		"position", len(bufferQueue.Checkpoints),
 	)
 
 	// TX bytes
@@ -380,6 +397,81 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	}
 }
 
+// checkBackupProposer rejects resubmitted expired range unless it comes from current proposer or from
+// backup proposer whose window is still open. Windows may close between tx and its post-tx execution.
+func checkBackupProposer(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint) (sdk.Result, bool) {
+	if k.GetCheckpointAggregation(ctx).Enabled() {
+		return sdk.Result{}, true
+	}
+
+	expiry, ok := k.GetActiveBufferExpiry(ctx, msg.RootChainType, msg.StartBlock)
+	if !ok {
+		return sdk.Result{}, true
+	}
+
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	if validatorSet.Proposer != nil && k.sk.IsValidatorSigner(ctx, *validatorSet.Proposer, msg.Proposer) {
+		return sdk.Result{}, true
+	}
+
+	if _, allowed := k.GetBackupProposer(ctx, *expiry, msg.Proposer); !allowed {
+		k.Logger(ctx).Error("Backup proposer submitted expired checkpoint outside its window",
+			"msgProposer", msg.Proposer.String(),
+			"startBlock", msg.StartBlock,
+			"expiredAt", expiry.ExpiredAt,
+			"root", msg.RootChainType,
+		)
+		return common.ErrInvalidMsg(k.Codespace(), "Checkpoint proposer outside of backup window").Result(), false
+	}
+
+	return sdk.Result{}, true
+}
+
+// checkCheckpointConflict rejects checkpoint if same bor range is already acked with different root hash on other root chain
+func checkCheckpointConflict(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint) (sdk.Result, bool) {
+	otherChain, number, other, found := k.GetConflictingCheckpoint(ctx, hmTypes.Checkpoint{
+		StartBlock: msg.StartBlock,
+		EndBlock:   msg.EndBlock,
+		RootHash:   msg.RootHash,
+	}, msg.RootChainType)
+	if !found {
+		return sdk.Result{}, false
+	}
+
+	k.Logger(ctx).Error("Checkpoint root hash conflicts with other root chain",
+		"root", msg.RootChainType,
+		"startBlock", msg.StartBlock,
+		"endBlock", msg.EndBlock,
+		"rootHash", msg.RootHash,
+		"conflictRoot", otherChain,
+		"conflictNumber", number,
+		"conflictRootHash", other.RootHash,
+	)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	result := common.ErrCheckpointConflict(k.Codespace(), otherChain, number).Result()
+	result.Events = sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCheckpointConflict,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()),
+			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
+			sdk.NewAttribute(types.AttributeKeyConflictRootChain, otherChain),
+			sdk.NewAttribute(types.AttributeKeyConflictHeaderIndex, strconv.FormatUint(number, 10)),
+			sdk.NewAttribute(types.AttributeKeyConflictRootHash, other.RootHash.String()),
+		),
+	}
+
+	return result, true
+}
+
 // PostHandleMsgCheckpointAck handles msg checkpoint ack
 func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck, sideTxResult abci.SideTxResultType) sdk.Result {
 	logger := k.Logger(ctx)
@@ -391,6 +483,13 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
 	}
 
+	// acks of several validators might be included in same block, first one wins
+	if k.IsCheckpointAcked(ctx, msg.Number, msg.RootChainType) {
+		logger.Debug("Skipping checkpoint-ack since checkpoint is already acked",
+			"checkpointNumber", msg.Number, "root", msg.RootChainType)
+		return common.ErrAckAlreadySubmitted(k.Codespace(), msg.RootChainType, msg.Number).Result()
+	}
+
 	// get last checkpoint from buffer
 	checkpointObj, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
 	if err != nil {
@@ -421,13 +520,23 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		return common.ErrBadAck(k.Codespace()).Result()
 	}
 
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
 	// adjust checkpoint data if latest checkpoint is already submitted
 	if checkpointObj.EndBlock > msg.EndBlock {
 		logger.Info("Adjusting endBlock to one already submitted on chain",
 			"endBlock", checkpointObj.EndBlock, "adjustedEndBlock", msg.EndBlock, "root", msg.RootChainType)
+
+		prev := *checkpointObj
 		checkpointObj.EndBlock = msg.EndBlock
 		checkpointObj.RootHash = msg.RootHash
 		checkpointObj.Proposer = msg.Proposer
+
+		adjustment := types.NewCheckpointAdjustment(msg.Number, msg.RootChainType, prev, *checkpointObj, msg.From, ctx.BlockHeight(), hmTypes.BytesToHeimdallHash(hash))
+		k.AddCheckpointAdjustment(ctx, adjustment)
+		emitCheckpointAdjustEvent(ctx, msg.Type(), sideTxResult, adjustment)
 	}
 
 	//
@@ -442,11 +551,23 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	}
 	logger.Debug("Checkpoint added to store", "checkpointNumber", msg.Number, "root", msg.RootChainType)
 
-	// Flush buffer
+	// record ack latency of checkpoint, slow acks emit sla breach event
+	k.RecordAckTiming(ctx, msg.RootChainType, msg.Number, *checkpointObj)
+
+	// keep validator set which signed checkpoint, before acked stake updates are applied
+	if err := k.sk.SnapshotValidatorSet(ctx, msg.Number, msg.RootChainType); err != nil {
+		logger.Error("Error while recording validator set of checkpoint", "checkpointNumber", msg.Number, "root", msg.RootChainType, "error", err)
+	}
+
+	// reward validators which signed acked checkpoint
+	k.DistributeSignerReward(ctx, msg.RootChainType, *checkpointObj)
+
+	// Pop acked checkpoint from buffer, next queued one becomes buffer head
 	k.UpdateACKCount(ctx, msg.RootChainType)
-	k.FlushCheckpointBuffer(ctx, msg.RootChainType)
+	k.FlushBufferExpiry(ctx, msg.RootChainType)
+	next := k.PopCheckpointBuffer(ctx, msg.RootChainType, checkpointObj.EndBlock)
 
-	logger.Debug("Checkpoint buffer flushed after receiving checkpoint ack", "root", msg.RootChainType)
+	logger.Debug("Checkpoint buffer popped after receiving checkpoint ack", "root", msg.RootChainType, "promoted", next != nil)
 
 	// Update ack count in staking module
 	logger.Info("Valid ack received",
@@ -455,13 +576,15 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		"root", msg.RootChainType)
 
 	if msg.RootChainType == hmTypes.RootChainTypeStake {
-		// Increment accum (selects new proposer)
-		k.sk.IncrementAccum(ctx, 1)
-	}
+		// apply stake updates batched during epoch, validator set changes are picked up at end of block
+		k.sk.ApplyPendingStakeUpdates(ctx)
 
-	// TX bytes
-	txBytes := ctx.TxBytes()
-	hash := tmTypes.Tx(txBytes).Hash()
+		// old signers stay active until checkpoint they might have signed is acked
+		k.sk.ApplyPendingSignerRotations(ctx, true)
+
+		// Increment accum (selects new live proposer)
+		k.RotateProposer(ctx)
+	}
 
 	// Emit event for checkpoints
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -481,6 +604,88 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	}
 }
 
+// PostHandleMsgCheckpointAdjust rewrites stored checkpoint with data from root chain contract
+func PostHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAdjust, sideTxResult abci.SideTxResultType) sdk.Result {
+	logger := k.Logger(ctx)
+
+	// Skip handler if checkpoint-adjust is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		logger.Debug("Skipping checkpoint-adjust since side-tx didn't get yes votes",
+			"checkpointNumber", msg.Number, "root", msg.RootChainType)
+		return common.ErrBadBlockDetails(k.Codespace()).Result()
+	}
+
+	// state might have changed since msg was handled
+	if err := validateCheckpointAdjust(ctx, k, msg); err != nil {
+		logger.Error("Invalid checkpoint adjust", "error", err, "root", msg.RootChainType, "number", msg.Number)
+		return err.Result()
+	}
+
+	prev, err := k.GetCheckpointByNumber(ctx, msg.Number, msg.RootChainType)
+	if err != nil {
+		return common.ErrNoCheckpointFound(k.Codespace()).Result()
+	}
+
+	checkpoint := prev
+	checkpoint.EndBlock = msg.EndBlock
+	checkpoint.RootHash = msg.RootHash
+	checkpoint.Proposer = msg.Proposer
+
+	if err := k.AddCheckpoint(ctx, msg.Number, checkpoint, msg.RootChainType); err != nil {
+		logger.Error("Error while adjusting checkpoint in store", "checkpointNumber", msg.Number, "root", msg.RootChainType)
+		return sdk.ErrInternal("Failed to adjust checkpoint in store").Result()
+	}
+
+	// buffered checkpoint doesn't follow adjusted one and can never be acked
+	if buffer, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType); err == nil && buffer.StartBlock != checkpoint.EndBlock+1 {
+		logger.Info("Flushing checkpoint buffer not continuous with adjusted checkpoint",
+			"bufferStart", buffer.StartBlock, "adjustedEnd", checkpoint.EndBlock, "root", msg.RootChainType)
+		k.FlushCheckpointBuffer(ctx, msg.RootChainType)
+	}
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	adjustment := types.NewCheckpointAdjustment(msg.Number, msg.RootChainType, prev, checkpoint, msg.From, ctx.BlockHeight(), hmTypes.BytesToHeimdallHash(hash))
+	k.AddCheckpointAdjustment(ctx, adjustment)
+
+	logger.Info("Checkpoint adjusted to root chain state",
+		"checkpointNumber", msg.Number,
+		"root", msg.RootChainType,
+		"prevEndBlock", prev.EndBlock,
+		"endBlock", checkpoint.EndBlock,
+	)
+
+	emitCheckpointAdjustEvent(ctx, msg.Type(), sideTxResult, adjustment)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// emitCheckpointAdjustEvent emits event for checkpoint adjustment
+func emitCheckpointAdjustEvent(ctx sdk.Context, action string, sideTxResult abci.SideTxResultType, adjustment types.CheckpointAdjustment) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCheckpointAdjust,
+			sdk.NewAttribute(sdk.AttributeKeyAction, action),                          // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),    // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, adjustment.TxHash.Hex()),     // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()), // result
+			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(adjustment.Number, 10)),
+			sdk.NewAttribute(types.AttributeKeyRootChain, adjustment.RootChain),
+			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(adjustment.StartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(adjustment.EndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyPrevEndBlock, strconv.FormatUint(adjustment.PrevEndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyRootHash, adjustment.RootHash.Hex()),
+			sdk.NewAttribute(types.AttributeKeyPrevRootHash, adjustment.PrevRootHash.Hex()),
+			sdk.NewAttribute(types.AttributeKeyProposer, adjustment.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyPrevProposer, adjustment.PrevProposer.String()),
+		),
+	})
+}
+
 // PostHandleMsgCheckpointSync handles msg checkpoint
 func PostHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpointSync, sideTxResult abci.SideTxResultType) sdk.Result {
 	logger := k.Logger(ctx)
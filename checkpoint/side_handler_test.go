@@ -1,13 +1,16 @@
 package checkpoint_test
 
 import (
+	"errors"
 	"math/big"
 	"math/rand"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
@@ -158,6 +161,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 	start := uint64(0)
 	maxSize := uint64(256)
 	params := keeper.GetParams(ctx)
+	childBlockInterval := app.ChainKeeper.GetChildBlockInterval(ctx, hmTypes.RootChainTypeEth)
 
 	header, _ := chSim.GenRandCheckpoint(start, maxSize, params.MaxCheckpointLength)
 	headerId := uint64(1)
@@ -179,7 +183,9 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 		rootchainInstance := &rootchain.Rootchain{}
 
 		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
-		suite.contractCaller.On("GetHeaderInfo", headerId, rootchainInstance, params.ChildBlockInterval).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+		suite.contractCaller.On("GetMainTxReceipt", mock.Anything, hmTypes.RootChainTypeEth).Return(&ethTypes.Receipt{BlockNumber: big.NewInt(10)}, nil)
+		suite.contractCaller.On("GetFinalizedCallOpts", hmTypes.RootChainTypeEth).Return(nil, nil)
+		suite.contractCaller.On("GetHeaderInfoAt", headerId, rootchainInstance, childBlockInterval, uint64(10)).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
 
 		result := suite.sideHandler(ctx, msgCheckpointAck)
 		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
@@ -204,12 +210,196 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 		rootchainInstance := &rootchain.Rootchain{}
 
 		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
-		suite.contractCaller.On("GetHeaderInfo", headerId, rootchainInstance, params.ChildBlockInterval).Return(nil, header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+		suite.contractCaller.On("GetMainTxReceipt", mock.Anything, hmTypes.RootChainTypeEth).Return(&ethTypes.Receipt{BlockNumber: big.NewInt(10)}, nil)
+		suite.contractCaller.On("GetFinalizedCallOpts", hmTypes.RootChainTypeEth).Return(nil, nil)
+		suite.contractCaller.On("GetHeaderInfoAt", headerId, rootchainInstance, childBlockInterval, uint64(10)).Return(nil, header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
 
 		result := suite.sideHandler(ctx, msgCheckpointAck)
 		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
 		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
 	})
+
+	suite.Run("Not Finalized", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		msgCheckpointAck := types.NewMsgCheckpointAck(
+			hmTypes.HexToHeimdallAddress("123"),
+			uint64(1),
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			hmTypes.HexToHeimdallHash("123123"),
+			uint64(1),
+			hmTypes.RootChainTypeEth,
+		)
+
+		suite.contractCaller.
+			ExpectMainTxReceipt(hmTypes.RootChainTypeEth, 10).
+			ExpectFinalizedBlock(hmTypes.RootChainTypeEth, 9)
+
+		result := suite.sideHandler(ctx, msgCheckpointAck)
+		require.Equal(t, uint32(common.CodeInvalidACK), result.Code)
+		suite.contractCaller.AssertNotCalled(t, "GetHeaderInfoAt", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	suite.Run("No Receipt", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		msgCheckpointAck := types.NewMsgCheckpointAck(
+			hmTypes.HexToHeimdallAddress("123"),
+			uint64(1),
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			hmTypes.HexToHeimdallHash("123123"),
+			uint64(1),
+			hmTypes.RootChainTypeEth,
+		)
+
+		suite.contractCaller.On("GetMainTxReceipt", mock.Anything, hmTypes.RootChainTypeEth).Return(nil, errors.New("not found"))
+
+		result := suite.sideHandler(ctx, msgCheckpointAck)
+		require.Equal(t, uint32(common.CodeInvalidACK), result.Code)
+		suite.contractCaller.AssertNotCalled(t, "GetHeaderInfoAt", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAckChainParamsChanged() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	params := keeper.GetParams(ctx)
+	childBlockInterval := app.ChainKeeper.GetChildBlockInterval(ctx, hmTypes.RootChainTypeEth)
+
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+
+	// checkpoint is buffered while old root chain contract is in effect
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	oldRootChain := hmTypes.HexToHeimdallAddress("0x1111")
+	chainParams.ChainParams.RootChainAddress = oldRootChain
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	ctx = ctx.WithBlockHeight(10)
+	app.ChainKeeper.SnapshotChainParams(ctx)
+	require.NoError(t, keeper.PushCheckpointBuffer(ctx, header, hmTypes.RootChainTypeEth))
+
+	height, ok := keeper.GetCheckpointBufferHeight(ctx, hmTypes.RootChainTypeEth, header.StartBlock)
+	require.True(t, ok)
+	require.Equal(t, int64(10), height)
+
+	// root chain contract changes before ack
+	chainParams.ChainParams.RootChainAddress = hmTypes.HexToHeimdallAddress("0x2222")
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	ctx = ctx.WithBlockHeight(15)
+	app.ChainKeeper.SnapshotChainParams(ctx)
+
+	ctx = ctx.WithBlockHeight(20)
+
+	msgCheckpointAck := types.NewMsgCheckpointAck(
+		hmTypes.HexToHeimdallAddress("123"),
+		uint64(1),
+		header.Proposer,
+		header.StartBlock,
+		header.EndBlock,
+		header.RootHash,
+		hmTypes.HexToHeimdallHash("123123"),
+		uint64(1),
+		hmTypes.RootChainTypeEth,
+	)
+	rootchainInstance := &rootchain.Rootchain{}
+
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.contractCaller.On("GetRootChainInstance", oldRootChain.EthAddress(), hmTypes.RootChainTypeEth).Return(rootchainInstance, nil)
+	suite.contractCaller.On("GetMainTxReceipt", mock.Anything, hmTypes.RootChainTypeEth).Return(&ethTypes.Receipt{BlockNumber: big.NewInt(10)}, nil)
+	suite.contractCaller.On("GetFinalizedCallOpts", hmTypes.RootChainTypeEth).Return(nil, nil)
+	suite.contractCaller.On("GetHeaderInfoAt", uint64(1), rootchainInstance, childBlockInterval, uint64(10)).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+
+	result := suite.sideHandler(ctx, msgCheckpointAck)
+	require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+	require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+
+	// buffer height is dropped with buffer
+	keeper.FlushCheckpointBuffer(ctx, hmTypes.RootChainTypeEth)
+	_, ok = keeper.GetCheckpointBufferHeight(ctx, hmTypes.RootChainTypeEth, header.StartBlock)
+	require.False(t, ok)
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAckFakeRootChain() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	params := keeper.GetParams(ctx)
+
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+
+	rootChain := mocks.NewFakeRootChain()
+	sideHandler := checkpoint.NewSideTxHandler(keeper, rootChain)
+
+	info := mocks.NewHeaderInfo(header.RootHash, header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer)
+	txHash := rootChain.SubmitCheckpoint(hmTypes.RootChainTypeEth, 1, info)
+
+	newAck := func(number uint64, txHash hmTypes.HeimdallHash) types.MsgCheckpointAck {
+		return types.NewMsgCheckpointAck(
+			hmTypes.HexToHeimdallAddress("123"),
+			number,
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			txHash,
+			uint64(1),
+			hmTypes.RootChainTypeEth,
+		)
+	}
+
+	suite.Run("Success", func() {
+		result := sideHandler(ctx, newAck(1, hmTypes.BytesToHeimdallHash(txHash.Bytes())))
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	suite.Run("Not submitted as of tx block", func() {
+		laterTxHash := rootChain.SubmitCheckpoint(hmTypes.RootChainTypeEth, 2, info)
+		require.NotEqual(t, txHash, laterTxHash)
+
+		result := sideHandler(ctx, newAck(2, hmTypes.BytesToHeimdallHash(txHash.Bytes())))
+		require.Equal(t, uint32(common.CodeInvalidACK), result.Code)
+
+		result = sideHandler(ctx, newAck(2, hmTypes.BytesToHeimdallHash(laterTxHash.Bytes())))
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	suite.Run("Unknown tx", func() {
+		result := sideHandler(ctx, newAck(1, hmTypes.HexToHeimdallHash("123123")))
+		require.Equal(t, uint32(common.CodeInvalidACK), result.Code)
+	})
+
+	suite.Run("Not finalized", func() {
+		rootChain.Finalize(hmTypes.RootChainTypeEth)
+		laterTxHash := rootChain.SubmitCheckpoint(hmTypes.RootChainTypeEth, 3, info)
+
+		result := sideHandler(ctx, newAck(3, hmTypes.BytesToHeimdallHash(laterTxHash.Bytes())))
+		require.Equal(t, uint32(common.CodeInvalidACK), result.Code)
+
+		rootChain.Finalize(hmTypes.RootChainTypeEth)
+		result = sideHandler(ctx, newAck(3, hmTypes.BytesToHeimdallHash(laterTxHash.Bytes())))
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	suite.Run("Header info error", func() {
+		contractCaller := mocks.IContractCaller{}
+		contractCaller.
+			ExpectRootChainInstance(hmTypes.RootChainTypeEth).
+			ExpectMainTxReceipt(hmTypes.RootChainTypeEth, 10).
+			ExpectNoFinalityTag(hmTypes.RootChainTypeEth).
+			ExpectHeaderInfoError(1, errors.New("header not found"))
+
+		result := checkpoint.NewSideTxHandler(keeper, &contractCaller)(ctx, newAck(1, hmTypes.HexToHeimdallHash("123123")))
+		require.Equal(t, uint32(common.CodeInvalidACK), result.Code)
+	})
 }
 
 func (suite *SideHandlerTestSuite) TestPostHandler() {
@@ -317,6 +507,173 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpoint() {
 	})
 }
 
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAggregation() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeEth
+
+	chSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
+	keeper.SetCheckpointAggregation(ctx, types.NewCheckpointAggregation(3, 2))
+	proposers := keeper.GetAggregationProposers(ctx)
+	require.Len(t, proposers, 3)
+
+	start := app.ChainKeeper.GetChainActivationHeight(ctx, rootChain)
+	header, err := chSim.GenRandCheckpoint(start, 256, keeper.GetParams(ctx).MaxCheckpointLength)
+	require.NoError(t, err)
+
+	newMsg := func(proposer hmTypes.HeimdallAddress) types.MsgCheckpoint {
+		return types.NewMsgCheckpointBlock(
+			proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			header.RootHash,
+			"1234",
+			uint64(1),
+			rootChain,
+		)
+	}
+
+	// first vote is recorded, range isn't buffered yet
+	result := suite.postHandler(ctx, newMsg(proposers[1].Signer), abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected vote to be ok, got %v", result)
+	_, err = keeper.GetCheckpointFromBuffer(ctx, rootChain)
+	require.Error(t, err)
+	require.Len(t, keeper.GetAggregationVotes(ctx, rootChain), 1)
+
+	// quorum buffers range with earliest vote's proposer
+	result = suite.postHandler(ctx, newMsg(proposers[2].Signer), abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected vote to be ok, got %v", result)
+	bufferedHeader, err := keeper.GetCheckpointFromBuffer(ctx, rootChain)
+	require.NoError(t, err)
+	require.Equal(t, header.StartBlock, bufferedHeader.StartBlock)
+	require.Equal(t, header.EndBlock, bufferedHeader.EndBlock)
+	require.Equal(t, header.RootHash, bufferedHeader.RootHash)
+	require.Equal(t, proposers[1].Signer, bufferedHeader.Proposer)
+	require.Empty(t, keeper.GetAggregationVotes(ctx, rootChain))
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointFailover() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeEth
+
+	chSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
+	keeper.SetCheckpointFailover(ctx, types.NewCheckpointFailover(2, 100*time.Second))
+
+	start := app.ChainKeeper.GetChainActivationHeight(ctx, rootChain)
+	header, err := chSim.GenRandCheckpoint(start, 256, keeper.GetParams(ctx).MaxCheckpointLength)
+	require.NoError(t, err)
+
+	expiry := types.BufferExpiry{
+		RootChain:  rootChain,
+		StartBlock: header.StartBlock,
+		EndBlock:   header.EndBlock,
+		ExpiredAt:  uint64(ctx.BlockTime().Unix()),
+	}
+	keeper.SetBufferExpiry(ctx, expiry)
+
+	backups := keeper.GetBackupProposers(ctx, expiry)
+	require.Len(t, backups, 2)
+	require.Equal(t, expiry.ExpiredAt+100, backups[1].WindowStart)
+
+	newMsg := func(proposer hmTypes.HeimdallAddress) types.MsgCheckpoint {
+		return types.NewMsgCheckpointBlock(
+			proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			header.RootHash,
+			"1234",
+			uint64(1),
+			rootChain,
+		)
+	}
+
+	suite.Run("Outside window", func() {
+		result := suite.postHandler(ctx, newMsg(backups[1].Validator.Signer), abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK(), "expected send-checkpoint to fail, got %v", result)
+		require.Equal(t, common.CodeInvalidMsg, result.Code)
+
+		_, err := keeper.GetCheckpointFromBuffer(ctx, rootChain)
+		require.Error(t, err)
+	})
+
+	suite.Run("In window", func() {
+		result := suite.postHandler(ctx, newMsg(backups[0].Validator.Signer), abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+		bufferedHeader, err := keeper.GetCheckpointFromBuffer(ctx, rootChain)
+		require.NoError(t, err)
+		require.Equal(t, backups[0].Validator.Signer, bufferedHeader.Proposer)
+	})
+
+	suite.Run("Flushed", func() {
+		keeper.FlushBufferExpiry(ctx, rootChain)
+		status := keeper.GetFailoverStatus(ctx, rootChain)
+		require.Nil(t, status.Expiry)
+		require.Empty(t, status.Backups)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointConflict() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	params := keeper.GetParams(ctx)
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	header, _ := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	header.Proposer = stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+
+	// same range acked on bsc with different root hash
+	bscHeader := header
+	bscHeader.RootHash = hmTypes.HexToHeimdallHash("456")
+	err := keeper.AddCheckpoint(ctx, 1, bscHeader, hmTypes.RootChainTypeBsc)
+	require.NoError(t, err)
+
+	suite.Run("Conflict", func() {
+		msgCheckpoint := types.NewMsgCheckpointBlock(
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			header.RootHash,
+			"1234",
+			uint64(1),
+			hmTypes.RootChainTypeEth,
+		)
+
+		result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK(), "expected send-checkpoint to fail, got %v", result)
+		require.Equal(t, common.CodeCheckpointConflict, result.Code)
+		require.Len(t, result.Events, 1)
+		require.Equal(t, types.EventTypeCheckpointConflict, result.Events[0].Type)
+
+		bufferedHeader, err := keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeEth)
+		require.Nil(t, bufferedHeader)
+		require.Error(t, err)
+	})
+
+	suite.Run("Match", func() {
+		msgCheckpoint := types.NewMsgCheckpointBlock(
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			bscHeader.RootHash,
+			bscHeader.RootHash,
+			"1234",
+			uint64(1),
+			hmTypes.RootChainTypeEth,
+		)
+
+		result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+	})
+}
+
 func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.CheckpointKeeper
@@ -401,7 +758,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 
 		result := suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
 		require.False(t, result.IsOK())
-		require.Equal(t, common.CodeInvalidACK, result.Code)
+		require.Equal(t, common.CodeAckAlreadySubmitted, result.Code)
 
 		afterAckBufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeEth)
 		require.Nil(t, afterAckBufferedCheckpoint)
@@ -426,7 +783,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 
 		msgCheckpointAck := types.NewMsgCheckpointAck(
 			hmTypes.HexToHeimdallAddress("123"),
-			checkpointNumber,
+			checkpointNumber+1,
 			header2.Proposer,
 			header2.StartBlock,
 			header2.EndBlock,
@@ -443,3 +800,244 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 		require.Nil(t, afterAckBufferedCheckpoint)
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAckSignerReward() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	header, _ := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	app.StakingKeeper.IncrementAccum(ctx, 1)
+
+	keeper.SetSignerReward(ctx, sdk.NewInt(1000))
+	require.True(t, keeper.GetSignerReward(ctx).Equal(sdk.NewInt(1000)))
+
+	// reward is paid out of fee collector
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
+	require.NoError(t, feeCollector.SetCoins(sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(1500))}))
+	app.SupplyKeeper.SetModuleAccount(ctx, feeCollector)
+	supply := app.SupplyKeeper.GetSupply(ctx)
+
+	// signers with power 1 and 2, no voter didn't sign
+	signer1 := hmTypes.HexToHeimdallAddress("0x01")
+	signer2 := hmTypes.HexToHeimdallAddress("0x02")
+	voters := []hmTypes.SideTxVoter{
+		{Address: signer1.Bytes(), Power: 1, Result: abci.SideTxResultType_Yes},
+		{Address: signer2.Bytes(), Power: 2, Result: abci.SideTxResultType_Yes},
+		{Address: hmTypes.HexToHeimdallAddress("0x03").Bytes(), Power: 5, Result: abci.SideTxResultType_No},
+	}
+
+	msgCheckpoint := types.NewMsgCheckpointBlock(
+		header.Proposer,
+		header.StartBlock,
+		header.EndBlock,
+		header.RootHash,
+		header.RootHash,
+		"1234",
+		1,
+		hmTypes.RootChainTypeEth,
+	)
+	result := suite.postHandler(hmTypes.WithSideTxVoters(ctx, voters), msgCheckpoint, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+	signers, ok := keeper.GetCheckpointSigners(ctx, hmTypes.RootChainTypeEth, header.StartBlock)
+	require.True(t, ok)
+	require.Len(t, signers.Signers, 2)
+
+	msgCheckpointAck := types.NewMsgCheckpointAck(
+		hmTypes.HexToHeimdallAddress("123"),
+		1,
+		header.Proposer,
+		header.StartBlock,
+		header.EndBlock,
+		header.RootHash,
+		hmTypes.HexToHeimdallHash("123123"),
+		uint64(1),
+		hmTypes.RootChainTypeEth,
+	)
+	result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+
+	// 1000 split 1:2, remainder goes to first signer
+	account1, err := app.TopupKeeper.GetDividendAccountByAddress(ctx, signer1)
+	require.NoError(t, err)
+	require.Equal(t, "334", account1.FeeAmount)
+
+	account2, err := app.TopupKeeper.GetDividendAccountByAddress(ctx, signer2)
+	require.NoError(t, err)
+	require.Equal(t, "666", account2.FeeAmount)
+
+	require.False(t, app.TopupKeeper.CheckIfDividendAccountExists(ctx, hmTypes.HexToHeimdallAddress("0x03")))
+
+	// credited reward is debited from fee collector, nothing is minted
+	feeBalance := app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName).GetCoins().AmountOf(authTypes.FeeToken)
+	require.True(t, feeBalance.Equal(sdk.NewInt(500)), "expected fee collector balance 500, got %v", feeBalance)
+	require.Equal(t, supply, app.SupplyKeeper.GetSupply(ctx))
+
+	_, ok = keeper.GetCheckpointSigners(ctx, hmTypes.RootChainTypeEth, header.StartBlock)
+	require.False(t, ok, "signers should be removed once rewarded")
+}
+
+func (suite *SideHandlerTestSuite) TestDistributeSignerRewardInsufficientFees() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeEth
+
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 0)
+	signer1 := hmTypes.HexToHeimdallAddress("0x01")
+	signer2 := hmTypes.HexToHeimdallAddress("0x02")
+	keeper.SetCheckpointSigners(ctx, types.CheckpointSigners{
+		RootChain:  rootChain,
+		StartBlock: checkpoint.StartBlock,
+		EndBlock:   checkpoint.EndBlock,
+		RootHash:   checkpoint.RootHash,
+		Signers: []types.CheckpointSigner{
+			{Signer: signer1, Power: 1},
+			{Signer: signer2, Power: 2},
+		},
+	})
+	keeper.SetSignerReward(ctx, sdk.NewInt(1000))
+
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
+	require.NoError(t, feeCollector.SetCoins(sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(300))}))
+	app.SupplyKeeper.SetModuleAccount(ctx, feeCollector)
+	supply := app.SupplyKeeper.GetSupply(ctx)
+
+	// reward is capped at fee collector balance
+	keeper.DistributeSignerReward(ctx, rootChain, checkpoint)
+
+	account1, err := app.TopupKeeper.GetDividendAccountByAddress(ctx, signer1)
+	require.NoError(t, err)
+	require.Equal(t, "100", account1.FeeAmount)
+
+	account2, err := app.TopupKeeper.GetDividendAccountByAddress(ctx, signer2)
+	require.NoError(t, err)
+	require.Equal(t, "200", account2.FeeAmount)
+
+	require.True(t, app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName).GetCoins().AmountOf(authTypes.FeeToken).IsZero())
+	require.Equal(t, supply, app.SupplyKeeper.GetSupply(ctx))
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAdjust() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	params := keeper.GetParams(ctx)
+	childBlockInterval := app.ChainKeeper.GetChildBlockInterval(ctx, hmTypes.RootChainTypeEth)
+
+	header, _ := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	headerId := uint64(1)
+	rootchainInstance := &rootchain.Rootchain{}
+
+	suite.Run("Success", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		msg := types.NewMsgCheckpointAdjust(hmTypes.HexToHeimdallAddress("123"), headerId, header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, hmTypes.RootChainTypeEth)
+
+		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
+		suite.contractCaller.On("GetHeaderInfoAt", headerId, rootchainInstance, childBlockInterval, uint64(0)).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	suite.Run("Mismatch", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		msg := types.NewMsgCheckpointAdjust(hmTypes.HexToHeimdallAddress("123"), headerId, header.Proposer, header.StartBlock, header.EndBlock-1, header.RootHash, hmTypes.RootChainTypeEth)
+
+		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
+		suite.contractCaller.On("GetHeaderInfoAt", headerId, rootchainInstance, childBlockInterval, uint64(0)).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(common.CodeInvalidBlockInput), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAdjust() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	params := keeper.GetParams(ctx)
+
+	header, _ := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, header, hmTypes.RootChainTypeEth))
+	keeper.UpdateACKCountWithValue(ctx, 1, hmTypes.RootChainTypeEth)
+
+	// buffered checkpoint following stored one
+	buffered, _ := chSim.GenRandCheckpoint(header.EndBlock+1, 256, params.MaxCheckpointLength)
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, buffered, hmTypes.RootChainTypeEth))
+
+	from := hmTypes.HexToHeimdallAddress("123")
+	rootHash := hmTypes.HexToHeimdallHash("456")
+	msg := types.NewMsgCheckpointAdjust(from, 1, header.Proposer, header.StartBlock, header.EndBlock-1, rootHash, hmTypes.RootChainTypeEth)
+
+	suite.Run("Failure", func() {
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_No)
+		require.False(t, result.IsOK())
+
+		checkpoint, err := keeper.GetCheckpointByNumber(ctx, 1, hmTypes.RootChainTypeEth)
+		require.NoError(t, err)
+		require.Equal(t, header.EndBlock, checkpoint.EndBlock)
+	})
+
+	suite.Run("Success", func() {
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected checkpoint-adjust to be ok, got %v", result)
+
+		checkpoint, err := keeper.GetCheckpointByNumber(ctx, 1, hmTypes.RootChainTypeEth)
+		require.NoError(t, err)
+		require.Equal(t, header.EndBlock-1, checkpoint.EndBlock)
+		require.Equal(t, rootHash, checkpoint.RootHash)
+
+		adjustments := keeper.GetCheckpointAdjustments(ctx, hmTypes.RootChainTypeEth, 1)
+		require.Len(t, adjustments, 1)
+		require.Equal(t, header.EndBlock, adjustments[0].PrevEndBlock)
+		require.Equal(t, header.RootHash, adjustments[0].PrevRootHash)
+		require.Equal(t, from, adjustments[0].AdjustedBy)
+
+		// buffer no longer follows stored checkpoint
+		bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeEth)
+		require.Nil(t, bufferedCheckpoint)
+	})
+
+	suite.Run("Replay", func() {
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK())
+	})
+
+	suite.Run("NotLatest", func() {
+		msg := types.NewMsgCheckpointAdjust(from, 2, header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, hmTypes.RootChainTypeEth)
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK())
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestRootChainVerifier() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	_, err := keeper.GetRootChainVerifier(ctx, "unknown", &suite.contractCaller)
+	require.Error(t, err, "unknown root chain should have no verifier")
+
+	verifier, err := keeper.GetRootChainVerifier(ctx, hmTypes.RootChainTypeTron, &suite.contractCaller)
+	require.NoError(t, err)
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	suite.contractCaller.On("GetTronHeaderInfo", uint64(1), mock.Anything, mock.Anything).
+		Return(rootHash.EthHash(), uint64(0), uint64(255), uint64(0), proposer, nil)
+
+	header, err := verifier.GetHeader(1)
+	require.NoError(t, err)
+	require.Equal(t, rootHash, header.RootHash)
+
+	require.NoError(t, checkpoint.VerifyRootChainHeader(header, 0, 255, proposer, &rootHash))
+	require.NoError(t, checkpoint.VerifyRootChainHeader(header, 0, 255, proposer, nil))
+	require.Error(t, checkpoint.VerifyRootChainHeader(header, 0, 256, proposer, nil))
+	require.Error(t, checkpoint.VerifyRootChainHeader(header, 0, 255, hmTypes.HexToHeimdallAddress("456"), nil))
+
+	otherRoot := hmTypes.HexToHeimdallHash("456")
+	require.Error(t, checkpoint.VerifyRootChainHeader(header, 0, 255, proposer, &otherRoot))
+}
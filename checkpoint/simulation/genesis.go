@@ -20,7 +20,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	timestamp := uint64(time.Now().Unix())
 	borChainID := "1234"
 
-	bufferedCheckpoint := hmTypes.CreateBlock(
+	ackedCheckpoint := hmTypes.CreateBlock(
 		startBlock,
 		endBlock,
 		rootHash,
@@ -32,18 +32,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 	Checkpoints := make([]hmTypes.Checkpoint, ackCount)
 
 	for i := range Checkpoints {
-		Checkpoints[i] = bufferedCheckpoint
+		Checkpoints[i] = ackedCheckpoint
 	}
 
 	params := types.DefaultParams()
 	genesisState := types.NewGenesisState(
 		params,
-		&bufferedCheckpoint,
+		nil,
 		uint64(lastNoACK),
 		uint64(ackCount),
 		Checkpoints,
 		uint64(ackCount),
 		Checkpoints,
+		nil,
 	)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
 
@@ -0,0 +1,164 @@
+package simulation
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/crypto"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/simulation"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/module"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCheckpoint    = "op_weight_msg_checkpoint"
+	OpWeightMsgCheckpointAck = "op_weight_msg_checkpoint_ack"
+
+	DefaultWeightMsgCheckpoint    = 40
+	DefaultWeightMsgCheckpointAck = 30
+)
+
+// root chains checkpoints are simulated on
+var simRootChains = []string{hmTypes.RootChainTypeTron, hmTypes.RootChainTypeEth}
+
+// Keeper checkpoint keeper methods used by simulation operations
+type Keeper interface {
+	GetLastCheckpoint(ctx sdk.Context, rootChain string) (hmTypes.Checkpoint, error)
+	GetCheckpointBufferQueue(ctx sdk.Context, rootChain string) types.CheckpointBufferQueue
+	GetACKCount(ctx sdk.Context, rootChain string) uint64
+	GetAccountRootHash(ctx sdk.Context) ([]byte, error)
+	GetParams(ctx sdk.Context) types.Params
+}
+
+// StakingKeeper staking keeper methods used by simulation operations
+type StakingKeeper interface {
+	GetValidatorSet(ctx sdk.Context) hmTypes.ValidatorSet
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	simState module.SimulationState,
+	k Keeper,
+	sk StakingKeeper,
+	handler sdk.Handler,
+	postHandler hmTypes.PostTxHandler,
+) []simTypes.WeightedOperation {
+	var weightMsgCheckpoint, weightMsgCheckpointAck int
+	simState.AppParams.GetOrGenerate(simState.Cdc, OpWeightMsgCheckpoint, &weightMsgCheckpoint, nil,
+		func(_ *rand.Rand) { weightMsgCheckpoint = DefaultWeightMsgCheckpoint })
+	simState.AppParams.GetOrGenerate(simState.Cdc, OpWeightMsgCheckpointAck, &weightMsgCheckpointAck, nil,
+		func(_ *rand.Rand) { weightMsgCheckpointAck = DefaultWeightMsgCheckpointAck })
+
+	return []simTypes.WeightedOperation{
+		simulation.NewWeightedOperation(weightMsgCheckpoint, SimulateMsgCheckpoint(k, sk, handler, postHandler)),
+		simulation.NewWeightedOperation(weightMsgCheckpointAck, SimulateMsgCheckpointAck(k, handler, postHandler)),
+	}
+}
+
+// SimulateMsgCheckpoint proposes next checkpoint of random root chain from current proposer
+func SimulateMsgCheckpoint(k Keeper, sk StakingKeeper, handler sdk.Handler, postHandler hmTypes.PostTxHandler) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+		rootChain := simRootChains[r.Intn(len(simRootChains))]
+
+		queue := k.GetCheckpointBufferQueue(ctx, rootChain)
+		if queue.IsFull() {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// next checkpoint follows buffer tail or last acked checkpoint
+		last, err := k.GetLastCheckpoint(ctx, rootChain)
+		if tail, ok := queue.Tail(); ok {
+			last, err = tail, nil
+		}
+		if err != nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		validatorSet := sk.GetValidatorSet(ctx)
+		if validatorSet.Proposer == nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		accountRootHash, err := k.GetAccountRootHash(ctx)
+		if err != nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		params := k.GetParams(ctx)
+		start := last.EndBlock + 1
+		end := start + uint64(r.Int63n(int64(params.MaxCheckpointLength)))
+
+		msg := types.NewMsgCheckpointBlock(
+			validatorSet.Proposer.Signer,
+			start,
+			end,
+			rangeRootHash(start, end),
+			hmTypes.BytesToHeimdallHash(accountRootHash),
+			last.BorChainID,
+			k.GetACKCount(ctx, hmTypes.RootChainTypeStake)+1,
+			rootChain,
+		)
+
+		sideTxResult, res := simulation.DeliverSideTx(r, ctx, handler, postHandler, msg)
+		return simTypes.NewOperationMsg(msg, res.IsOK(), fmt.Sprintf("%v %v", rootChain, sideTxResult)), nil, nil
+	}
+}
+
+// SimulateMsgCheckpointAck acks checkpoint in buffer of random root chain, end block is
+// adjusted now and then as if shorter checkpoint was submitted on root chain
+func SimulateMsgCheckpointAck(k Keeper, handler sdk.Handler, postHandler hmTypes.PostTxHandler) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+		rootChain := simRootChains[r.Intn(len(simRootChains))]
+
+		queue := k.GetCheckpointBufferQueue(ctx, rootChain)
+		if len(queue.Checkpoints) == 0 {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		head := queue.Checkpoints[0]
+		end, rootHash := head.EndBlock, head.RootHash
+		if end > head.StartBlock && r.Intn(10) == 0 {
+			end = head.StartBlock + uint64(r.Int63n(int64(end-head.StartBlock)))
+			rootHash = rangeRootHash(head.StartBlock, end)
+		}
+
+		sender, _ := simTypes.RandomAcc(r, accs)
+		msg := types.NewMsgCheckpointAck(
+			hmTypes.BytesToHeimdallAddress(sender.Address.Bytes()),
+			k.GetACKCount(ctx, rootChain)+1,
+			head.Proposer,
+			head.StartBlock,
+			end,
+			rootHash,
+			randomHash(r),
+			uint64(r.Intn(100)),
+			rootChain,
+		)
+
+		sideTxResult, res := simulation.DeliverSideTx(r, ctx, handler, postHandler, msg)
+		return simTypes.NewOperationMsg(msg, res.IsOK(), fmt.Sprintf("%v %v", rootChain, sideTxResult)), nil, nil
+	}
+}
+
+// randomHash returns random hash drawn from simulation source
+func randomHash(r *rand.Rand) hmTypes.HeimdallHash {
+	b := make([]byte, 32)
+	r.Read(b)
+	return hmTypes.BytesToHeimdallHash(b)
+}
+
+// rangeRootHash returns deterministic root hash of bor block range, so checkpoints
+// of the same range never conflict across root chains
+func rangeRootHash(start, end uint64) hmTypes.HeimdallHash {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b[:8], start)
+	binary.BigEndian.PutUint64(b[8:], end)
+	return hmTypes.BytesToHeimdallHash(crypto.Keccak256(b))
+}
@@ -0,0 +1,167 @@
+package checkpoint
+
+import (
+	"bytes"
+	"strconv"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+// statsWindow window of recent checkpoint count
+const statsWindow = 24 * time.Hour
+
+// statsCache keeps checkpoint stats of last queried store version, so dashboards polling
+// stats don't recompute them more than once per block
+type statsCache struct {
+	mu          sync.Mutex
+	height      int64 // store version stats were computed at
+	blockHeight int64 // height of block which time was used
+	stats       *types.CheckpointStats
+}
+
+// SetNoAckCount sets number of accepted no-acks
+func (k *Keeper) SetNoAckCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(NoACKCountKey, []byte(strconv.FormatUint(count, 10)))
+}
+
+// GetNoAckCount returns number of accepted no-acks
+func (k *Keeper) GetNoAckCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(NoACKCountKey) {
+		return 0
+	}
+
+	count, err := strconv.ParseUint(string(store.Get(NoACKCountKey)), 10, 64)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to parse no-ack count", "error", err)
+		return 0
+	}
+	return count
+}
+
+// GetCheckpointStats returns checkpoint stats of store version at height. Stats are cached,
+// repeated queries of same version in same block are served from cache.
+func (k *Keeper) GetCheckpointStats(ctx sdk.Context, height int64) types.CheckpointStats {
+	if k.statsCache == nil {
+		return k.ComputeCheckpointStats(ctx, height)
+	}
+
+	k.statsCache.mu.Lock()
+	defer k.statsCache.mu.Unlock()
+
+	if k.statsCache.stats != nil && k.statsCache.height == height && k.statsCache.blockHeight == ctx.BlockHeight() {
+		return *k.statsCache.stats
+	}
+
+	stats := k.ComputeCheckpointStats(ctx, height)
+	k.statsCache.height = height
+	k.statsCache.blockHeight = ctx.BlockHeight()
+	k.statsCache.stats = &stats
+
+	return stats
+}
+
+// ComputeCheckpointStats aggregates acked checkpoints of all root chains. Averages use first and
+// latest checkpoint, recent count and proposer streak walk back from latest checkpoint only,
+// so cost doesn't grow with number of checkpoints.
+func (k *Keeper) ComputeCheckpointStats(ctx sdk.Context, height int64) types.CheckpointStats {
+	stats := types.CheckpointStats{
+		Height:     height,
+		NoAckCount: k.GetNoAckCount(ctx),
+		LastNoAck:  k.GetLastNoAck(ctx),
+		RootChains: []types.RootChainCheckpointStats{},
+	}
+
+	for _, rootChain := range chainmanagerTypes.SortedRootChains() {
+		if getCheckpointPrefix(rootChain) == nil {
+			continue
+		}
+		stats.RootChains = append(stats.RootChains, k.computeRootChainStats(ctx, rootChain))
+	}
+
+	return stats
+}
+
+// estimateBorBlockTime returns bor block time measured from acked checkpoints of root chain,
+// default block time while there are not enough checkpoints to measure it
+func (k *Keeper) estimateBorBlockTime(ctx sdk.Context, rootChain string) time.Duration {
+	total := k.GetACKCount(ctx, rootChain)
+	if total < 2 {
+		return types.DefaultBorBlockTime
+	}
+
+	first, err := k.GetCheckpointByNumber(ctx, 1, rootChain)
+	if err != nil {
+		return types.DefaultBorBlockTime
+	}
+
+	last, err := k.GetCheckpointByNumber(ctx, total, rootChain)
+	if err != nil || last.TimeStamp <= first.TimeStamp || last.EndBlock < first.StartBlock {
+		return types.DefaultBorBlockTime
+	}
+
+	avgInterval := (last.TimeStamp - first.TimeStamp) / (total - 1)
+	avgBlocks := (last.EndBlock - first.StartBlock + 1) / total
+	if avgInterval == 0 || avgBlocks == 0 {
+		return types.DefaultBorBlockTime
+	}
+
+	return time.Duration(avgInterval) * time.Second / time.Duration(avgBlocks)
+}
+
+func (k *Keeper) computeRootChainStats(ctx sdk.Context, rootChain string) types.RootChainCheckpointStats {
+	stats := types.RootChainCheckpointStats{
+		RootChain:        rootChain,
+		TotalCheckpoints: k.GetACKCount(ctx, rootChain),
+	}
+
+	if stats.TotalCheckpoints == 0 {
+		return stats
+	}
+
+	last, err := k.GetCheckpointByNumber(ctx, stats.TotalCheckpoints, rootChain)
+	if err != nil {
+		return stats
+	}
+
+	if first, err := k.GetCheckpointByNumber(ctx, 1, rootChain); err == nil {
+		if stats.TotalCheckpoints > 1 && last.TimeStamp > first.TimeStamp {
+			stats.AvgInterval = (last.TimeStamp - first.TimeStamp) / (stats.TotalCheckpoints - 1)
+		}
+
+		// checkpoints of root chain cover contiguous bor blocks
+		if last.EndBlock >= first.StartBlock {
+			stats.AvgBlocksPerCheckpoint = (last.EndBlock - first.StartBlock + 1) / stats.TotalCheckpoints
+		}
+	}
+
+	stats.CurrentProposer = last.Proposer
+
+	// walk back from latest checkpoint until both recent window and proposer streak end
+	since := ctx.BlockTime().Add(-statsWindow).Unix()
+	recent, streak := true, true
+	for number := stats.TotalCheckpoints; number > 0 && (recent || streak); number-- {
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number, rootChain)
+		if err != nil {
+			break
+		}
+
+		recent = recent && int64(checkpoint.TimeStamp) >= since
+		if recent {
+			stats.Last24hCount++
+		}
+
+		streak = streak && bytes.Equal(checkpoint.Proposer.Bytes(), last.Proposer.Bytes())
+		if streak {
+			stats.CurrentProposerStreak++
+		}
+	}
+
+	return stats
+}
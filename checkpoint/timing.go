@@ -0,0 +1,139 @@
+package checkpoint
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SetAckLatencySLA sets max latency of checkpoint ack, slower acks emit sla breach event
+func (k *Keeper) SetAckLatencySLA(ctx sdk.Context, sla time.Duration) {
+	k.paramSpace.Set(ctx, types.KeyAckLatencySLA, sla)
+}
+
+// GetAckLatencySLA returns ack latency sla, zero if it was never set
+func (k *Keeper) GetAckLatencySLA(ctx sdk.Context) (sla time.Duration) {
+	k.paramSpace.GetIfExists(ctx, types.KeyAckLatencySLA, &sla)
+	return sla
+}
+
+// GetCheckpointTimingKey returns key of timing record of checkpoint
+func GetCheckpointTimingKey(rootChain string, number uint64) []byte {
+	return append(append(TimingKey, hmTypes.GetRootChainID(rootChain)), sdk.Uint64ToBigEndian(number)...)
+}
+
+// GetCheckpointTiming returns timing record of checkpoint, false if none was recorded
+func (k *Keeper) GetCheckpointTiming(ctx sdk.Context, rootChain string, number uint64) (timing types.CheckpointTiming, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetCheckpointTimingKey(rootChain, number)
+	if !store.Has(key) {
+		return timing, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &timing)
+	return timing, true
+}
+
+// setCheckpointTiming stores timing record of checkpoint. Only latest MaxTimingQueryLimit checkpoints are
+// kept, once record of new checkpoint is written all records falling out of window are deleted.
+func (k *Keeper) setCheckpointTiming(ctx sdk.Context, timing types.CheckpointTiming) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetCheckpointTimingKey(timing.RootChain, timing.Number)
+	if !store.Has(key) && timing.Number > types.MaxTimingQueryLimit {
+		k.pruneCheckpointTimings(ctx, timing.RootChain, timing.Number-types.MaxTimingQueryLimit+1)
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(timing))
+}
+
+// pruneCheckpointTimings deletes timing records of root chain checkpoints below number
+func (k *Keeper) pruneCheckpointTimings(ctx sdk.Context, rootChain string, number uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(append(TimingKey, hmTypes.GetRootChainID(rootChain)), GetCheckpointTimingKey(rootChain, number))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RecordAckSubmission records time first ack of checkpoint reached heimdall, which is as close
+// to root chain submission as heimdall gets. Later acks of same checkpoint don't change it and
+// acks of any other than next checkpoint aren't recorded.
+func (k *Keeper) RecordAckSubmission(ctx sdk.Context, rootChain string, number uint64) {
+	if number != k.GetACKCount(ctx, rootChain)+1 {
+		return
+	}
+
+	if _, ok := k.GetCheckpointTiming(ctx, rootChain, number); ok {
+		return
+	}
+
+	k.setCheckpointTiming(ctx, types.CheckpointTiming{
+		RootChain:   rootChain,
+		Number:      number,
+		SubmittedAt: uint64(ctx.BlockTime().Unix()),
+	})
+}
+
+// RecordAckTiming records buffer and ack time of acked checkpoint and emits sla breach event
+// if ack took longer than ack latency sla
+func (k *Keeper) RecordAckTiming(ctx sdk.Context, rootChain string, number uint64, checkpoint hmTypes.Checkpoint) types.CheckpointTiming {
+	timing, _ := k.GetCheckpointTiming(ctx, rootChain, number)
+	timing.RootChain = rootChain
+	timing.Number = number
+	timing.BufferedAt = checkpoint.TimeStamp
+	timing.AckedAt = uint64(ctx.BlockTime().Unix())
+	if timing.SubmittedAt == 0 {
+		timing.SubmittedAt = timing.AckedAt
+	}
+	k.setCheckpointTiming(ctx, timing)
+
+	if sla := k.GetAckLatencySLA(ctx); timing.IsSLABreached(sla) {
+		k.Logger(ctx).Info("Checkpoint ack latency exceeded sla",
+			"root", rootChain, "number", number, "ackLatency", timing.AckLatency(), "sla", sla)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCheckpointSLA,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyRootChain, rootChain),
+				sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(number, 10)),
+				sdk.NewAttribute(types.AttributeKeyAckLatency, strconv.FormatUint(timing.AckLatency(), 10)),
+				sdk.NewAttribute(types.AttributeKeySubmissionLatency, strconv.FormatUint(timing.SubmissionLatency(), 10)),
+				sdk.NewAttribute(types.AttributeKeySLA, strconv.FormatUint(uint64(sla.Seconds()), 10)),
+			),
+		})
+	}
+
+	return timing
+}
+
+// GetCheckpointTimings returns timings of latest acked checkpoints of root chain, newest first
+func (k *Keeper) GetCheckpointTimings(ctx sdk.Context, rootChain string, limit uint64) []types.CheckpointTiming {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, append(TimingKey, hmTypes.GetRootChainID(rootChain)))
+	defer iterator.Close()
+
+	timings := []types.CheckpointTiming{}
+	for ; iterator.Valid() && uint64(len(timings)) < limit; iterator.Next() {
+		var timing types.CheckpointTiming
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &timing)
+
+		// ack of checkpoint is still pending
+		if timing.AckedAt == 0 {
+			continue
+		}
+		timings = append(timings, timing)
+	}
+	return timings
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/maticnetwork/bor/accounts/abi"
+
+	"github.com/maticnetwork/heimdall/contracts/stakemanager"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// AccountRootSnapshot dividend accounts account root hash of checkpoints was computed from
+type AccountRootSnapshot struct {
+	AccountRootHash hmTypes.HeimdallHash      `json:"account_root_hash" yaml:"account_root_hash"`
+	Accounts        []hmTypes.DividendAccount `json:"accounts" yaml:"accounts"`
+}
+
+// DividendAccountClaim merkle proof of dividend account against account root hash of checkpoint,
+// which validator submits to stake manager to claim fees on root chain
+type DividendAccountClaim struct {
+	Number          uint64                  `json:"number" yaml:"number"`
+	RootChain       string                  `json:"root_chain" yaml:"root_chain"`
+	AccountRootHash hmTypes.HeimdallHash    `json:"account_root_hash" yaml:"account_root_hash"`
+	User            hmTypes.HeimdallAddress `json:"user" yaml:"user"`
+	FeeAmount       string                  `json:"fee_amount" yaml:"fee_amount"` // accumulated fee of account, decimal
+	Index           uint64                  `json:"index" yaml:"index"`           // leaf index in account tree
+	Proof           hmTypes.HexBytes        `json:"proof" yaml:"proof"`           // concatenated 32 byte branch hashes
+	Calldata        hmTypes.HexBytes        `json:"calldata" yaml:"calldata"`     // abi encoded claimFee(accumFeeAmount, index, proof)
+}
+
+// PackClaimFee abi encodes claimFee call of stake manager contract
+func PackClaimFee(feeAmount *big.Int, index uint64, proof []byte) ([]byte, error) {
+	stakeManagerABI, err := abi.JSON(strings.NewReader(stakemanager.StakemanagerABI))
+	if err != nil {
+		return nil, err
+	}
+
+	return stakeManagerABI.Pack("claimFee", feeAmount, new(big.Int).SetUint64(index), proof)
+}
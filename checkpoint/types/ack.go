@@ -0,0 +1,17 @@
+package types
+
+import (
+	"time"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// KeyAckGracePeriod param key of grace period of checkpoint ack. While it's not set (or zero)
+// any sender may submit ack right away.
+var KeyAckGracePeriod = []byte("AckGracePeriod")
+
+// AckGraceEnd returns unix time from which any active validator may submit ack of buffered
+// checkpoint, before it only checkpoint proposer may
+func AckGraceEnd(checkpoint hmTypes.Checkpoint, gracePeriod time.Duration) uint64 {
+	return checkpoint.TimeStamp + uint64(gracePeriod.Seconds())
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointAdjustment records rewrite of stored checkpoint to match root chain contract
+type CheckpointAdjustment struct {
+	Number       uint64                  `json:"number"`
+	RootChain    string                  `json:"root_chain"`
+	StartBlock   uint64                  `json:"start_block"`
+	PrevEndBlock uint64                  `json:"prev_end_block"`
+	PrevRootHash hmTypes.HeimdallHash    `json:"prev_root_hash"`
+	PrevProposer hmTypes.HeimdallAddress `json:"prev_proposer"`
+	EndBlock     uint64                  `json:"end_block"`
+	RootHash     hmTypes.HeimdallHash    `json:"root_hash"`
+	Proposer     hmTypes.HeimdallAddress `json:"proposer"`
+	AdjustedBy   hmTypes.HeimdallAddress `json:"adjusted_by"`
+	Height       int64                   `json:"height"`
+	TxHash       hmTypes.HeimdallHash    `json:"tx_hash"`
+}
+
+// NewCheckpointAdjustment creates adjustment record from previous and new checkpoint data
+func NewCheckpointAdjustment(
+	number uint64,
+	rootChain string,
+	prev hmTypes.Checkpoint,
+	next hmTypes.Checkpoint,
+	adjustedBy hmTypes.HeimdallAddress,
+	height int64,
+	txHash hmTypes.HeimdallHash,
+) CheckpointAdjustment {
+	return CheckpointAdjustment{
+		Number:       number,
+		RootChain:    rootChain,
+		StartBlock:   prev.StartBlock,
+		PrevEndBlock: prev.EndBlock,
+		PrevRootHash: prev.RootHash,
+		PrevProposer: prev.Proposer,
+		EndBlock:     next.EndBlock,
+		RootHash:     next.RootHash,
+		Proposer:     next.Proposer,
+		AdjustedBy:   adjustedBy,
+		Height:       height,
+		TxHash:       txHash,
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// KeyCheckpointAggregation param key of multi-proposer checkpoint aggregation mode.
+// While it's not set (or proposers is zero) single proposer submits checkpoint as usual.
+var KeyCheckpointAggregation = []byte("CheckpointAggregation")
+
+// CheckpointAggregation makes next Proposers proposers submit checkpoint for same range,
+// range is buffered once Quorum of them agree on root hash
+type CheckpointAggregation struct {
+	Proposers uint64 `json:"proposers" yaml:"proposers"`
+	Quorum    uint64 `json:"quorum" yaml:"quorum"`
+}
+
+// NewCheckpointAggregation creates aggregation settings
+func NewCheckpointAggregation(proposers uint64, quorum uint64) CheckpointAggregation {
+	return CheckpointAggregation{
+		Proposers: proposers,
+		Quorum:    quorum,
+	}
+}
+
+// Enabled returns true if checkpoints are aggregated from multiple proposers
+func (a CheckpointAggregation) Enabled() bool {
+	return a.Proposers > 0
+}
+
+// Validate checks quorum can be reached by proposers
+func (a CheckpointAggregation) Validate() error {
+	if !a.Enabled() {
+		return nil
+	}
+
+	if a.Quorum == 0 {
+		return errors.New("checkpoint aggregation quorum should be greater than zero")
+	}
+
+	if a.Quorum > a.Proposers {
+		return fmt.Errorf("checkpoint aggregation quorum %v exceeds proposers %v", a.Quorum, a.Proposers)
+	}
+
+	return nil
+}
+
+// String returns human readable string
+func (a CheckpointAggregation) String() string {
+	return fmt.Sprintf("CheckpointAggregation {%v/%v}", a.Quorum, a.Proposers)
+}
+
+// AggregationVotes checkpoints submitted by aggregation proposers for next range of root chain
+type AggregationVotes struct {
+	RootChain string               `json:"root_chain" yaml:"root_chain"`
+	Quorum    uint64               `json:"quorum" yaml:"quorum"`
+	Votes     []hmTypes.Checkpoint `json:"votes" yaml:"votes"`
+}
+
+// CountMatchingVotes returns number of votes for same range and root hash as checkpoint
+func CountMatchingVotes(votes []hmTypes.Checkpoint, checkpoint hmTypes.Checkpoint) (count uint64) {
+	for _, vote := range votes {
+		if vote.StartBlock == checkpoint.StartBlock &&
+			vote.EndBlock == checkpoint.EndBlock &&
+			vote.RootHash.Equals(checkpoint.RootHash) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointAttestation commitment of checkpoint proposer to hashes of bor blocks covered by checkpoint.
+// BlockHashesRoot is merkle root of block hashes in range (see FetchBlockHashesRoot), so light clients
+// can audit which blocks checkpoint covers with merkle branch of single block hash.
+type CheckpointAttestation struct {
+	RootChain       string                  `json:"root_chain" yaml:"root_chain"`
+	StartBlock      uint64                  `json:"start_block" yaml:"start_block"`
+	EndBlock        uint64                  `json:"end_block" yaml:"end_block"`
+	RootHash        hmTypes.HeimdallHash    `json:"root_hash" yaml:"root_hash"`
+	Proposer        hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	BlockHashesRoot hmTypes.HeimdallHash    `json:"block_hashes_root" yaml:"block_hashes_root"`
+	BlobReference   string                  `json:"blob_reference,omitempty" yaml:"blob_reference"` // optional reference of full block hash list, eg. blob or ipfs cid
+	AttestedAt      int64                   `json:"attested_at" yaml:"attested_at"`
+}
+
+// Matches returns true if attestation covers checkpoint
+func (a CheckpointAttestation) Matches(checkpoint hmTypes.Checkpoint) bool {
+	return a.StartBlock == checkpoint.StartBlock && a.EndBlock == checkpoint.EndBlock && a.RootHash.Equals(checkpoint.RootHash)
+}
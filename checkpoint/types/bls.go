@@ -0,0 +1,57 @@
+package types
+
+import (
+	"math/big"
+	"strconv"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// KeyBlsAggregation param key of BLS signature aggregation of checkpoints.
+// While it's not set validators don't submit BLS votes for buffered checkpoints.
+var KeyBlsAggregation = []byte("BlsAggregation")
+
+// BlsAggregate BLS signatures of validators for checkpoint, aggregated into single signature
+type BlsAggregate struct {
+	RootChain  string                `json:"root_chain" yaml:"root_chain"`
+	StartBlock uint64                `json:"start_block" yaml:"start_block"`
+	EndBlock   uint64                `json:"end_block" yaml:"end_block"`
+	RootHash   hmTypes.HeimdallHash  `json:"root_hash" yaml:"root_hash"`
+	Signature  hmTypes.HexBytes      `json:"signature" yaml:"signature"`
+	PubKey     hmTypes.HexBytes      `json:"pub_key" yaml:"pub_key"` // aggregated public key of signers
+	Signers    []hmTypes.ValidatorID `json:"signers" yaml:"signers"`
+	Power      int64                 `json:"power" yaml:"power"` // voting power of signers when they voted
+}
+
+// HasSigner returns true if validator signature is part of aggregate
+func (a BlsAggregate) HasSigner(id hmTypes.ValidatorID) bool {
+	for _, signer := range a.Signers {
+		if signer == id {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckpointBlsSignature aggregated BLS signature of checkpoint along with signed bytes,
+// so root chain can verify it against aggregated public key with single pairing check
+type CheckpointBlsSignature struct {
+	Number     uint64           `json:"number" yaml:"number"` // zero for buffered checkpoint
+	Aggregate  BlsAggregate     `json:"aggregate" yaml:"aggregate"`
+	SignBytes  hmTypes.HexBytes `json:"sign_bytes" yaml:"sign_bytes"`
+	TotalPower int64            `json:"total_power" yaml:"total_power"`
+}
+
+// CheckpointBlsSignBytes returns bytes validators sign with BLS key for checkpoint of root chain
+// abi.encode(rootChainID, proposer, startBlock, endBlock, rootHash, borChainID)
+func CheckpointBlsSignBytes(rootChain string, checkpoint hmTypes.Checkpoint) []byte {
+	borChainID, _ := strconv.ParseUint(checkpoint.BorChainID, 10, 64)
+	return appendBytes32(
+		[]byte{hmTypes.GetRootChainID(rootChain)},
+		checkpoint.Proposer.Bytes(),
+		new(big.Int).SetUint64(checkpoint.StartBlock).Bytes(),
+		new(big.Int).SetUint64(checkpoint.EndBlock).Bytes(),
+		checkpoint.RootHash.Bytes(),
+		new(big.Int).SetUint64(borChainID).Bytes(),
+	)
+}
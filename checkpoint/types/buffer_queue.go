@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// DefaultCheckpointBufferDepth number of checkpoints buffer holds per root chain while it's not set
+const DefaultCheckpointBufferDepth uint64 = 1
+
+// KeyCheckpointBufferDepth param key of max number of checkpoints waiting for ack per root chain.
+// Only head of buffer can be acked, checkpoints behind it are promoted one by one as acks arrive.
+var KeyCheckpointBufferDepth = []byte("CheckpointBufferDepth")
+
+// CheckpointBufferQueue checkpoints waiting for ack on root chain ordered by start block, head first
+type CheckpointBufferQueue struct {
+	RootChain   string               `json:"root_chain" yaml:"root_chain"`
+	Depth       uint64               `json:"depth" yaml:"depth"`
+	Checkpoints []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
+}
+
+// IsFull returns true if no more checkpoints can be buffered
+func (q CheckpointBufferQueue) IsFull() bool {
+	return uint64(len(q.Checkpoints)) >= q.Depth
+}
+
+// Tail returns last buffered checkpoint
+func (q CheckpointBufferQueue) Tail() (hmTypes.Checkpoint, bool) {
+	if len(q.Checkpoints) == 0 {
+		return hmTypes.Checkpoint{}, false
+	}
+
+	return q.Checkpoints[len(q.Checkpoints)-1], true
+}
+
+// String returns human readable string
+func (q CheckpointBufferQueue) String() string {
+	return fmt.Sprintf("CheckpointBufferQueue {%v %v/%v}", q.RootChain, len(q.Checkpoints), q.Depth)
+}
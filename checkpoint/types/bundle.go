@@ -0,0 +1,91 @@
+package types
+
+import (
+	"math/bits"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Merkle tree description of checkpoint root hash
+const (
+	MerkleHashFunction = "keccak256"
+	MerkleLeafEncoding = "keccak256(abi.encodePacked(uint256 number, uint256 timestamp, bytes32 txRoot, bytes32 receiptsRoot))"
+)
+
+type (
+	// CheckpointBundle is self-contained checkpoint data, sufficient to verify checkpoint offline
+	CheckpointBundle struct {
+		Number       uint64               `json:"number"`
+		RootChain    string               `json:"root_chain"`
+		Checkpoint   hmTypes.Checkpoint   `json:"checkpoint"`
+		HeaderBlock  BundleHeaderBlock    `json:"header_block"`
+		SideTx       BundleSideTx         `json:"side_tx"`
+		ValidatorSet hmTypes.ValidatorSet `json:"validator_set"`
+		Merkle       MerkleParams         `json:"merkle"`
+	}
+
+	// BundleHeaderBlock header block as stored in root chain contract
+	BundleHeaderBlock struct {
+		Contract   string                  `json:"contract"`
+		HeaderID   uint64                  `json:"header_id"`
+		RootHash   hmTypes.HeimdallHash    `json:"root_hash"`
+		StartBlock uint64                  `json:"start_block"`
+		EndBlock   uint64                  `json:"end_block"`
+		CreatedAt  uint64                  `json:"created_at"`
+		Proposer   hmTypes.HeimdallAddress `json:"proposer"`
+	}
+
+	// BundleSideTx checkpoint side-tx with validator signatures over side sign bytes
+	BundleSideTx struct {
+		TxHash string      `json:"tx_hash"`
+		Height int64       `json:"height"`
+		Tx     string      `json:"tx"`
+		Data   string      `json:"data"`
+		Sigs   [][3]string `json:"sigs"`
+	}
+
+	// MerkleParams parameters of checkpoint merkle tree
+	MerkleParams struct {
+		HashFunction       string               `json:"hash_function"`
+		LeafEncoding       string               `json:"leaf_encoding"`
+		LeafCount          uint64               `json:"leaf_count"`
+		PaddedLeafCount    uint64               `json:"padded_leaf_count"`
+		TreeDepth          uint64               `json:"tree_depth"`
+		ChildBlockInterval uint64               `json:"child_block_interval"`
+		AccountRootHash    hmTypes.HeimdallHash `json:"account_root_hash"`
+	}
+)
+
+// NewMerkleParams returns merkle tree parameters of checkpoint for range [start, end]
+func NewMerkleParams(start uint64, end uint64, childBlockInterval uint64, accountRootHash hmTypes.HeimdallHash) MerkleParams {
+	var leafCount uint64
+	if end >= start {
+		leafCount = end - start + 1
+	}
+
+	// leaves are padded with zero hashes up to power of two
+	padded := nextPowerOfTwo(leafCount)
+
+	return MerkleParams{
+		HashFunction:       MerkleHashFunction,
+		LeafEncoding:       MerkleLeafEncoding,
+		LeafCount:          leafCount,
+		PaddedLeafCount:    padded,
+		TreeDepth:          uint64(bits.TrailingZeros64(padded)),
+		ChildBlockInterval: childBlockInterval,
+		AccountRootHash:    accountRootHash,
+	}
+}
+
+// CheckpointSubmission approved checkpoint side-tx with validator signatures in exactly
+// the layout of RootChain.submitCheckpoint(bytes data, uint256[3][] sigs)
+type CheckpointSubmission struct {
+	RootChain  string                    `json:"root_chain"`
+	Checkpoint hmTypes.Checkpoint        `json:"checkpoint"`
+	TxHash     string                    `json:"tx_hash"`
+	Height     int64                     `json:"height"`
+	Data       string                    `json:"data"`      // side-tx vote bytes
+	Signers    []hmTypes.HeimdallAddress `json:"signers"`   // sorted by address, same order as sigs
+	Sigs       [][3]string               `json:"sigs"`      // [r, s, v]
+	CallData   string                    `json:"call_data"` // abi encoded submitCheckpoint call
+}
@@ -8,8 +8,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCheckpoint{}, "checkpoint/MsgCheckpoint", nil)
 	cdc.RegisterConcrete(MsgCheckpointAck{}, "checkpoint/MsgCheckpointACK", nil)
 	cdc.RegisterConcrete(MsgCheckpointNoAck{}, "checkpoint/MsgCheckpointNoACK", nil)
+	cdc.RegisterConcrete(MsgCheckpointAdjust{}, "checkpoint/MsgCheckpointAdjust", nil)
 	cdc.RegisterConcrete(MsgCheckpointSync{}, "checkpoint/MsgCheckpointSync", nil)
 	cdc.RegisterConcrete(MsgCheckpointSyncAck{}, "checkpoint/MsgCheckpointSyncAck", nil)
+	cdc.RegisterConcrete(MsgCheckpointBlsVote{}, "checkpoint/MsgCheckpointBlsVote", nil)
+	cdc.RegisterConcrete(MsgCheckpointAttestation{}, "checkpoint/MsgCheckpointAttestation", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+)
+
+// KeyPayloadCompression param key of compression of bulk auxiliary checkpoint data,
+// eg. dividend accounts kept for fee claim proofs
+var KeyPayloadCompression = []byte("PayloadCompression")
+
+const (
+	// PayloadCompressionNone payloads are stored as encoded
+	PayloadCompressionNone = "none"
+	// PayloadCompressionSnappy payloads are snappy block compressed
+	PayloadCompressionSnappy = "snappy"
+)
+
+// compressedPayloadMarker prefixes compressed payloads, followed by compression id. Amino encoded
+// structs never start with zero byte, so payloads stored before compression was enabled are read as is.
+const (
+	compressedPayloadMarker = byte(0x00)
+	snappyPayloadID         = byte(0x01)
+)
+
+// ValidatePayloadCompression checks compression is known
+func ValidatePayloadCompression(compression string) error {
+	switch compression {
+	case PayloadCompressionNone, PayloadCompressionSnappy:
+		return nil
+	}
+	return fmt.Errorf("unknown payload compression %v", compression)
+}
+
+// CompressPayload compresses encoded payload, payload is returned as is without compression
+func CompressPayload(compression string, payload []byte) ([]byte, error) {
+	switch compression {
+	case PayloadCompressionNone:
+		return payload, nil
+	case PayloadCompressionSnappy:
+		return append([]byte{compressedPayloadMarker, snappyPayloadID}, snappy.Encode(nil, payload)...), nil
+	}
+	return nil, fmt.Errorf("unknown payload compression %v", compression)
+}
+
+// DecompressPayload returns encoded payload of stored one, whichever compression it was stored with
+func DecompressPayload(stored []byte) ([]byte, error) {
+	if len(stored) == 0 || stored[0] != compressedPayloadMarker {
+		return stored, nil
+	}
+
+	if len(stored) < 2 {
+		return nil, errors.New("invalid compressed payload")
+	}
+
+	switch stored[1] {
+	case snappyPayloadID:
+		return snappy.Decode(nil, stored[2:])
+	}
+	return nil, fmt.Errorf("unknown payload compression id %v", stored[1])
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadCompression(t *testing.T) {
+	t.Parallel()
+
+	payload := append([]byte{0x0a, 0x20}, bytes.Repeat([]byte{0x12}, 1024)...)
+
+	stored, err := CompressPayload(PayloadCompressionSnappy, payload)
+	require.NoError(t, err)
+	require.Less(t, len(stored), len(payload))
+
+	decompressed, err := DecompressPayload(stored)
+	require.NoError(t, err)
+	require.Equal(t, payload, decompressed)
+
+	// uncompressed payloads are read as is
+	stored, err = CompressPayload(PayloadCompressionNone, payload)
+	require.NoError(t, err)
+	decompressed, err = DecompressPayload(stored)
+	require.NoError(t, err)
+	require.Equal(t, payload, decompressed)
+
+	_, err = CompressPayload("zip", payload)
+	require.Error(t, err)
+	require.Error(t, ValidatePayloadCompression("zip"))
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ProposerDeposit amount held by checkpoint module account for proposer
+type ProposerDeposit struct {
+	Proposer hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	Amount   sdk.Coins               `json:"amount" yaml:"amount"`
+}
+
+// NewProposerDeposit creates a new proposer deposit instance
+func NewProposerDeposit(proposer hmTypes.HeimdallAddress, amount sdk.Coins) ProposerDeposit {
+	return ProposerDeposit{
+		Proposer: proposer,
+		Amount:   amount,
+	}
+}
+
+func (d ProposerDeposit) String() string {
+	return fmt.Sprintf("deposit by %s: %s", d.Proposer, d.Amount)
+}
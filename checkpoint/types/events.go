@@ -2,11 +2,18 @@ package types
 
 // Checkpoint tags
 var (
-	EventTypeCheckpoint        = "checkpoint"
-	EventTypeCheckpointAck     = "checkpoint-ack"
-	EventTypeCheckpointNoAck   = "checkpoint-noack"
-	EventTypeCheckpointSync    = "checkpoint-sync"
-	EventTypeCheckpointSyncAck = "checkpoint-sync-ack"
+	EventTypeCheckpoint         = "checkpoint"
+	EventTypeCheckpointAck      = "checkpoint-ack"
+	EventTypeCheckpointNoAck    = "checkpoint-noack"
+	EventTypeCheckpointSync     = "checkpoint-sync"
+	EventTypeCheckpointSyncAck  = "checkpoint-sync-ack"
+	EventTypeCheckpointAdjust   = "checkpoint-adjust"
+	EventTypeCheckpointConflict = "checkpoint-conflict"
+	EventTypeCheckpointVote     = "checkpoint-vote"
+	EventTypeCheckpointBlsVote  = "checkpoint-bls-vote"
+	EventTypeCheckpointAttest   = "checkpoint-attestation"
+	EventTypeCheckpointReward   = "checkpoint-reward"
+	EventTypeCheckpointSLA      = "checkpoint-sla-breach"
 
 	AttributeKeyProposer    = "proposer"
 	AttributeKeyStartBlock  = "start-block"
@@ -17,5 +24,28 @@ var (
 	AttributeKeyAccountHash = "account-hash"
 	AttributeKeyRootChain   = "root-chain"
 
+	AttributeKeyPrevEndBlock = "prev-end-block"
+	AttributeKeyPrevRootHash = "prev-root-hash"
+	AttributeKeyPrevProposer = "prev-proposer"
+
+	AttributeKeyConflictRootChain   = "conflict-root-chain"
+	AttributeKeyConflictHeaderIndex = "conflict-header-index"
+	AttributeKeyConflictRootHash    = "conflict-root-hash"
+
+	AttributeKeyAggregationVotes = "aggregation-votes"
+
+	AttributeKeyBlsSigners = "bls-signers"
+	AttributeKeyBlsPower   = "bls-power"
+
+	AttributeKeyBlockHashesRoot = "block-hashes-root"
+	AttributeKeyBlobReference   = "blob-reference"
+
+	AttributeKeySigner = "signer"
+	AttributeKeyReward = "reward"
+
+	AttributeKeyAckLatency        = "ack-latency"
+	AttributeKeySubmissionLatency = "submission-latency"
+	AttributeKeySLA               = "sla"
+
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// KeyCheckpointFailover param key of checkpoint submission failover to backup proposers.
+// While it's not set (or backup proposers is zero) expired buffer waits for no-ack as usual.
+var KeyCheckpointFailover = []byte("CheckpointFailover")
+
+// CheckpointFailover lets next BackupProposers proposers submit expired checkpoint again,
+// each of them within its own Window after buffer expiry
+type CheckpointFailover struct {
+	BackupProposers uint64        `json:"backup_proposers" yaml:"backup_proposers"`
+	Window          time.Duration `json:"window" yaml:"window"`
+}
+
+// NewCheckpointFailover creates failover settings
+func NewCheckpointFailover(backupProposers uint64, window time.Duration) CheckpointFailover {
+	return CheckpointFailover{
+		BackupProposers: backupProposers,
+		Window:          window,
+	}
+}
+
+// Enabled returns true if expired checkpoints fail over to backup proposers
+func (f CheckpointFailover) Enabled() bool {
+	return f.BackupProposers > 0
+}
+
+// Validate checks backup proposers have submission window
+func (f CheckpointFailover) Validate() error {
+	if !f.Enabled() {
+		return nil
+	}
+
+	if f.Window < time.Second {
+		return errors.New("checkpoint failover window should be at least one second")
+	}
+
+	return nil
+}
+
+// String returns human readable string
+func (f CheckpointFailover) String() string {
+	return fmt.Sprintf("CheckpointFailover {%v backups, window %v}", f.BackupProposers, f.Window)
+}
+
+// BufferExpiry checkpoint of root chain flushed from buffer without ack
+type BufferExpiry struct {
+	RootChain  string                  `json:"root_chain" yaml:"root_chain"`
+	StartBlock uint64                  `json:"start_block" yaml:"start_block"`
+	EndBlock   uint64                  `json:"end_block" yaml:"end_block"`
+	Proposer   hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	ExpiredAt  uint64                  `json:"expired_at" yaml:"expired_at"` // unix time buffer expired at
+}
+
+// BackupProposer proposer allowed to submit expired checkpoint within [WindowStart, WindowEnd)
+type BackupProposer struct {
+	Validator   hmTypes.Validator `json:"validator" yaml:"validator"`
+	Position    uint64            `json:"position" yaml:"position"` // 1-based position in backup list
+	WindowStart uint64            `json:"window_start" yaml:"window_start"`
+	WindowEnd   uint64            `json:"window_end" yaml:"window_end"`
+}
+
+// InWindow returns true if backup may submit checkpoint at unix time
+func (b BackupProposer) InWindow(now uint64) bool {
+	return now >= b.WindowStart && now < b.WindowEnd
+}
+
+// FailoverStatus expired checkpoint of root chain along with its backup proposers
+type FailoverStatus struct {
+	Expiry  *BufferExpiry    `json:"expiry" yaml:"expiry"`
+	Backups []BackupProposer `json:"backups" yaml:"backups"`
+}
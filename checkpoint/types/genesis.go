@@ -3,6 +3,10 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -12,12 +16,22 @@ import (
 type GenesisState struct {
 	Params Params `json:"params" yaml:"params"`
 
-	BufferedCheckpoint *hmTypes.Checkpoint  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
-	LastNoACK          uint64               `json:"last_no_ack" yaml:"last_no_ack"`
-	AckCount           uint64               `json:"ack_count" yaml:"ack_count"`
-	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
-	TronAckCount       uint64               `json:"tron_ack_count" yaml:"tron_ack_count"`
-	TronCheckpoints    []hmTypes.Checkpoint `json:"tron_checkpoints" yaml:"tron_checkpoints"`
+	BufferedCheckpoint *hmTypes.Checkpoint    `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
+	LastNoACK          uint64                 `json:"last_no_ack" yaml:"last_no_ack"`
+	AckCount           uint64                 `json:"ack_count" yaml:"ack_count"`
+	Checkpoints        []hmTypes.Checkpoint   `json:"checkpoints" yaml:"checkpoints"`
+	TronAckCount       uint64                 `json:"tron_ack_count" yaml:"tron_ack_count"`
+	TronCheckpoints    []hmTypes.Checkpoint   `json:"tron_checkpoints" yaml:"tron_checkpoints"`
+	Deposits           []ProposerDeposit      `json:"deposits" yaml:"deposits"`
+	BufferDepth        uint64                 `json:"buffer_depth,omitempty" yaml:"buffer_depth"`                 // max checkpoints buffered per root chain, 0 means default
+	Aggregation        *CheckpointAggregation `json:"aggregation,omitempty" yaml:"aggregation"`                   // multi-proposer aggregation mode, nil means disabled
+	Failover           *CheckpointFailover    `json:"failover,omitempty" yaml:"failover"`                         // backup proposers of expired checkpoints, nil means disabled
+	NoACKCount         uint64                 `json:"no_ack_count,omitempty" yaml:"no_ack_count"`                 // number of accepted no-acks
+	BlsAggregation     bool                   `json:"bls_aggregation,omitempty" yaml:"bls_aggregation"`           // BLS signature aggregation of checkpoints
+	AckGracePeriod     time.Duration          `json:"ack_grace_period,omitempty" yaml:"ack_grace_period"`         // time only checkpoint proposer may ack, 0 means anyone any time
+	SignerReward       *sdk.Int               `json:"signer_reward,omitempty" yaml:"signer_reward"`               // reward split among signers of acked checkpoint, nil means none
+	RootHashAlgorithms []RootHashAlgorithm    `json:"root_hash_algorithms,omitempty" yaml:"root_hash_algorithms"` // root hash algorithms of child chains, bor if not listed
+	PayloadCompression string                 `json:"payload_compression,omitempty" yaml:"payload_compression"`   // compression of bulk auxiliary data, none if empty
 }
 
 // NewGenesisState creates a new genesis state.
@@ -29,6 +43,7 @@ func NewGenesisState(
 	checkpoints []hmTypes.Checkpoint,
 	tronAckCount uint64,
 	tronCheckpoints []hmTypes.Checkpoint,
+	deposits []ProposerDeposit,
 ) GenesisState {
 	return GenesisState{
 		Params:             params,
@@ -38,6 +53,7 @@ func NewGenesisState(
 		Checkpoints:        checkpoints,
 		TronAckCount:       tronAckCount,
 		TronCheckpoints:    tronCheckpoints,
+		Deposits:           deposits,
 	}
 }
 
@@ -61,6 +77,42 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	if data.Aggregation != nil {
+		if err := data.Aggregation.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if data.Failover != nil {
+		if err := data.Failover.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if data.AckGracePeriod < 0 {
+		return errors.New("checkpoint ack grace period should not be negative")
+	}
+
+	if data.SignerReward != nil && data.SignerReward.IsNegative() {
+		return errors.New("checkpoint signer reward should not be negative")
+	}
+
+	if err := ValidateRootHashAlgorithms(data.RootHashAlgorithms); err != nil {
+		return err
+	}
+
+	if data.PayloadCompression != "" {
+		if err := ValidatePayloadCompression(data.PayloadCompression); err != nil {
+			return err
+		}
+	}
+
+	for _, deposit := range data.Deposits {
+		if deposit.Proposer.Empty() || !deposit.Amount.IsValid() {
+			return fmt.Errorf("invalid proposer deposit %s", deposit)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+
+	"github.com/maticnetwork/bor/crypto"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// InclusionAttestation states that bor tx is included in acked checkpoint, inclusion is
+// verified by recomputing merkle branch of tx block from bor headers against checkpoint root hash.
+// Only verified attestations are signed, by attestation key of node if it has one.
+type InclusionAttestation struct {
+	TxHash           hmTypes.HeimdallHash    `json:"tx_hash"`
+	BlockNumber      uint64                  `json:"block_number"`
+	RootChain        string                  `json:"root_chain"`
+	CheckpointNumber uint64                  `json:"checkpoint_number"`
+	StartBlock       uint64                  `json:"start_block"`
+	EndBlock         uint64                  `json:"end_block"`
+	RootHash         hmTypes.HeimdallHash    `json:"root_hash"`
+	Proof            hmTypes.HexBytes        `json:"proof"`
+	Verified         bool                    `json:"verified"`
+	Height           int64                   `json:"height"` // heimdall height checkpoint was read at
+	Timestamp        int64                   `json:"timestamp"`
+	Signer           hmTypes.HeimdallAddress `json:"signer"`
+	Signature        hmTypes.HexBytes        `json:"signature,omitempty"`
+}
+
+// SignBytes returns keccak256 of json encoded attestation without signature
+func (a InclusionAttestation) SignBytes() []byte {
+	a.Signature = nil
+	data, _ := json.Marshal(a)
+	return crypto.Keccak256(data)
+}
+
+// Sign sets signer and signature of verified attestation
+func (a *InclusionAttestation) Sign(privKey *ecdsa.PrivateKey) error {
+	if !a.Verified {
+		return errors.New("unverified attestation can't be signed")
+	}
+
+	a.Signer = hmTypes.BytesToHeimdallAddress(crypto.PubkeyToAddress(privKey.PublicKey).Bytes())
+
+	sig, err := crypto.Sign(a.SignBytes(), privKey)
+	if err != nil {
+		return err
+	}
+
+	a.Signature = sig
+	return nil
+}
+
+// VerifySignature checks that attestation is verified and signed by its signer
+func (a InclusionAttestation) VerifySignature() error {
+	if len(a.Signature) == 0 {
+		return errors.New("attestation is not signed")
+	}
+
+	if !a.Verified {
+		return errors.New("attestation is not verified")
+	}
+
+	pub, err := crypto.SigToPub(a.SignBytes(), a.Signature)
+	if err != nil {
+		return err
+	}
+
+	if !hmTypes.BytesToHeimdallAddress(crypto.PubkeyToAddress(*pub).Bytes()).Equals(a.Signer) {
+		return errors.New("signature doesn't match signer")
+	}
+
+	return nil
+}
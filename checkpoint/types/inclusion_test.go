@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestInclusionAttestationSign(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	attestation := InclusionAttestation{
+		TxHash:      hmTypes.HexToHeimdallHash("0x01"),
+		BlockNumber: 10,
+		StartBlock:  0,
+		EndBlock:    255,
+		RootHash:    hmTypes.HexToHeimdallHash("0x02"),
+	}
+
+	// unverified inclusion is never attested
+	require.Error(t, attestation.Sign(privKey))
+	require.Error(t, attestation.VerifySignature())
+
+	attestation.Verified = true
+	require.NoError(t, attestation.Sign(privKey))
+	require.Equal(t, hmTypes.BytesToHeimdallAddress(crypto.PubkeyToAddress(privKey.PublicKey).Bytes()), attestation.Signer)
+	require.NoError(t, attestation.VerifySignature())
+
+	// tampered attestation
+	attestation.BlockNumber = 11
+	require.Error(t, attestation.VerifySignature())
+
+	attestation.BlockNumber = 10
+	attestation.Verified = false
+	require.Error(t, attestation.VerifySignature())
+}
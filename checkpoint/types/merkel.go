@@ -3,28 +3,51 @@ package types
 import (
 	"bytes"
 	"errors"
+	"math/big"
 
 	"github.com/cbergoon/merkletree"
 	"github.com/maticnetwork/bor/common"
-	"github.com/maticnetwork/bor/rpc"
 	"github.com/tendermint/crypto/sha3"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // ValidateCheckpoint - Validates if checkpoint rootHash matches or not
-func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash, checkpointLength uint64, contractCaller helper.IContractCaller) (bool, error) {
+func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash, checkpointLength uint64, builder RootHashBuilder, contractCaller helper.IContractCaller) (bool, error) {
 	// Check if blocks exist locally
 	if !contractCaller.CheckIfBlocksExist(end) {
 		return false, errors.New("blocks not found locally")
 	}
 
 	// Compare RootHash
-	root, err := contractCaller.GetRootHash(start, end, checkpointLength)
-	if err != nil {
-		return false, err
+	var root []byte
+	var err error
+	if builder.Name() == RootHashAlgorithmBor {
+		root, err = contractCaller.GetRootHash(start, end, checkpointLength)
+	}
+
+	if builder.Name() != RootHashAlgorithmBor || err != nil || helper.GetConfig().BorReceiptsRootCheck {
+		// bor rejects ranges beyond its own limit and its root hash can't be cross-checked
+		// against receipts, compute root from headers fetched in batches. Other child chains
+		// don't serve root hash at all.
+		if start > end || end-start+1 > checkpointLength {
+			if err == nil {
+				err = errors.New("number of headers requested exceeds")
+			}
+			return false, err
+		}
+
+		headers, headersErr := contractCaller.GetBorHeadersBatch(start, end)
+		if headersErr != nil {
+			return false, headersErr
+		}
+
+		headersRoot := BuildRootHash(builder, headers)
+		if root != nil && !bytes.Equal(root, headersRoot) {
+			return false, errors.New("root hash of bor doesn't match its headers")
+		}
+		root = headersRoot
 	}
 
 	if bytes.Equal(root, rootHash.Bytes()) {
@@ -104,6 +127,51 @@ func VerifyAccountProof(dividendAccounts []hmTypes.DividendAccount, userAddr hmT
 	return false, nil
 }
 
+// GetBlockHeaderLeaf returns checkpoint merkle tree leaf of child block header
+// keccak256(abi.encodePacked(number, time, txRoot, receiptRoot))
+func GetBlockHeaderLeaf(number uint64, time uint64, txRoot common.Hash, receiptRoot common.Hash) []byte {
+	leaf := appendBytes32(
+		new(big.Int).SetUint64(number).Bytes(),
+		new(big.Int).SetUint64(time).Bytes(),
+		txRoot.Bytes(),
+		receiptRoot.Bytes(),
+	)
+	return keccak256(leaf)
+}
+
+// VerifyBlockProof checks membership of leaf at index in checkpoint merkle tree with rootHash
+func VerifyBlockProof(leaf []byte, index uint64, rootHash []byte, proof []byte) (bool, error) {
+	if len(proof)%32 != 0 {
+		return false, errors.New("invalid proof length")
+	}
+
+	proofHeight := uint64(len(proof) / 32)
+	if proofHeight < 64 && index >= uint64(1)<<proofHeight {
+		return false, errors.New("leaf index is too big")
+	}
+
+	computedHash := leaf
+	for i := 0; i < len(proof); i += 32 {
+		proofElement := proof[i : i+32]
+		if index%2 == 0 {
+			computedHash = keccak256(computedHash, proofElement)
+		} else {
+			computedHash = keccak256(proofElement, computedHash)
+		}
+		index = index / 2
+	}
+
+	return bytes.Equal(computedHash, rootHash), nil
+}
+
+func keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		hasher.Write(d)
+	}
+	return hasher.Sum(nil)
+}
+
 func convert(input []([32]byte)) [][]byte {
 	var output [][]byte
 	for _, in := range input {
@@ -149,35 +217,3 @@ func nextPowerOfTwo(n uint64) uint64 {
 	n++
 	return n
 }
-
-// spins go-routines to fetch batch elements to allow creation of large merkle trees
-func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem, checkpointLength uint64) (err error) {
-	var batchLength = int(checkpointLength)
-	// group
-	var g errgroup.Group
-
-	for i := 0; i < len(elements); i += batchLength {
-		var newBatch []rpc.BatchElem
-		if len(elements) < i+batchLength {
-			newBatch = elements[i:]
-		} else {
-			newBatch = elements[i : i+batchLength]
-		}
-
-		// common.CheckpointLogger.Info("Batching requests", "index", i, "length", len(newBatch))
-
-		// spawn go-routine
-		g.Go(func() error {
-			// Batch call
-			err := rpcClient.BatchCall(newBatch)
-			return err
-		})
-	}
-
-	if err := g.Wait(); err != nil {
-		return err
-	}
-
-	// common.CheckpointLogger.Info("Fetched all headers", "len", len(elements))
-	return nil
-}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestVerifyBlockProof(t *testing.T) {
+	t.Parallel()
+
+	var leaves [][]byte
+	for i := uint64(0); i < 4; i++ {
+		leaves = append(leaves, GetBlockHeaderLeaf(100+i, 1000+i, common.BytesToHash([]byte{byte(i)}), common.BytesToHash([]byte{byte(i + 10)})))
+	}
+
+	left := keccak256(leaves[0], leaves[1])
+	right := keccak256(leaves[2], leaves[3])
+	root := keccak256(left, right)
+
+	// proof for leaf 2: sibling leaf 3, then left subtree
+	proof := append(append([]byte{}, leaves[3]...), left...)
+
+	valid, err := VerifyBlockProof(leaves[2], 2, root, proof)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	valid, err = VerifyBlockProof(leaves[2], 3, root, proof)
+	require.NoError(t, err)
+	require.False(t, valid, "wrong index should not verify")
+
+	_, err = VerifyBlockProof(leaves[2], 4, root, proof)
+	require.Error(t, err, "index out of tree")
+
+	_, err = VerifyBlockProof(leaves[2], 2, root, proof[:40])
+	require.Error(t, err, "malformed proof")
+}
+
+func TestNewMerkleParams(t *testing.T) {
+	t.Parallel()
+
+	params := NewMerkleParams(0, 255, 10000, hmTypes.HeimdallHash{})
+	require.Equal(t, uint64(256), params.LeafCount)
+	require.Equal(t, uint64(256), params.PaddedLeafCount)
+	require.Equal(t, uint64(8), params.TreeDepth)
+
+	params = NewMerkleParams(100, 356, 10000, hmTypes.HeimdallHash{})
+	require.Equal(t, uint64(257), params.LeafCount)
+	require.Equal(t, uint64(512), params.PaddedLeafCount)
+	require.Equal(t, uint64(9), params.TreeDepth)
+	require.Equal(t, MerkleHashFunction, params.HashFunction)
+}
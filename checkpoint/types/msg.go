@@ -248,6 +248,96 @@ func (msg MsgCheckpointNoAck) ValidateBasic() sdk.Error {
 	return nil
 }
 
+//
+// Msg Checkpoint Adjust
+//
+
+var _ sdk.Msg = &MsgCheckpointAdjust{}
+
+// MsgCheckpointAdjust rewrites stored checkpoint to match header block on root chain contract
+type MsgCheckpointAdjust struct {
+	From          types.HeimdallAddress `json:"from"`
+	Number        uint64                `json:"number"`
+	Proposer      types.HeimdallAddress `json:"proposer"`
+	StartBlock    uint64                `json:"start_block"`
+	EndBlock      uint64                `json:"end_block"`
+	RootHash      types.HeimdallHash    `json:"root_hash"`
+	RootChainType string                `json:"root_chain_type"`
+}
+
+// NewMsgCheckpointAdjust creates new checkpoint adjust msg
+func NewMsgCheckpointAdjust(
+	from types.HeimdallAddress,
+	number uint64,
+	proposer types.HeimdallAddress,
+	startBlock uint64,
+	endBlock uint64,
+	rootHash types.HeimdallHash,
+	rootChain string,
+) MsgCheckpointAdjust {
+	return MsgCheckpointAdjust{
+		From:          from,
+		Number:        number,
+		Proposer:      proposer,
+		StartBlock:    startBlock,
+		EndBlock:      endBlock,
+		RootHash:      rootHash,
+		RootChainType: rootChain,
+	}
+}
+
+func (msg MsgCheckpointAdjust) Type() string {
+	return "checkpoint-adjust"
+}
+
+func (msg MsgCheckpointAdjust) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns signers
+func (msg MsgCheckpointAdjust) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes
+func (msg MsgCheckpointAdjust) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic validate basic
+func (msg MsgCheckpointAdjust) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if msg.Proposer.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid empty proposer")
+	}
+
+	if msg.RootHash.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid empty root hash")
+	}
+
+	if msg.EndBlock < msg.StartBlock {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "End block %v is less than start block %v", msg.EndBlock, msg.StartBlock)
+	}
+
+	if types.GetRootChainID(msg.RootChainType) == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root chain %v", msg.RootChainType)
+	}
+
+	return nil
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgCheckpointAdjust) GetSideSignBytes() []byte {
+	return nil
+}
+
 //
 // Msg Checkpoint Sync
 //
@@ -362,3 +452,163 @@ func (msg MsgCheckpointSyncAck) ValidateBasic() sdk.Error {
 func (msg MsgCheckpointSyncAck) GetSideSignBytes() []byte {
 	return nil
 }
+
+//
+// Msg Checkpoint BLS Vote
+//
+
+var _ sdk.Msg = &MsgCheckpointBlsVote{}
+
+// MsgCheckpointBlsVote BLS signature of validator for buffered checkpoint, aggregated with other votes
+type MsgCheckpointBlsVote struct {
+	From          types.HeimdallAddress `json:"from"`
+	StartBlock    uint64                `json:"start_block"`
+	EndBlock      uint64                `json:"end_block"`
+	RootHash      types.HeimdallHash    `json:"root_hash"`
+	RootChainType string                `json:"root_chain_type"`
+	Signature     types.HexBytes        `json:"signature"`
+}
+
+// NewMsgCheckpointBlsVote creates new checkpoint BLS vote msg
+func NewMsgCheckpointBlsVote(
+	from types.HeimdallAddress,
+	startBlock uint64,
+	endBlock uint64,
+	rootHash types.HeimdallHash,
+	rootChain string,
+	signature types.HexBytes,
+) MsgCheckpointBlsVote {
+	return MsgCheckpointBlsVote{
+		From:          from,
+		StartBlock:    startBlock,
+		EndBlock:      endBlock,
+		RootHash:      rootHash,
+		RootChainType: rootChain,
+		Signature:     signature,
+	}
+}
+
+func (msg MsgCheckpointBlsVote) Type() string {
+	return "checkpoint-bls-vote"
+}
+
+func (msg MsgCheckpointBlsVote) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCheckpointBlsVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgCheckpointBlsVote) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgCheckpointBlsVote) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if types.GetRootChainID(msg.RootChainType) == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root chain %v", msg.RootChainType)
+	}
+
+	if msg.EndBlock < msg.StartBlock || msg.RootHash.Empty() {
+		return hmCommon.ErrBadBlockDetails(hmCommon.DefaultCodespace)
+	}
+
+	if len(msg.Signature) != helper.BlsSignatureLength {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid BLS signature length %v", len(msg.Signature))
+	}
+
+	return nil
+}
+
+//
+// Msg Checkpoint Attestation
+//
+
+// MaxBlobReferenceLength max length of blob reference of checkpoint attestation
+const MaxBlobReferenceLength = 256
+
+var _ sdk.Msg = &MsgCheckpointAttestation{}
+
+// MsgCheckpointAttestation commitment of proposer to hashes of bor blocks covered by buffered checkpoint
+type MsgCheckpointAttestation struct {
+	Proposer        types.HeimdallAddress `json:"proposer"`
+	StartBlock      uint64                `json:"start_block"`
+	EndBlock        uint64                `json:"end_block"`
+	RootHash        types.HeimdallHash    `json:"root_hash"`
+	RootChainType   string                `json:"root_chain_type"`
+	BlockHashesRoot types.HeimdallHash    `json:"block_hashes_root"`
+	BlobReference   string                `json:"blob_reference"`
+}
+
+// NewMsgCheckpointAttestation creates new checkpoint attestation msg
+func NewMsgCheckpointAttestation(
+	proposer types.HeimdallAddress,
+	startBlock uint64,
+	endBlock uint64,
+	rootHash types.HeimdallHash,
+	rootChain string,
+	blockHashesRoot types.HeimdallHash,
+	blobReference string,
+) MsgCheckpointAttestation {
+	return MsgCheckpointAttestation{
+		Proposer:        proposer,
+		StartBlock:      startBlock,
+		EndBlock:        endBlock,
+		RootHash:        rootHash,
+		RootChainType:   rootChain,
+		BlockHashesRoot: blockHashesRoot,
+		BlobReference:   blobReference,
+	}
+}
+
+func (msg MsgCheckpointAttestation) Type() string {
+	return "checkpoint-attestation"
+}
+
+func (msg MsgCheckpointAttestation) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCheckpointAttestation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.Proposer)}
+}
+
+func (msg MsgCheckpointAttestation) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgCheckpointAttestation) ValidateBasic() sdk.Error {
+	if msg.Proposer.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.Proposer.String())
+	}
+
+	if types.GetRootChainID(msg.RootChainType) == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root chain %v", msg.RootChainType)
+	}
+
+	if msg.EndBlock < msg.StartBlock || msg.RootHash.Empty() {
+		return hmCommon.ErrBadBlockDetails(hmCommon.DefaultCodespace)
+	}
+
+	if msg.BlockHashesRoot.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Empty block hashes root")
+	}
+
+	if len(msg.BlobReference) > MaxBlobReferenceLength {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Blob reference longer than %v", MaxBlobReferenceLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"time"
+
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+)
+
+// DefaultBorBlockTime bor block time assumed while there are no acked checkpoints to measure it
+const DefaultBorBlockTime = 2 * time.Second
+
+// QueryParamChangeParams proposed changes of checkpoint params, as in param change proposal
+type QueryParamChangeParams struct {
+	Changes []paramsTypes.ParamChange `json:"changes"`
+}
+
+// NewQueryParamChangeParams creates a new instance of QueryParamChangeParams.
+func NewQueryParamChangeParams(changes []paramsTypes.ParamChange) QueryParamChangeParams {
+	return QueryParamChangeParams{Changes: changes}
+}
+
+// ParamChangeEffects effects of proposed checkpoint params on checkpoint delivery, evaluated against current state
+type ParamChangeEffects struct {
+	Current  Params `json:"current"`
+	Proposed Params `json:"proposed"`
+	Error    string `json:"error,omitempty"` // validation error of proposed params
+
+	ValidatorCount        uint64        `json:"validator_count"`
+	MaxCalldataSize       uint64        `json:"max_calldata_size"`       // bytes of submitCheckpoint calldata signed by all current validators
+	BlockTime             time.Duration `json:"block_time"`              // bor block time measured from acked checkpoints
+	ExpectedAckInterval   time.Duration `json:"expected_ack_interval"`   // time to produce AvgCheckpointLength blocks
+	MaxCheckpointDuration time.Duration `json:"max_checkpoint_duration"` // time to produce MaxCheckpointLength blocks
+	ForcePushInterval     time.Duration `json:"force_push_interval"`     // bridge pushes shorter checkpoint once it passes since last one
+
+	Buffers  []BufferEffect `json:"buffers"`
+	Warnings []string       `json:"warnings"`
+}
+
+// BufferEffect checkpoint waiting for ack checked against proposed params
+type BufferEffect struct {
+	RootChain        string `json:"root_chain"`
+	StartBlock       uint64 `json:"start_block"`
+	EndBlock         uint64 `json:"end_block"`
+	ExceedsMaxLength bool   `json:"exceeds_max_length"`
+	ExpiresAt        uint64 `json:"expires_at,omitempty"` // unix time head of buffer expires at with proposed buffer time
+	Expired          bool   `json:"expired"`              // head of buffer expires as soon as params change
+}
+
+// Length returns number of bor blocks covered by buffered checkpoint
+func (b BufferEffect) Length() uint64 {
+	return b.EndBlock - b.StartBlock + 1
+}
+
+// CheckpointCalldataSize returns size of submitCheckpoint calldata with signatures of signers:
+// selector, offsets and lengths of both arguments, side-tx result byte with signed checkpoint data
+// padded to words, and three words of each signature
+func CheckpointCalldataSize(signers uint64) uint64 {
+	const word = 32
+	signedData := uint64(1 + 7*word) // result byte followed by 7 words of MsgCheckpoint.GetSideSignBytes
+	paddedData := (signedData + word - 1) / word * word
+	return 4 + 2*word + word + paddedData + word + signers*3*word
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/maticnetwork/heimdall/params/subspace"
 )
 
@@ -14,7 +16,8 @@ const (
 	DefaultCheckpointBufferTime time.Duration = 1000 * time.Second // Time checkpoint is allowed to stay in buffer (1000 seconds ~ 17 mins)
 	DefaultAvgCheckpointLength  uint64        = 256
 	DefaultMaxCheckpointLength  uint64        = 1024
-	DefaultChildBlockInterval   uint64        = 10000
+	DefaultStandbyProposerCount uint64        = 3
+	DefaultProposerGraceWindow  time.Duration = 200 * time.Second // Time each proposer in standby list waits for the previous one
 )
 
 // Parameter keys
@@ -22,9 +25,14 @@ var (
 	KeyCheckpointBufferTime = []byte("CheckpointBufferTime")
 	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
 	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
-	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+	KeyStandbyProposerCount = []byte("StandbyProposerCount")
+	KeyProposerGraceWindow  = []byte("ProposerGraceWindow")
 )
 
+// KeyChildBlockInterval legacy param key of child block interval, which is per root chain
+// chainmanager param now. It is kept registered so store migration can read it.
+var KeyChildBlockInterval = []byte("ChildBlockInterval")
+
 var _ subspace.ParamSet = &Params{}
 
 // Params defines the parameters for the auth module.
@@ -32,7 +40,8 @@ type Params struct {
 	CheckpointBufferTime time.Duration `json:"checkpoint_buffer_time" yaml:"checkpoint_buffer_time"`
 	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
 	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
-	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+	StandbyProposerCount uint64        `json:"standby_proposer_count" yaml:"standby_proposer_count"`
+	ProposerGraceWindow  time.Duration `json:"proposer_grace_window" yaml:"proposer_grace_window"`
 }
 
 // NewParams creates a new Params object
@@ -40,19 +49,30 @@ func NewParams(
 	checkpointBufferTime time.Duration,
 	checkpointLength uint64,
 	maxCheckpointLength uint64,
-	childBlockInterval uint64,
+	standbyProposerCount uint64,
+	proposerGraceWindow time.Duration,
 ) Params {
 	return Params{
 		CheckpointBufferTime: checkpointBufferTime,
 		AvgCheckpointLength:  checkpointLength,
 		MaxCheckpointLength:  maxCheckpointLength,
-		ChildBlockInterval:   childBlockInterval,
+		StandbyProposerCount: standbyProposerCount,
+		ProposerGraceWindow:  proposerGraceWindow,
 	}
 }
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().RegisterParamSet(&Params{}).RegisterType(KeyCheckpointBufferDepth, uint64(0)).
+		RegisterType(KeyChildBlockInterval, uint64(0)).
+		RegisterType(KeyCheckpointAggregation, CheckpointAggregation{}).
+		RegisterType(KeyCheckpointFailover, CheckpointFailover{}).
+		RegisterType(KeyBlsAggregation, false).
+		RegisterType(KeyAckGracePeriod, time.Duration(0)).
+		RegisterType(KeyAckLatencySLA, time.Duration(0)).
+		RegisterType(KeySignerReward, sdk.ZeroInt()).
+		RegisterType(KeyRootHashAlgorithms, []RootHashAlgorithm{}).
+		RegisterType(KeyPayloadCompression, "")
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -63,7 +83,8 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyCheckpointBufferTime, &p.CheckpointBufferTime},
 		{KeyAvgCheckpointLength, &p.AvgCheckpointLength},
 		{KeyMaxCheckpointLength, &p.MaxCheckpointLength},
-		{KeyChildBlockInterval, &p.ChildBlockInterval},
+		{KeyStandbyProposerCount, &p.StandbyProposerCount},
+		{KeyProposerGraceWindow, &p.ProposerGraceWindow},
 	}
 }
 
@@ -80,7 +101,8 @@ func DefaultParams() Params {
 		CheckpointBufferTime: DefaultCheckpointBufferTime,
 		AvgCheckpointLength:  DefaultAvgCheckpointLength,
 		MaxCheckpointLength:  DefaultMaxCheckpointLength,
-		ChildBlockInterval:   DefaultChildBlockInterval,
+		StandbyProposerCount: DefaultStandbyProposerCount,
+		ProposerGraceWindow:  DefaultProposerGraceWindow,
 	}
 }
 
@@ -91,7 +113,8 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("CheckpointBufferTime: %s\n", p.CheckpointBufferTime))
 	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
 	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
-	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("StandbyProposerCount: %d\n", p.StandbyProposerCount))
+	sb.WriteString(fmt.Sprintf("ProposerGraceWindow: %s\n", p.ProposerGraceWindow))
 	return sb.String()
 }
 
@@ -105,8 +128,8 @@ func (p Params) Validate() error {
 		return fmt.Errorf("AvgCheckpointLength should not be greater than MaxCheckpointLength")
 	}
 
-	if p.ChildBlockInterval == 0 {
-		return fmt.Errorf("ChildBlockInterval should be greater than zero")
+	if p.StandbyProposerCount > 0 && p.ProposerGraceWindow <= 0 {
+		return fmt.Errorf("ProposerGraceWindow should be greater than zero when standby proposers are enabled")
 	}
 
 	return nil
@@ -1,20 +1,46 @@
 package types
 
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
-	QueryParams               = "params"
-	QueryAckCount             = "ack-count"
-	QueryEpoch                = "epoch"
-	QueryCheckpoint           = "checkpoint"
-	QueryCheckpointBuffer     = "checkpoint-buffer"
-	QueryCheckpointSyncBuffer = "checkpoint-sync"
-	QueryCheckpointActivation = "checkpoint-activation"
-	QueryLastNoAck            = "last-no-ack"
-	QueryCheckpointList       = "checkpoint-list"
-	QueryNextCheckpoint       = "next-checkpoint"
-	QueryProposer             = "is-proposer"
-	QueryCurrentProposer      = "current-proposer"
-	StakingQuerierRoute       = "staking"
+	QueryParams                = "params"
+	QueryAckCount              = "ack-count"
+	QueryEpoch                 = "epoch"
+	QueryCheckpoint            = "checkpoint"
+	QueryCheckpointBuffer      = "checkpoint-buffer"
+	QueryCheckpointSyncBuffer  = "checkpoint-sync"
+	QueryCheckpointBufferQueue = "checkpoint-buffer-queue"
+	QueryCheckpointActivation  = "checkpoint-activation"
+	QueryLastNoAck             = "last-no-ack"
+	QueryCheckpointList        = "checkpoint-list"
+	QueryNextCheckpoint        = "next-checkpoint"
+	QueryProposer              = "is-proposer"
+	QueryCurrentProposer       = "current-proposer"
+	QueryStandbyProposers      = "standby-proposers"
+	QueryCheckpointByBorBlock  = "checkpoint-by-bor-block"
+	QueryAccountRootHash       = "account-root-hash"
+	QueryAdjustments           = "checkpoint-adjustments"
+	QueryProposerDeposit       = "proposer-deposit"
+	QueryProposerDeposits      = "proposer-deposits"
+	QueryAggregationProposers  = "aggregation-proposers"
+	QueryAggregationVotes      = "aggregation-votes"
+	QueryStats                 = "stats"
+	QueryFailover              = "failover"
+	QueryBlsSignature          = "bls-signature"
+	QueryBlsAggregation        = "bls-aggregation"
+	QueryAttestation           = "attestation"
+	QueryAckGracePeriod        = "ack-grace-period"
+	QueryRootHashAlgorithms    = "root-hash-algorithms"
+	QueryAccountClaim          = "account-claim"
+	QueryParamChangeEffects    = "param-change-effects"
+	QuerySimulateAck           = "simulate-ack"
+	QueryCheckpointTiming      = "checkpoint-timing"
+	StakingQuerierRoute        = "staking"
 )
 
 // QueryCheckpointParams defines the params for querying accounts.
@@ -31,6 +57,22 @@ func NewQueryCheckpointParams(number uint64, rootChain string) QueryCheckpointPa
 	}
 }
 
+// QueryAccountClaimParams defines the params for querying fee claim of dividend account against checkpoint
+type QueryAccountClaimParams struct {
+	Number      uint64
+	RootChain   string
+	UserAddress hmTypes.HeimdallAddress
+}
+
+// NewQueryAccountClaimParams creates a new instance of QueryAccountClaimParams
+func NewQueryAccountClaimParams(number uint64, rootChain string, userAddress hmTypes.HeimdallAddress) QueryAccountClaimParams {
+	return QueryAccountClaimParams{
+		Number:      number,
+		RootChain:   rootChain,
+		UserAddress: userAddress,
+	}
+}
+
 // QueryBorChainID defines the params for querying with bor chain id
 type QueryBorChainID struct {
 	BorChainID string
@@ -40,3 +82,48 @@ type QueryBorChainID struct {
 func NewQueryBorChainID(chainID string) QueryBorChainID {
 	return QueryBorChainID{BorChainID: chainID}
 }
+
+// QueryBorBlockParams defines the params for querying checkpoint by bor block
+type QueryBorBlockParams struct {
+	BlockNumber uint64
+	RootChain   string
+}
+
+// NewQueryBorBlockParams creates a new instance of QueryBorBlockParams
+func NewQueryBorBlockParams(blockNumber uint64, rootChain string) QueryBorBlockParams {
+	return QueryBorBlockParams{
+		BlockNumber: blockNumber,
+		RootChain:   rootChain,
+	}
+}
+
+// BorBlockCheckpoint is checkpoint which covers bor block
+type BorBlockCheckpoint struct {
+	Number     uint64             `json:"number"`
+	RootChain  string             `json:"root_chain"`
+	Checkpoint hmTypes.Checkpoint `json:"checkpoint"`
+}
+
+// QueryProposerDepositParams defines the params for querying deposit of proposer
+type QueryProposerDepositParams struct {
+	Proposer hmTypes.HeimdallAddress
+}
+
+// NewQueryProposerDepositParams creates a new instance of QueryProposerDepositParams
+func NewQueryProposerDepositParams(proposer hmTypes.HeimdallAddress) QueryProposerDepositParams {
+	return QueryProposerDepositParams{Proposer: proposer}
+}
+
+// QuerySimulateAckParams candidate checkpoint ack to dry-run through post-tx handler
+type QuerySimulateAckParams struct {
+	Msg          MsgCheckpointAck
+	SideTxResult abci.SideTxResultType
+}
+
+// NewQuerySimulateAckParams creates a new instance of QuerySimulateAckParams
+func NewQuerySimulateAckParams(msg MsgCheckpointAck, sideTxResult abci.SideTxResultType) QuerySimulateAckParams {
+	return QuerySimulateAckParams{
+		Msg:          msg,
+		SideTxResult: sideTxResult,
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// actions of checkpoint rebuild
+const (
+	RebuildActionKeep    = "keep"    // stored checkpoint matches root chain
+	RebuildActionAdd     = "add"     // checkpoint is missing in store
+	RebuildActionReplace = "replace" // stored checkpoint differs from root chain
+)
+
+// CheckpointRebuild is diff of stored checkpoint and header on root chain contract
+type CheckpointRebuild struct {
+	Number    uint64              `json:"number" yaml:"number"`
+	RootChain string              `json:"root_chain" yaml:"root_chain"`
+	Action    string              `json:"action" yaml:"action"`
+	Stored    *hmTypes.Checkpoint `json:"stored,omitempty" yaml:"stored"`
+	Rebuilt   hmTypes.Checkpoint  `json:"rebuilt" yaml:"rebuilt"`
+}
+
+// Changed returns true if rebuild writes checkpoint to store
+func (r CheckpointRebuild) Changed() bool {
+	return r.Action != RebuildActionKeep
+}
+
+// String returns human readable diff line
+func (r CheckpointRebuild) String() string {
+	switch r.Action {
+	case RebuildActionAdd:
+		return fmt.Sprintf("+ %v #%v [%v-%v] root %v proposer %v",
+			r.RootChain, r.Number, r.Rebuilt.StartBlock, r.Rebuilt.EndBlock, r.Rebuilt.RootHash, r.Rebuilt.Proposer)
+	case RebuildActionReplace:
+		return fmt.Sprintf("- %v #%v [%v-%v] root %v proposer %v\n+ %v #%v [%v-%v] root %v proposer %v",
+			r.RootChain, r.Number, r.Stored.StartBlock, r.Stored.EndBlock, r.Stored.RootHash, r.Stored.Proposer,
+			r.RootChain, r.Number, r.Rebuilt.StartBlock, r.Rebuilt.EndBlock, r.Rebuilt.RootHash, r.Rebuilt.Proposer)
+	default:
+		return fmt.Sprintf("  %v #%v [%v-%v] root %v",
+			r.RootChain, r.Number, r.Rebuilt.StartBlock, r.Rebuilt.EndBlock, r.Rebuilt.RootHash)
+	}
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// KeySignerReward param key of reward of checkpoint signers. While it's not set (or zero)
+// acked checkpoints don't credit signers. Reward is paid out of fee collector, capped at its balance.
+var KeySignerReward = []byte("SignerReward")
+
+// CheckpointSigner validator which voted yes on checkpoint, with its power when it voted
+type CheckpointSigner struct {
+	Signer hmTypes.HeimdallAddress `json:"signer" yaml:"signer"`
+	Power  int64                   `json:"power" yaml:"power"`
+}
+
+// CheckpointSigners signers of buffered checkpoint, rewarded once checkpoint is acked
+type CheckpointSigners struct {
+	RootChain  string               `json:"root_chain" yaml:"root_chain"`
+	StartBlock uint64               `json:"start_block" yaml:"start_block"`
+	EndBlock   uint64               `json:"end_block" yaml:"end_block"`
+	RootHash   hmTypes.HeimdallHash `json:"root_hash" yaml:"root_hash"`
+	Signers    []CheckpointSigner   `json:"signers" yaml:"signers"`
+}
+
+// Matches returns true if signers signed checkpoint
+func (s CheckpointSigners) Matches(checkpoint hmTypes.Checkpoint) bool {
+	return s.StartBlock == checkpoint.StartBlock && s.EndBlock == checkpoint.EndBlock && s.RootHash.Equals(checkpoint.RootHash)
+}
+
+// String returns human readable string
+func (s CheckpointSigners) String() string {
+	return fmt.Sprintf("CheckpointSigners{%v %v-%v signers: %v}", s.RootChain, s.StartBlock, s.EndBlock, len(s.Signers))
+}
+
+// SplitSignerReward splits reward among signers weighted by their power. Remainder of integer
+// division goes to first signer, so whole reward is credited.
+func SplitSignerReward(reward sdk.Int, signers []CheckpointSigner) []*big.Int {
+	var totalPower int64
+	for _, signer := range signers {
+		if signer.Power > 0 {
+			totalPower += signer.Power
+		}
+	}
+
+	shares := make([]*big.Int, len(signers))
+	if totalPower == 0 || !reward.IsPositive() {
+		for i := range shares {
+			shares[i] = big.NewInt(0)
+		}
+		return shares
+	}
+
+	remainder := new(big.Int).Set(reward.BigInt())
+	for i, signer := range signers {
+		shares[i] = big.NewInt(0)
+		if signer.Power > 0 {
+			shares[i].Mul(reward.BigInt(), big.NewInt(signer.Power))
+			shares[i].Quo(shares[i], big.NewInt(totalPower))
+		}
+		remainder.Sub(remainder, shares[i])
+	}
+	shares[0].Add(shares[0], remainder)
+
+	return shares
+}
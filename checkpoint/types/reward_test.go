@@ -0,0 +1,47 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestSplitSignerReward(t *testing.T) {
+	t.Parallel()
+
+	signers := []CheckpointSigner{
+		{Signer: hmTypes.HexToHeimdallAddress("0x01"), Power: 1},
+		{Signer: hmTypes.HexToHeimdallAddress("0x02"), Power: 2},
+		{Signer: hmTypes.HexToHeimdallAddress("0x03"), Power: 0},
+	}
+
+	tests := []struct {
+		reward sdk.Int
+		shares []int64
+	}{
+		{sdk.NewInt(300), []int64{100, 200, 0}},
+		{sdk.NewInt(1000), []int64{334, 666, 0}},
+		{sdk.NewInt(1), []int64{1, 0, 0}},
+		{sdk.ZeroInt(), []int64{0, 0, 0}},
+	}
+
+	for _, test := range tests {
+		shares := SplitSignerReward(test.reward, signers)
+		require.Len(t, shares, len(signers))
+
+		total := big.NewInt(0)
+		for i, share := range shares {
+			require.Equal(t, big.NewInt(test.shares[i]), share, "reward %v signer %v", test.reward, i)
+			total.Add(total, share)
+		}
+		require.Equal(t, test.reward.BigInt(), total)
+	}
+
+	// signers without power aren't rewarded
+	shares := SplitSignerReward(sdk.NewInt(100), signers[2:])
+	require.Equal(t, []*big.Int{big.NewInt(0)}, shares)
+}
@@ -0,0 +1,232 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/maticnetwork/bor/common/hexutil"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+// DefaultRootHashBatchSize number of bor headers fetched in one batch rpc call
+const DefaultRootHashBatchSize = 1000
+
+// RootHashProgress reports number of processed blocks out of total
+type RootHashProgress func(processed uint64, total uint64)
+
+type merkleNode struct {
+	level uint64
+	hash  []byte
+}
+
+// MerkleBuilder builds checkpoint merkle root from leaves streamed in order.
+// Only one pending node per tree level is kept, so memory is O(log n).
+// Tree is padded with zero leaves up to next power of two, same as bor root hash.
+type MerkleBuilder struct {
+	stack []merkleNode
+	count uint64
+}
+
+// NewMerkleBuilder creates empty merkle builder
+func NewMerkleBuilder() *MerkleBuilder {
+	return &MerkleBuilder{}
+}
+
+// AddLeaf appends next leaf of tree
+func (b *MerkleBuilder) AddLeaf(leaf []byte) {
+	b.stack = pushMerkleNode(b.stack, merkleNode{level: 0, hash: leaf})
+	b.count++
+}
+
+// Count returns number of leaves added so far
+func (b *MerkleBuilder) Count() uint64 {
+	return b.count
+}
+
+// Root returns merkle root of leaves added so far
+func (b *MerkleBuilder) Root() []byte {
+	zeroHashes := [][]byte{make([]byte, 32)}
+	if b.count == 0 {
+		return zeroHashes[0]
+	}
+
+	stack := append([]merkleNode{}, b.stack...)
+	for len(stack) > 1 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		// pad lowest subtree with zero subtree of same height
+		for uint64(len(zeroHashes)) <= top.level {
+			last := zeroHashes[len(zeroHashes)-1]
+			zeroHashes = append(zeroHashes, keccak256(last, last))
+		}
+
+		stack = pushMerkleNode(stack, merkleNode{
+			level: top.level + 1,
+			hash:  keccak256(top.hash, zeroHashes[top.level]),
+		})
+	}
+
+	return stack[0].hash
+}
+
+// pushMerkleNode pushes node and merges complete subtrees of same height
+func pushMerkleNode(stack []merkleNode, node merkleNode) []merkleNode {
+	for len(stack) > 0 && stack[len(stack)-1].level == node.level {
+		left := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node = merkleNode{level: node.level + 1, hash: keccak256(left.hash, node.hash)}
+	}
+	return append(stack, node)
+}
+
+// FetchRootHash computes checkpoint root hash of bor blocks [start, end].
+// Headers are fetched with batch rpc calls of batchSize and streamed into
+// merkle builder, so memory doesn't grow with length of range.
+func FetchRootHash(ctx context.Context, rpcClient *rpc.Client, start uint64, end uint64, batchSize uint64, progress RootHashProgress) ([]byte, error) {
+	builder := NewMerkleBuilder()
+	if err := fetchLeaves(ctx, rpcClient, start, end, batchSize, progress, builder.AddLeaf); err != nil {
+		return nil, err
+	}
+
+	return builder.Root(), nil
+}
+
+// GetHeadersRootHash computes checkpoint root hash of consecutive bor headers
+func GetHeadersRootHash(headers []*ethTypes.Header) []byte {
+	return BuildRootHash(borRootHashBuilder{}, headers)
+}
+
+// FetchBlockHashesRoot computes merkle root of hashes of bor blocks [start, end], which checkpoint
+// proposers attest to. Tree is built same as checkpoint root hash, with block hashes as leaves.
+func FetchBlockHashesRoot(ctx context.Context, rpcClient *rpc.Client, start uint64, end uint64, batchSize uint64, progress RootHashProgress) ([]byte, error) {
+	builder := NewMerkleBuilder()
+	if err := fetchHeaders(ctx, rpcClient, start, end, batchSize, progress, func(header *ethTypes.Header) {
+		builder.AddLeaf(header.Hash().Bytes())
+	}); err != nil {
+		return nil, err
+	}
+
+	return builder.Root(), nil
+}
+
+// FetchBlockProof computes checkpoint root hash of bor blocks [start, end] along with
+// leaf and merkle branch of block, proof can be checked with VerifyBlockProof
+func FetchBlockProof(ctx context.Context, rpcClient *rpc.Client, start uint64, end uint64, blockNumber uint64, batchSize uint64) (root []byte, leaf []byte, proof []byte, err error) {
+	if blockNumber < start || blockNumber > end {
+		return nil, nil, nil, fmt.Errorf("block %v is not in range [%v, %v]", blockNumber, start, end)
+	}
+
+	leaves, err := FetchBlockLeaves(ctx, rpcClient, start, end, batchSize)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	index := blockNumber - start
+	root, proof = GetBlockProof(leaves, index)
+	return root, leaves[index], proof, nil
+}
+
+// FetchBlockLeaves fetches checkpoint leaves of bor blocks [start, end], proofs of any block
+// in range can be built from them with GetBlockProof
+func FetchBlockLeaves(ctx context.Context, rpcClient *rpc.Client, start uint64, end uint64, batchSize uint64) ([][]byte, error) {
+	var leaves [][]byte
+	if err := fetchLeaves(ctx, rpcClient, start, end, batchSize, nil, func(leaf []byte) {
+		leaves = append(leaves, leaf)
+	}); err != nil {
+		return nil, err
+	}
+
+	return leaves, nil
+}
+
+// GetBlockProof returns merkle root of leaves and branch of leaf at index.
+// Tree is padded with zero leaves up to next power of two, same as MerkleBuilder.
+func GetBlockProof(leaves [][]byte, index uint64) (root []byte, proof []byte) {
+	level := make([][]byte, nextPowerOfTwo(uint64(len(leaves))))
+	for i := range level {
+		if i < len(leaves) {
+			level[i] = leaves[i]
+		} else {
+			level[i] = make([]byte, 32)
+		}
+	}
+
+	for len(level) > 1 {
+		proof = append(proof, level[index^1]...)
+
+		next := make([][]byte, 0, len(level)/2)
+		for i := 0; i < len(level); i += 2 {
+			next = append(next, keccak256(level[i], level[i+1]))
+		}
+		level, index = next, index/2
+	}
+
+	return level[0], proof
+}
+
+// fetchLeaves fetches headers of bor blocks [start, end] with batch rpc calls and passes their leaves in order
+func fetchLeaves(ctx context.Context, rpcClient *rpc.Client, start uint64, end uint64, batchSize uint64, progress RootHashProgress, add func(leaf []byte)) error {
+	return fetchHeaders(ctx, rpcClient, start, end, batchSize, progress, func(header *ethTypes.Header) {
+		add(GetBlockHeaderLeaf(header.Number.Uint64(), header.Time, header.TxHash, header.ReceiptHash))
+	})
+}
+
+// fetchHeaders fetches headers of bor blocks [start, end] with batch rpc calls and passes them in order
+func fetchHeaders(ctx context.Context, rpcClient *rpc.Client, start uint64, end uint64, batchSize uint64, progress RootHashProgress, add func(header *ethTypes.Header)) error {
+	if start > end {
+		return errors.New("start is greater than end")
+	}
+
+	if batchSize == 0 {
+		batchSize = DefaultRootHashBatchSize
+	}
+
+	total := end - start + 1
+
+	for from := start; ; from += batchSize {
+		to := end
+		if end-from >= batchSize {
+			to = from + batchSize - 1
+		}
+
+		headers := make([]*ethTypes.Header, to-from+1)
+		elements := make([]rpc.BatchElem, len(headers))
+		for i := range elements {
+			elements[i] = rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []interface{}{hexutil.EncodeUint64(from + uint64(i)), false},
+				Result: &headers[i],
+			}
+		}
+
+		if err := rpcClient.BatchCallContext(ctx, elements); err != nil {
+			return err
+		}
+
+		for i, element := range elements {
+			if element.Error != nil {
+				return element.Error
+			}
+
+			header := headers[i]
+			if header == nil || header.Number == nil {
+				return fmt.Errorf("block %v not found", from+uint64(i))
+			}
+
+			add(header)
+		}
+
+		if progress != nil {
+			progress(to-start+1, total)
+		}
+
+		if to == end {
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	ethTypes "github.com/maticnetwork/bor/core/types"
+)
+
+// KeyRootHashAlgorithms param key of root hash algorithms of child chains.
+// Child chains which aren't listed are checkpointed with bor root hash.
+var KeyRootHashAlgorithms = []byte("RootHashAlgorithms")
+
+const (
+	// RootHashAlgorithmBor keccak256(number, time, txRoot, receiptRoot) leaves, as bor commits headers
+	RootHashAlgorithmBor = "bor"
+	// RootHashAlgorithmBlockHash block hash leaves, for EVM chains without bor header commitment
+	RootHashAlgorithmBlockHash = "block-hash"
+
+	// DefaultRootHashAlgorithm algorithm of child chains without one in params
+	DefaultRootHashAlgorithm = RootHashAlgorithmBor
+)
+
+// RootHashBuilder computes checkpoint root hash of child chain headers.
+// Leaves are merkleized same way by every builder, they only differ in header commitment.
+type RootHashBuilder interface {
+	// Name returns algorithm name builder is selected by in params
+	Name() string
+	// Leaf returns merkle tree leaf of child chain header
+	Leaf(header *ethTypes.Header) []byte
+}
+
+// RootHashAlgorithm root hash algorithm selected for child chain
+type RootHashAlgorithm struct {
+	ChainID   string `json:"chain_id" yaml:"chain_id"`
+	Algorithm string `json:"algorithm" yaml:"algorithm"`
+}
+
+func (a RootHashAlgorithm) String() string {
+	return fmt.Sprintf("RootHashAlgorithm{%v: %v}", a.ChainID, a.Algorithm)
+}
+
+type borRootHashBuilder struct{}
+
+func (borRootHashBuilder) Name() string { return RootHashAlgorithmBor }
+
+func (borRootHashBuilder) Leaf(header *ethTypes.Header) []byte {
+	return GetBlockHeaderLeaf(header.Number.Uint64(), header.Time, header.TxHash, header.ReceiptHash)
+}
+
+type blockHashRootHashBuilder struct{}
+
+func (blockHashRootHashBuilder) Name() string { return RootHashAlgorithmBlockHash }
+
+func (blockHashRootHashBuilder) Leaf(header *ethTypes.Header) []byte {
+	return header.Hash().Bytes()
+}
+
+var (
+	rootHashBuildersMu sync.RWMutex
+	rootHashBuilders   = map[string]RootHashBuilder{
+		RootHashAlgorithmBor:       borRootHashBuilder{},
+		RootHashAlgorithmBlockHash: blockHashRootHashBuilder{},
+	}
+)
+
+// RegisterRootHashBuilder makes builder selectable in params by its name.
+// It panics if builder with same name is already registered.
+func RegisterRootHashBuilder(builder RootHashBuilder) {
+	rootHashBuildersMu.Lock()
+	defer rootHashBuildersMu.Unlock()
+
+	if _, ok := rootHashBuilders[builder.Name()]; ok {
+		panic(fmt.Sprintf("root hash builder %v is already registered", builder.Name()))
+	}
+	rootHashBuilders[builder.Name()] = builder
+}
+
+// GetRootHashBuilder returns registered builder of algorithm
+func GetRootHashBuilder(algorithm string) (RootHashBuilder, error) {
+	rootHashBuildersMu.RLock()
+	defer rootHashBuildersMu.RUnlock()
+
+	builder, ok := rootHashBuilders[algorithm]
+	if !ok {
+		return nil, fmt.Errorf("unknown root hash algorithm %v", algorithm)
+	}
+	return builder, nil
+}
+
+// GetRootHashAlgorithms returns names of registered root hash algorithms
+func GetRootHashAlgorithms() []string {
+	rootHashBuildersMu.RLock()
+	defer rootHashBuildersMu.RUnlock()
+
+	names := make([]string, 0, len(rootHashBuilders))
+	for name := range rootHashBuilders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetChainRootHashAlgorithm returns algorithm selected for child chain, default one if it's not listed
+func GetChainRootHashAlgorithm(algorithms []RootHashAlgorithm, chainID string) string {
+	for _, algorithm := range algorithms {
+		if algorithm.ChainID == chainID {
+			return algorithm.Algorithm
+		}
+	}
+	return DefaultRootHashAlgorithm
+}
+
+// ValidateRootHashAlgorithms checks every child chain is listed once with registered algorithm
+func ValidateRootHashAlgorithms(algorithms []RootHashAlgorithm) error {
+	chains := make(map[string]bool, len(algorithms))
+	for _, algorithm := range algorithms {
+		if algorithm.ChainID == "" {
+			return fmt.Errorf("chain id of %v should not be empty", algorithm)
+		}
+
+		if chains[algorithm.ChainID] {
+			return fmt.Errorf("duplicate root hash algorithm of chain %v", algorithm.ChainID)
+		}
+		chains[algorithm.ChainID] = true
+
+		if _, err := GetRootHashBuilder(algorithm.Algorithm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BuildRootHash computes checkpoint root hash of consecutive child chain headers with builder
+func BuildRootHash(builder RootHashBuilder, headers []*ethTypes.Header) []byte {
+	merkle := NewMerkleBuilder()
+	for _, header := range headers {
+		merkle.AddLeaf(builder.Leaf(header))
+	}
+
+	return merkle.Root()
+}
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// naiveRootHash builds whole padded tree in memory
+func naiveRootHash(leaves [][]byte) []byte {
+	level := make([][]byte, nextPowerOfTwo(uint64(len(leaves))))
+	for i := range level {
+		if i < len(leaves) {
+			level[i] = leaves[i]
+		} else {
+			level[i] = make([]byte, 32)
+		}
+	}
+
+	for len(level) > 1 {
+		var next [][]byte
+		for i := 0; i < len(level); i += 2 {
+			next = append(next, keccak256(level[i], level[i+1]))
+		}
+		level = next
+	}
+
+	return level[0]
+}
+
+func testLeaf(number uint64) []byte {
+	return GetBlockHeaderLeaf(number, 1000+number, common.BytesToHash([]byte{byte(number)}), common.BytesToHash([]byte{byte(number + 1)}))
+}
+
+func testHeader(number uint64) *ethTypes.Header {
+	return &ethTypes.Header{
+		Number:      new(big.Int).SetUint64(number),
+		Time:        1000 + number,
+		TxHash:      common.BytesToHash([]byte{byte(number)}),
+		ReceiptHash: common.BytesToHash([]byte{byte(number + 1)}),
+		Difficulty:  big.NewInt(1),
+	}
+}
+
+func TestMerkleBuilder(t *testing.T) {
+	t.Parallel()
+
+	for n := 1; n <= 33; n++ {
+		var leaves [][]byte
+		builder := NewMerkleBuilder()
+		for i := 0; i < n; i++ {
+			leaf := testLeaf(uint64(i))
+			leaves = append(leaves, leaf)
+			builder.AddLeaf(leaf)
+		}
+
+		require.Equal(t, naiveRootHash(leaves), builder.Root(), "root should match full tree for %d leaves", n)
+		require.Equal(t, uint64(n), builder.Count())
+	}
+}
+
+func TestFetchRootHash(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		ID     json.RawMessage `json:"id"`
+		Params []interface{}   `json:"params"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		var reqs []request
+		require.NoError(t, json.Unmarshal(body, &reqs))
+
+		var resps []map[string]interface{}
+		for _, req := range reqs {
+			number, err := hexutil.DecodeUint64(req.Params[0].(string))
+			require.NoError(t, err)
+
+			resps = append(resps, map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": testHeader(number)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resps))
+	}))
+	defer server.Close()
+
+	rpcClient, err := rpc.Dial(server.URL)
+	require.NoError(t, err)
+
+	var leaves [][]byte
+	for i := uint64(10); i <= 30; i++ {
+		leaves = append(leaves, testLeaf(i))
+	}
+
+	var progress []uint64
+	root, err := FetchRootHash(context.Background(), rpcClient, 10, 30, 8, func(processed uint64, total uint64) {
+		require.Equal(t, uint64(21), total)
+		progress = append(progress, processed)
+	})
+	require.NoError(t, err)
+	require.Equal(t, naiveRootHash(leaves), root)
+	require.Equal(t, []uint64{8, 16, 21}, progress)
+
+	_, err = FetchRootHash(context.Background(), rpcClient, 30, 10, 8, nil)
+	require.Error(t, err)
+
+	// block hashes root is built over hashes of same headers
+	var hashes [][]byte
+	for i := uint64(10); i <= 30; i++ {
+		hashes = append(hashes, testHeader(i).Hash().Bytes())
+	}
+
+	root, err = FetchBlockHashesRoot(context.Background(), rpcClient, 10, 30, 8, nil)
+	require.NoError(t, err)
+	require.Equal(t, naiveRootHash(hashes), root)
+}
+
+func TestGetBlockProof(t *testing.T) {
+	t.Parallel()
+
+	for n := 1; n <= 9; n++ {
+		var leaves [][]byte
+		for i := 0; i < n; i++ {
+			leaves = append(leaves, testLeaf(uint64(i)))
+		}
+
+		for i := 0; i < n; i++ {
+			root, proof := GetBlockProof(leaves, uint64(i))
+			require.Equal(t, naiveRootHash(leaves), root)
+
+			valid, err := VerifyBlockProof(leaves[i], uint64(i), root, proof)
+			require.NoError(t, err)
+			require.True(t, valid, "proof of leaf %d in %d leaves should be valid", i, n)
+		}
+	}
+}
+
+func TestRootHashBuilders(t *testing.T) {
+	t.Parallel()
+
+	headers := []*ethTypes.Header{testHeader(1), testHeader(2), testHeader(3)}
+
+	bor, err := GetRootHashBuilder(GetChainRootHashAlgorithm(nil, "15001"))
+	require.NoError(t, err)
+	require.Equal(t, GetHeadersRootHash(headers), BuildRootHash(bor, headers))
+	require.Equal(t, naiveRootHash([][]byte{testLeaf(1), testLeaf(2), testLeaf(3)}), BuildRootHash(bor, headers))
+
+	algorithms := []RootHashAlgorithm{{ChainID: "56", Algorithm: RootHashAlgorithmBlockHash}}
+	require.NoError(t, ValidateRootHashAlgorithms(algorithms))
+
+	blockHash, err := GetRootHashBuilder(GetChainRootHashAlgorithm(algorithms, "56"))
+	require.NoError(t, err)
+	require.Equal(t, naiveRootHash([][]byte{headers[0].Hash().Bytes(), headers[1].Hash().Bytes(), headers[2].Hash().Bytes()}), BuildRootHash(blockHash, headers))
+
+	// unknown algorithms and chains listed twice are rejected
+	require.Error(t, ValidateRootHashAlgorithms([]RootHashAlgorithm{{ChainID: "56", Algorithm: "sha256"}}))
+	require.Error(t, ValidateRootHashAlgorithms(append(algorithms, RootHashAlgorithm{ChainID: "56", Algorithm: RootHashAlgorithmBor})))
+	require.Panics(t, func() { RegisterRootHashBuilder(borRootHashBuilder{}) })
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// StandbyProposer is backup proposer for current checkpoint slot
+type StandbyProposer struct {
+	Validator  hmTypes.Validator `json:"validator"`
+	Position   uint64            `json:"position"`    // 1-based position in standby list
+	EligibleAt uint64            `json:"eligible_at"` // unix time after which proposals are accepted
+}
+
+// NewStandbyProposer creates new standby proposer
+func NewStandbyProposer(validator hmTypes.Validator, position uint64, eligibleAt uint64) StandbyProposer {
+	return StandbyProposer{
+		Validator:  validator,
+		Position:   position,
+		EligibleAt: eligibleAt,
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointStatus aggregated checkpoint state of root chain as seen by heimdall and root chain contract
+type CheckpointStatus struct {
+	RootChain           string                  `json:"root_chain"`
+	AckCount            uint64                  `json:"ack_count"`
+	Buffer              *hmTypes.Checkpoint     `json:"buffer,omitempty"`
+	BufferExpiresAt     uint64                  `json:"buffer_expires_at,omitempty"`
+	BufferExpiresIn     int64                   `json:"buffer_expires_in,omitempty"` // seconds, negative once expired
+	LastNoAck           uint64                  `json:"last_no_ack"`
+	Proposer            hmTypes.HeimdallAddress `json:"proposer"`
+	ContractHeaderBlock uint64                  `json:"contract_header_block"`
+	ContractError       string                  `json:"contract_error,omitempty"`
+}
+
+// CheckpointCostEstimate estimated root chain cost of submitting checkpoint in buffer with its current signatures
+type CheckpointCostEstimate struct {
+	RootChain  string                  `json:"root_chain"`
+	Checkpoint *hmTypes.Checkpoint     `json:"checkpoint,omitempty"`
+	Proposer   hmTypes.HeimdallAddress `json:"proposer"`
+	Signers    int                     `json:"signers"`
+	GasLimit   uint64                  `json:"gas_limit,omitempty"`
+	GasPrice   string                  `json:"gas_price,omitempty"` // wei
+	Cost       string                  `json:"cost,omitempty"`      // wei on evm chains, fee limit in sun on tron
+	Error      string                  `json:"error,omitempty"`
+}
+
+// CheckpointStats aggregated checkpoint stats of all root chains at height, served to dashboards
+type CheckpointStats struct {
+	Height     int64                      `json:"height"`
+	NoAckCount uint64                     `json:"no_ack_count"`
+	LastNoAck  uint64                     `json:"last_no_ack"`
+	RootChains []RootChainCheckpointStats `json:"root_chains"`
+}
+
+// RootChainCheckpointStats aggregated acked checkpoints of root chain
+type RootChainCheckpointStats struct {
+	RootChain              string                  `json:"root_chain"`
+	TotalCheckpoints       uint64                  `json:"total_checkpoints"`
+	AvgInterval            uint64                  `json:"avg_interval"` // seconds between acked checkpoints
+	AvgBlocksPerCheckpoint uint64                  `json:"avg_blocks_per_checkpoint"`
+	Last24hCount           uint64                  `json:"last_24h_count"`
+	CurrentProposer        hmTypes.HeimdallAddress `json:"current_proposer"`        // proposer of latest checkpoint
+	CurrentProposerStreak  uint64                  `json:"current_proposer_streak"` // consecutive latest checkpoints of current proposer
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"sort"
+	"time"
+)
+
+// KeyAckLatencySLA param key of max ack latency of checkpoint. Acks slower than it emit sla breach
+// event. While it's not set (or zero) breaches are not reported.
+var KeyAckLatencySLA = []byte("AckLatencySLA")
+
+// DefaultTimingQueryLimit number of latest checkpoints timing query covers by default, and MaxTimingQueryLimit at most
+const (
+	DefaultTimingQueryLimit uint64 = 100
+	MaxTimingQueryLimit     uint64 = 1000
+)
+
+// CheckpointTiming records when checkpoint was buffered, when first ack of it reached heimdall (bridge
+// saw it submitted on root chain) and when ack was accepted. Times are unix seconds of block time.
+type CheckpointTiming struct {
+	RootChain   string `json:"root_chain"`
+	Number      uint64 `json:"number"`
+	BufferedAt  uint64 `json:"buffered_at"`
+	SubmittedAt uint64 `json:"submitted_at"`
+	AckedAt     uint64 `json:"acked_at"`
+}
+
+// AckLatency returns seconds from buffering checkpoint to its ack
+func (t CheckpointTiming) AckLatency() uint64 {
+	return elapsed(t.BufferedAt, t.AckedAt)
+}
+
+// SubmissionLatency returns seconds from buffering checkpoint to submission of its ack,
+// which covers root chain submission, confirmations and bridge detection
+func (t CheckpointTiming) SubmissionLatency() uint64 {
+	return elapsed(t.BufferedAt, t.SubmittedAt)
+}
+
+// IsSLABreached returns true if ack took longer than sla, zero sla is never breached
+func (t CheckpointTiming) IsSLABreached(sla time.Duration) bool {
+	return sla > 0 && t.AckLatency() > uint64(sla.Seconds())
+}
+
+func elapsed(from uint64, to uint64) uint64 {
+	if to < from {
+		return 0
+	}
+	return to - from
+}
+
+// LatencyDistribution summary of latencies in seconds
+type LatencyDistribution struct {
+	Count uint64 `json:"count"`
+	Min   uint64 `json:"min"`
+	Max   uint64 `json:"max"`
+	Mean  uint64 `json:"mean"`
+	P50   uint64 `json:"p50"`
+	P90   uint64 `json:"p90"`
+	P99   uint64 `json:"p99"`
+}
+
+// NewLatencyDistribution returns distribution of latencies, percentiles are nearest-rank
+func NewLatencyDistribution(latencies []uint64) LatencyDistribution {
+	if len(latencies) == 0 {
+		return LatencyDistribution{}
+	}
+
+	sorted := make([]uint64, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum uint64
+	for _, latency := range sorted {
+		sum += latency
+	}
+
+	percentile := func(p uint64) uint64 {
+		rank := (p*uint64(len(sorted)) + 99) / 100
+		if rank == 0 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	}
+
+	return LatencyDistribution{
+		Count: uint64(len(sorted)),
+		Min:   sorted[0],
+		Max:   sorted[len(sorted)-1],
+		Mean:  sum / uint64(len(sorted)),
+		P50:   percentile(50),
+		P90:   percentile(90),
+		P99:   percentile(99),
+	}
+}
+
+// CheckpointTimings timings of latest acked checkpoints of root chain with their latency distributions
+type CheckpointTimings struct {
+	RootChain         string              `json:"root_chain"`
+	AckLatencySLA     time.Duration       `json:"ack_latency_sla"`
+	SLABreaches       uint64              `json:"sla_breaches"`
+	AckLatency        LatencyDistribution `json:"ack_latency"`
+	SubmissionLatency LatencyDistribution `json:"submission_latency"`
+	Timings           []CheckpointTiming  `json:"timings"`
+}
+
+// NewCheckpointTimings summarizes timings of root chain against sla
+func NewCheckpointTimings(rootChain string, sla time.Duration, timings []CheckpointTiming) CheckpointTimings {
+	result := CheckpointTimings{
+		RootChain:     rootChain,
+		AckLatencySLA: sla,
+		Timings:       timings,
+	}
+
+	ackLatencies := make([]uint64, 0, len(timings))
+	submissionLatencies := make([]uint64, 0, len(timings))
+	for _, timing := range timings {
+		ackLatencies = append(ackLatencies, timing.AckLatency())
+		submissionLatencies = append(submissionLatencies, timing.SubmissionLatency())
+		if timing.IsSLABreached(sla) {
+			result.SLABreaches++
+		}
+	}
+
+	result.AckLatency = NewLatencyDistribution(ackLatencies)
+	result.SubmissionLatency = NewLatencyDistribution(submissionLatencies)
+
+	return result
+}
+
+// QueryCheckpointTimingParams defines the params for querying timings of latest checkpoints
+type QueryCheckpointTimingParams struct {
+	RootChain string `json:"root_chain"`
+	Limit     uint64 `json:"limit"`
+}
+
+// NewQueryCheckpointTimingParams creates a new instance of QueryCheckpointTimingParams
+func NewQueryCheckpointTimingParams(rootChain string, limit uint64) QueryCheckpointTimingParams {
+	return QueryCheckpointTimingParams{RootChain: rootChain, Limit: limit}
+}
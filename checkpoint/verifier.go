@@ -0,0 +1,215 @@
+package checkpoint
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// RootChainHeader is checkpoint header as stored on root chain
+type RootChainHeader struct {
+	RootHash   hmTypes.HeimdallHash
+	StartBlock uint64
+	EndBlock   uint64
+	CreatedAt  uint64
+	Proposer   hmTypes.HeimdallAddress
+}
+
+// RootChainVerifier reads checkpoint state from root chain.
+// Implementations hide chain specific types (contract bindings, address formats),
+// so checkpoint handlers stay chain agnostic.
+type RootChainVerifier interface {
+	// GetHeader returns checkpoint header by checkpoint number
+	GetHeader(number uint64) (RootChainHeader, error)
+	// GetHeaderAtTx returns checkpoint header as of root chain block which included tx
+	GetHeaderAtTx(number uint64, txHash hmTypes.HeimdallHash) (RootChainHeader, error)
+	// CurrentHeaderBlock returns number of last checkpoint submitted to root chain
+	CurrentHeaderBlock() (uint64, error)
+}
+
+// RootChainVerifierFactory builds verifier for root chain using chain params in effect
+type RootChainVerifierFactory func(rootChain string, chainParams chainmanagerTypes.Params, contractCaller helper.IContractCaller) (RootChainVerifier, error)
+
+// rootChainVerifierFactories maps root chain type to its verifier factory
+var rootChainVerifierFactories = map[string]RootChainVerifierFactory{
+	hmTypes.RootChainTypeEth:  newEVMRootChainVerifier,
+	hmTypes.RootChainTypeBsc:  newEVMRootChainVerifier,
+	hmTypes.RootChainTypeTron: newTronRootChainVerifier,
+}
+
+// RegisterRootChainVerifier registers verifier factory for root chain type
+func RegisterRootChainVerifier(rootChain string, factory RootChainVerifierFactory) {
+	rootChainVerifierFactories[rootChain] = factory
+}
+
+// GetRootChainVerifier returns verifier for root chain with chain params in effect at current height
+func (k *Keeper) GetRootChainVerifier(ctx sdk.Context, rootChain string, contractCaller helper.IContractCaller) (RootChainVerifier, error) {
+	return k.GetRootChainVerifierAt(ctx, rootChain, ctx.BlockHeight(), contractCaller)
+}
+
+// GetRootChainVerifierAt returns verifier for root chain with chain params in effect at height
+func (k *Keeper) GetRootChainVerifierAt(ctx sdk.Context, rootChain string, height int64, contractCaller helper.IContractCaller) (RootChainVerifier, error) {
+	factory, ok := rootChainVerifierFactories[rootChain]
+	if !ok {
+		return nil, fmt.Errorf("no verifier for root chain %v", rootChain)
+	}
+
+	// chain params are read from height-indexed snapshots, stays valid on replay
+	chainParams, err := k.ck.GetChainParamsAt(ctx, rootChain, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return factory(rootChain, chainParams, contractCaller)
+}
+
+// VerifyRootChainHeader checks if header on root chain matches checkpoint data
+func VerifyRootChainHeader(header RootChainHeader, startBlock uint64, endBlock uint64, proposer hmTypes.HeimdallAddress, rootHash *hmTypes.HeimdallHash) error {
+	if header.StartBlock != startBlock || header.EndBlock != endBlock {
+		return errors.New("checkpoint range doesn't match with root chain")
+	}
+
+	if !header.Proposer.Equals(proposer) {
+		return errors.New("checkpoint proposer doesn't match with root chain")
+	}
+
+	// root hash is not part of every msg
+	if rootHash != nil && !header.RootHash.Equals(*rootHash) {
+		return errors.New("checkpoint root hash doesn't match with root chain")
+	}
+
+	return nil
+}
+
+//
+// EVM
+//
+
+type evmRootChainVerifier struct {
+	rootChain          string
+	address            hmTypes.HeimdallAddress
+	childBlockInterval uint64
+	contractCaller     helper.IContractCaller
+}
+
+func newEVMRootChainVerifier(rootChain string, chainParams chainmanagerTypes.Params, contractCaller helper.IContractCaller) (RootChainVerifier, error) {
+	return &evmRootChainVerifier{
+		rootChain:          rootChain,
+		address:            chainParams.ChainParams.RootChainAddress,
+		childBlockInterval: chainParams.ChildBlockInterval,
+		contractCaller:     contractCaller,
+	}, nil
+}
+
+func (v *evmRootChainVerifier) GetHeader(number uint64) (header RootChainHeader, err error) {
+	return v.getHeaderAt(number, 0)
+}
+
+func (v *evmRootChainVerifier) GetHeaderAtTx(number uint64, txHash hmTypes.HeimdallHash) (header RootChainHeader, err error) {
+	receipt, err := v.contractCaller.GetMainTxReceipt(txHash.EthHash(), v.rootChain)
+	if err != nil {
+		return header, err
+	}
+	if receipt == nil || receipt.BlockNumber == nil {
+		return header, errors.New("tx receipt not found")
+	}
+
+	// tx block has to be final if root chain has finality tag configured
+	finalized, err := v.contractCaller.GetFinalizedCallOpts(v.rootChain)
+	if err != nil {
+		return header, err
+	}
+	if finalized != nil && receipt.BlockNumber.Cmp(finalized.BlockNumber) > 0 {
+		return header, fmt.Errorf("tx block %v is not finalized yet, finalized block %v", receipt.BlockNumber, finalized.BlockNumber)
+	}
+
+	// bsc blocks reorg until quorum of parlia validators sealed past them
+	if v.rootChain == hmTypes.RootChainTypeBsc && helper.GetConfig().BscParliaFinality {
+		sealed, err := v.contractCaller.IsParliaFinalized(receipt.BlockNumber.Uint64())
+		if err != nil {
+			return header, err
+		}
+		if !sealed {
+			return header, fmt.Errorf("tx block %v is not sealed past by quorum of parlia validators yet", receipt.BlockNumber)
+		}
+	}
+
+	return v.getHeaderAt(number, receipt.BlockNumber.Uint64())
+}
+
+func (v *evmRootChainVerifier) CurrentHeaderBlock() (uint64, error) {
+	rootChainInstance, err := v.contractCaller.GetRootChainInstance(v.address.EthAddress(), v.rootChain)
+	if err != nil {
+		return 0, err
+	}
+
+	return v.contractCaller.CurrentHeaderBlock(rootChainInstance, v.childBlockInterval)
+}
+
+// getHeaderAt returns header as of block number, 0 means latest block
+func (v *evmRootChainVerifier) getHeaderAt(number uint64, blockNumber uint64) (header RootChainHeader, err error) {
+	rootChainInstance, err := v.contractCaller.GetRootChainInstance(v.address.EthAddress(), v.rootChain)
+	if err != nil {
+		return header, err
+	}
+
+	root, start, end, createdAt, proposer, err := v.contractCaller.GetHeaderInfoAt(number, rootChainInstance, v.childBlockInterval, blockNumber)
+	if err != nil {
+		return header, err
+	}
+
+	return RootChainHeader{
+		RootHash:   hmTypes.BytesToHeimdallHash(root.Bytes()),
+		StartBlock: start,
+		EndBlock:   end,
+		CreatedAt:  createdAt,
+		Proposer:   proposer,
+	}, nil
+}
+
+//
+// Tron
+//
+
+type tronRootChainVerifier struct {
+	address            string
+	childBlockInterval uint64
+	contractCaller     helper.IContractCaller
+}
+
+func newTronRootChainVerifier(rootChain string, chainParams chainmanagerTypes.Params, contractCaller helper.IContractCaller) (RootChainVerifier, error) {
+	return &tronRootChainVerifier{
+		address:            chainParams.ChainParams.TronChainAddress.Hex(),
+		childBlockInterval: chainParams.ChildBlockInterval,
+		contractCaller:     contractCaller,
+	}, nil
+}
+
+func (v *tronRootChainVerifier) GetHeader(number uint64) (header RootChainHeader, err error) {
+	root, start, end, createdAt, proposer, err := v.contractCaller.GetTronHeaderInfo(number, v.address, v.childBlockInterval)
+	if err != nil {
+		return header, err
+	}
+
+	return RootChainHeader{
+		RootHash:   hmTypes.BytesToHeimdallHash(root.Bytes()),
+		StartBlock: start,
+		EndBlock:   end,
+		CreatedAt:  createdAt,
+		Proposer:   proposer,
+	}, nil
+}
+
+// GetHeaderAtTx returns latest header, historical contract calls are not supported on tron
+func (v *tronRootChainVerifier) GetHeaderAtTx(number uint64, txHash hmTypes.HeimdallHash) (RootChainHeader, error) {
+	return v.GetHeader(number)
+}
+
+func (v *tronRootChainVerifier) CurrentHeaderBlock() (uint64, error) {
+	return v.contractCaller.GetTronCurrentHeaderBlock(v.address, v.childBlockInterval)
+}
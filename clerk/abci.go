@@ -0,0 +1,30 @@
+package clerk
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker prunes records bor acknowledged as processed, bounded per block,
+// and exports them to local archive if it is enabled
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	if k.GetAckedID(ctx) <= k.GetPrunedID(ctx) {
+		return
+	}
+
+	pruned := k.PruneEventRecords(ctx, MaxRecordsPrunedPerBlock)
+	if len(pruned) == 0 {
+		return
+	}
+
+	k.Logger(ctx).Debug("Pruned state records", "from", pruned[0].Record.ID, "to", pruned[len(pruned)-1].Record.ID)
+
+	if k.archive == nil {
+		return
+	}
+
+	for _, record := range pruned {
+		if err := k.archive.Put(record); err != nil {
+			k.Logger(ctx).Error("Unable to archive pruned record", "id", record.Record.ID, "error", err)
+		}
+	}
+}
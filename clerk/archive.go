@@ -0,0 +1,74 @@
+package clerk
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/maticnetwork/heimdall/clerk/types"
+)
+
+// archiveBucketSize records kept in single archive directory
+const archiveBucketSize uint64 = 10000
+
+// RecordArchive keeps records pruned from store in local files, one file per record
+// grouped in directories of archiveBucketSize records. It is node local and not part
+// of consensus, writing same record again (e.g. on block replay) overwrites it.
+type RecordArchive struct {
+	dir string
+}
+
+// NewRecordArchive creates archive writing to dir
+func NewRecordArchive(dir string) *RecordArchive {
+	return &RecordArchive{dir: dir}
+}
+
+// GetRecordArchiveDir returns archive directory in node home
+func GetRecordArchiveDir(homeDir string) string {
+	return filepath.Join(homeDir, "data", "clerk-archive")
+}
+
+// Put writes archived record
+func (a *RecordArchive) Put(record types.ArchivedEventRecord) error {
+	path := a.path(record.Record.ID)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	// write to temp file first, so readers never see partial record
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, bz, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Get returns archived record of heimdall record id
+func (a *RecordArchive) Get(id uint64) (*types.ArchivedEventRecord, error) {
+	bz, err := ioutil.ReadFile(a.path(id))
+	if os.IsNotExist(err) {
+		return nil, errors.New("No archived record found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var record types.ArchivedEventRecord
+	if err := json.Unmarshal(bz, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (a *RecordArchive) path(id uint64) string {
+	bucket := strconv.FormatUint(id/archiveBucketSize, 10)
+	return filepath.Join(a.dir, bucket, strconv.FormatUint(id, 10)+".json")
+}
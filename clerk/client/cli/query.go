@@ -33,6 +33,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	queryCmds.AddCommand(
 		client.GetCommands(
 			GetStateRecord(cdc),
+			GetPruneStatus(cdc),
 		)...,
 	)
 
@@ -77,8 +78,7 @@ func GetStateRecord(cdc *codec.Codec) *cobra.Command {
 				return errors.New("Record not found")
 			}
 
-			fmt.Println(string(res))
-			return nil
+			return hmClient.PrintRawOutput(cliCtx, res)
 		},
 	}
 
@@ -90,3 +90,26 @@ func GetStateRecord(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetPruneStatus get latest, acknowledged and pruned record ids
+func GetPruneStatus(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-status",
+		Short: "show latest, acknowledged by bor and pruned state record ids",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", clerkTypes.QuerierRoute, clerkTypes.QueryPruneStatus),
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintRawOutput(cliCtx, res)
+		},
+	}
+
+	return cmd
+}
@@ -30,6 +30,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		client.PostCommands(
 			CreateNewStateRecord(cdc),
+			AckStateSync(cdc),
 		)...,
 	)
 	return txCmd
@@ -160,3 +161,37 @@ func CreateNewStateRecord(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// AckStateSync send state sync ack transaction
+func AckStateSync(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ack",
+		Short: "acknowledge state records up to id are processed by bor",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := types.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			stateID := viper.GetUint64(FlagRecordID)
+			if stateID == 0 {
+				return fmt.Errorf("record id cannot be empty")
+			}
+
+			msg := clerkTypes.NewMsgStateSyncAck(proposer, stateID)
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Uint64(FlagRecordID, 0, "--id=<record-id>")
+
+	if err := cmd.MarkFlagRequired(FlagRecordID); err != nil {
+		logger.Error("AckStateSync | MarkFlagRequired | FlagRecordID", "Error", err)
+	}
+
+	return cmd
+}
@@ -28,6 +28,10 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/clerk/isoldtx",
 		DepositTxStatusHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/clerk/prune-status",
+		pruneStatusHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // rootchain only: recordHandlerFn returns record by record id
@@ -203,6 +207,25 @@ func DepositTxStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// pruneStatusHandlerFn returns latest, acknowledged and pruned record ids
+func pruneStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPruneStatus), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		hmRest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 //
 // Internal helpers
 //
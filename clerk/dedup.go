@@ -0,0 +1,66 @@
+package clerk
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/clerk/types"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// PendingEventRecordExpiry number of blocks event record stays pending. Side-tx of event record is
+// voted and executed within next two blocks, records nobody voted for can be sent again after expiry.
+const PendingEventRecordExpiry int64 = 64
+
+// GetPendingEventRecordKey returns key of pending event record of root chain tx log
+func GetPendingEventRecordKey(rootChainType string, txHash hmTypes.HeimdallHash, logIndex uint64) []byte {
+	key := append(append([]byte{}, PendingEventRecordPrefixKey...), []byte(rootChainType)...)
+	key = append(key, txHash.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(logIndex)...)
+}
+
+// SetPendingEventRecord marks event record as pending until its side-tx is executed
+func (k *Keeper) SetPendingEventRecord(ctx sdk.Context, msg types.MsgEventRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingEventRecordKey(msg.RootChainType, msg.TxHash, msg.LogIndex)
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// HasPendingEventRecord returns true if event record of root chain tx log is pending and not expired
+func (k *Keeper) HasPendingEventRecord(ctx sdk.Context, rootChainType string, txHash hmTypes.HeimdallHash, logIndex uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetPendingEventRecordKey(rootChainType, txHash, logIndex))
+	if bz == nil {
+		return false
+	}
+
+	return ctx.BlockHeight()-int64(sdk.BigEndianToUint64(bz)) < PendingEventRecordExpiry
+}
+
+// DeletePendingEventRecord removes pending mark of event record
+func (k *Keeper) DeletePendingEventRecord(ctx sdk.Context, msg types.MsgEventRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetPendingEventRecordKey(msg.RootChainType, msg.TxHash, msg.LogIndex))
+}
+
+// CheckEventRecordDuplicate rejects event record which is already synced or pending, keyed by
+// (root chain, tx hash, log index), so duplicates sent by redundant bridges don't take side-tx round
+func (k *Keeper) CheckEventRecordDuplicate(ctx sdk.Context, msg types.MsgEventRecord) sdk.Error {
+	if k.HasRootChainEventRecord(ctx, msg.RootChainType, msg.ID) {
+		return types.ErrEventRecordAlreadySynced(k.Codespace())
+	}
+
+	sequence := helper.CalculateSequence(new(big.Int).SetUint64(msg.BlockNumber), msg.LogIndex, msg.RootChainType)
+	if k.HasRecordSequence(ctx, sequence.String()) {
+		return hmCommon.ErrOldTx(k.Codespace())
+	}
+
+	if k.HasPendingEventRecord(ctx, msg.RootChainType, msg.TxHash, msg.LogIndex) {
+		return types.ErrEventRecordPending(k.Codespace())
+	}
+
+	return nil
+}
@@ -8,6 +8,16 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	// records are numbered after pruned ones
+	if data.PrunedID > 0 {
+		keeper.SetLatestID(ctx, data.PrunedID)
+		keeper.SetPrunedID(ctx, data.PrunedID)
+		keeper.SetPrunedRecordsHash(ctx, data.PrunedRecordsHash)
+	}
+	if data.AckedID > 0 {
+		keeper.SetAckedID(ctx, data.AckedID)
+	}
+
 	// add checkpoint headers
 	if len(data.EventRecords) != 0 {
 		for _, record := range data.EventRecords {
@@ -25,5 +35,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	return types.NewGenesisState(keeper.GetAllEventRecords(ctx), keeper.GetRecordSequences(ctx))
+	genesisState := types.NewGenesisState(keeper.GetAllEventRecords(ctx), keeper.GetRecordSequences(ctx))
+	genesisState.AckedID = keeper.GetAckedID(ctx)
+	genesisState.PrunedID = keeper.GetPrunedID(ctx)
+	genesisState.PrunedRecordsHash = keeper.GetPrunedRecordsHash(ctx)
+	return genesisState
 }
@@ -2,7 +2,6 @@ package clerk
 
 import (
 	"encoding/hex"
-	"math/big"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +20,8 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgEventRecord:
 			return handleMsgEventRecord(ctx, msg, k, contractCaller)
+		case types.MsgStateSyncAck:
+			return handleMsgStateSyncAck(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in clerk module").Result()
 		}
@@ -39,9 +40,10 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, c
 		"rootChainType", msg.RootChainType,
 	)
 
-	// check if event record exists
-	if exists := k.HasRootChainEventRecord(ctx, msg.RootChainType, msg.ID); exists {
-		return types.ErrEventRecordAlreadySynced(k.Codespace()).Result()
+	// check if event record exists or is waiting for votes of same tx log sent by other bridge
+	if err := k.CheckEventRecordDuplicate(ctx, msg); err != nil {
+		k.Logger(ctx).Debug("Duplicate event record", "id", msg.ID, "rootChainType", msg.RootChainType, "error", err)
+		return err.Result()
 	}
 
 	// chainManager params
@@ -54,15 +56,8 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, c
 		return common.ErrInvalidBorChainID(k.Codespace()).Result()
 	}
 
-	// sequence id
-	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := helper.CalculateSequence(blockNumber, msg.LogIndex, msg.RootChainType)
-
-	// check if incoming tx is older
-	if k.HasRecordSequence(ctx, sequence.String()) {
-		k.Logger(ctx).Error("Older invalid tx found")
-		return common.ErrOldTx(k.Codespace()).Result()
-	}
+	// reject duplicates until side-tx is executed
+	k.SetPendingEventRecord(ctx, msg)
 
 	// add events
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -81,3 +76,30 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, c
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+func handleMsgStateSyncAck(ctx sdk.Context, msg types.MsgStateSyncAck, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating state sync ack msg", "stateID", msg.StateID)
+
+	// ack should move forward and can't be ahead of records known to heimdall
+	if msg.StateID <= k.GetAckedID(ctx) || msg.StateID > k.GetLatestID(ctx) {
+		k.Logger(ctx).Error("Invalid state sync ack",
+			"stateID", msg.StateID,
+			"ackedID", k.GetAckedID(ctx),
+			"latestID", k.GetLatestID(ctx),
+		)
+		return types.ErrStateSyncAckInvalid(k.Codespace()).Result()
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStateSyncAck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAckedID, strconv.FormatUint(msg.StateID, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -95,6 +95,17 @@ func (suite *HandlerTestSuite) TestHandleMsgEventRecord() {
 		require.Error(t, err)
 	})
 
+	t.Run("PendingRecord", func(t *testing.T) {
+		// same tx log sent by other bridge while side-tx is waiting for votes
+		result := suite.handler(ctx, msg)
+		require.False(t, result.IsOK(), "should fail due to pending event record but succeeded")
+		require.Equal(t, types.CodeEventRecordPending, result.Code)
+
+		// pending mark expires if side-tx was never executed
+		expiredCtx := ctx.WithBlockHeight(ctx.BlockHeight() + clerk.PendingEventRecordExpiry)
+		require.False(t, app.ClerkKeeper.HasPendingEventRecord(expiredCtx, msg.RootChainType, msg.TxHash, msg.LogIndex))
+	})
+
 	t.Run("ExistingRecord", func(t *testing.T) {
 		// store event record in keeper
 		app.ClerkKeeper.SetEventRecord(ctx,
@@ -36,8 +36,18 @@ var (
 
 	RootIdToHeimdallIDPrefixKey = []byte{0x19} // store <rootChainID, hemidall chainID>
 
+	AckedIDKey = []byte{0x1A} // key of last heimdall record id processed by bor
+
+	PrunedIDKey = []byte{0x1B} // key of last heimdall record id pruned from store
+
+	PrunedRecordsHashKey = []byte{0x1C} // key of hash chain over pruned records
+
+	PendingEventRecordPrefixKey = []byte{0x1D} // prefix key of event records waiting for side-tx votes
 )
 
+// MaxRecordsPrunedPerBlock bounds records pruned in single end block
+const MaxRecordsPrunedPerBlock uint64 = 100
+
 // Keeper stores all related data
 type Keeper struct {
 	cdc *codec.Codec
@@ -49,6 +59,8 @@ type Keeper struct {
 	paramSpace subspace.Subspace
 	// chain param keeper
 	chainKeeper chainmanager.Keeper
+	// local archive of pruned records, nil if disabled
+	archive *RecordArchive
 }
 
 // NewKeeper create new keeper
@@ -74,6 +86,11 @@ func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
 }
 
+// SetArchive sets local archive pruned records are exported to
+func (k *Keeper) SetArchive(archive *RecordArchive) {
+	k.archive = archive
+}
+
 // Logger returns a module-specific logger
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -260,6 +277,10 @@ func (k *Keeper) HasRootChainEventRecord(ctx sdk.Context, rootChainType string,
 	if err != nil {
 		return false
 	}
+	// pruned records keep their root chain id mapping for replay protection
+	if *heimdallId <= k.GetPrunedID(ctx) {
+		return true
+	}
 	key := GetEventRecordKey(*heimdallId)
 	return store.Has(key)
 }
@@ -343,6 +364,106 @@ func (k *Keeper) GetEventRecordListWithTime(ctx sdk.Context, fromTime, toTime ti
 	return records, nil
 }
 
+// GetAckedID returns last heimdall record id bor acknowledged as processed
+func (k *Keeper) GetAckedID(ctx sdk.Context) uint64 {
+	return k.getID(ctx, AckedIDKey)
+}
+
+// SetAckedID sets last heimdall record id bor acknowledged as processed
+func (k *Keeper) SetAckedID(ctx sdk.Context, id uint64) {
+	k.setID(ctx, AckedIDKey, id)
+}
+
+// GetPrunedID returns last heimdall record id pruned from store, records up to it are gone
+func (k *Keeper) GetPrunedID(ctx sdk.Context) uint64 {
+	return k.getID(ctx, PrunedIDKey)
+}
+
+// SetPrunedID sets last heimdall record id pruned from store
+func (k *Keeper) SetPrunedID(ctx sdk.Context, id uint64) {
+	k.setID(ctx, PrunedIDKey, id)
+}
+
+// GetPrunedRecordsHash returns head of hash chain over pruned records
+func (k *Keeper) GetPrunedRecordsHash(ctx sdk.Context) hmTypes.HexBytes {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(PrunedRecordsHashKey)
+}
+
+// SetPrunedRecordsHash sets head of hash chain over pruned records
+func (k *Keeper) SetPrunedRecordsHash(ctx sdk.Context, hash hmTypes.HexBytes) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(PrunedRecordsHashKey, hash)
+}
+
+func (k *Keeper) getID(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(key) {
+		result, err := strconv.ParseUint(string(store.Get(key)), 10, 64)
+		if err == nil {
+			return result
+		}
+	}
+	return 0
+}
+
+func (k *Keeper) setID(ctx sdk.Context, key []byte, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, []byte(strconv.FormatUint(id, 10)))
+}
+
+// PruneEventRecords deletes up to max records acknowledged by bor, oldest first.
+// Root chain id mappings are kept for replay protection. Every pruned record extends
+// hash chain kept in store, so archived records can be verified against it.
+func (k *Keeper) PruneEventRecords(ctx sdk.Context, max uint64) (pruned []types.ArchivedEventRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	prunedID := k.GetPrunedID(ctx)
+	end := k.GetAckedID(ctx)
+	if end > prunedID+max {
+		end = prunedID + max
+	}
+	if end <= prunedID {
+		return nil
+	}
+
+	hash := k.GetPrunedRecordsHash(ctx)
+	for id := prunedID + 1; id <= end; id++ {
+		key := GetEventRecordKey(id)
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+
+		var record types.EventRecord
+		if err := k.cdc.UnmarshalBinaryBare(bz, &record); err != nil {
+			k.Logger(ctx).Error("PruneEventRecords | UnmarshalBinaryBare", "id", id, "error", err)
+			continue
+		}
+
+		store.Delete(key)
+		store.Delete(GetEventRecordKeyWithTime(record.ID, record.RecordTime))
+
+		hash = types.NextPrunedRecordsHash(hash, bz)
+		pruned = append(pruned, types.NewArchivedEventRecord(record, ctx.BlockHeight(), hash))
+	}
+
+	k.SetPrunedRecordsHash(ctx, hash)
+	k.SetPrunedID(ctx, end)
+	return pruned
+}
+
+// GetArchivedEventRecord returns pruned record from local archive
+func (k *Keeper) GetArchivedEventRecord(ctx sdk.Context, stateID uint64) (*types.ArchivedEventRecord, error) {
+	if stateID > k.GetPrunedID(ctx) {
+		return nil, errors.New("Record is not pruned")
+	}
+	if k.archive == nil {
+		return nil, errors.New("Record is pruned and archive is disabled")
+	}
+	return k.archive.Get(stateID)
+}
+
 //
 // GetEventRecordKey returns key for state record
 //
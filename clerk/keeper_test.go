@@ -1,6 +1,8 @@
 package clerk_test
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
@@ -199,3 +201,82 @@ func (suite *KeeperTestSuite) TestSetHasGetRecordSequence() {
 	recordSequences := ck.GetRecordSequences(ctx)
 	require.Len(t, recordSequences, 1)
 }
+
+func (suite *KeeperTestSuite) TestPruneEventRecords() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	hAddr := hmTypes.BytesToHeimdallAddress([]byte("some-address"))
+	hHash := hmTypes.BytesToHeimdallHash([]byte("some-address"))
+	ck := app.ClerkKeeper
+	for i := uint64(1); i <= 5; i++ {
+		testRecord := types.NewEventRecord(hHash, i, i, hAddr, make([]byte, 0), "1", time.Now(), hmTypes.RootChainTypeEth)
+		require.NoError(t, ck.SetEventRecord(ctx, testRecord))
+	}
+
+	// nothing is pruned before bor acknowledges records
+	require.Len(t, ck.PruneEventRecords(ctx, 10), 0)
+
+	ck.SetAckedID(ctx, 3)
+
+	// pruning is bounded by max
+	pruned := ck.PruneEventRecords(ctx, 2)
+	require.Len(t, pruned, 2)
+	require.Equal(t, uint64(2), ck.GetPrunedID(ctx))
+
+	// and by acked id
+	pruned = append(pruned, ck.PruneEventRecords(ctx, 10)...)
+	require.Len(t, pruned, 3)
+	require.Equal(t, uint64(3), ck.GetPrunedID(ctx))
+	require.Len(t, ck.PruneEventRecords(ctx, 10), 0)
+
+	for _, archived := range pruned {
+		require.False(t, ck.HasEventRecord(ctx, archived.Record.ID))
+		// root chain mapping is kept for replay protection
+		require.True(t, ck.HasRootChainEventRecord(ctx, hmTypes.RootChainTypeEth, archived.Record.ID))
+	}
+	require.True(t, ck.HasEventRecord(ctx, 4))
+	require.Len(t, ck.GetAllEventRecords(ctx), 2)
+
+	// archived records replay hash chain kept in store
+	var hash hmTypes.HexBytes
+	for _, archived := range pruned {
+		hash = types.NextPrunedRecordsHash(hash, app.Codec().MustMarshalBinaryBare(archived.Record))
+		require.Equal(t, hash, archived.Hash)
+	}
+	require.Equal(t, hash, ck.GetPrunedRecordsHash(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetArchivedEventRecord() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	dir, err := ioutil.TempDir("", "clerk-archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	hAddr := hmTypes.BytesToHeimdallAddress([]byte("some-address"))
+	hHash := hmTypes.BytesToHeimdallHash([]byte("some-address"))
+	ck := app.ClerkKeeper
+	for i := uint64(1); i <= 2; i++ {
+		testRecord := types.NewEventRecord(hHash, i, i, hAddr, make([]byte, 0), "1", time.Now(), hmTypes.RootChainTypeEth)
+		require.NoError(t, ck.SetEventRecord(ctx, testRecord))
+	}
+	ck.SetAckedID(ctx, 1)
+
+	// pruned without archive
+	clerk.EndBlocker(ctx, ck)
+	_, err = ck.GetArchivedEventRecord(ctx, 1)
+	require.Error(t, err)
+
+	// record which is not pruned
+	ck.SetArchive(clerk.NewRecordArchive(dir))
+	_, err = ck.GetArchivedEventRecord(ctx, 2)
+	require.Error(t, err)
+
+	ck.SetAckedID(ctx, 2)
+	clerk.EndBlocker(ctx, ck)
+
+	archived, err := ck.GetArchivedEventRecord(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), archived.Record.ID)
+	require.Equal(t, ck.GetPrunedRecordsHash(ctx), archived.Hash)
+}
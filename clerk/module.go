@@ -23,9 +23,14 @@ var (
 	_ module.AppModule             = AppModule{}
 	_ module.AppModuleBasic        = AppModuleBasic{}
 	_ hmModule.HeimdallModuleBasic = AppModule{}
+	_ hmModule.HasConsensusVersion = AppModule{}
+	_ hmModule.HasMigrations       = AppModule{}
 	// _ module.AppModuleSimulation = AppModule{}
 )
 
+// ConsensusVersion store layout version of the module
+const ConsensusVersion uint64 = 1
+
 // AppModuleBasic defines the basic application module used by the auth module.
 type AppModuleBasic struct{}
 
@@ -122,6 +127,18 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper, am.contractCaller)
 }
 
+// ConsensusVersion returns store layout version of the clerk module
+func (AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+// RegisterMigrations registers store migrations of the clerk module.
+// Bump ConsensusVersion and register migration from previous version
+// whenever key layout of the module changes.
+func (am AppModule) RegisterMigrations(cfg *hmModule.Configurator) error {
+	return nil
+}
+
 // InitGenesis performs genesis initialization for the auth module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
@@ -143,7 +160,8 @@ func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock returns the end blocker for the auth module. It returns no validator
 // updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
 	return []abci.ValidatorUpdate{}
 }
 
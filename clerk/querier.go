@@ -26,6 +26,8 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryRecordListWithTime(ctx, req, keeper)
 		case types.QueryRecordSequence:
 			return handleQueryRecordSequence(ctx, req, keeper, contractCaller)
+		case types.QueryPruneStatus:
+			return handleQueryPruneStatus(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -40,6 +42,13 @@ func handleQueryRecord(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([
 	}
 	// get state record by record id
 	record, err := keeper.GetEventRecord(ctx, params.RecordID)
+	if err != nil && params.RecordID <= keeper.GetPrunedID(ctx) {
+		// pruned records are served from local archive
+		var archived *types.ArchivedEventRecord
+		if archived, err = keeper.GetArchivedEventRecord(ctx, params.RecordID); err == nil {
+			record = &archived.Record
+		}
+	}
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get state record", err.Error()))
 	}
@@ -135,3 +144,18 @@ func handleQueryRecordSequence(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 
 	return bz, nil
 }
+
+func handleQueryPruneStatus(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	status := types.PruneStatus{
+		LatestID:          keeper.GetLatestID(ctx),
+		AckedID:           keeper.GetAckedID(ctx),
+		PrunedID:          keeper.GetPrunedID(ctx),
+		PrunedRecordsHash: keeper.GetPrunedRecordsHash(ctx),
+	}
+
+	bz, err := json.Marshal(status)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
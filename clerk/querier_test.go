@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/big"
 	"math/rand"
+	"os"
 	"testing"
 	"time"
 
@@ -139,6 +141,46 @@ func (suite *QuerierTestSuite) TestHandleQueryRecord() {
 	require.Equal(t, recordTron.RootChainType, hmTypes.RootChainTypeTron, "root chain type should be tron")
 }
 
+func (suite *QuerierTestSuite) TestHandleQueryArchivedRecord() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	path := []string{types.QueryRecord}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRecord)
+
+	dir, err := ioutil.TempDir("", "clerk-archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	hAddr := hmTypes.BytesToHeimdallAddress([]byte("some-address"))
+	hHash := hmTypes.BytesToHeimdallHash([]byte("some-address"))
+	testRecord := types.NewEventRecord(hHash, 1, 1, hAddr, make([]byte, 0), "1", time.Now(), hmTypes.RootChainTypeEth)
+
+	ck := app.ClerkKeeper
+	ck.SetArchive(clerk.NewRecordArchive(dir))
+	require.NoError(t, ck.SetEventRecord(ctx, testRecord))
+	ck.SetAckedID(ctx, 1)
+	clerk.EndBlocker(ctx, ck)
+	require.False(t, ck.HasEventRecord(ctx, 1))
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryRecordParams(1)),
+	}
+
+	// pruned record is served from archive
+	bz, err := clerk.NewQuerier(ck, &suite.contractCaller)(ctx, path, req)
+	require.NoError(t, err)
+
+	var record types.EventRecord
+	require.NoError(t, json.Unmarshal(bz, &record))
+	require.Equal(t, uint64(1), record.ID)
+	require.Equal(t, testRecord.RootChainType, record.RootChainType)
+
+	// node without archive
+	_, err = clerk.NewQuerier(app.ClerkKeeper, &suite.contractCaller)(ctx, path, req)
+	require.Error(t, err)
+}
+
 func (suite *QuerierTestSuite) TestHandleQueryRecordList() {
 	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
 
@@ -27,6 +27,8 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 		switch msg := msg.(type) {
 		case types.MsgEventRecord:
 			return SideHandleMsgEventRecord(ctx, k, msg, contractCaller)
+		case types.MsgStateSyncAck:
+			return SideHandleMsgStateSyncAck(ctx, k, msg, contractCaller)
 		default:
 			return abci.ResponseDeliverSideTx{
 				Code: uint32(sdk.CodeUnknownRequest),
@@ -42,6 +44,8 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 		switch msg := msg.(type) {
 		case types.MsgEventRecord:
 			return PostHandleMsgEventRecord(ctx, k, msg, sideTxResult)
+		case types.MsgStateSyncAck:
+			return PostHandleMsgStateSyncAck(ctx, k, msg, sideTxResult)
 		default:
 			return sdk.ErrUnknownRequest("Unknown msg type").Result()
 		}
@@ -71,7 +75,7 @@ func SideHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 		receipt, err = contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations,
 			hmTypes.RootChainTypeEth)
 		if err != nil || receipt == nil {
-			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+			return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
 		}
 		contractAddress = chainParams.StateSenderAddress.EthAddress()
 	case hmTypes.RootChainTypeBsc:
@@ -83,15 +87,15 @@ func SideHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 		receipt, err = contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), bscChain.TxConfirmations,
 			hmTypes.RootChainTypeBsc)
 		if err != nil || receipt == nil {
-			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+			return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
 		}
 		contractAddress = bscChain.StateSenderAddress.EthAddress()
 	case hmTypes.RootChainTypeTron:
 		receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
 		if err != nil || receipt == nil {
-			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+			return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
 		}
-		contractAddress = hmTypes.HexToTronAddress(chainParams.TronStateSenderAddress)
+		contractAddress = chainParams.TronStateSenderAddress.EthAddress()
 	default:
 		k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, " does not  match eth or tron")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
@@ -183,6 +187,7 @@ func PostHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 	}
 	// save record sequence
 	k.SetRecordSequence(ctx, sequence.String())
+	k.DeletePendingEventRecord(ctx, msg)
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -205,3 +210,63 @@ func PostHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// SideHandleMsgStateSyncAck checks state of ack is committed on bor
+func SideHandleMsgStateSyncAck(ctx sdk.Context, k Keeper, msg types.MsgStateSyncAck, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for state sync ack msg", "stateID", msg.StateID)
+
+	// chainManager params
+	params := k.chainKeeper.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	// bor commits states in order, so committed state implies all previous ones are committed
+	processed, err := contractCaller.IsStateProcessed(msg.StateID, chainParams.StateReceiverAddress.EthAddress())
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch state from bor", "stateID", msg.StateID, "error", err)
+		return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
+	}
+
+	if !processed {
+		k.Logger(ctx).Error("State is not processed by bor yet", "stateID", msg.StateID)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// PostHandleMsgStateSyncAck moves ack, records up to it are pruned by end blocker
+func PostHandleMsgStateSyncAck(ctx sdk.Context, k Keeper, msg types.MsgStateSyncAck, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if ack is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping state sync ack since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// check for replay, other ack may have been processed in between
+	if msg.StateID <= k.GetAckedID(ctx) {
+		k.Logger(ctx).Debug("Skipping state sync ack as it's already processed")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	k.SetAckedID(ctx, msg.StateID)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStateSyncAck,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyAckedID, strconv.FormatUint(msg.StateID, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -1,6 +1,7 @@
 package clerk_test
 
 import (
+	"errors"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -153,7 +154,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgEventRecord() {
 			ContractAddress: msg.ContractAddress.TronAddress(),
 			Data:            msg.Data,
 		}
-		suite.contractCaller.On("DecodeStateSyncedEvent", hmTypes.HexToTronAddress(chainParams.ChainParams.TronStateSenderAddress), txReceipt, logIndex).Return(event, nil)
+		suite.contractCaller.On("DecodeStateSyncedEvent", chainParams.ChainParams.TronStateSenderAddress.EthAddress(), txReceipt, logIndex).Return(event, nil)
 
 		// execute handler
 		result := suite.sideHandler(ctx, msg)
@@ -326,3 +327,65 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgEventRecord() {
 		require.Equal(t, common.CodeOldTx, result.Code)
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgStateSyncAck() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	stateReceiverAddress := app.ChainKeeper.GetParams(ctx).ChainParams.StateReceiverAddress.EthAddress()
+
+	_, _, addr1 := sdkAuth.KeyTestPubAddr()
+	msg := types.NewMsgStateSyncAck(hmTypes.BytesToHeimdallAddress(addr1.Bytes()), 10)
+
+	t.Run("Success", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("IsStateProcessed", msg.StateID, stateReceiverAddress).Return(true, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should succeed")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	t.Run("NotProcessed", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("IsStateProcessed", msg.StateID, stateReceiverAddress).Return(false, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+	})
+
+	t.Run("BorError", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("IsStateProcessed", msg.StateID, stateReceiverAddress).Return(false, errors.New("connection refused"))
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(common.CodeWaitFrConfirmation), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgStateSyncAck() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	_, _, addr1 := sdkAuth.KeyTestPubAddr()
+	msg := types.NewMsgStateSyncAck(hmTypes.BytesToHeimdallAddress(addr1.Bytes()), 10)
+
+	t.Run("NoResult", func(t *testing.T) {
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_No)
+		require.False(t, result.IsOK(), "Post handler should fail")
+		require.Equal(t, common.CodeSideTxValidationFailed, result.Code)
+		require.Equal(t, uint64(0), app.ClerkKeeper.GetAckedID(ctx))
+	})
+
+	t.Run("YesResult", func(t *testing.T) {
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "Post handler should succeed")
+		require.Greater(t, len(result.Events), 0, "Events should be emitted for successful post-tx")
+		require.Equal(t, msg.StateID, app.ClerkKeeper.GetAckedID(ctx))
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK(), "Post handler should prevent replay attack")
+		require.Equal(t, common.CodeOldTx, result.Code)
+	})
+}
@@ -9,6 +9,8 @@ const (
 	CodeEventRecordAlreadySynced sdk.CodeType = 5400
 	CodeEventRecordInvalid       sdk.CodeType = 5401
 	CodeEventRecordUpdate        sdk.CodeType = 5402
+	CodeStateSyncAckInvalid      sdk.CodeType = 5403
+	CodeEventRecordPending       sdk.CodeType = 5404
 )
 
 // ErrEventRecordAlreadySynced represents event sync error
@@ -25,3 +27,13 @@ func ErrEventRecordInvalid(codespace sdk.CodespaceType) sdk.Error {
 func ErrEventUpdate(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEventRecordUpdate, "Event record update error")
 }
+
+// ErrEventRecordPending represents duplicate of event record waiting for side-tx votes
+func ErrEventRecordPending(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEventRecordPending, "Event record is already pending")
+}
+
+// ErrStateSyncAckInvalid represents state sync ack error
+func ErrStateSyncAckInvalid(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeStateSyncAckInvalid, "State sync ack is old or ahead of latest record")
+}
@@ -1,7 +1,8 @@
 package types
 
 var (
-	EventTypeRecord = "record"
+	EventTypeRecord       = "record"
+	EventTypeStateSyncAck = "state-sync-ack"
 
 	AttributeKeyRecordTxHash     = "record-tx-hash"
 	AttributeKeyRecordTxLogIndex = "record-tx-log-index"
@@ -9,6 +10,7 @@ var (
 	AttributeKeyRecordContract   = "record-contract"
 	AttributeKeyCreatedAt        = "created-at"
 	AttributeRootChainType       = "root-chain-type"
+	AttributeKeyAckedID          = "acked-id"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,11 +1,20 @@
 package types
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/maticnetwork/heimdall/types"
+)
 
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
 	EventRecords    []*EventRecord `json:"event_records"`
 	RecordSequences []string       `json:"record_sequences" yaml:"record_sequences"`
+
+	// records up to pruned id are not part of event records, ids of event records follow it
+	AckedID           uint64         `json:"acked_id" yaml:"acked_id"`
+	PrunedID          uint64         `json:"pruned_id" yaml:"pruned_id"`
+	PrunedRecordsHash types.HexBytes `json:"pruned_records_hash" yaml:"pruned_records_hash"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -26,5 +35,9 @@ func ValidateGenesis(data GenesisState) error {
 			return errors.New("Invalid Sequence")
 		}
 	}
+
+	if data.AckedID < data.PrunedID {
+		return errors.New("Pruned id is ahead of acked id")
+	}
 	return nil
 }
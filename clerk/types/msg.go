@@ -95,3 +95,52 @@ func (msg MsgEventRecord) GetRootChainType() string {
 func (msg MsgEventRecord) GetSideSignBytes() []byte {
 	return nil
 }
+
+// MsgStateSyncAck - acknowledges state records up to id are processed by bor, so they can be pruned
+type MsgStateSyncAck struct {
+	From    types.HeimdallAddress `json:"from"`
+	StateID uint64                `json:"state_id"`
+}
+
+var _ sdk.Msg = MsgStateSyncAck{}
+
+// NewMsgStateSyncAck - construct state sync ack msg
+func NewMsgStateSyncAck(from types.HeimdallAddress, stateID uint64) MsgStateSyncAck {
+	return MsgStateSyncAck{
+		From:    from,
+		StateID: stateID,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgStateSyncAck) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgStateSyncAck) Type() string { return "state-sync-ack" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgStateSyncAck) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+
+	if msg.StateID == 0 {
+		return ErrEventRecordInvalid(DefaultCodespace)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgStateSyncAck) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgStateSyncAck) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgStateSyncAck) GetSideSignBytes() []byte {
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/maticnetwork/bor/crypto"
+
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// ArchivedEventRecord is record pruned from store along with its position in hash chain
+// over pruned records, it can be verified by replaying chain up to hash kept in store
+type ArchivedEventRecord struct {
+	Record       EventRecord    `json:"record" yaml:"record"`
+	PrunedHeight int64          `json:"pruned_height" yaml:"pruned_height"`
+	Hash         types.HexBytes `json:"hash" yaml:"hash"`
+}
+
+// NewArchivedEventRecord creates archived record
+func NewArchivedEventRecord(record EventRecord, prunedHeight int64, hash types.HexBytes) ArchivedEventRecord {
+	return ArchivedEventRecord{
+		Record:       record,
+		PrunedHeight: prunedHeight,
+		Hash:         hash,
+	}
+}
+
+// String returns the string representation of archived record
+func (r ArchivedEventRecord) String() string {
+	return fmt.Sprintf("ArchivedEventRecord{%v %v %v}", r.Record.String(), r.PrunedHeight, r.Hash.String())
+}
+
+// NextPrunedRecordsHash extends hash chain over pruned records with stored (amino) bytes of record
+func NextPrunedRecordsHash(prev types.HexBytes, recordBytes []byte) types.HexBytes {
+	return crypto.Keccak256(prev, crypto.Keccak256(recordBytes))
+}
+
+// PruneStatus is progress of record pruning
+type PruneStatus struct {
+	LatestID          uint64         `json:"latest_id" yaml:"latest_id"`
+	AckedID           uint64         `json:"acked_id" yaml:"acked_id"`
+	PrunedID          uint64         `json:"pruned_id" yaml:"pruned_id"`
+	PrunedRecordsHash types.HexBytes `json:"pruned_records_hash" yaml:"pruned_records_hash"`
+}
@@ -10,6 +10,7 @@ const (
 	QueryRecordList         = "record-list"
 	QueryRecordListWithTime = "record-list-time"
 	QueryRecordSequence     = "record-sequence"
+	QueryPruneStatus        = "prune-status"
 )
 
 // QueryRecordParams defines the params for querying accounts.
@@ -0,0 +1,161 @@
+// Package heimdall is typed Go client of heimdall node for external consumers (exchanges, indexers).
+// It talks to tendermint rpc of node, retries failed requests and hides codec handling.
+package heimdall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// DefaultMaxRetries max number of retries of failed request
+	DefaultMaxRetries = 3
+
+	// DefaultRetryDelay delay before first retry, doubled on every next retry
+	DefaultRetryDelay = 500 * time.Millisecond
+)
+
+var errNoSigner = errors.New("client has no signer, see WithSigner")
+
+// Client typed client of heimdall node
+type Client struct {
+	rpc *httpClient.HTTP
+	cdc *codec.Codec
+
+	maxRetries int
+	retryDelay time.Duration
+
+	// signer of txs, optional
+	signer *Signer
+}
+
+// Option configures client
+type Option func(*Client)
+
+// WithRetries sets max number of retries and delay before first retry
+func WithRetries(maxRetries int, retryDelay time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryDelay = retryDelay
+	}
+}
+
+// WithSigner sets signer of txs submitted by client
+func WithSigner(signer *Signer) Option {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// NewClient creates client of heimdall node tendermint rpc, eg. http://localhost:26657
+func NewClient(rpcURL string, opts ...Option) *Client {
+	c := &Client{
+		rpc:        httpClient.NewHTTP(rpcURL, "/websocket"),
+		cdc:        app.MakeCodec(),
+		maxRetries: DefaultMaxRetries,
+		retryDelay: DefaultRetryDelay,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Codec returns codec used to encode txs and decode query results
+func (c *Client) Codec() *codec.Codec {
+	return c.cdc
+}
+
+// retry calls fn until it succeeds, max retries are reached or ctx is done
+func (c *Client) retry(ctx context.Context, fn func() error) (err error) {
+	delay := c.retryDelay
+	for attempt := 0; ; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+
+		// query was processed by node and failed, retry won't help
+		var queryErr QueryError
+		if errors.As(err, &queryErr) || attempt >= c.maxRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// QueryError is returned when node processed query and returned error
+type QueryError struct {
+	Path string
+	Code uint32
+	Log  string
+}
+
+func (e QueryError) Error() string {
+	return fmt.Sprintf("query %v failed: code %v, %v", e.Path, e.Code, e.Log)
+}
+
+// query runs abci query at latest height
+func (c *Client) query(ctx context.Context, path string, data []byte) (res []byte, height int64, err error) {
+	err = c.retry(ctx, func() error {
+		result, err := c.rpc.ABCIQuery(path, data)
+		if err != nil {
+			return err
+		}
+
+		if !result.Response.IsOK() {
+			return QueryError{Path: path, Code: result.Response.Code, Log: result.Response.Log}
+		}
+
+		res, height = result.Response.Value, result.Response.Height
+		return nil
+	})
+
+	return res, height, err
+}
+
+// queryWithParams runs abci query with params encoded as json
+func (c *Client) queryWithParams(ctx context.Context, path string, params interface{}) ([]byte, error) {
+	var data []byte
+	if params != nil {
+		var err error
+		if data, err = c.cdc.MarshalJSON(params); err != nil {
+			return nil, err
+		}
+	}
+
+	res, _, err := c.query(ctx, path, data)
+	return res, err
+}
+
+// nodeQuerier adapts client to NodeQuerier used by module query helpers
+type nodeQuerier struct {
+	ctx context.Context
+	c   *Client
+}
+
+func (q nodeQuerier) QueryWithData(path string, data []byte) ([]byte, int64, error) {
+	return q.c.query(q.ctx, path, data)
+}
+
+func checkRootChain(rootChain string) error {
+	if types.GetRootChainID(rootChain) == 0 {
+		return fmt.Errorf("invalid root chain %v", rootChain)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package heimdall
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// newTestNode serves abci queries of tendermint rpc, first failures requests fail with 500
+func newTestNode(t *testing.T, failures int, handle func(path string) (code uint32, value []byte)) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= failures {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Params struct {
+				Path string `json:"path"`
+			} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		code, value := handle(req.Params.Path)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"response":{"code":%d,"value":"%s","height":"10"}}}`,
+			req.ID, code, base64.StdEncoding.EncodeToString(value))
+	}))
+
+	return server, &requests
+}
+
+func TestGetLastCheckpoint(t *testing.T) {
+	checkpoint := types.Checkpoint{StartBlock: 256, EndBlock: 511, RootHash: types.HexToHeimdallHash("0x01")}
+
+	server, requests := newTestNode(t, 1, func(path string) (uint32, []byte) {
+		switch path {
+		case "custom/checkpoint/ack-count":
+			return 0, []byte("2")
+		case "custom/checkpoint/checkpoint":
+			bz, _ := json.Marshal(checkpoint)
+			return 0, bz
+		}
+		return 1, nil
+	})
+	defer server.Close()
+
+	c := NewClient(server.URL, WithRetries(2, time.Millisecond))
+
+	result, err := c.GetLastCheckpoint(context.Background(), types.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, checkpoint.StartBlock, result.StartBlock)
+	require.Equal(t, checkpoint.EndBlock, result.EndBlock)
+	require.Equal(t, 3, *requests, "failed request is retried")
+
+	_, err = c.GetLastCheckpoint(context.Background(), "unknown")
+	require.Error(t, err)
+}
+
+func TestQueryErrorIsNotRetried(t *testing.T) {
+	server, requests := newTestNode(t, 0, func(path string) (uint32, []byte) {
+		return 1, nil
+	})
+	defer server.Close()
+
+	c := NewClient(server.URL, WithRetries(3, time.Millisecond))
+
+	_, err := c.GetValidatorSet(context.Background())
+	require.Error(t, err)
+	require.IsType(t, QueryError{}, err)
+	require.Equal(t, 1, *requests)
+
+	_, err = c.SubmitCheckpointAck(context.Background(), CheckpointAck{RootChain: types.RootChainTypeEth})
+	require.Equal(t, errNoSigner, err)
+}
@@ -0,0 +1,106 @@
+package heimdall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// GetCheckpointCount returns number of acked checkpoints of root chain
+func (c *Client) GetCheckpointCount(ctx context.Context, rootChain string) (count uint64, err error) {
+	if err := checkRootChain(rootChain); err != nil {
+		return 0, err
+	}
+
+	res, err := c.queryWithParams(ctx,
+		fmt.Sprintf("custom/%s/%s", checkpointTypes.QuerierRoute, checkpointTypes.QueryAckCount),
+		checkpointTypes.NewQueryCheckpointParams(0, rootChain))
+	if err != nil {
+		return 0, err
+	}
+
+	err = json.Unmarshal(res, &count)
+	return count, err
+}
+
+// GetCheckpoint returns acked checkpoint of root chain by number
+func (c *Client) GetCheckpoint(ctx context.Context, rootChain string, number uint64) (*types.Checkpoint, error) {
+	if err := checkRootChain(rootChain); err != nil {
+		return nil, err
+	}
+
+	res, err := c.queryWithParams(ctx,
+		fmt.Sprintf("custom/%s/%s", checkpointTypes.QuerierRoute, checkpointTypes.QueryCheckpoint),
+		checkpointTypes.NewQueryCheckpointParams(number, rootChain))
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoint types.Checkpoint
+	if err := json.Unmarshal(res, &checkpoint); err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
+// GetLastCheckpoint returns last acked checkpoint of root chain
+func (c *Client) GetLastCheckpoint(ctx context.Context, rootChain string) (*types.Checkpoint, error) {
+	count, err := c.GetCheckpointCount(ctx, rootChain)
+	if err != nil {
+		return nil, err
+	}
+
+	if count == 0 {
+		return nil, fmt.Errorf("no checkpoint acked on %v yet", rootChain)
+	}
+
+	return c.GetCheckpoint(ctx, rootChain, count)
+}
+
+// GetBufferedCheckpoint returns checkpoint of root chain waiting for ack
+func (c *Client) GetBufferedCheckpoint(ctx context.Context, rootChain string) (*types.Checkpoint, error) {
+	if err := checkRootChain(rootChain); err != nil {
+		return nil, err
+	}
+
+	res, err := c.queryWithParams(ctx,
+		fmt.Sprintf("custom/%s/%s", checkpointTypes.QuerierRoute, checkpointTypes.QueryCheckpointBuffer),
+		checkpointTypes.NewQueryCheckpointParams(0, rootChain))
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoint types.Checkpoint
+	if err := json.Unmarshal(res, &checkpoint); err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
+// GetValidatorSet returns current validator set
+func (c *Client) GetValidatorSet(ctx context.Context) (*types.ValidatorSet, error) {
+	res, err := c.queryWithParams(ctx,
+		fmt.Sprintf("custom/%s/%s", stakingTypes.QuerierRoute, stakingTypes.QueryCurrentValidatorSet), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var validatorSet types.ValidatorSet
+	if err := json.Unmarshal(res, &validatorSet); err != nil {
+		return nil, err
+	}
+
+	return &validatorSet, nil
+}
+
+// GetAccount returns account of address
+func (c *Client) GetAccount(ctx context.Context, address types.HeimdallAddress) (authTypes.Account, error) {
+	return authTypes.NewAccountRetriever(nodeQuerier{ctx: ctx, c: c}).GetAccount(address)
+}
@@ -0,0 +1,105 @@
+package heimdall
+
+import (
+	"context"
+	"fmt"
+
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// checkpointEventTypes events streamed by StreamCheckpointEvents
+var checkpointEventTypes = map[string]bool{
+	checkpointTypes.EventTypeCheckpoint:        true,
+	checkpointTypes.EventTypeCheckpointAck:     true,
+	checkpointTypes.EventTypeCheckpointNoAck:   true,
+	checkpointTypes.EventTypeCheckpointAdjust:  true,
+	checkpointTypes.EventTypeCheckpointSync:    true,
+	checkpointTypes.EventTypeCheckpointSyncAck: true,
+}
+
+// CheckpointEvent checkpoint event processed in block
+type CheckpointEvent struct {
+	Height     int64             `json:"height"`
+	Type       string            `json:"type"`
+	RootChain  string            `json:"root_chain"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// StreamCheckpointEvents streams checkpoint events of root chain (all root chains if empty) from new blocks.
+// Channel is closed once ctx is done or subscription is terminated by node.
+func (c *Client) StreamCheckpointEvents(ctx context.Context, rootChain string) (<-chan CheckpointEvent, error) {
+	if rootChain != "" {
+		if err := checkRootChain(rootChain); err != nil {
+			return nil, err
+		}
+	}
+
+	if !c.rpc.IsRunning() {
+		if err := c.rpc.Start(); err != nil {
+			return nil, err
+		}
+	}
+
+	subscriber := fmt.Sprintf("checkpoint-events-%p", ctx)
+	query := tmTypes.QueryForEvent(tmTypes.EventNewBlock).String()
+
+	eventCh, err := c.rpc.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan CheckpointEvent)
+	go func() {
+		defer close(out)
+		defer func() {
+			_ = c.rpc.Unsubscribe(context.Background(), subscriber, query)
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				block, ok := event.Data.(tmTypes.EventDataNewBlock)
+				if !ok {
+					continue
+				}
+
+				for _, e := range helper.ExpandCompactEvents(c.rpc, block.ResultBeginBlock.GetEvents()) {
+					if !checkpointEventTypes[e.Type] {
+						continue
+					}
+
+					checkpointEvent := CheckpointEvent{
+						Height:     block.Block.Height,
+						Type:       e.Type,
+						Attributes: make(map[string]string),
+					}
+					for _, attr := range e.Attributes {
+						checkpointEvent.Attributes[string(attr.Key)] = string(attr.Value)
+					}
+					checkpointEvent.RootChain = checkpointEvent.Attributes[checkpointTypes.AttributeKeyRootChain]
+
+					if rootChain != "" && checkpointEvent.RootChain != rootChain {
+						continue
+					}
+
+					select {
+					case out <- checkpointEvent:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
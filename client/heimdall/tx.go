@@ -0,0 +1,138 @@
+package heimdall
+
+import (
+	"context"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// Signer signs txs submitted by client
+type Signer struct {
+	mu sync.Mutex
+
+	privKey secp256k1.PrivKeySecp256k1
+	address types.HeimdallAddress
+	chainID string
+}
+
+// NewSigner creates signer of private key
+func NewSigner(privKey secp256k1.PrivKeySecp256k1) *Signer {
+	return &Signer{
+		privKey: privKey,
+		address: types.BytesToHeimdallAddress(privKey.PubKey().Address().Bytes()),
+	}
+}
+
+// Address returns address of signer
+func (s *Signer) Address() types.HeimdallAddress {
+	return s.address
+}
+
+// CheckpointAck ack of checkpoint submitted to root chain contract
+type CheckpointAck struct {
+	RootChain  string
+	Number     uint64
+	Proposer   types.HeimdallAddress
+	StartBlock uint64
+	EndBlock   uint64
+	RootHash   types.HeimdallHash
+	TxHash     types.HeimdallHash // root chain tx which submitted checkpoint
+	LogIndex   uint64
+}
+
+// SubmitCheckpointAck signs and broadcasts checkpoint ack
+func (c *Client) SubmitCheckpointAck(ctx context.Context, ack CheckpointAck) (sdk.TxResponse, error) {
+	if err := checkRootChain(ack.RootChain); err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	if c.signer == nil {
+		return sdk.TxResponse{}, errNoSigner
+	}
+
+	msg := checkpointTypes.NewMsgCheckpointAck(
+		c.signer.address,
+		ack.Number,
+		ack.Proposer,
+		ack.StartBlock,
+		ack.EndBlock,
+		ack.RootHash,
+		ack.TxHash,
+		ack.LogIndex,
+		ack.RootChain,
+	)
+
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// BroadcastMsgs signs msgs with signer and broadcasts them in sync mode.
+// Txs rejected because of stale account sequence are re-signed and re-broadcasted.
+func (c *Client) BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) (res sdk.TxResponse, err error) {
+	if c.signer == nil {
+		return res, errNoSigner
+	}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return res, err
+		}
+	}
+
+	c.signer.mu.Lock()
+	defer c.signer.mu.Unlock()
+
+	if c.signer.chainID == "" {
+		status, err := c.rpc.Status()
+		if err != nil {
+			return res, err
+		}
+		c.signer.chainID = status.NodeInfo.Network
+	}
+
+	for attempt := 0; ; attempt++ {
+		account, err := c.GetAccount(ctx, c.signer.address)
+		if err != nil {
+			return res, err
+		}
+
+		txBldr := authTypes.NewTxBuilder(
+			authTypes.DefaultTxEncoder(c.cdc),
+			account.GetAccountNumber(),
+			account.GetSequence(),
+			0,
+			0,
+			false,
+			c.signer.chainID,
+			"",
+			nil,
+			nil,
+		)
+
+		txBytes, err := txBldr.BuildAndSign(c.signer.privKey, msgs)
+		if err != nil {
+			return res, err
+		}
+
+		result, err := c.rpc.BroadcastTxSync(txBytes)
+		if err != nil {
+			return res, err
+		}
+
+		res = sdk.NewResponseFormatBroadcastTx(result)
+		rejection := helper.ClassifyTxResponse(res)
+		if rejection == helper.TxRejectionNone {
+			return res, nil
+		}
+
+		if rejection != helper.TxRejectionSequenceMismatch || attempt >= c.maxRetries {
+			return res, helper.TxRejectedError{Rejection: rejection, Response: res}
+		}
+	}
+}
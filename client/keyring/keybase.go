@@ -0,0 +1,163 @@
+package keyring
+
+import (
+	"bytes"
+	"fmt"
+
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// keyringKeybase is keybase backed by secret store of keyring backend. Keys are loaded into in-memory keybase
+// on open, and armored key infos are written back to store on every change.
+type keyringKeybase struct {
+	crkeys.Keybase
+	store Store
+}
+
+var _ crkeys.Keybase = keyringKeybase{}
+
+// NewKeyringKeybase returns keybase with keys of store
+func NewKeyringKeybase(store Store) (crkeys.Keybase, error) {
+	kb := keyringKeybase{Keybase: crkeys.NewInMemory(), store: store}
+
+	names, err := store.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		armor, err := store.Get(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key %s from keyring: %v", name, err)
+		}
+
+		if err := kb.Keybase.Import(name, string(armor)); err != nil {
+			return nil, fmt.Errorf("failed to load key %s from keyring: %v", name, err)
+		}
+	}
+
+	return kb, nil
+}
+
+// persist writes armored info of key to store
+func (kb keyringKeybase) persist(name string) error {
+	armor, err := kb.Keybase.Export(name)
+	if err != nil {
+		return err
+	}
+
+	return kb.store.Set(name, []byte(armor))
+}
+
+// GetByAddress returns info of key with address, address index isn't kept for loaded keys
+func (kb keyringKeybase) GetByAddress(address sdk.AccAddress) (crkeys.Info, error) {
+	infos, err := kb.Keybase.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		if bytes.Equal(info.GetAddress(), address) {
+			return info, nil
+		}
+	}
+
+	return nil, fmt.Errorf("key with address %s not found", address)
+}
+
+func (kb keyringKeybase) Delete(name, passphrase string, skipPass bool) error {
+	if err := kb.Keybase.Delete(name, passphrase, skipPass); err != nil {
+		return err
+	}
+
+	return kb.store.Remove(name)
+}
+
+func (kb keyringKeybase) CreateMnemonic(name string, language crkeys.Language, passwd string, algo crkeys.SigningAlgo) (crkeys.Info, string, error) {
+	info, mnemonic, err := kb.Keybase.CreateMnemonic(name, language, passwd, algo)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return info, mnemonic, kb.persist(name)
+}
+
+func (kb keyringKeybase) CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account uint32, index uint32) (crkeys.Info, error) {
+	info, err := kb.Keybase.CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd, account, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, kb.persist(name)
+}
+
+func (kb keyringKeybase) Derive(name, mnemonic, bip39Passwd, encryptPasswd string, params hd.BIP44Params) (crkeys.Info, error) {
+	info, err := kb.Keybase.Derive(name, mnemonic, bip39Passwd, encryptPasswd, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, kb.persist(name)
+}
+
+func (kb keyringKeybase) CreateLedger(name string, algo crkeys.SigningAlgo, hrp string, account, index uint32) (crkeys.Info, error) {
+	info, err := kb.Keybase.CreateLedger(name, algo, hrp, account, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, kb.persist(name)
+}
+
+func (kb keyringKeybase) CreateOffline(name string, pubkey crypto.PubKey) (crkeys.Info, error) {
+	info, err := kb.Keybase.CreateOffline(name, pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, kb.persist(name)
+}
+
+func (kb keyringKeybase) CreateMulti(name string, pubkey crypto.PubKey) (crkeys.Info, error) {
+	info, err := kb.Keybase.CreateMulti(name, pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, kb.persist(name)
+}
+
+func (kb keyringKeybase) Update(name, oldpass string, getNewpass func() (string, error)) error {
+	if err := kb.Keybase.Update(name, oldpass, getNewpass); err != nil {
+		return err
+	}
+
+	return kb.persist(name)
+}
+
+func (kb keyringKeybase) Import(name string, armor string) error {
+	if err := kb.Keybase.Import(name, armor); err != nil {
+		return err
+	}
+
+	return kb.persist(name)
+}
+
+func (kb keyringKeybase) ImportPrivKey(name, armor, passphrase string) error {
+	if err := kb.Keybase.ImportPrivKey(name, armor, passphrase); err != nil {
+		return err
+	}
+
+	return kb.persist(name)
+}
+
+func (kb keyringKeybase) ImportPubKey(name string, armor string) error {
+	if err := kb.Keybase.ImportPubKey(name, armor); err != nil {
+		return err
+	}
+
+	return kb.persist(name)
+}
@@ -0,0 +1,136 @@
+package keyring
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/spf13/viper"
+)
+
+// Keyring backends
+const (
+	// BackendLegacy keeps keys in legacy keybase under <home>/keys
+	BackendLegacy = "legacy"
+
+	// BackendFile keeps keys in files under <home>/keyring-file, encrypted with keyring passphrase
+	BackendFile = "file"
+
+	// BackendOS keeps keys in keychain of OS, macOS keychain or secret service on linux
+	BackendOS = "os"
+
+	// BackendKWallet keeps keys in KDE wallet
+	BackendKWallet = "kwallet"
+
+	// BackendTest keeps keys in memory, for tests only
+	BackendTest = "test"
+)
+
+const (
+	// FlagKeyringBackend flag to select keyring backend
+	FlagKeyringBackend = "keyring-backend"
+
+	// EnvKeyringPassphrase env variable with passphrase of file keyring, prompted when not set
+	EnvKeyringPassphrase = "HD_KEYRING_PASSPHRASE"
+
+	// ServiceName name of keyring service in OS keychains
+	ServiceName = "deliverycli"
+
+	// KeyringBackendUsage usage of keyring backend flag
+	KeyringBackendUsage = "Select keyring's backend (legacy|file|os|kwallet)"
+)
+
+// Backends returns supported keyring backends
+func Backends() []string {
+	return []string{BackendLegacy, BackendFile, BackendOS, BackendKWallet, BackendTest}
+}
+
+// ValidateBackend checks if backend is supported
+func ValidateBackend(backend string) error {
+	for _, b := range Backends() {
+		if b == backend {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported keyring backend %q, supported backends: %v", backend, strings.Join(Backends(), "|"))
+}
+
+// NewStore returns secret store of keyring backend, rooted at home dir for file backend
+func NewStore(backend string, home string) (Store, error) {
+	switch backend {
+	case BackendFile:
+		return NewFileStore(filepath.Join(home, "keyring-file"), PassphraseFromEnvOrStdin), nil
+	case BackendOS:
+		return NewOSStore(ServiceName)
+	case BackendKWallet:
+		return NewKWalletStore(ServiceName), nil
+	case BackendTest:
+		return NewMemStore(), nil
+	default:
+		return nil, ValidateBackend(backend)
+	}
+}
+
+// NewKeybase returns keybase of keyring backend, legacy backend returns legacy keybase of home dir
+func NewKeybase(backend string, home string) (crkeys.Keybase, error) {
+	if backend == "" || backend == BackendLegacy {
+		return keys.NewKeyBaseFromDir(home)
+	}
+
+	store, err := NewStore(backend, home)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeyringKeybase(store)
+}
+
+// NewKeyBaseFromFlags returns keybase of backend selected with keyring-backend flag
+func NewKeyBaseFromFlags() (crkeys.Keybase, error) {
+	return NewKeybase(viper.GetString(FlagKeyringBackend), viper.GetString(flags.FlagHome))
+}
+
+// GetKeyInfo returns info of key from keybase selected with keyring-backend flag
+func GetKeyInfo(name string) (crkeys.Info, error) {
+	kb, err := NewKeyBaseFromFlags()
+	if err != nil {
+		return nil, err
+	}
+
+	return kb.Get(name)
+}
+
+// GetPassphrase returns passphrase of key for signing, it's only needed for locally stored keys
+func GetPassphrase(name string) (string, error) {
+	keyInfo, err := GetKeyInfo(name)
+	if err != nil {
+		return "", err
+	}
+
+	if keyInfo.GetType() != crkeys.TypeLocal {
+		return "", nil
+	}
+
+	return keys.ReadPassphraseFromStdin(name)
+}
+
+// PassphraseFromEnvOrStdin returns keyring passphrase from env, or prompts it from stdin
+func PassphraseFromEnvOrStdin() (string, error) {
+	if passphrase, ok := os.LookupEnv(EnvKeyringPassphrase); ok {
+		return passphrase, nil
+	}
+
+	passphrase, err := input.GetPassword("Enter keyring passphrase:", bufio.NewReader(os.Stdin))
+	if err != nil {
+		return "", fmt.Errorf("Error reading keyring passphrase: %v", err)
+	}
+
+	return passphrase, nil
+}
@@ -0,0 +1,110 @@
+package keyring
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "keyring-file")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	passphrase := func(p string) func() (string, error) {
+		return func() (string, error) { return p, nil }
+	}
+
+	store := NewFileStore(dir, passphrase("secret"))
+	require.NoError(t, store.Set("validator/1", []byte("armor")))
+
+	value, err := store.Get("validator/1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("armor"), value)
+
+	keys, err := store.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"validator/1"}, keys)
+
+	// entries can't be read with other passphrase
+	_, err = NewFileStore(dir, passphrase("other")).Get("validator/1")
+	require.Equal(t, ErrWrongPassphrase, err)
+
+	require.NoError(t, store.Remove("validator/1"))
+	_, err = store.Get("validator/1")
+	require.Equal(t, ErrKeyNotFound, err)
+	require.Equal(t, ErrKeyNotFound, store.Remove("validator/1"))
+}
+
+func TestKeyringKeybase(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemStore()
+	kb, err := NewKeyringKeybase(store)
+	require.NoError(t, err)
+
+	info, _, err := kb.CreateMnemonic("validator", crkeys.English, "password", crkeys.Secp256k1)
+	require.NoError(t, err)
+
+	// keys are loaded from store on open
+	reopened, err := NewKeyringKeybase(store)
+	require.NoError(t, err)
+
+	loaded, err := reopened.GetByAddress(info.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, "validator", loaded.GetName())
+
+	_, pubKey, err := reopened.Sign("validator", "password", []byte("msg"))
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), pubKey)
+
+	require.NoError(t, reopened.Delete("validator", "password", false))
+	_, err = store.Get("validator")
+	require.Equal(t, ErrKeyNotFound, err)
+}
+
+func TestMigrateKeys(t *testing.T) {
+	t.Parallel()
+
+	legacy := crkeys.NewInMemory()
+	_, _, err := legacy.CreateMnemonic("validator", crkeys.English, "password", crkeys.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = legacy.CreateMnemonic("signer", crkeys.English, "password", crkeys.Secp256k1)
+	require.NoError(t, err)
+
+	kb, err := NewKeyringKeybase(NewMemStore())
+	require.NoError(t, err)
+	_, _, err = kb.CreateMnemonic("signer", crkeys.English, "password", crkeys.Secp256k1)
+	require.NoError(t, err)
+
+	migrated, skipped, err := MigrateKeys(legacy, kb, true, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"validator"}, migrated)
+	require.Equal(t, []string{"signer"}, skipped)
+	_, err = kb.Get("validator")
+	require.Error(t, err, "dry run shouldn't migrate keys")
+
+	migrated, _, err = MigrateKeys(legacy, kb, false, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"validator"}, migrated)
+
+	_, _, err = kb.Sign("validator", "password", []byte("msg"))
+	require.NoError(t, err)
+
+	_, err = legacy.Get("validator")
+	require.Error(t, err, "migrated key should be deleted from legacy keybase")
+}
+
+func TestValidateBackend(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range Backends() {
+		require.NoError(t, ValidateBackend(backend))
+	}
+	require.Error(t, ValidateBackend("pass"))
+}
@@ -0,0 +1,157 @@
+package keyring
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// FlagDryRun flag to list keys to migrate without migrating them
+	FlagDryRun = "dry-run"
+
+	// FlagDeleteLegacy flag to delete migrated keys from legacy keybase
+	FlagDeleteLegacy = "delete-legacy"
+)
+
+// MigrateKeys copies armored infos of all keys of legacy keybase into keyring keybase. Keys already present
+// in keyring are skipped. With deleteLegacy, migrated keys are removed from legacy keybase.
+func MigrateKeys(legacy crkeys.Keybase, keyring crkeys.Keybase, dryRun bool, deleteLegacy bool) (migrated []string, skipped []string, err error) {
+	infos, err := legacy.List()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, info := range infos {
+		name := info.GetName()
+		if _, err := keyring.Get(name); err == nil {
+			skipped = append(skipped, name)
+			continue
+		}
+
+		if dryRun {
+			migrated = append(migrated, name)
+			continue
+		}
+
+		armor, err := legacy.Export(name)
+		if err != nil {
+			return migrated, skipped, fmt.Errorf("failed to export key %s: %v", name, err)
+		}
+
+		if err := keyring.Import(name, armor); err != nil {
+			return migrated, skipped, fmt.Errorf("failed to import key %s: %v", name, err)
+		}
+
+		if deleteLegacy {
+			if err := legacy.Delete(name, "", true); err != nil {
+				return migrated, skipped, fmt.Errorf("failed to delete legacy key %s: %v", name, err)
+			}
+		}
+
+		migrated = append(migrated, name)
+	}
+
+	return migrated, skipped, nil
+}
+
+// Commands returns keys commands with keyring migrate command, and list command of keyring backend
+func Commands() *cobra.Command {
+	cmd := keys.Commands()
+	for _, c := range cmd.Commands() {
+		if c.Name() == "list" {
+			cmd.RemoveCommand(c)
+		}
+	}
+
+	cmd.AddCommand(
+		listKeysCmd(),
+		migrateKeysCmd(),
+	)
+
+	return cmd
+}
+
+func listKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all keys",
+		Long: `Return a list of all public keys stored in keyring backend selected with --keyring-backend
+along with their associated name and address.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kb, err := NewKeyBaseFromFlags()
+			if err != nil {
+				return err
+			}
+
+			infos, err := kb.List()
+			if err != nil {
+				return err
+			}
+
+			kos, err := crkeys.Bech32KeysOutput(infos)
+			if err != nil {
+				return err
+			}
+
+			for _, ko := range kos {
+				fmt.Printf("%s\t%s\t%s\t%s\n", ko.Name, ko.Type, ko.Address, ko.PubKey)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func migrateKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate keys from legacy keybase to keyring backend",
+		Long: `Copy all keys of legacy keybase under <home>/keys into keyring backend selected with --keyring-backend.
+Keys already present in keyring are skipped. File backend is encrypted with keyring passphrase,
+read from $HD_KEYRING_PASSPHRASE or prompted.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			backend := viper.GetString(FlagKeyringBackend)
+			if backend == BackendLegacy {
+				return fmt.Errorf("select target keyring backend with --%s", FlagKeyringBackend)
+			}
+
+			if err := ValidateBackend(backend); err != nil {
+				return err
+			}
+
+			legacy, err := keys.NewKeyBaseFromHomeFlag()
+			if err != nil {
+				return err
+			}
+
+			keyring, err := NewKeybase(backend, viper.GetString(flags.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			dryRun := viper.GetBool(FlagDryRun)
+			migrated, skipped, err := MigrateKeys(legacy, keyring, dryRun, viper.GetBool(FlagDeleteLegacy))
+			for _, name := range migrated {
+				if dryRun {
+					cmd.Printf("key %s will be migrated to %s keyring\n", name, backend)
+				} else {
+					cmd.Printf("key %s migrated to %s keyring\n", name, backend)
+				}
+			}
+			for _, name := range skipped {
+				cmd.Printf("key %s already exists in %s keyring, skipped\n", name, backend)
+			}
+
+			return err
+		},
+	}
+	cmd.Flags().Bool(FlagDryRun, false, "List keys to migrate without migrating them")
+	cmd.Flags().Bool(FlagDeleteLegacy, false, "Delete migrated keys from legacy keybase")
+	return cmd
+}
@@ -0,0 +1,248 @@
+package keyring
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// indexKey entry of secret store with names of stored keys, keychains can't list entries of a service
+const indexKey = "__keyring_index__"
+
+// secretTool reads and writes single secrets of a service in keychain of OS
+type secretTool interface {
+	// lookup returns secret of key, ErrKeyNotFound if it doesn't exist
+	lookup(key string) (string, error)
+
+	// store stores secret of key, replacing existing one
+	store(key string, secret string) error
+
+	// clear removes secret of key
+	clear(key string) error
+}
+
+// secretStore is store of keychain secrets, values are base64 encoded and names of keys are kept in index entry
+type secretStore struct {
+	mu   sync.Mutex
+	tool secretTool
+}
+
+// NewOSStore returns store of keychain of OS, macOS keychain with security or secret service with secret-tool on linux
+func NewOSStore(service string) (Store, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		return &secretStore{tool: macKeychain{service: service}}, nil
+	case "linux", "freebsd", "openbsd":
+		return &secretStore{tool: secretService{service: service}}, nil
+	default:
+		return nil, fmt.Errorf("os keyring backend is not supported on %s", runtime.GOOS)
+	}
+}
+
+// NewKWalletStore returns store of KDE wallet, accessed with kwallet-query
+func NewKWalletStore(service string) Store {
+	return &secretStore{tool: kWallet{wallet: "kdewallet", folder: service}}
+}
+
+func (s *secretStore) Get(key string) ([]byte, error) {
+	secret, err := s.tool.lookup(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(secret)
+}
+
+func (s *secretStore) Set(key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.tool.store(key, base64.StdEncoding.EncodeToString(value)); err != nil {
+		return err
+	}
+
+	keys, err := s.keys()
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		if k == key {
+			return nil
+		}
+	}
+
+	return s.setKeys(append(keys, key))
+}
+
+func (s *secretStore) Remove(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys, err := s.keys()
+	if err != nil {
+		return err
+	}
+
+	found := false
+	remaining := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k == key {
+			found = true
+			continue
+		}
+		remaining = append(remaining, k)
+	}
+
+	if !found {
+		return ErrKeyNotFound
+	}
+
+	if err := s.tool.clear(key); err != nil && err != ErrKeyNotFound {
+		return err
+	}
+
+	return s.setKeys(remaining)
+}
+
+func (s *secretStore) Keys() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.keys()
+}
+
+func (s *secretStore) keys() ([]string, error) {
+	index, err := s.tool.lookup(indexKey)
+	if err == ErrKeyNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	if err := json.Unmarshal([]byte(index), &keys); err != nil {
+		return nil, fmt.Errorf("invalid keyring index: %v", err)
+	}
+
+	return keys, nil
+}
+
+func (s *secretStore) setKeys(keys []string) error {
+	sort.Strings(keys)
+
+	index, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+
+	return s.tool.store(indexKey, string(index))
+}
+
+// runSecretCommand runs command with stdin, returns trimmed stdout. Failed lookups are reported as ErrKeyNotFound
+// when command has no output, keychain tools exit with error for missing entries.
+func runSecretCommand(stdin string, name string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if _, ok := err.(*exec.ExitError); ok && stdout.Len() == 0 {
+			return "", fmt.Errorf("%s: %v: %s", name, err, strings.TrimSpace(stderr.String()))
+		}
+		return "", err
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// macKeychain stores secrets as generic passwords of service in macOS keychain
+type macKeychain struct {
+	service string
+}
+
+func (k macKeychain) lookup(key string) (string, error) {
+	secret, err := runSecretCommand("", "security", "find-generic-password", "-s", k.service, "-a", key, "-w")
+	if err != nil {
+		return "", ErrKeyNotFound
+	}
+
+	return secret, nil
+}
+
+// store runs security in interactive mode and sends command on stdin, so secret never shows up
+// in arguments of process, which any local user can list
+func (k macKeychain) store(key string, secret string) error {
+	command := fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n", quoteSecurityArg(k.service), quoteSecurityArg(key), quoteSecurityArg(secret))
+	_, err := runSecretCommand(command, "security", "-i")
+	return err
+}
+
+// quoteSecurityArg quotes argument of security interactive mode command
+func quoteSecurityArg(arg string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(arg) + `"`
+}
+
+func (k macKeychain) clear(key string) error {
+	_, err := runSecretCommand("", "security", "delete-generic-password", "-s", k.service, "-a", key)
+	return err
+}
+
+// secretService stores secrets of service in freedesktop secret service (gnome-keyring, kwallet) with secret-tool
+type secretService struct {
+	service string
+}
+
+func (s secretService) lookup(key string) (string, error) {
+	secret, err := runSecretCommand("", "secret-tool", "lookup", "service", s.service, "key", key)
+	if err != nil || secret == "" {
+		return "", ErrKeyNotFound
+	}
+
+	return secret, nil
+}
+
+func (s secretService) store(key string, secret string) error {
+	label := fmt.Sprintf("%s %s", s.service, key)
+	_, err := runSecretCommand(secret, "secret-tool", "store", "--label", label, "service", s.service, "key", key)
+	return err
+}
+
+func (s secretService) clear(key string) error {
+	_, err := runSecretCommand("", "secret-tool", "clear", "service", s.service, "key", key)
+	return err
+}
+
+// kWallet stores secrets in folder of KDE wallet with kwallet-query. kwallet-query can't delete entries,
+// cleared entries are overwritten with empty secret and dropped from index.
+type kWallet struct {
+	wallet string
+	folder string
+}
+
+func (w kWallet) lookup(key string) (string, error) {
+	secret, err := runSecretCommand("", "kwallet-query", "-f", w.folder, "-r", key, w.wallet)
+	if err != nil || secret == "" {
+		return "", ErrKeyNotFound
+	}
+
+	return secret, nil
+}
+
+func (w kWallet) store(key string, secret string) error {
+	_, err := runSecretCommand(secret, "kwallet-query", "-f", w.folder, "-w", key, w.wallet)
+	return err
+}
+
+func (w kWallet) clear(key string) error {
+	return w.store(key, "")
+}
@@ -0,0 +1,222 @@
+package keyring
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tendermint/crypto/bcrypt"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+)
+
+// ErrKeyNotFound is returned by stores when key doesn't exist
+var ErrKeyNotFound = errors.New("key not found in keyring")
+
+// ErrWrongPassphrase is returned by file store when entry can't be decrypted with passphrase
+var ErrWrongPassphrase = errors.New("invalid keyring passphrase")
+
+// Store is secret store of keyring backend
+type Store interface {
+	// Get returns value of key, ErrKeyNotFound if key doesn't exist
+	Get(key string) ([]byte, error)
+
+	// Set sets value of key
+	Set(key string, value []byte) error
+
+	// Remove removes key, ErrKeyNotFound if key doesn't exist
+	Remove(key string) error
+
+	// Keys returns sorted keys of store
+	Keys() ([]string, error)
+}
+
+//
+// Memory store
+//
+
+// memStore keeps entries in memory
+type memStore struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+// NewMemStore returns store which keeps entries in memory
+func NewMemStore() Store {
+	return &memStore{entries: make(map[string][]byte)}
+}
+
+func (s *memStore) Get(key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.entries[key]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+
+	return append([]byte{}, value...), nil
+}
+
+func (s *memStore) Set(key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = append([]byte{}, value...)
+	return nil
+}
+
+func (s *memStore) Remove(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.entries[key]; !ok {
+		return ErrKeyNotFound
+	}
+
+	delete(s.entries, key)
+	return nil
+}
+
+func (s *memStore) Keys() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.entries))
+	for key := range s.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+//
+// File store
+//
+
+const (
+	fileStoreExt   = ".info"
+	fileSaltSize   = 16
+	fileBcryptCost = 12
+)
+
+// fileStore keeps every entry in its own file, encrypted with xsalsa20 and key derived with bcrypt from passphrase
+type fileStore struct {
+	dir            string
+	passphraseFunc func() (string, error)
+
+	mu         sync.Mutex
+	passphrase *string
+}
+
+// NewFileStore returns store which keeps encrypted entries in dir, passphraseFunc is called once on first access
+func NewFileStore(dir string, passphraseFunc func() (string, error)) Store {
+	return &fileStore{dir: dir, passphraseFunc: passphraseFunc}
+}
+
+func (s *fileStore) Get(key string) ([]byte, error) {
+	data, err := ioutil.ReadFile(s.filename(key))
+	if os.IsNotExist(err) {
+		return nil, ErrKeyNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(data) < fileSaltSize {
+		return nil, fmt.Errorf("invalid keyring file of key %s", key)
+	}
+
+	secret, err := s.secret(data[:fileSaltSize])
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := xsalsa20symmetric.DecryptSymmetric(data[fileSaltSize:], secret)
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+
+	return value, nil
+}
+
+func (s *fileStore) Set(key string, value []byte) error {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return err
+	}
+
+	salt := crypto.CRandBytes(fileSaltSize)
+	secret, err := s.secret(salt)
+	if err != nil {
+		return err
+	}
+
+	data := append(salt, xsalsa20symmetric.EncryptSymmetric(value, secret)...)
+	return ioutil.WriteFile(s.filename(key), data, 0600)
+}
+
+func (s *fileStore) Remove(key string) error {
+	err := os.Remove(s.filename(key))
+	if os.IsNotExist(err) {
+		return ErrKeyNotFound
+	}
+
+	return err
+}
+
+func (s *fileStore) Keys() ([]string, error) {
+	files, err := ioutil.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), fileStoreExt) {
+			continue
+		}
+
+		key, err := hex.DecodeString(strings.TrimSuffix(file.Name(), fileStoreExt))
+		if err != nil {
+			continue
+		}
+		keys = append(keys, string(key))
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// filename returns file of key, keys are hex encoded so any key name is a valid file name
+func (s *fileStore) filename(key string) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(key))+fileStoreExt)
+}
+
+// secret derives encryption key of entry from passphrase and salt of entry
+func (s *fileStore) secret(salt []byte) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.passphrase == nil {
+		passphrase, err := s.passphraseFunc()
+		if err != nil {
+			return nil, err
+		}
+		s.passphrase = &passphrase
+	}
+
+	key, err := bcrypt.GenerateFromPassword(salt, []byte(*s.passphrase), fileBcryptCost)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Sha256(key), nil
+}
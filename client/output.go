@@ -0,0 +1,243 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// output formats accepted by global --output flag
+const (
+	OutputFormatText = "text"
+	OutputFormatJSON = "json"
+	OutputFormatYAML = "yaml"
+)
+
+// OutputFlagUsage is usage of global --output flag
+const OutputFlagUsage = "Output format (text|json|yaml), json and yaml are canonical: sorted keys and stringified integers"
+
+// IsMachineOutput returns true if output format is meant to be parsed by scripts
+func IsMachineOutput(format string) bool {
+	return format == OutputFormatJSON || format == OutputFormatYAML
+}
+
+// ValidateOutputFormat returns error if format isn't supported by --output flag
+func ValidateOutputFormat(format string) error {
+	switch format {
+	case OutputFormatText, OutputFormatJSON, OutputFormatYAML:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// PrintOutput prints query result in format of --output flag.
+// Text format keeps human readable output of cli context.
+func PrintOutput(cliCtx context.CLIContext, v interface{}) error {
+	if !IsMachineOutput(cliCtx.OutputFormat) {
+		return cliCtx.PrintOutput(v)
+	}
+
+	bz, err := cliCtx.Codec.MarshalJSON(v)
+	if err != nil {
+		return err
+	}
+	return printCanonical(cliCtx.OutputFormat, bz)
+}
+
+// PrintRawOutput prints raw querier response in format of --output flag.
+// Non-JSON response is printed as JSON string in machine readable formats.
+func PrintRawOutput(cliCtx context.CLIContext, res []byte) error {
+	if !IsMachineOutput(cliCtx.OutputFormat) {
+		fmt.Println(string(res))
+		return nil
+	}
+
+	if !json.Valid(res) {
+		bz, err := json.Marshal(string(res))
+		if err != nil {
+			return err
+		}
+		res = bz
+	}
+	return printCanonical(cliCtx.OutputFormat, res)
+}
+
+// PrintTextOrOutput prints text in text format, or value in machine readable formats
+func PrintTextOrOutput(cliCtx context.CLIContext, text string, v interface{}) error {
+	if !IsMachineOutput(cliCtx.OutputFormat) {
+		fmt.Println(text)
+		return nil
+	}
+
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return printCanonical(cliCtx.OutputFormat, bz)
+}
+
+// OutputError is machine readable error printed by commands
+type OutputError struct {
+	Codespace string `json:"codespace,omitempty"`
+	Code      uint32 `json:"code,omitempty"`
+	Message   string `json:"message"`
+}
+
+// NewOutputError converts command error to output error, ABCI errors keep their codespace and code
+func NewOutputError(err error) OutputError {
+	var outputErr OutputError
+	if jsonErr := json.Unmarshal([]byte(err.Error()), &outputErr); jsonErr == nil && outputErr.Message != "" {
+		return outputErr
+	}
+	return OutputError{Message: err.Error()}
+}
+
+// WithOutputErrors makes command and its sub commands print machine readable error object to stdout
+// when they fail with machine readable output format. Error is still returned for exit code.
+func WithOutputErrors(cmd *cobra.Command) *cobra.Command {
+	for _, child := range cmd.Commands() {
+		WithOutputErrors(child)
+	}
+
+	if cmd.RunE == nil {
+		return cmd
+	}
+
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := runE(cmd, args)
+		if err == nil {
+			return nil
+		}
+
+		format := viper.GetString(cli.OutputFlag)
+		if !IsMachineOutput(format) {
+			return err
+		}
+
+		bz, marshalErr := json.Marshal(map[string]OutputError{"error": NewOutputError(err)})
+		if marshalErr != nil {
+			return err
+		}
+		if printErr := printCanonical(format, bz); printErr != nil {
+			fmt.Fprintln(os.Stderr, printErr)
+		}
+		return err
+	}
+
+	return cmd
+}
+
+// CanonicalJSON re-encodes JSON with sorted object keys and integers as strings,
+// so output doesn't change with field order or integer width of response types
+func CanonicalJSON(bz []byte) ([]byte, error) {
+	value, err := canonicalValue(bz)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// CanonicalYAML encodes JSON as YAML with sorted keys and integers as strings
+func CanonicalYAML(bz []byte) ([]byte, error) {
+	value, err := canonicalValue(bz)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(toYAMLValue(value))
+}
+
+func printCanonical(format string, bz []byte) error {
+	var (
+		out []byte
+		err error
+	)
+
+	if format == OutputFormatYAML {
+		out, err = CanonicalYAML(bz)
+	} else {
+		out, err = CanonicalJSON(bz)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(strings.TrimSuffix(string(out), "\n"))
+	return nil
+}
+
+// canonicalValue decodes JSON keeping numbers, integers are converted to strings.
+// Objects are decoded to maps, which encoding/json marshals with sorted keys.
+func canonicalValue(bz []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return stringifyIntegers(value), nil
+}
+
+func stringifyIntegers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = stringifyIntegers(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = stringifyIntegers(item)
+		}
+		return v
+	case json.Number:
+		if !strings.ContainsAny(v.String(), ".eE") {
+			return v.String()
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// toYAMLValue converts maps to ordered YAML maps, as yaml doesn't sort map[string]interface{} reliably
+func toYAMLValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		ordered := make(yaml.MapSlice, 0, len(keys))
+		for _, key := range keys {
+			ordered = append(ordered, yaml.MapItem{Key: key, Value: toYAMLValue(v[key])})
+		}
+		return ordered
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = toYAMLValue(item)
+		}
+		return items
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	default:
+		return v
+	}
+}
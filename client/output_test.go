@@ -0,0 +1,44 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	out, err := CanonicalJSON([]byte(`{"b":{"z":1,"a":[2,"x",1.5]},"a":18446744073709551615,"c":null}`))
+	require.NoError(t, err)
+	require.Equal(t, `{"a":"18446744073709551615","b":{"a":["2","x",1.5],"z":"1"},"c":null}`, string(out))
+
+	// same document in different field order is printed the same way
+	other, err := CanonicalJSON([]byte(`{"c":null,"a":18446744073709551615,"b":{"a":[2,"x",1.5],"z":1}}`))
+	require.NoError(t, err)
+	require.Equal(t, out, other)
+
+	_, err = CanonicalJSON([]byte(`{`))
+	require.Error(t, err)
+}
+
+func TestCanonicalYAML(t *testing.T) {
+	out, err := CanonicalYAML([]byte(`{"b":true,"a":{"d":5,"c":1.5}}`))
+	require.NoError(t, err)
+	require.Equal(t, "a:\n  c: 1.5\n  d: \"5\"\nb: true\n", string(out))
+}
+
+func TestNewOutputError(t *testing.T) {
+	// abci errors keep codespace and code
+	outputErr := NewOutputError(errors.New(`{"codespace":"checkpoint","code":1501,"message":"Invalid Block Input"}`))
+	require.Equal(t, OutputError{Codespace: "checkpoint", Code: 1501, Message: "Invalid Block Input"}, outputErr)
+
+	outputErr = NewOutputError(errors.New("record id cannot be empty"))
+	require.Equal(t, OutputError{Message: "record id cannot be empty"}, outputErr)
+}
+
+func TestValidateOutputFormat(t *testing.T) {
+	require.NoError(t, ValidateOutputFormat(OutputFormatText))
+	require.NoError(t, ValidateOutputFormat(OutputFormatJSON))
+	require.NoError(t, ValidateOutputFormat(OutputFormatYAML))
+	require.Error(t, ValidateOutputFormat("xml"))
+}
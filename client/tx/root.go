@@ -14,4 +14,6 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/txs", QueryTxsRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/txs", BroadcastTxRequest(cliCtx)).Methods("POST")
 	r.HandleFunc("/txs/encode", EncodeTxRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/txs/sign-bytes", SignBytesRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/txs/sign", SignTxRequestHandlerFn(cliCtx)).Methods("POST")
 }
@@ -0,0 +1,131 @@
+package tx
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+type (
+	// SignBytesReq defines a request for bytes to be signed offline
+	SignBytesReq struct {
+		Tx            authTypes.StdTx `json:"tx"`
+		ChainID       string          `json:"chain_id"`
+		AccountNumber uint64          `json:"account_number"`
+		Sequence      uint64          `json:"sequence"`
+	}
+
+	// SignBytesResp defines bytes to be signed, signature is made over keccak256 of sign bytes
+	SignBytesResp struct {
+		SignBytes string `json:"sign_bytes"`
+		Hash      string `json:"hash"`
+	}
+
+	// SignReq defines a request to attach offline signature to tx
+	SignReq struct {
+		SignBytesReq
+
+		Signature string `json:"signature"`
+	}
+)
+
+// SignBytesRequestHandlerFn returns bytes of generated tx which client signs with its own key,
+// so keys never have to be on the node
+func SignBytesRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SignBytesReq
+		if !readSignReq(w, r, cliCtx, &req) {
+			return
+		}
+
+		signBytes := getSignBytes(req)
+		rest.PostProcessResponse(w, cliCtx, SignBytesResp{
+			SignBytes: "0x" + hex.EncodeToString(signBytes),
+			Hash:      "0x" + hex.EncodeToString(crypto.Keccak256(signBytes)),
+		})
+	}
+}
+
+// SignTxRequestHandlerFn attaches offline signature to generated tx after checking it
+// recovers to tx signer. Response is signed tx, ready for broadcast.
+func SignTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SignReq
+		if !readSignReq(w, r, cliCtx, &req) {
+			return
+		}
+
+		sig := common.FromHex(req.Signature)
+		if len(sig) != 65 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid signature length %v", len(sig)))
+			return
+		}
+
+		// verify signature the same way ante handler does
+		var pk secp256k1.PubKeySecp256k1
+		p, err := authTypes.RecoverPubkey(getSignBytes(req.SignBytesReq), sig)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		copy(pk[:], p[:])
+
+		signer := req.Tx.GetSigners()[0]
+		if !bytes.Equal(signer.Bytes(), pk.Address().Bytes()) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "signature doesn't match tx signer; verify account number, sequence and chain-id")
+			return
+		}
+
+		req.Tx.Signature = sig
+		rest.PostProcessResponse(w, cliCtx, req.Tx)
+	}
+}
+
+// readSignReq reads and validates sign request body
+func readSignReq(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, req interface{}) bool {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+
+	if err := cliCtx.Codec.UnmarshalJSON(body, req); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+
+	var base SignBytesReq
+	switch req := req.(type) {
+	case *SignBytesReq:
+		base = *req
+	case *SignReq:
+		base = req.SignBytesReq
+	}
+
+	// check if msg is not nil
+	if base.Tx.Msg == nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid msg input")
+		return false
+	}
+
+	if base.ChainID == "" {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "chain-id required but not specified")
+		return false
+	}
+
+	return true
+}
+
+func getSignBytes(req SignBytesReq) []byte {
+	return authTypes.StdSignBytes(req.ChainID, req.AccountNumber, req.Sequence, req.Tx.Msg, req.Tx.Memo)
+}
@@ -13,7 +13,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
-	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -37,6 +36,8 @@ import (
 
 	"github.com/maticnetwork/heimdall/app"
 	authCli "github.com/maticnetwork/heimdall/auth/client/cli"
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/client/keyring"
 	hmTxCli "github.com/maticnetwork/heimdall/client/tx"
 	"github.com/maticnetwork/heimdall/helper"
 )
@@ -49,6 +50,10 @@ var (
 		Use:   "deliverycli",
 		Short: "Delivery light-client",
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := hmClient.ValidateOutputFormat(viper.GetString(cli.OutputFlag)); err != nil {
+				return err
+			}
+
 			// initialise config
 			initTendermintViperConfig(cmd)
 			return nil
@@ -89,6 +94,9 @@ func main() {
 	// chain id
 	rootCmd.PersistentFlags().String(client.FlagChainID, "", "Chain ID of tendermint node")
 
+	// keyring backend of keys
+	rootCmd.PersistentFlags().String(keyring.FlagKeyringBackend, keyring.BackendLegacy, keyring.KeyringBackendUsage)
+
 	// add query/post commands (custom to binary)
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
@@ -96,7 +104,7 @@ func main() {
 		queryCmd(cdc),
 		txCmd(cdc),
 		client.LineBreak,
-		keys.Commands(),
+		keyring.Commands(),
 		exportCmd(ctx, cdc),
 		convertAddressToHexCmd(cdc),
 		convertHexToAddressCmd(cdc),
@@ -117,7 +125,10 @@ func main() {
 	}
 
 	// prepare and add flags
-	executor := cli.PrepareMainCmd(rootCmd, "HD", os.ExpandEnv("$HOME/.deliveryd"))
+	// same flags as cli.PrepareMainCmd, with yaml output accepted
+	rootCmd.PersistentFlags().StringP(cli.EncodingFlag, "e", "hex", "Binary encoding (hex|b64|btc)")
+	rootCmd.PersistentFlags().StringP(cli.OutputFlag, "o", hmClient.OutputFormatText, hmClient.OutputFlagUsage)
+	executor := cli.PrepareBaseCmd(rootCmd, "HD", os.ExpandEnv("$HOME/.deliveryd"))
 	err := executor.Execute()
 	if err != nil {
 		// Note: Handle with #870
@@ -142,7 +153,8 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 	// add modules' query commands
 	app.ModuleBasics.AddQueryCommands(queryCmd, cdc)
 
-	return queryCmd
+	// print machine readable errors with json and yaml output
+	return hmClient.WithOutputErrors(queryCmd)
 }
 
 func txCmd(cdc *amino.Codec) *cobra.Command {
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+	flagTxHash     = "tx-hash"
+	flagMethod     = "method"
+	flagErrorsOnly = "errors-only"
+)
+
+// callJournalCmd prints contract calls made during side-tx validation, see call_journal_enabled
+func callJournalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call-journal",
+		Short: "Print contract calls made by node while validating side-txs",
+		Long: `Print journaled contract calls (method, args, endpoint, response hash, latency, height) made by node
+while validating side-txs, to explain why node voted yes, no or skipped side-tx.
+Journal is written only if call_journal_enabled is set in delivery config.
+
+Example:
+deliveryd call-journal --tx-hash 0x...
+deliveryd call-journal --from-height 1000 --to-height 1010 --errors-only`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter := helper.CallJournalFilter{
+				FromHeight: viper.GetInt64(flagFromHeight),
+				ToHeight:   viper.GetInt64(flagToHeight),
+				TxHash:     viper.GetString(flagTxHash),
+				Method:     viper.GetString(flagMethod),
+				ErrorsOnly: viper.GetBool(flagErrorsOnly),
+			}
+
+			entries, err := helper.ReadCallJournal(helper.GetCallJournalDir(viper.GetString(cli.HomeFlag)), filter)
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range entries {
+				b, err := json.Marshal(entry)
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(b))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagFromHeight, 0, "first block height of calls")
+	cmd.Flags().Int64(flagToHeight, 0, "last block height of calls")
+	cmd.Flags().String(flagTxHash, "", "hash of side-tx calls were made for")
+	cmd.Flags().String(flagMethod, "", "contract method or rpc method")
+	cmd.Flags().Bool(flagErrorsOnly, false, "print failed calls only")
+
+	return cmd
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const flagOut = "out"
+
+// debugCmd groups commands inspecting or repairing local store of stopped node
+func debugCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Inspect or repair local store of stopped node",
+	}
+
+	cmd.AddCommand(
+		dumpPendingCmd(cdc),
+		importPendingCmd(cdc),
+	)
+
+	return cmd
+}
+
+// dumpPendingCmd writes checkpoint buffers and pending side-txs of local store to JSON bundle
+func dumpPendingCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump-pending",
+		Short: "Dump buffered checkpoints and pending side-txs of local store",
+		Long: `Dump checkpoint buffer, sync buffer and last no-ack of every root chain, and side-txs waiting
+for votes with their vote tally (validator power and quorum), to portable JSON bundle. Node has to be stopped.
+
+Bundle can be restored with import-pending on patched binaries, eg. after halted network is
+restarted from exported genesis, which keeps only eth checkpoint buffer.
+
+Example:
+deliveryd debug dump-pending --out pending.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			happ, _, db, err := openApp()
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			chainID, err := genesisChainID()
+			if err != nil {
+				return err
+			}
+
+			sdkCtx := happ.NewContext(true, abci.Header{Height: happ.LastBlockHeight(), ChainID: chainID})
+			out, err := codec.MarshalJSONIndent(cdc, happ.DumpPending(sdkCtx))
+			if err != nil {
+				return err
+			}
+
+			if path := viper.GetString(flagOut); path != "" {
+				return ioutil.WriteFile(path, out, 0600)
+			}
+
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagOut, "", "file to write bundle to, stdout if empty")
+
+	return cmd
+}
+
+// importPendingCmd restores checkpoint buffers and pending side-txs of JSON bundle to local store
+func importPendingCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-pending [bundle]",
+		Short: "Restore buffered checkpoints and pending side-txs from dump-pending bundle",
+		Long: `Restore checkpoint buffers, sync buffers, last no-ack and pending side-txs of bundle created by
+dump-pending to local store. Node has to be stopped.
+
+Restored store is committed as new version, so its app hash changes. Every validator has to import
+same bundle at same height before network is restarted. Side-txs are executed once votes for them
+are included in blocks, side-txs nobody votes for after restart are skipped.
+
+Example:
+deliveryd debug import-pending pending.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dump app.PendingDump
+			if err := cdc.UnmarshalJSON(bz, &dump); err != nil {
+				return err
+			}
+
+			happ, cms, db, err := openApp()
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			chainID, err := genesisChainID()
+			if err != nil {
+				return err
+			}
+
+			ms := cms.CacheMultiStore()
+			sdkCtx := sdk.NewContext(ms, abci.Header{Height: happ.LastBlockHeight(), ChainID: chainID}, false, happ.Logger())
+			if err := happ.RestorePending(sdkCtx, dump); err != nil {
+				return err
+			}
+
+			ms.Write()
+			commitID := cms.Commit()
+			fmt.Fprintf(os.Stderr, "pending state of height %v restored, committed version %v app hash %X\n", dump.Height, commitID.Version, commitID.Hash)
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// openApp loads app from application db of node home, app uses returned commit multi store.
+// App logs to stderr, so output of commands can be piped.
+func openApp() (*app.HeimdallApp, store.CommitMultiStore, dbm.DB, error) {
+	helper.InitDeliveryConfig("")
+
+	db, err := sdk.NewLevelDB("application", filepath.Join(viper.GetString(cli.HomeFlag), "data"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// keep commit multi store to commit rewritten state
+	cms := store.NewCommitMultiStore(db)
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stderr))
+	happ := app.NewHeimdallApp(logger, db, func(bapp *baseapp.BaseApp) { bapp.SetCMS(cms) })
+
+	return happ, cms, db, nil
+}
+
+// genesisChainID returns chain id of node's genesis
+func genesisChainID() (string, error) {
+	genDoc, err := tmTypes.GenesisDocFromFile(filepath.Join(viper.GetString(cli.HomeFlag), "config/genesis.json"))
+	if err != nil {
+		return "", err
+	}
+
+	return genDoc.ChainID, nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+)
+
+const (
+	flagAnalyticsFormat = "format"
+
+	analyticsFormatCSV     = "csv"
+	analyticsFormatParquet = "parquet"
+
+	analyticsManifestFile = "manifest.json"
+)
+
+// analyticsManifest describes files of export, so loaders can check schema version before reading tables
+type analyticsManifest struct {
+	SchemaVersion int                      `json:"schema_version"`
+	Height        int64                    `json:"height"`
+	ChainID       string                   `json:"chain_id"`
+	Format        string                   `json:"format"`
+	Tables        []analyticsManifestTable `json:"tables"`
+}
+
+// analyticsManifestTable table columns with file it is written to
+type analyticsManifestTable struct {
+	app.AnalyticsTable
+	File string `json:"file"`
+	Rows int    `json:"rows"`
+}
+
+// analyticsTableWriter writes table to file in dir, returning file name
+type analyticsTableWriter func(dir string, table app.AnalyticsTable) (string, error)
+
+var analyticsTableWriters = map[string]analyticsTableWriter{
+	analyticsFormatCSV: writeAnalyticsCSV,
+}
+
+// exportAnalyticsCmd writes checkpoint, staking and topup tables of local store for offline analytics
+func exportAnalyticsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-analytics",
+		Short: "Export checkpoints, acks, no-acks, staking events and topups of local store as tables",
+		Long: `Export checkpoints, acks, no-acks, validators, staking events, topups and dividend accounts of
+local store at latest height to one file per table, read directly from keepers. Node has to be stopped.
+
+Files are described by manifest.json with schema version, height and columns of every table.
+Schema version is bumped whenever table or column changes.
+
+Only csv format is supported for now, parquet needs encoder which isn't dependency of this build.
+
+Example:
+deliveryd export-analytics --out ./analytics --format csv`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format := viper.GetString(flagAnalyticsFormat)
+			writeTable, ok := analyticsTableWriters[format]
+			if format == analyticsFormatParquet {
+				return fmt.Errorf("%v format is not supported by this build, use %v", format, analyticsFormatCSV)
+			} else if !ok {
+				return fmt.Errorf("invalid format %v", format)
+			}
+
+			dir := viper.GetString(flagOut)
+			if dir == "" {
+				return fmt.Errorf("--%v is required", flagOut)
+			}
+			if err := os.MkdirAll(dir, 0700); err != nil {
+				return err
+			}
+
+			happ, _, db, err := openApp()
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			chainID, err := genesisChainID()
+			if err != nil {
+				return err
+			}
+
+			sdkCtx := happ.NewContext(true, abci.Header{Height: happ.LastBlockHeight(), ChainID: chainID})
+			export := happ.ExportAnalytics(sdkCtx)
+
+			manifest := analyticsManifest{
+				SchemaVersion: export.SchemaVersion,
+				Height:        export.Height,
+				ChainID:       export.ChainID,
+				Format:        format,
+			}
+			for _, table := range export.Tables {
+				file, err := writeTable(dir, table)
+				if err != nil {
+					return err
+				}
+
+				manifest.Tables = append(manifest.Tables, analyticsManifestTable{AnalyticsTable: table, File: file, Rows: len(table.Rows)})
+				fmt.Fprintf(os.Stderr, "%v: %v rows\n", file, len(table.Rows))
+			}
+
+			out, err := json.MarshalIndent(manifest, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return ioutil.WriteFile(filepath.Join(dir, analyticsManifestFile), out, 0600)
+		},
+	}
+
+	cmd.Flags().String(flagOut, "analytics", "directory to write tables to")
+	cmd.Flags().String(flagAnalyticsFormat, analyticsFormatCSV, "output format of tables (csv)")
+
+	return cmd
+}
+
+// writeAnalyticsCSV writes table as csv file with header row
+func writeAnalyticsCSV(dir string, table app.AnalyticsTable) (string, error) {
+	file := table.Name + ".csv"
+
+	f, err := os.OpenFile(filepath.Join(dir, file), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err := w.Write(table.Columns); err != nil {
+		return "", err
+	}
+	if err := w.WriteAll(table.Rows); err != nil {
+		return "", err
+	}
+
+	return file, f.Close()
+}
@@ -103,6 +103,11 @@ func main() {
 	rootCmd.AddCommand(VerifyGenesis(ctx, cdc))
 	rootCmd.AddCommand(initCmd(ctx, cdc))
 	rootCmd.AddCommand(testnetCmd(ctx, cdc))
+	rootCmd.AddCommand(callJournalCmd())
+	rootCmd.AddCommand(rebuildCheckpointsCmd())
+	rootCmd.AddCommand(exportAnalyticsCmd())
+	rootCmd.AddCommand(replayFixtureCmd())
+	rootCmd.AddCommand(debugCmd(cdc))
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "HD", os.ExpandEnv("$HOME/.deliveryd"))
@@ -117,7 +122,18 @@ func newApp(logger log.Logger, db dbm.DB, storeTracer io.Writer) abci.Applicatio
 	// init heimdall config
 	helper.InitDeliveryConfig("")
 	// create new heimdall app
-	return app.NewHeimdallApp(logger, db, baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))))
+	happ := app.NewHeimdallApp(logger, db, baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))))
+
+	// compact events, full events are kept in local events db
+	if conf := helper.GetConfig(); conf.CompactEvents {
+		eventsDB, err := sdk.NewLevelDB("events", filepath.Join(viper.GetString(cli.HomeFlag), "data"))
+		if err != nil {
+			panic(err)
+		}
+		happ.SetEventRecordStore(eventsDB, conf.IndexEventAttributes)
+	}
+
+	return happ
 }
 
 func exportAppStateAndTMValidators(logger log.Logger, db dbm.DB, storeTracer io.Writer, height int64, forZeroHeight bool, jailWhiteList []string) (json.RawMessage, []tmTypes.GenesisValidator, error) {
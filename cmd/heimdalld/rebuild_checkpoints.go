@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	flagRootChain = "root-chain"
+	flagFrom      = "from"
+	flagTo        = "to"
+	flagDryRun    = "dry-run"
+)
+
+// rebuildCheckpointsCmd rewrites missing or wrong checkpoints of node's store from root chain contract
+func rebuildCheckpointsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild-checkpoints",
+		Short: "Rebuild missing checkpoints of local store from root chain contract",
+		Long: `Compare acked checkpoints of local store with headers on root chain contract and rewrite
+missing or different checkpoints, eg. after failed migration. Node has to be stopped.
+
+Rewritten store is committed as new version, so its app hash no longer matches the chain.
+Use it to export repaired state (deliveryd export) or on node which already diverged.
+Run with --dry-run first to print diff without writing.
+
+Example:
+deliveryd rebuild-checkpoints --root-chain eth --from 100 --to 120 --dry-run`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rootChain := viper.GetString(flagRootChain)
+			if _, ok := hmTypes.GetRootChainIDMap()[rootChain]; !ok {
+				return fmt.Errorf("invalid root chain %v", rootChain)
+			}
+
+			from, to := viper.GetUint64(flagFrom), viper.GetUint64(flagTo)
+			if from == 0 || to < from {
+				return errors.New("--from and --to should be valid checkpoint range")
+			}
+			dryRun := viper.GetBool(flagDryRun)
+
+			happ, cms, db, err := openApp()
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			contractCaller, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			ms := cms.CacheMultiStore()
+			ctx := sdk.NewContext(ms, abci.Header{Height: happ.LastBlockHeight()}, false, happ.Logger())
+
+			rebuilds, err := happ.CheckpointKeeper.RebuildCheckpoints(ctx, rootChain, from, to, &contractCaller, dryRun)
+			if err != nil {
+				return err
+			}
+
+			changed := 0
+			for _, rebuild := range rebuilds {
+				if rebuild.Changed() {
+					changed++
+				}
+
+				fmt.Println(rebuild.String())
+			}
+
+			if dryRun || changed == 0 {
+				fmt.Fprintf(os.Stderr, "%v checkpoints to rebuild, store not changed\n", changed)
+				return nil
+			}
+
+			ms.Write()
+			commitID := cms.Commit()
+			fmt.Fprintf(os.Stderr, "%v checkpoints rebuilt, committed version %v app hash %X\n", changed, commitID.Version, commitID.Hash)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagRootChain, hmTypes.RootChainTypeEth, "root chain type of checkpoints")
+	cmd.Flags().Uint64(flagFrom, 0, "first checkpoint number to rebuild")
+	cmd.Flags().Uint64(flagTo, 0, "last checkpoint number to rebuild")
+	cmd.Flags().Bool(flagDryRun, false, "print diff without writing store")
+
+	return cmd
+}
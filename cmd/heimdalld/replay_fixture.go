@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app/replay"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	flagName    = "name"
+	flagGenesis = "genesis"
+	flagModules = "modules"
+)
+
+// replayFixtureCmd captures side-tx of live chain as fixture of replay tests
+func replayFixtureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-fixture",
+		Short: "Capture side-tx of live chain as replay test fixture",
+		Long: `Fetch side-tx from tendermint RPC of node, run it through side and post handlers of its
+module with real contract caller, and write tx bytes, recorded contract calls and handler results
+as fixture of app/replay tests.
+
+Modules of genesis file (eg. exported with deliveryd export at height before the tx) listed
+in --modules are embedded in fixture, other modules use default genesis.
+
+Example:
+deliveryd replay-fixture --tx-hash 0x... --genesis exported.json --modules clerk,chainmanager --out app/replay/testdata/clerk_event_record.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txHash := viper.GetString(flagTxHash)
+			out := viper.GetString(flagOut)
+			if txHash == "" || out == "" {
+				return errors.New("--tx-hash and --out are required")
+			}
+
+			hash, err := hex.DecodeString(strings.TrimPrefix(txHash, "0x"))
+			if err != nil {
+				return err
+			}
+
+			rpc := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
+			txResult, err := rpc.Tx(hash, false)
+			if err != nil {
+				return err
+			}
+
+			block, err := rpc.Block(&txResult.Height)
+			if err != nil {
+				return err
+			}
+
+			fixture := replay.Fixture{
+				Name:    viper.GetString(flagName),
+				ChainID: block.Block.ChainID,
+				Height:  txResult.Height,
+				Time:    block.Block.Time.UTC(),
+				TxHash:  "0x" + strings.ToLower(txResult.Hash.String()),
+				Tx:      []byte(txResult.Tx),
+			}
+
+			if genesisFile := viper.GetString(flagGenesis); genesisFile != "" {
+				fixture.Genesis, err = genesisModules(genesisFile, viper.GetStringSlice(flagModules))
+				if err != nil {
+					return err
+				}
+			}
+
+			contractCaller, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			caller := replay.NewRecordingCaller(&contractCaller)
+			fixture.Results, err = replay.Replay(fixture, caller)
+			if err != nil {
+				return err
+			}
+			fixture.Calls = caller.Calls()
+
+			if err := replay.WriteFixture(out, fixture); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "fixture of %v side msgs with %v contract calls written to %v\n", len(fixture.Results), len(fixture.Calls), out)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagTxHash, "", "hash of side-tx")
+	cmd.Flags().String(flagOut, "", "fixture file to write")
+	cmd.Flags().String(flagName, "", "fixture name")
+	cmd.Flags().String(flagGenesis, "", "genesis file with state before the tx")
+	cmd.Flags().StringSlice(flagModules, []string{"chainmanager"}, "modules of genesis file to embed in fixture")
+
+	return cmd
+}
+
+// genesisModules returns app states of modules of genesis file
+func genesisModules(genesisFile string, modules []string) (map[string]json.RawMessage, error) {
+	genDoc, err := tmTypes.GenesisDocFromFile(genesisFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]json.RawMessage, len(modules))
+	for _, module := range modules {
+		state, ok := appState[module]
+		if !ok {
+			return nil, fmt.Errorf("module %v not found in genesis", module)
+		}
+		states[module] = state
+	}
+
+	return states, nil
+}
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -32,6 +34,10 @@ const (
 	CodeWrongRootChain           CodeType = 1512
 	CodeNoChainParams            CodeType = 1513
 	CodeChainParamsExist         CodeType = 1514
+	CodeCheckpointConflict       CodeType = 1515
+	CodeInvalidBlsSignature      CodeType = 1516
+	CodeAckAlreadySubmitted      CodeType = 1517
+	CodeAckGracePeriod           CodeType = 1518
 
 	CodeOldValidator        CodeType = 2500
 	CodeNoValidator         CodeType = 2501
@@ -49,6 +55,7 @@ const (
 	CodeNoSignerChangeError CodeType = 2513
 	CodeNonce               CodeType = 2514
 	CodeNoStakingEvent      CodeType = 2515
+	CodeInvalidBlsKey       CodeType = 2516
 
 	CodeSpanNotCountinuous  CodeType = 3501
 	CodeUnableToFreezeSet   CodeType = 3502
@@ -66,8 +73,10 @@ const (
 	CodeErrSetCheckpointBuffer CodeType = 4506
 	CodeErrAddCheckpoint       CodeType = 4507
 
-	CodeInvalidReceipt         CodeType = 5501
-	CodeSideTxValidationFailed CodeType = 5502
+	CodeInvalidReceipt           CodeType = 5501
+	CodeSideTxValidationFailed   CodeType = 5502
+	CodeExternalChainUnavailable CodeType = 5503
+	CodeBlockMsgWeightExceeded   CodeType = 5504
 
 	CodeValSigningInfoSave     CodeType = 6501
 	CodeErrValUnjail           CodeType = 6502
@@ -145,6 +154,22 @@ func ErrChainPamramsExist(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeChainParamsExist, "root chain chain params has exist")
 }
 
+func ErrCheckpointConflict(codespace sdk.CodespaceType, rootChain string, number uint64) sdk.Error {
+	return newError(codespace, CodeCheckpointConflict, fmt.Sprintf("Checkpoint root hash conflicts with checkpoint %v on %v", number, rootChain))
+}
+
+func ErrInvalidBlsSignature(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidBlsSignature, "Invalid BLS signature of checkpoint")
+}
+
+func ErrAckAlreadySubmitted(codespace sdk.CodespaceType, rootChain string, number uint64) sdk.Error {
+	return newError(codespace, CodeAckAlreadySubmitted, fmt.Sprintf("Checkpoint %v on %v is already acked", number, rootChain))
+}
+
+func ErrAckGracePeriod(codespace sdk.CodespaceType, graceEnd uint64) sdk.Error {
+	return newError(codespace, CodeAckGracePeriod, fmt.Sprintf("Only checkpoint proposer may ack before %v", graceEnd))
+}
+
 func ErrInvalidNoACK(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidNoACK, "Invalid No ACK -- Waiting for last checkpoint ACK")
 }
@@ -219,6 +244,10 @@ func ErrNoStakingFound(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeNoStakingEvent, "Staking not found")
 }
 
+func ErrInvalidBlsKey(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidBlsKey, "Invalid BLS key or proof of possession")
+}
+
 // Bor Errors --------------------------------
 
 func ErrInvalidBorChainID(codespace sdk.CodespaceType) sdk.Error {
@@ -249,6 +278,11 @@ func ErrProducerMisMatch(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeProducerMisMatch, "Producer set mismatch")
 }
 
+// ErrBlockMsgWeightExceeded tx was delivered after msg weight of block was used up
+func ErrBlockMsgWeightExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeBlockMsgWeightExceeded, msg)
+}
+
 //
 // Side-tx errors
 //
@@ -261,6 +295,30 @@ func ErrorSideTx(codespace sdk.CodespaceType, code CodeType) (res abci.ResponseD
 	return
 }
 
+// ErrorSideTxCause returns side-tx error response of failed external call, code is replaced by
+// CodeExternalChainUnavailable if call failed because root chain endpoint is unavailable or timed out
+func ErrorSideTxCause(codespace sdk.CodespaceType, code CodeType, cause error) abci.ResponseDeliverSideTx {
+	if IsExternalChainUnavailable(cause) {
+		code = CodeExternalChainUnavailable
+	}
+	return ErrorSideTx(codespace, code)
+}
+
+// IsExternalChainUnavailable checks if error is caused by unavailable external chain endpoint or rpc timeout
+func IsExternalChainUnavailable(err error) bool {
+	var unavailable interface{ ExternalChainUnavailable() bool }
+	if errors.As(err, &unavailable) && unavailable.ExternalChainUnavailable() {
+		return true
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var timeout interface{ Timeout() bool }
+	return errors.As(err, &timeout) && timeout.Timeout()
+}
+
 func ErrSideTxValidation(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeSideTxValidationFailed, "External call majority validation failed. ")
 }
@@ -338,6 +396,10 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "Producer set mismatch"
 	case CodeInvalidBorChainID:
 		return "Invalid Bor chain id"
+	case CodeExternalChainUnavailable:
+		return "External chain unavailable"
+	case CodeBlockMsgWeightExceeded:
+		return "Block msg weight exceeded"
 	default:
 		return sdk.CodeToDefaultMsg(code)
 	}
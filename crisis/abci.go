@@ -0,0 +1,14 @@
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker asserts invariants every invariant check period
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	if k.InvCheckPeriod() == 0 || ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
+		return
+	}
+
+	k.AssertInvariants(ctx)
+}
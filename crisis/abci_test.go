@@ -0,0 +1,38 @@
+package crisis_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/crisis"
+)
+
+func TestEndBlocker(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	broken := false
+	k := crisis.NewKeeper(5)
+	k.RegisterRoute("test", "broken", func(ctx sdk.Context) (string, bool) {
+		return "broken", broken
+	})
+	require.Len(t, k.Routes(), 1)
+	require.Equal(t, "test/broken", k.Routes()[0].FullRoute())
+
+	require.NotPanics(t, func() { crisis.EndBlocker(ctx, k) })
+
+	broken = true
+	require.Panics(t, func() { crisis.EndBlocker(ctx, k) })
+
+	// invariants are asserted only every check period
+	require.NotPanics(t, func() { crisis.EndBlocker(ctx.WithBlockHeight(11), k) })
+	require.NotPanics(t, func() { crisis.EndBlocker(ctx, crisis.NewKeeper(0)) })
+
+	// invariants of app modules hold on genesis state
+	require.NotEmpty(t, happ.CrisisKeeper.Routes())
+	require.NotPanics(t, func() { happ.CrisisKeeper.AssertInvariants(ctx) })
+}
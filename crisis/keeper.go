@@ -0,0 +1,77 @@
+package crisis
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// ModuleName name of crisis module
+const ModuleName = "crisis"
+
+// InvarRoute invariant registered by module
+type InvarRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// FullRoute returns full route of invariant
+func (r InvarRoute) FullRoute() string {
+	return r.ModuleName + "/" + r.Route
+}
+
+// Keeper keeps invariants of modules and asserts them every invariant check period
+type Keeper struct {
+	routes         []InvarRoute
+	invCheckPeriod uint64
+}
+
+var _ sdk.InvariantRegistry = &Keeper{}
+
+// NewKeeper creates crisis keeper, invariant check period 0 disables periodic checks
+func NewKeeper(invCheckPeriod uint64) Keeper {
+	return Keeper{
+		invCheckPeriod: invCheckPeriod,
+	}
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", ModuleName)
+}
+
+// RegisterRoute registers invariant of module
+func (k *Keeper) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	k.routes = append(k.routes, InvarRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	})
+}
+
+// Routes returns registered invariants
+func (k Keeper) Routes() []InvarRoute {
+	return k.routes
+}
+
+// InvCheckPeriod returns invariant check period
+func (k Keeper) InvCheckPeriod() uint64 {
+	return k.invCheckPeriod
+}
+
+// AssertInvariants asserts all registered invariants and halts chain on the first broken one
+func (k Keeper) AssertInvariants(ctx sdk.Context) {
+	for _, r := range k.routes {
+		res, stop := r.Invar(ctx)
+		if stop {
+			msg := fmt.Sprintf("invariant %v broken at height %d, halting chain to keep state from being corrupted:\n%s",
+				r.FullRoute(), ctx.BlockHeight(), res)
+			k.Logger(ctx).Error(msg)
+			panic(msg)
+		}
+	}
+
+	k.Logger(ctx).Debug("Asserted all invariants", "height", ctx.BlockHeight(), "count", len(k.routes))
+}
@@ -0,0 +1,300 @@
+package helper
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
+	bn256 "github.com/maticnetwork/bor/crypto/bn256/cloudflare"
+)
+
+// BLS signatures over bn256, signatures are G1 points and public keys G2 points, so
+// aggregated signature of checkpoint can be verified by root chain pairing precompile
+const (
+	BlsSignatureLength = 64
+	BlsPubKeyLength    = 128
+
+	// DefaultBlsKeyFile file of BLS key under config dir
+	DefaultBlsKeyFile = "bls_key.json"
+)
+
+var (
+	blsKeyDomain  = []byte("heimdall-bls-key")
+	blsSignDomain = []byte("heimdall-bls-sign")
+	blsPopDomain  = []byte("heimdall-bls-pop")
+
+	// sqrt exponent of bn256 base field, P = 3 mod 4
+	blsSqrtExp = new(big.Int).Rsh(new(big.Int).Add(bn256.P, big.NewInt(1)), 2)
+
+	// BLS key file and key loaded from it, nil if file doesn't exist
+	blsKeyFilePath string
+	blsPrivObject  *big.Int
+)
+
+// blsKey BLS key file content
+type blsKey struct {
+	SecretKey string `json:"secret_key"`
+}
+
+// GetBlsPrivKey returns BLS secret key of validator loaded from BLS key file. Key is independent
+// of signer key, so leaked signer key doesn't leak BLS key and signer can rotate without it.
+func GetBlsPrivKey() (*big.Int, error) {
+	if blsPrivObject == nil {
+		return nil, fmt.Errorf("no BLS key in %v", blsKeyFilePath)
+	}
+	return blsPrivObject, nil
+}
+
+// LoadOrGenerateBlsKey returns BLS secret key of validator, random key is generated and
+// written to BLS key file if there is none yet
+func LoadOrGenerateBlsKey() (*big.Int, error) {
+	if blsPrivObject != nil {
+		return blsPrivObject, nil
+	}
+
+	sk, err := GenerateBlsPrivKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := WriteBlsKeyFile(blsKeyFilePath, sk); err != nil {
+		return nil, err
+	}
+
+	blsPrivObject = sk
+	Logger.Info("Generated BLS key", "file", blsKeyFilePath)
+	return sk, nil
+}
+
+// GenerateBlsPrivKey returns random non-zero BLS secret key
+func GenerateBlsPrivKey() (*big.Int, error) {
+	for {
+		sk, err := rand.Int(rand.Reader, bn256.Order)
+		if err != nil {
+			return nil, err
+		}
+		if sk.Sign() != 0 {
+			return sk, nil
+		}
+	}
+}
+
+// LoadBlsKeyFile reads BLS secret key from file, which must not be accessible by other users
+func LoadBlsKeyFile(path string) (*big.Int, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Mode().Perm()&^secretFilePerm != 0 {
+		return nil, fmt.Errorf("BLS key file %v should have permissions %v, has %v", path, os.FileMode(secretFilePerm), info.Mode().Perm())
+	}
+
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var key blsKey
+	if err := json.Unmarshal(bz, &key); err != nil {
+		return nil, err
+	}
+
+	skBytes, err := hex.DecodeString(key.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sk := new(big.Int).SetBytes(skBytes)
+	if sk.Sign() == 0 || sk.Cmp(bn256.Order) >= 0 {
+		return nil, errors.New("invalid BLS secret key")
+	}
+	return sk, nil
+}
+
+// WriteBlsKeyFile writes BLS secret key to file, existing file is never overwritten
+func WriteBlsKeyFile(path string, sk *big.Int) error {
+	bz, err := json.MarshalIndent(blsKey{SecretKey: hex.EncodeToString(common.LeftPadBytes(sk.Bytes(), 32))}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, secretFilePerm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(bz); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// BlsPrivKeyFromSeed derives non-zero BLS secret key from seed, for deterministic keys of tests and simulations
+func BlsPrivKeyFromSeed(seed []byte) *big.Int {
+	sk := new(big.Int).SetBytes(crypto.Keccak256(blsKeyDomain, seed))
+	sk.Mod(sk, bn256.Order)
+	if sk.Sign() == 0 {
+		sk.SetInt64(1)
+	}
+	return sk
+}
+
+// BlsPubKey returns public key of BLS secret key
+func BlsPubKey(sk *big.Int) []byte {
+	return new(bn256.G2).ScalarBaseMult(sk).Marshal()
+}
+
+// BlsSign signs msg with BLS secret key
+func BlsSign(sk *big.Int, msg []byte) []byte {
+	return new(bn256.G1).ScalarMult(blsHashToG1(blsSignDomain, msg), sk).Marshal()
+}
+
+// BlsVerify verifies (aggregated) BLS signature of msg against (aggregated) public key
+func BlsVerify(pubKey []byte, msg []byte, sig []byte) bool {
+	return blsVerify(pubKey, blsHashToG1(blsSignDomain, msg), sig)
+}
+
+// BlsProofOfPossession signs public key of BLS secret key, so key can't be registered
+// by anyone not holding secret key (rogue key attack on aggregated public keys)
+func BlsProofOfPossession(sk *big.Int) []byte {
+	return new(bn256.G1).ScalarMult(blsHashToG1(blsPopDomain, BlsPubKey(sk)), sk).Marshal()
+}
+
+// VerifyBlsProofOfPossession verifies proof of possession of BLS public key
+func VerifyBlsProofOfPossession(pubKey []byte, proof []byte) bool {
+	return blsVerify(pubKey, blsHashToG1(blsPopDomain, pubKey), proof)
+}
+
+// BlsAggregateSignatures adds BLS signatures into single signature
+func BlsAggregateSignatures(sigs ...[]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no BLS signatures to aggregate")
+	}
+
+	aggregated := new(bn256.G1)
+	for i, sig := range sigs {
+		point, err := unmarshalBlsSignature(sig)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			aggregated.Set(point)
+		} else {
+			aggregated.Add(aggregated, point)
+		}
+	}
+
+	return aggregated.Marshal(), nil
+}
+
+// BlsAggregatePubKeys adds BLS public keys into single public key
+func BlsAggregatePubKeys(pubKeys ...[]byte) ([]byte, error) {
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no BLS public keys to aggregate")
+	}
+
+	aggregated := new(bn256.G2)
+	for i, pubKey := range pubKeys {
+		point, err := unmarshalBlsPubKey(pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			aggregated.Set(point)
+		} else {
+			aggregated.Add(aggregated, point)
+		}
+	}
+
+	return aggregated.Marshal(), nil
+}
+
+// ValidateBlsPubKey checks public key is valid non-identity G2 point
+func ValidateBlsPubKey(pubKey []byte) error {
+	_, err := unmarshalBlsPubKey(pubKey)
+	return err
+}
+
+func blsVerify(pubKey []byte, h *bn256.G1, sig []byte) bool {
+	pk, err := unmarshalBlsPubKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	s, err := unmarshalBlsSignature(sig)
+	if err != nil {
+		return false
+	}
+
+	// e(-s, g2) * e(H(m), pk) == 1, miller loop expects affine points so g2 is normalized by marshalling
+	g2 := new(bn256.G2).ScalarBaseMult(big.NewInt(1))
+	g2.Marshal()
+
+	return bn256.PairingCheck([]*bn256.G1{new(bn256.G1).Neg(s), h}, []*bn256.G2{g2, pk})
+}
+
+func unmarshalBlsSignature(sig []byte) (*bn256.G1, error) {
+	if len(sig) != BlsSignatureLength || bytes.Equal(sig, make([]byte, BlsSignatureLength)) {
+		return nil, errors.New("invalid BLS signature")
+	}
+
+	point := new(bn256.G1)
+	if _, err := point.Unmarshal(sig); err != nil {
+		return nil, err
+	}
+	return point, nil
+}
+
+func unmarshalBlsPubKey(pubKey []byte) (*bn256.G2, error) {
+	if len(pubKey) != BlsPubKeyLength || bytes.Equal(pubKey, make([]byte, BlsPubKeyLength)) {
+		return nil, errors.New("invalid BLS public key")
+	}
+
+	point := new(bn256.G2)
+	if _, err := point.Unmarshal(pubKey); err != nil {
+		return nil, err
+	}
+	return point, nil
+}
+
+// blsHashToG1 maps domain separated msg to G1 point by try-and-increment,
+// G1 of bn256 has cofactor 1 so every curve point is in group. About half of
+// candidates are on curve, counter is 64 bit so it never wraps around.
+func blsHashToG1(domain []byte, msg []byte) *bn256.G1 {
+	three := big.NewInt(3)
+	counter := make([]byte, 8)
+	for i := uint64(0); ; i++ {
+		binary.BigEndian.PutUint64(counter, i)
+		x := new(big.Int).SetBytes(crypto.Keccak256(domain, msg, counter))
+		x.Mod(x, bn256.P)
+
+		// y^2 = x^3 + 3
+		y2 := new(big.Int).Exp(x, three, bn256.P)
+		y2.Add(y2, three).Mod(y2, bn256.P)
+
+		y := new(big.Int).Exp(y2, blsSqrtExp, bn256.P)
+		if new(big.Int).Exp(y, big.NewInt(2), bn256.P).Cmp(y2) != 0 {
+			continue
+		}
+
+		point := append(common.LeftPadBytes(x.Bytes(), 32), common.LeftPadBytes(y.Bytes(), 32)...)
+
+		g1 := new(bn256.G1)
+		if _, err := g1.Unmarshal(point); err == nil {
+			return g1
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package helper
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlsAggregateSignatures(t *testing.T) {
+	t.Parallel()
+
+	sk1, sk2 := BlsPrivKeyFromSeed([]byte("validator-1")), BlsPrivKeyFromSeed([]byte("validator-2"))
+	pk1, pk2 := BlsPubKey(sk1), BlsPubKey(sk2)
+	msg := []byte("checkpoint")
+
+	sig1, sig2 := BlsSign(sk1, msg), BlsSign(sk2, msg)
+	require.True(t, BlsVerify(pk1, msg, sig1))
+	require.False(t, BlsVerify(pk2, msg, sig1))
+	require.False(t, BlsVerify(pk1, []byte("other"), sig1))
+
+	sig, err := BlsAggregateSignatures(sig1, sig2)
+	require.NoError(t, err)
+	pk, err := BlsAggregatePubKeys(pk1, pk2)
+	require.NoError(t, err)
+	require.True(t, BlsVerify(pk, msg, sig))
+	require.False(t, BlsVerify(pk1, msg, sig))
+
+	_, err = BlsAggregateSignatures(make([]byte, BlsSignatureLength))
+	require.Error(t, err)
+}
+
+func TestBlsProofOfPossession(t *testing.T) {
+	t.Parallel()
+
+	sk1, sk2 := BlsPrivKeyFromSeed([]byte("validator-1")), BlsPrivKeyFromSeed([]byte("validator-2"))
+	require.True(t, VerifyBlsProofOfPossession(BlsPubKey(sk1), BlsProofOfPossession(sk1)))
+	require.False(t, VerifyBlsProofOfPossession(BlsPubKey(sk2), BlsProofOfPossession(sk1)))
+
+	// signature of pub key under sign domain isn't proof of possession
+	require.False(t, VerifyBlsProofOfPossession(BlsPubKey(sk1), BlsSign(sk1, BlsPubKey(sk1))))
+}
+
+func TestBlsKeyFile(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "bls-key")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sk, err := GenerateBlsPrivKey()
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, DefaultBlsKeyFile)
+	_, err = LoadBlsKeyFile(path)
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, WriteBlsKeyFile(path, sk))
+	loaded, err := LoadBlsKeyFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 0, sk.Cmp(loaded))
+
+	// existing key is never overwritten
+	require.Error(t, WriteBlsKeyFile(path, BlsPrivKeyFromSeed([]byte("validator-1"))))
+
+	// key readable by other users is rejected
+	require.NoError(t, os.Chmod(path, 0644))
+	_, err = LoadBlsKeyFile(path)
+	require.Error(t, err)
+}
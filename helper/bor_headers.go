@@ -0,0 +1,198 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/rlp"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+// borReceipt consensus fields of receipt returned by eth_getBlockReceipts
+type borReceipt struct {
+	Type              hexutil.Uint64  `json:"type"`
+	PostState         hexutil.Bytes   `json:"root"`
+	Status            *hexutil.Uint64 `json:"status"`
+	CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed"`
+	Bloom             ethTypes.Bloom  `json:"logsBloom"`
+	Logs              []borReceiptLog `json:"logs"`
+}
+
+// borReceiptLog consensus fields of receipt log
+type borReceiptLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+// consensusEncoding returns encoding of receipt in receipts trie, typed receipts are prefixed with their type
+func (r *borReceipt) consensusEncoding() ([]byte, error) {
+	statusOrRoot := []byte(r.PostState)
+	if len(statusOrRoot) == 0 {
+		statusOrRoot = []byte{}
+		if r.Status != nil && *r.Status == hexutil.Uint64(ethTypes.ReceiptStatusSuccessful) {
+			statusOrRoot = []byte{0x01}
+		}
+	}
+
+	logs := make([]interface{}, len(r.Logs))
+	for i, log := range r.Logs {
+		logs[i] = []interface{}{log.Address, log.Topics, []byte(log.Data)}
+	}
+
+	enc, err := rlp.EncodeToBytes([]interface{}{statusOrRoot, uint64(r.CumulativeGasUsed), r.Bloom, logs})
+	if err != nil || r.Type == 0 {
+		return enc, err
+	}
+
+	return append([]byte{byte(r.Type)}, enc...), nil
+}
+
+// encodedList list of encoded trie values
+type encodedList [][]byte
+
+func (l encodedList) Len() int            { return len(l) }
+func (l encodedList) GetRlp(i int) []byte { return l[i] }
+
+// borReceiptsRoot returns receipts root of receipts
+func borReceiptsRoot(receipts []borReceipt) (common.Hash, error) {
+	list := make(encodedList, len(receipts))
+	for i := range receipts {
+		enc, err := receipts[i].consensusEncoding()
+		if err != nil {
+			return common.Hash{}, err
+		}
+		list[i] = enc
+	}
+
+	return ethTypes.DeriveSha(list), nil
+}
+
+// GetBorHeadersBatch returns headers of bor blocks [start, end], fetched with batch rpc calls of
+// bor_header_batch_size headers. With bor_receipts_root_check enabled, receipts root of every header
+// is cross-checked against receipts of its block returned by eth_getBlockReceipts.
+func (c *ContractCaller) GetBorHeadersBatch(start uint64, end uint64) (headers []*ethTypes.Header, err error) {
+	callStart := time.Now()
+	defer func() {
+		c.Journal.Record("eth_getBlockByNumber", GetConfig().BttcRPCUrl, []interface{}{start, end}, len(headers), err, callStart)
+	}()
+
+	if start > end {
+		return nil, errors.New("start is greater than end")
+	}
+
+	if c.MaticChainRPC == nil {
+		return nil, errors.New("bor rpc client is not configured")
+	}
+
+	batchSize := GetConfig().BorHeaderBatchSize
+	if batchSize == 0 {
+		batchSize = DefaultBorHeaderBatchSize
+	}
+
+	ctx := context.Background()
+	headers = make([]*ethTypes.Header, 0, end-start+1)
+	for from := start; ; from += batchSize {
+		to := end
+		if end-from >= batchSize {
+			to = from + batchSize - 1
+		}
+
+		batch, err := getBorHeaders(ctx, c.MaticChainRPC, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		if GetConfig().BorReceiptsRootCheck {
+			if err := checkBorReceiptsRoots(ctx, c.MaticChainRPC, batch); err != nil {
+				return nil, err
+			}
+		}
+
+		headers = append(headers, batch...)
+		if to == end {
+			break
+		}
+	}
+
+	return headers, nil
+}
+
+// getBorHeaders fetches headers of bor blocks [from, to] with one batch rpc call
+func getBorHeaders(ctx context.Context, rpcClient *rpc.Client, from uint64, to uint64) ([]*ethTypes.Header, error) {
+	headers := make([]*ethTypes.Header, to-from+1)
+	elements := make([]rpc.BatchElem, len(headers))
+	for i := range elements {
+		elements[i] = rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeUint64(from + uint64(i)), false},
+			Result: &headers[i],
+		}
+	}
+
+	if err := rpcClient.BatchCallContext(ctx, elements); err != nil {
+		return nil, err
+	}
+
+	for i, element := range elements {
+		if element.Error != nil {
+			return nil, element.Error
+		}
+
+		if headers[i] == nil || headers[i].Number == nil {
+			return nil, fmt.Errorf("block %v not found", from+uint64(i))
+		}
+	}
+
+	return headers, nil
+}
+
+// checkBorReceiptsRoots cross-checks receipts root of headers against receipts of their blocks, fetched
+// with one batch rpc call. Bor appends receipt of state-sync txs, which isn't part of receipts root, so
+// receipts are also checked without last receipt.
+func checkBorReceiptsRoots(ctx context.Context, rpcClient *rpc.Client, headers []*ethTypes.Header) error {
+	receipts := make([][]borReceipt, len(headers))
+	elements := make([]rpc.BatchElem, len(headers))
+	for i, header := range headers {
+		elements[i] = rpc.BatchElem{
+			Method: "eth_getBlockReceipts",
+			Args:   []interface{}{hexutil.EncodeBig(header.Number)},
+			Result: &receipts[i],
+		}
+	}
+
+	if err := rpcClient.BatchCallContext(ctx, elements); err != nil {
+		return err
+	}
+
+	for i, element := range elements {
+		if element.Error != nil {
+			return element.Error
+		}
+
+		header := headers[i]
+		root, err := borReceiptsRoot(receipts[i])
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(root.Bytes(), header.ReceiptHash.Bytes()) && len(receipts[i]) > 0 {
+			if root, err = borReceiptsRoot(receipts[i][:len(receipts[i])-1]); err != nil {
+				return err
+			}
+		}
+
+		if !bytes.Equal(root.Bytes(), header.ReceiptHash.Bytes()) {
+			return fmt.Errorf("receipts root of bor block %v doesn't match its receipts, header %v, receipts %v",
+				header.Number, header.ReceiptHash.Hex(), root.Hex())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package helper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func testBorReceipts(number uint64) ethTypes.Receipts {
+	return ethTypes.Receipts{
+		{
+			Status:            ethTypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: 21000 * number,
+			Logs: []*ethTypes.Log{{
+				Address: common.BytesToAddress([]byte{byte(number)}),
+				Topics:  []common.Hash{common.BytesToHash([]byte{1})},
+				Data:    []byte{byte(number)},
+			}},
+		},
+		{
+			Status:            ethTypes.ReceiptStatusFailed,
+			CumulativeGasUsed: 42000 * number,
+			Logs:              []*ethTypes.Log{},
+		},
+	}
+}
+
+func TestGetBorHeadersBatch(t *testing.T) {
+	type request struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params []interface{}   `json:"params"`
+	}
+
+	// receipts root of block 13 doesn't match its receipts
+	var receiptsCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		var reqs []request
+		require.NoError(t, json.Unmarshal(body, &reqs))
+
+		var resps []map[string]interface{}
+		for _, req := range reqs {
+			number, err := hexutil.DecodeUint64(req.Params[0].(string))
+			require.NoError(t, err)
+
+			var result interface{}
+			switch req.Method {
+			case "eth_getBlockByNumber":
+				receiptHash := ethTypes.DeriveSha(testBorReceipts(number))
+				if number == 13 {
+					receiptHash = common.Hash{}
+				}
+				result = &ethTypes.Header{
+					Number:      new(big.Int).SetUint64(number),
+					Time:        1000 + number,
+					ReceiptHash: receiptHash,
+					Difficulty:  big.NewInt(1),
+				}
+
+			case "eth_getBlockReceipts":
+				atomic.AddInt32(&receiptsCalls, 1)
+				receipts := testBorReceipts(number)
+				// state-sync receipt of bor is not part of receipts root
+				if number == 11 {
+					receipts = append(receipts, &ethTypes.Receipt{Status: ethTypes.ReceiptStatusSuccessful, Logs: []*ethTypes.Log{}})
+				}
+				result = receipts
+			}
+
+			resps = append(resps, map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resps))
+	}))
+	defer server.Close()
+
+	rpcClient, err := rpc.Dial(server.URL)
+	require.NoError(t, err)
+	caller := ContractCaller{MaticChainRPC: rpcClient}
+
+	prevConf := GetConfig()
+	defer SetTestConfig(prevConf)
+
+	testConf := prevConf
+	testConf.BorHeaderBatchSize = 2
+	testConf.BorReceiptsRootCheck = false
+	SetTestConfig(testConf)
+
+	headers, err := caller.GetBorHeadersBatch(10, 14)
+	require.NoError(t, err)
+	require.Len(t, headers, 5)
+	for i, header := range headers {
+		require.Equal(t, uint64(10+i), header.Number.Uint64())
+	}
+	require.Equal(t, int32(0), atomic.LoadInt32(&receiptsCalls), "receipts shouldn't be fetched without receipts root check")
+
+	_, err = caller.GetBorHeadersBatch(14, 10)
+	require.Error(t, err)
+
+	// receipts roots are cross-checked
+	testConf.BorReceiptsRootCheck = true
+	SetTestConfig(testConf)
+
+	headers, err = caller.GetBorHeadersBatch(10, 12)
+	require.NoError(t, err)
+	require.Len(t, headers, 3)
+	require.Equal(t, int32(3), atomic.LoadInt32(&receiptsCalls))
+
+	_, err = caller.GetBorHeadersBatch(10, 14)
+	require.Error(t, err, "receipts root mismatch of block 13 should fail")
+}
+
+func TestBorReceiptConsensusEncoding(t *testing.T) {
+	t.Parallel()
+
+	status := hexutil.Uint64(ethTypes.ReceiptStatusSuccessful)
+	receipt := borReceipt{Status: &status, CumulativeGasUsed: 21000, Logs: []borReceiptLog{}}
+
+	legacy, err := receipt.consensusEncoding()
+	require.NoError(t, err)
+
+	receipt.Type = 2
+	typed, err := receipt.consensusEncoding()
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{2}, legacy...), typed, "typed receipts should be prefixed with their type")
+}
@@ -0,0 +1,277 @@
+package helper
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// DefaultBroadcastMaxRetries max number of re-broadcasts after sequence mismatch
+	DefaultBroadcastMaxRetries = 3
+
+	// txPollInterval interval node is polled at for inclusion of broadcasted tx
+	txPollInterval = time.Second
+)
+
+// TxRejection classifies why a tx was rejected by mempool/ante handler
+type TxRejection int
+
+const (
+	// TxRejectionNone tx was accepted
+	TxRejectionNone TxRejection = iota
+	// TxRejectionSequenceMismatch account sequence used for signing is stale
+	TxRejectionSequenceMismatch
+	// TxRejectionInsufficientFees fee is too low or account can't pay it
+	TxRejectionInsufficientFees
+	// TxRejectionMsgWeightExceeded tx was delivered after msg weight of block was used up
+	TxRejectionMsgWeightExceeded
+	// TxRejectionOther any other rejection
+	TxRejectionOther
+)
+
+// String returns name of rejection class
+func (r TxRejection) String() string {
+	switch r {
+	case TxRejectionNone:
+		return "none"
+	case TxRejectionSequenceMismatch:
+		return "sequence-mismatch"
+	case TxRejectionInsufficientFees:
+		return "insufficient-fees"
+	case TxRejectionMsgWeightExceeded:
+		return "msg-weight-exceeded"
+	default:
+		return "other"
+	}
+}
+
+// TxRejectedError is returned when broadcasted tx is rejected by node
+type TxRejectedError struct {
+	Rejection TxRejection
+	Response  sdk.TxResponse
+}
+
+func (e TxRejectedError) Error() string {
+	return fmt.Sprintf("tx rejected (%v): code %v, log %v", e.Rejection, e.Response.Code, e.Response.RawLog)
+}
+
+// ClassifyTxResponse returns rejection class for tx response
+func ClassifyTxResponse(res sdk.TxResponse) TxRejection {
+	if res.Code == uint32(sdk.CodeOK) {
+		return TxRejectionNone
+	}
+
+	if res.Codespace != "" && res.Codespace != string(sdk.CodespaceRoot) {
+		return TxRejectionOther
+	}
+
+	switch sdk.CodeType(res.Code) {
+	case sdk.CodeInvalidSequence:
+		return TxRejectionSequenceMismatch
+	case sdk.CodeUnauthorized:
+		// signature covers sequence, so stale sequence fails signature verification
+		if strings.Contains(res.RawLog, "sequence") {
+			return TxRejectionSequenceMismatch
+		}
+	case sdk.CodeInsufficientFee, sdk.CodeInsufficientFunds, sdk.CodeInsufficientCoins:
+		return TxRejectionInsufficientFees
+	case common.CodeBlockMsgWeightExceeded:
+		return TxRejectionMsgWeightExceeded
+	}
+
+	return TxRejectionOther
+}
+
+// RPCBroadcaster signs and broadcasts msgs directly to tendermint rpc.
+// It tracks account sequence locally and re-fetches it from node state
+// when tx is rejected because of sequence mismatch.
+type RPCBroadcaster struct {
+	mu sync.Mutex
+
+	cliCtx     context.CLIContext
+	mode       string
+	maxRetries int
+	logger     log.Logger
+
+	address types.HeimdallAddress
+	accNum  uint64
+	seqNo   uint64
+	loaded  bool
+}
+
+// NewRPCBroadcaster creates new rpc broadcaster for local validator account.
+// mode is one of BroadcastSync, BroadcastAsync or BroadcastBlock (commit).
+func NewRPCBroadcaster(cliCtx context.CLIContext, mode string) (*RPCBroadcaster, error) {
+	switch mode {
+	case BroadcastSync, BroadcastAsync, BroadcastBlock:
+	default:
+		return nil, fmt.Errorf("unsupported broadcast mode %v", mode)
+	}
+
+	return &RPCBroadcaster{
+		cliCtx:     cliCtx,
+		mode:       mode,
+		maxRetries: DefaultBroadcastMaxRetries,
+		logger:     Logger.With("module", "rpcBroadcaster"),
+		address:    types.BytesToHeimdallAddress(GetAddress()),
+	}, nil
+}
+
+// WithMaxRetries sets max number of re-broadcasts after sequence mismatch
+func (b *RPCBroadcaster) WithMaxRetries(maxRetries int) *RPCBroadcaster {
+	b.maxRetries = maxRetries
+	return b
+}
+
+// Sequence returns locally tracked account sequence
+func (b *RPCBroadcaster) Sequence() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.seqNo
+}
+
+// RefreshSequence re-fetches account number and sequence from node state
+func (b *RPCBroadcaster) RefreshSequence() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.refreshSequence()
+}
+
+func (b *RPCBroadcaster) refreshSequence() error {
+	accNum, seqNo, err := authTypes.NewAccountRetriever(b.cliCtx).GetAccountNumberSequence(b.address)
+	if err != nil {
+		return err
+	}
+
+	b.accNum, b.seqNo, b.loaded = accNum, seqNo, true
+	return nil
+}
+
+// BroadcastMsgs signs msgs with local key and broadcasts them.
+// Txs rejected due to stale sequence are re-signed with fresh sequence and re-broadcasted.
+func (b *RPCBroadcaster) BroadcastMsgs(msgs []sdk.Msg) (sdk.TxResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.loaded {
+		if err := b.refreshSequence(); err != nil {
+			return sdk.TxResponse{}, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := b.broadcast(msgs)
+		if err != nil {
+			// tx might have reached mempool, don't trust local sequence anymore
+			b.loaded = false
+			return res, err
+		}
+
+		rejection := ClassifyTxResponse(res)
+		if rejection == TxRejectionNone {
+			b.logger.Debug("Tx broadcasted", "txHash", res.TxHash, "accSeq", b.seqNo, "mode", b.mode)
+			b.seqNo++
+			return res, nil
+		}
+
+		b.logger.Error("Tx rejected", "txHash", res.TxHash, "rejection", rejection, "code", res.Code, "log", res.RawLog, "accSeq", b.seqNo)
+
+		if err := b.refreshSequence(); err != nil {
+			b.loaded = false
+			return res, err
+		}
+
+		if rejection != TxRejectionSequenceMismatch || attempt >= b.maxRetries {
+			return res, TxRejectedError{Rejection: rejection, Response: res}
+		}
+
+		b.logger.Info("Re-broadcasting tx with fresh sequence", "accSeq", b.seqNo, "attempt", attempt+1)
+	}
+}
+
+// WaitForTx polls node until tx is included in block and returns its deliver result,
+// error is returned if tx isn't included within timeout
+func (b *RPCBroadcaster) WaitForTx(txHash string, timeout time.Duration) (sdk.TxResponse, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	node, err := b.cliCtx.GetNode()
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		resTx, err := node.Tx(hash, false)
+		if err == nil {
+			return sdk.TxResponse{
+				Height:    resTx.Height,
+				TxHash:    txHash,
+				Code:      resTx.TxResult.Code,
+				Codespace: resTx.TxResult.Codespace,
+				RawLog:    resTx.TxResult.Log,
+			}, nil
+		}
+
+		if time.Now().After(deadline) {
+			return sdk.TxResponse{}, fmt.Errorf("tx %v not included within %v: %v", txHash, timeout, err)
+		}
+		time.Sleep(txPollInterval)
+	}
+}
+
+// broadcast signs msgs with current sequence and sends them to node
+func (b *RPCBroadcaster) broadcast(msgs []sdk.Msg) (sdk.TxResponse, error) {
+	txBldr := authTypes.NewTxBuilderFromCLI().
+		WithTxEncoder(GetTxEncoder(b.cliCtx.Codec)).
+		WithAccountNumber(b.accNum).
+		WithSequence(b.seqNo).
+		WithChainID(GetGenesisDoc().ChainID)
+
+	txBytes, err := txBldr.BuildAndSign(GetPrivKey(), msgs)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	node, err := b.cliCtx.GetNode()
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	switch b.mode {
+	case BroadcastAsync:
+		res, err := node.BroadcastTxAsync(txBytes)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+		return sdk.NewResponseFormatBroadcastTx(res), nil
+	case BroadcastBlock:
+		res, err := node.BroadcastTxCommit(txBytes)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+		return sdk.NewResponseFormatBroadcastTxCommit(res), nil
+	case BroadcastSync:
+		res, err := node.BroadcastTxSync(txBytes)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+		return sdk.NewResponseFormatBroadcastTx(res), nil
+	}
+
+	return sdk.TxResponse{}, errors.New("unsupported broadcast mode")
+}
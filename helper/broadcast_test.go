@@ -0,0 +1,69 @@
+package helper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/common"
+)
+
+func TestClassifyTxResponse(t *testing.T) {
+	t.Parallel()
+
+	tc := []struct {
+		res      sdk.TxResponse
+		expected TxRejection
+		msg      string
+	}{
+		{
+			res:      sdk.TxResponse{Code: uint32(sdk.CodeOK)},
+			expected: TxRejectionNone,
+			msg:      "accepted tx",
+		},
+		{
+			res:      sdk.TxResponse{Code: uint32(sdk.CodeInvalidSequence), Codespace: string(sdk.CodespaceRoot)},
+			expected: TxRejectionSequenceMismatch,
+			msg:      "invalid sequence",
+		},
+		{
+			res: sdk.TxResponse{
+				Code:      uint32(sdk.CodeUnauthorized),
+				Codespace: string(sdk.CodespaceRoot),
+				RawLog:    "signature verification failed; verify correct account sequence and chain-id",
+			},
+			expected: TxRejectionSequenceMismatch,
+			msg:      "signature failed due to stale sequence",
+		},
+		{
+			res:      sdk.TxResponse{Code: uint32(sdk.CodeUnauthorized), Codespace: string(sdk.CodespaceRoot), RawLog: "unauthorized"},
+			expected: TxRejectionOther,
+			msg:      "unauthorized",
+		},
+		{
+			res:      sdk.TxResponse{Code: uint32(sdk.CodeInsufficientFee), Codespace: string(sdk.CodespaceRoot)},
+			expected: TxRejectionInsufficientFees,
+			msg:      "insufficient fee",
+		},
+		{
+			res:      sdk.TxResponse{Code: uint32(sdk.CodeInsufficientFunds), Codespace: string(sdk.CodespaceRoot)},
+			expected: TxRejectionInsufficientFees,
+			msg:      "insufficient funds",
+		},
+		{
+			res:      sdk.TxResponse{Code: uint32(common.CodeBlockMsgWeightExceeded), Codespace: string(sdk.CodespaceRoot)},
+			expected: TxRejectionMsgWeightExceeded,
+			msg:      "delivered after block msg weight was used up",
+		},
+		{
+			res:      sdk.TxResponse{Code: uint32(sdk.CodeInvalidSequence), Codespace: "checkpoint"},
+			expected: TxRejectionOther,
+			msg:      "module error with same code",
+		},
+	}
+
+	for _, c := range tc {
+		require.Equal(t, c.expected, ClassifyTxResponse(c.res), c.msg)
+	}
+}
@@ -5,16 +5,21 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/maticnetwork/heimdall/tron"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
 	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
 	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/bor/rpc"
@@ -31,27 +36,37 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+//go:generate mockery --name IContractCaller --output ./mocks
+
 // IContractCaller represents contract caller
 type IContractCaller interface {
 	GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
+	GetHeaderInfoAt(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64, blockNumber uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
 	GetRootHash(start uint64, end uint64, checkpointLength uint64) ([]byte, error)
+	GetBorHeadersBatch(start uint64, end uint64) ([]*ethTypes.Header, error)
 	GetValidatorInfo(valID types.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error)
 	GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error)
 	CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error)
 	GetBalance(address common.Address) (*big.Int, error)
 	SendCheckpoint(sigedData []byte, sigs [][3]*big.Int, rootchainAddress common.Address, rootChainInstance *rootchain.Rootchain, rootChain string) (err error)
 	SendTronCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress string) error
+	EstimateCheckpointGas(signedData []byte, sigs [][3]*big.Int, rootChainAddress common.Address, rootChain string) (gasLimit uint64, gasPrice *big.Int, err error)
+	SimulateTronCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress string) (feeLimit uint64, err error)
 	SendTick(sigedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (err error)
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int, string) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetConfirmedTxReceipt(common.Hash, uint64, string) (*ethTypes.Receipt, error)
+	GetFinalizedCallOpts(rootChain string) (*bind.CallOpts, error)
+	IsParliaFinalized(blockNumber uint64) (bool, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
 
 	// decode header event
 	DecodeNewHeaderBlockEvent(common.Address, *ethTypes.Receipt, uint64) (*rootchain.RootchainNewHeaderBlock, error)
 	// decode validator events
 	DecodeValidatorTopupFeesEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoTopUpFee, error)
+	// decode erc20 transfer of whitelisted fee token
+	DecodeERC20TransferEvent(common.Address, *ethTypes.Receipt, uint64) (*erc20.Erc20Transfer, error)
 	DecodeValidatorJoinEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStaked, error)
 	DecodeValidatorStakeUpdateEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStakeUpdate, error)
 	DecodeValidatorExitEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUnstakeInit, error)
@@ -74,6 +89,7 @@ type IContractCaller interface {
 	GetSpanDetails(id *big.Int, validatorset *validatorset.Validatorset) (*big.Int, *big.Int, *big.Int, error)
 	CurrentStateCounter(stateSenderInstance *statesender.Statesender) (Number *big.Int)
 	CheckIfBlocksExist(end uint64) bool
+	IsStateProcessed(stateID uint64, stateReceiverAddress common.Address) (bool, error)
 
 	// staking sync
 	GetMainStakingSyncNonce(validatorID uint64, stakingManagerInstance *stakemanager.Stakemanager) (nonce uint64)
@@ -81,6 +97,10 @@ type IContractCaller interface {
 	SendMainStakingSync(stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) (err error)
 	SendTronStakingSync(stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress string) (err error)
 
+	// validator set sync
+	GetValidatorSetSyncNonce(stakingManagerInstance *stakemanager.Stakemanager) (nonce uint64)
+	SendValidatorSetSync(sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) (err error)
+
 	GetRootChainInstance(rootchainAddress common.Address, rootChain string) (*rootchain.Rootchain, error)
 	GetStakingInfoInstance(stakingInfoAddress common.Address, rootChain string) (*stakinginfo.Stakinginfo, error)
 	GetValidatorSetInstance(validatorSetAddress common.Address) (*validatorset.Validatorset, error)
@@ -91,6 +111,7 @@ type IContractCaller interface {
 	GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error)
 
 	GetTronHeaderInfo(headerID uint64, rootChainAddress string, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
+	GetTronCurrentHeaderBlock(rootChainAddress string, childBlockInterval uint64) (uint64, error)
 	GetTronEventsByContractAddress(address []string, from, to int64) ([]ethTypes.Log, error)
 	GetTronTransactionReceipt(txID string) (*ethTypes.Receipt, error)
 	GetTronLatestBlockNumber() (int64, error)
@@ -126,6 +147,19 @@ type ContractCaller struct {
 	LatestBlockCache map[string]uint64
 
 	ContractInstanceCache map[string]interface{}
+
+	// read-only contract call results, scoped to root chain block
+	CallCache          *ContractCallCache
+	rootChainInstances map[*rootchain.Rootchain]rootChainInstanceInfo
+
+	// journal of calls made during side-tx validation, nil if disabled
+	Journal *CallJournal
+}
+
+// rootChainInstanceInfo identifies contract behind root chain instance
+type rootChainInstanceInfo struct {
+	rootChain string
+	address   common.Address
 }
 
 type txExtraInfo struct {
@@ -138,6 +172,15 @@ type rpcTransaction struct {
 	txExtraInfo
 }
 
+// headerInfo cached result of rootchain headerBlocks call
+type headerInfo struct {
+	Root      common.Hash
+	Start     uint64
+	End       uint64
+	CreatedAt uint64
+	Proposer  types.HeimdallAddress
+}
+
 // NewContractCaller contract caller
 func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	contractCallerObj.MainChainClient = GetMainClient()
@@ -148,6 +191,8 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	contractCallerObj.BscChainRPC = GetBscChainRPCClient()
 	contractCallerObj.MaticChainRPC = GetMaticRPCClient()
 	contractCallerObj.ReceiptCache, _ = NewLru(5000)
+	contractCallerObj.LatestBlockCache = make(map[string]uint64)
+	contractCallerObj.CallCache, _ = NewContractCallCache(DefaultContractCallCacheSize, DefaultContractCallCacheTTL)
 
 	//
 	// ABIs
@@ -186,33 +231,91 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	}
 
 	contractCallerObj.ContractInstanceCache = make(map[string]interface{})
+	contractCallerObj.rootChainInstances = make(map[*rootchain.Rootchain]rootChainInstanceInfo)
 
 	return
 }
 
+// contractCallerMu guards caches of contract callers, side-tx handlers call contracts concurrently
+var contractCallerMu sync.RWMutex
+
+func (c *ContractCaller) getCachedInstance(key string) (interface{}, bool) {
+	contractCallerMu.RLock()
+	defer contractCallerMu.RUnlock()
+	ci, ok := c.ContractInstanceCache[key]
+	return ci, ok
+}
+
+func (c *ContractCaller) setCachedInstance(key string, ci interface{}) {
+	contractCallerMu.Lock()
+	defer contractCallerMu.Unlock()
+	c.ContractInstanceCache[key] = ci
+}
+
+func (c *ContractCaller) getRootChainInstanceInfo(ci *rootchain.Rootchain) (rootChainInstanceInfo, bool) {
+	contractCallerMu.RLock()
+	defer contractCallerMu.RUnlock()
+	info, ok := c.rootChainInstances[ci]
+	return info, ok
+}
+
+func (c *ContractCaller) setRootChainInstanceInfo(ci *rootchain.Rootchain, info rootChainInstanceInfo) {
+	contractCallerMu.Lock()
+	defer contractCallerMu.Unlock()
+	if c.rootChainInstances != nil {
+		c.rootChainInstances[ci] = info
+	}
+}
+
+func (c *ContractCaller) getLatestBlock(rootChain string) uint64 {
+	contractCallerMu.RLock()
+	defer contractCallerMu.RUnlock()
+	return c.LatestBlockCache[rootChain]
+}
+
 // GetRootChainInstance returns RootChain contract instance for selected base chain
 func (c *ContractCaller) GetRootChainInstance(rootchainAddress common.Address, rootChain string) (*rootchain.Rootchain, error) {
 	cacheKey := rootchainAddress.String() + rootChain
-	contractInstance, ok := c.ContractInstanceCache[cacheKey]
+	contractInstance, ok := c.getCachedInstance(cacheKey)
 	if !ok {
-		var client *ethclient.Client
-		switch rootChain {
-		case hmTypes.RootChainTypeEth:
-			client = mainChainClient
-		case hmTypes.RootChainTypeBsc:
-			client = bscChainClient
-		}
-		ci, err := rootchain.NewRootchain(rootchainAddress, client)
-		c.ContractInstanceCache[cacheKey] = ci
+		ci, err := rootchain.NewRootchain(rootchainAddress, getRootChainClient(rootChain))
+		c.setCachedInstance(cacheKey, ci)
+		c.setRootChainInstanceInfo(ci, rootChainInstanceInfo{rootChain: rootChain, address: rootchainAddress})
 		return ci, err
 	}
 	return contractInstance.(*rootchain.Rootchain), nil
 }
 
+// getRootChainClient returns client of evm root chain
+func getRootChainClient(rootChain string) *ethclient.Client {
+	switch rootChain {
+	case hmTypes.RootChainTypeEth:
+		return mainChainClient
+	case hmTypes.RootChainTypeBsc:
+		return bscChainClient
+	}
+	return nil
+}
+
+// IsMissingStateError returns true if call failed because node doesn't have state for requested block
+func IsMissingStateError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{"missing trie node", "header not found", "state is not available", "pruned"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetStakingInfoInstance returns stakinginfo contract instance for selected base chain
 func (c *ContractCaller) GetStakingInfoInstance(stakingInfoAddress common.Address, rootChain string) (*stakinginfo.Stakinginfo, error) {
 	cacheKey := stakingInfoAddress.String() + rootChain
-	contractInstance, ok := c.ContractInstanceCache[cacheKey]
+	contractInstance, ok := c.getCachedInstance(cacheKey)
 	if !ok {
 		var client *ethclient.Client
 		switch rootChain {
@@ -222,7 +325,7 @@ func (c *ContractCaller) GetStakingInfoInstance(stakingInfoAddress common.Addres
 			client = bscChainClient
 		}
 		ci, err := stakinginfo.NewStakinginfo(stakingInfoAddress, client)
-		c.ContractInstanceCache[cacheKey] = ci
+		c.setCachedInstance(cacheKey, ci)
 		return ci, err
 	}
 	return contractInstance.(*stakinginfo.Stakinginfo), nil
@@ -230,10 +333,10 @@ func (c *ContractCaller) GetStakingInfoInstance(stakingInfoAddress common.Addres
 
 // GetValidatorSetInstance returns stakinginfo contract instance for selected base chain
 func (c *ContractCaller) GetValidatorSetInstance(validatorSetAddress common.Address) (*validatorset.Validatorset, error) {
-	contractInstance, ok := c.ContractInstanceCache[validatorSetAddress.String()]
+	contractInstance, ok := c.getCachedInstance(validatorSetAddress.String())
 	if !ok {
 		ci, err := validatorset.NewValidatorset(validatorSetAddress, mainChainClient)
-		c.ContractInstanceCache[validatorSetAddress.String()] = ci
+		c.setCachedInstance(validatorSetAddress.String(), ci)
 		return ci, err
 
 	}
@@ -243,7 +346,7 @@ func (c *ContractCaller) GetValidatorSetInstance(validatorSetAddress common.Addr
 // GetStakeManagerInstance returns stakinginfo contract instance for selected base chain
 func (c *ContractCaller) GetStakeManagerInstance(stakingManagerAddress common.Address, rootChain string) (*stakemanager.Stakemanager, error) {
 	cacheKey := stakingManagerAddress.String() + rootChain
-	contractInstance, ok := c.ContractInstanceCache[cacheKey]
+	contractInstance, ok := c.getCachedInstance(cacheKey)
 	if !ok {
 		var client *ethclient.Client
 		switch rootChain {
@@ -253,7 +356,7 @@ func (c *ContractCaller) GetStakeManagerInstance(stakingManagerAddress common.Ad
 			client = bscChainClient
 		}
 		ci, err := stakemanager.NewStakemanager(stakingManagerAddress, client)
-		c.ContractInstanceCache[cacheKey] = ci
+		c.setCachedInstance(cacheKey, ci)
 		return ci, err
 	}
 	return contractInstance.(*stakemanager.Stakemanager), nil
@@ -261,10 +364,10 @@ func (c *ContractCaller) GetStakeManagerInstance(stakingManagerAddress common.Ad
 
 // GetSlashManagerInstance returns slashManager contract instance for selected base chain
 func (c *ContractCaller) GetSlashManagerInstance(slashManagerAddress common.Address) (*slashmanager.Slashmanager, error) {
-	contractInstance, ok := c.ContractInstanceCache[slashManagerAddress.String()]
+	contractInstance, ok := c.getCachedInstance(slashManagerAddress.String())
 	if !ok {
 		ci, err := slashmanager.NewSlashmanager(slashManagerAddress, mainChainClient)
-		c.ContractInstanceCache[slashManagerAddress.String()] = ci
+		c.setCachedInstance(slashManagerAddress.String(), ci)
 		return ci, err
 	}
 	return contractInstance.(*slashmanager.Slashmanager), nil
@@ -272,10 +375,10 @@ func (c *ContractCaller) GetSlashManagerInstance(slashManagerAddress common.Addr
 
 // GetStateSenderInstance returns stakinginfo contract instance for selected base chain
 func (c *ContractCaller) GetStateSenderInstance(stateSenderAddress common.Address) (*statesender.Statesender, error) {
-	contractInstance, ok := c.ContractInstanceCache[stateSenderAddress.String()]
+	contractInstance, ok := c.getCachedInstance(stateSenderAddress.String())
 	if !ok {
 		ci, err := statesender.NewStatesender(stateSenderAddress, mainChainClient)
-		c.ContractInstanceCache[stateSenderAddress.String()] = ci
+		c.setCachedInstance(stateSenderAddress.String(), ci)
 		return ci, err
 	}
 	return contractInstance.(*statesender.Statesender), nil
@@ -283,10 +386,10 @@ func (c *ContractCaller) GetStateSenderInstance(stateSenderAddress common.Addres
 
 // GetStateReceiverInstance returns stakinginfo contract instance for selected base chain
 func (c *ContractCaller) GetStateReceiverInstance(stateReceiverAddress common.Address) (*statereceiver.Statereceiver, error) {
-	contractInstance, ok := c.ContractInstanceCache[stateReceiverAddress.String()]
+	contractInstance, ok := c.getCachedInstance(stateReceiverAddress.String())
 	if !ok {
 		ci, err := statereceiver.NewStatereceiver(stateReceiverAddress, mainChainClient)
-		c.ContractInstanceCache[stateReceiverAddress.String()] = ci
+		c.setCachedInstance(stateReceiverAddress.String(), ci)
 		return ci, err
 	}
 	return contractInstance.(*statereceiver.Statereceiver), nil
@@ -294,10 +397,10 @@ func (c *ContractCaller) GetStateReceiverInstance(stateReceiverAddress common.Ad
 
 // GetMaticTokenInstance returns stakinginfo contract instance for selected base chain
 func (c *ContractCaller) GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error) {
-	contractInstance, ok := c.ContractInstanceCache[maticTokenAddress.String()]
+	contractInstance, ok := c.getCachedInstance(maticTokenAddress.String())
 	if !ok {
 		ci, err := erc20.NewErc20(maticTokenAddress, mainChainClient)
-		c.ContractInstanceCache[maticTokenAddress.String()] = ci
+		c.setCachedInstance(maticTokenAddress.String(), ci)
 		return ci, err
 	}
 	return contractInstance.(*erc20.Erc20), nil
@@ -321,20 +424,71 @@ func (c *ContractCaller) GetHeaderInfo(number uint64, rootChainInstance *rootcha
 	proposer types.HeimdallAddress,
 	err error,
 ) {
-	// get header from rootchain
+	return c.GetHeaderInfoAt(number, rootChainInstance, childBlockInterval, 0)
+}
+
+// GetHeaderInfoAt get header info from checkpoint number as of root chain block.
+// Latest state is used if block number is 0. Historical state which is already
+// pruned on configured node is fetched from archive node (if configured).
+func (c *ContractCaller) GetHeaderInfoAt(number uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64, blockNumber uint64) (
+	root common.Hash,
+	start uint64,
+	end uint64,
+	createdAt uint64,
+	proposer types.HeimdallAddress,
+	err error,
+) {
+	method, args := "headerBlocks", []interface{}{number, childBlockInterval}
+	var opts *bind.CallOpts
+	if blockNumber > 0 {
+		method, args = "headerBlocksAt", append(args, blockNumber)
+		opts = &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(blockNumber)}
+	}
+
+	// instances not created by this caller are not cached
+	instanceInfo, known := c.getRootChainInstanceInfo(rootChainInstance)
+
+	// only calls pinned to root chain block are cached, side handlers vote on result
+	// and result as of latest block may change with every new block
+	cacheable := known && blockNumber > 0
+
+	callStart := time.Now()
+	defer func() {
+		c.Journal.Record(method, journalEndpoint(instanceInfo.rootChain), args, []interface{}{root, start, end, createdAt, proposer}, err, callStart)
+	}()
+
+	if cacheable {
+		if cached, ok := c.CallCache.Get(instanceInfo.rootChain, method, args...); ok {
+			info := cached.(headerInfo)
+			return info.Root, info.Start, info.End, info.CreatedAt, info.Proposer, nil
+		}
+	}
+
+	// get header from rootchain, with binding of detected contract version
+	binding, err := c.getInstanceBinding(rootChainInstance)
+	if err != nil {
+		Logger.Error("Unable to bind rootchain contract", "root", instanceInfo.rootChain, "error", err)
+		return root, start, end, createdAt, proposer, errors.New("Unable to fetch checkpoint block")
+	}
+
 	checkpointBigInt := big.NewInt(0).Mul(big.NewInt(0).SetUint64(number), big.NewInt(0).SetUint64(childBlockInterval))
-	headerBlock, err := rootChainInstance.HeaderBlocks(nil, checkpointBigInt)
+	info, err := binding.HeaderBlock(opts, checkpointBigInt)
+	if err != nil && cacheable && IsMissingStateError(err) {
+		if archiveBinding, archiveErr := c.getRootChainBinding(instanceInfo, GetArchiveClient(instanceInfo.rootChain), true); archiveErr == nil {
+			Logger.Debug("State not available, calling archive node", "root", instanceInfo.rootChain, "blockNumber", blockNumber)
+			info, err = archiveBinding.HeaderBlock(opts, checkpointBigInt)
+		}
+	}
 	if err != nil {
-		Logger.Error("Unable to fetch checkpoint block", "error", err)
+		Logger.Error("Unable to fetch checkpoint block", "blockNumber", blockNumber, "error", err)
 		return root, start, end, createdAt, proposer, errors.New("Unable to fetch checkpoint block")
 	}
 
-	return headerBlock.Root,
-		headerBlock.Start.Uint64(),
-		headerBlock.End.Uint64(),
-		headerBlock.CreatedAt.Uint64(),
-		types.BytesToHeimdallAddress(headerBlock.Proposer.Bytes()),
-		nil
+	if cacheable {
+		c.CallCache.Add(info, instanceInfo.rootChain, method, args...)
+	}
+
+	return info.Root, info.Start, info.End, info.CreatedAt, info.Proposer, nil
 }
 
 // GetRootHash get root hash from bor chain
@@ -359,7 +513,13 @@ func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength
 
 // GetLastChildBlock fetch current child block
 func (c *ContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error) {
-	GetLastChildBlock, err := rootChainInstance.GetLastChildBlock(nil)
+	binding, err := c.getInstanceBinding(rootChainInstance)
+	if err != nil {
+		Logger.Error("Unable to bind rootchain contract", "Error", err)
+		return 0, err
+	}
+
+	GetLastChildBlock, err := binding.GetLastChildBlock(nil)
 	if err != nil {
 		Logger.Error("Could not fetch current child block from rootchain contract", "Error", err)
 		return 0, err
@@ -369,7 +529,13 @@ func (c *ContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootchai
 
 // CurrentHeaderBlock fetches current header block
 func (c *ContractCaller) CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error) {
-	currentHeaderBlock, err := rootChainInstance.CurrentHeaderBlock(nil)
+	binding, err := c.getInstanceBinding(rootChainInstance)
+	if err != nil {
+		Logger.Error("Unable to bind rootchain contract", "Error", err)
+		return 0, err
+	}
+
+	currentHeaderBlock, err := binding.CurrentHeaderBlock(nil)
 	if err != nil {
 		Logger.Error("Could not fetch current header block from rootchain contract", "Error", err)
 		return 0, err
@@ -434,6 +600,11 @@ func (c *ContractCaller) GetMainChainBlock(blockNum *big.Int, rootChain string)
 
 // GetMaticChainBlock returns child chain block header
 func (c *ContractCaller) GetMaticChainBlock(blockNum *big.Int) (header *ethTypes.Header, err error) {
+	callStart := time.Now()
+	defer func() {
+		c.Journal.Record("eth_getBlockByNumber", GetConfig().BttcRPCUrl, []interface{}{blockNum}, header, err, callStart)
+	}()
+
 	latestBlock, err := c.MaticChainClient.HeaderByNumber(context.Background(), blockNum)
 	if err != nil {
 		Logger.Error("Unable to connect to matic chain", "Error", err)
@@ -463,6 +634,13 @@ func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, err
 
 // GetConfirmedTxReceipt returns confirmed tx receipt
 func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmations uint64, rootChain string) (*ethTypes.Receipt, error) {
+	callStart := time.Now()
+	receipt, err := c.getConfirmedTxReceipt(tx, requiredConfirmations, rootChain)
+	c.Journal.Record("eth_getTransactionReceipt", journalEndpoint(rootChain), []interface{}{tx.Hex(), requiredConfirmations}, receipt, err, callStart)
+	return receipt, err
+}
+
+func (c *ContractCaller) getConfirmedTxReceipt(tx common.Hash, requiredConfirmations uint64, rootChain string) (*ethTypes.Receipt, error) {
 
 	var receipt *ethTypes.Receipt = nil
 	receiptCache, ok := c.ReceiptCache.Get(tx.String())
@@ -484,7 +662,7 @@ func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmat
 
 	Logger.Debug("Tx included in block", "root", rootChain, "block", receipt.BlockNumber.Uint64(), "tx", tx)
 
-	latestBlkNumber := c.LatestBlockCache[rootChain]
+	latestBlkNumber := c.getLatestBlock(rootChain)
 	if latestBlkNumber-receipt.BlockNumber.Uint64() >= requiredConfirmations {
 		Logger.Debug("receipt block is confirmed by cache",
 			"root", rootChain, "latestBlockCached", latestBlkNumber, "receiptBlock", receipt.BlockNumber.Uint64())
@@ -497,7 +675,7 @@ func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmat
 		return nil, err
 	}
 	Logger.Debug("Latest block on main chain obtained", "root", rootChain, "Block", latestBlk.Number.Uint64())
-	c.LatestBlockCache[rootChain] = latestBlk.Number.Uint64()
+	c.observeRootChainBlock(rootChain, latestBlk.Number.Uint64())
 	diff := latestBlk.Number.Uint64() - receipt.BlockNumber.Uint64()
 	if diff < requiredConfirmations {
 		return nil, errors.New("not enough confirmations")
@@ -506,6 +684,45 @@ func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmat
 	return receipt, nil
 }
 
+// GetFinalizedCallOpts returns call opts pinned to root chain block of configured finality tag.
+// Nil opts are returned if root chain has no finality tag, fixed tx confirmations apply then.
+func (c *ContractCaller) GetFinalizedCallOpts(rootChain string) (opts *bind.CallOpts, err error) {
+	tag := GetFinalityTag(rootChain)
+	if tag == "" {
+		return nil, nil
+	}
+
+	var rpcClient *rpc.Client
+	switch rootChain {
+	case hmTypes.RootChainTypeEth:
+		rpcClient = c.MainChainRPC
+	case hmTypes.RootChainTypeBsc:
+		rpcClient = c.BscChainRPC
+	default:
+		return nil, errors.New("wrong chain type")
+	}
+
+	var head struct {
+		Number *hexutil.Big `json:"number"`
+	}
+
+	callStart := time.Now()
+	defer func() {
+		c.Journal.Record("eth_getBlockByNumber", journalEndpoint(rootChain), []interface{}{tag, false}, head.Number, err, callStart)
+	}()
+
+	if err = rpcClient.CallContext(context.Background(), &head, "eth_getBlockByNumber", tag, false); err != nil {
+		Logger.Error("Unable to fetch block by tag", "root", rootChain, "tag", tag, "error", err)
+		return nil, err
+	}
+
+	if head.Number == nil {
+		return nil, fmt.Errorf("no %v block on root chain %v", tag, rootChain)
+	}
+
+	return &bind.CallOpts{BlockNumber: head.Number.ToInt()}, nil
+}
+
 //
 // Validator decode events
 //
@@ -532,6 +749,28 @@ func (c *ContractCaller) DecodeNewHeaderBlockEvent(contractAddress common.Addres
 	return event, nil
 }
 
+// DecodeERC20TransferEvent represents transfer of erc20 token
+func (c *ContractCaller) DecodeERC20TransferEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*erc20.Erc20Transfer, error) {
+	event := new(erc20.Erc20Transfer)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.MaticTokenABI, event, "Transfer", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
 // DecodeValidatorTopupFeesEvent represents topup for fees tokens
 func (c *ContractCaller) DecodeValidatorTopupFeesEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoTopUpFee, error) {
 	event := new(stakinginfo.StakinginfoTopUpFee)
@@ -781,6 +1020,22 @@ func (c *ContractCaller) CheckIfBlocksExist(end uint64) bool {
 	return true
 }
 
+// IsStateProcessed checks if state is committed on bor by state receiver contract
+func (c *ContractCaller) IsStateProcessed(stateID uint64, stateReceiverAddress common.Address) (processed bool, err error) {
+	callStart := time.Now()
+	defer func() {
+		c.Journal.Record("states", GetConfig().BttcRPCUrl, []interface{}{stateReceiverAddress.Hex(), stateID}, processed, err, callStart)
+	}()
+
+	// state receiver lives on bor, instance cache is bound to main chain client
+	stateReceiverInstance, err := statereceiver.NewStatereceiver(stateReceiverAddress, c.MaticChainClient)
+	if err != nil {
+		return false, err
+	}
+
+	return stateReceiverInstance.States(nil, new(big.Int).SetUint64(stateID))
+}
+
 //
 // Receipt functions
 //
@@ -804,7 +1059,16 @@ func (c *ContractCaller) GetMaticTxReceipt(txHash common.Hash) (*ethTypes.Receip
 func (c *ContractCaller) getTxReceipt(client *ethclient.Client, txHash common.Hash) (*ethTypes.Receipt, error) {
 	return client.TransactionReceipt(context.Background(), txHash)
 }
+
+// GetTronTransactionReceipt returns tron tx receipt
 func (c *ContractCaller) GetTronTransactionReceipt(txID string) (*ethTypes.Receipt, error) {
+	callStart := time.Now()
+	receipt, err := c.getTronTransactionReceipt(txID)
+	c.Journal.Record(tron.GetTransactionByHash, GetTronGridEndpoint("/jsonrpc"), []interface{}{txID}, receipt, err, callStart)
+	return receipt, err
+}
+
+func (c *ContractCaller) getTronTransactionReceipt(txID string) (*ethTypes.Receipt, error) {
 	// create filter
 	var txIDs = []string{txID}
 	queryFilter := tron.FilterOtherParams{
@@ -858,7 +1122,9 @@ func (c *ContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte,
 
 // GetMainStakingSyncNonce return validator nonce
 func (c *ContractCaller) GetMainStakingSyncNonce(validatorID uint64, stakingManagerInstance *stakemanager.Stakemanager) (nonce uint64) {
+	callStart := time.Now()
 	validatorNonce, err := stakingManagerInstance.ValidatorNonce(nil, big.NewInt(int64(validatorID)))
+	c.Journal.Record("validatorNonce", "", []interface{}{validatorID}, validatorNonce, err, callStart)
 	if err != nil {
 		Logger.Error("Error fetching validator nonce from stake manager",
 			"error", err, "validatorId", validatorID)
@@ -876,7 +1142,9 @@ func (c *ContractCaller) GetTronStakingSyncNonce(validatorID uint64, stakingMana
 
 		return 0
 	}
+	callStart := time.Now()
 	result, err := c.TronChainRPC.TriggerConstantContract(stakingManagerAddress, data)
+	c.Journal.Record("validatorNonce", journalEndpoint(hmTypes.RootChainTypeTron), []interface{}{stakingManagerAddress, validatorID}, result, err, callStart)
 	if err != nil {
 		Logger.Error("Error fetching validator nonce from stake manager",
 			"error", err, "validatorId", validatorID)
@@ -893,6 +1161,18 @@ func (c *ContractCaller) GetTronStakingSyncNonce(validatorID uint64, stakingMana
 	return (*ret0).Uint64()
 }
 
+// GetValidatorSetSyncNonce return nonce of last validator set synced to stake manager
+func (c *ContractCaller) GetValidatorSetSyncNonce(stakingManagerInstance *stakemanager.Stakemanager) (nonce uint64) {
+	callStart := time.Now()
+	syncNonce, err := stakingManagerInstance.ValidatorSetSyncNonce(nil)
+	c.Journal.Record("validatorSetSyncNonce", "", nil, syncNonce, err, callStart)
+	if err != nil {
+		Logger.Error("Error fetching validator set sync nonce from stake manager", "error", err)
+		return 0
+	}
+	return syncNonce.Uint64()
+}
+
 func (c *ContractCaller) GetTronEventsByContractAddress(address []string, from, to int64) ([]ethTypes.Log, error) {
 	var decodedAddress []string
 	for _, adr := range address {
@@ -955,9 +1235,25 @@ func (c *ContractCaller) GetTronLatestBlockNumber() (int64, error) {
 	if err != nil {
 		return 0, err
 	}
+	c.observeRootChainBlock(hmTypes.RootChainTypeTron, blockNumber)
 	return int64(blockNumber), nil
 }
 
+// observeRootChainBlock records latest root chain block and drops cached call results on new block
+func (c *ContractCaller) observeRootChainBlock(rootChain string, number uint64) {
+	contractCallerMu.Lock()
+	defer contractCallerMu.Unlock()
+
+	if c.LatestBlockCache == nil {
+		c.LatestBlockCache = make(map[string]uint64)
+	}
+
+	if number > c.LatestBlockCache[rootChain] {
+		c.CallCache.Invalidate(rootChain)
+	}
+	c.LatestBlockCache[rootChain] = number
+}
+
 func (c *ContractCaller) GetStartListenBlock(rootChainType string) uint64 {
 	if rootChainType == hmTypes.RootChainTypeTron {
 		return GetConfig().TronStartListenBlock
@@ -970,6 +1266,12 @@ func (c *ContractCaller) GetStartListenBlock(rootChainType string) uint64 {
 
 func (c *ContractCaller) GetTronHeaderInfo(headerID uint64, contractAddress string, childBlockInterval uint64) (
 	root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error) {
+	callStart := time.Now()
+	defer func() {
+		c.Journal.Record("headerBlocks", journalEndpoint(hmTypes.RootChainTypeTron), []interface{}{contractAddress, headerID, childBlockInterval},
+			[]interface{}{root, start, end, createdAt, proposer}, err, callStart)
+	}()
+
 	// Pack the input
 	btsPack, err := c.RootChainABI.Pack("headerBlocks",
 		big.NewInt(0).Mul(big.NewInt(0).SetUint64(headerID), big.NewInt(0).SetUint64(childBlockInterval)))
@@ -995,11 +1297,26 @@ func (c *ContractCaller) GetTronHeaderInfo(headerID uint64, contractAddress stri
 		return root, 0, 0, 0, types.HeimdallAddress{}, err
 	}
 
-	return ret.Root, ret.Start.Uint64(), ret.End.Uint64(),
-		ret.CreatedAt.Uint64(), types.HeimdallAddress(ret.Proposer), nil
+	return ret.Root, ret.Start.Uint64(), ret.End.Uint64(), ret.CreatedAt.Uint64(), types.HeimdallAddress(ret.Proposer), nil
+}
+
+// GetTronCurrentHeaderBlock fetches current header block of root chain contract on tron
+func (c *ContractCaller) GetTronCurrentHeaderBlock(contractAddress string, childBlockInterval uint64) (uint64, error) {
+	number, err := c.TronChainRPC.CurrentHeaderBlock(contractAddress, childBlockInterval)
+	if err != nil {
+		Logger.Error("Could not fetch current header block from tron rootchain contract", "Error", err)
+		return 0, err
+	}
+
+	return number, nil
 }
 
 func (c *ContractCaller) GetSyncedCheckpointId(contractAddress string, rootChain string) (currentHeader uint64, err error) {
+	callStart := time.Now()
+	defer func() {
+		c.Journal.Record("getCurrentSyncedCheckpoint", journalEndpoint(hmTypes.RootChainTypeTron), []interface{}{contractAddress, rootChain}, currentHeader, err, callStart)
+	}()
+
 	// Pack the input
 	chainID := types.GetRootChainID(rootChain)
 	btsPack, err := c.StakeManagerABI.Pack("getCurrentSyncedCheckpoint", big.NewInt(int64(chainID)))
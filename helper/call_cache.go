@@ -0,0 +1,114 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// DefaultContractCallCacheSize max number of cached contract call results
+	DefaultContractCallCacheSize = 1000
+
+	// DefaultContractCallCacheTTL max age of cached contract call result
+	DefaultContractCallCacheTTL = 10 * time.Second
+)
+
+type contractCallCacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// ContractCallCache caches read-only contract call results.
+// Entries are keyed by root chain, method and args and are dropped after TTL
+// or when a new block is observed on their root chain. Args must pin root
+// chain block of call, results as of latest block can't be cached.
+type ContractCallCache struct {
+	mu sync.Mutex
+
+	ttl     time.Duration
+	entries *lru.Cache
+	now     func() time.Time
+}
+
+// NewContractCallCache creates new contract call cache
+func NewContractCallCache(size int, ttl time.Duration) (*ContractCallCache, error) {
+	entries, err := NewLru(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractCallCache{
+		ttl:     ttl,
+		entries: entries,
+		now:     time.Now,
+	}, nil
+}
+
+// contractCallCacheKey returns cache key as `rootChain/method/arg1/arg2...`
+func contractCallCacheKey(rootChain string, method string, args ...interface{}) string {
+	parts := []string{rootChain, method}
+	for _, arg := range args {
+		parts = append(parts, fmt.Sprintf("%v", arg))
+	}
+	return strings.Join(parts, "/")
+}
+
+// Get returns cached result for call
+func (c *ContractCallCache) Get(rootChain string, method string, args ...interface{}) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := contractCallCacheKey(rootChain, method, args...)
+	value, ok := c.entries.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	entry := value.(contractCallCacheEntry)
+	if c.now().After(entry.expiresAt) {
+		c.entries.Remove(key)
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// Add stores result for call
+func (c *ContractCallCache) Add(value interface{}, rootChain string, method string, args ...interface{}) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries.Add(contractCallCacheKey(rootChain, method, args...), contractCallCacheEntry{
+		value:     value,
+		expiresAt: c.now().Add(c.ttl),
+	})
+}
+
+// Invalidate drops all cached results for root chain
+func (c *ContractCallCache) Invalidate(rootChain string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prefix := rootChain + "/"
+	for _, key := range c.entries.Keys() {
+		if strings.HasPrefix(key.(string), prefix) {
+			c.entries.Remove(key)
+		}
+	}
+}
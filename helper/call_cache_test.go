@@ -0,0 +1,46 @@
+package helper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractCallCache(t *testing.T) {
+	t.Parallel()
+
+	cache, err := NewContractCallCache(10, time.Minute)
+	require.NoError(t, err)
+
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	cache.Add(uint64(5), "tron", "getCurrentSyncedCheckpoint", "addr", "eth")
+	cache.Add(uint64(7), "eth", "headerBlocks", 1, 10000)
+
+	value, ok := cache.Get("tron", "getCurrentSyncedCheckpoint", "addr", "eth")
+	require.True(t, ok)
+	require.Equal(t, uint64(5), value)
+
+	_, ok = cache.Get("tron", "getCurrentSyncedCheckpoint", "addr", "bsc")
+	require.False(t, ok, "args are part of the key")
+
+	// new tron block drops only tron results
+	cache.Invalidate("tron")
+	_, ok = cache.Get("tron", "getCurrentSyncedCheckpoint", "addr", "eth")
+	require.False(t, ok)
+	_, ok = cache.Get("eth", "headerBlocks", 1, 10000)
+	require.True(t, ok)
+
+	// expired results are dropped
+	now = now.Add(2 * time.Minute)
+	_, ok = cache.Get("eth", "headerBlocks", 1, 10000)
+	require.False(t, ok)
+
+	// nil cache is a no-op
+	var nilCache *ContractCallCache
+	nilCache.Add(1, "eth", "headerBlocks")
+	_, ok = nilCache.Get("eth", "headerBlocks")
+	require.False(t, ok)
+}
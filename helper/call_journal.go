@@ -0,0 +1,322 @@
+package helper
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/crypto"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// CallJournalFileName name of active journal file, rotated files are suffixed with their index
+	CallJournalFileName = "calls.log"
+
+	DefaultCallJournalMaxFileSize = 64 << 20 // 64 MB
+	DefaultCallJournalMaxFiles    = 5
+)
+
+// CallJournalEntry external contract call made during side-tx validation
+type CallJournalEntry struct {
+	Height       int64     `json:"height"`
+	TxHash       string    `json:"tx_hash"`
+	Method       string    `json:"method"`
+	Args         []string  `json:"args,omitempty"`
+	Endpoint     string    `json:"endpoint"` // empty if call is made through contract binding of unknown chain
+	ResponseHash string    `json:"response_hash,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	LatencyMs    int64     `json:"latency_ms"`
+	Time         time.Time `json:"time"`
+}
+
+// CallJournal appends contract calls made during side-tx validation to rotating
+// local files, so votes of node can be explained afterwards.
+// Calls are only recorded within side-tx scope (see Begin), nil journal records nothing.
+type CallJournal struct {
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+
+	// scope serializes side-tx validations, so calls are attributed to their tx
+	scope sync.Mutex
+
+	mu     sync.Mutex
+	height int64
+	txHash string
+	active bool
+	file   *os.File
+	size   int64
+}
+
+// NewCallJournal creates journal writing to dir
+func NewCallJournal(dir string, maxFileSize int64, maxFiles int) *CallJournal {
+	if maxFileSize <= 0 {
+		maxFileSize = DefaultCallJournalMaxFileSize
+	}
+
+	if maxFiles < 1 {
+		maxFiles = 1
+	}
+
+	return &CallJournal{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}
+}
+
+// GetCallJournalDir returns journal directory in node home
+func GetCallJournalDir(homeDir string) string {
+	return filepath.Join(homeDir, "data", "call-journal")
+}
+
+// Begin opens side-tx scope, calls recorded until returned func is called
+// are attributed to tx. Scopes don't overlap, concurrent validations wait for each other.
+func (j *CallJournal) Begin(height int64, txHash string) func() {
+	if j == nil {
+		return func() {}
+	}
+
+	j.scope.Lock()
+	j.setScope(height, txHash, true)
+
+	return func() {
+		j.setScope(0, "", false)
+		j.scope.Unlock()
+	}
+}
+
+func (j *CallJournal) setScope(height int64, txHash string, active bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.height, j.txHash, j.active = height, txHash, active
+}
+
+// Record writes call to journal if side-tx scope is open. Response is hashed
+// (keccak256 of its json encoding) to keep journal small.
+func (j *CallJournal) Record(method string, endpoint string, args []interface{}, resp interface{}, callErr error, start time.Time) {
+	if j == nil {
+		return
+	}
+
+	entry := CallJournalEntry{
+		Method:    method,
+		Endpoint:  endpoint,
+		LatencyMs: int64(time.Since(start) / time.Millisecond),
+		Time:      start.UTC(),
+	}
+
+	for _, arg := range args {
+		entry.Args = append(entry.Args, fmt.Sprintf("%v", arg))
+	}
+
+	if callErr != nil {
+		entry.Error = callErr.Error()
+	} else if data, err := json.Marshal(resp); err == nil {
+		entry.ResponseHash = fmt.Sprintf("0x%x", crypto.Keccak256(data))
+	}
+
+	if err := j.write(entry); err != nil {
+		Logger.Error("Unable to write call journal", "error", err)
+	}
+}
+
+func (j *CallJournal) write(entry CallJournalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.active {
+		return nil
+	}
+	entry.Height, entry.TxHash = j.height, j.txHash
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if j.file == nil {
+		if err := j.open(); err != nil {
+			return err
+		}
+	}
+
+	if j.size+int64(len(data)) > j.maxFileSize && j.size > 0 {
+		if err := j.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := j.file.Write(data)
+	j.size += int64(n)
+	return err
+}
+
+func (j *CallJournal) open() error {
+	if err := os.MkdirAll(j.dir, 0755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filepath.Join(j.dir, CallJournalFileName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	j.file, j.size = file, info.Size()
+	return nil
+}
+
+// rotate shifts rotated files by one (oldest is dropped) and starts new active file
+func (j *CallJournal) rotate() error {
+	if err := j.file.Close(); err != nil {
+		return err
+	}
+	j.file = nil
+
+	active := filepath.Join(j.dir, CallJournalFileName)
+	os.Remove(rotatedJournalFile(active, j.maxFiles-1))
+	for i := j.maxFiles - 2; i >= 1; i-- {
+		os.Rename(rotatedJournalFile(active, i), rotatedJournalFile(active, i+1))
+	}
+
+	if j.maxFiles > 1 {
+		if err := os.Rename(active, rotatedJournalFile(active, 1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(active); err != nil {
+		return err
+	}
+
+	return j.open()
+}
+
+// Close closes active journal file
+func (j *CallJournal) Close() error {
+	if j == nil {
+		return nil
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.file == nil {
+		return nil
+	}
+
+	err := j.file.Close()
+	j.file = nil
+	return err
+}
+
+// journalEndpoint returns configured rpc endpoint of root chain
+func journalEndpoint(rootChain string) string {
+	switch rootChain {
+	case hmTypes.RootChainTypeEth:
+		return GetConfig().EthRPCUrl
+	case hmTypes.RootChainTypeBsc:
+		return GetConfig().BscRPCUrl
+	case hmTypes.RootChainTypeTron:
+		return GetConfig().TronRPCUrl
+	}
+
+	return ""
+}
+
+func rotatedJournalFile(active string, index int) string {
+	return fmt.Sprintf("%s.%d", active, index)
+}
+
+// CallJournalFilter filters journal entries, zero values match everything
+type CallJournalFilter struct {
+	FromHeight int64
+	ToHeight   int64
+	TxHash     string
+	Method     string
+	ErrorsOnly bool
+}
+
+// Match returns true if entry matches filter
+func (f CallJournalFilter) Match(entry CallJournalEntry) bool {
+	if f.FromHeight > 0 && entry.Height < f.FromHeight {
+		return false
+	}
+
+	if f.ToHeight > 0 && entry.Height > f.ToHeight {
+		return false
+	}
+
+	if f.TxHash != "" && entry.TxHash != f.TxHash {
+		return false
+	}
+
+	if f.Method != "" && entry.Method != f.Method {
+		return false
+	}
+
+	return !f.ErrorsOnly || entry.Error != ""
+}
+
+// ReadCallJournal returns entries of journal in dir (rotated files included) matching filter, oldest first.
+// Journal can be read while node is writing to it.
+func ReadCallJournal(dir string, filter CallJournalFilter) ([]CallJournalEntry, error) {
+	active := filepath.Join(dir, CallJournalFileName)
+	rotated, err := filepath.Glob(active + ".*")
+	if err != nil {
+		return nil, err
+	}
+
+	// highest index is oldest
+	sort.Slice(rotated, func(i, k int) bool {
+		var a, b int
+		fmt.Sscanf(rotated[i][len(active)+1:], "%d", &a)
+		fmt.Sscanf(rotated[k][len(active)+1:], "%d", &b)
+		return a > b
+	})
+
+	var entries []CallJournalEntry
+	for _, path := range append(rotated, active) {
+		file, err := os.Open(path)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 64*1024), 1<<20)
+		for scanner.Scan() {
+			var entry CallJournalEntry
+			// last line may be partially written
+			if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+				continue
+			}
+
+			if filter.Match(entry) {
+				entries = append(entries, entry)
+			}
+		}
+
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
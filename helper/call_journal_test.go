@@ -0,0 +1,85 @@
+package helper
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallJournal(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "call-journal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	journal := NewCallJournal(dir, 1<<20, 2)
+	defer journal.Close()
+
+	// calls outside of side-tx scope are not recorded
+	journal.Record("headerBlocks", "http://localhost:9545", []interface{}{1}, "root", nil, time.Now())
+
+	end := journal.Begin(10, "0x01")
+	journal.Record("headerBlocks", "http://localhost:9545", []interface{}{1, 10000}, "root", nil, time.Now())
+	journal.Record("validatorNonce", "", []interface{}{2}, nil, errors.New("timeout"), time.Now())
+	end()
+
+	end = journal.Begin(11, "0x02")
+	journal.Record("getCurrentSyncedCheckpoint", "http://localhost:50051", nil, 5, nil, time.Now())
+	end()
+
+	entries, err := ReadCallJournal(dir, CallJournalFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, int64(10), entries[0].Height)
+	require.Equal(t, "0x01", entries[0].TxHash)
+	require.Equal(t, []string{"1", "10000"}, entries[0].Args)
+	require.NotEmpty(t, entries[0].ResponseHash)
+	require.Equal(t, "timeout", entries[1].Error)
+	require.Empty(t, entries[1].ResponseHash)
+
+	entries, err = ReadCallJournal(dir, CallJournalFilter{TxHash: "0x01", ErrorsOnly: true})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "validatorNonce", entries[0].Method)
+
+	entries, err = ReadCallJournal(dir, CallJournalFilter{FromHeight: 11})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "0x02", entries[0].TxHash)
+}
+
+func TestCallJournalRotation(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "call-journal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// every entry exceeds max file size, so each write rotates
+	journal := NewCallJournal(dir, 1, 3)
+	defer journal.Close()
+
+	for height := int64(1); height <= 5; height++ {
+		end := journal.Begin(height, "0x01")
+		journal.Record("headerBlocks", "", nil, height, nil, time.Now())
+		end()
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, CallJournalFileName+"*"))
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	// oldest entries are dropped, rest are read in order
+	entries, err := ReadCallJournal(dir, CallJournalFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for i, entry := range entries {
+		require.Equal(t, int64(i+3), entry.Height)
+	}
+}
@@ -0,0 +1,232 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ErrCircuitOpen is returned for calls to root chain endpoint while its circuit breaker is open
+type ErrCircuitOpen struct {
+	Endpoint string
+	RetryAt  time.Time
+}
+
+func (e ErrCircuitOpen) Error() string {
+	return fmt.Sprintf("%v endpoint unavailable, circuit breaker open until %v", e.Endpoint, e.RetryAt.UTC().Format(time.RFC3339))
+}
+
+// ExternalChainUnavailable marks error as infra failure of external chain, see common.IsExternalChainUnavailable
+func (e ErrCircuitOpen) ExternalChainUnavailable() bool {
+	return true
+}
+
+// CircuitBreakerState snapshot of circuit breaker
+type CircuitBreakerState struct {
+	Endpoint  string    `json:"endpoint"`
+	Open      bool      `json:"open"`
+	Failures  int       `json:"failures"`
+	Trips     uint64    `json:"trips"`
+	OpenUntil time.Time `json:"open_until,omitempty"`
+}
+
+// CircuitBreaker stops calls to endpoint for cool-down window after threshold consecutive failures.
+// Once cool-down passes a single trial call is let through, success closes breaker and failure reopens it.
+type CircuitBreaker struct {
+	mu sync.Mutex
+
+	endpoint  string
+	threshold int
+	cooldown  time.Duration
+
+	failures  int
+	trips     uint64
+	openUntil time.Time
+	now       func() time.Time
+}
+
+// NewCircuitBreaker creates circuit breaker of endpoint, threshold 0 disables breaker
+func NewCircuitBreaker(endpoint string, threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		endpoint:  endpoint,
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// Allow returns ErrCircuitOpen if calls to endpoint are not allowed
+func (cb *CircuitBreaker) Allow() error {
+	if cb == nil || cb.threshold <= 0 {
+		return nil
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.failures < cb.threshold {
+		return nil
+	}
+
+	now := cb.now()
+	if now.Before(cb.openUntil) {
+		return ErrCircuitOpen{Endpoint: cb.endpoint, RetryAt: cb.openUntil}
+	}
+
+	// half-open, let trial call through and hold others back until it's recorded
+	cb.openUntil = now.Add(cb.cooldown)
+	return nil
+}
+
+// Record records result of call to endpoint
+func (cb *CircuitBreaker) Record(err error) {
+	if cb == nil || cb.threshold <= 0 {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if err == nil {
+		if cb.failures >= cb.threshold {
+			Logger.Info("Circuit breaker closed, endpoint is available again", "endpoint", cb.endpoint)
+		}
+		cb.failures = 0
+		cb.openUntil = time.Time{}
+		return
+	}
+
+	cb.failures++
+	if cb.failures < cb.threshold {
+		return
+	}
+
+	cb.openUntil = cb.now().Add(cb.cooldown)
+	if cb.failures == cb.threshold {
+		cb.trips++
+		Logger.Error("Circuit breaker opened, endpoint is unavailable",
+			"endpoint", cb.endpoint,
+			"failures", cb.failures,
+			"cooldown", cb.cooldown,
+			"trips", cb.trips,
+			"error", err,
+		)
+	} else {
+		Logger.Debug("Circuit breaker trial call failed", "endpoint", cb.endpoint, "error", err)
+	}
+}
+
+// State returns snapshot of circuit breaker
+func (cb *CircuitBreaker) State() CircuitBreakerState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	state := CircuitBreakerState{
+		Endpoint: cb.endpoint,
+		Failures: cb.failures,
+		Trips:    cb.trips,
+	}
+
+	if cb.threshold > 0 && cb.failures >= cb.threshold {
+		state.Open = cb.now().Before(cb.openUntil)
+		state.OpenUntil = cb.openUntil
+	}
+
+	return state
+}
+
+//
+// Root chain circuit breakers
+//
+
+var (
+	circuitBreakersMu sync.Mutex
+	circuitBreakers   = make(map[string]*CircuitBreaker)
+)
+
+// GetCircuitBreaker returns circuit breaker of root chain endpoint
+func GetCircuitBreaker(rootChain string) *CircuitBreaker {
+	circuitBreakersMu.Lock()
+	defer circuitBreakersMu.Unlock()
+
+	cb, ok := circuitBreakers[rootChain]
+	if !ok {
+		cb = NewCircuitBreaker(rootChain, conf.CircuitBreakerThreshold, conf.CircuitBreakerCooldown)
+		circuitBreakers[rootChain] = cb
+	}
+
+	return cb
+}
+
+// GetCircuitBreakerStates returns snapshot of circuit breakers of root chain endpoints
+func GetCircuitBreakerStates() []CircuitBreakerState {
+	states := make([]CircuitBreakerState, 0, 3)
+	for _, rootChain := range []string{hmTypes.RootChainTypeTron, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc} {
+		states = append(states, GetCircuitBreaker(rootChain).State())
+	}
+	return states
+}
+
+// circuitBreakerTransport http transport which records transport errors and 5xx responses in circuit breaker
+type circuitBreakerTransport struct {
+	breaker *CircuitBreaker
+	base    http.RoundTripper
+}
+
+func (t *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.breaker.Allow(); err != nil {
+		return nil, err
+	}
+
+	res, err := t.base.RoundTrip(req)
+	switch {
+	case err != nil:
+		t.breaker.Record(err)
+	case res.StatusCode >= http.StatusInternalServerError:
+		t.breaker.Record(fmt.Errorf("status %v", res.Status))
+	default:
+		t.breaker.Record(nil)
+	}
+
+	return res, err
+}
+
+// dialRPC dials root chain rpc endpoint, http endpoints are guarded by circuit breaker
+func dialRPC(url string, breaker *CircuitBreaker) (*rpc.Client, error) {
+	if strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://") {
+		return rpc.DialHTTPWithClient(url, &http.Client{
+			Transport: &circuitBreakerTransport{breaker: breaker, base: http.DefaultTransport},
+		})
+	}
+
+	return rpc.Dial(url)
+}
+
+// circuitBreakerInterceptor grpc interceptor which records unavailable endpoint errors in circuit breaker
+func circuitBreakerInterceptor(breaker *CircuitBreaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := breaker.Allow(); err != nil {
+			return err
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		switch status.Code(err) {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			breaker.Record(err)
+		default:
+			breaker.Record(nil)
+		}
+
+		return err
+	}
+}
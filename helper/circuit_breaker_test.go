@@ -0,0 +1,77 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/common"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	cb := NewCircuitBreaker("eth", 3, time.Minute)
+
+	now := time.Now()
+	cb.now = func() time.Time { return now }
+
+	failure := errors.New("connection refused")
+
+	// failures below threshold keep breaker closed
+	cb.Record(failure)
+	cb.Record(failure)
+	require.NoError(t, cb.Allow())
+
+	// success resets failures
+	cb.Record(nil)
+	cb.Record(failure)
+	cb.Record(failure)
+	require.NoError(t, cb.Allow())
+
+	// threshold opens breaker
+	cb.Record(failure)
+	err := cb.Allow()
+	require.Error(t, err)
+	require.True(t, cb.State().Open)
+	require.Equal(t, uint64(1), cb.State().Trips)
+
+	// error is reported as unavailable external chain, also when wrapped by http client
+	require.True(t, common.IsExternalChainUnavailable(err))
+	require.True(t, common.IsExternalChainUnavailable(&url.Error{Op: "Post", URL: "http://localhost", Err: err}))
+	require.False(t, common.IsExternalChainUnavailable(failure))
+
+	// rpc timeouts are transient too
+	require.True(t, common.IsExternalChainUnavailable(&url.Error{Op: "Post", URL: "http://localhost", Err: context.DeadlineExceeded}))
+
+	// single trial call after cool-down
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, cb.Allow())
+	require.Error(t, cb.Allow())
+
+	// failed trial reopens breaker
+	cb.Record(failure)
+	require.Error(t, cb.Allow())
+	require.Equal(t, uint64(1), cb.State().Trips)
+
+	// successful trial closes breaker
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, cb.Allow())
+	cb.Record(nil)
+	require.NoError(t, cb.Allow())
+	require.False(t, cb.State().Open)
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	t.Parallel()
+
+	cb := NewCircuitBreaker("bsc", 0, time.Minute)
+	for i := 0; i < 10; i++ {
+		cb.Record(errors.New("connection refused"))
+	}
+	require.NoError(t, cb.Allow())
+}
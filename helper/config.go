@@ -2,6 +2,7 @@ package helper
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 	"log"
 	"math/big"
 	"os"
@@ -16,11 +17,13 @@ import (
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/bor/rpc"
 	"github.com/maticnetwork/heimdall/file"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/spf13/viper"
 	"github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	logger "github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/privval"
+	"google.golang.org/grpc"
 
 	tmTypes "github.com/tendermint/tendermint/types"
 )
@@ -49,6 +52,13 @@ const (
 	DefaultBttcRPCUrl = "http://localhost:8545"
 	DefaultBscRPCUrl  = "http://localhost:7545"
 
+	// root chain block tags of post-merge ethereum
+	FinalityTagSafe      = "safe"
+	FinalityTagFinalized = "finalized"
+
+	// DefaultBscParliaQuorum percent of parlia validators which have to seal blocks after bsc block
+	DefaultBscParliaQuorum = 66
+
 	// tron
 	DefaultTronRPCUrl  = "http://localhost:50051"
 	DefaultTronGridUrl = "http://localhost:30080" // get log host
@@ -86,6 +96,41 @@ const (
 
 	DefaultBttcChainID string = "15001"
 
+	DefaultBridgeDutyLockHeartbeat = 5 * time.Second
+
+	DefaultCheckpointMonitorInterval = 1 * time.Minute
+
+	DefaultConfigHashInterval = 1 * time.Hour
+
+	DefaultSchedulerMaxJitter = 5 * time.Second
+
+	DefaultTxManagerCheckInterval   = 30 * time.Second
+	DefaultTxManagerResubmitTimeout = 5 * time.Minute
+	DefaultTxManagerGasBumpPercent  = 15
+
+	DefaultBorFinalityNotifyRetries = 3
+	DefaultBorFinalityNotifyTimeout = 5 * time.Second
+
+	DefaultAutoNoAckPollInterval = 30 * time.Second
+	DefaultAutoNoAckMaxDelay     = 2 * time.Minute
+
+	DefaultRestTxRateLimit    = 1.0
+	DefaultRestTxRateBurst    = 5
+	DefaultRestTxMaxBodyBytes = 1 << 20 // 1 MB
+	DefaultRestTxMaxInFlight  = 100
+	DefaultRestTxRouteWorkers = 10
+
+	DefaultSideTxValidationWorkers = 4
+
+	DefaultBorHeaderBatchSize = 100
+
+	DefaultCircuitBreakerThreshold = 5
+	DefaultCircuitBreakerCooldown  = 30 * time.Second
+
+	DefaultInvCheckPeriod = 1000
+
+	DefaultCallJournalMaxFileSizeMB = DefaultCallJournalMaxFileSize >> 20
+
 	secretFilePerm = 0600
 )
 
@@ -111,6 +156,18 @@ type Configuration struct {
 	BttcRPCUrl       string `mapstructure:"bttc_rpc_url"`       // RPC endpoint for bttc chain
 	TendermintRPCUrl string `mapstructure:"tendermint_rpc_url"` // tendemint node url
 
+	EthArchiveRPCUrl string `mapstructure:"eth_archive_rpc_url"` // archive node RPC endpoint for main chain, used for historical calls
+	BscArchiveRPCUrl string `mapstructure:"bsc_archive_rpc_url"` // archive node RPC endpoint for bsc chain, used for historical calls
+
+	EthWSUrl string `mapstructure:"eth_ws_url"` // websocket endpoint for main chain, new heads are subscribed instead of polled if set
+	BscWSUrl string `mapstructure:"bsc_ws_url"` // websocket endpoint for bsc chain, new heads are subscribed instead of polled if set
+
+	EthFinalityTag string `mapstructure:"eth_finality_tag"` // block tag ("safe" or "finalized") checkpoint acks on main chain have to reach, tx confirmations are used if empty
+	BscFinalityTag string `mapstructure:"bsc_finality_tag"` // block tag ("safe" or "finalized") checkpoint acks on bsc chain have to reach, tx confirmations are used if empty
+
+	BscParliaFinality bool   `mapstructure:"bsc_parlia_finality"` // checkpoint acks on bsc chain have to be sealed past by quorum of parlia validators
+	BscParliaQuorum   uint64 `mapstructure:"bsc_parlia_quorum"`   // percent of parlia validators which have to seal blocks after block of ack
+
 	TronGridURL       string `mapstructure:"tron_grid_url"`        // tron grid url
 	AmqpURL           string `mapstructure:"amqp_url"`             // amqp url
 	DeliveryServerURL string `mapstructure:"delivery_rest_server"` // delivery server url
@@ -145,6 +202,101 @@ type Configuration struct {
 	EthMaxQueryBlocks  int64 `mapstructure:"eth_max_query_blocks"`  // eth max number of blocks in one query logs
 	BscMaxQueryBlocks  int64 `mapstructure:"bsc_max_query_blocks"`  // bsc max number of blocks in one query logs
 	TronMaxQueryBlocks int64 `mapstructure:"tron_max_query_blocks"` // tron max number of blocks in one query logs
+
+	// double-submission guard for redundant bridge instances
+	BridgeDutyLockURL       string        `mapstructure:"bridge_duty_lock_url"`       // amqp url of broker shared by redundant bridge instances, empty disables guard
+	BridgeDutyLockHeartbeat time.Duration `mapstructure:"bridge_duty_lock_heartbeat"` // heartbeat of lock connection, lock is released after holder misses heartbeats
+
+	// checkpoint divergence monitor of bridge
+	CheckpointMonitorInterval     time.Duration `mapstructure:"checkpoint_monitor_interval"`      // interval between checks, 0 disables monitor
+	CheckpointMonitorListenAddr   string        `mapstructure:"checkpoint_monitor_listen_addr"`   // address of /health/checkpoint endpoint, empty disables endpoint
+	CheckpointMonitorSlackWebhook string        `mapstructure:"checkpoint_monitor_slack_webhook"` // slack incoming webhook url for divergence alerts
+	CheckpointMonitorPagerDutyKey string        `mapstructure:"checkpoint_monitor_pagerduty_key"` // pagerduty events v2 routing key for divergence alerts
+
+	ConfigHashInterval time.Duration `mapstructure:"config_hash_interval"` // interval between config hash publications of validator, 0 disables publishing
+
+	CheckpointAttestationEnabled bool `mapstructure:"checkpoint_attestation_enabled"` // proposer attests to hashes of bor blocks covered by its checkpoints
+
+	BlsKeyFile string `mapstructure:"bls_key_file"` // file of BLS key signing checkpoint votes, relative paths are under config dir
+
+	AttestationKeyFile string `mapstructure:"attestation_key_file"` // file of hex key signing tx inclusion attestations, empty leaves them unsigned
+
+	// task scheduler of bridge
+	SchedulerMaxJitter    time.Duration `mapstructure:"scheduler_max_jitter"`     // max random start offset of polling tasks, spreads requests of tasks and instances
+	SchedulerMinInterval  time.Duration `mapstructure:"scheduler_min_interval"`   // lower bound of root chain polling interval shortened while checkpoints wait for ack, 0 disables adjustment
+	BridgeAdminListenAddr string        `mapstructure:"bridge_admin_listen_addr"` // address of bridge admin endpoint (tasks, processors, pipelines, job queue), empty disables endpoint
+	BridgeAdminAPIKey     string        `mapstructure:"bridge_admin_api_key"`     // key required by bridge admin endpoint (X-Admin-Key header), empty disables auth
+
+	// root chain submission tx manager of bridge
+	TxManagerEnabled         bool          `mapstructure:"tx_manager_enabled"`          // submit checkpoints to eth/bsc through tx manager which bumps gas of stuck txs and fills nonce gaps
+	TxManagerCheckInterval   time.Duration `mapstructure:"tx_manager_check_interval"`   // interval between checks of pending submissions
+	TxManagerResubmitTimeout time.Duration `mapstructure:"tx_manager_resubmit_timeout"` // time submission waits to be mined before it's replaced with higher gas price
+	TxManagerGasBumpPercent  uint64        `mapstructure:"tx_manager_gas_bump_percent"` // gas price increase of replacement tx, nodes accept replacements from 10 percent
+	TxManagerMaxGasPriceGwei uint64        `mapstructure:"tx_manager_max_gas_price"`    // max gas price of submissions in gwei, 0 disables limit
+	TxManagerSpendCapGwei    uint64        `mapstructure:"tx_manager_spend_cap"`        // max fees in gwei spent per root chain since bridge start, 0 disables limit
+
+	// push notifications of acked checkpoints to bor nodes
+	BorFinalityNotifyEndpoints []string      `mapstructure:"bor_finality_notify_endpoints"` // gRPC endpoints (host:port) of bor nodes notified of acked checkpoints, empty disables notifier
+	BorFinalityNotifyToken     string        `mapstructure:"bor_finality_notify_token"`     // token sent to bor nodes as bearer authorization
+	BorFinalityNotifyTLS       bool          `mapstructure:"bor_finality_notify_tls"`       // connect to bor nodes over TLS
+	BorFinalityNotifyRetries   int           `mapstructure:"bor_finality_notify_retries"`   // attempts per bor node and notification
+	BorFinalityNotifyTimeout   time.Duration `mapstructure:"bor_finality_notify_timeout"`   // timeout of single notification attempt
+
+	// automatic no-ack of expired buffered checkpoints
+	AutoNoAckEnabled      bool          `mapstructure:"auto_no_ack_enabled"`       // bridge sends no-ack once buffered checkpoint of any root chain expired
+	AutoNoAckPollInterval time.Duration `mapstructure:"auto_no_ack_poll_interval"` // interval of checking buffers for expiry
+	AutoNoAckMaxDelay     time.Duration `mapstructure:"auto_no_ack_max_delay"`     // max random delay before sending no-ack, so validators don't send it at once
+
+	// config related to rest server
+	RestAPIKeys     string  `mapstructure:"rest_api_keys"`      // comma separated api keys required by tx rest endpoints, empty disables auth
+	RestTxRateLimit float64 `mapstructure:"rest_tx_rate_limit"` // allowed tx rest requests per second per client ip, 0 disables rate limit
+	RestTxRateBurst int     `mapstructure:"rest_tx_rate_burst"` // allowed burst of tx rest requests per client ip
+
+	RestSignedRequests bool   `mapstructure:"rest_signed_requests"` // tx rest requests have to be signed by validator key or with one of signing keys
+	RestSigningKeys    string `mapstructure:"rest_signing_keys"`    // comma separated keys of hmac-sha256 request signatures, first one signs requests of this node's clients
+
+	RestTxMaxBodyBytes int64 `mapstructure:"rest_tx_max_body_bytes"` // max body size of tx rest requests, 0 disables limit
+	RestTxMaxInFlight  int   `mapstructure:"rest_tx_max_in_flight"`  // max concurrent tx rest requests, 0 disables limit
+	RestTxRouteWorkers int   `mapstructure:"rest_tx_route_workers"`  // max concurrent requests per tx rest route, 0 disables limit
+
+	SideTxValidationWorkers int `mapstructure:"side_tx_validation_workers"` // max concurrent external validations of side-txs, 1 validates sequentially
+
+	// bor headers of checkpoint validation
+	BorHeaderBatchSize   uint64 `mapstructure:"bor_header_batch_size"`   // bor headers fetched per batch rpc call
+	BorReceiptsRootCheck bool   `mapstructure:"bor_receipts_root_check"` // cross-check receipts root of bor headers against eth_getBlockReceipts
+
+	// journal of contract calls made during side-tx validation
+	CallJournalEnabled       bool  `mapstructure:"call_journal_enabled"`          // record contract calls of side-tx validation in local journal, side-txs are validated sequentially
+	CallJournalMaxFileSizeMB int64 `mapstructure:"call_journal_max_file_size_mb"` // size of journal file in MB before it's rotated
+	CallJournalMaxFiles      int   `mapstructure:"call_journal_max_files"`        // journal files kept, including active one
+
+	ClerkArchiveEnabled bool `mapstructure:"clerk_archive_enabled"` // export state records pruned from store to local archive, served by record queries
+
+	// circuit breaker of root chain endpoints
+	CircuitBreakerThreshold int           `mapstructure:"circuit_breaker_threshold"` // consecutive failures which open breaker of endpoint, 0 disables breaker
+	CircuitBreakerCooldown  time.Duration `mapstructure:"circuit_breaker_cooldown"`  // time calls to endpoint are stopped once breaker is open
+
+	InvCheckPeriod uint64 `mapstructure:"inv_check_period"` // blocks between invariant checks which halt chain if broken, 0 disables checks
+
+	DisabledModules []string `mapstructure:"disabled_modules"` // optional modules (bor, clerk, tron) left out of app, same on every validator of network
+
+	// compact events
+	CompactEvents        bool                `mapstructure:"compact_events"`         // emit only essential event attributes, full events are kept in local events db
+	IndexEventAttributes map[string][]string `mapstructure:"index_event_attributes"` // module -> attributes kept in compact events and written to indexer
+
+	// bech32 prefixes of addresses rendered by CLI, REST and events
+	Bech32AccountPrefix   string `mapstructure:"bech32_account_prefix"`   // prefix of account addresses, "pub" suffixed for public keys, sdk default if empty
+	Bech32ValidatorPrefix string `mapstructure:"bech32_validator_prefix"` // prefix of validator operator addresses, sdk default if empty
+	Bech32ConsensusPrefix string `mapstructure:"bech32_consensus_prefix"` // prefix of consensus node addresses, sdk default if empty
+}
+
+// AddressPrefixes returns bech32 prefixes of addresses, empty ones default to sdk prefixes
+func (c Configuration) AddressPrefixes() hmTypes.AddressPrefixes {
+	return hmTypes.AddressPrefixes{
+		Account:   c.Bech32AccountPrefix,
+		Validator: c.Bech32ValidatorPrefix,
+		Consensus: c.Bech32ConsensusPrefix,
+	}.WithDefaults()
 }
 
 var conf Configuration
@@ -157,6 +309,10 @@ var mainRPCClient *rpc.Client
 var bscChainClient *ethclient.Client
 var bscRPCClient *rpc.Client
 
+// archive node clients for historical root chain calls, nil if not configured
+var mainArchiveClient *ethclient.Client
+var bscArchiveClient *ethclient.Client
+
 var tronRPCClient *tron.Client
 
 // MaticClient stores eth/rpc client for Matic Network
@@ -170,6 +326,9 @@ var privObject secp256k1.PrivKeySecp256k1
 
 var pubObject secp256k1.PubKeySecp256k1
 
+// key signing tx inclusion attestations
+var attestationPrivKey *ecdsa.PrivateKey
+
 // Logger stores global logger object
 var Logger logger.Logger
 
@@ -223,7 +382,7 @@ func InitDeliveryConfigWith(homeDir string, deliveryConfigFilePath string) {
 		log.Fatalln("Unable to unmarshall config", "Error", err)
 	}
 
-	if mainRPCClient, err = rpc.Dial(conf.EthRPCUrl); err != nil {
+	if mainRPCClient, err = dialRPC(conf.EthRPCUrl, GetCircuitBreaker(hmTypes.RootChainTypeEth)); err != nil {
 		log.Fatalln("Unable to dial via ethClient", "URL=", conf.EthRPCUrl, "chain=eth", "Error", err)
 	}
 
@@ -232,12 +391,38 @@ func InitDeliveryConfigWith(homeDir string, deliveryConfigFilePath string) {
 		log.Fatal(err)
 	}
 
-	if bscRPCClient, err = rpc.Dial(conf.BscRPCUrl); err != nil {
+	if bscRPCClient, err = dialRPC(conf.BscRPCUrl, GetCircuitBreaker(hmTypes.RootChainTypeBsc)); err != nil {
 		log.Fatalln("Unable to dial via ethClient", "URL=", conf.BscRPCUrl, "chain=bsc", "Error", err)
 	}
 	bscChainClient = ethclient.NewClient(bscRPCClient)
 
-	tronRPCClient = tron.NewClient(conf.TronRPCUrl)
+	if conf.EthArchiveRPCUrl != "" {
+		if mainArchiveClient, err = ethclient.Dial(conf.EthArchiveRPCUrl); err != nil {
+			log.Fatalln("Unable to dial via ethClient", "URL=", conf.EthArchiveRPCUrl, "chain=eth", "Error", err)
+		}
+	}
+
+	if conf.BscArchiveRPCUrl != "" {
+		if bscArchiveClient, err = ethclient.Dial(conf.BscArchiveRPCUrl); err != nil {
+			log.Fatalln("Unable to dial via ethClient", "URL=", conf.BscArchiveRPCUrl, "chain=bsc", "Error", err)
+		}
+	}
+
+	for _, tag := range []string{conf.EthFinalityTag, conf.BscFinalityTag} {
+		if err = ValidateFinalityTag(tag); err != nil {
+			log.Fatalln("Invalid root chain finality tag", "Error", err)
+		}
+	}
+
+	if conf.BscParliaFinality && (conf.BscParliaQuorum == 0 || conf.BscParliaQuorum > 100) {
+		log.Fatalln("Invalid bsc parlia quorum", "Quorum", conf.BscParliaQuorum)
+	}
+
+	if err = hmTypes.SetAddressPrefixes(conf.AddressPrefixes()); err != nil {
+		log.Fatalln("Invalid bech32 address prefixes", "Error", err)
+	}
+
+	tronRPCClient = tron.NewClient(conf.TronRPCUrl, grpc.WithUnaryInterceptor(circuitBreakerInterceptor(GetCircuitBreaker(hmTypes.RootChainTypeTron))))
 
 	maticClient = ethclient.NewClient(maticRPCClient)
 	// Loading genesis doc
@@ -255,6 +440,30 @@ func InitDeliveryConfigWith(homeDir string, deliveryConfigFilePath string) {
 	privVal := privval.LoadFilePV(filepath.Join(configDir, "priv_validator_key.json"), filepath.Join(configDir, "priv_validator_key.json"))
 	cdc.MustUnmarshalBinaryBare(privVal.Key.PrivKey.Bytes(), &privObject)
 	cdc.MustUnmarshalBinaryBare(privObject.PubKey().Bytes(), &pubObject)
+
+	// BLS key is optional, validators which don't vote on aggregated checkpoints don't have one
+	blsKeyFilePath = conf.BlsKeyFile
+	if blsKeyFilePath == "" {
+		blsKeyFilePath = DefaultBlsKeyFile
+	}
+	if !filepath.IsAbs(blsKeyFilePath) {
+		blsKeyFilePath = filepath.Join(configDir, blsKeyFilePath)
+	}
+	if blsPrivObject, err = LoadBlsKeyFile(blsKeyFilePath); err != nil && !os.IsNotExist(err) {
+		log.Fatalln("Unable to load BLS key", "File", blsKeyFilePath, "Error", err)
+	}
+
+	// attestation key is optional and never the validator key, inclusion attestations are unsigned without it
+	attestationPrivKey = nil
+	if conf.AttestationKeyFile != "" {
+		attestationKeyFilePath := conf.AttestationKeyFile
+		if !filepath.IsAbs(attestationKeyFilePath) {
+			attestationKeyFilePath = filepath.Join(configDir, attestationKeyFilePath)
+		}
+		if attestationPrivKey, err = ethCrypto.LoadECDSA(attestationKeyFilePath); err != nil {
+			log.Fatalln("Unable to load attestation key", "File", attestationKeyFilePath, "Error", err)
+		}
+	}
 }
 
 // GetDefaultHeimdallConfig returns configration with default params
@@ -295,6 +504,52 @@ func GetDefaultHeimdallConfig() Configuration {
 		EthMaxQueryBlocks:  DefaultEthMaxQueryBlocks,
 		BscMaxQueryBlocks:  DefaultBscMaxQueryBlocks,
 		TronMaxQueryBlocks: DefaultTronMaxQueryBlocks,
+
+		BridgeDutyLockHeartbeat: DefaultBridgeDutyLockHeartbeat,
+
+		CheckpointMonitorInterval: DefaultCheckpointMonitorInterval,
+
+		ConfigHashInterval: DefaultConfigHashInterval,
+
+		SchedulerMaxJitter: DefaultSchedulerMaxJitter,
+
+		TxManagerCheckInterval:   DefaultTxManagerCheckInterval,
+		TxManagerResubmitTimeout: DefaultTxManagerResubmitTimeout,
+		TxManagerGasBumpPercent:  DefaultTxManagerGasBumpPercent,
+
+		BorFinalityNotifyRetries: DefaultBorFinalityNotifyRetries,
+		BorFinalityNotifyTimeout: DefaultBorFinalityNotifyTimeout,
+
+		AutoNoAckPollInterval: DefaultAutoNoAckPollInterval,
+		AutoNoAckMaxDelay:     DefaultAutoNoAckMaxDelay,
+
+		RestTxRateLimit: DefaultRestTxRateLimit,
+		RestTxRateBurst: DefaultRestTxRateBurst,
+
+		RestTxMaxBodyBytes: DefaultRestTxMaxBodyBytes,
+		RestTxMaxInFlight:  DefaultRestTxMaxInFlight,
+		RestTxRouteWorkers: DefaultRestTxRouteWorkers,
+
+		SideTxValidationWorkers: DefaultSideTxValidationWorkers,
+
+		BlsKeyFile: DefaultBlsKeyFile,
+
+		BorHeaderBatchSize: DefaultBorHeaderBatchSize,
+
+		BscParliaQuorum: DefaultBscParliaQuorum,
+
+		CallJournalMaxFileSizeMB: DefaultCallJournalMaxFileSizeMB,
+		CallJournalMaxFiles:      DefaultCallJournalMaxFiles,
+
+		CircuitBreakerThreshold: DefaultCircuitBreakerThreshold,
+		CircuitBreakerCooldown:  DefaultCircuitBreakerCooldown,
+
+		InvCheckPeriod: DefaultInvCheckPeriod,
+
+		IndexEventAttributes: map[string][]string{
+			// used to find checkpoint side-txs, see checkpoint bundle
+			"checkpoint": {"root-hash", "start-block"},
+		},
 	}
 }
 
@@ -337,6 +592,49 @@ func GetBscClient() *ethclient.Client {
 	return bscChainClient
 }
 
+// GetArchiveClient returns archive node client for root chain, nil if not configured
+func GetArchiveClient(rootChain string) *ethclient.Client {
+	switch rootChain {
+	case hmTypes.RootChainTypeEth:
+		return mainArchiveClient
+	case hmTypes.RootChainTypeBsc:
+		return bscArchiveClient
+	}
+	return nil
+}
+
+// ValidateFinalityTag returns error if tag isn't supported block tag, empty tag is valid
+func ValidateFinalityTag(tag string) error {
+	switch tag {
+	case "", FinalityTagSafe, FinalityTagFinalized:
+		return nil
+	default:
+		return fmt.Errorf("unsupported finality tag %v", tag)
+	}
+}
+
+// GetFinalityTag returns block tag checkpoint acks on root chain have to reach, empty if not configured
+func GetFinalityTag(rootChain string) string {
+	switch rootChain {
+	case hmTypes.RootChainTypeEth:
+		return conf.EthFinalityTag
+	case hmTypes.RootChainTypeBsc:
+		return conf.BscFinalityTag
+	}
+	return ""
+}
+
+// GetWSUrl returns websocket endpoint of root chain, empty if not configured
+func GetWSUrl(rootChain string) string {
+	switch rootChain {
+	case hmTypes.RootChainTypeEth:
+		return conf.EthWSUrl
+	case hmTypes.RootChainTypeBsc:
+		return conf.BscWSUrl
+	}
+	return ""
+}
+
 // GetTronChainRPCClient returns main chain RPC client
 func GetTronChainRPCClient() *tron.Client {
 	return tronRPCClient
@@ -372,6 +670,11 @@ func GetECDSAPrivKey() *ecdsa.PrivateKey {
 	return ecdsaPrivateKey
 }
 
+// GetAttestationPrivKey returns key signing tx inclusion attestations, nil if none is configured
+func GetAttestationPrivKey() *ecdsa.PrivateKey {
+	return attestationPrivKey
+}
+
 // GetPubKey returns pub key object
 func GetPubKey() secp256k1.PubKeySecp256k1 {
 	return pubObject
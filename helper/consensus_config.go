@@ -0,0 +1,47 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/ethclient"
+)
+
+// ConsensusConfig is subset of node configuration which affects side-tx votes.
+// Validators publish hash of it, nodes with hash different from supermajority
+// are likely misconfigured (wrong network rpc, different heimdall endpoint etc.)
+type ConsensusConfig struct {
+	ChainID    string                     `json:"chain_id"`
+	BorNetwork string                     `json:"bor_network"`
+	RootChains []RootChainConsensusConfig `json:"root_chains"`
+}
+
+// RootChainConsensusConfig root chain part of consensus config
+type RootChainConsensusConfig struct {
+	RootChain             string `json:"root_chain"`
+	Network               string `json:"network"` // network id reported by rpc endpoint, empty for tron
+	RootChainAddress      string `json:"root_chain_address"`
+	StakingManagerAddress string `json:"staking_manager_address"`
+	StakingInfoAddress    string `json:"staking_info_address"`
+	StateSenderAddress    string `json:"state_sender_address"`
+	TxConfirmations       uint64 `json:"tx_confirmations"`
+}
+
+// Hash returns keccak256 hash of canonical json of config
+func (c ConsensusConfig) Hash() ([]byte, error) {
+	bz, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(bz), nil
+}
+
+// GetNetworkID returns network id reported by rpc endpoint of client
+func GetNetworkID(ctx context.Context, client *ethclient.Client) (string, error) {
+	networkID, err := client.NetworkID(ctx)
+	if err != nil {
+		return "", err
+	}
+	return networkID.String(), nil
+}
@@ -5,6 +5,8 @@ package mocks
 import (
 	big "math/big"
 
+	bind "github.com/maticnetwork/bor/accounts/abi/bind"
+
 	common "github.com/maticnetwork/bor/common"
 	erc20 "github.com/maticnetwork/heimdall/contracts/erc20"
 
@@ -138,6 +140,29 @@ func (_m *IContractCaller) CurrentStateCounter(stateSenderInstance *statesender.
 	return r0
 }
 
+// DecodeERC20TransferEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeERC20TransferEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*erc20.Erc20Transfer, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *erc20.Erc20Transfer
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *erc20.Erc20Transfer); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*erc20.Erc20Transfer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeNewChainEvent provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IContractCaller) DecodeNewChainEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*rootchain.RootchainNewChain, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -368,6 +393,36 @@ func (_m *IContractCaller) DecodeValidatorTopupFeesEvent(_a0 common.Address, _a1
 	return r0, r1
 }
 
+// EstimateCheckpointGas provides a mock function with given fields: signedData, sigs, rootChainAddress, rootChain
+func (_m *IContractCaller) EstimateCheckpointGas(signedData []byte, sigs [][3]*big.Int, rootChainAddress common.Address, rootChain string) (uint64, *big.Int, error) {
+	ret := _m.Called(signedData, sigs, rootChainAddress, rootChain)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func([]byte, [][3]*big.Int, common.Address, string) uint64); ok {
+		r0 = rf(signedData, sigs, rootChainAddress, rootChain)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 *big.Int
+	if rf, ok := ret.Get(1).(func([]byte, [][3]*big.Int, common.Address, string) *big.Int); ok {
+		r1 = rf(signedData, sigs, rootChainAddress, rootChain)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*big.Int)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func([]byte, [][3]*big.Int, common.Address, string) error); ok {
+		r2 = rf(signedData, sigs, rootChainAddress, rootChain)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetBalance provides a mock function with given fields: address
 func (_m *IContractCaller) GetBalance(address common.Address) (*big.Int, error) {
 	ret := _m.Called(address)
@@ -414,6 +469,29 @@ func (_m *IContractCaller) GetBlockNumberFromTxHash(_a0 common.Hash) (*big.Int,
 	return r0, r1
 }
 
+// GetBorHeadersBatch provides a mock function with given fields: start, end
+func (_m *IContractCaller) GetBorHeadersBatch(start uint64, end uint64) ([]*types.Header, error) {
+	ret := _m.Called(start, end)
+
+	var r0 []*types.Header
+	if rf, ok := ret.Get(0).(func(uint64, uint64) []*types.Header); ok {
+		r0 = rf(start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCheckpointSign provides a mock function with given fields: txHash
 func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error) {
 	ret := _m.Called(txHash)
@@ -478,6 +556,50 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash, _a1 uint64, _a
 	return r0, r1
 }
 
+// GetFinalizedCallOpts provides a mock function with given fields: rootChain
+func (_m *IContractCaller) GetFinalizedCallOpts(rootChain string) (*bind.CallOpts, error) {
+	ret := _m.Called(rootChain)
+
+	var r0 *bind.CallOpts
+	if rf, ok := ret.Get(0).(func(string) *bind.CallOpts); ok {
+		r0 = rf(rootChain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bind.CallOpts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(rootChain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsParliaFinalized provides a mock function with given fields: blockNumber
+func (_m *IContractCaller) IsParliaFinalized(blockNumber uint64) (bool, error) {
+	ret := _m.Called(blockNumber)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uint64) bool); ok {
+		r0 = rf(blockNumber)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHeaderInfo provides a mock function with given fields: headerID, rootChainInstance, childBlockInterval
 func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
 	ret := _m.Called(headerID, rootChainInstance, childBlockInterval)
@@ -531,6 +653,59 @@ func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *roo
 	return r0, r1, r2, r3, r4, r5
 }
 
+// GetHeaderInfoAt provides a mock function with given fields: headerID, rootChainInstance, childBlockInterval, blockNumber
+func (_m *IContractCaller) GetHeaderInfoAt(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64, blockNumber uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
+	ret := _m.Called(headerID, rootChainInstance, childBlockInterval, blockNumber)
+
+	var r0 common.Hash
+	if rf, ok := ret.Get(0).(func(uint64, *rootchain.Rootchain, uint64, uint64) common.Hash); ok {
+		r0 = rf(headerID, rootChainInstance, childBlockInterval, blockNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Hash)
+		}
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func(uint64, *rootchain.Rootchain, uint64, uint64) uint64); ok {
+		r1 = rf(headerID, rootChainInstance, childBlockInterval, blockNumber)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	var r2 uint64
+	if rf, ok := ret.Get(2).(func(uint64, *rootchain.Rootchain, uint64, uint64) uint64); ok {
+		r2 = rf(headerID, rootChainInstance, childBlockInterval, blockNumber)
+	} else {
+		r2 = ret.Get(2).(uint64)
+	}
+
+	var r3 uint64
+	if rf, ok := ret.Get(3).(func(uint64, *rootchain.Rootchain, uint64, uint64) uint64); ok {
+		r3 = rf(headerID, rootChainInstance, childBlockInterval, blockNumber)
+	} else {
+		r3 = ret.Get(3).(uint64)
+	}
+
+	var r4 heimdalltypes.HeimdallAddress
+	if rf, ok := ret.Get(4).(func(uint64, *rootchain.Rootchain, uint64, uint64) heimdalltypes.HeimdallAddress); ok {
+		r4 = rf(headerID, rootChainInstance, childBlockInterval, blockNumber)
+	} else {
+		if ret.Get(4) != nil {
+			r4 = ret.Get(4).(heimdalltypes.HeimdallAddress)
+		}
+	}
+
+	var r5 error
+	if rf, ok := ret.Get(5).(func(uint64, *rootchain.Rootchain, uint64, uint64) error); ok {
+		r5 = rf(headerID, rootChainInstance, childBlockInterval, blockNumber)
+	} else {
+		r5 = ret.Error(5)
+	}
+
+	return r0, r1, r2, r3, r4, r5
+}
+
 // GetLastChildBlock provides a mock function with given fields: rootChainInstance
 func (_m *IContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error) {
 	ret := _m.Called(rootChainInstance)
@@ -941,6 +1116,27 @@ func (_m *IContractCaller) GetTronEventsByContractAddress(address []string, from
 	return r0, r1
 }
 
+// GetTronCurrentHeaderBlock provides a mock function with given fields: rootChainAddress, childBlockInterval
+func (_m *IContractCaller) GetTronCurrentHeaderBlock(rootChainAddress string, childBlockInterval uint64) (uint64, error) {
+	ret := _m.Called(rootChainAddress, childBlockInterval)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(string, uint64) uint64); ok {
+		r0 = rf(rootChainAddress, childBlockInterval)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint64) error); ok {
+		r1 = rf(rootChainAddress, childBlockInterval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTronHeaderInfo provides a mock function with given fields: headerID, rootChainAddress, childBlockInterval
 func (_m *IContractCaller) GetTronHeaderInfo(headerID uint64, rootChainAddress string, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
 	ret := _m.Called(headerID, rootChainAddress, childBlockInterval)
@@ -1096,6 +1292,41 @@ func (_m *IContractCaller) GetValidatorSetInstance(validatorSetAddress common.Ad
 	return r0, r1
 }
 
+// GetValidatorSetSyncNonce provides a mock function with given fields: stakingManagerInstance
+func (_m *IContractCaller) GetValidatorSetSyncNonce(stakingManagerInstance *stakemanager.Stakemanager) uint64 {
+	ret := _m.Called(stakingManagerInstance)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(*stakemanager.Stakemanager) uint64); ok {
+		r0 = rf(stakingManagerInstance)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// IsStateProcessed provides a mock function with given fields: stateID, stateReceiverAddress
+func (_m *IContractCaller) IsStateProcessed(stateID uint64, stateReceiverAddress common.Address) (bool, error) {
+	ret := _m.Called(stateID, stateReceiverAddress)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uint64, common.Address) bool); ok {
+		r0 = rf(stateID, stateReceiverAddress)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, common.Address) error); ok {
+		r1 = rf(stateID, stateReceiverAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendCheckpoint provides a mock function with given fields: sigedData, sigs, rootchainAddress, rootChainInstance, rootChain
 func (_m *IContractCaller) SendCheckpoint(sigedData []byte, sigs [][3]*big.Int, rootchainAddress common.Address, rootChainInstance *rootchain.Rootchain, rootChain string) error {
 	ret := _m.Called(sigedData, sigs, rootchainAddress, rootChainInstance, rootChain)
@@ -1166,6 +1397,41 @@ func (_m *IContractCaller) SendTronStakingSync(stakingType string, sigedData []b
 	return r0
 }
 
+// SendValidatorSetSync provides a mock function with given fields: sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain
+func (_m *IContractCaller) SendValidatorSetSync(sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) error {
+	ret := _m.Called(sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte, [][3]*big.Int, common.Address, *stakemanager.Stakemanager, string) error); ok {
+		r0 = rf(sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SimulateTronCheckpoint provides a mock function with given fields: signedData, sigs, rootChainAddress
+func (_m *IContractCaller) SimulateTronCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress string) (uint64, error) {
+	ret := _m.Called(signedData, sigs, rootChainAddress)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func([]byte, [][3]*big.Int, string) uint64); ok {
+		r0 = rf(signedData, sigs, rootChainAddress)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, [][3]*big.Int, string) error); ok {
+		r1 = rf(signedData, sigs, rootChainAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StakeFor provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
 func (_m *IContractCaller) StakeFor(_a0 common.Address, _a1 *big.Int, _a2 *big.Int, _a3 bool, _a4 common.Address, _a5 *stakemanager.Stakemanager) error {
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
@@ -0,0 +1,114 @@
+package mocks
+
+import (
+	big "math/big"
+
+	bind "github.com/maticnetwork/bor/accounts/abi/bind"
+	common "github.com/maticnetwork/bor/common"
+	types "github.com/maticnetwork/bor/core/types"
+	mock "github.com/stretchr/testify/mock"
+
+	rootchain "github.com/maticnetwork/heimdall/contracts/rootchain"
+	heimdalltypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Builder style expectation helpers for IContractCaller.
+// They are kept out of IContractCaller.go so mock can be regenerated with mockery.
+
+// HeaderInfo is checkpoint header as returned by header info calls
+type HeaderInfo struct {
+	Root      common.Hash
+	Start     uint64
+	End       uint64
+	CreatedAt uint64
+	Proposer  heimdalltypes.HeimdallAddress
+}
+
+// NewHeaderInfo creates header info of checkpoint
+func NewHeaderInfo(root heimdalltypes.HeimdallHash, start uint64, end uint64, createdAt uint64, proposer heimdalltypes.HeimdallAddress) HeaderInfo {
+	return HeaderInfo{
+		Root:      root.EthHash(),
+		Start:     start,
+		End:       end,
+		CreatedAt: createdAt,
+		Proposer:  proposer,
+	}
+}
+
+// ExpectHeaderInfo expects evm header info calls of checkpoint number (latest and at any root chain block)
+func (_m *IContractCaller) ExpectHeaderInfo(number uint64, info HeaderInfo) *IContractCaller {
+	_m.On("GetHeaderInfo", number, mock.Anything, mock.Anything).
+		Return(info.Root, info.Start, info.End, info.CreatedAt, info.Proposer, nil)
+	_m.On("GetHeaderInfoAt", number, mock.Anything, mock.Anything, mock.Anything).
+		Return(info.Root, info.Start, info.End, info.CreatedAt, info.Proposer, nil)
+	return _m
+}
+
+// ExpectHeaderInfoError expects evm header info calls of checkpoint number to fail with err
+func (_m *IContractCaller) ExpectHeaderInfoError(number uint64, err error) *IContractCaller {
+	_m.On("GetHeaderInfo", number, mock.Anything, mock.Anything).
+		Return(common.Hash{}, uint64(0), uint64(0), uint64(0), heimdalltypes.HeimdallAddress{}, err)
+	_m.On("GetHeaderInfoAt", number, mock.Anything, mock.Anything, mock.Anything).
+		Return(common.Hash{}, uint64(0), uint64(0), uint64(0), heimdalltypes.HeimdallAddress{}, err)
+	return _m
+}
+
+// ExpectTronHeaderInfo expects tron header info call of checkpoint number
+func (_m *IContractCaller) ExpectTronHeaderInfo(number uint64, info HeaderInfo) *IContractCaller {
+	_m.On("GetTronHeaderInfo", number, mock.Anything, mock.Anything).
+		Return(info.Root, info.Start, info.End, info.CreatedAt, info.Proposer, nil)
+	return _m
+}
+
+// ExpectTronHeaderInfoError expects tron header info call of checkpoint number to fail with err
+func (_m *IContractCaller) ExpectTronHeaderInfoError(number uint64, err error) *IContractCaller {
+	_m.On("GetTronHeaderInfo", number, mock.Anything, mock.Anything).
+		Return(common.Hash{}, uint64(0), uint64(0), uint64(0), heimdalltypes.HeimdallAddress{}, err)
+	return _m
+}
+
+// ExpectRootChainInstance expects root chain instance of any address on root chain
+func (_m *IContractCaller) ExpectRootChainInstance(rootChain string) *IContractCaller {
+	_m.On("GetRootChainInstance", mock.Anything, rootChain).Return(&rootchain.Rootchain{}, nil)
+	return _m
+}
+
+// ExpectMainTxReceipt expects receipt of any tx on root chain to be included in block
+func (_m *IContractCaller) ExpectMainTxReceipt(rootChain string, blockNumber uint64) *IContractCaller {
+	receipt := &types.Receipt{BlockNumber: new(big.Int).SetUint64(blockNumber)}
+	_m.On("GetMainTxReceipt", mock.Anything, rootChain).Return(receipt, nil)
+	_m.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, rootChain).Return(receipt, nil)
+	return _m
+}
+
+// ExpectNoFinalityTag expects root chain to have no finality tag configured
+func (_m *IContractCaller) ExpectNoFinalityTag(rootChain string) *IContractCaller {
+	_m.On("GetFinalizedCallOpts", rootChain).Return(nil, nil)
+	return _m
+}
+
+// ExpectParliaFinalized expects every bsc block to be sealed past by quorum of parlia validators or not
+func (_m *IContractCaller) ExpectParliaFinalized(finalized bool) *IContractCaller {
+	_m.On("IsParliaFinalized", mock.Anything).Return(finalized, nil)
+	return _m
+}
+
+// ExpectFinalizedBlock expects block of root chain finality tag to be block number
+func (_m *IContractCaller) ExpectFinalizedBlock(rootChain string, blockNumber uint64) *IContractCaller {
+	_m.On("GetFinalizedCallOpts", rootChain).Return(&bind.CallOpts{BlockNumber: new(big.Int).SetUint64(blockNumber)}, nil)
+	return _m
+}
+
+// ExpectMainTxReceiptError expects receipt of any tx on root chain to fail with err
+func (_m *IContractCaller) ExpectMainTxReceiptError(rootChain string, err error) *IContractCaller {
+	_m.On("GetMainTxReceipt", mock.Anything, rootChain).Return(nil, err)
+	_m.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything, rootChain).Return(nil, err)
+	return _m
+}
+
+// ExpectRootHash expects root hash of bor blocks [start, end]
+func (_m *IContractCaller) ExpectRootHash(start uint64, end uint64, root heimdalltypes.HeimdallHash) *IContractCaller {
+	_m.On("CheckIfBlocksExist", end).Return(true)
+	_m.On("GetRootHash", start, end, mock.Anything).Return(root.Bytes(), nil)
+	return _m
+}
@@ -0,0 +1,241 @@
+package mocks
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	big "math/big"
+	"sync"
+
+	bind "github.com/maticnetwork/bor/accounts/abi/bind"
+	common "github.com/maticnetwork/bor/common"
+	types "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+
+	rootchain "github.com/maticnetwork/heimdall/contracts/rootchain"
+	heimdalltypes "github.com/maticnetwork/heimdall/types"
+)
+
+// FakeRootChain is deterministic in-memory root chain for integration tests of side handlers.
+// Checkpoints submitted to it are served by header info, receipt and sync calls of IContractCaller,
+// every submission is mined in its own root chain block. Other calls fall through to embedded
+// mock, so they can still be set up with On (or fail loudly if unexpected).
+type FakeRootChain struct {
+	IContractCaller
+
+	mu          sync.Mutex
+	blockNumber uint64
+	chains      map[string]*fakeChain
+	instances   map[*rootchain.Rootchain]string
+	receipts    map[common.Hash]fakeReceipt
+}
+
+type fakeChain struct {
+	instance  *rootchain.Rootchain
+	headers   map[uint64]fakeHeader
+	last      uint64
+	finalized *uint64 // nil while chain has no finality tag
+}
+
+type fakeHeader struct {
+	HeaderInfo
+	blockNumber uint64
+}
+
+type fakeReceipt struct {
+	rootChain string
+	receipt   *types.Receipt
+}
+
+// NewFakeRootChain creates empty fake root chain
+func NewFakeRootChain() *FakeRootChain {
+	return &FakeRootChain{
+		chains:    make(map[string]*fakeChain),
+		instances: make(map[*rootchain.Rootchain]string),
+		receipts:  make(map[common.Hash]fakeReceipt),
+	}
+}
+
+// SubmitCheckpoint mines checkpoint number on root chain and returns hash of submission tx
+func (f *FakeRootChain) SubmitCheckpoint(rootChain string, number uint64, info HeaderInfo) common.Hash {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.blockNumber++
+	chain := f.chain(rootChain)
+	chain.headers[number] = fakeHeader{HeaderInfo: info, blockNumber: f.blockNumber}
+	if number > chain.last {
+		chain.last = number
+	}
+
+	// tx hash only depends on submission, so it is stable across runs
+	seed := make([]byte, 16)
+	binary.BigEndian.PutUint64(seed[:8], number)
+	binary.BigEndian.PutUint64(seed[8:], f.blockNumber)
+	txHash := crypto.Keccak256Hash([]byte(rootChain), seed)
+
+	f.receipts[txHash] = fakeReceipt{
+		rootChain: rootChain,
+		receipt: &types.Receipt{
+			Status:      types.ReceiptStatusSuccessful,
+			TxHash:      txHash,
+			BlockNumber: new(big.Int).SetUint64(f.blockNumber),
+		},
+	}
+
+	return txHash
+}
+
+// Mine advances root chain by n empty blocks
+func (f *FakeRootChain) Mine(n uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.blockNumber += n
+}
+
+// Finalize marks latest block of root chain as finalized, chain is served with finality tag from then on
+func (f *FakeRootChain) Finalize(rootChain string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	finalized := f.blockNumber
+	f.chain(rootChain).finalized = &finalized
+}
+
+// BlockNumber returns latest root chain block
+func (f *FakeRootChain) BlockNumber() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.blockNumber
+}
+
+// GetRootChainInstance returns instance bound to root chain, address is ignored
+func (f *FakeRootChain) GetRootChainInstance(rootchainAddress common.Address, rootChain string) (*rootchain.Rootchain, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.chain(rootChain).instance, nil
+}
+
+// GetHeaderInfo returns latest header of checkpoint number
+func (f *FakeRootChain) GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
+	return f.GetHeaderInfoAt(headerID, rootChainInstance, childBlockInterval, 0)
+}
+
+// GetHeaderInfoAt returns header of checkpoint number as of root chain block, 0 means latest
+func (f *FakeRootChain) GetHeaderInfoAt(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64, blockNumber uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	rootChain, ok := f.instances[rootChainInstance]
+	if !ok {
+		return common.Hash{}, 0, 0, 0, heimdalltypes.HeimdallAddress{}, errors.New("unknown root chain instance")
+	}
+
+	return f.headerInfo(rootChain, headerID, blockNumber)
+}
+
+// GetTronHeaderInfo returns latest header of checkpoint number on tron
+func (f *FakeRootChain) GetTronHeaderInfo(headerID uint64, rootChainAddress string, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.headerInfo(heimdalltypes.RootChainTypeTron, headerID, 0)
+}
+
+// CurrentHeaderBlock returns last checkpoint number of root chain
+func (f *FakeRootChain) CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	rootChain, ok := f.instances[rootChainInstance]
+	if !ok {
+		return 0, errors.New("unknown root chain instance")
+	}
+
+	return f.chain(rootChain).last, nil
+}
+
+// GetTronCurrentHeaderBlock returns last checkpoint number of tron
+func (f *FakeRootChain) GetTronCurrentHeaderBlock(rootChainAddress string, childBlockInterval uint64) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.chain(heimdalltypes.RootChainTypeTron).last, nil
+}
+
+// GetSyncedCheckpointId returns last checkpoint number of root chain
+func (f *FakeRootChain) GetSyncedCheckpointId(contractAddress string, rootChain string) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.chain(rootChain).last, nil
+}
+
+// GetMainTxReceipt returns receipt of checkpoint submission on root chain
+func (f *FakeRootChain) GetMainTxReceipt(txHash common.Hash, rootChain string) (*types.Receipt, error) {
+	return f.GetConfirmedTxReceipt(txHash, 0, rootChain)
+}
+
+// GetConfirmedTxReceipt returns receipt of checkpoint submission once it has required confirmations
+func (f *FakeRootChain) GetConfirmedTxReceipt(txHash common.Hash, requiredConfirmations uint64, rootChain string) (*types.Receipt, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.receipts[txHash]
+	if !ok || r.rootChain != rootChain {
+		return nil, fmt.Errorf("tx %v not found on %v", txHash.Hex(), rootChain)
+	}
+
+	if r.receipt.BlockNumber.Uint64()+requiredConfirmations > f.blockNumber {
+		return nil, fmt.Errorf("tx %v is not confirmed yet", txHash.Hex())
+	}
+
+	return r.receipt, nil
+}
+
+// GetFinalizedCallOpts returns call opts at finalized block, nil if root chain was never finalized
+func (f *FakeRootChain) GetFinalizedCallOpts(rootChain string) (*bind.CallOpts, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	finalized := f.chain(rootChain).finalized
+	if finalized == nil {
+		return nil, nil
+	}
+
+	return &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(*finalized)}, nil
+}
+
+// GetTronTransactionReceipt returns receipt of checkpoint submission on tron
+func (f *FakeRootChain) GetTronTransactionReceipt(txID string) (*types.Receipt, error) {
+	return f.GetMainTxReceipt(common.HexToHash(txID), heimdalltypes.RootChainTypeTron)
+}
+
+// chain returns state of root chain, creating it on first use. Caller must hold lock.
+func (f *FakeRootChain) chain(rootChain string) *fakeChain {
+	chain, ok := f.chains[rootChain]
+	if !ok {
+		chain = &fakeChain{
+			instance: &rootchain.Rootchain{},
+			headers:  make(map[uint64]fakeHeader),
+		}
+		f.chains[rootChain] = chain
+		f.instances[chain.instance] = rootChain
+	}
+
+	return chain
+}
+
+// headerInfo returns header of checkpoint number as of block. Caller must hold lock.
+func (f *FakeRootChain) headerInfo(rootChain string, number uint64, blockNumber uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
+	header, ok := f.chain(rootChain).headers[number]
+	if !ok || (blockNumber > 0 && header.blockNumber > blockNumber) {
+		// contract returns empty header block for unknown number
+		return common.Hash{}, 0, 0, 0, heimdalltypes.HeimdallAddress{}, nil
+	}
+
+	return header.Root, header.Start, header.End, header.CreatedAt, header.Proposer, nil
+}
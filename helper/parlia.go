@@ -0,0 +1,99 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// parliaSnapshot validator set of parlia consensus returned by parlia_getSnapshot
+type parliaSnapshot struct {
+	Validators map[common.Address]json.RawMessage `json:"validators"`
+}
+
+// ParliaQuorum returns number of distinct validators which have to seal blocks after block
+// for it to be final, quorum percent of validators rounded up
+func ParliaQuorum(validators uint64, quorumPercent uint64) uint64 {
+	return (validators*quorumPercent + 99) / 100
+}
+
+// ParliaSealed returns true if headers are sealed by quorum of distinct validators.
+// Coinbase of parlia block is validator which sealed it.
+func ParliaSealed(validators map[common.Address]bool, headers []*ethTypes.Header, quorumPercent uint64) bool {
+	quorum := ParliaQuorum(uint64(len(validators)), quorumPercent)
+	if quorum == 0 {
+		return false
+	}
+
+	sealers := make(map[common.Address]bool)
+	for _, header := range headers {
+		if validators[header.Coinbase] {
+			sealers[header.Coinbase] = true
+		}
+		if uint64(len(sealers)) >= quorum {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsParliaFinalized returns true once quorum of current parlia validator set of bsc has sealed
+// blocks after block number, so block can't be reorged without them. Up to two rounds of
+// validators are checked after block, sealers of later blocks don't count.
+func (c *ContractCaller) IsParliaFinalized(blockNumber uint64) (finalized bool, err error) {
+	callStart := time.Now()
+	defer func() {
+		c.Journal.Record("parlia_getSnapshot", journalEndpoint(hmTypes.RootChainTypeBsc), []interface{}{blockNumber}, finalized, err, callStart)
+	}()
+
+	if c.BscChainRPC == nil {
+		return false, errors.New("bsc rpc client is not configured")
+	}
+
+	ctx := context.Background()
+
+	var latest *ethTypes.Header
+	if err := c.BscChainRPC.CallContext(ctx, &latest, "eth_getBlockByNumber", "latest", false); err != nil {
+		return false, err
+	}
+	if latest == nil || latest.Number == nil {
+		return false, errors.New("latest bsc block not found")
+	}
+
+	if latest.Number.Uint64() <= blockNumber {
+		return false, nil
+	}
+
+	var snapshot parliaSnapshot
+	if err := c.BscChainRPC.CallContext(ctx, &snapshot, "parlia_getSnapshot", hexutil.EncodeUint64(latest.Number.Uint64())); err != nil {
+		return false, err
+	}
+	if len(snapshot.Validators) == 0 {
+		return false, errors.New("empty parlia validator set")
+	}
+
+	validators := make(map[common.Address]bool, len(snapshot.Validators))
+	for validator := range snapshot.Validators {
+		validators[validator] = true
+	}
+
+	end := latest.Number.Uint64()
+	if limit := blockNumber + 2*uint64(len(validators)); end > limit {
+		end = limit
+	}
+
+	headers, err := getBorHeaders(ctx, c.BscChainRPC, blockNumber+1, end)
+	if err != nil {
+		return false, err
+	}
+
+	return ParliaSealed(validators, headers, GetConfig().BscParliaQuorum), nil
+}
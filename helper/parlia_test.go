@@ -0,0 +1,39 @@
+package helper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParliaSealed(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, uint64(14), ParliaQuorum(21, DefaultBscParliaQuorum))
+	require.Equal(t, uint64(2), ParliaQuorum(3, DefaultBscParliaQuorum))
+	require.Equal(t, uint64(21), ParliaQuorum(21, 100))
+
+	validators := make(map[common.Address]bool)
+	for i := 1; i <= 3; i++ {
+		validators[common.BytesToAddress([]byte{byte(i)})] = true
+	}
+
+	sealedBy := func(sealers ...byte) []*ethTypes.Header {
+		headers := make([]*ethTypes.Header, len(sealers))
+		for i, sealer := range sealers {
+			headers[i] = &ethTypes.Header{Number: big.NewInt(int64(i + 1)), Coinbase: common.BytesToAddress([]byte{sealer})}
+		}
+		return headers
+	}
+
+	// same validator sealing repeatedly, or non-validators, don't make block final
+	require.False(t, ParliaSealed(validators, sealedBy(1, 1, 1), DefaultBscParliaQuorum))
+	require.False(t, ParliaSealed(validators, sealedBy(1, 4, 5), DefaultBscParliaQuorum))
+	require.True(t, ParliaSealed(validators, sealedBy(1, 4, 2), DefaultBscParliaQuorum))
+	require.False(t, ParliaSealed(validators, sealedBy(1, 2), 100))
+	require.True(t, ParliaSealed(validators, sealedBy(1, 2, 3), 100))
+	require.False(t, ParliaSealed(validators, sealedBy(1, 2, 3), 0))
+}
@@ -3,6 +3,7 @@ package helper
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strings"
@@ -16,6 +17,8 @@ import (
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmTypes "github.com/tendermint/tendermint/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 const (
@@ -274,7 +277,7 @@ func GetBeginBlockEvents(client *httpClient.HTTP, height int64) ([]abci.Event, e
 	// get block using client
 	blockResults, err := client.BlockResults(&height)
 	if err == nil && blockResults != nil {
-		return blockResults.Results.BeginBlock.GetEvents(), nil
+		return ExpandCompactEvents(client, blockResults.Results.BeginBlock.GetEvents()), nil
 	}
 
 	// subscriber
@@ -299,7 +302,7 @@ func GetBeginBlockEvents(client *httpClient.HTTP, height int64) ([]abci.Event, e
 			switch t := eventData.(type) {
 			case tmTypes.EventDataNewBlock:
 				if t.Block.Height == height {
-					return t.ResultBeginBlock.GetEvents(), nil
+					return ExpandCompactEvents(client, t.ResultBeginBlock.GetEvents()), nil
 				}
 			default:
 				return nil, errors.New("timed out waiting for event")
@@ -310,6 +313,43 @@ func GetBeginBlockEvents(client *httpClient.HTTP, height int64) ([]abci.Event, e
 	}
 }
 
+// ExpandCompactEvents replaces compact events with their full event records stored by node,
+// events whose record can't be fetched are kept compact
+func ExpandCompactEvents(client *httpClient.HTTP, events []abci.Event) []abci.Event {
+	for i, event := range events {
+		var recordKey string
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == hmTypes.AttributeKeyEventRecord {
+				recordKey = string(attr.Value)
+			}
+		}
+
+		if recordKey == "" {
+			continue
+		}
+
+		res, err := client.ABCIQuery("custom/events/record", []byte(recordKey))
+		if err != nil || !res.Response.IsOK() {
+			Logger.Error("Error fetching event record of compact event", "record", recordKey, "error", err)
+			continue
+		}
+
+		var record sdk.StringEvent
+		if err := json.Unmarshal(res.Response.Value, &record); err != nil {
+			Logger.Error("Error unmarshalling event record", "record", recordKey, "error", err)
+			continue
+		}
+
+		full := abci.Event{Type: record.Type}
+		for _, attr := range record.Attributes {
+			full.Attributes = append(full.Attributes, sdk.NewAttribute(attr.Key, attr.Value).ToKVPair())
+		}
+		events[i] = full
+	}
+
+	return events
+}
+
 // FetchVotes fetches votes and extracts sigs from it
 func FetchVotes(
 	client *httpClient.HTTP,
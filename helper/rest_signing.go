@@ -0,0 +1,77 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	// RESTTimestampHeader header which carries unix time request was signed at
+	RESTTimestampHeader = "X-Request-Timestamp"
+
+	// RESTSignatureHeader header which carries hex encoded signature of request
+	RESTSignatureHeader = "X-Request-Signature"
+)
+
+// RESTSigner signs payload of REST request
+type RESTSigner func(payload []byte) ([]byte, error)
+
+// NewHMACRESTSigner signs requests with hmac-sha256 of dedicated api key shared with node
+func NewHMACRESTSigner(key string) RESTSigner {
+	return func(payload []byte) ([]byte, error) {
+		return RESTRequestHMAC(key, payload), nil
+	}
+}
+
+// NewKeyRESTSigner signs requests with private key, eg. validator key node accepts signatures of
+func NewKeyRESTSigner(privKey crypto.PrivKey) RESTSigner {
+	return privKey.Sign
+}
+
+// GetRESTSigner returns signer of first rest signing key of config, or of validator key if none is configured
+func GetRESTSigner() RESTSigner {
+	for _, key := range strings.Split(conf.RestSigningKeys, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			return NewHMACRESTSigner(key)
+		}
+	}
+
+	return NewKeyRESTSigner(GetPrivKey())
+}
+
+// RESTSigningPayload returns payload of REST request which is signed: method, request uri,
+// unix timestamp and sha256 of body, so none of them can be changed or replayed later
+func RESTSigningPayload(method string, requestURI string, timestamp int64, body []byte) []byte {
+	bodyHash := sha256.Sum256(body)
+	return []byte(fmt.Sprintf("%s\n%s\n%d\n%x", method, requestURI, timestamp, bodyHash))
+}
+
+// RESTRequestHMAC returns hmac-sha256 of payload with key
+func RESTRequestHMAC(key string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(key))
+	_, _ = mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// SignRESTRequest sets timestamp and signature headers of request, body has to be same as body of request
+func SignRESTRequest(req *http.Request, body []byte, signer RESTSigner, now time.Time) error {
+	timestamp := now.Unix()
+
+	signature, err := signer(RESTSigningPayload(req.Method, req.URL.RequestURI(), timestamp, body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set(RESTTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(RESTSignatureHeader, hex.EncodeToString(signature))
+
+	return nil
+}
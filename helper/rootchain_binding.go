@@ -0,0 +1,281 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/ethclient"
+
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// RootChainVersionLegacy version of RootChain contracts without VERSION() method
+	RootChainVersionLegacy uint64 = 1
+
+	// rootChainVersionTTL max age of detected contract version, proxies can be upgraded any time
+	rootChainVersionTTL = 10 * time.Minute
+)
+
+// RootChainBinding reads checkpoints of RootChain contract with ABI of one contract version
+type RootChainBinding interface {
+	Version() uint64
+	HeaderBlock(opts *bind.CallOpts, headerBlockID *big.Int) (headerInfo, error)
+	CurrentHeaderBlock(opts *bind.CallOpts) (*big.Int, error)
+	GetLastChildBlock(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// RootChainBindingConstructor binds RootChain contract at address with ABI of registered version
+type RootChainBindingConstructor func(address common.Address, backend bind.ContractCaller) (RootChainBinding, error)
+
+// rootChainBindingEntry registered binding of contract version
+type rootChainBindingEntry struct {
+	version     uint64
+	codeHashes  []common.Hash
+	constructor RootChainBindingConstructor
+}
+
+var (
+	rootChainBindingsMu sync.RWMutex
+	rootChainBindings   = map[uint64]rootChainBindingEntry{}
+)
+
+func init() {
+	RegisterRootChainBinding(RootChainVersionLegacy, nil, newRootChainBindingV1)
+}
+
+// RegisterRootChainBinding registers binding of RootChain contract version. Contracts are matched to
+// version by their VERSION() result, or by runtime code hash for deployments without VERSION().
+func RegisterRootChainBinding(version uint64, codeHashes []common.Hash, constructor RootChainBindingConstructor) {
+	rootChainBindingsMu.Lock()
+	defer rootChainBindingsMu.Unlock()
+
+	rootChainBindings[version] = rootChainBindingEntry{
+		version:     version,
+		codeHashes:  codeHashes,
+		constructor: constructor,
+	}
+}
+
+// getRootChainBindingEntry returns binding registered for version. Unknown versions use closest lower
+// registered version, so contract upgrades which keep checkpoint ABI need no heimdall release.
+func getRootChainBindingEntry(version uint64) rootChainBindingEntry {
+	rootChainBindingsMu.RLock()
+	defer rootChainBindingsMu.RUnlock()
+
+	if entry, ok := rootChainBindings[version]; ok {
+		return entry
+	}
+
+	versions := make([]uint64, 0, len(rootChainBindings))
+	for v := range rootChainBindings {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+	for _, v := range versions {
+		if v < version {
+			return rootChainBindings[v]
+		}
+	}
+
+	return rootChainBindings[RootChainVersionLegacy]
+}
+
+// getRootChainVersionByCodeHash returns version registered for runtime code hash
+func getRootChainVersionByCodeHash(codeHash common.Hash) (uint64, bool) {
+	rootChainBindingsMu.RLock()
+	defer rootChainBindingsMu.RUnlock()
+
+	for _, entry := range rootChainBindings {
+		for _, hash := range entry.codeHashes {
+			if hash == codeHash {
+				return entry.version, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// parseRootChainVersion decodes VERSION() result, either uint256 or semantic version string
+// like "2.1.0", of which major version is used
+func parseRootChainVersion(ret []byte) (uint64, error) {
+	if len(ret) == 32 {
+		version := new(big.Int).SetBytes(ret)
+		if !version.IsUint64() || version.Sign() == 0 {
+			return 0, errors.New("invalid contract version")
+		}
+		return version.Uint64(), nil
+	}
+
+	// abi encoded string: offset, length, data
+	if len(ret) < 64 {
+		return 0, errors.New("invalid contract version")
+	}
+
+	offset := new(big.Int).SetBytes(ret[:32])
+	if !offset.IsUint64() || offset.Uint64()+32 > uint64(len(ret)) {
+		return 0, errors.New("invalid contract version")
+	}
+
+	length := new(big.Int).SetBytes(ret[offset.Uint64() : offset.Uint64()+32])
+	start := offset.Uint64() + 32
+	if !length.IsUint64() || start+length.Uint64() > uint64(len(ret)) {
+		return 0, errors.New("invalid contract version")
+	}
+
+	version := strings.TrimPrefix(strings.TrimSpace(string(ret[start:start+length.Uint64()])), "v")
+	major, err := strconv.ParseUint(strings.SplitN(version, ".", 2)[0], 10, 64)
+	if err != nil || major == 0 {
+		return 0, errors.New("invalid contract version")
+	}
+	return major, nil
+}
+
+// DetectRootChainVersion detects version of RootChain contract by its VERSION() method, or by runtime
+// code hash for contracts without it. Contracts matching neither are legacy contracts.
+func DetectRootChainVersion(backend bind.ContractCaller, address common.Address) (uint64, error) {
+	ctx := context.Background()
+
+	ret, err := backend.CallContract(ctx, ethereum.CallMsg{To: &address, Data: crypto.Keccak256([]byte("VERSION()"))[:4]}, nil)
+	if err == nil && len(ret) > 0 {
+		if version, err := parseRootChainVersion(ret); err == nil {
+			return version, nil
+		}
+	}
+
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(code) == 0 {
+		return 0, bind.ErrNoCode
+	}
+
+	if version, ok := getRootChainVersionByCodeHash(crypto.Keccak256Hash(code)); ok {
+		return version, nil
+	}
+
+	return RootChainVersionLegacy, nil
+}
+
+// rootChainBindingCacheEntry binding of contract along with time its version was detected
+type rootChainBindingCacheEntry struct {
+	binding    RootChainBinding
+	detectedAt time.Time
+}
+
+// getRootChainBinding returns binding matching current version of contract behind root chain instance.
+// Version is detected again after TTL, detection failures keep previous binding.
+func (c *ContractCaller) getRootChainBinding(instanceInfo rootChainInstanceInfo, client *ethclient.Client, archive bool) (RootChainBinding, error) {
+	if client == nil {
+		return nil, errors.New("root chain client is not configured")
+	}
+
+	cacheKey := instanceInfo.address.String() + instanceInfo.rootChain + "binding"
+	if archive {
+		cacheKey += "archive"
+	}
+
+	cached, ok := c.getCachedInstance(cacheKey)
+	if ok && time.Since(cached.(rootChainBindingCacheEntry).detectedAt) < rootChainVersionTTL {
+		return cached.(rootChainBindingCacheEntry).binding, nil
+	}
+
+	version, err := DetectRootChainVersion(client, instanceInfo.address)
+	if err != nil {
+		if ok {
+			Logger.Debug("Unable to detect root chain contract version, keeping binding", "root", instanceInfo.rootChain, "error", err)
+			return cached.(rootChainBindingCacheEntry).binding, nil
+		}
+		return nil, err
+	}
+
+	entry := getRootChainBindingEntry(version)
+	if entry.version != version {
+		Logger.Info("No binding of root chain contract version, using closest older binding",
+			"root", instanceInfo.rootChain, "version", version, "binding", entry.version)
+	}
+
+	binding, err := entry.constructor(instanceInfo.address, client)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setCachedInstance(cacheKey, rootChainBindingCacheEntry{binding: binding, detectedAt: time.Now()})
+	return binding, nil
+}
+
+// getInstanceBinding returns binding of root chain instance. Instances not created by this caller
+// are called with ABI they were generated with.
+func (c *ContractCaller) getInstanceBinding(rootChainInstance *rootchain.Rootchain) (RootChainBinding, error) {
+	instanceInfo, ok := c.getRootChainInstanceInfo(rootChainInstance)
+	if !ok {
+		return &rootChainBindingV1{caller: &rootChainInstance.RootchainCaller}, nil
+	}
+	return c.getRootChainBinding(instanceInfo, getRootChainClient(instanceInfo.rootChain), false)
+}
+
+// GetRootChainVersion returns detected version of RootChain contract of root chain instance
+func (c *ContractCaller) GetRootChainVersion(rootChainInstance *rootchain.Rootchain) (uint64, error) {
+	binding, err := c.getInstanceBinding(rootChainInstance)
+	if err != nil {
+		return 0, err
+	}
+	return binding.Version(), nil
+}
+
+//
+// Version 1, RootChain contract ABI of contracts/rootchain
+//
+
+type rootChainBindingV1 struct {
+	caller *rootchain.RootchainCaller
+}
+
+func newRootChainBindingV1(address common.Address, backend bind.ContractCaller) (RootChainBinding, error) {
+	caller, err := rootchain.NewRootchainCaller(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &rootChainBindingV1{caller: caller}, nil
+}
+
+func (b *rootChainBindingV1) Version() uint64 {
+	return RootChainVersionLegacy
+}
+
+func (b *rootChainBindingV1) HeaderBlock(opts *bind.CallOpts, headerBlockID *big.Int) (headerInfo, error) {
+	headerBlock, err := b.caller.HeaderBlocks(opts, headerBlockID)
+	if err != nil {
+		return headerInfo{}, err
+	}
+
+	return headerInfo{
+		Root:      headerBlock.Root,
+		Start:     headerBlock.Start.Uint64(),
+		End:       headerBlock.End.Uint64(),
+		CreatedAt: headerBlock.CreatedAt.Uint64(),
+		Proposer:  types.BytesToHeimdallAddress(headerBlock.Proposer.Bytes()),
+	}, nil
+}
+
+func (b *rootChainBindingV1) CurrentHeaderBlock(opts *bind.CallOpts) (*big.Int, error) {
+	return b.caller.CurrentHeaderBlock(opts)
+}
+
+func (b *rootChainBindingV1) GetLastChildBlock(opts *bind.CallOpts) (*big.Int, error) {
+	return b.caller.GetLastChildBlock(opts)
+}
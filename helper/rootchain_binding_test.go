@@ -0,0 +1,67 @@
+package helper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRootChainVersion(t *testing.T) {
+	t.Parallel()
+
+	// uint256
+	version, err := parseRootChainVersion(common.LeftPadBytes(big.NewInt(3).Bytes(), 32))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), version)
+
+	// string
+	encodeString := func(s string) []byte {
+		ret := common.LeftPadBytes(big.NewInt(32).Bytes(), 32)
+		ret = append(ret, common.LeftPadBytes(big.NewInt(int64(len(s))).Bytes(), 32)...)
+		return append(ret, common.RightPadBytes([]byte(s), 32)...)
+	}
+
+	version, err = parseRootChainVersion(encodeString("2.1.0"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), version)
+
+	version, err = parseRootChainVersion(encodeString("v4"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), version)
+
+	_, err = parseRootChainVersion(encodeString("beta"))
+	require.Error(t, err)
+
+	_, err = parseRootChainVersion(make([]byte, 32))
+	require.Error(t, err)
+
+	_, err = parseRootChainVersion([]byte{1, 2})
+	require.Error(t, err)
+}
+
+func TestGetRootChainBindingEntry(t *testing.T) {
+	codeHash := common.HexToHash("0x01")
+	RegisterRootChainBinding(5, []common.Hash{codeHash}, func(address common.Address, backend bind.ContractCaller) (RootChainBinding, error) {
+		return newRootChainBindingV1(address, backend)
+	})
+	defer func() {
+		rootChainBindingsMu.Lock()
+		delete(rootChainBindings, 5)
+		rootChainBindingsMu.Unlock()
+	}()
+
+	require.Equal(t, uint64(5), getRootChainBindingEntry(5).version)
+	require.Equal(t, uint64(5), getRootChainBindingEntry(7).version, "newer version should use closest older binding")
+	require.Equal(t, RootChainVersionLegacy, getRootChainBindingEntry(3).version)
+	require.Equal(t, RootChainVersionLegacy, getRootChainBindingEntry(0).version)
+
+	version, ok := getRootChainVersionByCodeHash(codeHash)
+	require.True(t, ok)
+	require.Equal(t, uint64(5), version)
+
+	_, ok = getRootChainVersionByCodeHash(common.HexToHash("0x02"))
+	require.False(t, ok)
+}
@@ -0,0 +1,119 @@
+package storeproof
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// LastCheckpointProof last acked checkpoint of root chain, proven by ack count and checkpoint itself at same height
+type LastCheckpointProof struct {
+	RootChain  string     `json:"root_chain"`
+	AckCount   StoreProof `json:"ack_count"`
+	Checkpoint StoreProof `json:"checkpoint"`
+}
+
+// QueryLastCheckpoint queries last acked checkpoint of root chain with proofs, at latest height if height is 0
+func QueryLastCheckpoint(node rpcclient.Client, rootChain string, height int64) (result LastCheckpointProof, err error) {
+	rootID := hmTypes.GetRootChainID(rootChain)
+	if rootID == 0 {
+		return result, fmt.Errorf("'%s' is not a valid rootChain", rootChain)
+	}
+
+	result.RootChain = rootChain
+	result.AckCount, err = Query(node, checkpointTypes.StoreKey, checkpoint.GetAckCountKey(rootID), height)
+	if err != nil {
+		return result, err
+	}
+
+	number, err := parseAckCount(result.AckCount.Value)
+	if err != nil {
+		return result, err
+	}
+
+	// checkpoint is read at height of ack count, so both are proven against same app hash
+	result.Checkpoint, err = Query(node, checkpointTypes.StoreKey, checkpoint.GetCheckpointKey(number, rootChain), result.AckCount.Height)
+	return result, err
+}
+
+// Height returns height of state proofs are of
+func (p LastCheckpointProof) Height() int64 {
+	return p.AckCount.Height
+}
+
+// Verify verifies proofs against app hash and returns number and checkpoint they prove
+func (p LastCheckpointProof) Verify(cdc *codec.Codec, appHash []byte) (number uint64, result hmTypes.Checkpoint, err error) {
+	if p.AckCount.Height != p.Checkpoint.Height {
+		return 0, result, fmt.Errorf("ack count at height %v and checkpoint at height %v", p.AckCount.Height, p.Checkpoint.Height)
+	}
+
+	rootID := hmTypes.GetRootChainID(p.RootChain)
+	if rootID == 0 {
+		return 0, result, fmt.Errorf("'%s' is not a valid rootChain", p.RootChain)
+	}
+
+	if err := verifyKey(p.AckCount, checkpointTypes.StoreKey, checkpoint.GetAckCountKey(rootID), appHash); err != nil {
+		return 0, result, err
+	}
+
+	number, err = parseAckCount(p.AckCount.Value)
+	if err != nil {
+		return 0, result, err
+	}
+
+	if err := verifyKey(p.Checkpoint, checkpointTypes.StoreKey, checkpoint.GetCheckpointKey(number, p.RootChain), appHash); err != nil {
+		return 0, result, err
+	}
+
+	if p.Checkpoint.Value == nil {
+		return 0, result, fmt.Errorf("no checkpoint acked on root chain %v", p.RootChain)
+	}
+
+	if err := cdc.UnmarshalBinaryBare(p.Checkpoint.Value, &result); err != nil {
+		return 0, result, err
+	}
+	return number, result, nil
+}
+
+// QueryValidatorSet queries current validator set of staking store with proof, at latest height if height is 0
+func QueryValidatorSet(node rpcclient.Client, height int64) (StoreProof, error) {
+	return Query(node, stakingTypes.StoreKey, staking.CurrentValidatorSetKey, height)
+}
+
+// VerifyValidatorSet verifies proof of validator set against app hash and returns validator set it proves
+func VerifyValidatorSet(cdc *codec.Codec, p StoreProof, appHash []byte) (validatorSet hmTypes.ValidatorSet, err error) {
+	if err := verifyKey(p, stakingTypes.StoreKey, staking.CurrentValidatorSetKey, appHash); err != nil {
+		return validatorSet, err
+	}
+
+	if p.Value == nil {
+		return validatorSet, fmt.Errorf("no validator set at height %v", p.Height)
+	}
+
+	err = cdc.UnmarshalBinaryBare(p.Value, &validatorSet)
+	return validatorSet, err
+}
+
+// verifyKey checks proof is of expected store key, so node can't prove other key instead
+func verifyKey(p StoreProof, storeName string, key []byte, appHash []byte) error {
+	if p.StoreName != storeName || string(p.Key) != string(key) {
+		return fmt.Errorf("proof of key %X in store %v, expected key %X in store %v", []byte(p.Key), p.StoreName, key, storeName)
+	}
+	return p.Verify(appHash)
+}
+
+// parseAckCount parses ack count stored as decimal string, missing one means no acks
+func parseAckCount(value []byte) (uint64, error) {
+	if value == nil {
+		return 0, nil
+	}
+	return strconv.ParseUint(string(value), 10, 64)
+}
@@ -0,0 +1,116 @@
+// Package storeproof queries values of heimdall stores with merkle proofs through ABCI query path
+// and verifies them client-side against app hash of a header signed by heimdall validators.
+// It lets external bridges read heimdall state from full nodes they don't operate without trusting them.
+//
+// Proofs are tendermint merkle proof ops (IAVL value/absence op followed by multistore op), the
+// commitment scheme which ICS23 specs describe for IAVL stores.
+package storeproof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// StoreProof value of store key at height, with merkle proof of it against app hash.
+// Value is nil if key doesn't exist, proof is absence proof then.
+type StoreProof struct {
+	StoreName string        `json:"store_name"`
+	Key       cmn.HexBytes  `json:"key"`
+	Value     cmn.HexBytes  `json:"value"`
+	Height    int64         `json:"height"`
+	Proof     *merkle.Proof `json:"proof"`
+}
+
+// StorePath returns ABCI query path of store key with proof
+func StorePath(storeName string) string {
+	return fmt.Sprintf("/store/%s/key", storeName)
+}
+
+// Query queries key of store with proof, at latest height if height is 0
+func Query(node rpcclient.Client, storeName string, key []byte, height int64) (StoreProof, error) {
+	result, err := node.ABCIQueryWithOptions(StorePath(storeName), key, rpcclient.ABCIQueryOptions{
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return StoreProof{}, err
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return StoreProof{}, errors.New(resp.Log)
+	}
+
+	if resp.Proof == nil || len(resp.Proof.Ops) == 0 {
+		return StoreProof{}, fmt.Errorf("no proof of key %X in store %v at height %v", key, storeName, resp.Height)
+	}
+
+	return StoreProof{
+		StoreName: storeName,
+		Key:       key,
+		Value:     resp.Value,
+		Height:    resp.Height,
+		Proof:     resp.Proof,
+	}, nil
+}
+
+// Verify verifies value (or absence) of key against app hash.
+// App hash of state at height H is committed in header of block H+1.
+func (p StoreProof) Verify(appHash []byte) error {
+	if p.Proof == nil {
+		return errors.New("proof is empty")
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(p.StoreName), merkle.KeyEncodingURL).
+		AppendKey(p.Key, merkle.KeyEncodingURL).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if p.Value == nil {
+		if err := prt.VerifyAbsence(p.Proof, appHash, keyPath); err != nil {
+			return fmt.Errorf("invalid absence proof of key %X in store %v: %v", []byte(p.Key), p.StoreName, err)
+		}
+		return nil
+	}
+
+	if err := prt.VerifyValue(p.Proof, appHash, keyPath, p.Value); err != nil {
+		return fmt.Errorf("invalid proof of key %X in store %v: %v", []byte(p.Key), p.StoreName, err)
+	}
+	return nil
+}
+
+// VerifyHeader checks header is signed by more than 2/3 power of validator set trusted by caller.
+// App hash of verified header can be used to verify proofs of state at previous height.
+func VerifyHeader(chainID string, header tmTypes.SignedHeader, validators *tmTypes.ValidatorSet) error {
+	if err := header.ValidateBasic(chainID); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(header.ValidatorsHash, validators.Hash()) {
+		return fmt.Errorf("header at height %v is signed by different validator set", header.Height)
+	}
+
+	return validators.VerifyCommit(chainID, header.Commit.BlockID, header.Height, header.Commit)
+}
+
+// QueryVerifiedAppHash returns app hash of state at height, from header of next block verified against validators
+func QueryVerifiedAppHash(node rpcclient.Client, chainID string, height int64, validators *tmTypes.ValidatorSet) ([]byte, error) {
+	next := height + 1
+	commit, err := node.Commit(&next)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyHeader(chainID, commit.SignedHeader, validators); err != nil {
+		return nil, err
+	}
+	return commit.AppHash, nil
+}
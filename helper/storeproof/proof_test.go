@@ -0,0 +1,79 @@
+package storeproof
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// queryStore queries multistore as node does for ABCI query with prove=true
+func queryStore(t *testing.T, ms *rootmulti.Store, storeName string, key []byte) StoreProof {
+	resp := ms.Query(abci.RequestQuery{Path: "/" + storeName + "/key", Data: key, Prove: true})
+	require.True(t, resp.IsOK(), resp.Log)
+
+	return StoreProof{StoreName: storeName, Key: key, Value: resp.Value, Height: resp.Height, Proof: resp.Proof}
+}
+
+func TestLastCheckpointProof(t *testing.T) {
+	t.Parallel()
+
+	cdc := codec.New()
+	checkpointKey := sdk.NewKVStoreKey(checkpointTypes.StoreKey)
+	stakingKey := sdk.NewKVStoreKey(stakingTypes.StoreKey)
+
+	ms := rootmulti.NewStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(checkpointKey, storeTypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(stakingKey, storeTypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	rootChain := hmTypes.RootChainTypeEth
+	expected := hmTypes.Checkpoint{StartBlock: 256, EndBlock: 511, BorChainID: "15001", TimeStamp: 1000}
+
+	store := ms.GetCommitKVStore(checkpointKey)
+	store.Set(checkpoint.GetAckCountKey(hmTypes.GetRootChainID(rootChain)), []byte("2"))
+	store.Set(checkpoint.GetCheckpointKey(2, rootChain), cdc.MustMarshalBinaryBare(expected))
+	appHash := ms.Commit().Hash
+
+	ackCountKey := checkpoint.GetAckCountKey(hmTypes.GetRootChainID(rootChain))
+	proof := LastCheckpointProof{
+		RootChain:  rootChain,
+		AckCount:   queryStore(t, ms, checkpointTypes.StoreKey, ackCountKey),
+		Checkpoint: queryStore(t, ms, checkpointTypes.StoreKey, checkpoint.GetCheckpointKey(2, rootChain)),
+	}
+
+	number, result, err := proof.Verify(cdc, appHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+	require.Equal(t, expected, result)
+
+	// tampered value isn't proven
+	tampered := proof
+	tampered.Checkpoint.Value = cdc.MustMarshalBinaryBare(hmTypes.Checkpoint{StartBlock: 256, EndBlock: 1023})
+	_, _, err = tampered.Verify(cdc, appHash)
+	require.Error(t, err)
+
+	// proof of older checkpoint isn't accepted as last one
+	stale := proof
+	stale.Checkpoint = queryStore(t, ms, checkpointTypes.StoreKey, checkpoint.GetCheckpointKey(1, rootChain))
+	_, _, err = stale.Verify(cdc, appHash)
+	require.Error(t, err)
+
+	// absence of checkpoints of other root chain is proven
+	bscAckCount := queryStore(t, ms, checkpointTypes.StoreKey, checkpoint.GetAckCountKey(hmTypes.GetRootChainID(hmTypes.RootChainTypeBsc)))
+	require.Nil(t, bscAckCount.Value)
+	require.NoError(t, bscAckCount.Verify(appHash))
+
+	// proofs are bound to app hash
+	require.Error(t, proof.AckCount.Verify(make([]byte, len(appHash))))
+}
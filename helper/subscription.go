@@ -0,0 +1,76 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	ethereum "github.com/maticnetwork/bor"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/ethclient"
+)
+
+// wsSubscription closes its websocket client once unsubscribed. Each subscription dials its own
+// connection and isn't restored once connection drops, callers resubscribe after its error.
+type wsSubscription struct {
+	ethereum.Subscription
+	client *ethclient.Client
+}
+
+// Unsubscribe cancels subscription and closes its websocket connection
+func (s *wsSubscription) Unsubscribe() {
+	s.Subscription.Unsubscribe()
+	s.client.Close()
+}
+
+// dialWS dials websocket endpoint of root chain
+func dialWS(ctx context.Context, rootChain string) (*ethclient.Client, error) {
+	url := GetWSUrl(rootChain)
+	if url == "" {
+		return nil, fmt.Errorf("no websocket endpoint configured for root chain %v", rootChain)
+	}
+
+	return ethclient.DialContext(ctx, url)
+}
+
+// SubscribeNewHeads subscribes to new heads of root chain (eth_subscribe newHeads) over its websocket
+// endpoint. Error is returned if endpoint is not configured or not reachable, callers poll instead.
+func (c *ContractCaller) SubscribeNewHeads(ctx context.Context, rootChain string, ch chan<- *ethTypes.Header) (ethereum.Subscription, error) {
+	client, err := dialWS(ctx, rootChain)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := client.SubscribeNewHead(ctx, ch)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	return &wsSubscription{Subscription: subscription, client: client}, nil
+}
+
+// SubscribeLogs subscribes to logs of root chain matching query (eth_subscribe logs) over its websocket
+// endpoint, along with number of latest block at subscription time. Logs of every later block are
+// delivered to ch while subscription is alive.
+func (c *ContractCaller) SubscribeLogs(ctx context.Context, rootChain string, query ethereum.FilterQuery, ch chan<- ethTypes.Log) (ethereum.Subscription, uint64, error) {
+	client, err := dialWS(ctx, rootChain)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	subscription, err := client.SubscribeFilterLogs(ctx, query, ch)
+	if err != nil {
+		client.Close()
+		return nil, 0, err
+	}
+
+	// blocks imported after subscription was set up have their logs delivered
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		subscription.Unsubscribe()
+		client.Close()
+		return nil, 0, err
+	}
+
+	return &wsSubscription{Subscription: subscription, client: client}, header.Number.Uint64(), nil
+}
@@ -21,6 +21,23 @@ eth_rpc_url = "{{ .EthRPCUrl }}"
 # RPC endpoint for bsc chain
 bsc_rpc_url = "{{ .BscRPCUrl }}"
 
+# Archive node RPC endpoints, used for root chain calls at historical blocks (optional)
+eth_archive_rpc_url = "{{ .EthArchiveRPCUrl }}"
+bsc_archive_rpc_url = "{{ .BscArchiveRPCUrl }}"
+
+# Websocket endpoints, root chain new heads are subscribed instead of polled (optional)
+eth_ws_url = "{{ .EthWSUrl }}"
+bsc_ws_url = "{{ .BscWSUrl }}"
+
+# Block tag ("safe" or "finalized") root chain block of checkpoint ack has to reach,
+# instead of fixed number of tx confirmations (optional, post-merge ethereum only)
+eth_finality_tag = "{{ .EthFinalityTag }}"
+bsc_finality_tag = "{{ .BscFinalityTag }}"
+
+# Wait for quorum (percent) of parlia validators to seal blocks past bsc block of checkpoint ack
+bsc_parlia_finality = {{ .BscParliaFinality }}
+bsc_parlia_quorum = {{ .BscParliaQuorum }}
+
 # RPC endpoint for bttc chain
 bttc_rpc_url = "{{ .BttcRPCUrl }}"
 
@@ -63,9 +80,151 @@ eth_max_query_blocks = "{{ .EthMaxQueryBlocks }}"
 bsc_max_query_blocks = "{{ .BscMaxQueryBlocks }}"
 tron_max_query_blocks = "{{ .TronMaxQueryBlocks }}"
 
+#### double-submission guard for redundant bridge instances ####
+# AMQP endpoint shared by all instances of the bridge, empty disables guard
+bridge_duty_lock_url = "{{ .BridgeDutyLockURL }}"
+bridge_duty_lock_heartbeat = "{{ .BridgeDutyLockHeartbeat }}"
+
+#### checkpoint divergence monitor of bridge ####
+# interval between checks of heimdall, root chain contract and bor, 0 disables monitor
+checkpoint_monitor_interval = "{{ .CheckpointMonitorInterval }}"
+# listen address of /health/checkpoint endpoint, empty disables endpoint
+checkpoint_monitor_listen_addr = "{{ .CheckpointMonitorListenAddr }}"
+# divergence alerts are posted to slack webhook and/or pagerduty (events v2 routing key)
+checkpoint_monitor_slack_webhook = "{{ .CheckpointMonitorSlackWebhook }}"
+checkpoint_monitor_pagerduty_key = "{{ .CheckpointMonitorPagerDutyKey }}"
+
+#### consensus config sanity check ####
+# interval between publications of consensus config hash of validator, 0 disables publishing
+config_hash_interval = "{{ .ConfigHashInterval }}"
+
+#### checkpoint data availability attestation ####
+# proposer commits to merkle root of bor block hashes covered by its checkpoints
+checkpoint_attestation_enabled = "{{ .CheckpointAttestationEnabled }}"
+
+#### BLS key ####
+# file of BLS key signing checkpoint votes when BLS aggregation is enabled, relative paths are under config dir.
+# key is generated by "set-bls-key" tx command if file doesn't exist
+bls_key_file = "{{ .BlsKeyFile }}"
+
+#### tx inclusion attestation key ####
+# file of hex encoded secp256k1 key signing attestations of verify-tx endpoint, relative paths are under config dir.
+# attestations are unsigned if not set, validator key never signs them
+attestation_key_file = "{{ .AttestationKeyFile }}"
+
+#### task scheduler of bridge ####
+# max random start offset of polling tasks
+scheduler_max_jitter = "{{ .SchedulerMaxJitter }}"
+# root chain polling interval is divided by number of checkpoints waiting for ack, down to this bound; 0 disables adjustment
+scheduler_min_interval = "{{ .SchedulerMinInterval }}"
+# listen address of admin endpoint to list, pause, resume and re-time tasks, list processors and their last errors,
+# pause root chain pipelines, resubmit buffered checkpoint and flush job queue; keep it local, empty disables endpoint
+bridge_admin_listen_addr = "{{ .BridgeAdminListenAddr }}"
+# key required by admin endpoint (X-Admin-Key header), empty disables auth
+bridge_admin_api_key = "{{ .BridgeAdminAPIKey }}"
+
+#### Root chain tx manager of bridge ####
+# submit checkpoints to eth/bsc through tx manager: stuck submissions are replaced with bumped gas price,
+# nonce gaps are filled and spend is capped; pending submissions and metrics are served by admin endpoint
+tx_manager_enabled = "{{ .TxManagerEnabled }}"
+tx_manager_check_interval = "{{ .TxManagerCheckInterval }}"
+# time submission waits to be mined before replacement, and gas price increase of replacement (at least 10 percent)
+tx_manager_resubmit_timeout = "{{ .TxManagerResubmitTimeout }}"
+tx_manager_gas_bump_percent = "{{ .TxManagerGasBumpPercent }}"
+# max gas price (gwei) of submissions and max fees (gwei) spent per root chain since bridge start, 0 disables limit
+tx_manager_max_gas_price = "{{ .TxManagerMaxGasPriceGwei }}"
+tx_manager_spend_cap = "{{ .TxManagerSpendCapGwei }}"
+
+#### Bor finality notifier of bridge ####
+# gRPC endpoints (host:port) of bor nodes which are pushed "checkpoint acked covering blocks X..Y"
+# as soon as ack is processed, instead of bor polling heimdall; empty disables notifier
+bor_finality_notify_endpoints = [{{ range $i, $endpoint := .BorFinalityNotifyEndpoints }}{{ if $i }}, {{ end }}"{{ $endpoint }}"{{ end }}]
+# token sent as bearer authorization, and whether bor nodes are reached over TLS
+bor_finality_notify_token = "{{ .BorFinalityNotifyToken }}"
+bor_finality_notify_tls = "{{ .BorFinalityNotifyTLS }}"
+# attempts per bor node and notification, and timeout of single attempt
+bor_finality_notify_retries = "{{ .BorFinalityNotifyRetries }}"
+bor_finality_notify_timeout = "{{ .BorFinalityNotifyTimeout }}"
+
+#### Automatic no-ack of bridge ####
+# send no-ack once buffered checkpoint of any root chain expired, instead of proposer based no-ack;
+# every validator waits random delay up to max delay and skips sending if no-ack was accepted meanwhile.
+# counters are served by admin endpoint /noack/metrics
+auto_no_ack_enabled = "{{ .AutoNoAckEnabled }}"
+auto_no_ack_poll_interval = "{{ .AutoNoAckPollInterval }}"
+auto_no_ack_max_delay = "{{ .AutoNoAckMaxDelay }}"
+
+#### REST server configs ####
+# comma separated api keys required by tx endpoints (X-API-Key header), empty disables auth
+rest_api_keys = "{{ .RestAPIKeys }}"
+# per client ip rate limit (requests per second) and burst for tx endpoints, 0 disables rate limit
+rest_tx_rate_limit = "{{ .RestTxRateLimit }}"
+rest_tx_rate_burst = "{{ .RestTxRateBurst }}"
+# require tx endpoints to be signed (X-Request-Timestamp and X-Request-Signature headers) by validator key
+# of this node or with one of comma separated signing keys (hmac-sha256), so exposed rest server can't be
+# used to inject txs. signed requests older than 30s or replayed are rejected
+rest_signed_requests = "{{ .RestSignedRequests }}"
+rest_signing_keys = "{{ .RestSigningKeys }}"
+# max body size (bytes), max concurrent requests and max concurrent requests per route for tx endpoints, 0 disables limit
+rest_tx_max_body_bytes = "{{ .RestTxMaxBodyBytes }}"
+rest_tx_max_in_flight = "{{ .RestTxMaxInFlight }}"
+rest_tx_route_workers = "{{ .RestTxRouteWorkers }}"
+
+#### Side-tx configs ####
+# max concurrent external (root chain) validations of side-txs, 1 validates sequentially
+side_tx_validation_workers = "{{ .SideTxValidationWorkers }}"
+# bor headers fetched per batch rpc call during checkpoint validation
+bor_header_batch_size = "{{ .BorHeaderBatchSize }}"
+# cross-check receipts root of bor headers against eth_getBlockReceipts during checkpoint validation,
+# root hash of checkpoints is then computed from headers
+bor_receipts_root_check = "{{ .BorReceiptsRootCheck }}"
+
+#### Call journal ####
+# record every contract call made during side-tx validation (method, args, endpoint, response hash, latency, height)
+# in rotating files under data/call-journal, query with "deliveryd call-journal". Side-txs are validated sequentially when enabled
+call_journal_enabled = "{{ .CallJournalEnabled }}"
+call_journal_max_file_size_mb = "{{ .CallJournalMaxFileSizeMB }}"
+call_journal_max_files = "{{ .CallJournalMaxFiles }}"
+
+#### Clerk archive ####
+# export state records pruned from store once bor processed them to files under data/clerk-archive,
+# record queries of this node keep serving them
+clerk_archive_enabled = "{{ .ClerkArchiveEnabled }}"
+
+#### Root chain endpoint circuit breaker ####
+# consecutive failures to eth/bsc/tron endpoint which stop calls to it for cool-down window, 0 disables breaker
+circuit_breaker_threshold = "{{ .CircuitBreakerThreshold }}"
+circuit_breaker_cooldown = "{{ .CircuitBreakerCooldown }}"
+
+#### Invariants ####
+# blocks between invariant checks which halt chain if state is inconsistent, 0 disables checks
+inv_check_period = "{{ .InvCheckPeriod }}"
+
+#### Module toggles ####
+# optional modules left out of app: "bor", "clerk" and "tron" (tron checkpoints and checkpoint-sync).
+# Their stores stay mounted but msgs, queries, blockers and genesis are skipped, so every validator
+# of network must disable same modules
+disabled_modules = [{{ range $i, $module := .DisabledModules }}{{ if $i }}, {{ end }}"{{ $module }}"{{ end }}]
+
+#### Events ####
+# emit only essential attributes (action, module, tx hash, side-tx result, root chain, number) in events,
+# full events are kept in local events db and referenced by "event-record" attribute
+compact_events = "{{ .CompactEvents }}"
+
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
+#### Address prefixes ####
+# bech32 prefixes of account, validator operator and consensus addresses (public keys get "pub" suffix)
+# rendered by CLI, REST and events; sdk defaults are used if empty. Must be the same on all nodes and clients
+bech32_account_prefix = "{{ .Bech32AccountPrefix }}"
+bech32_validator_prefix = "{{ .Bech32ValidatorPrefix }}"
+bech32_consensus_prefix = "{{ .Bech32ConsensusPrefix }}"
+
+# attributes kept in compact events and written to tendermint indexer, per module
+[index_event_attributes]
+{{ range $module, $attributes := .IndexEventAttributes }}{{ $module }} = [{{ range $i, $attribute := $attributes }}{{ if $i }}, {{ end }}"{{ $attribute }}"{{ end }}]
+{{ end }}
 `
 
 var configTemplate *template.Template
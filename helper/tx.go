@@ -107,6 +107,41 @@ func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int,
 	return
 }
 
+// EstimateCheckpointGas estimates gas of submitting checkpoint to rootchain contract, along with current gas price
+func (c *ContractCaller) EstimateCheckpointGas(signedData []byte, sigs [][3]*big.Int, rootChainAddress common.Address, rootChain string) (gasLimit uint64, gasPrice *big.Int, err error) {
+	data, err := c.RootChainABI.Pack("submitCheckpoint", signedData, sigs)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var client *ethclient.Client
+	switch rootChain {
+	case hmtypes.RootChainTypeEth:
+		client = GetMainClient()
+	case hmtypes.RootChainTypeBsc:
+		client = GetBscClient()
+	default:
+		return 0, nil, fmt.Errorf("gas estimation is not supported on root chain %v", rootChain)
+	}
+
+	if gasPrice, err = client.SuggestGasPrice(context.Background()); err != nil {
+		return 0, nil, err
+	}
+
+	// estimate from same sender as SendCheckpoint
+	pkObject := GetPrivKey()
+	gasLimit, err = client.EstimateGas(context.Background(), ethereum.CallMsg{
+		From: common.BytesToAddress(pkObject.PubKey().Address().Bytes()),
+		To:   &rootChainAddress,
+		Data: data,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return gasLimit, gasPrice, nil
+}
+
 // SendTick sends slash tick to rootchain contract
 func (c *ContractCaller) SendTick(signedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (er error) {
 	data, err := c.SlashManagerABI.Pack("updateSlashedAmounts", signedData, sigs)
@@ -230,6 +265,22 @@ func (c *ContractCaller) SendTronCheckpoint(signedData []byte, sigs [][3]*big.In
 	return nil
 }
 
+// SimulateTronCheckpoint dry-runs checkpoint submission on tron rootchain contract. Tron node does not
+// expose energy estimation, so fee limit submission would be sent with is returned as its maximum cost
+func (c *ContractCaller) SimulateTronCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress string) (feeLimit uint64, err error) {
+	data, err := c.RootChainABI.Pack("submitCheckpoint", signedData, sigs)
+	if err != nil {
+		return 0, err
+	}
+
+	privateKey := GetPrivKey()
+	if err := c.TronChainRPC.SimulateContract(privateKey.PubKey().Address().String(), rootChainAddress, data); err != nil {
+		return 0, err
+	}
+
+	return GetConfig().TronchainFeeLimit, nil
+}
+
 // SendCheckpointSyncToTron sends staking sync to tron stake manager contract
 func (c *ContractCaller) SendCheckpointSyncToTron(signedData []byte, sigs [][3]*big.Int, stakeManagerAddress string) error {
 	data, err := c.StakeManagerABI.Pack("submitCheckpointSync", signedData, sigs)
@@ -303,6 +354,8 @@ func (c *ContractCaller) SendMainStakingSync(syncMethod string, signedData []byt
 		tx, err = stakingManagerInstance.ValidatorExit(auth, signedData, sigs)
 	case "signerUpdate":
 		tx, err = stakingManagerInstance.SignerUpdate(auth, signedData, sigs)
+	case "stakeUpdate":
+		tx, err = stakingManagerInstance.StakeUpdate(auth, signedData, sigs)
 	default:
 		Logger.Error("Submitted new staking sync to stake chain failed", "method", syncMethod)
 		return
@@ -316,6 +369,49 @@ func (c *ContractCaller) SendMainStakingSync(syncMethod string, signedData []byt
 	return
 }
 
+// SendValidatorSetSync sends validator set sync to stake manager contract of secondary root chain
+func (c *ContractCaller) SendValidatorSetSync(signedData []byte, sigs [][3]*big.Int, stakingManager common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) (er error) {
+	data, err := c.StakeManagerABI.Pack("submitValidatorSetSync", signedData, sigs)
+	if err != nil {
+		Logger.Error("Unable to pack tx for submitValidatorSetSync", "error", err)
+		return err
+	}
+	var client *ethclient.Client
+	switch rootChain {
+	case hmtypes.RootChainTypeEth:
+		client = GetMainClient()
+	case hmtypes.RootChainTypeBsc:
+		client = GetBscClient()
+	default:
+		return fmt.Errorf("validator set sync is not supported on %v", rootChain)
+	}
+	auth, err := GenerateAuthObj(client, stakingManager, data)
+	if err != nil {
+		Logger.Error("Unable to create auth object", "error", err)
+		Logger.Info("Setting custom gaslimit", "gaslimit", GetConfig().MainchainGasLimit)
+		auth.GasLimit = GetConfig().MainchainGasLimit
+	}
+
+	s := make([]string, 0)
+	for i := 0; i < len(sigs); i++ {
+		s = append(s, fmt.Sprintf("[%s,%s,%s]", sigs[i][0].String(), sigs[i][1].String(), sigs[i][2].String()))
+	}
+
+	Logger.Debug("Sending new validator set sync",
+		"sigs", strings.Join(s, ","),
+		"data", hex.EncodeToString(signedData),
+		"address", stakingManager.Hex(),
+	)
+
+	tx, err := stakingManagerInstance.SubmitValidatorSetSync(auth, signedData, sigs)
+	if err != nil {
+		Logger.Error("Error while submitting validator set sync", "error", err)
+		return err
+	}
+	Logger.Info("Submitted new validator set sync to root chain successfully", "root", rootChain, "txHash", tx.Hash().String())
+	return
+}
+
 // SendTronStakingSync sends staking sync to tron contract
 func (c *ContractCaller) SendTronStakingSync(syncMethod string, signedData []byte, sigs [][3]*big.Int, stakingManagerAddress string) (er error) {
 	data, err := c.StakeManagerABI.Pack(syncMethod, signedData, sigs)
@@ -21,7 +21,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/input"
-	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/accounts/abi"
@@ -37,6 +36,7 @@ import (
 	tmTypes "github.com/tendermint/tendermint/types"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/client/keyring"
 	"github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/rest"
@@ -181,6 +181,13 @@ type sideTxSig struct {
 
 // GetSideTxSigs returns sigs bytes from vote by tx hash
 func GetSideTxSigs(txHash []byte, sideTxData []byte, unFilteredVotes []*tmTypes.CommitSig) (sigs [][3]*big.Int, err error) {
+	_, sigs, err = GetSideTxSigsWithSigners(txHash, sideTxData, unFilteredVotes)
+	return sigs, err
+}
+
+// GetSideTxSigsWithSigners returns sigs from vote by tx hash along with signer addresses,
+// both sorted by signer address which is the order root chain contracts expect
+func GetSideTxSigsWithSigners(txHash []byte, sideTxData []byte, unFilteredVotes []*tmTypes.CommitSig) (signers []hmTypes.HeimdallAddress, sigs [][3]*big.Int, err error) {
 	// side tx result with data
 	sideTxResultWithData := tmTypes.SideTxResultWithData{
 		SideTxResult: tmTypes.SideTxResult{
@@ -231,13 +238,41 @@ func GetSideTxSigs(txHash []byte, sideTxData []byte, unFilteredVotes []*tmTypes.
 		for _, sideTxSig := range sideTxSigs {
 			R, S, V, err := ethTypes.HomesteadSigner{}.SignatureValues(nil, sideTxSig.Sig)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
+			signers = append(signers, hmTypes.BytesToHeimdallAddress(sideTxSig.Address))
 			sigs = append(sigs, [3]*big.Int{R, S, V})
 		}
 	}
 
-	return sigs, nil
+	return signers, sigs, nil
+}
+
+// VerifyExtendedVote verifies that vote is signed by its validator over vote result and vote extension data
+func VerifyExtendedVote(vote hmTypes.ExtendedVote, data []byte) error {
+	if len(vote.Signature) != 65 {
+		return errors.New("invalid vote signature length")
+	}
+
+	sideTxResultWithData := tmTypes.SideTxResultWithData{
+		SideTxResult: tmTypes.SideTxResult{
+			Result: int32(vote.Result),
+		},
+		Data: data,
+	}
+
+	p, err := authTypes.RecoverPubkey(sideTxResultWithData.GetBytes(), vote.Signature)
+	if err != nil {
+		return err
+	}
+
+	var pk secp256k1.PubKeySecp256k1
+	copy(pk[:], p[:])
+	if !bytes.Equal(pk.Address().Bytes(), vote.Validator) {
+		return errors.New("vote is not signed by its validator")
+	}
+
+	return nil
 }
 
 // GetVoteBytes returns vote bytes
@@ -386,7 +421,7 @@ func GetSignedTxBytes(cliCtx context.CLIContext, txBldr authTypes.TxBuilder, msg
 		}
 	}
 
-	passphrase, err := keys.GetPassphrase(fromName)
+	passphrase, err := keyring.GetPassphrase(fromName)
 	if err != nil {
 		return nil, err
 	}
@@ -436,7 +471,7 @@ func GetSignedTxBytesWithCLI(cliCtx context.CLIContext, txBldr authTypes.TxBuild
 		}
 	}
 
-	passphrase, err := keys.GetPassphrase(fromName)
+	passphrase, err := keyring.GetPassphrase(fromName)
 	if err != nil {
 		return nil, err
 	}
@@ -527,7 +562,7 @@ func SignStdTx(
 	}
 
 	if fromName != "" {
-		passphrase, err := keys.GetPassphrase(fromName)
+		passphrase, err := keyring.GetPassphrase(fromName)
 		if err != nil {
 			return signedStdTx, err
 		}
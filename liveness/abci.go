@@ -0,0 +1,23 @@
+package liveness
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// BeginBlocker counts precommits of last block for every validator which should have signed it,
+// and closes liveness window of all validators once window size blocks elapsed
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	// module is added by upgrade, nothing is tracked until its params are set
+	if !k.HasParams(ctx) {
+		return
+	}
+
+	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
+		k.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.SignedLastBlock)
+	}
+
+	if ctx.BlockHeight()%int64(k.GetParams(ctx).WindowSize) == 0 {
+		k.CloseWindow(ctx)
+	}
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/liveness/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the liveness module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryParams(cdc),
+			GetCmdQueryUptime(cdc),
+			GetCmdQueryUptimes(cdc),
+		)...,
+	)
+	return queryCmd
+}
+
+// GetCmdQueryParams implements the liveness params query command.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current liveness parameters information",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err = json.Unmarshal(bz, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryUptime implements the validator uptime query command.
+func GetCmdQueryUptime(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "uptime [validator-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show uptime and liveness status of validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query windows validator missed, its uptime and whether it is considered live.
+Offline validators are skipped as checkpoint proposers.
+
+Example:
+$ %s query liveness uptime 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.NewValidatorID(id)))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorUptime)
+			bz, _, err := cliCtx.QueryWithData(route, params)
+			if err != nil {
+				return err
+			}
+
+			var uptime types.ValidatorUptime
+			if err = json.Unmarshal(bz, &uptime); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(uptime)
+		},
+	}
+}
+
+// GetCmdQueryUptimes implements the current validators uptime query command.
+func GetCmdQueryUptimes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "uptimes",
+		Args:  cobra.NoArgs,
+		Short: "show uptime and liveness status of current validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUptimes)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var uptimes []types.ValidatorUptime
+			if err = json.Unmarshal(bz, &uptimes); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(uptimes)
+		},
+	}
+}
@@ -0,0 +1,13 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers the liveness module REST routes.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/liveness/params", paramsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/liveness/uptime", uptimesHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/liveness/uptime/{id}", validatorUptimeHandlerFn(cliCtx)).Methods("GET")
+}
@@ -0,0 +1,24 @@
+package liveness
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/liveness/types"
+)
+
+// InitGenesis sets liveness information for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, liveness := range data.Liveness {
+		keeper.SetValidatorLiveness(ctx, liveness)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	return types.NewGenesisState(
+		keeper.GetParams(ctx),
+		keeper.GetAllValidatorLiveness(ctx),
+	)
+}
@@ -0,0 +1,223 @@
+package liveness
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/liveness/types"
+	"github.com/maticnetwork/heimdall/params/subspace"
+	"github.com/maticnetwork/heimdall/staking"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// staking keeper
+	sk staking.Keeper
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// param space
+	paramSpace subspace.Subspace
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace subspace.Subspace,
+	codespace sdk.CodespaceType,
+	stakingKeeper staking.Keeper,
+) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:  codespace,
+		sk:         stakingKeeper,
+	}
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+//
+// Params
+//
+
+// SetParams sets the liveness module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the liveness module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// HasParams returns true once module params are set, i.e. at genesis or by upgrade which added module
+func (k Keeper) HasParams(ctx sdk.Context) bool {
+	return k.paramSpace.Has(ctx, types.KeyWindowSize)
+}
+
+//
+// Liveness records
+//
+
+// SetValidatorLiveness sets liveness record of validator
+func (k Keeper) SetValidatorLiveness(ctx sdk.Context, liveness types.ValidatorLiveness) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLivenessKey(liveness.ID), k.cdc.MustMarshalBinaryBare(liveness))
+}
+
+// GetValidatorLiveness returns liveness record of validator
+func (k Keeper) GetValidatorLiveness(ctx sdk.Context, id hmTypes.ValidatorID) (liveness types.ValidatorLiveness, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLivenessKey(id))
+	if bz == nil {
+		return liveness, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &liveness)
+	return liveness, true
+}
+
+// IterateValidatorLiveness iterates over liveness records and applies cb, iteration stops if cb returns true
+func (k Keeper) IterateValidatorLiveness(ctx sdk.Context, cb func(liveness types.ValidatorLiveness) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.LivenessKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var liveness types.ValidatorLiveness
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &liveness)
+		if cb(liveness) {
+			break
+		}
+	}
+}
+
+// GetAllValidatorLiveness returns all liveness records
+func (k Keeper) GetAllValidatorLiveness(ctx sdk.Context) (records []types.ValidatorLiveness) {
+	k.IterateValidatorLiveness(ctx, func(liveness types.ValidatorLiveness) bool {
+		records = append(records, liveness)
+		return false
+	})
+	return records
+}
+
+//
+// Tracking
+//
+
+// HandleValidatorSignature records whether validator with consensus address signed last block
+func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr []byte, signed bool) {
+	validator, err := k.sk.GetValidatorInfo(ctx, addr)
+	if err != nil {
+		k.Logger(ctx).Debug("Validator of precommit not found", "address", hmTypes.BytesToHeimdallAddress(addr))
+		return
+	}
+
+	liveness, found := k.GetValidatorLiveness(ctx, validator.ID)
+	if !found {
+		liveness = types.NewValidatorLiveness(validator.ID)
+	}
+
+	liveness.WindowBlocks++
+	if signed {
+		liveness.WindowSigned++
+		liveness.LastSignedHeight = ctx.BlockHeight()
+	}
+
+	k.SetValidatorLiveness(ctx, liveness)
+}
+
+// CloseWindow closes current window of every validator which should have signed blocks in it.
+// Validator which signed less than min signed fraction misses the window.
+func (k Keeper) CloseWindow(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	var records []types.ValidatorLiveness
+	k.IterateValidatorLiveness(ctx, func(liveness types.ValidatorLiveness) bool {
+		if liveness.WindowBlocks > 0 {
+			records = append(records, liveness)
+		}
+		return false
+	})
+
+	for _, liveness := range records {
+		wasLive := k.isLive(params, liveness)
+
+		signed := sdk.NewDec(int64(liveness.WindowSigned)).QuoInt64(int64(liveness.WindowBlocks))
+		if signed.LT(params.MinSignedPerWindow) {
+			liveness.MissedWindows++
+			liveness.TotalMissedWindows++
+		} else {
+			liveness.MissedWindows = 0
+		}
+
+		liveness.TotalWindows++
+		liveness.WindowBlocks = 0
+		liveness.WindowSigned = 0
+		k.SetValidatorLiveness(ctx, liveness)
+
+		if live := k.isLive(params, liveness); live != wasLive {
+			eventType := types.EventTypeValidatorOnline
+			if !live {
+				eventType = types.EventTypeValidatorOffline
+				k.Logger(ctx).Info("Validator is offline", "validatorID", liveness.ID, "missedWindows", liveness.MissedWindows)
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					eventType,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyValidatorID, liveness.ID.String()),
+					sdk.NewAttribute(types.AttributeKeyMissedWindows, strconv.FormatUint(liveness.MissedWindows, 10)),
+				),
+			)
+		}
+	}
+}
+
+// IsLive returns false if validator missed max missed windows in a row.
+// Validators without liveness record are live.
+func (k Keeper) IsLive(ctx sdk.Context, id hmTypes.ValidatorID) bool {
+	if !k.HasParams(ctx) {
+		return true
+	}
+
+	liveness, found := k.GetValidatorLiveness(ctx, id)
+	if !found {
+		return true
+	}
+
+	return k.isLive(k.GetParams(ctx), liveness)
+}
+
+// GetValidatorUptime returns uptime and liveness status of validator
+func (k Keeper) GetValidatorUptime(ctx sdk.Context, id hmTypes.ValidatorID) types.ValidatorUptime {
+	liveness, found := k.GetValidatorLiveness(ctx, id)
+	if !found {
+		liveness = types.NewValidatorLiveness(id)
+	}
+
+	return types.NewValidatorUptime(liveness, k.isLive(k.GetParams(ctx), liveness))
+}
+
+func (k Keeper) isLive(params types.Params, liveness types.ValidatorLiveness) bool {
+	return params.MaxMissedWindows == 0 || liveness.MissedWindows < params.MaxMissedWindows
+}
@@ -0,0 +1,98 @@
+package liveness_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/liveness/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Test suite
+//
+
+// KeeperTestSuite integrate test suite context object
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 10})
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+//
+// Tests
+//
+
+func (suite *KeeperTestSuite) TestCloseWindow() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.LivenessKeeper
+
+	keeper.SetParams(ctx, types.NewParams(10, sdk.NewDecWithPrec(5, 1), 2))
+
+	valSet := chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	online, flaky := valSet.Validators[0], valSet.Validators[1]
+
+	// online signs every block, flaky signs 2 of 10
+	runWindow := func(flakySigned int) {
+		for i := 0; i < 10; i++ {
+			keeper.HandleValidatorSignature(ctx, online.Signer.Bytes(), true)
+			keeper.HandleValidatorSignature(ctx, flaky.Signer.Bytes(), i < flakySigned)
+		}
+		keeper.CloseWindow(ctx)
+	}
+
+	runWindow(2)
+	liveness, found := keeper.GetValidatorLiveness(ctx, flaky.ID)
+	require.True(t, found)
+	require.Equal(t, uint64(1), liveness.MissedWindows)
+	require.Equal(t, uint64(0), liveness.WindowBlocks, "window counters are reset")
+	require.True(t, keeper.IsLive(ctx, flaky.ID), "single missed window is tolerated")
+
+	runWindow(2)
+	require.False(t, keeper.IsLive(ctx, flaky.ID), "validator is offline after max missed windows")
+	require.True(t, keeper.IsLive(ctx, online.ID))
+
+	// signing again brings validator back online
+	runWindow(10)
+	uptime := keeper.GetValidatorUptime(ctx, flaky.ID)
+	require.True(t, uptime.Live)
+	require.Equal(t, uint64(0), uptime.MissedWindows)
+	require.Equal(t, uint64(3), uptime.TotalWindows)
+	require.Equal(t, sdk.NewDec(1).QuoInt64(3), uptime.Uptime)
+
+	require.Equal(t, sdk.OneDec(), keeper.GetValidatorUptime(ctx, online.ID).Uptime)
+}
+
+func (suite *KeeperTestSuite) TestIsLive() {
+	t, keeper, ctx := suite.T(), suite.app.LivenessKeeper, suite.ctx
+
+	// validator without record is live
+	require.True(t, keeper.IsLive(ctx, hmTypes.NewValidatorID(1)))
+
+	liveness := types.NewValidatorLiveness(hmTypes.NewValidatorID(1))
+	liveness.MissedWindows = types.DefaultMaxMissedWindows
+	keeper.SetValidatorLiveness(ctx, liveness)
+	require.False(t, keeper.IsLive(ctx, liveness.ID))
+
+	// zero max missed windows disables liveness check
+	params := keeper.GetParams(ctx)
+	params.MaxMissedWindows = 0
+	keeper.SetParams(ctx, params)
+	require.True(t, keeper.IsLive(ctx, liveness.ID))
+}
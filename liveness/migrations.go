@@ -0,0 +1,14 @@
+package liveness
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/liveness/types"
+)
+
+// initModuleState sets default params on chains which started without liveness module,
+// tracking starts from the upgrade height
+func initModuleState(ctx sdk.Context, k Keeper) error {
+	InitGenesis(ctx, k, types.DefaultGenesisState())
+	return nil
+}
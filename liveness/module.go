@@ -0,0 +1,168 @@
+package liveness
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	livenessCli "github.com/maticnetwork/heimdall/liveness/client/cli"
+	livenessRest "github.com/maticnetwork/heimdall/liveness/client/rest"
+	"github.com/maticnetwork/heimdall/liveness/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+var (
+	_ module.AppModule             = AppModule{}
+	_ module.AppModuleBasic        = AppModuleBasic{}
+	_ hmModule.HeimdallModuleBasic = AppModule{}
+	_ hmModule.HasConsensusVersion = AppModule{}
+	_ hmModule.HasMigrations       = AppModule{}
+)
+
+// ConsensusVersion store layout version of the module.
+// Version 1 is chain which started without liveness module.
+const ConsensusVersion uint64 = 2
+
+// AppModuleBasic defines the basic application module used by the liveness module.
+type AppModuleBasic struct{}
+
+// Name returns the liveness module's name.
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterCodec registers the liveness module's types for the given codec.
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the liveness
+// module.
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return types.ModuleCdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the liveness module.
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	data := types.DefaultGenesisState()
+	if bz != nil {
+		err := types.ModuleCdc.UnmarshalJSON(bz, &data)
+		if err != nil {
+			return err
+		}
+	}
+	return types.ValidateGenesis(data)
+}
+
+// VerifyGenesis performs verification on liveness module state.
+func (AppModuleBasic) VerifyGenesis(bz map[string]json.RawMessage) error {
+	return nil
+}
+
+// RegisterRESTRoutes registers the REST routes for the liveness module.
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+	livenessRest.RegisterRoutes(ctx, rtr)
+}
+
+// GetTxCmd returns the root tx command for the liveness module.
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return nil
+}
+
+// GetQueryCmd returns the root query command for the liveness module.
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return livenessCli.GetQueryCmd(cdc)
+}
+
+//____________________________________________________________________________
+
+// AppModule implements an application module for the liveness module.
+type AppModule struct {
+	AppModuleBasic
+
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+	}
+}
+
+// Name returns the liveness module's name.
+func (AppModule) Name() string {
+	return types.ModuleName
+}
+
+// RegisterInvariants performs a no-op.
+func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the liveness module.
+func (AppModule) Route() string {
+	return ""
+}
+
+// NewHandler returns an sdk.Handler for the module.
+func (am AppModule) NewHandler() sdk.Handler {
+	return nil
+}
+
+// QuerierRoute returns the liveness module's querier route name.
+func (AppModule) QuerierRoute() string {
+	return types.QuerierRoute
+}
+
+// NewQuerierHandler returns the liveness module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper)
+}
+
+// ConsensusVersion returns store layout version of the liveness module
+func (AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+// RegisterMigrations registers store migrations of the liveness module.
+func (am AppModule) RegisterMigrations(cfg *hmModule.Configurator) error {
+	// v1 -> v2: module added, set default params
+	return cfg.RegisterMigration(types.ModuleName, 1, func(ctx sdk.Context) error {
+		return initModuleState(ctx, am.keeper)
+	})
+}
+
+// InitGenesis performs genesis initialization for the liveness module. It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	genesisState := types.DefaultGenesisState()
+	if data != nil {
+		types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	}
+	InitGenesis(ctx, am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the liveness
+// module.
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return types.ModuleCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock tracks precommit participation of validators.
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	BeginBlocker(ctx, req, am.keeper)
+}
+
+// EndBlock returns the end blocker for the liveness module. It returns no validator
+// updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,66 @@
+package liveness
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/liveness/types"
+)
+
+// NewQuerier creates a querier for liveness REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		// module is added by upgrade, params are missing until it is applied
+		if !keeper.HasParams(ctx) {
+			return nil, sdk.ErrInternal("liveness module is not enabled yet")
+		}
+
+		switch path[0] {
+		case types.QueryParams:
+			return queryParams(ctx, req, keeper)
+		case types.QueryValidatorUptime:
+			return queryValidatorUptime(ctx, req, keeper)
+		case types.QueryUptimes:
+			return queryUptimes(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown liveness query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryValidatorUptime(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	bz, err := json.Marshal(keeper.GetValidatorUptime(ctx, params.ValidatorID))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// queryUptimes returns uptime of every validator in current validator set
+func queryUptimes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	uptimes := make([]types.ValidatorUptime, 0)
+	for _, validator := range keeper.sk.GetCurrentValidators(ctx) {
+		uptimes = append(uptimes, keeper.GetValidatorUptime(ctx, validator.ID))
+	}
+
+	bz, err := json.Marshal(uptimes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,12 @@
+package types
+
+// liveness module event types
+const (
+	EventTypeValidatorOffline = "validator_offline"
+	EventTypeValidatorOnline  = "validator_online"
+
+	AttributeKeyValidatorID   = "validator-id"
+	AttributeKeyMissedWindows = "missed-windows"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GenesisState is the liveness state that must be provided at genesis.
+type GenesisState struct {
+	Params   Params              `json:"params" yaml:"params"`
+	Liveness []ValidatorLiveness `json:"liveness" yaml:"liveness"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, liveness []ValidatorLiveness) GenesisState {
+	return GenesisState{
+		Params:   params,
+		Liveness: liveness,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// ValidateGenesis performs basic validation of liveness genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[hmTypes.ValidatorID]bool, len(data.Liveness))
+	for _, liveness := range data.Liveness {
+		if ids[liveness.ID] {
+			return fmt.Errorf("duplicate liveness record of validator %v", liveness.ID)
+		}
+		ids[liveness.ID] = true
+
+		if liveness.TotalMissedWindows > liveness.TotalWindows || liveness.WindowSigned > liveness.WindowBlocks {
+			return fmt.Errorf("invalid liveness record of validator %v", liveness.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// ModuleName defines the name of the module
+	ModuleName = "liveness"
+
+	// StoreKey is the store key string for liveness
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for liveness
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for liveness
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+)
+
+var (
+	// LivenessKeyPrefix prefix for liveness records of validators
+	LivenessKeyPrefix = []byte{0x01}
+)
+
+// GetLivenessKey returns key used to get liveness record of validator
+func GetLivenessKey(id hmTypes.ValidatorID) []byte {
+	return append(append([]byte{}, LivenessKeyPrefix...), id.Bytes()...)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorLiveness precommit participation of validator, counted per window of blocks
+type ValidatorLiveness struct {
+	ID                 hmTypes.ValidatorID `json:"id" yaml:"id"`
+	WindowBlocks       uint64              `json:"window_blocks" yaml:"window_blocks"`               // blocks validator should have signed in current window
+	WindowSigned       uint64              `json:"window_signed" yaml:"window_signed"`               // blocks validator signed in current window
+	MissedWindows      uint64              `json:"missed_windows" yaml:"missed_windows"`             // consecutive missed windows
+	TotalWindows       uint64              `json:"total_windows" yaml:"total_windows"`               // closed windows validator took part in
+	TotalMissedWindows uint64              `json:"total_missed_windows" yaml:"total_missed_windows"` // closed windows validator missed
+	LastSignedHeight   int64               `json:"last_signed_height" yaml:"last_signed_height"`
+}
+
+// NewValidatorLiveness creates empty liveness record of validator
+func NewValidatorLiveness(id hmTypes.ValidatorID) ValidatorLiveness {
+	return ValidatorLiveness{ID: id}
+}
+
+// Uptime returns fraction of closed windows validator did not miss, 1 if no window was closed yet
+func (l ValidatorLiveness) Uptime() sdk.Dec {
+	if l.TotalWindows == 0 {
+		return sdk.OneDec()
+	}
+	return sdk.NewDec(int64(l.TotalWindows - l.TotalMissedWindows)).QuoInt64(int64(l.TotalWindows))
+}
+
+// String returns string representation of liveness record
+func (l ValidatorLiveness) String() string {
+	return fmt.Sprintf("ValidatorLiveness{%v window %v/%v missed %v total %v/%v}",
+		l.ID, l.WindowSigned, l.WindowBlocks, l.MissedWindows, l.TotalMissedWindows, l.TotalWindows)
+}
+
+// ValidatorUptime liveness record of validator along with its uptime and liveness status
+type ValidatorUptime struct {
+	ValidatorLiveness
+	Uptime sdk.Dec `json:"uptime" yaml:"uptime"`
+	Live   bool    `json:"live" yaml:"live"`
+}
+
+// NewValidatorUptime creates uptime of liveness record
+func NewValidatorUptime(liveness ValidatorLiveness, live bool) ValidatorUptime {
+	return ValidatorUptime{
+		ValidatorLiveness: liveness,
+		Uptime:            liveness.Uptime(),
+		Live:              live,
+	}
+}
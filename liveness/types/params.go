@@ -0,0 +1,94 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+)
+
+// Default parameter values
+var (
+	DefaultWindowSize         uint64 = 100
+	DefaultMinSignedPerWindow        = sdk.NewDecWithPrec(5, 1)
+	DefaultMaxMissedWindows   uint64 = 2
+)
+
+// Parameter keys
+var (
+	KeyWindowSize         = []byte("WindowSize")
+	KeyMinSignedPerWindow = []byte("MinSignedPerWindow")
+	KeyMaxMissedWindows   = []byte("MaxMissedWindows")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the liveness module.
+type Params struct {
+	WindowSize         uint64  `json:"window_size" yaml:"window_size"`                     // blocks per liveness window
+	MinSignedPerWindow sdk.Dec `json:"min_signed_per_window" yaml:"min_signed_per_window"` // fraction of window validator must precommit
+	MaxMissedWindows   uint64  `json:"max_missed_windows" yaml:"max_missed_windows"`       // consecutive missed windows after which validator is offline, 0 disables
+}
+
+// NewParams creates a new Params object
+func NewParams(windowSize uint64, minSignedPerWindow sdk.Dec, maxMissedWindows uint64) Params {
+	return Params{
+		WindowSize:         windowSize,
+		MinSignedPerWindow: minSignedPerWindow,
+		MaxMissedWindows:   maxMissedWindows,
+	}
+}
+
+// ParamKeyTable for liveness module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of liveness module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyWindowSize, &p.WindowSize},
+		{KeyMinSignedPerWindow, &p.MinSignedPerWindow},
+		{KeyMaxMissedWindows, &p.MaxMissedWindows},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultWindowSize, DefaultMinSignedPerWindow, DefaultMaxMissedWindows)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("WindowSize: %d\n", p.WindowSize))
+	sb.WriteString(fmt.Sprintf("MinSignedPerWindow: %s\n", p.MinSignedPerWindow))
+	sb.WriteString(fmt.Sprintf("MaxMissedWindows: %d\n", p.MaxMissedWindows))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.WindowSize == 0 {
+		return fmt.Errorf("WindowSize should be greater than zero")
+	}
+
+	if p.MinSignedPerWindow.IsNil() || p.MinSignedPerWindow.IsNegative() || p.MinSignedPerWindow.GT(sdk.OneDec()) {
+		return fmt.Errorf("MinSignedPerWindow should be between 0 and 1, is %s", p.MinSignedPerWindow)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the liveness Querier
+const (
+	QueryParams          = "params"
+	QueryValidatorUptime = "validator-uptime"
+	QueryUptimes         = "uptimes"
+)
+
+// QueryValidatorParams defines the params for querying uptime of validator
+type QueryValidatorParams struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+}
+
+// NewQueryValidatorParams creates a new instance of QueryValidatorParams.
+func NewQueryValidatorParams(validatorID hmTypes.ValidatorID) QueryValidatorParams {
+	return QueryValidatorParams{ValidatorID: validatorID}
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+const (
+	// BatchQueryPath runs several module queries in one request, at same height
+	BatchQueryPath = "/batch"
+
+	// MaxBatchQueries max queries of single batch request
+	MaxBatchQueries = 50
+
+	// maxBatchBodyBytes max body size of batch request
+	maxBatchBodyBytes = 1 << 20 // 1 MB
+
+	// batchQueryPrefix only module queriers can be queried in batch
+	batchQueryPrefix = "custom/"
+)
+
+// BatchQuery single module query of batch, eg. {"path": "custom/checkpoint/params"}.
+// Data is passed to module querier as is, as query params of module rest routes.
+type BatchQuery struct {
+	Path string          `json:"path"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// BatchQueryReq request of batch endpoint, queries run at latest height if height is 0
+type BatchQueryReq struct {
+	Height  int64        `json:"height,omitempty"`
+	Queries []BatchQuery `json:"queries"`
+}
+
+// BatchQueryResult result or error of single query, in order of queries of request
+type BatchQueryResult struct {
+	Path   string          `json:"path"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// BatchQueryResp response of batch endpoint
+type BatchQueryResp struct {
+	Height  int64              `json:"height"`
+	Results []BatchQueryResult `json:"results"`
+}
+
+// batchQueryFn queries module querier at height, returning height query ran at
+type batchQueryFn func(path string, data []byte, height int64) ([]byte, int64, error)
+
+// RegisterBatchRoute serves batch queries through ABCI query of connected node
+func RegisterBatchRoute(cliCtx context.CLIContext, r *mux.Router) {
+	query := func(path string, data []byte, height int64) ([]byte, int64, error) {
+		return cliCtx.WithHeight(height).QueryWithData(path, data)
+	}
+
+	latestHeight := func() (int64, error) {
+		status, err := helper.GetNodeStatus(cliCtx)
+		if err != nil {
+			return 0, err
+		}
+		return status.SyncInfo.LatestBlockHeight, nil
+	}
+
+	r.HandleFunc(BatchQueryPath, batchQueryHandler(query, latestHeight)).Methods(http.MethodPost)
+}
+
+// batchQueryHandler runs queries of request concurrently at single height, so results are consistent
+func batchQueryHandler(query batchQueryFn, latestHeight func() (int64, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req BatchQueryReq
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBatchBodyBytes)).Decode(&req); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if err := validateBatchQueries(req); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		height := req.Height
+		if height == 0 {
+			var err error
+			if height, err = latestHeight(); err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
+
+		resp := BatchQueryResp{
+			Height:  height,
+			Results: make([]BatchQueryResult, len(req.Queries)),
+		}
+
+		var wg sync.WaitGroup
+		for i, q := range req.Queries {
+			wg.Add(1)
+			go func(i int, q BatchQuery) {
+				defer wg.Done()
+				resp.Results[i] = runBatchQuery(query, q, height)
+			}(i, q)
+		}
+		wg.Wait()
+
+		output, err := json.Marshal(resp)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(output)
+	}
+}
+
+func validateBatchQueries(req BatchQueryReq) error {
+	if req.Height < 0 {
+		return errors.New("height must not be negative")
+	}
+
+	if len(req.Queries) == 0 {
+		return errors.New("no queries")
+	}
+
+	if len(req.Queries) > MaxBatchQueries {
+		return fmt.Errorf("too many queries: %v, max %v", len(req.Queries), MaxBatchQueries)
+	}
+
+	for _, q := range req.Queries {
+		if !strings.HasPrefix(strings.TrimPrefix(q.Path, "/"), batchQueryPrefix) {
+			return fmt.Errorf("invalid query path %q, only module queries (%s<module>/<query>) are allowed", q.Path, batchQueryPrefix)
+		}
+	}
+	return nil
+}
+
+func runBatchQuery(query batchQueryFn, q BatchQuery, height int64) BatchQueryResult {
+	result := BatchQueryResult{Path: q.Path}
+
+	var data []byte
+	if len(q.Data) > 0 && string(q.Data) != "null" {
+		data = q.Data
+	}
+
+	res, resHeight, err := query(strings.TrimPrefix(q.Path, "/"), data, height)
+	switch {
+	case err != nil:
+		result.Error = err.Error()
+	case resHeight != height:
+		result.Error = fmt.Sprintf("queried at height %v instead of %v", resHeight, height)
+	case len(res) == 0:
+		result.Result = json.RawMessage("null")
+	case json.Valid(res):
+		result.Result = res
+	default:
+		// non-json results are returned as base64 string
+		result.Result, _ = json.Marshal(res)
+	}
+	return result
+}
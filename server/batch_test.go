@@ -0,0 +1,78 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchQueryHandler(t *testing.T) {
+	t.Parallel()
+
+	query := func(path string, data []byte, height int64) ([]byte, int64, error) {
+		switch path {
+		case "custom/checkpoint/params":
+			return []byte(`{"checkpoint_buffer_time":"1000000000000"}`), height, nil
+		case "custom/checkpoint/ack-count":
+			return data, height, nil
+		case "custom/staking/pruned":
+			return nil, height - 1, nil
+		default:
+			return nil, height, errors.New("unknown query")
+		}
+	}
+	latestHeight := func() (int64, error) { return 100, nil }
+	handler := batchQueryHandler(query, latestHeight)
+
+	serve := func(body string) (int, BatchQueryResp) {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, BatchQueryPath, strings.NewReader(body)))
+
+		var resp BatchQueryResp
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		}
+		return w.Code, resp
+	}
+
+	code, resp := serve(`{"queries": [
+		{"path": "custom/checkpoint/params"},
+		{"path": "/custom/checkpoint/ack-count", "data": {"root_chain": "bsc"}},
+		{"path": "custom/unknown/query"},
+		{"path": "custom/staking/pruned"}
+	]}`)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, int64(100), resp.Height)
+	require.Len(t, resp.Results, 4)
+	require.JSONEq(t, `{"checkpoint_buffer_time":"1000000000000"}`, string(resp.Results[0].Result))
+	require.Empty(t, resp.Results[0].Error)
+	require.JSONEq(t, `{"root_chain": "bsc"}`, string(resp.Results[1].Result))
+	require.Equal(t, "unknown query", resp.Results[2].Error)
+	require.Contains(t, resp.Results[3].Error, "instead of 100")
+
+	// explicit height is kept
+	code, resp = serve(`{"height": 42, "queries": [{"path": "custom/checkpoint/params"}]}`)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, int64(42), resp.Height)
+
+	// only module queries, and limited number of them
+	code, _ = serve(`{"queries": [{"path": "store/acc/key"}]}`)
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serve(`{"queries": []}`)
+	require.Equal(t, http.StatusBadRequest, code)
+
+	queries := make([]BatchQuery, MaxBatchQueries+1)
+	for i := range queries {
+		queries[i].Path = "custom/checkpoint/params"
+	}
+	body, err := json.Marshal(BatchQueryReq{Queries: queries})
+	require.NoError(t, err)
+	code, _ = serve(string(body))
+	require.Equal(t, http.StatusBadRequest, code)
+}
@@ -0,0 +1,459 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+const (
+	// APIKeyHeader header which carries api key for tx endpoints
+	APIKeyHeader = "X-API-Key"
+
+	// idle limiters are dropped after this duration
+	limiterIdleTimeout = 10 * time.Minute
+
+	// saturatedRetryAfter is Retry-After (in seconds) sent when tx routes are saturated
+	saturatedRetryAfter = 1
+
+	// maxRequestSignatureAge max age (and clock skew) of signed tx requests
+	maxRequestSignatureAge = 30 * time.Second
+)
+
+// RegisterTxMiddlewares protects tx-posting routes with api key auth, per-IP rate limit,
+// body size cap, request signatures and in-flight request caps
+func RegisterTxMiddlewares(r *mux.Router, conf helper.Configuration) {
+	if keys := parseAPIKeys(conf.RestAPIKeys); len(keys) > 0 {
+		r.Use(apiKeyMiddleware(keys))
+	}
+
+	if conf.RestTxRateLimit > 0 {
+		r.Use(rateLimitMiddleware(newIPRateLimiter(conf.RestTxRateLimit, conf.RestTxRateBurst)))
+	}
+
+	if conf.RestTxMaxBodyBytes > 0 {
+		r.Use(maxBodyMiddleware(conf.RestTxMaxBodyBytes))
+	}
+
+	// body is read by signature check, so it goes after body cap
+	if conf.RestSignedRequests {
+		pubKeys := []crypto.PubKey{helper.GetPubKey()}
+		r.Use(signedRequestMiddleware(newRequestVerifier(parseAPIKeys(conf.RestSigningKeys), pubKeys, maxRequestSignatureAge)))
+	}
+
+	if conf.RestTxMaxInFlight > 0 || conf.RestTxRouteWorkers > 0 {
+		r.Use(inFlightMiddleware(newInFlightLimiter(conf.RestTxMaxInFlight, conf.RestTxRouteWorkers)))
+	}
+}
+
+// isTxRequest returns true for requests which post transactions
+func isTxRequest(r *http.Request) bool {
+	// batch queries only read state, they are capped by batch handler itself
+	if r.URL.Path == BatchQueryPath {
+		return false
+	}
+
+	switch r.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func parseAPIKeys(value string) (keys []string) {
+	for _, key := range strings.Split(value, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return
+}
+
+func apiKeyMiddleware(keys []string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isTxRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			given := []byte(r.Header.Get(APIKeyHeader))
+			for _, key := range keys {
+				if subtle.ConstantTimeCompare(given, []byte(key)) == 1 {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			hmRest.WriteErrorResponse(w, http.StatusUnauthorized, "missing or invalid api key")
+		})
+	}
+}
+
+func rateLimitMiddleware(limiter *ipRateLimiter) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isTxRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if ok, retryAfter := limiter.allow(clientIP(r), time.Now()); !ok {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				hmRest.WriteErrorResponse(w, http.StatusTooManyRequests, "rate limit exceeded")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func maxBodyMiddleware(maxBytes int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isTxRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if r.ContentLength > maxBytes {
+				hmRest.WriteErrorResponse(w, http.StatusRequestEntityTooLarge, "request body too large")
+				return
+			}
+
+			// handlers fail to read body beyond limit
+			r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func signedRequestMiddleware(verifier *requestVerifier) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isTxRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			if err := verifier.verify(r, body, time.Now()); err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusUnauthorized, err.Error())
+				return
+			}
+
+			// handlers read body again
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func inFlightMiddleware(limiter *inFlightLimiter) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isTxRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			release, ok := limiter.acquire(routeName(r))
+			if !ok {
+				w.Header().Set("Retry-After", strconv.Itoa(saturatedRetryAfter))
+				hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "server is busy, retry later")
+				return
+			}
+			defer release()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// routeName returns matched route template, falls back to request path
+func routeName(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			return tpl
+		}
+	}
+	return r.URL.Path
+}
+
+// clientIP returns remote ip of the request (proxy headers are not trusted)
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+//
+// Rate limiter
+//
+
+type tokenBucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// ipRateLimiter is token bucket rate limiter keyed by client ip
+type ipRateLimiter struct {
+	mu sync.Mutex
+
+	rate      float64
+	burst     float64
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+func newIPRateLimiter(rate float64, burst int) *ipRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &ipRateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// allow consumes a token for ip, returns wait duration if none available
+func (l *ipRateLimiter) allow(ip string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	bucket, ok := l.buckets[ip]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, lastSeen: now}
+		l.buckets[ip] = bucket
+	}
+
+	// refill tokens
+	elapsed := now.Sub(bucket.lastSeen).Seconds()
+	if elapsed > 0 {
+		bucket.tokens = math.Min(l.burst, bucket.tokens+elapsed*l.rate)
+	}
+	bucket.lastSeen = now
+
+	if bucket.tokens < 1 {
+		return false, time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+	}
+
+	bucket.tokens--
+	return true, 0
+}
+
+// sweep drops idle buckets to keep memory bounded
+func (l *ipRateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < limiterIdleTimeout {
+		return
+	}
+
+	for ip, bucket := range l.buckets {
+		if now.Sub(bucket.lastSeen) > limiterIdleTimeout {
+			delete(l.buckets, ip)
+		}
+	}
+	l.lastSweep = now
+}
+
+//
+// Request signatures
+//
+
+// requestVerifier verifies signatures of tx requests, made with one of hmac keys or by one of pub keys
+type requestVerifier struct {
+	mu sync.Mutex
+
+	hmacKeys  []string
+	pubKeys   []crypto.PubKey
+	maxAge    time.Duration
+	seen      map[[sha256.Size]byte]time.Time // payloads of accepted requests, rejected if replayed within max age
+	lastSweep time.Time
+}
+
+func newRequestVerifier(hmacKeys []string, pubKeys []crypto.PubKey, maxAge time.Duration) *requestVerifier {
+	return &requestVerifier{
+		hmacKeys: hmacKeys,
+		pubKeys:  pubKeys,
+		maxAge:   maxAge,
+		seen:     make(map[[sha256.Size]byte]time.Time),
+	}
+}
+
+// verify checks signature headers of request with body, same signed request is accepted once
+func (v *requestVerifier) verify(r *http.Request, body []byte, now time.Time) error {
+	timestamp, err := strconv.ParseInt(r.Header.Get(helper.RESTTimestampHeader), 10, 64)
+	if err != nil {
+		return errors.New("missing or invalid request timestamp")
+	}
+
+	signedAt := time.Unix(timestamp, 0)
+	if age := now.Sub(signedAt); age > v.maxAge || age < -v.maxAge {
+		return errors.New("request signature expired")
+	}
+
+	signature, err := hex.DecodeString(r.Header.Get(helper.RESTSignatureHeader))
+	if err != nil || len(signature) == 0 {
+		return errors.New("missing or invalid request signature")
+	}
+
+	payload := helper.RESTSigningPayload(r.Method, r.URL.RequestURI(), timestamp, body)
+	if !v.matches(payload, signature) {
+		return errors.New("invalid request signature")
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.sweep(now)
+
+	// keyed by payload, as ecdsa signatures of same payload differ
+	key := sha256.Sum256(payload)
+	if _, ok := v.seen[key]; ok {
+		return errors.New("request already submitted")
+	}
+	v.seen[key] = signedAt
+
+	return nil
+}
+
+// matches returns true if signature is hmac of one of keys or signature of one of pub keys
+func (v *requestVerifier) matches(payload []byte, signature []byte) bool {
+	for _, key := range v.hmacKeys {
+		if subtle.ConstantTimeCompare(signature, helper.RESTRequestHMAC(key, payload)) == 1 {
+			return true
+		}
+	}
+
+	for _, pubKey := range v.pubKeys {
+		if pubKey.VerifyBytes(payload, signature) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sweep drops payloads which are too old to be accepted anyway, at most once per max age
+func (v *requestVerifier) sweep(now time.Time) {
+	if now.Sub(v.lastSweep) < v.maxAge {
+		return
+	}
+
+	for key, signedAt := range v.seen {
+		if now.Sub(signedAt) > v.maxAge {
+			delete(v.seen, key)
+		}
+	}
+	v.lastSweep = now
+}
+
+//
+// In-flight limiter
+//
+
+// inFlightLimiter caps concurrent requests globally and per route (route worker pool)
+type inFlightLimiter struct {
+	mu sync.Mutex
+
+	global       chan struct{}
+	routeWorkers int
+	routes       map[string]chan struct{}
+}
+
+func newInFlightLimiter(maxInFlight int, routeWorkers int) *inFlightLimiter {
+	l := &inFlightLimiter{
+		routeWorkers: routeWorkers,
+		routes:       make(map[string]chan struct{}),
+	}
+
+	if maxInFlight > 0 {
+		l.global = make(chan struct{}, maxInFlight)
+	}
+
+	return l
+}
+
+// acquire takes a slot without blocking, returns release func if slot is available
+func (l *inFlightLimiter) acquire(route string) (func(), bool) {
+	if !tryAcquire(l.global) {
+		return nil, false
+	}
+
+	pool := l.routePool(route)
+	if !tryAcquire(pool) {
+		release(l.global)
+		return nil, false
+	}
+
+	return func() {
+		release(pool)
+		release(l.global)
+	}, true
+}
+
+// routePool returns worker pool for route, nil if route pools are disabled
+func (l *inFlightLimiter) routePool(route string) chan struct{} {
+	if l.routeWorkers <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	pool, ok := l.routes[route]
+	if !ok {
+		pool = make(chan struct{}, l.routeWorkers)
+		l.routes[route] = pool
+	}
+	return pool
+}
+
+// tryAcquire takes a slot from sem, nil sem is unlimited
+func tryAcquire(sem chan struct{}) bool {
+	if sem == nil {
+		return true
+	}
+
+	select {
+	case sem <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func release(sem chan struct{}) {
+	if sem != nil {
+		<-sem
+	}
+}
@@ -0,0 +1,212 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func TestIPRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := newIPRateLimiter(1, 2)
+	now := time.Now()
+
+	ok, _ := limiter.allow("1.1.1.1", now)
+	require.True(t, ok)
+	ok, _ = limiter.allow("1.1.1.1", now)
+	require.True(t, ok)
+
+	ok, retryAfter := limiter.allow("1.1.1.1", now)
+	require.False(t, ok, "burst should be exhausted")
+	require.True(t, retryAfter > 0)
+
+	// other ip has its own bucket
+	ok, _ = limiter.allow("2.2.2.2", now)
+	require.True(t, ok)
+
+	// refill after one second
+	ok, _ = limiter.allow("1.1.1.1", now.Add(time.Second))
+	require.True(t, ok)
+}
+
+func TestTxMiddlewares(t *testing.T) {
+	t.Parallel()
+
+	r := mux.NewRouter()
+	r.HandleFunc("/tx", func(w http.ResponseWriter, r *http.Request) {}).Methods("POST")
+	r.HandleFunc("/query", func(w http.ResponseWriter, r *http.Request) {}).Methods("GET")
+
+	RegisterTxMiddlewares(r, helper.Configuration{
+		RestAPIKeys:     "key1, key2",
+		RestTxRateLimit: 1,
+		RestTxRateBurst: 1,
+	})
+
+	serve := func(method, path, key string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = "1.1.1.1:1234"
+		if key != "" {
+			req.Header.Set(APIKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve("GET", "/query", ""), "queries are not protected")
+	require.Equal(t, http.StatusUnauthorized, serve("POST", "/tx", ""))
+	require.Equal(t, http.StatusUnauthorized, serve("POST", "/tx", "wrong"))
+	require.Equal(t, http.StatusOK, serve("POST", "/tx", "key2"))
+	require.Equal(t, http.StatusTooManyRequests, serve("POST", "/tx", "key1"))
+}
+
+func TestInFlightLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := newInFlightLimiter(2, 1)
+
+	releaseA, ok := limiter.acquire("/a")
+	require.True(t, ok)
+
+	_, ok = limiter.acquire("/a")
+	require.False(t, ok, "route pool should be exhausted")
+
+	releaseB, ok := limiter.acquire("/b")
+	require.True(t, ok)
+
+	_, ok = limiter.acquire("/c")
+	require.False(t, ok, "global cap should be exhausted")
+
+	releaseA()
+	releaseC, ok := limiter.acquire("/c")
+	require.True(t, ok)
+
+	releaseB()
+	releaseC()
+}
+
+func TestTxResourceLimits(t *testing.T) {
+	t.Parallel()
+
+	block := make(chan struct{})
+	started := make(chan struct{})
+
+	r := mux.NewRouter()
+	r.HandleFunc("/tx", func(w http.ResponseWriter, r *http.Request) {}).Methods("POST")
+	r.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-block
+	}).Methods("POST")
+
+	RegisterTxMiddlewares(r, helper.Configuration{
+		RestTxMaxBodyBytes: 8,
+		RestTxRouteWorkers: 1,
+	})
+
+	serve := func(path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", path, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, serve("/tx", "small").Code)
+	require.Equal(t, http.StatusRequestEntityTooLarge, serve("/tx", "too large body").Code)
+
+	// saturate slow route
+	go serve("/slow", "")
+	<-started
+
+	w := serve("/slow", "")
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	// other routes have own worker pool
+	require.Equal(t, http.StatusOK, serve("/tx", "").Code)
+
+	close(block)
+}
+
+func TestSignedRequestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	validatorKey := secp256k1.GenPrivKey()
+	var received string
+
+	r := mux.NewRouter()
+	r.HandleFunc("/tx", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received = string(body)
+	}).Methods("POST")
+	r.HandleFunc("/query", func(w http.ResponseWriter, r *http.Request) {}).Methods("GET")
+	r.Use(signedRequestMiddleware(newRequestVerifier([]string{"secret"}, []crypto.PubKey{validatorKey.PubKey()}, maxRequestSignatureAge)))
+
+	serve := func(method, path, body string, signer helper.RESTSigner, signedAt time.Time, tamper func(*http.Request)) int {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if signer != nil {
+			require.NoError(t, helper.SignRESTRequest(req, []byte(body), signer, signedAt))
+		}
+		if tamper != nil {
+			tamper(req)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	now := time.Now()
+	hmacSigner := helper.NewHMACRESTSigner("secret")
+	keySigner := helper.NewKeyRESTSigner(validatorKey)
+
+	require.Equal(t, http.StatusOK, serve("GET", "/query", "", nil, now, nil), "queries are not protected")
+	require.Equal(t, http.StatusUnauthorized, serve("POST", "/tx", "{}", nil, now, nil))
+
+	require.Equal(t, http.StatusOK, serve("POST", "/tx", `{"a":1}`, hmacSigner, now, nil))
+	require.Equal(t, `{"a":1}`, received, "body is passed to handler")
+	require.Equal(t, http.StatusOK, serve("POST", "/tx", `{"a":2}`, keySigner, now, nil))
+
+	// same signed request can't be replayed
+	require.Equal(t, http.StatusUnauthorized, serve("POST", "/tx", `{"a":1}`, hmacSigner, now, nil))
+
+	// unknown keys, expired signatures and tampered bodies are rejected
+	require.Equal(t, http.StatusUnauthorized, serve("POST", "/tx", `{"a":3}`, helper.NewHMACRESTSigner("other"), now, nil))
+	require.Equal(t, http.StatusUnauthorized, serve("POST", "/tx", `{"a":3}`, helper.NewKeyRESTSigner(secp256k1.GenPrivKey()), now, nil))
+	require.Equal(t, http.StatusUnauthorized, serve("POST", "/tx", `{"a":3}`, hmacSigner, now.Add(-time.Minute), nil))
+	require.Equal(t, http.StatusUnauthorized, serve("POST", "/tx", `{"a":3}`, hmacSigner, now, func(req *http.Request) {
+		req.Body = ioutil.NopCloser(strings.NewReader(`{"a":4}`))
+	}))
+}
+
+func TestRequestVerifierSweep(t *testing.T) {
+	t.Parallel()
+
+	v := newRequestVerifier(nil, nil, time.Minute)
+	now := time.Now()
+
+	v.seen[[32]byte{1}] = now.Add(-2 * time.Minute)
+	v.seen[[32]byte{2}] = now
+	v.sweep(now)
+	require.Len(t, v.seen, 1, "expired payload should be dropped")
+
+	// payloads are swept at most once per max age, not on every request
+	v.seen[[32]byte{3}] = now.Add(-2 * time.Minute)
+	v.sweep(now.Add(30 * time.Second))
+	require.Len(t, v.seen, 2)
+
+	v.sweep(now.Add(time.Minute))
+	require.Len(t, v.seen, 1)
+
+	v.sweep(now.Add(2 * time.Minute))
+	require.Empty(t, v.seen)
+}
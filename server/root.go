@@ -34,6 +34,10 @@ func ServeCommands(cdc *codec.Codec, registerRoutesFn func(*lcd.RestServer)) *co
 
 			rs := lcd.NewRestServer(cdc)
 			registerRoutesFn(rs)
+
+			// protect tx endpoints
+			RegisterTxMiddlewares(rs.Mux, helper.GetConfig())
+
 			logger := tmLog.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rest-server")
 			err := rs.Start(
 				viper.GetString(client.FlagListenAddr),
@@ -61,6 +65,7 @@ func RegisterRoutes(rs *lcd.RestServer) {
 
 	rpc.RegisterRPCRoutes(rs.CliCtx, rs.Mux)
 	tx.RegisterRoutes(rs.CliCtx, rs.Mux)
+	RegisterBatchRoute(rs.CliCtx, rs.Mux)
 
 	// auth.RegisterRoutes(rs.CliCtx, rs.Mux)
 	// bank.RegisterRoutes(rs.CliCtx, rs.Mux)
@@ -73,6 +78,9 @@ func RegisterRoutes(rs *lcd.RestServer) {
 	// register rest routes
 	app.ModuleBasics.RegisterRESTRoutes(rs.CliCtx, rs.Mux)
 
+	// serve query routes under root chain prefix, eg. /bsc/checkpoints/latest
+	RegisterRootChainScopedRoutes(rs.Mux)
+
 	// list all paths
 	// rs.Mux.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
 	// 	t, err := route.GetPathTemplate()
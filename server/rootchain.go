@@ -0,0 +1,41 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+// RegisterRootChainScopedRoutes serves query routes under root chain prefix, eg. /bsc/checkpoints/latest,
+// as the same route with ?root-chain= query param. It must be registered after all routes, so prefixed
+// paths only reach it when no other route matches.
+func RegisterRootChainScopedRoutes(r *mux.Router) {
+	r.PathPrefix("/{" + hmRest.RootChainVar + "}/").Methods(http.MethodGet).HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		rootChain := mux.Vars(req)[hmRest.RootChainVar]
+		if !hmRest.IsRootChainRegistered(rootChain) {
+			http.NotFound(w, req)
+			return
+		}
+
+		query := req.URL.Query()
+		if given := strings.ToLower(query.Get(hmRest.RootChainQueryParam)); given != "" && given != rootChain {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "conflicting root chains "+rootChain+" and "+given)
+			return
+		}
+		query.Set(hmRest.RootChainQueryParam, rootChain)
+
+		scoped := *req.URL
+		scoped.Path = strings.TrimPrefix(req.URL.Path, "/"+rootChain)
+		scoped.RawPath = ""
+		scoped.RawQuery = query.Encode()
+
+		scopedReq := req.WithContext(req.Context())
+		scopedReq.URL = &scoped
+		scopedReq.RequestURI = scoped.RequestURI()
+
+		r.ServeHTTP(w, scopedReq)
+	})
+}
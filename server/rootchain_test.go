@@ -0,0 +1,61 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+func TestRootChainScopedRoutes(t *testing.T) {
+	t.Parallel()
+
+	r := mux.NewRouter()
+	rootChainHandler := func(w http.ResponseWriter, r *http.Request) {
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+		_, _ = w.Write([]byte(rootChain))
+	}
+	r.HandleFunc("/checkpoints/latest", rootChainHandler).Methods("GET")
+	r.HandleFunc("/checkpoints/latest/{root}", rootChainHandler).Methods("GET")
+	RegisterRootChainScopedRoutes(r)
+
+	serve := func(path string) (int, string) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w.Code, w.Body.String()
+	}
+
+	tests := []struct {
+		path      string
+		code      int
+		rootChain string
+	}{
+		{"/checkpoints/latest", http.StatusOK, "eth"},
+		{"/checkpoints/latest/bsc", http.StatusOK, "bsc"},
+		{"/checkpoints/latest?root-chain=tron", http.StatusOK, "tron"},
+		{"/checkpoints/latest?root=bsc", http.StatusOK, "bsc"},
+		{"/bsc/checkpoints/latest", http.StatusOK, "bsc"},
+		{"/tron/checkpoints/latest/tron", http.StatusOK, "tron"},
+		{"/checkpoints/latest/polygon", http.StatusBadRequest, ""},
+		{"/checkpoints/latest?root-chain=polygon", http.StatusBadRequest, ""},
+		{"/checkpoints/latest/bsc?root-chain=eth", http.StatusBadRequest, ""},
+		{"/bsc/checkpoints/latest?root-chain=eth", http.StatusBadRequest, ""},
+		{"/polygon/checkpoints/latest", http.StatusNotFound, ""},
+		{"/bsc/checkpoints/unknown", http.StatusNotFound, ""},
+	}
+
+	for _, test := range tests {
+		code, body := serve(test.path)
+		require.Equal(t, test.code, code, test.path)
+		if test.code == http.StatusOK {
+			require.Equal(t, test.rootChain, body, test.path)
+		}
+	}
+}
@@ -11,6 +11,15 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	// params are optional for genesis files created before quorum params
+	if data.Params.DefaultQuorum != (types.Quorum{}) {
+		keeper.SetParams(ctx, data.Params)
+	}
+
+	// new chains process side-txs in delivery order from the start
+	keeper.enableTxOrder(ctx)
+	keeper.enableVoteVerification(ctx)
+
 	for _, pastCommit := range data.PastCommits {
 		// set all txs
 		if len(pastCommit.Txs) > 0 {
@@ -23,13 +32,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	// get all txs
+	// get all txs, in delivery order per height
 	txMap := make(map[int64]tmTypes.Txs)
 	keeper.IterateTxsAndApplyFn(ctx, func(height int64, tx tmTypes.Tx) error {
 		if _, ok := txMap[height]; !ok {
-			txMap[height] = make(tmTypes.Txs, 0)
+			txMap[height] = keeper.GetTxs(ctx, height)
 		}
-		txMap[height] = append(txMap[height], tx)
 		return nil
 	})
 
@@ -47,5 +55,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		return result[i].Height < result[j].Height
 	})
 
-	return types.NewGenesisState(result)
+	return types.NewGenesisState(result, keeper.GetParams(ctx))
 }
@@ -48,7 +48,7 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	// get random seed from time as source
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
-	genesisState = types.NewGenesisState(simulation.RandomPastCommits(r, 2, 5, 10))
+	genesisState = types.NewGenesisState(simulation.RandomPastCommits(r, 2, 5, 10), types.DefaultParams())
 	sidechannel.InitGenesis(ctx, app.SidechannelKeeper, genesisState)
 
 	actualParams = sidechannel.ExportGenesis(ctx, app.SidechannelKeeper)
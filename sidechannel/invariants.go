@@ -0,0 +1,64 @@
+package sidechannel
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+)
+
+// RegisterInvariants registers all sidechannel invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "tx-order", TxOrderInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the sidechannel module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return TxOrderInvariant(keeper)(ctx)
+	}
+}
+
+// TxOrderInvariant checks that every pending side-tx has exactly one position
+// in delivery order of its height once tx order is enabled, so side-txs are processed deterministically
+func TxOrderInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		if !keeper.IsTxOrderEnabled(ctx) {
+			return sdk.FormatInvariant(types.ModuleName, "tx-order", msg), broken
+		}
+
+		positions := make(map[int64]map[string]int)
+		keeper.IterateTxIndexesAndApplyFn(ctx, func(height int64, index uint64, hash []byte) error {
+			if _, ok := positions[height]; !ok {
+				positions[height] = make(map[string]int)
+			}
+			positions[height][string(hash)]++
+
+			if !keeper.HasTx(ctx, height, hash) {
+				broken = true
+				msg += fmt.Sprintf("\tindex %d at height %d points to missing tx %X\n", index, height, hash)
+			}
+
+			if i, ok := keeper.getTxIndex(ctx, height, hash); !ok || i != index {
+				broken = true
+				msg += fmt.Sprintf("\tindex %d at height %d is not indexed by hash of tx %X\n", index, height, hash)
+			}
+			return nil
+		})
+
+		keeper.IterateTxsAndApplyFn(ctx, func(height int64, tx tmTypes.Tx) error {
+			if count := positions[height][string(tx.Hash())]; count != 1 {
+				broken = true
+				msg += fmt.Sprintf("\ttx %X at height %d has %d positions in delivery order\n", tx.Hash(), height, count)
+			}
+			return nil
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "tx-order", msg), broken
+	}
+}
@@ -36,7 +36,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:        cdc,
 		key:        storeKey,
-		paramSpace: paramSpace,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:  codespace,
 	}
 }
@@ -51,6 +51,59 @@ func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
+//
+// Params methods
+//
+
+// SetParams sets the sidechannel module's parameters.
+func (keeper Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	keeper.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the sidechannel module's parameters.
+// Params are optional, default quorum applies until they are set by governance.
+func (keeper Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	keeper.paramSpace.GetIfExists(ctx, types.KeyDefaultQuorum, &params.DefaultQuorum)
+	keeper.paramSpace.GetIfExists(ctx, types.KeyMsgQuorums, &params.MsgQuorums)
+	keeper.paramSpace.GetIfExists(ctx, types.KeyMinParticipation, &params.MinParticipation)
+	return params
+}
+
+// GetQuorum returns side-tx vote quorum of msg type.
+// Subspace doesn't validate param changes, invalid quorum falls back to default one.
+func (keeper Keeper) GetQuorum(ctx sdk.Context, msgType string) types.Quorum {
+	params := keeper.GetParams(ctx)
+
+	quorum := params.GetQuorum(msgType)
+	if err := quorum.Validate(); err != nil {
+		keeper.Logger(ctx).Error("Invalid side-tx quorum, using default", "msgType", msgType, "quorum", quorum, "error", err)
+
+		quorum = params.DefaultQuorum
+		if quorum.Validate() != nil {
+			quorum = types.DefaultParams().DefaultQuorum
+		}
+	}
+
+	return quorum
+}
+
+// GetMinParticipation returns power which must not abstain from side-tx vote for abstains to be left
+// out of quorum, zero if abstaining is disabled. Invalid min participation disables abstaining.
+func (keeper Keeper) GetMinParticipation(ctx sdk.Context) types.Quorum {
+	minParticipation := keeper.GetParams(ctx).MinParticipation
+	if minParticipation == (types.Quorum{}) {
+		return minParticipation
+	}
+
+	if err := minParticipation.Validate(); err != nil {
+		keeper.Logger(ctx).Error("Invalid side-tx min participation, abstaining is disabled", "minParticipation", minParticipation, "error", err)
+		return types.Quorum{}
+	}
+
+	return minParticipation
+}
+
 //
 // Txs methods
 //
@@ -67,13 +120,61 @@ func (keeper Keeper) HasTx(ctx sdk.Context, height int64, hash []byte) bool {
 	return store.Has(types.TxStoreKey(height, hash))
 }
 
-// SetTx sets tx
+// SetTx sets tx and appends it to delivery order of the height once tx order is enabled
 func (keeper Keeper) SetTx(ctx sdk.Context, height int64, tx tmTypes.Tx) {
 	store := ctx.KVStore(keeper.key)
+	if keeper.IsTxOrderEnabled(ctx) && !keeper.HasTx(ctx, height, tx.Hash()) {
+		keeper.setTxIndex(ctx, height, tx.Hash())
+	}
 	store.Set(types.TxStoreKey(height, tx.Hash()), tx)
 }
 
-// GetTxs returns txs per height
+// IsTxOrderEnabled returns true if txs are processed in delivery order. It's enabled at genesis
+// of new chains and by store migration of existing ones, before that txs are processed in hash order.
+func (keeper Keeper) IsTxOrderEnabled(ctx sdk.Context) bool {
+	return ctx.KVStore(keeper.key).Has(types.TxOrderKey)
+}
+
+func (keeper Keeper) enableTxOrder(ctx sdk.Context) {
+	ctx.KVStore(keeper.key).Set(types.TxOrderKey, []byte{0x01})
+}
+
+// IsVoteVerificationEnabled returns true if votes on side-txs are verified by signature and vote
+// extensions before they are counted. It's enabled at genesis of new chains and by store migration
+// of existing ones, so nodes count same votes on blocks of either side of the upgrade.
+func (keeper Keeper) IsVoteVerificationEnabled(ctx sdk.Context) bool {
+	return ctx.KVStore(keeper.key).Has(types.VoteVerificationKey)
+}
+
+func (keeper Keeper) enableVoteVerification(ctx sdk.Context) {
+	ctx.KVStore(keeper.key).Set(types.VoteVerificationKey, []byte{0x01})
+}
+
+// setTxIndex appends tx hash to delivery order of the height
+func (keeper Keeper) setTxIndex(ctx sdk.Context, height int64, hash []byte) {
+	store := ctx.KVStore(keeper.key)
+	index := keeper.nextTxIndex(ctx, height)
+
+	store.Set(types.TxIndexStoreKey(height, index), hash)
+	store.Set(types.TxIndexByHashStoreKey(height, hash), sdk.Uint64ToBigEndian(index))
+}
+
+// nextTxIndex returns index for the next tx delivered at height
+func (keeper Keeper) nextTxIndex(ctx sdk.Context, height int64) uint64 {
+	store := ctx.KVStore(keeper.key)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.TxIndexesStoreKey(height))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+
+	prefixLength := len(types.TxIndexesStoreKey(height))
+	return binary.BigEndian.Uint64(iterator.Key()[prefixLength:]) + 1
+}
+
+// GetTxs returns txs per height in delivery order
 func (keeper Keeper) GetTxs(ctx sdk.Context, height int64) (txs tmTypes.Txs) {
 	// iterate through tx and append to txs
 	keeper.IterateTxAndApplyFn(ctx, height, func(tx tmTypes.Tx) error {
@@ -84,10 +185,24 @@ func (keeper Keeper) GetTxs(ctx sdk.Context, height int64) (txs tmTypes.Txs) {
 	return
 }
 
-// RemoveTx removes tx per height and hash
+// getTxIndex returns position of tx in delivery order of the height, false if tx has no position
+func (keeper Keeper) getTxIndex(ctx sdk.Context, height int64, hash []byte) (uint64, bool) {
+	index := ctx.KVStore(keeper.key).Get(types.TxIndexByHashStoreKey(height, hash))
+	if index == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(index), true
+}
+
+// RemoveTx removes tx per height and hash along with its position in delivery order
 func (keeper Keeper) RemoveTx(ctx sdk.Context, height int64, hash []byte) {
 	store := ctx.KVStore(keeper.key)
 	store.Delete(types.TxStoreKey(height, hash))
+
+	if index, ok := keeper.getTxIndex(ctx, height, hash); ok {
+		store.Delete(types.TxIndexStoreKey(height, index))
+		store.Delete(types.TxIndexByHashStoreKey(height, hash))
+	}
 }
 
 //
@@ -136,24 +251,61 @@ func (keeper Keeper) RemoveValidators(ctx sdk.Context, height int64) {
 // Iterators
 //
 
-// IterateTxAndApplyFn interate tx and apply the given function.
+// IterateTxAndApplyFn interate tx in delivery order and apply the given function.
+// Txs stored without index (all of them until tx order is enabled) are applied afterwards, ordered by hash.
 func (keeper Keeper) IterateTxAndApplyFn(ctx sdk.Context, height int64, f func(tmTypes.Tx) error) {
 	store := ctx.KVStore(keeper.key)
 
+	// collect hashes in delivery order
+	var hashes [][]byte
+	indexed := make(map[string]bool)
+	if keeper.IsTxOrderEnabled(ctx) {
+		indexIterator := sdk.KVStorePrefixIterator(store, types.TxIndexesStoreKey(height))
+		for ; indexIterator.Valid(); indexIterator.Next() {
+			hash := indexIterator.Value()
+			if !indexed[string(hash)] && store.Has(types.TxStoreKey(height, hash)) {
+				hashes = append(hashes, hash)
+				indexed[string(hash)] = true
+			}
+		}
+		indexIterator.Close()
+	}
+
 	// get sequence iterator
 	iterator := sdk.KVStorePrefixIterator(store, types.TxsStoreKey(height))
+	prefixLength := len(types.TxsStoreKey(height))
+	for ; iterator.Valid(); iterator.Next() {
+		if hash := iterator.Key()[prefixLength:]; !indexed[string(hash)] {
+			hashes = append(hashes, hash)
+		}
+	}
+	iterator.Close()
+
+	for _, hash := range hashes {
+		// call function and return if required
+		if err := f(store.Get(types.TxStoreKey(height, hash))); err != nil {
+			return
+		}
+	}
+}
+
+// IterateTxIndexesAndApplyFn interate tx indexes of all heights and apply the given function.
+func (keeper Keeper) IterateTxIndexesAndApplyFn(ctx sdk.Context, f func(height int64, index uint64, hash []byte) error) {
+	store := ctx.KVStore(keeper.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TxIndexKeyPrefix)
 	defer iterator.Close()
 
-	// loop through validators to get valid validators
+	prefixLength := len(types.TxIndexKeyPrefix)
 	for ; iterator.Valid(); iterator.Next() {
-		val := iterator.Value()
+		height := binary.BigEndian.Uint64(iterator.Key()[prefixLength : 8+prefixLength])
+		index := binary.BigEndian.Uint64(iterator.Key()[8+prefixLength:])
 
 		// call function and return if required
-		if err := f(val); err != nil {
+		if err := f(int64(height), index, iterator.Value()); err != nil {
 			return
 		}
 	}
-	return
 }
 
 // IterateTxsAndApplyFn interate all txs and apply the given function.
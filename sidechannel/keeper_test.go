@@ -1,7 +1,9 @@
 package sidechannel_test
 
 import (
+	"bytes"
 	"errors"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -12,6 +14,9 @@ import (
 	tmTypes "github.com/tendermint/tendermint/types"
 
 	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/sidechannel"
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
 )
 
 //
@@ -140,6 +145,113 @@ func (suite *KeeperTestSuite) TestTx() {
 	})
 }
 
+func (suite *KeeperTestSuite) TestTxDeliveryOrder() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	var height int64 = 30
+	var txs tmTypes.Txs
+	for i := 0; i < 10; i++ {
+		txs = append(txs, tmTypes.Tx([]byte("ordered-transaction-"+strconv.Itoa(i))))
+	}
+
+	// deliver in reverse order, so hash order differs from delivery order
+	for i := len(txs) - 1; i >= 0; i-- {
+		app.SidechannelKeeper.SetTx(ctx, height, txs[i])
+	}
+
+	// setting same tx again keeps its position
+	app.SidechannelKeeper.SetTx(ctx, height, txs[3])
+
+	result := app.SidechannelKeeper.GetTxs(ctx, height)
+	require.Equal(t, len(txs), len(result))
+	for i, tx := range result {
+		require.Equal(t, txs[len(txs)-1-i], tx, "Txs should be returned in delivery order")
+	}
+
+	_, broken := sidechannel.TxOrderInvariant(app.SidechannelKeeper)(ctx)
+	require.False(t, broken)
+
+	// removing tx keeps order of remaining txs
+	app.SidechannelKeeper.RemoveTx(ctx, height, txs[5].Hash())
+	result = app.SidechannelKeeper.GetTxs(ctx, height)
+	require.Equal(t, len(txs)-1, len(result))
+	require.Equal(t, -1, result.Index(txs[5]))
+	require.Equal(t, txs[9], result[0])
+	require.Equal(t, txs[0], result[len(result)-1])
+
+	_, broken = sidechannel.TxOrderInvariant(app.SidechannelKeeper)(ctx)
+	require.False(t, broken)
+
+	// new tx goes to the end
+	app.SidechannelKeeper.SetTx(ctx, height, txs[5])
+	result = app.SidechannelKeeper.GetTxs(ctx, height)
+	require.Equal(t, txs[5], result[len(result)-1])
+}
+
+func (suite *KeeperTestSuite) TestTxOrderMigration() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	// chain started before delivery order processes txs in hash order
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.TxOrderKey)
+	require.False(t, app.SidechannelKeeper.IsTxOrderEnabled(ctx))
+
+	var height int64 = 40
+	var txs tmTypes.Txs
+	for i := 0; i < 5; i++ {
+		txs = append(txs, tmTypes.Tx([]byte("migrated-transaction-"+strconv.Itoa(i))))
+	}
+	for i := len(txs) - 1; i >= 0; i-- {
+		app.SidechannelKeeper.SetTx(ctx, height, txs[i])
+	}
+
+	hashOrder := append(tmTypes.Txs{}, txs...)
+	sort.Slice(hashOrder, func(i, j int) bool {
+		return bytes.Compare(hashOrder[i].Hash(), hashOrder[j].Hash()) < 0
+	})
+	require.Equal(t, hashOrder, app.SidechannelKeeper.GetTxs(ctx, height))
+
+	app.SidechannelKeeper.IterateTxIndexesAndApplyFn(ctx, func(height int64, index uint64, hash []byte) error {
+		require.Fail(t, "Txs should not be indexed before migration")
+		return nil
+	})
+
+	// migration keeps hash order of pending txs and appends new ones
+	cfg := hmModule.NewConfigurator()
+	require.NoError(t, sidechannel.NewAppModule(app.SidechannelKeeper).RegisterMigrations(cfg))
+	fromVM := hmModule.GetVersionMap(app.GetModuleManager())
+	fromVM[types.ModuleName] = 1
+	_, err := cfg.RunMigrations(ctx, app.GetModuleManager(), fromVM)
+	require.NoError(t, err)
+	require.True(t, app.SidechannelKeeper.IsTxOrderEnabled(ctx))
+
+	app.SidechannelKeeper.RemoveTx(ctx, height, hashOrder[0].Hash())
+	app.SidechannelKeeper.SetTx(ctx, height, hashOrder[0])
+	require.Equal(t, append(hashOrder[1:], hashOrder[0]), app.SidechannelKeeper.GetTxs(ctx, height))
+
+	_, broken := sidechannel.TxOrderInvariant(app.SidechannelKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func (suite *KeeperTestSuite) TestVoteVerificationMigration() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	// new chains verify votes from genesis
+	require.True(t, app.SidechannelKeeper.IsVoteVerificationEnabled(ctx))
+
+	// chain started before verification counts votes as they are
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.VoteVerificationKey)
+	require.False(t, app.SidechannelKeeper.IsVoteVerificationEnabled(ctx))
+
+	cfg := hmModule.NewConfigurator()
+	require.NoError(t, sidechannel.NewAppModule(app.SidechannelKeeper).RegisterMigrations(cfg))
+	fromVM := hmModule.GetVersionMap(app.GetModuleManager())
+	fromVM[types.ModuleName] = 2
+	vm, err := cfg.RunMigrations(ctx, app.GetModuleManager(), fromVM)
+	require.NoError(t, err)
+	require.Equal(t, sidechannel.ConsensusVersion, vm[types.ModuleName])
+	require.True(t, app.SidechannelKeeper.IsVoteVerificationEnabled(ctx))
+}
+
 func (suite *KeeperTestSuite) TestValidators() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 
@@ -216,6 +328,52 @@ func (suite *KeeperTestSuite) TestValidators() {
 	})
 }
 
+func (suite *KeeperTestSuite) TestQuorum() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	defaultQuorum := types.DefaultParams().DefaultQuorum
+	require.Equal(t, defaultQuorum, app.SidechannelKeeper.GetQuorum(ctx, "checkpoint"))
+	require.Equal(t, int64(67), defaultQuorum.Threshold(100))
+
+	params := types.NewParams(types.NewQuorum(1, 2), []types.MsgQuorum{
+		{MsgType: "checkpoint", Quorum: types.NewQuorum(4, 5)},
+		{MsgType: "event-record", Quorum: types.NewQuorum(1, 0)},
+	})
+	app.SidechannelKeeper.SetParams(ctx, params)
+	require.Equal(t, params, app.SidechannelKeeper.GetParams(ctx))
+
+	require.Equal(t, types.NewQuorum(4, 5), app.SidechannelKeeper.GetQuorum(ctx, "checkpoint"))
+	require.Equal(t, types.NewQuorum(1, 2), app.SidechannelKeeper.GetQuorum(ctx, "checkpoint-ack"))
+	require.Equal(t, types.NewQuorum(1, 2), app.SidechannelKeeper.GetQuorum(ctx, "event-record"), "Invalid quorum should fall back to default quorum")
+}
+
+func (suite *KeeperTestSuite) TestMinParticipation() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	quorum := types.DefaultParams().DefaultQuorum
+
+	// abstains count against quorum until min participation is set
+	require.Equal(t, types.Quorum{}, app.SidechannelKeeper.GetMinParticipation(ctx))
+	require.Equal(t, int64(67), quorum.ThresholdWithAbstains(app.SidechannelKeeper.GetMinParticipation(ctx), 100, 30))
+
+	params := types.DefaultParams()
+	params.MinParticipation = types.NewQuorum(1, 2)
+	app.SidechannelKeeper.SetParams(ctx, params)
+	minParticipation := app.SidechannelKeeper.GetMinParticipation(ctx)
+	require.Equal(t, types.NewQuorum(1, 2), minParticipation)
+
+	// 30 abstained, 2/3 of remaining 70
+	require.Equal(t, int64(47), quorum.ThresholdWithAbstains(minParticipation, 100, 30))
+	// 50 abstained, remaining power doesn't reach min participation
+	require.Equal(t, int64(67), quorum.ThresholdWithAbstains(minParticipation, 100, 50))
+	require.Equal(t, int64(67), quorum.ThresholdWithAbstains(minParticipation, 100, 0))
+
+	params.MinParticipation = types.NewQuorum(1, 3)
+	require.Error(t, params.Validate())
+	app.SidechannelKeeper.SetParams(ctx, params)
+	require.Equal(t, types.Quorum{}, app.SidechannelKeeper.GetMinParticipation(ctx), "Invalid min participation should disable abstaining")
+}
+
 func (suite *KeeperTestSuite) TestLogger() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 
@@ -0,0 +1,37 @@
+package sidechannel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// migrateTxOrder indexes pending txs in hash order, the order they were processed in so far, and
+// enables delivery order (v1 -> v2). Txs stored from now on are appended to delivery order of their height.
+func migrateTxOrder(ctx sdk.Context, k Keeper) error {
+	// collect txs first, store must not be written while it's iterated
+	var heights []int64
+	var hashes [][]byte
+	k.IterateTxsAndApplyFn(ctx, func(height int64, tx tmTypes.Tx) error {
+		heights = append(heights, height)
+		hashes = append(hashes, tx.Hash())
+		return nil
+	})
+
+	for i, hash := range hashes {
+		if _, ok := k.getTxIndex(ctx, heights[i], hash); !ok {
+			k.setTxIndex(ctx, heights[i], hash)
+		}
+	}
+
+	k.enableTxOrder(ctx)
+	k.Logger(ctx).Info("Enabled side-tx delivery order", "indexedTxs", len(hashes))
+	return nil
+}
+
+// migrateVoteVerification enables verification of side-tx votes (v2 -> v3). Votes on txs of blocks
+// from now on are counted only if they are signed by their validators and pass vote extensions.
+func migrateVoteVerification(ctx sdk.Context, k Keeper) error {
+	k.enableVoteVerification(ctx)
+	k.Logger(ctx).Info("Enabled side-tx vote verification")
+	return nil
+}
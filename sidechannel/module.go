@@ -13,6 +13,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/maticnetwork/heimdall/auth/simulation"
+	sidechannelCli "github.com/maticnetwork/heimdall/sidechannel/client/cli"
+	sidechannelRest "github.com/maticnetwork/heimdall/sidechannel/client/rest"
 	"github.com/maticnetwork/heimdall/sidechannel/types"
 	hmModule "github.com/maticnetwork/heimdall/types/module"
 	simTypes "github.com/maticnetwork/heimdall/types/simulation"
@@ -23,8 +25,13 @@ var (
 	_ module.AppModuleBasic        = AppModuleBasic{}
 	_ hmModule.HeimdallModuleBasic = AppModule{}
 	_ hmModule.AppModuleSimulation = AppModule{}
+	_ hmModule.HasConsensusVersion = AppModule{}
+	_ hmModule.HasMigrations       = AppModule{}
 )
 
+// ConsensusVersion store layout version of the module
+const ConsensusVersion uint64 = 3
+
 // AppModuleBasic defines the basic application module used by the auth module.
 type AppModuleBasic struct{}
 
@@ -63,7 +70,7 @@ func (AppModuleBasic) VerifyGenesis(bz map[string]json.RawMessage) error {
 
 // RegisterRESTRoutes registers the REST routes for the auth module.
 func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
-
+	sidechannelRest.RegisterRoutes(ctx, rtr)
 }
 
 // GetTxCmd returns the root tx command for the auth module.
@@ -73,7 +80,7 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 // GetQueryCmd returns the root query command for the auth module.
 func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	return sidechannelCli.GetQueryCmd(cdc)
 }
 
 //____________________________________________________________________________
@@ -98,8 +105,10 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers sidechannel invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the auth module.
 func (AppModule) Route() string {
@@ -118,7 +127,29 @@ func (AppModule) QuerierRoute() string {
 
 // NewQuerierHandler returns the auth module sdk.Querier.
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return nil
+	return NewQuerier(am.keeper)
+}
+
+// ConsensusVersion returns store layout version of the sidechannel module
+func (AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+// RegisterMigrations registers store migrations of the sidechannel module.
+// Bump ConsensusVersion and register migration from previous version
+// whenever key layout of the module changes.
+func (am AppModule) RegisterMigrations(cfg *hmModule.Configurator) error {
+	// v1 -> v2: side-txs are processed in delivery order
+	if err := cfg.RegisterMigration(types.ModuleName, 1, func(ctx sdk.Context) error {
+		return migrateTxOrder(ctx, am.keeper)
+	}); err != nil {
+		return err
+	}
+
+	// v2 -> v3: votes on side-txs are verified before they are counted
+	return cfg.RegisterMigration(types.ModuleName, 2, func(ctx sdk.Context) error {
+		return migrateVoteVerification(ctx, am.keeper)
+	})
 }
 
 // InitGenesis performs genesis initialization for the auth module. It returns
@@ -58,13 +58,13 @@ func (suite *ModuleTestSuite) TestGetTxCmd() {
 func (suite *ModuleTestSuite) GetQueryCmd() {
 	t, module := suite.T(), suite.module
 
-	require.Nil(t, module.GetQueryCmd(sidechannelTypes.ModuleCdc))
+	require.NotNil(t, module.GetQueryCmd(sidechannelTypes.ModuleCdc))
 }
 
 func (suite *ModuleTestSuite) TestInitGenesis() {
 	t, ctx, module := suite.T(), suite.ctx, suite.module
 
-	data := sidechannelTypes.NewGenesisState([]sidechannelTypes.PastCommit{{Height: 23}})
+	data := sidechannelTypes.NewGenesisState([]sidechannelTypes.PastCommit{{Height: 23}}, sidechannelTypes.DefaultParams())
 	genesisState := sidechannelTypes.ModuleCdc.MustMarshalJSON(data)
 
 	// init genesis
@@ -72,7 +72,7 @@ func (suite *ModuleTestSuite) TestInitGenesis() {
 		module.InitGenesis(ctx, genesisState)
 	}, "Init genesis should not panic")
 
-	data = sidechannelTypes.NewGenesisState([]sidechannelTypes.PastCommit{{Height: 122, Txs: []tmTypes.Tx{[]byte("test-tx122")}}})
+	data = sidechannelTypes.NewGenesisState([]sidechannelTypes.PastCommit{{Height: 122, Txs: []tmTypes.Tx{[]byte("test-tx122")}}}, sidechannelTypes.DefaultParams())
 	genesisState = sidechannelTypes.ModuleCdc.MustMarshalJSON(data)
 
 	// init genesis
@@ -94,7 +94,7 @@ func (suite *ModuleTestSuite) TestExportGenesisWithoutPastCommit() {
 	require.Equal(t, json.RawMessage(genesisState), actualParams, "Default export should be default genesis state")
 
 	// genesis state with past commits
-	gs1 := sidechannelTypes.NewGenesisState([]sidechannelTypes.PastCommit{{Height: 23}})
+	gs1 := sidechannelTypes.NewGenesisState([]sidechannelTypes.PastCommit{{Height: 23}}, sidechannelTypes.DefaultParams())
 	genesisState1 := sidechannelTypes.ModuleCdc.MustMarshalJSON(gs1)
 
 	// init/export genesis
@@ -109,7 +109,7 @@ func (suite *ModuleTestSuite) TestExportGenesis() {
 	t, ctx, module := suite.T(), suite.ctx, suite.module
 
 	// genesis state with past commits
-	gs := sidechannelTypes.NewGenesisState(simulation.RandomPastCommits(suite.r, 2, 5, 5))
+	gs := sidechannelTypes.NewGenesisState(simulation.RandomPastCommits(suite.r, 2, 5, 5), sidechannelTypes.DefaultParams())
 	genesisState := sidechannelTypes.ModuleCdc.MustMarshalJSON(gs)
 
 	// init/export genesis
@@ -15,23 +15,32 @@ type PastCommit struct {
 // GenesisState is the sidechannel state that must be provided at genesis.
 type GenesisState struct {
 	PastCommits []PastCommit `json:"past_commits" yaml:"past_commits"`
+	Params      Params       `json:"params" yaml:"params"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(pastCommits []PastCommit) GenesisState {
+func NewGenesisState(pastCommits []PastCommit, params Params) GenesisState {
 	return GenesisState{
 		PastCommits: pastCommits,
+		Params:      params,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(make([]PastCommit, 0))
+	return NewGenesisState(make([]PastCommit, 0), DefaultParams())
 }
 
 // ValidateGenesis performs basic validation of topup genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	// params are optional for genesis files created before quorum params
+	if data.Params.DefaultQuorum != (Quorum{}) || len(data.Params.MsgQuorums) > 0 {
+		if err := data.Params.Validate(); err != nil {
+			return err
+		}
+	}
+
 	for _, pastCommit := range data.PastCommits {
 		if pastCommit.Height <= 2 {
 			return fmt.Errorf("Past commit height must be greater 2")
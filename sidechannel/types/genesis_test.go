@@ -18,7 +18,7 @@ func TestDefaultGenesisState(t *testing.T) {
 }
 
 func TestNewGenesisState(t *testing.T) {
-	genesis := types.NewGenesisState([]types.PastCommit{{Height: 2}})
+	genesis := types.NewGenesisState([]types.PastCommit{{Height: 2}}, types.DefaultParams())
 	require.NotNil(t, genesis, "NewGenesisState should not return nil response")
 	require.Equal(t, 1, len(genesis.PastCommits), "NewGenesisState should create proper pastcommits")
 
@@ -30,23 +30,29 @@ func TestValidateGenesis(t *testing.T) {
 	emptyGenesis := types.GenesisState{}
 	require.Nil(t, types.ValidateGenesis(emptyGenesis), "Empty genesis should be valid genesis")
 
-	emptyGenesis = types.NewGenesisState(make([]types.PastCommit, 0))
+	emptyGenesis = types.NewGenesisState(make([]types.PastCommit, 0), types.DefaultParams())
 	require.Nil(t, types.ValidateGenesis(emptyGenesis), "Empty genesis should be valid genesis (using NewGenesisState)")
 
-	genesis := types.NewGenesisState([]types.PastCommit{{Height: 2}})
+	genesis := types.NewGenesisState([]types.PastCommit{{Height: 2}}, types.DefaultParams())
 	err := types.ValidateGenesis(genesis)
 	require.Error(t, err, "PastCommit object with height 2 should not be allowed")
 
 	// get random seed from time as source
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
-	genesis = types.NewGenesisState(simulation.RandomPastCommits(r, 10, 0, 0))
+	genesis = types.NewGenesisState(simulation.RandomPastCommits(r, 10, 0, 0), types.DefaultParams())
 	err = types.ValidateGenesis(genesis)
 	require.Error(t, err, "PastCommit object without should not be allowed")
 
-	genesis = types.NewGenesisState(simulation.RandomPastCommits(r, 10, 5, 0))
+	genesis = types.NewGenesisState(simulation.RandomPastCommits(r, 10, 5, 0), types.DefaultParams())
 	err = types.ValidateGenesis(genesis)
 	require.Equal(t, 10, len(genesis.PastCommits))
 	require.Equal(t, 5, len(genesis.PastCommits[0].Txs))
 	require.Nil(t, err, "PastCommit object with txs should not throw an error")
+
+	genesis = types.NewGenesisState(nil, types.NewParams(types.NewQuorum(1, 3), nil))
+	require.Error(t, types.ValidateGenesis(genesis), "Quorum less than majority should not be allowed")
+
+	genesis = types.NewGenesisState(nil, types.NewParams(types.DefaultParams().DefaultQuorum, []types.MsgQuorum{{MsgType: "checkpoint", Quorum: types.NewQuorum(3, 0)}}))
+	require.Error(t, types.ValidateGenesis(genesis), "Quorum with zero denominator should not be allowed")
 }
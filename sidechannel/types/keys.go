@@ -28,6 +28,18 @@ var (
 
 	// ValidatorsKeyPrefix prefix for validators
 	ValidatorsKeyPrefix = []byte{0x02}
+
+	// TxIndexKeyPrefix prefix for tx index (delivery order) within height
+	TxIndexKeyPrefix = []byte{0x03}
+
+	// TxIndexByHashKeyPrefix prefix for index of tx within height by tx hash
+	TxIndexByHashKeyPrefix = []byte{0x04}
+
+	// TxOrderKey key set once txs are indexed in delivery order, until then txs are processed in hash order
+	TxOrderKey = []byte{0x05}
+
+	// VoteVerificationKey key set once signatures of side-tx votes are verified before they are counted
+	VoteVerificationKey = []byte{0x06}
 )
 
 // TxStoreKey returns key used to get tx from store
@@ -48,6 +60,39 @@ func TxsStoreKey(height int64) []byte {
 	return result
 }
 
+// TxIndexStoreKey returns key used to get tx hash by index from store
+func TxIndexStoreKey(height int64, index uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, index)
+
+	result := TxIndexesStoreKey(height)
+	result = append(result, b...)
+	return result
+}
+
+// TxIndexesStoreKey returns key used to get tx indexes from store
+func TxIndexesStoreKey(height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+
+	result := []byte{}
+	result = append(result, TxIndexKeyPrefix...)
+	result = append(result, b...)
+	return result
+}
+
+// TxIndexByHashStoreKey returns key used to get index of tx by hash from store
+func TxIndexByHashStoreKey(height int64, hash []byte) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+
+	result := []byte{}
+	result = append(result, TxIndexByHashKeyPrefix...)
+	result = append(result, b...)
+	result = append(result, hash...)
+	return result
+}
+
 // ValidatorsKey returns key used to get past-validators from store
 func ValidatorsKey(height int64) []byte {
 	b := make([]byte, 8)
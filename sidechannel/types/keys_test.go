@@ -40,3 +40,26 @@ func TestValidatorsKey(t *testing.T) {
 	data := binary.BigEndian.Uint64(validatorsKey[1:9])
 	require.Equal(t, uint64(120), data, "ValidatorsKey should have valid height in key")
 }
+
+func TestTxIndexStoreKey(t *testing.T) {
+	txIndexStoreKey := types.TxIndexStoreKey(120, 3)
+
+	require.Equal(t, 17, len(txIndexStoreKey), "TxIndexStoreKey should be enough length")
+	require.Equal(t, types.TxIndexKeyPrefix, txIndexStoreKey[:1], "TxIndexStoreKey should have valid prefix")
+	require.Equal(t, types.TxIndexesStoreKey(120), txIndexStoreKey[:9], "TxIndexStoreKey should have valid height in key")
+
+	data := binary.BigEndian.Uint64(txIndexStoreKey[9:17])
+	require.Equal(t, uint64(3), data, "TxIndexStoreKey should have valid index in key")
+}
+
+func TestTxIndexByHashStoreKey(t *testing.T) {
+	hash := []byte("tx-hash")
+	txIndexByHashStoreKey := types.TxIndexByHashStoreKey(120, hash)
+
+	require.Equal(t, 9+len(hash), len(txIndexByHashStoreKey), "TxIndexByHashStoreKey should be enough length")
+	require.Equal(t, types.TxIndexByHashKeyPrefix, txIndexByHashStoreKey[:1], "TxIndexByHashStoreKey should have valid prefix")
+
+	data := binary.BigEndian.Uint64(txIndexByHashStoreKey[1:9])
+	require.Equal(t, uint64(120), data, "TxIndexByHashStoreKey should have valid height in key")
+	require.Equal(t, hash, txIndexByHashStoreKey[9:], "TxIndexByHashStoreKey should have valid hash in key")
+}
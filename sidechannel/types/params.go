@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+)
+
+// Default parameter values
+const (
+	DefaultQuorumNumerator   uint64 = 2
+	DefaultQuorumDenominator uint64 = 3
+)
+
+// Parameter keys
+var (
+	KeyDefaultQuorum = []byte("DefaultQuorum")
+	KeyMsgQuorums    = []byte("MsgQuorums")
+
+	KeyMinParticipation = []byte("MinParticipation")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Quorum super-majority of voting power required to approve or reject side-tx.
+// Side-tx result needs strictly more than numerator/denominator of total power.
+type Quorum struct {
+	Numerator   uint64 `json:"numerator" yaml:"numerator"`
+	Denominator uint64 `json:"denominator" yaml:"denominator"`
+}
+
+// NewQuorum creates new quorum
+func NewQuorum(numerator uint64, denominator uint64) Quorum {
+	return Quorum{
+		Numerator:   numerator,
+		Denominator: denominator,
+	}
+}
+
+// Threshold returns min signed power required by quorum
+func (q Quorum) Threshold(totalPower int64) int64 {
+	return totalPower*int64(q.Numerator)/int64(q.Denominator) + 1
+}
+
+// ThresholdWithAbstains returns min signed power required by quorum when validators with abstain power
+// voted skip, eg. as root chain was unreachable. Abstains are left out of total power once power of
+// validators which didn't abstain reaches min participation, zero min participation disables abstaining.
+func (q Quorum) ThresholdWithAbstains(minParticipation Quorum, totalPower int64, abstainPower int64) int64 {
+	if minParticipation == (Quorum{}) || abstainPower <= 0 {
+		return q.Threshold(totalPower)
+	}
+
+	participatingPower := totalPower - abstainPower
+	if participatingPower < minParticipation.Threshold(totalPower) {
+		return q.Threshold(totalPower)
+	}
+
+	return q.Threshold(participatingPower)
+}
+
+// Validate checks quorum is at least a majority and can be reached
+func (q Quorum) Validate() error {
+	if q.Denominator == 0 {
+		return fmt.Errorf("quorum denominator must be positive")
+	}
+
+	if q.Numerator >= q.Denominator {
+		return fmt.Errorf("quorum %v must be less than 1", q)
+	}
+
+	if q.Numerator*2 < q.Denominator {
+		return fmt.Errorf("quorum %v must be at least 1/2", q)
+	}
+
+	return nil
+}
+
+// String returns human readable string
+func (q Quorum) String() string {
+	return fmt.Sprintf("%d/%d", q.Numerator, q.Denominator)
+}
+
+// MsgQuorum quorum of side-tx msg type
+type MsgQuorum struct {
+	MsgType string `json:"msg_type" yaml:"msg_type"`
+	Quorum  Quorum `json:"quorum" yaml:"quorum"`
+}
+
+// Params defines the parameters for the sidechannel module.
+type Params struct {
+	DefaultQuorum    Quorum      `json:"default_quorum" yaml:"default_quorum"`
+	MsgQuorums       []MsgQuorum `json:"msg_quorums" yaml:"msg_quorums"`
+	MinParticipation Quorum      `json:"min_participation" yaml:"min_participation"` // power which must not abstain for abstains to be left out of quorum, zero disables abstaining
+}
+
+// NewParams creates a new Params object
+func NewParams(defaultQuorum Quorum, msgQuorums []MsgQuorum) Params {
+	return Params{
+		DefaultQuorum: defaultQuorum,
+		MsgQuorums:    msgQuorums,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of sidechannel module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyDefaultQuorum, &p.DefaultQuorum},
+		{KeyMsgQuorums, &p.MsgQuorums},
+		{KeyMinParticipation, &p.MinParticipation},
+	}
+}
+
+// GetQuorum returns quorum of msg type, default quorum if msg type has no quorum
+func (p Params) GetQuorum(msgType string) Quorum {
+	for _, q := range p.MsgQuorums {
+		if q.MsgType == msgType {
+			return q.Quorum
+		}
+	}
+
+	return p.DefaultQuorum
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("DefaultQuorum: %s\n", p.DefaultQuorum))
+	for _, q := range p.MsgQuorums {
+		sb.WriteString(fmt.Sprintf("MsgQuorum: %s %s\n", q.MsgType, q.Quorum))
+	}
+	sb.WriteString(fmt.Sprintf("MinParticipation: %s\n", p.MinParticipation))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := p.DefaultQuorum.Validate(); err != nil {
+		return fmt.Errorf("invalid default quorum: %v", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, q := range p.MsgQuorums {
+		if q.MsgType == "" {
+			return fmt.Errorf("msg type of quorum must be present")
+		}
+
+		if seen[q.MsgType] {
+			return fmt.Errorf("duplicate quorum of msg type %v", q.MsgType)
+		}
+		seen[q.MsgType] = true
+
+		if err := q.Quorum.Validate(); err != nil {
+			return fmt.Errorf("invalid quorum of msg type %v: %v", q.MsgType, err)
+		}
+	}
+
+	if p.MinParticipation != (Quorum{}) {
+		if err := p.MinParticipation.Validate(); err != nil {
+			return fmt.Errorf("invalid min participation: %v", err)
+		}
+	}
+
+	return nil
+}
+
+//
+// Extra functions
+//
+
+// ParamKeyTable for sidechannel module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		DefaultQuorum: NewQuorum(DefaultQuorumNumerator, DefaultQuorumDenominator),
+	}
+}
@@ -0,0 +1,6 @@
+package types
+
+// query endpoints supported by the sidechannel Querier
+const (
+	QueryParams = "params"
+)
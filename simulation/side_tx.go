@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Side-tx vote results are randomized with these weights (percent), validators mostly agree on
+// root chain state but side-txs are rejected or skipped every now and then.
+const (
+	SideTxYesWeight  = 70
+	SideTxNoWeight   = 20
+	SideTxSkipWeight = 10
+)
+
+// RandomSideTxResult returns random result of side-tx vote
+func RandomSideTxResult(r *rand.Rand) abci.SideTxResultType {
+	x := r.Intn(SideTxYesWeight + SideTxNoWeight + SideTxSkipWeight)
+	switch {
+	case x < SideTxYesWeight:
+		return abci.SideTxResultType_Yes
+	case x < SideTxYesWeight+SideTxNoWeight:
+		return abci.SideTxResultType_No
+	default:
+		return abci.SideTxResultType_Skip
+	}
+}
+
+// DeliverSideTx runs msg through handler and, if it's accepted, through post-tx handler with
+// randomized side-tx vote result, the way side-tx is processed over two blocks on chain.
+// Side-tx handler is skipped as it only verifies msg against root chain.
+func DeliverSideTx(r *rand.Rand, ctx sdk.Context, handler sdk.Handler, postHandler hmTypes.PostTxHandler, msg sdk.Msg) (abci.SideTxResultType, sdk.Result) {
+	if res := handler(ctx, msg); !res.IsOK() {
+		return abci.SideTxResultType_Skip, res
+	}
+
+	sideTxResult := RandomSideTxResult(r)
+	return sideTxResult, postHandler(ctx, msg, sideTxResult)
+}
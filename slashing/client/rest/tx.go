@@ -29,6 +29,11 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/slashing/tick-ack",
 		newTickAckHandler(cliCtx),
 	).Methods("POST")
+
+	r.HandleFunc(
+		"/slashing/evidence",
+		newSubmitEvidenceHandler(cliCtx),
+	).Methods("POST")
 }
 
 // Unjail TX body
@@ -57,6 +62,14 @@ type TickAckReq struct {
 	BlockNumber uint64       `json:"block_number" yaml:"block_number"`
 }
 
+type SubmitEvidenceReq struct {
+	BaseReq      rest.BaseReq     `json:"base_req"`
+	ValidatorID  uint64           `json:"validator_id"`
+	EvidenceType string           `json:"evidence_type"`
+	VoteA        types.SideTxVote `json:"vote_a"`
+	VoteB        types.SideTxVote `json:"vote_b"`
+}
+
 func newUnjailRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// read req from Request
@@ -138,3 +151,32 @@ func newTickAckHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func newSubmitEvidenceHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// read req from Request
+		var req SubmitEvidenceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgSubmitEvidence(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.ValidatorID,
+			req.EvidenceType,
+			req.VoteA,
+			req.VoteB,
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,115 @@
+package slashing_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func signSideTxVote(t *testing.T, privKey secp256k1.PrivKeySecp256k1, vote slashingTypes.SideTxVote) slashingTypes.SideTxVote {
+	ecdsaKey, err := crypto.ToECDSA(privKey[:])
+	require.NoError(t, err)
+
+	sig, err := crypto.Sign(crypto.Keccak256(vote.SignBytes()), ecdsaKey)
+	require.NoError(t, err)
+
+	vote.Sig = sig
+	return vote
+}
+
+func checkpointSideSignBytes(proposer []byte, start, end uint64, root []byte) []byte {
+	words := [][]byte{
+		proposer,
+		new(big.Int).SetUint64(start).Bytes(),
+		new(big.Int).SetUint64(end).Bytes(),
+		root,
+		{},
+		{},
+		{},
+	}
+
+	var data []byte
+	for _, word := range words {
+		padded := make([]byte, 32)
+		copy(padded[32-len(word):], word)
+		data = append(data, padded...)
+	}
+	return data
+}
+
+func TestConflictingSideTxVoteEvidence(t *testing.T) {
+	t.Parallel()
+
+	privKey := secp256k1.GenPrivKey()
+	signer := hmTypes.BytesToHeimdallAddress(privKey.PubKey().Address().Bytes())
+	txHash := hmTypes.HexToHexBytes("0x01")
+
+	yes := signSideTxVote(t, privKey, slashingTypes.SideTxVote{TxHash: txHash, Result: int32(abci.SideTxResultType_Yes)})
+	no := signSideTxVote(t, privKey, slashingTypes.SideTxVote{TxHash: txHash, Result: int32(abci.SideTxResultType_No)})
+
+	recovered, err := yes.Signer()
+	require.NoError(t, err)
+	require.Equal(t, signer, recovered)
+
+	require.NoError(t, slashingTypes.ValidateSideTxEvidence(slashingTypes.EvidenceTypeConflictingVote, yes, no))
+	require.Error(t, slashingTypes.ValidateSideTxEvidence(slashingTypes.EvidenceTypeConflictingVote, yes, yes), "same result is not evidence")
+
+	other := signSideTxVote(t, privKey, slashingTypes.SideTxVote{TxHash: hmTypes.HexToHexBytes("0x02"), Result: int32(abci.SideTxResultType_No)})
+	require.Error(t, slashingTypes.ValidateSideTxEvidence(slashingTypes.EvidenceTypeConflictingVote, yes, other), "different txs are not evidence")
+
+	// evidence hash doesn't depend on vote order
+	require.Equal(t,
+		slashingTypes.SideTxEvidenceHash(slashingTypes.EvidenceTypeConflictingVote, yes, no),
+		slashingTypes.SideTxEvidenceHash(slashingTypes.EvidenceTypeConflictingVote, no, yes),
+	)
+
+	msg := slashingTypes.NewMsgSubmitEvidence(signer, 1, slashingTypes.EvidenceTypeConflictingVote, yes, no)
+	require.Nil(t, msg.ValidateBasic())
+}
+
+func TestDuplicateCheckpointEvidence(t *testing.T) {
+	t.Parallel()
+
+	privKey := secp256k1.GenPrivKey()
+	proposer := privKey.PubKey().Address().Bytes()
+
+	voteA := signSideTxVote(t, privKey, slashingTypes.SideTxVote{
+		TxHash: hmTypes.HexToHexBytes("0x01"),
+		Result: int32(abci.SideTxResultType_Yes),
+		Data:   checkpointSideSignBytes(proposer, 0, 255, hmTypes.HexToHeimdallHash("0xaa").Bytes()),
+	})
+	voteB := signSideTxVote(t, privKey, slashingTypes.SideTxVote{
+		TxHash: hmTypes.HexToHexBytes("0x02"),
+		Result: int32(abci.SideTxResultType_Yes),
+		Data:   checkpointSideSignBytes(proposer, 0, 255, hmTypes.HexToHeimdallHash("0xbb").Bytes()),
+	})
+	require.NoError(t, slashingTypes.ValidateSideTxEvidence(slashingTypes.EvidenceTypeDuplicateCheckpoint, voteA, voteB))
+
+	checkpoint, err := slashingTypes.DecodeCheckpointSideSignBytes(voteA.Data)
+	require.NoError(t, err)
+	require.Equal(t, hmTypes.BytesToHeimdallAddress(proposer), checkpoint.Proposer)
+	require.Equal(t, uint64(255), checkpoint.EndBlock)
+
+	// same root hash is not evidence
+	voteC := signSideTxVote(t, privKey, slashingTypes.SideTxVote{
+		TxHash: hmTypes.HexToHexBytes("0x03"),
+		Result: int32(abci.SideTxResultType_Yes),
+		Data:   voteA.Data,
+	})
+	require.Error(t, slashingTypes.ValidateSideTxEvidence(slashingTypes.EvidenceTypeDuplicateCheckpoint, voteA, voteC))
+
+	// different range is not evidence
+	voteD := signSideTxVote(t, privKey, slashingTypes.SideTxVote{
+		TxHash: hmTypes.HexToHexBytes("0x04"),
+		Result: int32(abci.SideTxResultType_Yes),
+		Data:   checkpointSideSignBytes(proposer, 256, 511, hmTypes.HexToHeimdallHash("0xbb").Bytes()),
+	})
+	require.Error(t, slashingTypes.ValidateSideTxEvidence(slashingTypes.EvidenceTypeDuplicateCheckpoint, voteA, voteD))
+}
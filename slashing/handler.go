@@ -27,6 +27,8 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 			return handleMsgTickAck(ctx, msg, k, contractCaller)
 		case types.MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k, contractCaller)
+		case types.MsgSubmitEvidence:
+			return handleMsgSubmitEvidence(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in slashing module").Result()
 		}
@@ -177,3 +179,32 @@ func handleMsgTickAck(ctx sdk.Context, msg types.MsgTickAck, k Keeper, contractC
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// handleMsgSubmitEvidence - validates submitted side-tx evidence against state,
+// signatures are verified in side handler
+func handleMsgSubmitEvidence(ctx sdk.Context, msg types.MsgSubmitEvidence, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating submit evidence msg",
+		"validatorID", msg.ValidatorID,
+		"evidenceType", msg.EvidenceType,
+	)
+
+	if k.HasSideTxEvidence(ctx, msg.EvidenceHash()) {
+		k.Logger(ctx).Error("Evidence already handled", "validatorID", msg.ValidatorID)
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	validator, ok := k.sk.GetValidatorFromValID(ctx, msg.ValidatorID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ValidatorID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	if validator.Jailed {
+		k.Logger(ctx).Error("Validator is already jailed", "validatorId", msg.ValidatorID)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Validator is already jailed").Result()
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
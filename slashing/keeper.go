@@ -607,3 +607,38 @@ func (k *Keeper) IterateSlashingSequencesAndApplyFn(ctx sdk.Context, f func(sequ
 	}
 	return
 }
+
+//
+// Side-tx evidence
+//
+
+// SetSideTxEvidence marks side-tx evidence as handled
+func (k *Keeper) SetSideTxEvidence(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSideTxEvidenceKey(hash), types.DefaultValue)
+}
+
+// HasSideTxEvidence checks if side-tx evidence is already handled
+func (k *Keeper) HasSideTxEvidence(ctx sdk.Context, hash []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetSideTxEvidenceKey(hash))
+}
+
+// SlashAndJailForEvidence slashes validator by double-sign fraction and jails it right away
+func (k *Keeper) SlashAndJailForEvidence(ctx sdk.Context, valID hmTypes.ValidatorID) (uint64, error) {
+	params := k.GetParams(ctx)
+
+	// slashed amount goes to buffer and is pushed to root chain with next tick
+	slashedAmount := k.SlashInterim(ctx, valID, params.SlashFractionDoubleSign)
+
+	valSlashingInfo, found := k.GetBufferValSlashingInfo(ctx, valID)
+	if !found {
+		valSlashingInfo = hmTypes.NewValidatorSlashingInfo(valID, slashedAmount, true)
+	}
+	valSlashingInfo.IsJailed = true
+	k.SetBufferValSlashingInfo(ctx, valID, valSlashingInfo)
+
+	// jail now, power is reduced once tick is confirmed
+	err := k.sk.Slash(ctx, hmTypes.NewValidatorSlashingInfo(valID, 0, true))
+	return slashedAmount, err
+}
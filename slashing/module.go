@@ -32,6 +32,7 @@ var (
 	_ module.AppModuleBasic        = AppModuleBasic{}
 	_ hmModule.HeimdallModuleBasic = AppModule{}
 	_ hmModule.AppModuleSimulation = AppModule{}
+	_ hmModule.VoteExtensionModule = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the slashing module.
@@ -217,3 +218,8 @@ func (am AppModule) NewSideTxHandler() hmTypes.SideTxHandler {
 func (am AppModule) NewPostTxHandler() hmTypes.PostTxHandler {
 	return NewPostTxHandler(am.keeper, am.contractCaller)
 }
+
+// NewVoteExtension vote extension of slashing msgs
+func (am AppModule) NewVoteExtension() hmTypes.VoteExtension {
+	return NewVoteExtension(am.keeper, am.contractCaller)
+}
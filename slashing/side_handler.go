@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"math/big"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -27,6 +28,8 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 			return SideHandleMsgTickAck(ctx, k, msg, contractCaller)
 		case types.MsgUnjail:
 			return SideHandleMsgUnjail(ctx, k, msg, contractCaller)
+		case types.MsgSubmitEvidence:
+			return SideHandleMsgSubmitEvidence(ctx, k, msg)
 		default:
 			return abci.ResponseDeliverSideTx{
 				Code: uint32(sdk.CodeUnknownRequest),
@@ -46,6 +49,8 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 			return PostHandleMsgTickAck(ctx, k, msg, sideTxResult)
 		case types.MsgUnjail:
 			return PostHandleMsgUnjail(ctx, k, msg, sideTxResult)
+		case types.MsgSubmitEvidence:
+			return PostHandleMsgSubmitEvidence(ctx, k, msg, sideTxResult)
 		default:
 			errMsg := "Unrecognized slash Msg type: %s" + msg.Type()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -76,7 +81,7 @@ func SideHandleMsgTickAck(ctx sdk.Context, k Keeper, msg types.MsgTickAck, contr
 	// get main tx receipt
 	receipt, err := contractCaller.GetTronTransactionReceipt(msg.TxHash.TronHash().Hex())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
 	}
 
 	// get event log for slashed event
@@ -117,7 +122,7 @@ func SideHandleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail, contrac
 	// get main tx receipt
 	receipt, err := contractCaller.GetTronTransactionReceipt(msg.TxHash.TronHash().Hex())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
 	}
 
 	// get unjail event
@@ -142,6 +147,47 @@ func SideHandleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail, contrac
 	return
 }
 
+// SideHandleMsgSubmitEvidence verifies both votes in evidence are signed by accused validator
+func SideHandleMsgSubmitEvidence(ctx sdk.Context, k Keeper, msg types.MsgSubmitEvidence) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for submit evidence msg",
+		"validatorID", msg.ValidatorID,
+		"evidenceType", msg.EvidenceType,
+	)
+
+	if err := types.ValidateSideTxEvidence(msg.EvidenceType, msg.VoteA, msg.VoteB); err != nil {
+		k.Logger(ctx).Error("Invalid evidence", "error", err)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	validator, ok := k.sk.GetValidatorFromValID(ctx, msg.ValidatorID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ValidatorID)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeNoValidator)
+	}
+
+	for _, vote := range []types.SideTxVote{msg.VoteA, msg.VoteB} {
+		signer, err := vote.Signer()
+		// votes might be signed by either key during signer rotation
+		if err != nil || !k.sk.IsValidatorSigner(ctx, validator, signer) {
+			k.Logger(ctx).Error("Vote is not signed by validator", "validatorID", msg.ValidatorID, "signer", signer, "error", err)
+			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+		}
+	}
+
+	// duplicate checkpoints must be proposed by accused validator
+	if msg.EvidenceType == types.EvidenceTypeDuplicateCheckpoint {
+		checkpoint, _ := types.DecodeCheckpointSideSignBytes(msg.VoteA.Data)
+		if !k.sk.IsValidatorSigner(ctx, validator, checkpoint.Proposer) {
+			k.Logger(ctx).Error("Checkpoint is not proposed by validator", "validatorID", msg.ValidatorID, "proposer", checkpoint.Proposer)
+			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+		}
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for submit evidence msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
 // PostHandleMsgTick  - handles slashing of validators
 // 1. copy slashBuffer into latestTickData
 // 2. flush slashBuffer, totalSlashedAmount
@@ -355,3 +401,62 @@ func PostHandleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail, sideTxR
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// PostHandleMsgSubmitEvidence slashes and jails validator for verified side-tx evidence
+func PostHandleMsgSubmitEvidence(ctx sdk.Context, k Keeper, msg types.MsgSubmitEvidence, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if evidence is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping evidence since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// check for replay
+	evidenceHash := msg.EvidenceHash()
+	if k.HasSideTxEvidence(ctx, evidenceHash) {
+		k.Logger(ctx).Error("Evidence already handled", "validatorID", msg.ValidatorID)
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	if _, ok := k.sk.GetValidatorFromValID(ctx, msg.ValidatorID); !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ValidatorID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	slashedAmount, err := k.SlashAndJailForEvidence(ctx, msg.ValidatorID)
+	if err != nil {
+		k.Logger(ctx).Error("Error slashing validator for evidence", "validatorID", msg.ValidatorID, "error", err)
+		return hmCommon.ErrSlashInfoDetails(k.Codespace()).Result()
+	}
+
+	// save evidence
+	k.SetSideTxEvidence(ctx, evidenceHash)
+
+	k.Logger(ctx).Info("Validator slashed and jailed for side-tx evidence",
+		"validatorID", msg.ValidatorID,
+		"evidenceType", msg.EvidenceType,
+		"slashedAmount", slashedAmount,
+	)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEvidence,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyValID, msg.ValidatorID.String()),
+			sdk.NewAttribute(types.AttributeKeyEvidenceType, msg.EvidenceType),
+			sdk.NewAttribute(types.AttributeKeyEvidenceHash, hex.EncodeToString(evidenceHash)),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(slashedAmount, 10)),
+			sdk.NewAttribute(types.AttributeKeyJailed, "true"),
+		),
+	)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
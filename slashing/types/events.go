@@ -9,6 +9,7 @@ const (
 	EventTypeTickAck     = "tick-ack"
 	EventTypeUnjail      = "unjail"
 	EventTypeLiveness    = "liveness"
+	EventTypeEvidence    = "side-tx-evidence"
 
 	AttributeKeyAddress        = "address"
 	AttributeKeyValID          = "valid"
@@ -20,6 +21,8 @@ const (
 	AttributeKeyReason         = "reason"
 	AttributeKeyJailed         = "jailed"
 	AttributeKeyMissedBlocks   = "missed_blocks"
+	AttributeKeyEvidenceType   = "evidence-type"
+	AttributeKeyEvidenceHash   = "evidence-hash"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
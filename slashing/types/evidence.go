@@ -34,10 +34,9 @@ type Evidence interface {
 	GetTotalPower() int64
 }
 
-// MsgSubmitEvidence defines the specific interface a concrete message must
-// implement in order to process submitted evidence. The concrete MsgSubmitEvidence
-// must be defined at the application-level.
-type MsgSubmitEvidence interface {
+// EvidenceMsg defines the specific interface a concrete message must
+// implement in order to process submitted evidence.
+type EvidenceMsg interface {
 	sdk.Msg
 
 	GetEvidence() Evidence
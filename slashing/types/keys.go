@@ -37,6 +37,7 @@ var (
 	TickValSlashingInfoKey          = []byte{0x06} // Prefix for Slashing Info stored after tick tx
 	SlashingSequenceKey             = []byte{0x07} // prefix for each key for slashing sequence map
 	TickCountKey                    = []byte{0x08} // key to store Tick counts
+	SideTxEvidenceKey               = []byte{0x09} // prefix for handled side-tx evidence hashes
 )
 
 // GetValidatorSigningInfoKey - stored by *valID*
@@ -69,3 +70,8 @@ func GetTickValSlashingInfoKey(id []byte) []byte {
 func GetSlashingSequenceKey(sequence string) []byte {
 	return append(SlashingSequenceKey, []byte(sequence)...)
 }
+
+// GetSideTxEvidenceKey returns handled side-tx evidence key
+func GetSideTxEvidenceKey(hash []byte) []byte {
+	return append(SideTxEvidenceKey, hash...)
+}
@@ -202,3 +202,76 @@ func (msg MsgTickAck) GetLogIndex() uint64 {
 func (msg MsgTickAck) GetSideSignBytes() []byte {
 	return nil
 }
+
+//
+// Msg Submit Evidence
+//
+
+var _ sdk.Msg = &MsgSubmitEvidence{}
+
+// MsgSubmitEvidence submits evidence of validator signing contradictory side-tx votes
+type MsgSubmitEvidence struct {
+	From         types.HeimdallAddress `json:"from"`
+	ValidatorID  hmTypes.ValidatorID   `json:"validator_id"`
+	EvidenceType string                `json:"evidence_type"`
+	VoteA        SideTxVote            `json:"vote_a"`
+	VoteB        SideTxVote            `json:"vote_b"`
+}
+
+// NewMsgSubmitEvidence creates new submit evidence msg
+func NewMsgSubmitEvidence(from types.HeimdallAddress, validatorID uint64, evidenceType string, voteA SideTxVote, voteB SideTxVote) MsgSubmitEvidence {
+	return MsgSubmitEvidence{
+		From:         from,
+		ValidatorID:  hmTypes.NewValidatorID(validatorID),
+		EvidenceType: evidenceType,
+		VoteA:        voteA,
+		VoteB:        voteB,
+	}
+}
+
+// Type returns message type
+func (msg MsgSubmitEvidence) Type() string {
+	return "submit-evidence"
+}
+
+func (msg MsgSubmitEvidence) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgSubmitEvidence) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if msg.ValidatorID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ValidatorID)
+	}
+
+	if err := ValidateSideTxEvidence(msg.EvidenceType, msg.VoteA, msg.VoteB); err != nil {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid evidence: %v", err)
+	}
+	return nil
+}
+
+// EvidenceHash returns hash of submitted evidence
+func (msg MsgSubmitEvidence) EvidenceHash() []byte {
+	return SideTxEvidenceHash(msg.EvidenceType, msg.VoteA, msg.VoteB)
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgSubmitEvidence) GetSideSignBytes() []byte {
+	return nil
+}
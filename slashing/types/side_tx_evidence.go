@@ -0,0 +1,149 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Side-tx evidence types
+const (
+	// EvidenceTypeConflictingVote yes and no votes signed for the same side-tx
+	EvidenceTypeConflictingVote = "conflicting-side-tx-vote"
+	// EvidenceTypeDuplicateCheckpoint checkpoints signed by proposer for the same range with different root hashes
+	EvidenceTypeDuplicateCheckpoint = "duplicate-checkpoint"
+)
+
+// checkpoint side sign bytes are 7 abi words: proposer, start, end, root, account root, bor chain id, epoch
+const checkpointSideSignBytesLength = 7 * 32
+
+// SideTxVote is side-tx result signed by validator in its pre-commit
+type SideTxVote struct {
+	TxHash hmTypes.HexBytes `json:"tx_hash"`
+	Result int32            `json:"result"`
+	Data   hmTypes.HexBytes `json:"data"`
+	Sig    hmTypes.HexBytes `json:"sig"`
+}
+
+// SignBytes returns bytes signed by validator for side-tx vote
+func (v SideTxVote) SignBytes() []byte {
+	sideTxResultWithData := tmTypes.SideTxResultWithData{
+		SideTxResult: tmTypes.SideTxResult{
+			TxHash: v.TxHash,
+			Result: v.Result,
+		},
+		Data: v.Data,
+	}
+	return sideTxResultWithData.GetBytes()
+}
+
+// Signer recovers signer address of vote
+func (v SideTxVote) Signer() (hmTypes.HeimdallAddress, error) {
+	if len(v.Sig) != 65 {
+		return hmTypes.ZeroHeimdallAddress, errors.New("invalid signature length")
+	}
+
+	p, err := authTypes.RecoverPubkey(v.SignBytes(), v.Sig)
+	if err != nil {
+		return hmTypes.ZeroHeimdallAddress, err
+	}
+
+	var pk secp256k1.PubKeySecp256k1
+	copy(pk[:], p[:])
+	return hmTypes.BytesToHeimdallAddress(pk.Address().Bytes()), nil
+}
+
+// CheckpointSideSignData is checkpoint data decoded from checkpoint side sign bytes
+type CheckpointSideSignData struct {
+	Proposer   hmTypes.HeimdallAddress
+	StartBlock uint64
+	EndBlock   uint64
+	RootHash   hmTypes.HeimdallHash
+}
+
+// DecodeCheckpointSideSignBytes decodes checkpoint side sign bytes
+func DecodeCheckpointSideSignBytes(data []byte) (result CheckpointSideSignData, err error) {
+	if len(data) != checkpointSideSignBytesLength {
+		return result, errors.New("invalid checkpoint side sign bytes length")
+	}
+
+	result.Proposer = hmTypes.BytesToHeimdallAddress(data[12:32])
+	result.StartBlock = new(big.Int).SetBytes(data[32:64]).Uint64()
+	result.EndBlock = new(big.Int).SetBytes(data[64:96]).Uint64()
+	result.RootHash = hmTypes.BytesToHeimdallHash(data[96:128])
+	return result, nil
+}
+
+// ValidateSideTxEvidence checks that votes contradict each other for evidence type
+func ValidateSideTxEvidence(evidenceType string, voteA SideTxVote, voteB SideTxVote) error {
+	if len(voteA.Sig) != 65 || len(voteB.Sig) != 65 {
+		return errors.New("invalid vote signature")
+	}
+
+	switch evidenceType {
+	case EvidenceTypeConflictingVote:
+		if !bytes.Equal(voteA.TxHash, voteB.TxHash) {
+			return errors.New("votes are for different side-txs")
+		}
+
+		results := map[int32]bool{voteA.Result: true, voteB.Result: true}
+		if !results[int32(abci.SideTxResultType_Yes)] || !results[int32(abci.SideTxResultType_No)] {
+			return errors.New("votes are not yes and no")
+		}
+
+	case EvidenceTypeDuplicateCheckpoint:
+		if bytes.Equal(voteA.TxHash, voteB.TxHash) {
+			return errors.New("votes are for same side-tx")
+		}
+
+		if voteA.Result != int32(abci.SideTxResultType_Yes) || voteB.Result != int32(abci.SideTxResultType_Yes) {
+			return errors.New("checkpoint votes must be yes votes")
+		}
+
+		checkpointA, err := DecodeCheckpointSideSignBytes(voteA.Data)
+		if err != nil {
+			return err
+		}
+
+		checkpointB, err := DecodeCheckpointSideSignBytes(voteB.Data)
+		if err != nil {
+			return err
+		}
+
+		if !checkpointA.Proposer.Equals(checkpointB.Proposer) {
+			return errors.New("checkpoints have different proposers")
+		}
+
+		if checkpointA.StartBlock != checkpointB.StartBlock || checkpointA.EndBlock != checkpointB.EndBlock {
+			return errors.New("checkpoints have different ranges")
+		}
+
+		if checkpointA.RootHash.Equals(checkpointB.RootHash) {
+			return errors.New("checkpoints have same root hash")
+		}
+
+	default:
+		return errors.New("unknown evidence type")
+	}
+
+	return nil
+}
+
+// SideTxEvidenceHash returns hash of evidence, independent of vote order
+func SideTxEvidenceHash(evidenceType string, voteA SideTxVote, voteB SideTxVote) []byte {
+	votes := []SideTxVote{voteA, voteB}
+	sort.Slice(votes, func(i, j int) bool {
+		return bytes.Compare(votes[i].Sig, votes[j].Sig) < 0
+	})
+
+	return tmhash.Sum(append([]byte(evidenceType), ModuleCdc.MustMarshalBinaryBare(votes)...))
+}
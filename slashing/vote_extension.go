@@ -0,0 +1,77 @@
+package slashing
+
+import (
+	"bytes"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// VoteExtensionName name of slashing vote extension, carried by vote data of evidence
+const VoteExtensionName = "slashing"
+
+// voteExtension prepares votes on slashing msgs with side-tx handler. Votes on evidence sign evidence hash
+// with versioned vote data and accused validator can't vote on its own evidence. Ticks are signed for
+// root chain contracts, so their votes keep legacy vote data.
+type voteExtension struct {
+	k       Keeper
+	handler hmTypes.SideTxHandler
+}
+
+// NewVoteExtension returns vote extension of slashing msgs
+func NewVoteExtension(k Keeper, contractCaller helper.IContractCaller) hmTypes.VoteExtension {
+	return voteExtension{
+		k:       k,
+		handler: NewSideTxHandler(k, contractCaller),
+	}
+}
+
+func (e voteExtension) Name() string {
+	return VoteExtensionName
+}
+
+func (e voteExtension) Version(msg sdk.Msg) byte {
+	if _, ok := msg.(types.MsgSubmitEvidence); ok {
+		return hmTypes.VoteExtensionVersion1
+	}
+
+	return hmTypes.VoteExtensionVersionLegacy
+}
+
+func (e voteExtension) PrepareVote(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+	return e.handler(ctx, msg)
+}
+
+func (e voteExtension) Payload(msg sdk.Msg) []byte {
+	switch msg := msg.(type) {
+	case types.MsgSubmitEvidence:
+		return msg.EvidenceHash()
+	case hmTypes.SideTxMsg:
+		return msg.GetSideSignBytes()
+	default:
+		return nil
+	}
+}
+
+func (e voteExtension) VerifyVote(ctx sdk.Context, msg sdk.Msg, vote hmTypes.ExtendedVote) error {
+	evidence, ok := msg.(types.MsgSubmitEvidence)
+	if !ok || vote.Result == abci.SideTxResultType_Skip {
+		return nil
+	}
+
+	if !bytes.Equal(vote.Data, evidence.EvidenceHash()) {
+		return errors.New("vote is not on evidence hash")
+	}
+
+	validator, ok := e.k.sk.GetValidatorFromValID(ctx, evidence.ValidatorID)
+	if ok && e.k.sk.IsValidatorSigner(ctx, validator, hmTypes.BytesToHeimdallAddress(vote.Validator)) {
+		return errors.New("accused validator can't vote on its evidence")
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package slashing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingSim "github.com/maticnetwork/heimdall/staking/simulation"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestEvidenceVoteExtension(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{})
+
+	validator := stakingSim.GenRandomVal(1, 0, 10, 10, false, 1)[0]
+	require.NoError(t, happ.StakingKeeper.AddValidator(ctx, validator))
+
+	privKey := secp256k1.GenPrivKey()
+	txHash := hmTypes.HexToHexBytes("0x01")
+	yes := signSideTxVote(t, privKey, slashingTypes.SideTxVote{TxHash: txHash, Result: int32(abci.SideTxResultType_Yes)})
+	no := signSideTxVote(t, privKey, slashingTypes.SideTxVote{TxHash: txHash, Result: int32(abci.SideTxResultType_No)})
+	msg := slashingTypes.NewMsgSubmitEvidence(validator.Signer, uint64(validator.ID), slashingTypes.EvidenceTypeConflictingVote, yes, no)
+
+	ext := slashing.NewVoteExtension(happ.SlashingKeeper, &mocks.IContractCaller{})
+
+	// votes on evidence sign evidence hash with versioned data, ticks keep legacy data for contracts
+	require.Equal(t, hmTypes.VoteExtensionVersion1, ext.Version(msg))
+	require.Equal(t, hmTypes.VoteExtensionVersionLegacy, ext.Version(slashingTypes.MsgTick{}))
+
+	data, err := hmTypes.EncodeVoteData(ext, msg, ext.Payload(msg))
+	require.NoError(t, err)
+	name, payload, err := hmTypes.DecodeVoteData(hmTypes.VoteExtensionVersion1, data)
+	require.NoError(t, err)
+	require.Equal(t, slashing.VoteExtensionName, name)
+	require.Equal(t, msg.EvidenceHash(), payload)
+
+	other := secp256k1.GenPrivKey().PubKey().Address().Bytes()
+	vote := hmTypes.ExtendedVote{Result: abci.SideTxResultType_Yes, Validator: other, Data: payload}
+	require.NoError(t, ext.VerifyVote(ctx, msg, vote))
+
+	// vote on other data
+	vote.Data = []byte("data")
+	require.Error(t, ext.VerifyVote(ctx, msg, vote))
+
+	// accused validator can't vote on its evidence
+	vote = hmTypes.ExtendedVote{Result: abci.SideTxResultType_No, Validator: validator.Signer.Bytes(), Data: payload}
+	require.Error(t, ext.VerifyVote(ctx, msg, vote))
+
+	vote.Result = abci.SideTxResultType_Skip
+	require.NoError(t, ext.VerifyVote(ctx, msg, vote), "skip votes aren't counted for either result")
+}
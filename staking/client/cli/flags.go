@@ -19,4 +19,15 @@ const (
 
 	FlagStartEpoch = "start-epoch"
 	FlagEndEpoch   = "end-epoch"
+
+	FlagMoniker      = "moniker"
+	FlagWebsite      = "website"
+	FlagDetails      = "details"
+	FlagAlertWebhook = "alert-webhook"
+	FlagContactHash  = "contact-hash"
+
+	FlagRootChain = "root-chain"
+
+	// validator submitting proposal
+	FlagProposerValidatorID = "validator-id"
 )
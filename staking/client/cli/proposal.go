@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+// ValidatorProposalJSON defines force validator exit or force unjail proposal with deposit
+// used to parse proposal from a JSON file.
+type ValidatorProposalJSON struct {
+	Title       string              `json:"title" yaml:"title"`
+	Description string              `json:"description" yaml:"description"`
+	ValidatorID hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	Deposit     sdk.Coins           `json:"deposit" yaml:"deposit"`
+}
+
+// GetCmdSubmitForceValidatorExitProposal implements a command handler for submitting
+// force validator exit proposal transaction.
+func GetCmdSubmitForceValidatorExitProposal(cdc *codec.Codec) *cobra.Command {
+	return getCmdSubmitValidatorProposal(cdc, "force-validator-exit",
+		"Submit a proposal to remove byzantine or lost-key validator from validator set",
+		"Validator is removed at current epoch once proposal passes, without waiting for stake withdrawal on root chain.",
+		func(p ValidatorProposalJSON) govTypes.Content {
+			return types.NewForceValidatorExitProposal(p.Title, p.Description, p.ValidatorID)
+		},
+	)
+}
+
+// GetCmdSubmitForceUnjailProposal implements a command handler for submitting
+// force unjail proposal transaction.
+func GetCmdSubmitForceUnjailProposal(cdc *codec.Codec) *cobra.Command {
+	return getCmdSubmitValidatorProposal(cdc, "force-unjail",
+		"Submit a proposal to unjail validator",
+		"Validator joins validator set again once proposal passes, without unjail tx on root chain.",
+		func(p ValidatorProposalJSON) govTypes.Content {
+			return types.NewForceUnjailProposal(p.Title, p.Description, p.ValidatorID)
+		},
+	)
+}
+
+func getCmdSubmitValidatorProposal(cdc *codec.Codec, use, short, long string, newContent func(ValidatorProposalJSON) govTypes.Content) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: short,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`%s along with an initial deposit.
+%s
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal %s <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Validator 7 lost its signer key",
+  "description": "Signer key of validator 7 is compromised",
+  "validator_id": 7,
+  "deposit": [
+    {
+      "denom": "btt",
+      "amount": "1000000000000000000"
+    }
+  ]
+}
+`,
+				short, long, version.ClientName, use,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var proposal ValidatorProposalJSON
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			validatorID := viper.GetUint64(FlagProposerValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			from := helper.GetFromAddress(cliCtx)
+
+			// create submit proposal
+			msg := govTypes.NewMsgSubmitProposal(newContent(proposal), proposal.Deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(FlagProposerValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(FlagProposerValidatorID); err != nil {
+		logger.Error("getCmdSubmitValidatorProposal | MarkFlagRequired | FlagProposerValidatorID", "Error", err)
+	}
+
+	return cmd
+}
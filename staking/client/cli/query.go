@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -31,6 +32,12 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetValidatorInfo(cdc),
 			GetCurrentValSet(cdc),
+			GetValidatorMetadata(cdc),
+			GetConfigHashes(cdc),
+			GetValidatorSetSync(cdc),
+			GetPendingStakeUpdates(cdc),
+			GetPendingSignerRotations(cdc),
+			GetValidatorSetAtCheckpoint(cdc),
 		)...,
 	)
 
@@ -73,8 +80,7 @@ func GetValidatorInfo(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			fmt.Println(string(res))
-			return nil
+			return hmClient.PrintRawOutput(cliCtx, res)
 		},
 	}
 
@@ -97,8 +103,161 @@ func GetCurrentValSet(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			fmt.Println(string(res))
-			return nil
+			return hmClient.PrintRawOutput(cliCtx, res)
+		},
+	}
+
+	return cmd
+}
+
+// GetValidatorMetadata validator metadata via id
+func GetValidatorMetadata(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-metadata",
+		Short: "show validator metadata via validator id, or metadata of all validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			validatorID := viper.GetUint64(FlagValidatorID)
+
+			var queryParams []byte
+			var err error
+			t := types.QueryAllValidatorMetadata
+			if validatorID != 0 {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(validatorID)))
+				if err != nil {
+					return err
+				}
+				t = types.QueryValidatorMetadata
+			}
+
+			// get metadata
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, t), queryParams)
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintRawOutput(cliCtx, res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+	return cmd
+}
+
+// GetConfigHashes config hashes of current validators compared to supermajority
+func GetConfigHashes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config-hashes",
+		Short: "show consensus config hashes of current validators and flag ones diverging from supermajority",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryConfigHashes), nil)
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintRawOutput(cliCtx, res)
+		},
+	}
+
+	return cmd
+}
+
+// GetValidatorSetSync validator set sync state of secondary root chain
+func GetValidatorSetSync(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-sync",
+		Short: "show last validator set synced to root chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			rootChain := viper.GetString(FlagRootChain)
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryStakingParams(0, rootChain))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetSync), queryParams)
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintRawOutput(cliCtx, res)
+		},
+	}
+
+	cmd.Flags().String(FlagRootChain, hmTypes.RootChainTypeEth, "--root-chain=<root-chain-type>")
+	return cmd
+}
+
+// GetValidatorSetAtCheckpoint validator set active when checkpoint was acked
+func GetValidatorSetAtCheckpoint(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-at-checkpoint [number]",
+		Short: "show validator set active when checkpoint was acked, to verify signatures of old checkpoints",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			rootChain := viper.GetString(FlagRootChain)
+
+			number, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryStakingParams(number, rootChain))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetAtCheckpoint), queryParams)
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintRawOutput(cliCtx, res)
+		},
+	}
+
+	cmd.Flags().String(FlagRootChain, hmTypes.RootChainTypeEth, "--root-chain=<root-chain-type>")
+	return cmd
+}
+
+// GetPendingStakeUpdates stake updates waiting for next checkpoint ack
+func GetPendingStakeUpdates(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-stake-updates",
+		Short: "show stake updates which are applied to validator set on next checkpoint ack",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingStakeUpdates), nil)
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintRawOutput(cliCtx, res)
+		},
+	}
+
+	return cmd
+}
+
+// GetPendingSignerRotations signer rotations waiting for activation
+func GetPendingSignerRotations(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-signer-rotations",
+		Short: "show signer updates which take effect on next checkpoint ack or at activation height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingSignerRotations), nil)
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintRawOutput(cliCtx, res)
 		},
 	}
 
@@ -40,6 +40,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 			SendValidatorUpdateTx(cdc),
 			SendValidatorExitTx(cdc),
 			SendValidatorStakeUpdateTx(cdc),
+			SendValidatorMetadataTx(cdc),
+			SendBlsKeyTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -391,3 +393,100 @@ func SendValidatorStakeUpdateTx(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// SendValidatorMetadataTx sends validator metadata transaction
+func SendValidatorMetadataTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-metadata",
+		Short: "Register operational metadata for validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("valid validator ID required")
+			}
+
+			// msg
+			msg := types.NewMsgSetValidatorMetadata(
+				proposer,
+				hmTypes.NewValidatorID(validatorID),
+				viper.GetString(FlagMoniker),
+				viper.GetString(FlagWebsite),
+				viper.GetString(FlagDetails),
+				viper.GetString(FlagAlertWebhook),
+				hmTypes.HexToHeimdallHash(viper.GetString(FlagContactHash)),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagMoniker, "", "--moniker=<moniker>")
+	cmd.Flags().String(FlagWebsite, "", "--website=<website-url>")
+	cmd.Flags().String(FlagDetails, "", "--details=<details>")
+	cmd.Flags().String(FlagAlertWebhook, "", "--alert-webhook=<webhook-url>")
+	cmd.Flags().String(FlagContactHash, "", "--contact-hash=<operator-contact-hash>")
+
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("SendValidatorMetadataTx | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
+
+// SendBlsKeyTx registers local BLS key for checkpoint signature aggregation, key is generated if there is none yet
+func SendBlsKeyTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bls-key",
+		Short: "Register BLS key of validator, generated into BLS key file if it doesn't exist",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("valid validator ID required")
+			}
+
+			sk, err := helper.LoadOrGenerateBlsKey()
+			if err != nil {
+				return err
+			}
+
+			// msg
+			msg := types.NewMsgSetBlsKey(
+				proposer,
+				hmTypes.NewValidatorID(validatorID),
+				helper.BlsPubKey(sk),
+				helper.BlsProofOfPossession(sk),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator-id>")
+
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("SendBlsKeyTx | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/maticnetwork/heimdall/gov/client"
+	"github.com/maticnetwork/heimdall/staking/client/cli"
+	"github.com/maticnetwork/heimdall/staking/client/rest"
+)
+
+// validator replacement proposal handlers
+var (
+	ForceValidatorExitProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitForceValidatorExitProposal, rest.ForceValidatorExitProposalRESTHandler)
+	ForceUnjailProposalHandler        = govclient.NewProposalHandler(cli.GetCmdSubmitForceUnjailProposal, rest.ForceUnjailProposalRESTHandler)
+)
@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	govRest "github.com/maticnetwork/heimdall/gov/client/rest"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+// ValidatorProposalReq defines force validator exit or force unjail proposal request body
+type ValidatorProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string                  `json:"title" yaml:"title"`
+	Description string                  `json:"description" yaml:"description"`
+	ValidatorID hmTypes.ValidatorID     `json:"validator_id" yaml:"validator_id"`
+	Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+	Validator   hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+}
+
+// ForceValidatorExitProposalRESTHandler returns a ProposalRESTHandler that exposes the
+// force validator exit REST handler with a given sub-route.
+func ForceValidatorExitProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "force_validator_exit",
+		Handler: postValidatorProposalHandlerFn(cliCtx, func(req ValidatorProposalReq) govTypes.Content {
+			return types.NewForceValidatorExitProposal(req.Title, req.Description, req.ValidatorID)
+		}),
+	}
+}
+
+// ForceUnjailProposalRESTHandler returns a ProposalRESTHandler that exposes the
+// force unjail REST handler with a given sub-route.
+func ForceUnjailProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "force_unjail",
+		Handler: postValidatorProposalHandlerFn(cliCtx, func(req ValidatorProposalReq) govTypes.Content {
+			return types.NewForceUnjailProposal(req.Title, req.Description, req.ValidatorID)
+		}),
+	}
+}
+
+func postValidatorProposalHandlerFn(cliCtx context.CLIContext, newContent func(ValidatorProposalReq) govTypes.Content) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ValidatorProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := govTypes.NewMsgSubmitProposal(newContent(req), req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
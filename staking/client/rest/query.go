@@ -56,10 +56,52 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/staking/next/{root}",
 		stakingNextHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc("/staking/next",
+		stakingNextHandlerFn(cliCtx),
+	).Methods("GET")
 
 	r.HandleFunc("/staking/queue/{root}",
 		stakingQueueHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc("/staking/queue",
+		stakingQueueHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/staking/validator-metadata",
+		allValidatorMetadataHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator-metadata/{id}",
+		validatorMetadataHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/config-hashes",
+		configHashesHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/bls-keys",
+		blsKeysHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/bls-key/{id}",
+		blsKeyHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc("/staking/validator-set-sync/{root}",
+		validatorSetSyncHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc("/staking/validator-set-sync",
+		validatorSetSyncHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc("/staking/pending-stake-updates",
+		pendingStakeUpdatesHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc("/staking/pending-signer-rotations",
+		pendingSignerRotationsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc("/staking/validator-set/checkpoint/{number}",
+		validatorSetAtCheckpointHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // Returns total power of current validator set
@@ -415,11 +457,8 @@ func stakingNextHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		vars := mux.Vars(r)
-		rootChain, ok := vars["root"]
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
 		if !ok {
-			err := fmt.Errorf("'%s' is not a valid rootChain", vars["root"])
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 		// get query params
@@ -448,11 +487,8 @@ func stakingQueueHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		vars := mux.Vars(r)
-		rootChain, ok := vars["root"]
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
 		if !ok {
-			err := fmt.Errorf("'%s' is not a valid rootChain", vars["root"])
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 		// get query params
@@ -472,3 +508,253 @@ func stakingQueueHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, result)
 	}
 }
+
+// Returns validator set sync state of root chain
+func validatorSetSyncHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryStakingParams(0, rootChain))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetSync), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// Returns validator metadata by val ID
+func validatorMetadataHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorMetadata), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator metadata", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no metadata found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No validator metadata found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns metadata of all validators
+func allValidatorMetadataHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllValidatorMetadata), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns config hashes of current validators compared to supermajority
+func configHashesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryConfigHashes), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns BLS public keys registered by validators
+func blsKeysHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlsKeys), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns BLS public key registered by validator
+func blsKeyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlsKey), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator BLS key", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no key found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No validator BLS key found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns stake updates waiting for next checkpoint ack
+func pendingStakeUpdatesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingStakeUpdates), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns signer rotations waiting for activation
+// Returns validator set active when checkpoint was acked
+func validatorSetAtCheckpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		rootChain, ok := hmRest.ParseRootChainOrReturnBadRequest(w, r, hmRest.DefaultRootChain)
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryStakingParams(number, rootChain))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetAtCheckpoint), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func pendingSignerRotationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingSignerRotations), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
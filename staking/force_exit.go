@@ -0,0 +1,70 @@
+package staking
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// validator replacement by governance
+//
+
+// ForceValidatorExit ends validator at current epoch, so it's removed from validator set at end of block.
+// Accum is reset, so validator doesn't carry stale proposer priority if it joins again.
+// It returns end epoch of validator.
+func (k *Keeper) ForceValidatorExit(ctx sdk.Context, valID hmTypes.ValidatorID) (uint64, error) {
+	validator, ok := k.GetValidatorFromValID(ctx, valID)
+	if !ok {
+		return 0, errors.New("validator not found")
+	}
+
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+	if !validator.IsCurrentValidator(ackCount) {
+		return 0, errors.New("validator is not in validator set")
+	}
+
+	// validator set can't be left empty
+	remaining := 0
+	for _, v := range k.GetCurrentValidators(ctx) {
+		if v.ID != valID {
+			remaining++
+		}
+	}
+	if remaining == 0 {
+		return 0, errors.New("validator is last one in validator set")
+	}
+
+	// current epoch is ack count + 1, validator isn't current from its end epoch on
+	validator.EndEpoch = ackCount + 1
+	validator.ProposerPriority = 0
+
+	if err := k.AddValidator(ctx, validator); err != nil {
+		return 0, err
+	}
+	return validator.EndEpoch, nil
+}
+
+// ForceUnjail unjails validator which didn't exit, it joins validator set again at end of block with accum reset
+func (k *Keeper) ForceUnjail(ctx sdk.Context, valID hmTypes.ValidatorID) error {
+	validator, ok := k.GetValidatorFromValID(ctx, valID)
+	if !ok {
+		return errors.New("validator not found")
+	}
+
+	if !validator.Jailed {
+		return errors.New("validator is not jailed")
+	}
+
+	currentEpoch := k.moduleCommunicator.GetACKCount(ctx) + 1
+	if validator.EndEpoch != 0 && validator.EndEpoch <= currentEpoch {
+		return errors.New("validator already exited")
+	}
+
+	validator.Jailed = false
+	validator.ProposerPriority = 0
+
+	return k.AddValidator(ctx, validator)
+}
@@ -8,6 +8,9 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	// new chains deduplicate stake updates by validator nonce from the start
+	keeper.enableStakeUpdateNonceCheck(ctx)
+
 	// get current val set
 	var vals []*hmTypes.Validator
 	if len(data.CurrentValSet.Validators) == 0 {
@@ -42,16 +45,57 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		keeper.SetStakingSequence(ctx, sequence)
 	}
 
+	for _, metadata := range data.ValidatorMetadata {
+		if err := keeper.SetValidatorMetadata(ctx, metadata); err != nil {
+			keeper.Logger(ctx).Error("Error InitGenesis", "error", err)
+		}
+	}
+
+	for _, record := range data.ValidatorSetSyncRecords {
+		if err := keeper.SetValidatorSetSyncRecord(ctx, record); err != nil {
+			keeper.Logger(ctx).Error("Error InitGenesis", "error", err)
+		}
+	}
+
+	for _, update := range data.PendingStakeUpdates {
+		if err := keeper.SetPendingStakeUpdate(ctx, update); err != nil {
+			keeper.Logger(ctx).Error("Error InitGenesis", "error", err)
+		}
+	}
+
+	for _, rotation := range data.PendingSignerRotations {
+		if err := keeper.SetPendingSignerRotation(ctx, rotation); err != nil {
+			keeper.Logger(ctx).Error("Error InitGenesis", "error", err)
+		}
+	}
+
+	for _, blsKey := range data.BlsKeys {
+		if err := keeper.SetValidatorBlsKey(ctx, blsKey); err != nil {
+			keeper.Logger(ctx).Error("Error InitGenesis", "error", err)
+		}
+	}
+
 	keeper.SetParams(ctx, data.Params)
+	keeper.SetBatchStakeUpdates(ctx, data.BatchStakeUpdates)
+	keeper.SetSignerRotationDelay(ctx, data.SignerRotationDelay)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	// return new genesis state
-	return types.NewGenesisState(
+	genesis := types.NewGenesisState(
 		keeper.GetParams(ctx),
 		keeper.GetAllValidators(ctx),
 		keeper.GetValidatorSet(ctx),
 		keeper.GetStakingSequences(ctx),
 	)
+	genesis.ValidatorMetadata = keeper.GetAllValidatorMetadata(ctx)
+	genesis.ValidatorSetSyncRecords = keeper.GetAllValidatorSetSyncRecords(ctx)
+	genesis.BatchStakeUpdates = keeper.GetBatchStakeUpdates(ctx)
+	genesis.PendingStakeUpdates = keeper.GetPendingStakeUpdates(ctx)
+	genesis.SignerRotationDelay = keeper.GetSignerRotationDelay(ctx)
+	genesis.PendingSignerRotations = keeper.GetPendingSignerRotations(ctx)
+	genesis.BlsKeys = keeper.GetAllValidatorBlsKeys(ctx)
+
+	return genesis
 }
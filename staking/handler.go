@@ -33,6 +33,16 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 			return handleMsgStakingSync(ctx, msg, k, contractCaller)
 		case types.MsgStakingSyncAck:
 			return handleMsgStakingSyncAck(ctx, msg, k, contractCaller)
+		case types.MsgSetValidatorMetadata:
+			return handleMsgSetValidatorMetadata(ctx, msg, k)
+		case types.MsgConfigHash:
+			return handleMsgConfigHash(ctx, msg, k)
+		case types.MsgSetBlsKey:
+			return handleMsgSetBlsKey(ctx, msg, k)
+		case types.MsgValidatorSetSync:
+			return handleMsgValidatorSetSync(ctx, msg, k)
+		case types.MsgValidatorSetSyncAck:
+			return handleMsgValidatorSetSyncAck(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in staking module").Result()
 		}
@@ -366,3 +376,219 @@ func handleMsgStakingSyncAck(ctx sdk.Context, msg types.MsgStakingSyncAck, k Kee
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// handleMsgSetValidatorMetadata stores operational metadata submitted by validator signer
+func handleMsgSetValidatorMetadata(ctx sdk.Context, msg types.MsgSetValidatorMetadata, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating validator metadata msg",
+		"validatorId", msg.ID,
+		"from", msg.From,
+	)
+
+	// metadata can only be set by current signer of the validator
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Unable to fetch validator from store", "validatorId", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		k.Logger(ctx).Error("Metadata sender is not validator signer", "validatorId", msg.ID, "signer", validator.Signer, "from", msg.From)
+		return hmCommon.ErrValSignerMismatch(k.Codespace()).Result()
+	}
+
+	metadata := msg.GetMetadata(ctx.BlockTime().Unix())
+	if err := k.SetValidatorMetadata(ctx, metadata); err != nil {
+		k.Logger(ctx).Error("Unable to store validator metadata", "validatorId", msg.ID, "error", err)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorMetadata,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(msg.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, validator.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgConfigHash stores consensus config hash published by validator signer
+func handleMsgConfigHash(ctx sdk.Context, msg types.MsgConfigHash, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating config hash msg",
+		"validatorId", msg.ID,
+		"from", msg.From,
+		"hash", msg.Hash,
+	)
+
+	// config hash can only be published by current signer of the validator
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Unable to fetch validator from store", "validatorId", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		k.Logger(ctx).Error("Config hash sender is not validator signer", "validatorId", msg.ID, "signer", validator.Signer, "from", msg.From)
+		return hmCommon.ErrValSignerMismatch(k.Codespace()).Result()
+	}
+
+	configHash := types.NewValidatorConfigHash(msg.ID, msg.Hash, ctx.BlockTime().Unix())
+	if err := k.SetValidatorConfigHash(ctx, configHash); err != nil {
+		k.Logger(ctx).Error("Unable to store validator config hash", "validatorId", msg.ID, "error", err)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConfigHash,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(msg.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyConfigHash, msg.Hash.Hex()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgSetBlsKey registers BLS public key of validator once its proof of possession is verified
+func handleMsgSetBlsKey(ctx sdk.Context, msg types.MsgSetBlsKey, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating set BLS key msg",
+		"validatorId", msg.ID,
+		"from", msg.From,
+		"pubKey", msg.PubKey,
+	)
+
+	// BLS key can only be registered by current signer of the validator
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Unable to fetch validator from store", "validatorId", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		k.Logger(ctx).Error("BLS key sender is not validator signer", "validatorId", msg.ID, "signer", validator.Signer, "from", msg.From)
+		return hmCommon.ErrValSignerMismatch(k.Codespace()).Result()
+	}
+
+	if !helper.VerifyBlsProofOfPossession(msg.PubKey, msg.Proof) {
+		k.Logger(ctx).Error("Invalid BLS proof of possession", "validatorId", msg.ID)
+		return hmCommon.ErrInvalidBlsKey(k.Codespace()).Result()
+	}
+
+	// same key can't be aggregated twice
+	for _, blsKey := range k.GetAllValidatorBlsKeys(ctx) {
+		if blsKey.ValidatorID != msg.ID && blsKey.PubKey.Equals(msg.PubKey) {
+			k.Logger(ctx).Error("BLS key already registered by other validator", "validatorId", msg.ID, "other", blsKey.ValidatorID)
+			return hmCommon.ErrInvalidBlsKey(k.Codespace()).Result()
+		}
+	}
+
+	blsKey := types.NewValidatorBlsKey(msg.ID, msg.PubKey, ctx.BlockTime().Unix())
+	if err := k.SetValidatorBlsKey(ctx, blsKey); err != nil {
+		k.Logger(ctx).Error("Unable to store validator BLS key", "validatorId", msg.ID, "error", err)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetBlsKey,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(msg.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyBlsPubKey, msg.PubKey.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgValidatorSetSync validates validator set to be mirrored to secondary root chain
+func handleMsgValidatorSetSync(ctx sdk.Context, msg types.MsgValidatorSetSync, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating validator set sync",
+		"root", msg.RootChain,
+		"nonce", msg.Nonce,
+		"epoch", msg.Epoch,
+	)
+
+	if err := validateValidatorSetSync(ctx, msg, k); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorSetSync,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChain),
+			sdk.NewAttribute(types.AttributeKeyValidatorSetNonce, strconv.FormatUint(msg.Nonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(msg.Epoch, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// validateValidatorSetSync checks that msg carries next nonce and current validator set of new stake chain epoch
+func validateValidatorSetSync(ctx sdk.Context, msg types.MsgValidatorSetSync, k Keeper) sdk.Error {
+	logger := k.Logger(ctx)
+	record := k.GetValidatorSetSyncRecord(ctx, msg.RootChain)
+
+	if msg.Nonce != record.Nonce+1 {
+		logger.Error("Invalid validator set sync nonce", "root", msg.RootChain, "nonce", msg.Nonce, "lastNonce", record.Nonce)
+		return common.ErrInvalidMsg(k.Codespace(), "Invalid validator set sync nonce %v, expected %v", msg.Nonce, record.Nonce+1)
+	}
+
+	epoch := k.moduleCommunicator.GetACKCount(ctx)
+	if msg.Epoch != epoch || (record.Nonce > 0 && msg.Epoch <= record.Epoch) {
+		logger.Error("Invalid validator set sync epoch", "root", msg.RootChain, "epoch", msg.Epoch, "currentEpoch", epoch, "lastEpoch", record.Epoch)
+		return common.ErrInvalidMsg(k.Codespace(), "Invalid validator set sync epoch %v", msg.Epoch)
+	}
+
+	if !types.EqualSyncValidators(msg.Validators, k.GetSyncValidators(ctx)) {
+		logger.Error("Validator set in msg doesn't match current validator set", "root", msg.RootChain, "nonce", msg.Nonce)
+		return common.ErrInvalidMsg(k.Codespace(), "Validator set doesn't match current validator set")
+	}
+
+	return nil
+}
+
+// handleMsgValidatorSetSyncAck validates ack of validator set synced on secondary root chain
+func handleMsgValidatorSetSyncAck(ctx sdk.Context, msg types.MsgValidatorSetSyncAck, k Keeper) sdk.Result {
+	logger := k.Logger(ctx)
+	logger.Debug("✅ Validating validator set sync ack",
+		"root", msg.RootChain,
+		"nonce", msg.Nonce,
+	)
+
+	record := k.GetValidatorSetSyncRecord(ctx, msg.RootChain)
+	if !record.HasPending() || msg.Nonce != record.PendingNonce {
+		logger.Error("Invalid validator set sync ack",
+			"root", msg.RootChain,
+			"nonce", msg.Nonce,
+			"pendingNonce", record.PendingNonce,
+			"lastNonce", record.Nonce,
+		)
+		return common.ErrBadAck(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorSetSyncAck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChain),
+			sdk.NewAttribute(types.AttributeKeyValidatorSetNonce, strconv.FormatUint(msg.Nonce, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
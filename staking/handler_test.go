@@ -3,6 +3,7 @@ package staking_test
 import (
 	"math/big"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -367,3 +368,226 @@ func (suite *HandlerTestSuite) TestTopupSuccessBeforeValidatorJoin() {
 	require.True(t, result.IsOK(), "expected validator stake update to be ok, got %v", result)
 
 }
+
+func (suite *HandlerTestSuite) TestHandleMsgSetValidatorMetadata() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	validators := keeper.GetCurrentValidators(ctx)
+	val := validators[0]
+
+	contactHash := hmTypes.HexToHeimdallHash("0xabcd")
+
+	t.Run("Success", func(t *testing.T) {
+		msg := types.NewMsgSetValidatorMetadata(val.Signer, val.ID, "moniker", "https://example.com", "details", "https://example.com/hook", contactHash)
+		require.Nil(t, msg.ValidateBasic())
+
+		got := suite.handler(ctx, msg)
+		require.True(t, got.IsOK(), "expected set validator metadata to be ok, got %v", got)
+
+		metadata, err := keeper.GetValidatorMetadata(ctx, val.ID)
+		require.NoError(t, err)
+		require.Equal(t, "moniker", metadata.Moniker)
+		require.Equal(t, contactHash, metadata.ContactHash)
+	})
+
+	t.Run("SignerMismatch", func(t *testing.T) {
+		msg := types.NewMsgSetValidatorMetadata(validators[1].Signer, val.ID, "moniker", "", "", "", contactHash)
+
+		got := suite.handler(ctx, msg)
+		require.False(t, got.IsOK(), "expected set validator metadata to fail, got %v", got)
+		require.Equal(t, errs.CodeValSignerMismatch, got.Code)
+	})
+
+	t.Run("NoValidator", func(t *testing.T) {
+		msg := types.NewMsgSetValidatorMetadata(val.Signer, hmTypes.NewValidatorID(1000), "moniker", "", "", "", contactHash)
+
+		got := suite.handler(ctx, msg)
+		require.False(t, got.IsOK(), "expected set validator metadata to fail, got %v", got)
+		require.Equal(t, errs.CodeNoValidator, got.Code)
+	})
+
+	t.Run("InvalidMetadata", func(t *testing.T) {
+		msg := types.NewMsgSetValidatorMetadata(val.Signer, val.ID, strings.Repeat("a", types.MaxMonikerLength+1), "", "", "", contactHash)
+		require.NotNil(t, msg.ValidateBasic())
+
+		msg = types.NewMsgSetValidatorMetadata(val.Signer, val.ID, "moniker", "ftp://example.com", "", "", contactHash)
+		require.NotNil(t, msg.ValidateBasic())
+	})
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgSetBlsKey() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	validators := keeper.GetCurrentValidators(ctx)
+	val := validators[0]
+
+	sk := helper.BlsPrivKeyFromSeed([]byte("validator-0"))
+	pubKey := helper.BlsPubKey(sk)
+
+	t.Run("Success", func(t *testing.T) {
+		msg := types.NewMsgSetBlsKey(val.Signer, val.ID, pubKey, helper.BlsProofOfPossession(sk))
+		require.Nil(t, msg.ValidateBasic())
+
+		got := suite.handler(ctx, msg)
+		require.True(t, got.IsOK(), "expected set BLS key to be ok, got %v", got)
+
+		blsKey, err := keeper.GetValidatorBlsKey(ctx, val.ID)
+		require.NoError(t, err)
+		require.Equal(t, hmTypes.HexBytes(pubKey), blsKey.PubKey)
+	})
+
+	t.Run("InvalidProof", func(t *testing.T) {
+		other := helper.BlsPrivKeyFromSeed([]byte("validator-1"))
+		msg := types.NewMsgSetBlsKey(validators[1].Signer, validators[1].ID, helper.BlsPubKey(other), helper.BlsProofOfPossession(sk))
+
+		got := suite.handler(ctx, msg)
+		require.False(t, got.IsOK(), "expected set BLS key to fail, got %v", got)
+		require.Equal(t, errs.CodeInvalidBlsKey, got.Code)
+	})
+
+	t.Run("DuplicateKey", func(t *testing.T) {
+		msg := types.NewMsgSetBlsKey(validators[1].Signer, validators[1].ID, pubKey, helper.BlsProofOfPossession(sk))
+
+		got := suite.handler(ctx, msg)
+		require.False(t, got.IsOK(), "expected set BLS key to fail, got %v", got)
+		require.Equal(t, errs.CodeInvalidBlsKey, got.Code)
+	})
+
+	t.Run("SignerMismatch", func(t *testing.T) {
+		msg := types.NewMsgSetBlsKey(validators[1].Signer, val.ID, pubKey, helper.BlsProofOfPossession(sk))
+
+		got := suite.handler(ctx, msg)
+		require.False(t, got.IsOK(), "expected set BLS key to fail, got %v", got)
+		require.Equal(t, errs.CodeValSignerMismatch, got.Code)
+	})
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgConfigHash() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	validators := keeper.GetCurrentValidators(ctx)
+	val := validators[0]
+
+	hashA := hmTypes.HexToHeimdallHash("0xaaaa")
+	hashB := hmTypes.HexToHeimdallHash("0xbbbb")
+
+	t.Run("Missing", func(t *testing.T) {
+		report := keeper.GetConfigHashReport(ctx)
+		require.True(t, report.Supermajority.Empty())
+		require.Len(t, report.Validators, len(validators))
+		for _, status := range report.Validators {
+			require.True(t, status.Missing)
+			require.False(t, status.Mismatch)
+		}
+	})
+
+	t.Run("Supermajority", func(t *testing.T) {
+		for _, v := range validators {
+			got := suite.handler(ctx, types.NewMsgConfigHash(v.Signer, v.ID, hashA))
+			require.True(t, got.IsOK(), "expected config hash to be ok, got %v", got)
+		}
+
+		report := keeper.GetConfigHashReport(ctx)
+		require.Equal(t, hashA, report.Supermajority)
+		require.Equal(t, report.TotalPower, report.SupermajorityPower)
+		for _, status := range report.Validators {
+			require.False(t, status.Missing)
+			require.False(t, status.Mismatch)
+		}
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		got := suite.handler(ctx, types.NewMsgConfigHash(val.Signer, val.ID, hashB))
+		require.True(t, got.IsOK(), "expected config hash to be ok, got %v", got)
+
+		report := keeper.GetConfigHashReport(ctx)
+		if (report.TotalPower-val.VotingPower)*3 <= report.TotalPower*2 {
+			// remaining validators don't hold supermajority
+			require.True(t, report.Supermajority.Empty())
+			return
+		}
+
+		require.Equal(t, hashA, report.Supermajority)
+		for _, status := range report.Validators {
+			require.Equal(t, status.ValidatorID == val.ID, status.Mismatch)
+		}
+	})
+
+	t.Run("SignerMismatch", func(t *testing.T) {
+		got := suite.handler(ctx, types.NewMsgConfigHash(validators[1].Signer, val.ID, hashA))
+		require.False(t, got.IsOK(), "expected config hash to fail, got %v", got)
+		require.Equal(t, errs.CodeValSignerMismatch, got.Code)
+	})
+
+	t.Run("InvalidHash", func(t *testing.T) {
+		msg := types.NewMsgConfigHash(val.Signer, val.ID, hmTypes.HeimdallHash{})
+		require.NotNil(t, msg.ValidateBasic())
+	})
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgValidatorSetSync() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 5, hmTypes.RootChainTypeStake)
+
+	validators := keeper.GetSyncValidators(ctx)
+	from := hmTypes.BytesToHeimdallAddress([]byte("some-address"))
+
+	t.Run("InvalidNonce", func(t *testing.T) {
+		msg := types.NewMsgValidatorSetSync(from, hmTypes.RootChainTypeEth, 2, 5, validators)
+		got := suite.handler(ctx, msg)
+		require.False(t, got.IsOK(), "expected validator set sync to fail, got %v", got)
+		require.Equal(t, errs.CodeInvalidMsg, got.Code)
+	})
+
+	t.Run("InvalidEpoch", func(t *testing.T) {
+		msg := types.NewMsgValidatorSetSync(from, hmTypes.RootChainTypeEth, 1, 4, validators)
+		got := suite.handler(ctx, msg)
+		require.False(t, got.IsOK(), "expected validator set sync to fail, got %v", got)
+	})
+
+	t.Run("InvalidValidators", func(t *testing.T) {
+		msg := types.NewMsgValidatorSetSync(from, hmTypes.RootChainTypeEth, 1, 5, validators[1:])
+		got := suite.handler(ctx, msg)
+		require.False(t, got.IsOK(), "expected validator set sync to fail, got %v", got)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		msg := types.NewMsgValidatorSetSync(from, hmTypes.RootChainTypeEth, 1, 5, validators)
+		got := suite.handler(ctx, msg)
+		require.True(t, got.IsOK(), "expected validator set sync to be ok, got %v", got)
+	})
+
+	t.Run("AckWithoutPending", func(t *testing.T) {
+		msg := types.NewMsgValidatorSetSyncAck(from, hmTypes.RootChainTypeEth, 1)
+		got := suite.handler(ctx, msg)
+		require.False(t, got.IsOK(), "expected validator set sync ack to fail, got %v", got)
+		require.Equal(t, errs.CodeInvalidACK, got.Code)
+	})
+
+	t.Run("Ack", func(t *testing.T) {
+		err := keeper.SetValidatorSetSyncRecord(ctx, types.ValidatorSetSyncRecord{
+			RootChain:    hmTypes.RootChainTypeEth,
+			PendingNonce: 1,
+			PendingEpoch: 5,
+		})
+		require.NoError(t, err)
+
+		msg := types.NewMsgValidatorSetSyncAck(from, hmTypes.RootChainTypeEth, 1)
+		got := suite.handler(ctx, msg)
+		require.True(t, got.IsOK(), "expected validator set sync ack to be ok, got %v", got)
+	})
+
+	t.Run("StakeChain", func(t *testing.T) {
+		msg := types.NewMsgValidatorSetSync(from, hmTypes.RootChainTypeStake, 1, 5, validators)
+		require.NotNil(t, msg.ValidateBasic())
+	})
+}
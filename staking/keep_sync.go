@@ -137,3 +137,65 @@ func (k *Keeper) UpdateStakingRecordTimestamp(ctx sdk.Context, rootID byte, vali
 		}
 	}
 }
+
+//
+// validator set sync
+//
+
+// GetValidatorSetSyncKey returns key of validator set sync record of root chain
+func GetValidatorSetSyncKey(rootID byte) []byte {
+	return append(ValidatorSetSyncKey, rootID)
+}
+
+// SetValidatorSetSyncRecord stores validator set sync record of root chain
+func (k *Keeper) SetValidatorSetSyncRecord(ctx sdk.Context, record stakingTypes.ValidatorSetSyncRecord) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(record)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling validator set sync record", "error", err)
+		return err
+	}
+
+	store.Set(GetValidatorSetSyncKey(hmTypes.GetRootChainID(record.RootChain)), out)
+	return nil
+}
+
+// GetValidatorSetSyncRecord returns validator set sync record of root chain, empty record if nothing synced yet
+func (k *Keeper) GetValidatorSetSyncRecord(ctx sdk.Context, rootChain string) (record stakingTypes.ValidatorSetSyncRecord) {
+	record.RootChain = rootChain
+
+	store := ctx.KVStore(k.storeKey)
+	key := GetValidatorSetSyncKey(hmTypes.GetRootChainID(rootChain))
+	if !store.Has(key) {
+		return record
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &record); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling validator set sync record", "root", rootChain, "error", err)
+	}
+	return record
+}
+
+// GetAllValidatorSetSyncRecords returns validator set sync records of all root chains
+func (k *Keeper) GetAllValidatorSetSyncRecords(ctx sdk.Context) (records []stakingTypes.ValidatorSetSyncRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSetSyncKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record stakingTypes.ValidatorSetSyncRecord
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &record); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling validator set sync record", "error", err)
+			continue
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetSyncValidators returns current validator set in form mirrored to secondary root chains
+func (k *Keeper) GetSyncValidators(ctx sdk.Context) []stakingTypes.SyncValidator {
+	validatorSet := k.GetValidatorSet(ctx)
+	return stakingTypes.NewSyncValidators(validatorSet.Validators)
+}
@@ -23,6 +23,15 @@ var (
 	ValidatorMapKey        = []byte{0x22} // prefix for each key for validator map
 	CurrentValidatorSetKey = []byte{0x23} // Key to store current validator set
 	StakingSequenceKey     = []byte{0x24} // prefix for each key for staking sequence map
+	ValidatorMetadataKey   = []byte{0x25} // prefix for each key for validator metadata
+	ValidatorConfigHashKey = []byte{0x26} // prefix for each key for validator config hash
+	ValidatorSetSyncKey    = []byte{0x27} // prefix for each key for validator set sync record of root chain
+	PendingStakeUpdateKey  = []byte{0x28} // prefix for each key for stake update waiting for checkpoint ack
+	PendingSignerKey       = []byte{0x29} // prefix for each key for signer rotation waiting for activation
+	ValidatorBlsKeyKey     = []byte{0x2a} // prefix for each key for validator BLS public key
+	ValidatorSnapshotKey   = []byte{0x2b} // prefix for each key for validators snapshot by hash
+	CheckpointValSetKey    = []byte{0x2c} // prefix for each key for validator set snapshot reference of acked checkpoint
+	StakeUpdateNonceKey    = []byte{0x2d} // key set once stake updates are deduplicated by validator nonce
 
 	stakingSendingQueueKey = []byte{0x31} // prefix key for when storing staking sending queue
 
@@ -101,6 +110,21 @@ func GetStakingSequenceKey(sequence string) []byte {
 	return append(StakingSequenceKey, []byte(sequence)...)
 }
 
+// GetValidatorMetadataKey returns validator metadata key
+func GetValidatorMetadataKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorMetadataKey, valID.Bytes()...)
+}
+
+// GetValidatorConfigHashKey returns validator config hash key
+func GetValidatorConfigHashKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorConfigHashKey, valID.Bytes()...)
+}
+
+// GetValidatorBlsKeyKey returns validator BLS key key
+func GetValidatorBlsKeyKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorBlsKeyKey, valID.Bytes()...)
+}
+
 // AddValidator adds validator indexed with address
 func (k *Keeper) AddValidator(ctx sdk.Context, validator hmTypes.Validator) error {
 	// TODO uncomment
@@ -419,6 +443,17 @@ func (k *Keeper) HasStakingSequence(ctx sdk.Context, sequence string) bool {
 	return store.Has(GetStakingSequenceKey(sequence))
 }
 
+// IsStakeUpdateNonceCheckEnabled returns true if stake updates with nonce validator already reached are
+// rejected. It's enabled at genesis of new chains and by store migration of existing ones, so nodes
+// apply same stake updates on blocks of either side of the upgrade.
+func (k *Keeper) IsStakeUpdateNonceCheckEnabled(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(StakeUpdateNonceKey)
+}
+
+func (k *Keeper) enableStakeUpdateNonceCheck(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Set(StakeUpdateNonceKey, DefaultValue)
+}
+
 // GetStakingSequences checks if Staking already exists
 func (k *Keeper) GetStakingSequences(ctx sdk.Context) (sequences []string) {
 	k.IterateStakingSequencesAndApplyFn(ctx, func(sequence string) error {
@@ -447,6 +482,76 @@ func (k *Keeper) IterateStakingSequencesAndApplyFn(ctx sdk.Context, f func(seque
 	}
 }
 
+//
+// Validator metadata
+//
+
+// SetValidatorMetadata sets operational metadata for validator
+func (k *Keeper) SetValidatorMetadata(ctx sdk.Context, metadata types.ValidatorMetadata) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(metadata)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetValidatorMetadataKey(metadata.ValidatorID), bz)
+	return nil
+}
+
+// GetValidatorMetadata returns operational metadata for validator
+func (k *Keeper) GetValidatorMetadata(ctx sdk.Context, valID hmTypes.ValidatorID) (metadata types.ValidatorMetadata, err error) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetValidatorMetadataKey(valID)
+
+	if !store.Has(key) {
+		return metadata, errors.New("validator metadata not found")
+	}
+
+	if err = k.cdc.UnmarshalBinaryBare(store.Get(key), &metadata); err != nil {
+		return metadata, err
+	}
+
+	return metadata, nil
+}
+
+// HasValidatorMetadata checks if validator has registered metadata
+func (k *Keeper) HasValidatorMetadata(ctx sdk.Context, valID hmTypes.ValidatorID) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetValidatorMetadataKey(valID))
+}
+
+// GetAllValidatorMetadata returns metadata of all validators
+func (k *Keeper) GetAllValidatorMetadata(ctx sdk.Context) (metadataList []types.ValidatorMetadata) {
+	k.IterateValidatorMetadataAndApplyFn(ctx, func(metadata types.ValidatorMetadata) error {
+		metadataList = append(metadataList, metadata)
+		return nil
+	})
+	return
+}
+
+// IterateValidatorMetadataAndApplyFn iterate validator metadata and apply the given function.
+func (k *Keeper) IterateValidatorMetadataAndApplyFn(ctx sdk.Context, f func(metadata types.ValidatorMetadata) error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// get metadata iterator
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorMetadataKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.ValidatorMetadata
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &metadata); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling validator metadata", "error", err)
+			continue
+		}
+
+		// call function and return if required
+		if err := f(metadata); err != nil {
+			return
+		}
+	}
+}
+
 // Slashing api's
 // AddValidatorSigningInfo creates a signing info for validator
 func (k *Keeper) AddValidatorSigningInfo(ctx sdk.Context, valID hmTypes.ValidatorID, valSigningInfo hmTypes.ValidatorSigningInfo) error {
@@ -505,6 +610,132 @@ func (k *Keeper) Unjail(ctx sdk.Context, valID hmTypes.ValidatorID) {
 
 }
 
+//
+// Validator config hash
+//
+
+// SetValidatorConfigHash sets config hash published by validator
+func (k *Keeper) SetValidatorConfigHash(ctx sdk.Context, configHash types.ValidatorConfigHash) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(configHash)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetValidatorConfigHashKey(configHash.ValidatorID), bz)
+	return nil
+}
+
+// GetValidatorConfigHash returns config hash published by validator
+func (k *Keeper) GetValidatorConfigHash(ctx sdk.Context, valID hmTypes.ValidatorID) (configHash types.ValidatorConfigHash, err error) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetValidatorConfigHashKey(valID)
+
+	if !store.Has(key) {
+		return configHash, errors.New("validator config hash not found")
+	}
+
+	if err = k.cdc.UnmarshalBinaryBare(store.Get(key), &configHash); err != nil {
+		return configHash, err
+	}
+
+	return configHash, nil
+}
+
+//
+// Validator BLS key
+//
+
+// SetValidatorBlsKey sets BLS public key registered by validator
+func (k *Keeper) SetValidatorBlsKey(ctx sdk.Context, blsKey types.ValidatorBlsKey) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(blsKey)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetValidatorBlsKeyKey(blsKey.ValidatorID), bz)
+	return nil
+}
+
+// GetValidatorBlsKey returns BLS public key registered by validator
+func (k *Keeper) GetValidatorBlsKey(ctx sdk.Context, valID hmTypes.ValidatorID) (blsKey types.ValidatorBlsKey, err error) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetValidatorBlsKeyKey(valID)
+
+	if !store.Has(key) {
+		return blsKey, errors.New("validator BLS key not found")
+	}
+
+	if err = k.cdc.UnmarshalBinaryBare(store.Get(key), &blsKey); err != nil {
+		return blsKey, err
+	}
+
+	return blsKey, nil
+}
+
+// GetAllValidatorBlsKeys returns BLS public keys of all validators
+func (k *Keeper) GetAllValidatorBlsKeys(ctx sdk.Context) (blsKeys []types.ValidatorBlsKey) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorBlsKeyKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var blsKey types.ValidatorBlsKey
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &blsKey); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling validator BLS key", "error", err)
+			continue
+		}
+
+		blsKeys = append(blsKeys, blsKey)
+	}
+
+	return blsKeys
+}
+
+// GetConfigHashReport compares config hashes of current validators. Hash published
+// by validators holding more than 2/3 of voting power is reference, validators with
+// other hash are flagged as mismatched.
+func (k *Keeper) GetConfigHashReport(ctx sdk.Context) (report types.ConfigHashReport) {
+	powers := make(map[hmTypes.HeimdallHash]int64)
+
+	for _, validator := range k.GetCurrentValidators(ctx) {
+		status := types.ConfigHashStatus{
+			ValidatorID: validator.ID,
+			Signer:      validator.Signer,
+			Power:       validator.VotingPower,
+			Missing:     true,
+		}
+
+		if configHash, err := k.GetValidatorConfigHash(ctx, validator.ID); err == nil {
+			status.Hash, status.UpdatedAt, status.Missing = configHash.Hash, configHash.UpdatedAt, false
+			powers[configHash.Hash] += validator.VotingPower
+		}
+
+		report.TotalPower += validator.VotingPower
+		report.Validators = append(report.Validators, status)
+	}
+
+	for hash, power := range powers {
+		if power*3 > report.TotalPower*2 {
+			report.Supermajority, report.SupermajorityPower = hash, power
+		}
+	}
+
+	if report.Supermajority.Empty() {
+		return report
+	}
+
+	for i, status := range report.Validators {
+		report.Validators[i].Mismatch = !status.Missing && !status.Hash.Equals(report.Supermajority)
+	}
+
+	return report
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
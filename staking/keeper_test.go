@@ -12,12 +12,15 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 
 	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/staking"
 
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	stakingSim "github.com/maticnetwork/heimdall/staking/simulation"
 
 	"github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
 	"github.com/maticnetwork/heimdall/types/simulation"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -405,3 +408,94 @@ func (suite *KeeperTestSuite) TestUpdateStakingSync() {
 	fmt.Println(stakingBufferTime)
 	require.Equal(t, result.TimeStamp >= now && result.TimeStamp-now < stakingBufferTime, true)
 }
+
+func (suite *KeeperTestSuite) TestValidatorMetadata() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	_, err := keeper.GetValidatorMetadata(ctx, hmTypes.NewValidatorID(1))
+	require.Error(t, err)
+	require.False(t, keeper.HasValidatorMetadata(ctx, hmTypes.NewValidatorID(1)))
+
+	for i := 1; i <= 3; i++ {
+		metadata := stakingTypes.NewValidatorMetadata(
+			hmTypes.NewValidatorID(uint64(i)),
+			fmt.Sprintf("validator-%v", i),
+			"https://example.com",
+			"",
+			"",
+			hmTypes.ZeroHeimdallHash,
+			ctx.BlockTime().Unix(),
+		)
+		require.NoError(t, keeper.SetValidatorMetadata(ctx, metadata))
+	}
+
+	metadata, err := keeper.GetValidatorMetadata(ctx, hmTypes.NewValidatorID(2))
+	require.NoError(t, err)
+	require.Equal(t, "validator-2", metadata.Moniker)
+	require.True(t, keeper.HasValidatorMetadata(ctx, hmTypes.NewValidatorID(2)))
+	require.Len(t, keeper.GetAllValidatorMetadata(ctx), 3)
+}
+
+func (suite *KeeperTestSuite) TestValidatorSetAtCheckpoint() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+
+	_, err := keeper.GetValidatorSetAtCheckpoint(ctx, 1, hmTypes.RootChainTypeEth)
+	require.Error(t, err)
+
+	acked := keeper.GetValidatorSet(ctx)
+	require.NoError(t, keeper.SnapshotValidatorSet(ctx, 1, hmTypes.RootChainTypeEth))
+
+	// proposer rotation and power change after ack don't change recorded set
+	keeper.IncrementAccum(ctx, 1)
+	require.NoError(t, keeper.SnapshotValidatorSet(ctx, 2, hmTypes.RootChainTypeEth))
+
+	updated := keeper.GetValidatorSet(ctx)
+	updated.Validators[0].VotingPower += 100
+	require.NoError(t, keeper.UpdateValidatorSetInStore(ctx, updated))
+	require.NoError(t, keeper.SnapshotValidatorSet(ctx, 3, hmTypes.RootChainTypeEth))
+
+	first, err := keeper.GetValidatorSetAtCheckpoint(ctx, 1, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Len(t, first.ValidatorSet.Validators, len(acked.Validators))
+	require.Equal(t, acked.Proposer.ID, first.ValidatorSet.Proposer.ID)
+	for i, validator := range first.ValidatorSet.Validators {
+		require.Equal(t, acked.Validators[i].Signer, validator.Signer)
+		require.Equal(t, acked.Validators[i].VotingPower, validator.VotingPower)
+	}
+
+	second, err := keeper.GetValidatorSetAtCheckpoint(ctx, 2, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, first.ValidatorSet.Validators, second.ValidatorSet.Validators)
+
+	third, err := keeper.GetValidatorSetAtCheckpoint(ctx, 3, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, acked.Validators[0].VotingPower+100, third.ValidatorSet.Validators[0].VotingPower)
+
+	// root chains are kept apart
+	_, err = keeper.GetValidatorSetAtCheckpoint(ctx, 1, hmTypes.RootChainTypeBsc)
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestStakeUpdateNonceCheckMigration() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// new chains deduplicate stake updates from genesis
+	require.True(t, keeper.IsStakeUpdateNonceCheckEnabled(ctx))
+
+	// chain started before nonce check applies stake updates as they are
+	ctx.KVStore(app.GetKey(stakingTypes.StoreKey)).Delete(staking.StakeUpdateNonceKey)
+	require.False(t, keeper.IsStakeUpdateNonceCheckEnabled(ctx))
+
+	cfg := hmModule.NewConfigurator()
+	require.NoError(t, staking.NewAppModule(keeper, &mocks.IContractCaller{}).RegisterMigrations(cfg))
+	fromVM := hmModule.GetVersionMap(app.GetModuleManager())
+	fromVM[stakingTypes.ModuleName] = 1
+	vm, err := cfg.RunMigrations(ctx, app.GetModuleManager(), fromVM)
+	require.NoError(t, err)
+	require.Equal(t, staking.ConsensusVersion, vm[stakingTypes.ModuleName])
+	require.True(t, keeper.IsStakeUpdateNonceCheckEnabled(ctx))
+}
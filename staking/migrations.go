@@ -0,0 +1,13 @@
+package staking
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// migrateStakeUpdateNonceCheck enables deduplication of stake updates by validator nonce (v1 -> v2).
+// Stake updates delivered from now on are rejected once validator reached their nonce.
+func migrateStakeUpdateNonceCheck(ctx sdk.Context, k Keeper) error {
+	k.enableStakeUpdateNonceCheck(ctx)
+	k.Logger(ctx).Info("Enabled stake update nonce check")
+	return nil
+}
@@ -27,9 +27,14 @@ var (
 	_ module.AppModule             = AppModule{}
 	_ module.AppModuleBasic        = AppModuleBasic{}
 	_ hmModule.HeimdallModuleBasic = AppModule{}
+	_ hmModule.HasConsensusVersion = AppModule{}
+	_ hmModule.HasMigrations       = AppModule{}
 	// _ module.AppModuleSimulation = AppModule{}
 )
 
+// ConsensusVersion store layout version of the module
+const ConsensusVersion uint64 = 2
+
 // AppModuleBasic defines the basic application module used by the auth module.
 type AppModuleBasic struct{}
 
@@ -159,6 +164,21 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper, am.contractCaller)
 }
 
+// ConsensusVersion returns store layout version of the staking module
+func (AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+// RegisterMigrations registers store migrations of the staking module.
+// Bump ConsensusVersion and register migration from previous version
+// whenever key layout of the module changes.
+func (am AppModule) RegisterMigrations(cfg *hmModule.Configurator) error {
+	// v1 -> v2: stake updates are deduplicated by validator nonce
+	return cfg.RegisterMigration(types.ModuleName, 1, func(ctx sdk.Context) error {
+		return migrateStakeUpdateNonceCheck(ctx, am.keeper)
+	})
+}
+
 // InitGenesis performs genesis initialization for the auth module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
@@ -175,8 +195,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return types.ModuleCdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the auth module.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock applies signer rotations which reached their activation height.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.ApplyPendingSignerRotations(ctx, false)
+}
 
 // EndBlock returns the end blocker for the auth module. It returns no validator
 // updates.
@@ -213,7 +235,12 @@ func (AppModule) RegisterStoreDecoder(sdr hmModule.StoreDecoderRegistry) {
 	return
 }
 
-// WeightedOperations doesn't return any chainmanager module operation.
-func (AppModule) WeightedOperations(_ hmModule.SimulationState) []simTypes.WeightedOperation {
-	return nil
+// WeightedOperations returns stake update operation with randomized side-tx votes.
+func (am AppModule) WeightedOperations(simState hmModule.SimulationState) []simTypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState,
+		&am.keeper,
+		NewHandler(am.keeper, am.contractCaller),
+		NewPostTxHandler(am.keeper, am.contractCaller),
+	)
 }
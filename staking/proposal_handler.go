@@ -0,0 +1,71 @@
+package staking
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/staking/types"
+)
+
+// NewValidatorReplacementProposalHandler new force validator exit and force unjail proposal handler
+func NewValidatorReplacementProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.ForceValidatorExitProposal:
+			return handleForceValidatorExitProposal(ctx, k, c)
+
+		case types.ForceUnjailProposal:
+			return handleForceUnjailProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized staking proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+// handleForceValidatorExitProposal removes validator from validator set without stake withdrawal on root chain
+func handleForceValidatorExitProposal(ctx sdk.Context, k Keeper, p types.ForceValidatorExitProposal) sdk.Error {
+	endEpoch, err := k.ForceValidatorExit(ctx, p.ValidatorID)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to force validator exit", "validatorID", p.ValidatorID, "error", err)
+		return hmCommon.ErrValIsNotCurrentVal(k.Codespace())
+	}
+
+	k.Logger(ctx).Info("✅ Validator exit forced by governance", "validatorID", p.ValidatorID, "endEpoch", endEpoch)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeForceValidatorExit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, p.ValidatorID.String()),
+			sdk.NewAttribute(types.AttributeKeyDeactivationEpoch, strconv.FormatUint(endEpoch, 10)),
+		),
+	})
+
+	return nil
+}
+
+// handleForceUnjailProposal unjails validator without unjail tx on root chain
+func handleForceUnjailProposal(ctx sdk.Context, k Keeper, p types.ForceUnjailProposal) sdk.Error {
+	if err := k.ForceUnjail(ctx, p.ValidatorID); err != nil {
+		k.Logger(ctx).Error("Unable to force unjail", "validatorID", p.ValidatorID, "error", err)
+		return hmCommon.ErrUnjailValidator(k.Codespace())
+	}
+
+	k.Logger(ctx).Info("✅ Validator unjailed by governance", "validatorID", p.ValidatorID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeForceUnjail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, p.ValidatorID.String()),
+		),
+	})
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package staking_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/staking"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestValidatorReplacementProposals(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+	keeper := happ.StakingKeeper
+	handler := staking.NewValidatorReplacementProposalHandler(keeper)
+
+	chSim.LoadValidatorSet(2, t, keeper, ctx, false, 10)
+	validators := keeper.GetCurrentValidators(ctx)
+	require.Len(t, validators, 2)
+	target := validators[0]
+
+	// unknown validator
+	require.NotNil(t, handler(ctx, types.NewForceValidatorExitProposal("title", "description", hmTypes.NewValidatorID(1000))))
+
+	// validator which isn't jailed can't be unjailed
+	require.NotNil(t, handler(ctx, types.NewForceUnjailProposal("title", "description", target.ID)))
+
+	// validator is removed at current epoch, with accum reset
+	require.Nil(t, handler(ctx, types.NewForceValidatorExitProposal("title", "description", target.ID)))
+
+	exited, ok := keeper.GetValidatorFromValID(ctx, target.ID)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), exited.EndEpoch)
+	require.Equal(t, int64(0), exited.ProposerPriority)
+	require.Len(t, keeper.GetCurrentValidators(ctx), 1)
+
+	// exited validator can't be forced out again, nor unjailed
+	require.NotNil(t, handler(ctx, types.NewForceValidatorExitProposal("title", "description", target.ID)))
+	require.NotNil(t, handler(ctx, types.NewForceUnjailProposal("title", "description", target.ID)))
+
+	// last validator can't be removed
+	remaining := keeper.GetCurrentValidators(ctx)[0]
+	require.NotNil(t, handler(ctx, types.NewForceValidatorExitProposal("title", "description", remaining.ID)))
+
+	// jailed validator joins validator set again
+	remaining.Jailed = true
+	remaining.ProposerPriority = 5
+	require.NoError(t, keeper.AddValidator(ctx, remaining))
+	require.Empty(t, keeper.GetCurrentValidators(ctx))
+
+	require.Nil(t, handler(ctx, types.NewForceUnjailProposal("title", "description", remaining.ID)))
+
+	unjailed, ok := keeper.GetValidatorFromValID(ctx, remaining.ID)
+	require.True(t, ok)
+	require.False(t, unjailed.Jailed)
+	require.Equal(t, int64(0), unjailed.ProposerPriority)
+	require.Len(t, keeper.GetCurrentValidators(ctx), 1)
+}
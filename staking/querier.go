@@ -38,6 +38,24 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryNextStaking(ctx, req, keeper)
 		case types.QueryStakingQueue:
 			return handleQueryStakingQueue(ctx, req, keeper)
+		case types.QueryValidatorMetadata:
+			return handleQueryValidatorMetadata(ctx, req, keeper)
+		case types.QueryAllValidatorMetadata:
+			return handleQueryAllValidatorMetadata(ctx, req, keeper)
+		case types.QueryConfigHashes:
+			return handleQueryConfigHashes(ctx, req, keeper)
+		case types.QueryValidatorSetSync:
+			return handleQueryValidatorSetSync(ctx, req, keeper)
+		case types.QueryPendingStakeUpdates:
+			return handleQueryPendingStakeUpdates(ctx, req, keeper)
+		case types.QueryPendingSignerRotations:
+			return handleQueryPendingSignerRotations(ctx, req, keeper)
+		case types.QueryBlsKey:
+			return handleQueryBlsKey(ctx, req, keeper)
+		case types.QueryBlsKeys:
+			return handleQueryBlsKeys(ctx, req, keeper)
+		case types.QueryValidatorSetAtCheckpoint:
+			return handleQueryValidatorSetAtCheckpoint(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -240,3 +258,143 @@ func handleQueryStakingQueue(ctx sdk.Context, req abci.RequestQuery, keeper Keep
 	}
 	return bz, nil
 }
+
+func handleQueryValidatorMetadata(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	metadata, err := keeper.GetValidatorMetadata(ctx, params.ValidatorID)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("Error while getting validator metadata", err.Error()))
+	}
+
+	bz, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAllValidatorMetadata(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	metadataList := keeper.GetAllValidatorMetadata(ctx)
+	if metadataList == nil {
+		metadataList = []types.ValidatorMetadata{}
+	}
+
+	bz, err := json.Marshal(metadataList)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryConfigHashes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	report := keeper.GetConfigHashReport(ctx)
+	if report.Validators == nil {
+		report.Validators = []types.ConfigHashStatus{}
+	}
+
+	bz, err := json.Marshal(report)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryBlsKey(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	blsKey, err := keeper.GetValidatorBlsKey(ctx, params.ValidatorID)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("Error while getting validator BLS key", err.Error()))
+	}
+
+	bz, err := json.Marshal(blsKey)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryBlsKeys(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	blsKeys := keeper.GetAllValidatorBlsKeys(ctx)
+	if blsKeys == nil {
+		blsKeys = []types.ValidatorBlsKey{}
+	}
+
+	bz, err := json.Marshal(blsKeys)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorSetSync(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStakingParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if hmTypes.GetRootChainID(params.RootChain) == 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid root chain %v", params.RootChain))
+	}
+
+	bz, err := json.Marshal(keeper.GetValidatorSetSyncRecord(ctx, params.RootChain))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorSetAtCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStakingParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if hmTypes.GetRootChainID(params.RootChain) == 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid root chain %v", params.RootChain))
+	}
+
+	validatorSet, err := keeper.GetValidatorSetAtCheckpoint(ctx, params.Number, params.RootChain)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	bz, err := json.Marshal(validatorSet)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryPendingStakeUpdates(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	updates := keeper.GetPendingStakeUpdates(ctx)
+	if updates == nil {
+		updates = []types.PendingStakeUpdate{}
+	}
+
+	bz, err := json.Marshal(updates)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryPendingSignerRotations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	rotations := keeper.GetPendingSignerRotations(ctx)
+	if rotations == nil {
+		rotations = []types.PendingSignerRotation{}
+	}
+
+	bz, err := json.Marshal(rotations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
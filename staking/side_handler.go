@@ -2,13 +2,14 @@ package staking
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"math/big"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethCommon "github.com/maticnetwork/bor/common"
 	ethTypes "github.com/maticnetwork/bor/core/types"
-	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/common"
 	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
@@ -30,12 +31,16 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 			return SideHandleMsgValidatorExit(ctx, msg, k, contractCaller)
 		case types.MsgSignerUpdate:
 			return SideHandleMsgSignerUpdate(ctx, msg, k, contractCaller)
-		//case types.MsgStakeUpdate:
-		//	return SideHandleMsgStakeUpdate(ctx, msg, k, contractCaller)
+		case types.MsgStakeUpdate:
+			return SideHandleMsgStakeUpdate(ctx, msg, k, contractCaller)
 		case types.MsgStakingSync:
 			return SideHandleMsgStakingSync(ctx, msg, k, contractCaller)
 		case types.MsgStakingSyncAck:
 			return SideHandleMsgStakingSyncAck(ctx, msg, k, contractCaller)
+		case types.MsgValidatorSetSync:
+			return SideHandleMsgValidatorSetSync(ctx, msg, k, contractCaller)
+		case types.MsgValidatorSetSyncAck:
+			return SideHandleMsgValidatorSetSyncAck(ctx, msg, k, contractCaller)
 		default:
 			return abci.ResponseDeliverSideTx{
 				Code: uint32(sdk.CodeUnknownRequest),
@@ -56,12 +61,16 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 			return PostHandleMsgValidatorExit(ctx, k, msg, sideTxResult)
 		case types.MsgSignerUpdate:
 			return PostHandleMsgSignerUpdate(ctx, k, msg, sideTxResult)
-		//case types.MsgStakeUpdate:
-		//	return PostHandleMsgStakeUpdate(ctx, k, msg, sideTxResult)
+		case types.MsgStakeUpdate:
+			return PostHandleMsgStakeUpdate(ctx, k, msg, sideTxResult)
 		case types.MsgStakingSync:
 			return PostHandleMsgStakingSync(ctx, k, msg, sideTxResult)
 		case types.MsgStakingSyncAck:
 			return PostHandleMsgStakingSyncAck(ctx, k, msg, sideTxResult)
+		case types.MsgValidatorSetSync:
+			return PostHandleMsgValidatorSetSync(ctx, k, msg, sideTxResult)
+		case types.MsgValidatorSetSyncAck:
+			return PostHandleMsgValidatorSetSyncAck(ctx, k, msg, sideTxResult)
 		default:
 			return sdk.ErrUnknownRequest("Unrecognized Staking Msg type").Result()
 		}
@@ -89,9 +98,9 @@ func SideHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k K
 	// get event log on tron
 	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
 	}
-	contractAddress = hmTypes.HexToTronAddress(chainParams.TronStakingInfoAddress)
+	contractAddress = chainParams.TronStakingInfoAddress.EthAddress()
 	// decode validator join event
 	eventLog, err := contractCaller.DecodeValidatorJoinEvent(contractAddress, receipt, msg.LogIndex)
 	if err != nil || eventLog == nil {
@@ -158,55 +167,56 @@ func SideHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k K
 }
 
 // SideHandleMsgStakeUpdate handles stake update message
-//func SideHandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
-//	k.Logger(ctx).Debug("✅ Validating External call for stake update msg",
-//		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
-//		"logIndex", uint64(msg.LogIndex),
-//		"blockNumber", msg.BlockNumber,
-//	)
-//
-//	// chainManager params
-//	params := k.chainKeeper.GetParams(ctx)
-//	chainParams := params.ChainParams
-//
-//	// get main tx receipt
-//	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
-//	if err != nil || receipt == nil {
-//		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
-//	}
-//
-//	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
-//	if err != nil || eventLog == nil {
-//		k.Logger(ctx).Error("Error fetching log from txhash")
-//		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
-//	}
-//
-//	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
-//		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64)
-//		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
-//	}
-//
-//	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-//		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
-//		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
-//	}
-//
-//	// check Amount
-//	if eventLog.NewAmount.Cmp(msg.NewAmount.BigInt()) != 0 {
-//		k.Logger(ctx).Error("NewAmount in message doesn't match NewAmount in event logs", "MsgNewAmount", msg.NewAmount, "NewAmountFromEvent", eventLog.NewAmount)
-//		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
-//	}
-//
-//	// check nonce
-//	if eventLog.Nonce.Uint64() != msg.Nonce {
-//		k.Logger(ctx).Error("Nonce in message doesn't match with nonce in log", "msgNonce", msg.Nonce, "nonceFromTx", eventLog.Nonce)
-//		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
-//	}
-//
-//	k.Logger(ctx).Debug("✅ Succesfully validated External call for stake update msg")
-//	result.Result = abci.SideTxResultType_Yes
-//	return
-//}
+func SideHandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for stake update msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// chainManager params
+	params := k.chainKeeper.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	// get event log on tron
+	receipt, err := contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
+	}
+	contractAddress := chainParams.TronStakingInfoAddress.EthAddress()
+
+	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(contractAddress, receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// check Amount
+	if eventLog.NewAmount.Cmp(msg.NewAmount.BigInt()) != 0 {
+		k.Logger(ctx).Error("NewAmount in message doesn't match NewAmount in event logs", "MsgNewAmount", msg.NewAmount, "NewAmountFromEvent", eventLog.NewAmount)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// check nonce
+	if eventLog.Nonce.Uint64() != msg.Nonce {
+		k.Logger(ctx).Error("Nonce in message doesn't match with nonce in log", "msgNonce", msg.Nonce, "nonceFromTx", eventLog.Nonce)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for stake update msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
 
 // SideHandleMsgSignerUpdate handles signer update message
 func SideHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
@@ -228,9 +238,9 @@ func SideHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Kee
 	// get event log on tron
 	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
 	}
-	contractAddress = hmTypes.HexToTronAddress(chainParams.TronStakingInfoAddress)
+	contractAddress = chainParams.TronStakingInfoAddress.EthAddress()
 
 	newPubKey := msg.NewSignerPubKey
 	newSigner := newPubKey.Address()
@@ -293,9 +303,9 @@ func SideHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k K
 	// get event log on tron
 	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
 	}
-	contractAddress = hmTypes.HexToTronAddress(chainParams.TronStakingInfoAddress)
+	contractAddress = chainParams.TronStakingInfoAddress.EthAddress()
 
 	// decode validator exit
 	eventLog, err := contractCaller.DecodeValidatorExitEvent(contractAddress, receipt, msg.LogIndex)
@@ -359,7 +369,7 @@ func SideHandleMsgStakingSync(ctx sdk.Context, msg types.MsgStakingSync, k Keepe
 		stakingManagerInstance, _ := contractCaller.GetStakeManagerInstance(stakingManagerAddress, msg.RootChain)
 		nonce = contractCaller.GetMainStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerInstance)
 	case hmTypes.RootChainTypeTron:
-		stakingManagerAddress := chainParams.TronStakingManagerAddress
+		stakingManagerAddress := chainParams.TronStakingManagerAddress.Hex()
 		nonce = contractCaller.GetTronStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerAddress)
 	}
 	if nonce >= msg.Nonce {
@@ -402,7 +412,7 @@ func SideHandleMsgStakingSyncAck(ctx sdk.Context, msg types.MsgStakingSyncAck, k
 		stakingManagerInstance, _ := contractCaller.GetStakeManagerInstance(stakingManagerAddress, msg.RootChain)
 		nonce = contractCaller.GetMainStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerInstance)
 	case hmTypes.RootChainTypeTron:
-		stakingManagerAddress := chainParams.TronStakingManagerAddress
+		stakingManagerAddress := chainParams.TronStakingManagerAddress.Hex()
 		nonce = contractCaller.GetTronStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerAddress)
 	}
 	if nonce < msg.Nonce {
@@ -415,6 +425,74 @@ func SideHandleMsgStakingSyncAck(ctx sdk.Context, msg types.MsgStakingSyncAck, k
 	return
 }
 
+// SideHandleMsgValidatorSetSync side msg validator set sync
+func SideHandleMsgValidatorSetSync(ctx sdk.Context, msg types.MsgValidatorSetSync, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	logger := k.Logger(ctx)
+	logger.Debug("✅ Validating External call for validator set sync msg",
+		"root", msg.RootChain,
+		"nonce", msg.Nonce,
+		"epoch", msg.Epoch,
+	)
+
+	nonce, err := getValidatorSetSyncNonce(ctx, k, contractCaller, msg.RootChain)
+	if err != nil {
+		logger.Error("Unable to fetch validator set sync nonce", "root", msg.RootChain, "error", err)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
+	}
+
+	if nonce >= msg.Nonce {
+		logger.Error("Validator set with nonce is already synced on root", "msgNonce", msg.Nonce, "nonceFromRoot", nonce, "root", msg.RootChain)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	logger.Debug("✅ Successfully validated External call for validator set sync msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// SideHandleMsgValidatorSetSyncAck side msg validator set sync ack
+func SideHandleMsgValidatorSetSyncAck(ctx sdk.Context, msg types.MsgValidatorSetSyncAck, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	logger := k.Logger(ctx)
+	logger.Debug("✅ Validating External call for validator set sync ack msg",
+		"root", msg.RootChain,
+		"nonce", msg.Nonce,
+	)
+
+	nonce, err := getValidatorSetSyncNonce(ctx, k, contractCaller, msg.RootChain)
+	if err != nil {
+		logger.Error("Unable to fetch validator set sync nonce", "root", msg.RootChain, "error", err)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
+	}
+
+	if nonce < msg.Nonce {
+		logger.Error("Nonce in message is bigger than validator set nonce in root", "msgNonce", msg.Nonce, "nonceFromRoot", nonce, "root", msg.RootChain)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	logger.Debug("✅ Successfully validated External call for validator set sync ack msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// getValidatorSetSyncNonce returns nonce of last validator set synced to stake manager of secondary root chain
+func getValidatorSetSyncNonce(ctx sdk.Context, k Keeper, contractCaller helper.IContractCaller, rootChain string) (uint64, error) {
+	if rootChain == hmTypes.RootChainTypeStake {
+		return 0, errors.New("validator set is not synced to stake chain")
+	}
+
+	params, err := k.chainKeeper.GetEffectiveParams(ctx, rootChain)
+	if err != nil {
+		return 0, err
+	}
+
+	stakingManagerInstance, err := contractCaller.GetStakeManagerInstance(params.ChainParams.StakingManagerAddress.EthAddress(), rootChain)
+	if err != nil {
+		return 0, err
+	}
+
+	return contractCaller.GetValidatorSetSyncNonce(stakingManagerInstance), nil
+}
+
 /*
 	Post Handlers - update the state of the tx
 **/
@@ -519,95 +597,10 @@ func PostHandleMsgValidatorJoin(ctx sdk.Context, k Keeper, msg types.MsgValidato
 }
 
 // PostHandleMsgStakeUpdate handles stake update message
-//func PostHandleMsgStakeUpdate(ctx sdk.Context, k Keeper, msg types.MsgStakeUpdate, sideTxResult abci.SideTxResultType) sdk.Result {
-//	// Skip handler if stakeUpdate is not approved
-//	if sideTxResult != abci.SideTxResultType_Yes {
-//		k.Logger(ctx).Debug("Skipping stake update since side-tx didn't get yes votes")
-//		return common.ErrSideTxValidation(k.Codespace()).Result()
-//	}
-//
-//	// Check for replay attack
-//	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-//	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-//	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-//
-//	// check if incoming tx is older
-//	if k.HasStakingSequence(ctx, sequence.String()) {
-//		k.Logger(ctx).Error("Older invalid tx found")
-//		return hmCommon.ErrOldTx(k.Codespace()).Result()
-//	}
-//
-//	k.Logger(ctx).Debug("Updating validator stake", "sideTxResult", sideTxResult)
-//
-//	// pull validator from store
-//	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
-//	if !ok {
-//		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
-//		return hmCommon.ErrNoValidator(k.Codespace()).Result()
-//	}
-//
-//	// update last updated
-//	validator.LastUpdated = sequence.String()
-//
-//	// update nonce
-//	validator.Nonce = msg.Nonce
-//
-//	// set validator amount
-//	p, err := helper.GetPowerFromAmount(msg.NewAmount.BigInt())
-//	if err != nil {
-//		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Invalid amount %v for validator %v", msg.NewAmount, msg.ID)).Result()
-//	}
-//	validator.VotingPower = p.Int64()
-//
-//	// save validator
-//	err = k.AddValidator(ctx, validator)
-//	if err != nil {
-//		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
-//		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
-//	}
-//
-//	// save staking sequence
-//	k.SetStakingSequence(ctx, sequence.String())
-//
-//	// TX bytes
-//	txBytes := ctx.TxBytes()
-//	hash := tmTypes.Tx(txBytes).Hash()
-//
-//	ctx.EventManager().EmitEvents(sdk.Events{
-//		sdk.NewEvent(
-//			types.EventTypeStakeUpdate,
-//			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
-//			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-//			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
-//			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
-//			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
-//			sdk.NewAttribute(types.AttributeKeyValidatorNonce, strconv.FormatUint(msg.Nonce, 10)),
-//		),
-//	})
-//
-//	// save staking record
-//	for root, rootID := range hmTypes.GetRootChainIDMap() {
-//		if root != hmTypes.RootChainTypeStake {
-//			k.AddStakingRecordToQueue(ctx, rootID, types.StakingRecord{
-//				Type:        "stakeUpdate",
-//				ValidatorID: msg.ID,
-//				Nonce:       msg.Nonce,
-//				Height:      ctx.BlockHeight(),
-//				TxHash:      hmTypes.BytesToHeimdallHash(hash),
-//			})
-//		}
-//	}
-//
-//	return sdk.Result{
-//		Events: ctx.EventManager().Events(),
-//	}
-//}
-
-// PostHandleMsgSignerUpdate handles signer update message
-func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpdate, sideTxResult abci.SideTxResultType) sdk.Result {
-	// Skip handler if signer update is not approved
+func PostHandleMsgStakeUpdate(ctx sdk.Context, k Keeper, msg types.MsgStakeUpdate, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if stakeUpdate is not approved
 	if sideTxResult != abci.SideTxResultType_Yes {
-		k.Logger(ctx).Debug("Skipping signer update since side-tx didn't get yes votes")
+		k.Logger(ctx).Debug("Skipping stake update since side-tx didn't get yes votes")
 		return common.ErrSideTxValidation(k.Codespace()).Result()
 	}
 
@@ -615,16 +608,14 @@ func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpd
 	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
 	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
 	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
 	// check if incoming tx is older
 	if k.HasStakingSequence(ctx, sequence.String()) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
 
-	k.Logger(ctx).Debug("Persisting signer update", "sideTxResult", sideTxResult)
-
-	newPubKey := msg.NewSignerPubKey
-	newSigner := newPubKey.Address()
+	k.Logger(ctx).Debug("Updating validator stake", "sideTxResult", sideTxResult)
 
 	// pull validator from store
 	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
@@ -632,77 +623,158 @@ func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpd
 		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
 		return hmCommon.ErrNoValidator(k.Codespace()).Result()
 	}
-	oldValidator := validator.Copy()
 
-	// update last udpated
+	// same stake update may be delivered more than once (eg. with different tx), validator nonce dedups it
+	if k.IsStakeUpdateNonceCheckEnabled(ctx) && msg.Nonce <= validator.Nonce {
+		k.Logger(ctx).Error("Incorrect validator nonce", "msgNonce", msg.Nonce, "validatorNonce", validator.Nonce)
+		return hmCommon.ErrNonce(k.Codespace()).Result()
+	}
+
+	// update last updated
 	validator.LastUpdated = sequence.String()
 
 	// update nonce
 	validator.Nonce = msg.Nonce
 
-	// check if we are actually updating signer
-	if !bytes.Equal(newSigner.Bytes(), validator.Signer.Bytes()) {
-		// Update signer in prev Validator
-		validator.Signer = hmTypes.HeimdallAddress(newSigner)
-		validator.PubKey = newPubKey
-		k.Logger(ctx).Debug("Updating new signer", "newSigner", newSigner.String(), "oldSigner", oldValidator.Signer.String(), "validatorID", msg.ID)
+	// set validator amount
+	p, err := helper.GetPowerFromAmount(msg.NewAmount.BigInt())
+	if err != nil {
+		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Invalid amount %v for validator %v", msg.NewAmount, msg.ID)).Result()
+	}
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// with batching, power change waits for next checkpoint ack, nonce is updated right away
+	pending := k.GetBatchStakeUpdates(ctx)
+	if pending {
+		if err := k.SetPendingStakeUpdate(ctx, types.PendingStakeUpdate{
+			ValidatorID: validator.ID,
+			VotingPower: p.Int64(),
+			Nonce:       msg.Nonce,
+			Height:      ctx.BlockHeight(),
+			TxHash:      hmTypes.BytesToHeimdallHash(hash),
+		}); err != nil {
+			return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+		}
 	} else {
-		k.Logger(ctx).Error("No signer change", "newSigner", newSigner.String(), "oldSigner", oldValidator.Signer.String(), "validatorID", msg.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+		validator.VotingPower = p.Int64()
 	}
 
-	k.Logger(ctx).Debug("Removing old validator", "validator", oldValidator.String())
+	// save validator
+	err = k.AddValidator(ctx, validator)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to update validator stake", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
 
-	// remove old validator from HM
-	oldValidator.EndEpoch = k.moduleCommunicator.GetACKCount(ctx)
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
 
-	// remove old validator from TM
-	oldValidator.VotingPower = 0
-	// updated last
-	oldValidator.LastUpdated = sequence.String()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStakeUpdate,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorNonce, strconv.FormatUint(msg.Nonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyPending, strconv.FormatBool(pending)),
+		),
+	})
 
-	// updated nonce
-	oldValidator.Nonce = msg.Nonce
+	// save staking record
+	for root, rootID := range hmTypes.GetRootChainIDMap() {
+		if root != hmTypes.RootChainTypeStake {
+			k.AddStakingRecordToQueue(ctx, rootID, types.StakingRecord{
+				Type:        "stakeUpdate",
+				ValidatorID: msg.ID,
+				Nonce:       msg.Nonce,
+				Height:      ctx.BlockHeight(),
+				TxHash:      hmTypes.BytesToHeimdallHash(hash),
+			})
+		}
+	}
 
-	// save old validator
-	if err := k.AddValidator(ctx, *oldValidator); err != nil {
-		k.Logger(ctx).Error("Unable to update signer", "error", err, "validatorId", validator.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
-	// adding new validator
-	k.Logger(ctx).Debug("Adding new validator", "validator", validator.String())
+// PostHandleMsgSignerUpdate handles signer update message
+func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpdate, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if signer update is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping signer update since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
 
-	// save validator
-	err := k.AddValidator(ctx, validator)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	// Check for replay attack
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
 
-	// save staking sequence
-	k.SetStakingSequence(ctx, sequence.String())
+	k.Logger(ctx).Debug("Persisting signer update", "sideTxResult", sideTxResult)
+
+	newPubKey := msg.NewSignerPubKey
+	newSigner := newPubKey.Address()
+
+	// pull validator from store
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	// check if we are actually updating signer
+	if bytes.Equal(newSigner.Bytes(), validator.Signer.Bytes()) {
+		k.Logger(ctx).Error("No signer change", "newSigner", newSigner.String(), "oldSigner", validator.Signer.String(), "validatorID", msg.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	}
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
 	hash := tmTypes.Tx(txBytes).Hash()
 
-	//
-	// Move heimdall fee to new signer
-	//
+	// with rotation delay, old signer stays active until next checkpoint ack or delay has passed,
+	// so checkpoints it signed meanwhile aren't lost. Nonce is updated right away.
+	var activationHeight int64
+	delay := k.GetSignerRotationDelay(ctx)
+	if delay > 0 {
+		activationHeight = ctx.BlockHeight() + int64(delay)
+		if err := k.SetPendingSignerRotation(ctx, types.PendingSignerRotation{
+			ValidatorID:      msg.ID,
+			NewPubKey:        newPubKey,
+			NewSigner:        hmTypes.HeimdallAddress(newSigner),
+			Nonce:            msg.Nonce,
+			Sequence:         sequence.String(),
+			Height:           ctx.BlockHeight(),
+			ActivationHeight: activationHeight,
+			TxHash:           hmTypes.BytesToHeimdallHash(hash),
+		}); err != nil {
+			return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+		}
 
-	// check if fee is already withdrawn
-	coins := k.moduleCommunicator.GetCoins(ctx, oldValidator.Signer)
-	maticBalance := coins.AmountOf(authTypes.FeeToken)
-	if !maticBalance.IsZero() {
-		k.Logger(ctx).Info("Transferring fee", "from", oldValidator.Signer.String(), "to", validator.Signer.String(), "balance", maticBalance.String())
-		maticCoins := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: maticBalance}}
-		if err := k.moduleCommunicator.SendCoins(ctx, oldValidator.Signer, validator.Signer, maticCoins); err != nil {
-			k.Logger(ctx).Info("Error while transferring fee", "from", oldValidator.Signer.String(), "to", validator.Signer.String(), "balance", maticBalance.String())
-			return err.Result()
+		validator.LastUpdated = sequence.String()
+		validator.Nonce = msg.Nonce
+		if err := k.AddValidator(ctx, validator); err != nil {
+			k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
+			return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
 		}
+	} else if err := k.RotateSigner(ctx, msg.ID, newPubKey, msg.Nonce, sequence.String()); err != nil {
+		return err.Result()
 	}
 
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSignerUpdate,
@@ -712,6 +784,8 @@ func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpd
 			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
 			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
 			sdk.NewAttribute(types.AttributeKeyValidatorNonce, strconv.FormatUint(msg.Nonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyPending, strconv.FormatBool(delay > 0)),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatInt(activationHeight, 10)),
 		),
 	})
 
@@ -760,8 +834,10 @@ func PostHandleMsgValidatorExit(ctx sdk.Context, k Keeper, msg types.MsgValidato
 		return hmCommon.ErrNoValidator(k.Codespace()).Result()
 	}
 
-	// set end epoch
-	validator.EndEpoch = msg.DeactivationEpoch
+	// set end epoch, unless validator was already ended earlier by governance
+	if validator.EndEpoch == 0 || msg.DeactivationEpoch < validator.EndEpoch {
+		validator.EndEpoch = msg.DeactivationEpoch
+	}
 
 	// update last updated
 	validator.LastUpdated = sequence.String()
@@ -945,3 +1021,109 @@ func PostHandleMsgStakingSync(ctx sdk.Context, k Keeper, msg types.MsgStakingSyn
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// PostHandleMsgValidatorSetSync handle msg validator set sync
+func PostHandleMsgValidatorSetSync(ctx sdk.Context, k Keeper, msg types.MsgValidatorSetSync, sideTxResult abci.SideTxResultType) sdk.Result {
+	logger := k.Logger(ctx)
+
+	// Skip handler if validator set sync is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		logger.Debug("Skipping validator set sync since side-tx didn't get yes votes",
+			"root", msg.RootChain,
+			"nonce", msg.Nonce)
+		return common.ErrBadBlockDetails(k.Codespace()).Result()
+	}
+
+	// state might have changed since msg was delivered
+	if err := validateValidatorSetSync(ctx, msg, k); err != nil {
+		return err.Result()
+	}
+
+	//
+	// Update validator set sync state
+	//
+	record := k.GetValidatorSetSyncRecord(ctx, msg.RootChain)
+	record.PendingNonce = msg.Nonce
+	record.PendingEpoch = msg.Epoch
+	if err := k.SetValidatorSetSyncRecord(ctx, record); err != nil {
+		logger.Error("Unable to store validator set sync record", "root", msg.RootChain, "error", err)
+		return common.ErrBadAck(k.Codespace()).Result()
+	}
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// Emit event for validator set sync
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorSetSync,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChain),
+			sdk.NewAttribute(types.AttributeKeyValidatorSetNonce, strconv.FormatUint(msg.Nonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(msg.Epoch, 10)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgValidatorSetSyncAck handle msg validator set sync ack
+func PostHandleMsgValidatorSetSyncAck(ctx sdk.Context, k Keeper, msg types.MsgValidatorSetSyncAck, sideTxResult abci.SideTxResultType) sdk.Result {
+	logger := k.Logger(ctx)
+
+	// Skip handler if validator set sync ack is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		logger.Debug("Skipping validator set sync ack since side-tx didn't get yes votes",
+			"root", msg.RootChain,
+			"nonce", msg.Nonce)
+		return common.ErrBadBlockDetails(k.Codespace()).Result()
+	}
+
+	record := k.GetValidatorSetSyncRecord(ctx, msg.RootChain)
+	if !record.HasPending() || msg.Nonce != record.PendingNonce {
+		logger.Error("Invalid validator set sync ack",
+			"root", msg.RootChain,
+			"nonce", msg.Nonce,
+			"pendingNonce", record.PendingNonce,
+			"lastNonce", record.Nonce,
+		)
+		return common.ErrBadAck(k.Codespace()).Result()
+	}
+
+	//
+	// Update validator set sync state
+	//
+	record.Nonce = record.PendingNonce
+	record.Epoch = record.PendingEpoch
+	if err := k.SetValidatorSetSyncRecord(ctx, record); err != nil {
+		logger.Error("Unable to store validator set sync record", "root", msg.RootChain, "error", err)
+		return common.ErrBadAck(k.Codespace()).Result()
+	}
+	logger.Debug("Validator set synced", "root", msg.RootChain, "nonce", record.Nonce, "epoch", record.Epoch)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// Emit event for validator set sync ack
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorSetSyncAck,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChain),
+			sdk.NewAttribute(types.AttributeKeyValidatorSetNonce, strconv.FormatUint(msg.Nonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(record.Epoch, 10)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
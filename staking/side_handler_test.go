@@ -912,198 +912,197 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 	})
 }
 
-//
-//func (suite *SideHandlerTestSuite) TestSideHandleMsgStakeUpdate() {
-//	t, app, ctx := suite.T(), suite.app, suite.ctx
-//	keeper := app.StakingKeeper
-//
-//	// pass 0 as time alive to generate non de-activated validators
-//	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
-//	oldValSet := keeper.GetValidatorSet(ctx)
-//	oldVal := oldValSet.Validators[0]
-//
-//	chainParams := app.ChainKeeper.GetParams(ctx)
-//
-//	msgTxHash := hmTypes.HexToHeimdallHash("123")
-//	blockNumber := big.NewInt(10)
-//	nonce := big.NewInt(1)
-//
-//	suite.Run("Success", func() {
-//		msg := types.NewMsgStakeUpdate(
-//			oldVal.Signer,
-//			oldVal.ID.Uint64(),
-//			sdk.NewInt(2000000000000000000),
-//			msgTxHash,
-//			0,
-//			blockNumber.Uint64(),
-//			nonce.Uint64())
-//
-//		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-//
-//		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
-//			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
-//			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
-//			Nonce:       nonce,
-//		}
-//		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
-//
-//		result := suite.sideHandler(ctx, msg)
-//		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
-//		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
-//	})
-//
-//	suite.Run("No Receipt", func() {
-//		suite.contractCaller = mocks.IContractCaller{}
-//		msg := types.NewMsgStakeUpdate(
-//			oldVal.Signer,
-//			oldVal.ID.Uint64(),
-//			sdk.NewInt(2000000000000000000),
-//			msgTxHash,
-//			0,
-//			blockNumber.Uint64(),
-//			nonce.Uint64())
-//
-//		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
-//
-//		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
-//			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
-//			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
-//			Nonce:       nonce,
-//		}
-//		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
-//
-//		result := suite.sideHandler(ctx, msg)
-//		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
-//		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
-//	})
-//
-//	suite.Run("No Eventlog", func() {
-//		suite.contractCaller = mocks.IContractCaller{}
-//		msg := types.NewMsgStakeUpdate(
-//			oldVal.Signer,
-//			oldVal.ID.Uint64(),
-//			sdk.NewInt(2000000000000000000),
-//			msgTxHash,
-//			0,
-//			blockNumber.Uint64(),
-//			nonce.Uint64())
-//
-//		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-//		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(nil, nil)
-//
-//		result := suite.sideHandler(ctx, msg)
-//		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
-//		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
-//	})
-//
-//	suite.Run("Invalid BlockNumber", func() {
-//		suite.contractCaller = mocks.IContractCaller{}
-//		msg := types.NewMsgStakeUpdate(
-//			oldVal.Signer,
-//			oldVal.ID.Uint64(),
-//			sdk.NewInt(2000000000000000000),
-//			msgTxHash,
-//			0,
-//			uint64(15),
-//			nonce.Uint64())
-//
-//		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-//
-//		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
-//			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
-//			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
-//			Nonce:       nonce,
-//		}
-//		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
-//
-//		result := suite.sideHandler(ctx, msg)
-//		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
-//		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
-//	})
-//
-//	suite.Run("Invalid ValidatorID", func() {
-//		suite.contractCaller = mocks.IContractCaller{}
-//		msg := types.NewMsgStakeUpdate(
-//			oldVal.Signer,
-//			uint64(13),
-//			sdk.NewInt(2000000000000000000),
-//			msgTxHash,
-//			0,
-//			blockNumber.Uint64(),
-//			nonce.Uint64())
-//
-//		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-//
-//		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
-//			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
-//			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
-//			Nonce:       nonce,
-//		}
-//		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
-//
-//		result := suite.sideHandler(ctx, msg)
-//		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
-//		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
-//	})
-//
-//	suite.Run("Invalid Amount", func() {
-//		suite.contractCaller = mocks.IContractCaller{}
-//		msg := types.NewMsgStakeUpdate(
-//			oldVal.Signer,
-//			oldVal.ID.Uint64(),
-//			sdk.NewInt(200000000000000000),
-//			msgTxHash,
-//			0,
-//			blockNumber.Uint64(),
-//			nonce.Uint64())
-//
-//		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-//
-//		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
-//			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
-//			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
-//			Nonce:       nonce,
-//		}
-//		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
-//
-//		result := suite.sideHandler(ctx, msg)
-//		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
-//		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
-//	})
-//
-//	suite.Run("Invalid Nonce", func() {
-//		suite.contractCaller = mocks.IContractCaller{}
-//		msg := types.NewMsgStakeUpdate(
-//			oldVal.Signer,
-//			oldVal.ID.Uint64(),
-//			sdk.NewInt(2000000000000000000),
-//			msgTxHash,
-//			0,
-//			blockNumber.Uint64(),
-//			uint64(9))
-//
-//		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-//
-//		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
-//			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
-//			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
-//			Nonce:       nonce,
-//		}
-//		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
-//
-//		result := suite.sideHandler(ctx, msg)
-//		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
-//		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
-//	})
-//}
+func (suite *SideHandlerTestSuite) TestSideHandleMsgStakeUpdate() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// pass 0 as time alive to generate non de-activated validators
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	oldValSet := keeper.GetValidatorSet(ctx)
+	oldVal := oldValSet.Validators[0]
+
+	chainParams := app.ChainKeeper.GetParams(ctx)
+
+	msgTxHash := hmTypes.HexToHeimdallHash("123")
+	blockNumber := big.NewInt(10)
+	nonce := big.NewInt(1)
+
+	suite.Run("Success", func() {
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			oldVal.ID.Uint64(),
+			sdk.NewInt(2000000000000000000),
+			msgTxHash,
+			0,
+			blockNumber.Uint64(),
+			nonce.Uint64())
+
+		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+
+		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
+			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
+			Nonce:       nonce,
+		}
+		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.TronStakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	suite.Run("No Receipt", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			oldVal.ID.Uint64(),
+			sdk.NewInt(2000000000000000000),
+			msgTxHash,
+			0,
+			blockNumber.Uint64(),
+			nonce.Uint64())
+
+		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(nil, nil)
+
+		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
+			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
+			Nonce:       nonce,
+		}
+		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.TronStakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+
+	suite.Run("No Eventlog", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			oldVal.ID.Uint64(),
+			sdk.NewInt(2000000000000000000),
+			msgTxHash,
+			0,
+			blockNumber.Uint64(),
+			nonce.Uint64())
+
+		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+
+		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.TronStakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(nil, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+
+	suite.Run("Invalid BlockNumber", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			oldVal.ID.Uint64(),
+			sdk.NewInt(2000000000000000000),
+			msgTxHash,
+			0,
+			uint64(15),
+			nonce.Uint64())
+
+		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+
+		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
+			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
+			Nonce:       nonce,
+		}
+		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.TronStakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+
+	suite.Run("Invalid ValidatorID", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			uint64(13),
+			sdk.NewInt(2000000000000000000),
+			msgTxHash,
+			0,
+			blockNumber.Uint64(),
+			nonce.Uint64())
+
+		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+
+		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
+			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
+			Nonce:       nonce,
+		}
+		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.TronStakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+
+	suite.Run("Invalid Amount", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			oldVal.ID.Uint64(),
+			sdk.NewInt(200000000000000000),
+			msgTxHash,
+			0,
+			blockNumber.Uint64(),
+			nonce.Uint64())
+
+		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+
+		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
+			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
+			Nonce:       nonce,
+		}
+		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.TronStakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+
+	suite.Run("Invalid Nonce", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			oldVal.ID.Uint64(),
+			sdk.NewInt(2000000000000000000),
+			msgTxHash,
+			0,
+			blockNumber.Uint64(),
+			uint64(9))
+
+		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+
+		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
+			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+			NewAmount:   new(big.Int).SetInt64(2000000000000000000),
+			Nonce:       nonce,
+		}
+		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.TronStakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoStakeUpdate, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+}
 
 func (suite *SideHandlerTestSuite) TestPostHandler() {
 	t, ctx := suite.T(), suite.ctx
@@ -1317,60 +1316,162 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgValidatorExit() {
 	})
 }
 
-//
-//func (suite *SideHandlerTestSuite) TestPostHandleMsgStakeUpdate() {
-//	t, app, ctx := suite.T(), suite.app, suite.ctx
-//	keeper := app.StakingKeeper
-//
-//	// pass 0 as time alive to generate non de-activated validators
-//	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
-//	oldValSet := keeper.GetValidatorSet(ctx)
-//	oldVal := oldValSet.Validators[0]
-//
-//	msgTxHash := hmTypes.HexToHeimdallHash("123")
-//	blockNumber := big.NewInt(10)
-//	nonce := big.NewInt(1)
-//	newAmount := new(big.Int).SetInt64(2000000000000000000)
-//
-//	suite.Run("No result", func() {
-//		msg := types.NewMsgStakeUpdate(
-//			oldVal.Signer,
-//			oldVal.ID.Uint64(),
-//			sdk.NewInt(2000000000000000000),
-//			msgTxHash,
-//			0,
-//			blockNumber.Uint64(),
-//			nonce.Uint64())
-//
-//		result := suite.postHandler(ctx, msg, abci.SideTxResultType_No)
-//		require.True(t, !result.IsOK(), errs.CodeToDefaultMsg(result.Code))
-//
-//		updatedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
-//		require.Empty(t, err, "unable to fetch validator info %v-", err)
-//
-//		acctualPower, err := helper.GetPowerFromAmount(newAmount)
-//		require.NoError(t, err)
-//		require.NotEqual(t, acctualPower.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", newAmount.Uint64())
-//	})
-//
-//	suite.Run("Success", func() {
-//		msg := types.NewMsgStakeUpdate(
-//			oldVal.Signer,
-//			oldVal.ID.Uint64(),
-//			sdk.NewInt(2000000000000000000),
-//			msgTxHash,
-//			0,
-//			blockNumber.Uint64(),
-//			nonce.Uint64())
-//
-//		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
-//		require.True(t, result.IsOK(), "expected validator stake update to be ok, got %v", result)
-//
-//		updatedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
-//		require.Empty(t, err, "unable to fetch validator info %v-", err)
-//
-//		acctualPower, err := helper.GetPowerFromAmount(new(big.Int).SetInt64(2000000000000000000))
-//		require.NoError(t, err)
-//		require.Equal(t, acctualPower.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", newAmount.Uint64())
-//	})
-//}
+func (suite *SideHandlerTestSuite) TestPostHandleMsgStakeUpdate() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// pass 0 as time alive to generate non de-activated validators
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	oldValSet := keeper.GetValidatorSet(ctx)
+	oldVal := oldValSet.Validators[0]
+
+	msgTxHash := hmTypes.HexToHeimdallHash("123")
+	blockNumber := big.NewInt(10)
+	nonce := big.NewInt(1)
+	newAmount := new(big.Int).SetInt64(2000000000000000000)
+
+	suite.Run("No result", func() {
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			oldVal.ID.Uint64(),
+			sdk.NewInt(2000000000000000000),
+			msgTxHash,
+			0,
+			blockNumber.Uint64(),
+			nonce.Uint64())
+
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_No)
+		require.True(t, !result.IsOK(), errs.CodeToDefaultMsg(result.Code))
+
+		updatedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
+		require.Empty(t, err, "unable to fetch validator info %v-", err)
+
+		acctualPower, err := helper.GetPowerFromAmount(newAmount)
+		require.NoError(t, err)
+		require.NotEqual(t, acctualPower.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", newAmount.Uint64())
+	})
+
+	suite.Run("Success", func() {
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			oldVal.ID.Uint64(),
+			sdk.NewInt(2000000000000000000),
+			msgTxHash,
+			0,
+			blockNumber.Uint64(),
+			nonce.Uint64())
+
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected validator stake update to be ok, got %v", result)
+
+		updatedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
+		require.Empty(t, err, "unable to fetch validator info %v-", err)
+
+		acctualPower, err := helper.GetPowerFromAmount(new(big.Int).SetInt64(2000000000000000000))
+		require.NoError(t, err)
+		require.Equal(t, acctualPower.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", newAmount.Uint64())
+	})
+
+	suite.Run("Processed Nonce", func() {
+		// same stake update delivered again with another tx
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			oldVal.ID.Uint64(),
+			sdk.NewInt(3000000000000000000),
+			msgTxHash,
+			1,
+			blockNumber.Uint64(),
+			nonce.Uint64())
+
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK(), "expected stake update with processed nonce to fail")
+		require.Equal(t, common.CodeNonce, result.Code)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgStakeUpdateBatched() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	oldVal := keeper.GetValidatorSet(ctx).Validators[0]
+	keeper.SetBatchStakeUpdates(ctx, true)
+
+	msg := types.NewMsgStakeUpdate(
+		oldVal.Signer,
+		oldVal.ID.Uint64(),
+		sdk.NewInt(2000000000000000000),
+		hmTypes.HexToHeimdallHash("123"),
+		0,
+		10,
+		oldVal.Nonce+1)
+
+	result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected validator stake update to be ok, got %v", result)
+
+	power, err := helper.GetPowerFromAmount(new(big.Int).SetInt64(2000000000000000000))
+	require.NoError(t, err)
+
+	// nonce is updated, power waits for checkpoint ack
+	queuedVal, ok := keeper.GetValidatorFromValID(ctx, oldVal.ID)
+	require.True(t, ok)
+	require.Equal(t, oldVal.Nonce+1, queuedVal.Nonce)
+	require.Equal(t, oldVal.VotingPower, queuedVal.VotingPower)
+
+	pending := keeper.GetPendingStakeUpdates(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, oldVal.ID, pending[0].ValidatorID)
+	require.Equal(t, power.Int64(), pending[0].VotingPower)
+
+	keeper.ApplyPendingStakeUpdates(ctx)
+
+	updatedVal, ok := keeper.GetValidatorFromValID(ctx, oldVal.ID)
+	require.True(t, ok)
+	require.Equal(t, power.Int64(), updatedVal.VotingPower)
+	require.Empty(t, keeper.GetPendingStakeUpdates(ctx))
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgSignerUpdateDelayed() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	oldSigner := keeper.GetValidatorSet(ctx).Validators[0]
+	newSigner := stakingSim.GenRandomVal(1, 0, 10, 10, false, 1)
+	keeper.SetSignerRotationDelay(ctx, 10)
+	ctx = ctx.WithBlockHeight(100)
+
+	msg := types.NewMsgSignerUpdate(newSigner[0].Signer, uint64(oldSigner.ID), newSigner[0].PubKey, hmTypes.HexToHeimdallHash("123"), 0, 10, oldSigner.Nonce+1)
+	result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "Post handler should succeed")
+
+	// nonce is updated, signer waits for activation
+	val, ok := keeper.GetValidatorFromValID(ctx, oldSigner.ID)
+	require.True(t, ok)
+	require.Equal(t, oldSigner.Nonce+1, val.Nonce)
+	require.Equal(t, oldSigner.Signer, val.Signer)
+
+	pending := keeper.GetPendingSignerRotations(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, int64(110), pending[0].ActivationHeight)
+
+	// both keys are accepted during transition window
+	require.True(t, keeper.IsValidatorSigner(ctx, val, oldSigner.Signer))
+	require.True(t, keeper.IsValidatorSigner(ctx, val, newSigner[0].Signer))
+
+	keeper.ApplyPendingSignerRotations(ctx.WithBlockHeight(109), false)
+	require.Len(t, keeper.GetPendingSignerRotations(ctx), 1)
+
+	keeper.ApplyPendingSignerRotations(ctx.WithBlockHeight(110), false)
+	require.Empty(t, keeper.GetPendingSignerRotations(ctx))
+
+	val, ok = keeper.GetValidatorFromValID(ctx, oldSigner.ID)
+	require.True(t, ok)
+	require.Equal(t, newSigner[0].Signer, val.Signer)
+	require.Equal(t, oldSigner.VotingPower, val.VotingPower)
+	require.False(t, keeper.IsValidatorSigner(ctx, val, oldSigner.Signer))
+
+	removedVal, err := keeper.GetValidatorInfo(ctx, oldSigner.Signer.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), removedVal.VotingPower)
+}
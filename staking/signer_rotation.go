@@ -0,0 +1,199 @@
+package staking
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// delayed signer rotation
+//
+
+// GetPendingSignerRotationKey returns key of pending signer rotation of validator
+func GetPendingSignerRotationKey(valID hmTypes.ValidatorID) []byte {
+	return append(PendingSignerKey, valID.Bytes()...)
+}
+
+// SetSignerRotationDelay sets blocks signer update waits before it takes effect, 0 rotates right away
+func (k *Keeper) SetSignerRotationDelay(ctx sdk.Context, delay uint64) {
+	k.paramSpace.Set(ctx, types.KeySignerRotationDelay, delay)
+}
+
+// GetSignerRotationDelay returns blocks signer update waits before it takes effect, 0 if it was never set
+func (k *Keeper) GetSignerRotationDelay(ctx sdk.Context) (delay uint64) {
+	k.paramSpace.GetIfExists(ctx, types.KeySignerRotationDelay, &delay)
+	return
+}
+
+// SetPendingSignerRotation queues signer rotation of validator, it replaces rotation queued earlier
+func (k *Keeper) SetPendingSignerRotation(ctx sdk.Context, rotation types.PendingSignerRotation) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(rotation)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling pending signer rotation", "error", err)
+		return err
+	}
+
+	store.Set(GetPendingSignerRotationKey(rotation.ValidatorID), out)
+	return nil
+}
+
+// GetPendingSignerRotation returns pending signer rotation of validator
+func (k *Keeper) GetPendingSignerRotation(ctx sdk.Context, valID hmTypes.ValidatorID) (rotation types.PendingSignerRotation, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingSignerRotationKey(valID)
+	if !store.Has(key) {
+		return rotation, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &rotation); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling pending signer rotation", "validatorId", valID, "error", err)
+		return rotation, false
+	}
+	return rotation, true
+}
+
+// GetPendingSignerRotations returns pending signer rotations ordered by validator id
+func (k *Keeper) GetPendingSignerRotations(ctx sdk.Context) (rotations []types.PendingSignerRotation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PendingSignerKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.PendingSignerRotation
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &rotation); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling pending signer rotation", "error", err)
+			continue
+		}
+		rotations = append(rotations, rotation)
+	}
+
+	// keys hold decimal validator ids
+	sort.Slice(rotations, func(i, j int) bool {
+		return rotations[i].ValidatorID < rotations[j].ValidatorID
+	})
+	return rotations
+}
+
+// IsValidatorSigner returns true if address is signer of validator or new signer of its pending rotation.
+// Both keys are accepted during transition window, so messages signed before rotation takes effect stay valid.
+func (k *Keeper) IsValidatorSigner(ctx sdk.Context, validator hmTypes.Validator, address hmTypes.HeimdallAddress) bool {
+	if bytes.Equal(validator.Signer.Bytes(), address.Bytes()) {
+		return true
+	}
+
+	rotation, ok := k.GetPendingSignerRotation(ctx, validator.ID)
+	return ok && bytes.Equal(rotation.NewSigner.Bytes(), address.Bytes())
+}
+
+// RotateSigner ends validator with old signer at current epoch, adds validator with new signer
+// and moves fee of old signer to new one
+func (k *Keeper) RotateSigner(ctx sdk.Context, valID hmTypes.ValidatorID, newPubKey hmTypes.PubKey, nonce uint64, sequence string) sdk.Error {
+	newSigner := newPubKey.Address()
+
+	// pull validator from store
+	validator, ok := k.GetValidatorFromValID(ctx, valID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", valID)
+		return hmCommon.ErrNoValidator(k.Codespace())
+	}
+
+	// check if we are actually updating signer
+	if bytes.Equal(newSigner.Bytes(), validator.Signer.Bytes()) {
+		k.Logger(ctx).Error("No signer change", "newSigner", newSigner.String(), "oldSigner", validator.Signer.String(), "validatorID", valID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace())
+	}
+
+	oldValidator := validator.Copy()
+
+	// Update signer in prev Validator
+	validator.Signer = hmTypes.HeimdallAddress(newSigner)
+	validator.PubKey = newPubKey
+	validator.LastUpdated = sequence
+	validator.Nonce = nonce
+	k.Logger(ctx).Debug("Updating new signer", "newSigner", newSigner.String(), "oldSigner", oldValidator.Signer.String(), "validatorID", valID)
+
+	k.Logger(ctx).Debug("Removing old validator", "validator", oldValidator.String())
+
+	// remove old validator from HM
+	oldValidator.EndEpoch = k.moduleCommunicator.GetACKCount(ctx)
+
+	// remove old validator from TM
+	oldValidator.VotingPower = 0
+	oldValidator.LastUpdated = sequence
+	oldValidator.Nonce = nonce
+
+	// save old validator
+	if err := k.AddValidator(ctx, *oldValidator); err != nil {
+		k.Logger(ctx).Error("Unable to update signer", "error", err, "validatorId", validator.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace())
+	}
+
+	// adding new validator
+	k.Logger(ctx).Debug("Adding new validator", "validator", validator.String())
+
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace())
+	}
+
+	//
+	// Move heimdall fee to new signer
+	//
+
+	// check if fee is already withdrawn
+	coins := k.moduleCommunicator.GetCoins(ctx, oldValidator.Signer)
+	maticBalance := coins.AmountOf(authTypes.FeeToken)
+	if !maticBalance.IsZero() {
+		k.Logger(ctx).Info("Transferring fee", "from", oldValidator.Signer.String(), "to", validator.Signer.String(), "balance", maticBalance.String())
+		maticCoins := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: maticBalance}}
+		if err := k.moduleCommunicator.SendCoins(ctx, oldValidator.Signer, validator.Signer, maticCoins); err != nil {
+			k.Logger(ctx).Info("Error while transferring fee", "from", oldValidator.Signer.String(), "to", validator.Signer.String(), "balance", maticBalance.String())
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyPendingSignerRotations rotates signers of pending rotations which reached their activation height,
+// or all of them if all is set. It's called every block and with all set on checkpoint ack.
+func (k *Keeper) ApplyPendingSignerRotations(ctx sdk.Context, all bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, rotation := range k.GetPendingSignerRotations(ctx) {
+		if !all && ctx.BlockHeight() < rotation.ActivationHeight {
+			continue
+		}
+
+		store.Delete(GetPendingSignerRotationKey(rotation.ValidatorID))
+
+		// rotation is applied on cache, failed one doesn't leave partial state
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.RotateSigner(cacheCtx, rotation.ValidatorID, rotation.NewPubKey, rotation.Nonce, rotation.Sequence); err != nil {
+			k.Logger(ctx).Error("Dropping pending signer rotation", "error", err, "validatorId", rotation.ValidatorID)
+			continue
+		}
+		writeCache()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSignerRotationApplied,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(hmTypes.AttributeKeyTxHash, rotation.TxHash.Hex()),
+				sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(rotation.ValidatorID.Uint64(), 10)),
+				sdk.NewAttribute(types.AttributeKeyValidatorNonce, strconv.FormatUint(rotation.Nonce, 10)),
+				sdk.NewAttribute(types.AttributeKeySigner, rotation.NewSigner.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,75 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmSimulation "github.com/maticnetwork/heimdall/simulation"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgStakeUpdate = "op_weight_msg_stake_update"
+
+	DefaultWeightMsgStakeUpdate = 20
+)
+
+// Keeper staking keeper methods used by simulation operations
+type Keeper interface {
+	GetAllValidators(ctx sdk.Context) []*hmTypes.Validator
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	simState module.SimulationState,
+	k Keeper,
+	handler sdk.Handler,
+	postHandler hmTypes.PostTxHandler,
+) []simulation.WeightedOperation {
+	var weightMsgStakeUpdate int
+	simState.AppParams.GetOrGenerate(simState.Cdc, OpWeightMsgStakeUpdate, &weightMsgStakeUpdate, nil,
+		func(_ *rand.Rand) { weightMsgStakeUpdate = DefaultWeightMsgStakeUpdate })
+
+	return []simulation.WeightedOperation{
+		hmSimulation.NewWeightedOperation(weightMsgStakeUpdate, SimulateMsgStakeUpdate(k, handler, postHandler)),
+	}
+}
+
+// SimulateMsgStakeUpdate updates stake of random validator to random power, which changes proposer rotation
+func SimulateMsgStakeUpdate(k Keeper, handler sdk.Handler, postHandler hmTypes.PostTxHandler) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		validators := k.GetAllValidators(ctx)
+		if len(validators) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		validator := validators[r.Intn(len(validators))]
+		amount, err := helper.GetAmountFromPower(int64(simulation.RandIntBetween(r, 10, 100)))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		txHash := make([]byte, 32)
+		r.Read(txHash)
+
+		msg := types.NewMsgStakeUpdate(
+			validator.Signer,
+			validator.ID.Uint64(),
+			sdk.NewIntFromBigInt(amount),
+			hmTypes.BytesToHeimdallHash(txHash),
+			uint64(r.Intn(hmTypes.DefaultLogIndexUnit)),
+			uint64(ctx.BlockHeight()),
+			validator.Nonce+1,
+		)
+
+		_, res := hmSimulation.DeliverSideTx(r, ctx, handler, postHandler, msg)
+		return simulation.NewOperationMsg(msg, res.IsOK(), ""), nil, nil
+	}
+}
@@ -0,0 +1,121 @@
+package staking
+
+import (
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// stake update batching
+//
+
+// GetPendingStakeUpdateKey returns key of pending stake update of validator
+func GetPendingStakeUpdateKey(valID hmTypes.ValidatorID) []byte {
+	return append(PendingStakeUpdateKey, valID.Bytes()...)
+}
+
+// SetBatchStakeUpdates sets whether stake updates are queued until next checkpoint ack
+func (k *Keeper) SetBatchStakeUpdates(ctx sdk.Context, enabled bool) {
+	k.paramSpace.Set(ctx, types.KeyBatchStakeUpdates, enabled)
+}
+
+// GetBatchStakeUpdates returns whether stake updates are queued until next checkpoint ack, false if it was never set
+func (k *Keeper) GetBatchStakeUpdates(ctx sdk.Context) (enabled bool) {
+	k.paramSpace.GetIfExists(ctx, types.KeyBatchStakeUpdates, &enabled)
+	return
+}
+
+// SetPendingStakeUpdate queues stake update of validator, it replaces update queued earlier in same epoch
+func (k *Keeper) SetPendingStakeUpdate(ctx sdk.Context, update types.PendingStakeUpdate) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(update)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling pending stake update", "error", err)
+		return err
+	}
+
+	store.Set(GetPendingStakeUpdateKey(update.ValidatorID), out)
+	return nil
+}
+
+// GetPendingStakeUpdate returns pending stake update of validator
+func (k *Keeper) GetPendingStakeUpdate(ctx sdk.Context, valID hmTypes.ValidatorID) (update types.PendingStakeUpdate, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingStakeUpdateKey(valID)
+	if !store.Has(key) {
+		return update, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &update); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling pending stake update", "validatorId", valID, "error", err)
+		return update, false
+	}
+	return update, true
+}
+
+// GetPendingStakeUpdates returns pending stake updates ordered by validator id
+func (k *Keeper) GetPendingStakeUpdates(ctx sdk.Context) (updates []types.PendingStakeUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PendingStakeUpdateKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var update types.PendingStakeUpdate
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &update); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling pending stake update", "error", err)
+			continue
+		}
+		updates = append(updates, update)
+	}
+
+	// keys hold decimal validator ids
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].ValidatorID < updates[j].ValidatorID
+	})
+	return updates
+}
+
+// ApplyPendingStakeUpdates sets voting power of validators to their pending stake updates and
+// clears the queue. It's called on checkpoint ack, so power changes take effect at epoch boundary.
+func (k *Keeper) ApplyPendingStakeUpdates(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, update := range k.GetPendingStakeUpdates(ctx) {
+		store.Delete(GetPendingStakeUpdateKey(update.ValidatorID))
+
+		validator, ok := k.GetValidatorFromValID(ctx, update.ValidatorID)
+		if !ok {
+			k.Logger(ctx).Error("Dropping pending stake update of unknown validator", "validatorId", update.ValidatorID)
+			continue
+		}
+
+		// validator exiting (or exited) meanwhile, its power is handled by exit
+		if validator.EndEpoch != 0 {
+			k.Logger(ctx).Info("Dropping pending stake update of exiting validator", "validatorId", update.ValidatorID)
+			continue
+		}
+
+		validator.VotingPower = update.VotingPower
+		if err := k.AddValidator(ctx, validator); err != nil {
+			k.Logger(ctx).Error("Unable to apply pending stake update", "error", err, "validatorId", update.ValidatorID)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStakeUpdateApplied,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(hmTypes.AttributeKeyTxHash, update.TxHash.Hex()),
+				sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(update.ValidatorID.Uint64(), 10)),
+				sdk.NewAttribute(types.AttributeKeyValidatorNonce, strconv.FormatUint(update.Nonce, 10)),
+				sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(update.VotingPower, 10)),
+			),
+		)
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorBlsKey BLS public key registered by validator for checkpoint signature aggregation
+type ValidatorBlsKey struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	PubKey      hmTypes.HexBytes    `json:"pub_key" yaml:"pub_key"`
+	UpdatedAt   int64               `json:"updated_at" yaml:"updated_at"`
+}
+
+// NewValidatorBlsKey creates new validator BLS key
+func NewValidatorBlsKey(validatorID hmTypes.ValidatorID, pubKey hmTypes.HexBytes, updatedAt int64) ValidatorBlsKey {
+	return ValidatorBlsKey{
+		ValidatorID: validatorID,
+		PubKey:      pubKey,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// String returns string representation of BLS key
+func (k ValidatorBlsKey) String() string {
+	return fmt.Sprintf("ValidatorBlsKey{%v %v %v}", k.ValidatorID, k.PubKey.String(), k.UpdatedAt)
+}
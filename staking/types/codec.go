@@ -13,6 +13,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgStakeUpdate{}, "staking/MsgStakeUpdate", nil)
 	cdc.RegisterConcrete(MsgStakingSync{}, "staking/MsgStakingSync", nil)
 	cdc.RegisterConcrete(MsgStakingSyncAck{}, "staking/MsgStakingSyncAck", nil)
+	cdc.RegisterConcrete(MsgSetValidatorMetadata{}, "staking/MsgSetValidatorMetadata", nil)
+	cdc.RegisterConcrete(MsgConfigHash{}, "staking/MsgConfigHash", nil)
+	cdc.RegisterConcrete(MsgSetBlsKey{}, "staking/MsgSetBlsKey", nil)
+	cdc.RegisterConcrete(MsgValidatorSetSync{}, "staking/MsgValidatorSetSync", nil)
+	cdc.RegisterConcrete(MsgValidatorSetSyncAck{}, "staking/MsgValidatorSetSyncAck", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorConfigHash hash of consensus-critical config published by validator
+type ValidatorConfigHash struct {
+	ValidatorID hmTypes.ValidatorID  `json:"validator_id" yaml:"validator_id"`
+	Hash        hmTypes.HeimdallHash `json:"hash" yaml:"hash"`
+	UpdatedAt   int64                `json:"updated_at" yaml:"updated_at"`
+}
+
+// NewValidatorConfigHash creates new validator config hash
+func NewValidatorConfigHash(validatorID hmTypes.ValidatorID, hash hmTypes.HeimdallHash, updatedAt int64) ValidatorConfigHash {
+	return ValidatorConfigHash{
+		ValidatorID: validatorID,
+		Hash:        hash,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// String returns string representation of config hash
+func (c ValidatorConfigHash) String() string {
+	return fmt.Sprintf("ValidatorConfigHash{%v %v %v}", c.ValidatorID, c.Hash.Hex(), c.UpdatedAt)
+}
+
+// ConfigHashStatus config hash of current validator compared to supermajority
+type ConfigHashStatus struct {
+	ValidatorID hmTypes.ValidatorID     `json:"validator_id"`
+	Signer      hmTypes.HeimdallAddress `json:"signer"`
+	Power       int64                   `json:"power"`
+	Hash        hmTypes.HeimdallHash    `json:"hash"`
+	UpdatedAt   int64                   `json:"updated_at"`
+	Missing     bool                    `json:"missing"`  // validator didn't publish config hash
+	Mismatch    bool                    `json:"mismatch"` // published hash diverges from supermajority
+}
+
+// ConfigHashReport config hashes of current validator set
+type ConfigHashReport struct {
+	Supermajority      hmTypes.HeimdallHash `json:"supermajority"` // empty if no hash has more than 2/3 of power
+	SupermajorityPower int64                `json:"supermajority_power"`
+	TotalPower         int64                `json:"total_power"`
+	Validators         []ConfigHashStatus   `json:"validators"`
+}
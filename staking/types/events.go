@@ -10,6 +10,19 @@ var (
 	EventTypeStakingSync    = "staking-sync"
 	EventTypeStakingSyncAck = "staking-ack"
 
+	EventTypeValidatorMetadata = "validator-metadata"
+	EventTypeConfigHash        = "config-hash"
+	EventTypeSetBlsKey         = "set-bls-key"
+
+	EventTypeValidatorSetSync    = "validator-set-sync"
+	EventTypeValidatorSetSyncAck = "validator-set-sync-ack"
+
+	EventTypeStakeUpdateApplied    = "stake-update-applied"
+	EventTypeSignerRotationApplied = "signer-rotation-applied"
+
+	EventTypeForceValidatorExit = "force-validator-exit"
+	EventTypeForceUnjail        = "force-unjail"
+
 	AttributeKeySigner            = "signer"
 	AttributeKeyDeactivationEpoch = "deactivation-epoch"
 	AttributeKeyActivationEpoch   = "activation-epoch"
@@ -17,6 +30,13 @@ var (
 	AttributeKeyValidatorNonce    = "validator-nonce"
 	AttributeKeyUpdatedAt         = "updated-at"
 	AttributeKeyRootChain         = "root-chain"
+	AttributeKeyConfigHash        = "config-hash"
+	AttributeKeyBlsPubKey         = "bls-pub-key"
+	AttributeKeyEpoch             = "epoch"
+	AttributeKeyValidatorSetNonce = "validator-set-nonce"
+	AttributeKeyPower             = "power"
+	AttributeKeyPending           = "pending"
+	AttributeKeyActivationHeight  = "activation-height"
 
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// ProposalTypeForceValidatorExit defines the type for a ForceValidatorExitProposal
+	ProposalTypeForceValidatorExit = "ForceValidatorExit"
+	// ProposalTypeForceUnjail defines the type for a ForceUnjailProposal
+	ProposalTypeForceUnjail = "ForceUnjail"
+)
+
+// Assert proposals implement govTypes.Content at compile-time
+var (
+	_ govTypes.Content = ForceValidatorExitProposal{}
+	_ govTypes.Content = ForceUnjailProposal{}
+)
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeForceValidatorExit)
+	govTypes.RegisterProposalTypeCodec(ForceValidatorExitProposal{}, "staking/ForceValidatorExitProposal")
+	govTypes.RegisterProposalType(ProposalTypeForceUnjail)
+	govTypes.RegisterProposalTypeCodec(ForceUnjailProposal{}, "staking/ForceUnjailProposal")
+}
+
+// ForceValidatorExitProposal governance proposal which removes byzantine or lost-key validator
+// from validator set at current epoch, without waiting for its stake withdrawal on root chain.
+type ForceValidatorExitProposal struct {
+	Title       string              `json:"title" yaml:"title"`
+	Description string              `json:"description" yaml:"description"`
+	ValidatorID hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+}
+
+// NewForceValidatorExitProposal creates new force validator exit proposal
+func NewForceValidatorExitProposal(title, description string, validatorID hmTypes.ValidatorID) ForceValidatorExitProposal {
+	return ForceValidatorExitProposal{
+		Title:       title,
+		Description: description,
+		ValidatorID: validatorID,
+	}
+}
+
+// GetTitle returns the title of force validator exit proposal
+func (p ForceValidatorExitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of force validator exit proposal
+func (p ForceValidatorExitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of force validator exit proposal
+func (p ForceValidatorExitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of force validator exit proposal
+func (p ForceValidatorExitProposal) ProposalType() string { return ProposalTypeForceValidatorExit }
+
+// ValidateBasic validates force validator exit proposal
+func (p ForceValidatorExitProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(hmCommon.DefaultCodespace, p); err != nil {
+		return err
+	}
+
+	if p.ValidatorID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator id %v", p.ValidatorID)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ForceValidatorExitProposal) String() string {
+	return fmt.Sprintf(`Force Validator Exit Proposal:
+  Title:       %s
+  Description: %s
+  ValidatorID: %s
+`, p.Title, p.Description, p.ValidatorID)
+}
+
+// ForceUnjailProposal governance proposal which unjails validator, eg. jailed by mistake
+// or after its signer key is recovered, without unjail tx on root chain.
+type ForceUnjailProposal struct {
+	Title       string              `json:"title" yaml:"title"`
+	Description string              `json:"description" yaml:"description"`
+	ValidatorID hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+}
+
+// NewForceUnjailProposal creates new force unjail proposal
+func NewForceUnjailProposal(title, description string, validatorID hmTypes.ValidatorID) ForceUnjailProposal {
+	return ForceUnjailProposal{
+		Title:       title,
+		Description: description,
+		ValidatorID: validatorID,
+	}
+}
+
+// GetTitle returns the title of force unjail proposal
+func (p ForceUnjailProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of force unjail proposal
+func (p ForceUnjailProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of force unjail proposal
+func (p ForceUnjailProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of force unjail proposal
+func (p ForceUnjailProposal) ProposalType() string { return ProposalTypeForceUnjail }
+
+// ValidateBasic validates force unjail proposal
+func (p ForceUnjailProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(hmCommon.DefaultCodespace, p); err != nil {
+		return err
+	}
+
+	if p.ValidatorID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator id %v", p.ValidatorID)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ForceUnjailProposal) String() string {
+	return fmt.Sprintf(`Force Unjail Proposal:
+  Title:       %s
+  Description: %s
+  ValidatorID: %s
+`, p.Title, p.Description, p.ValidatorID)
+}
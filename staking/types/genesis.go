@@ -1,10 +1,13 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -38,6 +41,18 @@ type GenesisState struct {
 	Validators       []*hmTypes.Validator `json:"validators" yaml:"validators"`
 	CurrentValSet    hmTypes.ValidatorSet `json:"current_val_set" yaml:"current_val_set"`
 	StakingSequences []string             `json:"staking_sequences" yaml:"staking_sequences"`
+
+	ValidatorMetadata []ValidatorMetadata `json:"validator_metadata" yaml:"validator_metadata"`
+
+	ValidatorSetSyncRecords []ValidatorSetSyncRecord `json:"validator_set_sync_records" yaml:"validator_set_sync_records"`
+
+	BatchStakeUpdates   bool                 `json:"batch_stake_updates" yaml:"batch_stake_updates"` // queue stake updates until checkpoint ack
+	PendingStakeUpdates []PendingStakeUpdate `json:"pending_stake_updates" yaml:"pending_stake_updates"`
+
+	SignerRotationDelay    uint64                  `json:"signer_rotation_delay,omitempty" yaml:"signer_rotation_delay"` // blocks signer update waits before it takes effect
+	PendingSignerRotations []PendingSignerRotation `json:"pending_signer_rotations,omitempty" yaml:"pending_signer_rotations"`
+
+	BlsKeys []ValidatorBlsKey `json:"bls_keys,omitempty" yaml:"bls_keys"` // BLS keys registered for checkpoint signature aggregation
 }
 
 // NewGenesisState creates a new genesis state.
@@ -74,6 +89,36 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, metadata := range data.ValidatorMetadata {
+		if err := metadata.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range data.ValidatorSetSyncRecords {
+		if hmTypes.GetRootChainID(record.RootChain) == 0 {
+			return errors.New("Invalid validator set sync root chain")
+		}
+	}
+
+	for _, update := range data.PendingStakeUpdates {
+		if update.VotingPower < 0 {
+			return errors.New("Invalid pending stake update power")
+		}
+	}
+
+	for _, blsKey := range data.BlsKeys {
+		if err := helper.ValidateBlsPubKey(blsKey.PubKey); err != nil {
+			return fmt.Errorf("Invalid BLS key of validator %v: %v", blsKey.ValidatorID, err)
+		}
+	}
+
+	for _, rotation := range data.PendingSignerRotations {
+		if rotation.NewSigner.Empty() || !bytes.Equal(rotation.NewPubKey.Address().Bytes(), rotation.NewSigner.Bytes()) {
+			return errors.New("Invalid pending signer rotation")
+		}
+	}
+
 	return nil
 }
 
@@ -512,3 +512,354 @@ func (msg MsgStakingSyncAck) ValidateBasic() sdk.Error {
 func (msg MsgStakingSyncAck) GetSideSignBytes() []byte {
 	return nil
 }
+
+//
+// validator metadata
+//
+var _ sdk.Msg = &MsgSetValidatorMetadata{}
+
+// MsgSetValidatorMetadata registers operational metadata for a validator
+type MsgSetValidatorMetadata struct {
+	From         hmTypes.HeimdallAddress `json:"from"`
+	ID           hmTypes.ValidatorID     `json:"id"`
+	Moniker      string                  `json:"moniker"`
+	Website      string                  `json:"website"`
+	Details      string                  `json:"details"`
+	AlertWebhook string                  `json:"alert_webhook"`
+	ContactHash  hmTypes.HeimdallHash    `json:"contact_hash"`
+}
+
+// NewMsgSetValidatorMetadata creates new set-validator-metadata msg
+func NewMsgSetValidatorMetadata(
+	from hmTypes.HeimdallAddress,
+	id hmTypes.ValidatorID,
+	moniker string,
+	website string,
+	details string,
+	alertWebhook string,
+	contactHash hmTypes.HeimdallHash,
+) MsgSetValidatorMetadata {
+	return MsgSetValidatorMetadata{
+		From:         from,
+		ID:           id,
+		Moniker:      moniker,
+		Website:      website,
+		Details:      details,
+		AlertWebhook: alertWebhook,
+		ContactHash:  contactHash,
+	}
+}
+
+func (msg MsgSetValidatorMetadata) Type() string {
+	return "validator-metadata"
+}
+
+func (msg MsgSetValidatorMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetValidatorMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgSetValidatorMetadata) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSetValidatorMetadata) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid sender %v", msg.From.String())
+	}
+
+	if err := msg.GetMetadata(0).ValidateBasic(); err != nil {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator metadata: %v", err)
+	}
+
+	return nil
+}
+
+// GetMetadata returns validator metadata from msg
+func (msg MsgSetValidatorMetadata) GetMetadata(updatedAt int64) ValidatorMetadata {
+	return NewValidatorMetadata(
+		msg.ID,
+		msg.Moniker,
+		msg.Website,
+		msg.Details,
+		msg.AlertWebhook,
+		msg.ContactHash,
+		updatedAt,
+	)
+}
+
+//
+// validator config hash
+//
+var _ sdk.Msg = &MsgConfigHash{}
+
+// MsgConfigHash publishes hash of consensus-critical config of validator node
+type MsgConfigHash struct {
+	From hmTypes.HeimdallAddress `json:"from"`
+	ID   hmTypes.ValidatorID     `json:"id"`
+	Hash hmTypes.HeimdallHash    `json:"hash"`
+}
+
+// NewMsgConfigHash creates new config-hash msg
+func NewMsgConfigHash(from hmTypes.HeimdallAddress, id hmTypes.ValidatorID, hash hmTypes.HeimdallHash) MsgConfigHash {
+	return MsgConfigHash{
+		From: from,
+		ID:   id,
+		Hash: hash,
+	}
+}
+
+func (msg MsgConfigHash) Type() string {
+	return "config-hash"
+}
+
+func (msg MsgConfigHash) Route() string {
+	return RouterKey
+}
+
+func (msg MsgConfigHash) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgConfigHash) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgConfigHash) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid sender %v", msg.From.String())
+	}
+
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.Hash.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid config hash %v", msg.Hash.String())
+	}
+
+	return nil
+}
+
+//
+// validator BLS key
+//
+var _ sdk.Msg = &MsgSetBlsKey{}
+
+// MsgSetBlsKey registers BLS public key of validator, proof is BLS signature of public key
+// which proves possession of secret key
+type MsgSetBlsKey struct {
+	From   hmTypes.HeimdallAddress `json:"from"`
+	ID     hmTypes.ValidatorID     `json:"id"`
+	PubKey hmTypes.HexBytes        `json:"pub_key"`
+	Proof  hmTypes.HexBytes        `json:"proof"`
+}
+
+// NewMsgSetBlsKey creates new set-bls-key msg
+func NewMsgSetBlsKey(from hmTypes.HeimdallAddress, id hmTypes.ValidatorID, pubKey hmTypes.HexBytes, proof hmTypes.HexBytes) MsgSetBlsKey {
+	return MsgSetBlsKey{
+		From:   from,
+		ID:     id,
+		PubKey: pubKey,
+		Proof:  proof,
+	}
+}
+
+func (msg MsgSetBlsKey) Type() string {
+	return "set-bls-key"
+}
+
+func (msg MsgSetBlsKey) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetBlsKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgSetBlsKey) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSetBlsKey) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid sender %v", msg.From.String())
+	}
+
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if len(msg.PubKey) != helper.BlsPubKeyLength {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid BLS public key length %v", len(msg.PubKey))
+	}
+
+	if len(msg.Proof) != helper.BlsSignatureLength {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid BLS proof of possession length %v", len(msg.Proof))
+	}
+
+	return nil
+}
+
+//
+// validator set sync
+//
+var _ sdk.Msg = &MsgValidatorSetSync{}
+
+// MsgValidatorSetSync mirrors validator set of stake chain epoch to secondary root chain
+type MsgValidatorSetSync struct {
+	From       hmTypes.HeimdallAddress `json:"from"`
+	RootChain  string                  `json:"root"`
+	Nonce      uint64                  `json:"nonce"`
+	Epoch      uint64                  `json:"epoch"`
+	Validators []SyncValidator         `json:"validators"`
+}
+
+// NewMsgValidatorSetSync creates new validator-set-sync msg
+func NewMsgValidatorSetSync(from hmTypes.HeimdallAddress, rootChain string, nonce uint64, epoch uint64, validators []SyncValidator) MsgValidatorSetSync {
+	return MsgValidatorSetSync{
+		From:       from,
+		RootChain:  rootChain,
+		Nonce:      nonce,
+		Epoch:      epoch,
+		Validators: validators,
+	}
+}
+
+func (msg MsgValidatorSetSync) Type() string {
+	return "validator-set-sync"
+}
+
+func (msg MsgValidatorSetSync) Route() string {
+	return RouterKey
+}
+
+func (msg MsgValidatorSetSync) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgValidatorSetSync) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgValidatorSetSync) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if hmTypes.GetRootChainID(msg.RootChain) == 0 || msg.RootChain == hmTypes.RootChainTypeStake {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root chain %v", msg.RootChain)
+	}
+
+	if msg.Nonce == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid nonce %v", msg.Nonce)
+	}
+
+	if len(msg.Validators) == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Empty validator set")
+	}
+
+	return nil
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgValidatorSetSync) GetSideSignBytes() []byte {
+	// validatorSetSync:(uint256 chainId, uint256 nonce, uint256 epoch, uint256 count, (uint256 validatorId, uint256 power, address signer)[])
+	data := [][]byte{
+		new(big.Int).SetUint64(uint64(hmTypes.GetRootChainID(msg.RootChain))).Bytes(),
+		new(big.Int).SetUint64(msg.Nonce).Bytes(),
+		new(big.Int).SetUint64(msg.Epoch).Bytes(),
+		new(big.Int).SetUint64(uint64(len(msg.Validators))).Bytes(),
+	}
+
+	for _, validator := range msg.Validators {
+		data = append(data,
+			new(big.Int).SetUint64(validator.ID.Uint64()).Bytes(),
+			new(big.Int).SetInt64(validator.VotingPower).Bytes(),
+			validator.Signer.Bytes(),
+		)
+	}
+
+	return helper.AppendBytes32(data...)
+}
+
+//
+// validator set sync ack
+//
+var _ sdk.Msg = &MsgValidatorSetSyncAck{}
+
+// MsgValidatorSetSyncAck acknowledges validator set synced on secondary root chain
+type MsgValidatorSetSyncAck struct {
+	From      hmTypes.HeimdallAddress `json:"from"`
+	RootChain string                  `json:"root"`
+	Nonce     uint64                  `json:"nonce"`
+}
+
+// NewMsgValidatorSetSyncAck creates new validator-set-sync-ack msg
+func NewMsgValidatorSetSyncAck(from hmTypes.HeimdallAddress, rootChain string, nonce uint64) MsgValidatorSetSyncAck {
+	return MsgValidatorSetSyncAck{
+		From:      from,
+		RootChain: rootChain,
+		Nonce:     nonce,
+	}
+}
+
+func (msg MsgValidatorSetSyncAck) Type() string {
+	return "validator-set-sync-ack"
+}
+
+func (msg MsgValidatorSetSyncAck) Route() string {
+	return RouterKey
+}
+
+func (msg MsgValidatorSetSyncAck) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgValidatorSetSyncAck) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgValidatorSetSyncAck) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if hmTypes.GetRootChainID(msg.RootChain) == 0 || msg.RootChain == hmTypes.RootChainTypeStake {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root chain %v", msg.RootChain)
+	}
+
+	if msg.Nonce == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid nonce %v", msg.Nonce)
+	}
+
+	return nil
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgValidatorSetSyncAck) GetSideSignBytes() []byte {
+	return nil
+}
@@ -36,7 +36,8 @@ func NewParams(stakingBufferTime time.Duration) Params {
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().RegisterParamSet(&Params{}).RegisterType(KeyBatchStakeUpdates, false).
+		RegisterType(KeySignerRotationDelay, uint64(0))
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
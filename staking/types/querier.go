@@ -6,17 +6,26 @@ import (
 
 // query endpoints supported by the staking Querier
 const (
-	QueryCurrentValidatorSet  = "current-validator-set"
-	QuerySigner               = "signer"
-	QueryValidator            = "validator"
-	QueryValidatorStatus      = "validator-status"
-	QueryProposer             = "proposer"
-	QueryTotalValidatorPower  = "total-val-power"
-	QueryCurrentProposer      = "current-proposer"
-	QueryProposerBonusPercent = "proposer-bonus-percent"
-	QueryStakingSequence      = "staking-sequence"
-	QueryNextStaking          = "staking-next"
-	QueryStakingQueue         = "staking-queue"
+	QueryCurrentValidatorSet      = "current-validator-set"
+	QuerySigner                   = "signer"
+	QueryValidator                = "validator"
+	QueryValidatorStatus          = "validator-status"
+	QueryProposer                 = "proposer"
+	QueryTotalValidatorPower      = "total-val-power"
+	QueryCurrentProposer          = "current-proposer"
+	QueryProposerBonusPercent     = "proposer-bonus-percent"
+	QueryStakingSequence          = "staking-sequence"
+	QueryNextStaking              = "staking-next"
+	QueryStakingQueue             = "staking-queue"
+	QueryValidatorMetadata        = "validator-metadata"
+	QueryAllValidatorMetadata     = "all-validator-metadata"
+	QueryConfigHashes             = "config-hashes"
+	QueryValidatorSetSync         = "validator-set-sync"
+	QueryPendingStakeUpdates      = "pending-stake-updates"
+	QueryPendingSignerRotations   = "pending-signer-rotations"
+	QueryBlsKey                   = "bls-key"
+	QueryBlsKeys                  = "bls-keys"
+	QueryValidatorSetAtCheckpoint = "validator-set-at-checkpoint"
 )
 
 // QuerySignerParams defines the params for querying by address
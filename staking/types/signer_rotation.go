@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// KeySignerRotationDelay param key of blocks signer update waits before it takes effect.
+// Rotation takes effect on next checkpoint ack or once delay has passed, whichever comes first.
+// It's registered separately from Params, so chains which never set it keep rotating signers right away.
+var KeySignerRotationDelay = []byte("SignerRotationDelay")
+
+// PendingSignerRotation signer update of validator waiting for activation. Both old and
+// new signer are accepted for validator until it's applied, so in-flight checkpoints aren't lost.
+type PendingSignerRotation struct {
+	ValidatorID      hmTypes.ValidatorID     `json:"validator_id"`
+	NewPubKey        hmTypes.PubKey          `json:"new_pub_key"`
+	NewSigner        hmTypes.HeimdallAddress `json:"new_signer"`
+	Nonce            uint64                  `json:"nonce"`
+	Sequence         string                  `json:"sequence"`
+	Height           int64                   `json:"height"`
+	ActivationHeight int64                   `json:"activation_height"`
+	TxHash           hmTypes.HeimdallHash    `json:"tx_hash"`
+}
+
+// String returns human readable string
+func (r PendingSignerRotation) String() string {
+	return fmt.Sprintf(
+		"PendingSignerRotation {%v %v %v %v %v %v}",
+		r.ValidatorID,
+		r.NewSigner.String(),
+		r.Nonce,
+		r.Height,
+		r.ActivationHeight,
+		r.TxHash.Hex(),
+	)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// KeyBatchStakeUpdates param key of whether stake updates are queued and applied on checkpoint ack.
+// It's registered separately from Params, so chains which never set it keep applying stake updates right away.
+var KeyBatchStakeUpdates = []byte("BatchStakeUpdates")
+
+// PendingStakeUpdate power change of validator queued until next checkpoint ack (epoch boundary)
+type PendingStakeUpdate struct {
+	ValidatorID hmTypes.ValidatorID  `json:"validator_id"`
+	VotingPower int64                `json:"power"`
+	Nonce       uint64               `json:"nonce"`
+	Height      int64                `json:"height"`
+	TxHash      hmTypes.HeimdallHash `json:"tx_hash"`
+}
+
+// String returns human readable string
+func (u PendingStakeUpdate) String() string {
+	return fmt.Sprintf(
+		"PendingStakeUpdate {%v %v %v %v %v}",
+		u.ValidatorID,
+		u.VotingPower,
+		u.Nonce,
+		u.Height,
+		u.TxHash.Hex(),
+	)
+}
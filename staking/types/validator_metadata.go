@@ -0,0 +1,117 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Size limits for validator metadata fields
+const (
+	MaxMonikerLength      = 70
+	MaxWebsiteLength      = 140
+	MaxDetailsLength      = 280
+	MaxAlertWebhookLength = 256
+)
+
+// ValidatorMetadata represents operational metadata registered by a validator
+type ValidatorMetadata struct {
+	ValidatorID  hmTypes.ValidatorID  `json:"validator_id" yaml:"validator_id"`
+	Moniker      string               `json:"moniker" yaml:"moniker"`
+	Website      string               `json:"website" yaml:"website"`
+	Details      string               `json:"details" yaml:"details"`
+	AlertWebhook string               `json:"alert_webhook" yaml:"alert_webhook"`
+	ContactHash  hmTypes.HeimdallHash `json:"contact_hash" yaml:"contact_hash"`
+	UpdatedAt    int64                `json:"updated_at" yaml:"updated_at"`
+}
+
+// NewValidatorMetadata creates new validator metadata
+func NewValidatorMetadata(
+	validatorID hmTypes.ValidatorID,
+	moniker string,
+	website string,
+	details string,
+	alertWebhook string,
+	contactHash hmTypes.HeimdallHash,
+	updatedAt int64,
+) ValidatorMetadata {
+	return ValidatorMetadata{
+		ValidatorID:  validatorID,
+		Moniker:      moniker,
+		Website:      website,
+		Details:      details,
+		AlertWebhook: alertWebhook,
+		ContactHash:  contactHash,
+		UpdatedAt:    updatedAt,
+	}
+}
+
+// ValidateBasic checks size limits and urls of metadata fields
+func (m ValidatorMetadata) ValidateBasic() error {
+	if m.ValidatorID == 0 {
+		return errors.New("invalid validator id")
+	}
+
+	if len(m.Moniker) > MaxMonikerLength {
+		return fmt.Errorf("moniker is longer than %d characters", MaxMonikerLength)
+	}
+
+	if len(m.Details) > MaxDetailsLength {
+		return fmt.Errorf("details are longer than %d characters", MaxDetailsLength)
+	}
+
+	if len(m.Website) > MaxWebsiteLength {
+		return fmt.Errorf("website is longer than %d characters", MaxWebsiteLength)
+	}
+
+	if len(m.AlertWebhook) > MaxAlertWebhookLength {
+		return fmt.Errorf("alert webhook is longer than %d characters", MaxAlertWebhookLength)
+	}
+
+	if err := validateURL(m.Website); err != nil {
+		return fmt.Errorf("invalid website: %v", err)
+	}
+
+	if err := validateURL(m.AlertWebhook); err != nil {
+		return fmt.Errorf("invalid alert webhook: %v", err)
+	}
+
+	return nil
+}
+
+// String returns string representation of metadata
+func (m ValidatorMetadata) String() string {
+	return fmt.Sprintf(
+		"ValidatorMetadata{%v %v %v %v %v %v}",
+		m.ValidatorID,
+		m.Moniker,
+		m.Website,
+		m.AlertWebhook,
+		m.ContactHash.Hex(),
+		m.UpdatedAt,
+	)
+}
+
+// validateURL allows empty values, otherwise expects absolute http(s) url
+func validateURL(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %v", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return errors.New("missing host")
+	}
+
+	return nil
+}
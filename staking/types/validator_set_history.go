@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointValidatorSet reference of acked checkpoint to snapshot of validator set active at ack.
+// Snapshots are shared by checkpoints acked while validator set didn't change.
+type CheckpointValidatorSet struct {
+	Number    uint64               `json:"number"`
+	RootChain string               `json:"root_chain"`
+	Height    int64                `json:"height"`   // heimdall height of ack
+	SetHash   hmTypes.HeimdallHash `json:"set_hash"` // hash of validators snapshot
+	Proposer  hmTypes.ValidatorID  `json:"proposer"` // proposer of validator set at ack
+}
+
+// String returns human readable string
+func (c CheckpointValidatorSet) String() string {
+	return fmt.Sprintf(
+		"CheckpointValidatorSet {%v %v %v %v %v}",
+		c.Number,
+		c.RootChain,
+		c.Height,
+		c.SetHash.String(),
+		c.Proposer,
+	)
+}
+
+// ValidatorSetAtCheckpoint validator set active when checkpoint was acked, proposer priorities are not kept
+type ValidatorSetAtCheckpoint struct {
+	Number       uint64               `json:"number"`
+	RootChain    string               `json:"root_chain"`
+	Height       int64                `json:"height"`
+	ValidatorSet hmTypes.ValidatorSet `json:"validator_set"`
+}
+
+// NewValidatorSetSnapshot returns copy of validator set without proposer and proposer priorities, which
+// change on every proposer rotation
+func NewValidatorSetSnapshot(validatorSet hmTypes.ValidatorSet) hmTypes.ValidatorSet {
+	snapshot := hmTypes.ValidatorSet{Validators: make([]*hmTypes.Validator, 0, len(validatorSet.Validators))}
+	for _, validator := range validatorSet.Validators {
+		v := *validator
+		v.ProposerPriority = 0
+		snapshot.Validators = append(snapshot.Validators, &v)
+	}
+	return snapshot
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SyncValidator validator entry of validator set mirrored to secondary root chain
+type SyncValidator struct {
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Signer      hmTypes.HeimdallAddress `json:"signer"`
+	VotingPower int64                   `json:"power"`
+}
+
+// NewSyncValidators returns validators of set ordered by validator id
+func NewSyncValidators(validators []*hmTypes.Validator) []SyncValidator {
+	result := make([]SyncValidator, 0, len(validators))
+	for _, validator := range validators {
+		result = append(result, SyncValidator{
+			ID:          validator.ID,
+			Signer:      validator.Signer,
+			VotingPower: validator.VotingPower,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+// EqualSyncValidators checks if both validator lists are the same
+func EqualSyncValidators(a []SyncValidator, b []SyncValidator) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].ID != b[i].ID || !a[i].Signer.Equals(b[i].Signer) || a[i].VotingPower != b[i].VotingPower {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidatorSetSyncRecord validator set sync state of secondary root chain
+type ValidatorSetSyncRecord struct {
+	RootChain    string `json:"root_chain"`
+	Nonce        uint64 `json:"nonce"`         // nonce of last acked validator set
+	Epoch        uint64 `json:"epoch"`         // stake chain epoch of last acked validator set
+	PendingNonce uint64 `json:"pending_nonce"` // nonce of approved validator set waiting for ack
+	PendingEpoch uint64 `json:"pending_epoch"`
+}
+
+// HasPending returns true if approved validator set is not acked yet
+func (r ValidatorSetSyncRecord) HasPending() bool {
+	return r.PendingNonce > r.Nonce
+}
+
+// String returns human readable string
+func (r ValidatorSetSyncRecord) String() string {
+	return fmt.Sprintf(
+		"ValidatorSetSyncRecord {%v %v %v %v %v}",
+		r.RootChain,
+		r.Nonce,
+		r.Epoch,
+		r.PendingNonce,
+		r.PendingEpoch,
+	)
+}
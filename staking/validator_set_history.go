@@ -0,0 +1,99 @@
+package staking
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// validator set history
+//
+
+// GetValidatorSnapshotKey returns key of validators snapshot by hash
+func GetValidatorSnapshotKey(hash []byte) []byte {
+	return append(append([]byte{}, ValidatorSnapshotKey...), hash...)
+}
+
+// GetCheckpointValidatorSetKey returns key of validator set reference of checkpoint of root chain
+func GetCheckpointValidatorSetKey(rootChain string, number uint64) []byte {
+	return append(append(append([]byte{}, CheckpointValSetKey...), hmTypes.GetRootChainID(rootChain)), sdk.Uint64ToBigEndian(number)...)
+}
+
+// SnapshotValidatorSet records current validator set as set which signed checkpoint acked now.
+// Validators are stored once per distinct set and referenced by every checkpoint acked with it.
+func (k *Keeper) SnapshotValidatorSet(ctx sdk.Context, number uint64, rootChain string) error {
+	store := ctx.KVStore(k.storeKey)
+	validatorSet := k.GetValidatorSet(ctx)
+
+	snapshot, err := k.cdc.MarshalBinaryBare(types.NewValidatorSetSnapshot(validatorSet))
+	if err != nil {
+		return err
+	}
+
+	hash := tmhash.Sum(snapshot)
+	if key := GetValidatorSnapshotKey(hash); !store.Has(key) {
+		store.Set(key, snapshot)
+	}
+
+	reference := types.CheckpointValidatorSet{
+		Number:    number,
+		RootChain: rootChain,
+		Height:    ctx.BlockHeight(),
+		SetHash:   hmTypes.BytesToHeimdallHash(hash),
+	}
+	if validatorSet.Proposer != nil {
+		reference.Proposer = validatorSet.Proposer.ID
+	}
+
+	out, err := k.cdc.MarshalBinaryBare(reference)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetCheckpointValidatorSetKey(rootChain, number), out)
+	return nil
+}
+
+// GetValidatorSetAtCheckpoint returns validator set active when checkpoint of root chain was acked.
+// Checkpoints acked before snapshots were recorded have none.
+func (k *Keeper) GetValidatorSetAtCheckpoint(ctx sdk.Context, number uint64, rootChain string) (result types.ValidatorSetAtCheckpoint, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetCheckpointValidatorSetKey(rootChain, number))
+	if bz == nil {
+		return result, fmt.Errorf("no validator set snapshot of checkpoint %v on root chain %v", number, rootChain)
+	}
+
+	var reference types.CheckpointValidatorSet
+	if err := k.cdc.UnmarshalBinaryBare(bz, &reference); err != nil {
+		return result, err
+	}
+
+	bz = store.Get(GetValidatorSnapshotKey(reference.SetHash.Bytes()))
+	if bz == nil {
+		return result, fmt.Errorf("validators snapshot %v of checkpoint %v not found", reference.SetHash.String(), number)
+	}
+
+	var validatorSet hmTypes.ValidatorSet
+	if err := k.cdc.UnmarshalBinaryBare(bz, &validatorSet); err != nil {
+		return result, err
+	}
+
+	for _, validator := range validatorSet.Validators {
+		if validator.ID == reference.Proposer {
+			validatorSet.Proposer = validator
+		}
+	}
+
+	return types.ValidatorSetAtCheckpoint{
+		Number:       number,
+		RootChain:    rootChain,
+		Height:       reference.Height,
+		ValidatorSet: validatorSet,
+	}, nil
+}
@@ -10,5 +10,7 @@ const (
 	FlagTo              = "to"
 	FlagAmount          = "amount"
 	FlagFeeAmount       = "fee-amount"
+	FlagAccounts        = "accounts"
+	FlagToken           = "token"
 	RootChainType       = "root-chain-type"
 )
@@ -11,6 +11,7 @@ import (
 
 	hmClient "github.com/maticnetwork/heimdall/client"
 	"github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -28,6 +29,10 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	topupQueryCmd.AddCommand(
 		client.GetCommands(
 			GetSequence(cdc),
+			GetDividendAccount(cdc),
+			GetDividendAccounts(cdc),
+			GetDividendAccountRoot(cdc),
+			GetERC20FeeTokens(cdc),
 		)...,
 	)
 
@@ -60,12 +65,13 @@ func GetSequence(cdc *codec.Codec) *cobra.Command {
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, t), queryParams)
 			if err != nil {
-				fmt.Println("No topup exists")
-				return nil
+				return hmClient.PrintTextOrOutput(cliCtx, "No topup exists", map[string]interface{}{"exists": false})
 			}
 
-			fmt.Println("Success. Topup exists with sequence:", string(res))
-			return nil
+			return hmClient.PrintTextOrOutput(cliCtx,
+				fmt.Sprintf("Success. Topup exists with sequence: %s", string(res)),
+				map[string]interface{}{"exists": true, "sequence": string(res)},
+			)
 		},
 	}
 
@@ -79,3 +85,90 @@ func GetSequence(cdc *codec.Codec) *cobra.Command {
 	}
 	return cmd
 }
+
+// GetDividendAccount returns dividend account of user
+func GetDividendAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dividend-account [address]",
+		Short: "get dividend account (pending fee withdrawal) of user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryDividendAccountParams(hmTypes.HexToHeimdallAddress(args[0])))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDividendAccount), queryParams)
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintRawOutput(cliCtx, res)
+		},
+	}
+
+	return cmd
+}
+
+// GetDividendAccounts returns all dividend accounts
+func GetDividendAccounts(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dividend-accounts",
+		Short: "get all dividend accounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDividendAccounts), nil)
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintRawOutput(cliCtx, res)
+		},
+	}
+
+	return cmd
+}
+
+// GetERC20FeeTokens returns whitelisted erc20 fee tokens
+func GetERC20FeeTokens(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-fee-tokens",
+		Short: "get erc20 tokens accepted as fee with their rates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryERC20FeeTokens), nil)
+			if err != nil {
+				return err
+			}
+
+			return hmClient.PrintRawOutput(cliCtx, res)
+		},
+	}
+
+	return cmd
+}
+
+// GetDividendAccountRoot returns account root hash which would be included in next checkpoint
+func GetDividendAccountRoot(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dividend-account-root",
+		Short: "get dividend account root hash for next checkpoint",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDividendAccountRoot), nil)
+			if err != nil {
+				return err
+			}
+
+			accountRoot := hmTypes.BytesToHeimdallHash(res).String()
+			return hmClient.PrintTextOrOutput(cliCtx, accountRoot, map[string]string{"account_root_hash": accountRoot})
+		},
+	}
+
+	return cmd
+}
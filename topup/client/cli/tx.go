@@ -33,7 +33,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		client.PostCommands(
 			TopupTxCmd(cdc),
+			TopupERC20TxCmd(cdc),
 			WithdrawFeeTxCmd(cdc),
+			TopupSweepTxCmd(cdc),
 		)...,
 	)
 	return txCmd
@@ -115,6 +117,76 @@ func TopupTxCmd(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// TopupERC20TxCmd will create a topup tx of whitelisted erc20 token deposit
+func TopupERC20TxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-erc20",
+		Short: "Topup fee tokens for validators by erc20 token deposit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := types.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			// get user
+			user := types.HexToHeimdallAddress(viper.GetString(FlagUserAddress))
+			if user.Empty() {
+				return fmt.Errorf("user address cannot be zero")
+			}
+
+			// get token
+			token, err := types.ParseTronAddress(viper.GetString(FlagToken))
+			if err != nil {
+				return fmt.Errorf("invalid token address: %v", err)
+			}
+
+			// deposited token amount
+			amount, ok := sdk.NewIntFromString(viper.GetString(FlagAmount))
+			if !ok {
+				return errors.New("Invalid token amount")
+			}
+
+			txhash := viper.GetString(FlagTxHash)
+			if txhash == "" {
+				return fmt.Errorf("transaction hash has to be supplied")
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := topupTypes.NewMsgTopupERC20(
+				proposer,
+				user,
+				token,
+				amount,
+				types.HexToHeimdallHash(txhash),
+				viper.GetUint64(FlagLogIndex),
+				viper.GetUint64(FlagBlockNumber),
+			)
+
+			// broadcast msg with cli
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().String(FlagUserAddress, "", "--user=<user>")
+	cmd.Flags().String(FlagToken, "", "--token=<token-address>")
+	cmd.Flags().String(FlagAmount, "", "--amount=<token-amount>")
+	cmd.Flags().Uint64(FlagLogIndex, 0, "--log-index=<log-index>")
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block-number=<block-number>")
+
+	for _, flag := range []string{FlagTxHash, FlagLogIndex, FlagUserAddress, FlagToken, FlagAmount, FlagBlockNumber} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			cliLogger.Error("TopupERC20TxCmd | MarkFlagRequired", "flag", flag, "Error", err)
+		}
+	}
+
+	return cmd
+}
+
 // WithdrawFeeTxCmd will create a fee withdraw tx
 func WithdrawFeeTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -152,3 +224,42 @@ func WithdrawFeeTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// TopupSweepTxCmd will create a topup sweep tx
+func TopupSweepTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep",
+		Short: "Move dust fee balances of accounts into their dividend accounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := types.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			var accounts []types.HeimdallAddress
+			for _, account := range viper.GetStringSlice(FlagAccounts) {
+				accounts = append(accounts, types.HexToHeimdallAddress(account))
+			}
+
+			// get msg
+			msg := topupTypes.NewMsgTopupSweep(proposer, accounts)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// broadcast msg with cli
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().StringSlice(FlagAccounts, nil, "--accounts=<address>,<address>")
+	if err := cmd.MarkFlagRequired(FlagAccounts); err != nil {
+		cliLogger.Error("TopupSweepTxCmd | MarkFlagRequired | FlagAccounts", "Error", err)
+	}
+
+	return cmd
+}
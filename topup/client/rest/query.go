@@ -23,6 +23,14 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/topup/dividend-account/{address}",
 		dividendAccountByAddressHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/topup/dividend-accounts",
+		dividendAccountsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/topup/erc20-fee-tokens",
+		erc20FeeTokensHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/topup/dividend-account-root",
 		dividendAccountRootHandlerFn(cliCtx),
@@ -121,6 +129,48 @@ func dividendAccountByAddressHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 	}
 }
 
+// dividendAccountsHandlerFn returns all dividend accounts with pending fee
+func dividendAccountsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDividendAccounts), nil)
+		if err != nil {
+			RestLogger.Error("Error while fetching Dividend accounts", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// erc20FeeTokensHandlerFn returns whitelisted erc20 fee tokens
+func erc20FeeTokensHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryERC20FeeTokens), nil)
+		if err != nil {
+			RestLogger.Error("Error while fetching erc20 fee tokens", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // dividendAccountRootHandlerFn returns genesis accountroothash
 func dividendAccountRootHandlerFn(
 	cliCtx context.CLIContext,
@@ -18,7 +18,9 @@ import (
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/topup/fee", TopupHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/topup/fee-erc20", TopupERC20HandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/topup/withdraw", WithdrawFeeHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/topup/sweep", TopupSweepHandlerFn(cliCtx)).Methods("POST")
 }
 
 //
@@ -75,6 +77,66 @@ func TopupHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+//
+// ERC20 topup req
+//
+
+// TopupERC20Req defines the properties of a erc20 topup request's body.
+type TopupERC20Req struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	TxHash      string `json:"tx_hash" yaml:"tx_hash"`
+	LogIndex    uint64 `json:"log_index" yaml:"log_index"`
+	User        string `json:"user" yaml:"user"`
+	Token       string `json:"token" yaml:"token"`
+	Amount      string `json:"amount" yaml:"amount"`
+	BlockNumber uint64 `json:"block_number" yaml:"block_number"`
+}
+
+// TopupERC20HandlerFn - http request handler to topup coins to a address by erc20 deposit.
+func TopupERC20HandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TopupERC20Req
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// get from address
+		fromAddr := types.HexToHeimdallAddress(req.BaseReq.From)
+
+		// token address
+		token, err := types.ParseTronAddress(req.Token)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid token address")
+			return
+		}
+
+		// token amount
+		amount, ok := sdk.NewIntFromString(req.Amount)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid amount")
+			return
+		}
+
+		msg := topupTypes.NewMsgTopupERC20(
+			fromAddr,
+			types.HexToHeimdallAddress(req.User),
+			token,
+			amount,
+			types.HexToHeimdallHash(req.TxHash),
+			req.LogIndex,
+			req.BlockNumber,
+		)
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 //
 // Withdraw Fee req
 //
@@ -120,3 +182,45 @@ func WithdrawFeeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+//
+// Topup sweep req
+//
+
+// TopupSweepReq defines the properties of a topup sweep request's body.
+type TopupSweepReq struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Accounts []string     `json:"accounts" yaml:"accounts"`
+}
+
+// TopupSweepHandlerFn - http request handler to sweep dust fee balances into dividend accounts.
+func TopupSweepHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TopupSweepReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		accounts := make([]types.HeimdallAddress, 0, len(req.Accounts))
+		for _, account := range req.Accounts {
+			accounts = append(accounts, types.HexToHeimdallAddress(account))
+		}
+
+		// get msg
+		msg := topupTypes.NewMsgTopupSweep(
+			types.HexToHeimdallAddress(req.BaseReq.From),
+			accounts,
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
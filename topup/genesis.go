@@ -7,6 +7,8 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetERC20FeeTokens(ctx, data.ERC20FeeTokens)
+
 	for _, sequence := range data.TopupSequences {
 		keeper.SetTopupSequence(ctx, sequence)
 	}
@@ -22,8 +24,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	return types.NewGenesisState(
+	genesis := types.NewGenesisState(
 		keeper.GetTopupSequences(ctx),
 		keeper.GetAllDividendAccounts(ctx),
 	)
+	genesis.ERC20FeeTokens = keeper.GetERC20FeeTokens(ctx)
+
+	return genesis
 }
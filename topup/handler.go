@@ -20,8 +20,12 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgTopup:
 			return HandleMsgTopup(ctx, k, msg, contractCaller)
+		case types.MsgTopupERC20:
+			return HandleMsgTopupERC20(ctx, k, msg)
 		case types.MsgWithdrawFee:
 			return HandleMsgWithdrawFee(ctx, k, msg)
+		case types.MsgTopupSweep:
+			return HandleMsgTopupSweep(ctx, k, msg)
 		default:
 			return sdk.ErrUnknownRequest("Unrecognized topup msg type").Result()
 		}
@@ -68,6 +72,53 @@ func HandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractCalle
 	}
 }
 
+// HandleMsgTopupERC20 handles erc20 topup event
+func HandleMsgTopupERC20(ctx sdk.Context, k Keeper, msg types.MsgTopupERC20) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating erc20 topup msg",
+		"User", msg.User,
+		"Token", msg.Token,
+		"Amount", msg.Amount,
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	if !k.bk.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	if _, ok := k.GetERC20FeeToken(ctx, msg.Token); !ok {
+		k.Logger(ctx).Error("Token is not whitelisted erc20 fee token", "token", msg.Token)
+		return types.ErrTokenNotWhitelisted(k.Codespace()).Result()
+	}
+
+	// sequence id, shared with native topups as log index is unique in block
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx already exists
+	if k.HasTopupSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTopupERC20,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.User.String()),
+			sdk.NewAttribute(types.AttributeKeyToken, msg.Token.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenAmount, msg.Amount.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 // HandleMsgWithdrawFee handle withdraw fee event
 func HandleMsgWithdrawFee(ctx sdk.Context, k Keeper, msg types.MsgWithdrawFee) sdk.Result {
 	// partial withdraw
@@ -111,3 +162,56 @@ func HandleMsgWithdrawFee(ctx sdk.Context, k Keeper, msg types.MsgWithdrawFee) s
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// HandleMsgTopupSweep moves dust fee balances into dividend accounts of their owners.
+// Balance is dust if it can't pay fee of a tx (including own withdraw) at current tx fees param.
+func HandleMsgTopupSweep(ctx sdk.Context, k Keeper, msg types.MsgTopupSweep) sdk.Result {
+	if !k.sk.IsCurrentValidatorByAddress(ctx, msg.From.Bytes()) {
+		k.Logger(ctx).Error("Sweep sender is not a current validator", "from", msg.From)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Sender %v is not a current validator", msg.From.String()).Result()
+	}
+
+	dustThreshold, _ := sdk.NewIntFromString(k.ak.GetParams(ctx).TxFees)
+
+	swept := make(map[string]bool)
+	for _, account := range msg.Accounts {
+		if swept[account.String()] {
+			continue
+		}
+
+		amount := k.bk.GetCoins(ctx, account).AmountOf(authTypes.FeeToken)
+		if amount.IsZero() || amount.GTE(dustThreshold) {
+			continue
+		}
+
+		coins := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: amount}}
+		if _, err := k.bk.SubtractCoins(ctx, account, coins); err != nil {
+			k.Logger(ctx).Error("Error while sweeping fee balance", "account", account, "err", err)
+			return err.Result()
+		}
+
+		if err := k.AddFeeToDividendAccount(ctx, account, amount.BigInt()); err != nil {
+			k.Logger(ctx).Error("handleMsgTopupSweep | AddFeeToDividendAccount", "account", account, "err", err)
+			return err.Result()
+		}
+		swept[account.String()] = true
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFeeSweep,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeySender, msg.From.String()),
+				sdk.NewAttribute(types.AttributeKeyUser, account.String()),
+				sdk.NewAttribute(types.AttributeKeyFeeSweepAmount, amount.String()),
+			),
+		)
+	}
+
+	if len(swept) == 0 {
+		return types.ErrNothingToSweep(k.Codespace()).Result()
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	chainTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/topup"
@@ -193,3 +194,53 @@ func (suite *HandlerTestSuite) TestHandleMsgWithdrawFee() {
 		require.False(t, result.IsOK(), "Expected withdraw to be failed while withdrawing more than account's coins")
 	})
 }
+
+func (suite *HandlerTestSuite) TestHandleMsgTopupSweep() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	valSet := chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0].Signer
+
+	_, _, dustAddr := sdkAuth.KeyTestPubAddr()
+	_, _, richAddr := sdkAuth.KeyTestPubAddr()
+	dust := hmTypes.AccAddressToHeimdallAddress(dustAddr)
+	rich := hmTypes.AccAddressToHeimdallAddress(richAddr)
+
+	threshold, _ := sdk.NewIntFromString(app.AccountKeeper.GetParams(ctx).TxFees)
+	setFeeBalance := func(addr hmTypes.HeimdallAddress, amount sdk.Int) {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		acc.SetCoins(sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: amount}})
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+	setFeeBalance(dust, sdk.NewInt(10))
+	setFeeBalance(rich, threshold)
+
+	// only validators can sweep
+	result := suite.handler(ctx, types.NewMsgTopupSweep(dust, []hmTypes.HeimdallAddress{dust}))
+	require.False(t, result.IsOK())
+
+	result = suite.handler(ctx, types.NewMsgTopupSweep(validator, []hmTypes.HeimdallAddress{dust, rich, dust}))
+	require.True(t, result.IsOK(), "expected sweep to succeed, got %v", result)
+
+	require.True(t, app.AccountKeeper.GetAccount(ctx, dust).GetCoins().AmountOf(authTypes.FeeToken).IsZero())
+	require.Equal(t, threshold, app.AccountKeeper.GetAccount(ctx, rich).GetCoins().AmountOf(authTypes.FeeToken), "non-dust balance is kept")
+
+	dividendAccount, err := app.TopupKeeper.GetDividendAccountByAddress(ctx, dust)
+	require.NoError(t, err)
+	require.Equal(t, "10", dividendAccount.FeeAmount)
+	require.False(t, app.TopupKeeper.CheckIfDividendAccountExists(ctx, rich))
+
+	// nothing left to sweep
+	result = suite.handler(ctx, types.NewMsgTopupSweep(validator, []hmTypes.HeimdallAddress{dust, rich}))
+	require.Equal(t, types.CodeNothingToSweep, result.Code)
+
+	// balance below raised tx fees is dust
+	authParams := app.AccountKeeper.GetParams(ctx)
+	authParams.TxFees = threshold.AddRaw(1).String()
+	app.AccountKeeper.SetParams(ctx, authParams)
+
+	result = suite.handler(ctx, types.NewMsgTopupSweep(validator, []hmTypes.HeimdallAddress{rich}))
+	require.True(t, result.IsOK(), "expected sweep to succeed, got %v", result)
+	require.True(t, app.AccountKeeper.GetAccount(ctx, rich).GetCoins().AmountOf(authTypes.FeeToken).IsZero())
+	require.True(t, app.TopupKeeper.CheckIfDividendAccountExists(ctx, rich))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/maticnetwork/heimdall/auth"
 	"github.com/maticnetwork/heimdall/bank"
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/params/subspace"
@@ -24,6 +25,9 @@ var (
 	TopupSequencePrefixKey = []byte{0x81}
 
 	DividendAccountMapKey = []byte{0x82} // prefix for each key for Dividend Account Map
+
+	DividendAccountsDirtyKey   = []byte{0x83} // key set once dividend accounts changed since account root hash was computed
+	DividendAccountsTrackedKey = []byte{0x84} // key set once changes of dividend accounts are tracked with dirty key
 )
 
 // Keeper stores all related data
@@ -42,6 +46,8 @@ type Keeper struct {
 	bk bank.Keeper
 	// staking keeper
 	sk staking.Keeper
+	// account keeper
+	ak auth.AccountKeeper
 }
 
 // NewKeeper create new keeper
@@ -53,15 +59,17 @@ func NewKeeper(
 	chainKeeper chainmanager.Keeper,
 	bankKeeper bank.Keeper,
 	stakingKeeper staking.Keeper,
+	accountKeeper auth.AccountKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:         cdc,
 		key:         storeKey,
-		paramSpace:  paramSpace,
+		paramSpace:  paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:   codespace,
 		chainKeeper: chainKeeper,
 		bk:          bankKeeper,
 		sk:          stakingKeeper,
+		ak:          accountKeeper,
 	}
 }
 
@@ -139,10 +147,33 @@ func (k *Keeper) AddDividendAccount(ctx sdk.Context, dividendAccount hmTypes.Div
 	}
 
 	store.Set(GetDividendAccountMapKey(dividendAccount.User.Bytes()), bz)
+	if store.Has(DividendAccountsTrackedKey) {
+		store.Set(DividendAccountsDirtyKey, DefaultValue)
+	}
 	k.Logger(ctx).Debug("DividendAccount Stored", "key", hex.EncodeToString(GetDividendAccountMapKey(dividendAccount.User.Bytes())), "dividendAccount", dividendAccount.String())
 	return nil
 }
 
+// TrackDividendAccounts starts tracking changes of dividend accounts, accounts are dirty until
+// account root hash is computed from them
+func (k *Keeper) TrackDividendAccounts(ctx sdk.Context) {
+	store := ctx.KVStore(k.key)
+	store.Set(DividendAccountsTrackedKey, DefaultValue)
+	store.Set(DividendAccountsDirtyKey, DefaultValue)
+}
+
+// IsDividendAccountsDirty returns true if dividend accounts changed since account root hash was computed
+func (k *Keeper) IsDividendAccountsDirty(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.key)
+	return store.Has(DividendAccountsDirtyKey)
+}
+
+// ClearDividendAccountsDirty marks account root hash as computed from current dividend accounts
+func (k *Keeper) ClearDividendAccountsDirty(ctx sdk.Context) {
+	store := ctx.KVStore(k.key)
+	store.Delete(DividendAccountsDirtyKey)
+}
+
 // GetDividendAccountByAddress will return DividendAccount of user
 func (k *Keeper) GetDividendAccountByAddress(ctx sdk.Context, address hmTypes.HeimdallAddress) (dividendAccount hmTypes.DividendAccount, err error) {
 
@@ -227,3 +258,23 @@ func (k *Keeper) IterateDividendAccountsByPrefixAndApplyFn(ctx sdk.Context, pref
 		}
 	}
 }
+
+//
+// ERC20 fee tokens
+//
+
+// SetERC20FeeTokens sets whitelist of erc20 tokens which can be deposited for fee
+func (keeper *Keeper) SetERC20FeeTokens(ctx sdk.Context, tokens types.ERC20FeeTokens) {
+	keeper.paramSpace.Set(ctx, types.KeyERC20FeeTokens, tokens)
+}
+
+// GetERC20FeeTokens returns whitelist of erc20 fee tokens, empty if it was never set
+func (keeper *Keeper) GetERC20FeeTokens(ctx sdk.Context) (tokens types.ERC20FeeTokens) {
+	keeper.paramSpace.GetIfExists(ctx, types.KeyERC20FeeTokens, &tokens)
+	return
+}
+
+// GetERC20FeeToken returns whitelisted erc20 fee token by its root chain address
+func (keeper *Keeper) GetERC20FeeToken(ctx sdk.Context, token hmTypes.TronAddress) (types.ERC20FeeToken, bool) {
+	return keeper.GetERC20FeeTokens(ctx).Get(token)
+}
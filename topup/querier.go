@@ -23,12 +23,16 @@ func NewQuerier(k Keeper, contractCaller helper.IContractCaller) sdk.Querier {
 			return querySequence(ctx, req, k, contractCaller)
 		case types.QueryDividendAccount:
 			return handleQueryDividendAccount(ctx, req, k)
+		case types.QueryDividendAccounts:
+			return handleQueryDividendAccounts(ctx, req, k)
 		case types.QueryDividendAccountRoot:
 			return handleDividendAccountRoot(ctx, req, k)
 		case types.QueryAccountProof:
 			return handleQueryAccountProof(ctx, req, k, contractCaller)
 		case types.QueryVerifyAccountProof:
 			return handleQueryVerifyAccountProof(ctx, req, k)
+		case types.QueryERC20FeeTokens:
+			return handleQueryERC20FeeTokens(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown topup query endpoint")
 		}
@@ -87,6 +91,32 @@ func handleQueryDividendAccount(ctx sdk.Context, req abci.RequestQuery, keeper K
 	return bz, nil
 }
 
+func handleQueryDividendAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	dividendAccounts := keeper.GetAllDividendAccounts(ctx)
+	if dividendAccounts == nil {
+		dividendAccounts = []hmTypes.DividendAccount{}
+	}
+
+	bz, err := json.Marshal(dividendAccounts)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryERC20FeeTokens(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	tokens := keeper.GetERC20FeeTokens(ctx)
+	if tokens == nil {
+		tokens = types.ERC20FeeTokens{}
+	}
+
+	bz, err := json.Marshal(tokens)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleDividendAccountRoot(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	// Calculate new account root hash
 	dividendAccounts := keeper.GetAllDividendAccounts(ctx)
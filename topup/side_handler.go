@@ -24,6 +24,8 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 		switch msg := msg.(type) {
 		case types.MsgTopup:
 			return SideHandleMsgTopup(ctx, k, msg, contractCaller)
+		case types.MsgTopupERC20:
+			return SideHandleMsgTopupERC20(ctx, k, msg, contractCaller)
 		default:
 			return abci.ResponseDeliverSideTx{
 				Code: uint32(sdk.CodeUnknownRequest),
@@ -39,6 +41,8 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 		switch msg := msg.(type) {
 		case types.MsgTopup:
 			return PostHandleMsgTopup(ctx, k, msg, sideTxResult)
+		case types.MsgTopupERC20:
+			return PostHandleMsgTopupERC20(ctx, k, msg, sideTxResult)
 		default:
 			return sdk.ErrUnknownRequest("Unrecognized topup msg type").Result()
 		}
@@ -61,11 +65,11 @@ func SideHandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractC
 	// get main tx receipt
 	receipt, err := contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
 	}
 
 	// get event log for topup
-	contractAddress := hmTypes.HexToTronAddress(chainParams.TronStakingInfoAddress)
+	contractAddress := chainParams.TronStakingInfoAddress.EthAddress()
 	eventLog, err := contractCaller.DecodeValidatorTopupFeesEvent(contractAddress, receipt, msg.LogIndex)
 	if err != nil || eventLog == nil {
 		k.Logger(ctx).Error("Error fetching log from txhash")
@@ -159,3 +163,139 @@ func PostHandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, sideTxRes
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// SideHandleMsgTopupERC20 handles MsgTopupERC20 message for external call.
+// It checks transfer of whitelisted token from user to token's deposit address.
+func SideHandleMsgTopupERC20(ctx sdk.Context, k Keeper, msg types.MsgTopupERC20, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+
+	k.Logger(ctx).Debug("✅ Validating External call for erc20 topup msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	feeToken, ok := k.GetERC20FeeToken(ctx, msg.Token)
+	if !ok {
+		k.Logger(ctx).Error("Token is not whitelisted erc20 fee token", "token", msg.Token)
+		return hmCommon.ErrorSideTx(k.Codespace(), types.CodeTokenNotWhitelisted)
+	}
+
+	// get main tx receipt
+	receipt, err := contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTxCause(k.Codespace(), common.CodeWaitFrConfirmation, err)
+	}
+
+	// get transfer log of token
+	eventLog, err := contractCaller.DecodeERC20TransferEvent(feeToken.Token.EthAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if !bytes.Equal(eventLog.From.Bytes(), msg.User.Bytes()) {
+		k.Logger(ctx).Error(
+			"Sender from event does not match with Msg user",
+			"EventFrom", eventLog.From.String(),
+			"MsgUser", msg.User.String(),
+		)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if !bytes.Equal(eventLog.To.Bytes(), feeToken.DepositAddress.EthAddress().Bytes()) {
+		k.Logger(ctx).Error(
+			"Recipient from event is not deposit address of token",
+			"EventTo", eventLog.To.String(),
+			"DepositAddress", feeToken.DepositAddress.String(),
+		)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if eventLog.Value.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesn't match value in event logs", "MsgAmount", msg.Amount, "ValueFromEvent", eventLog.Value)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for erc20 topup msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// PostHandleMsgTopupERC20 credits fee token for deposited erc20 amount at rate of token
+func PostHandleMsgTopupERC20(ctx sdk.Context, k Keeper, msg types.MsgTopupERC20, sideTxResult abci.SideTxResultType) sdk.Result {
+
+	// Skip handler if topup is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping new erc20 topup since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// check if incoming tx is older
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	if k.HasTopupSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	// token could be removed from whitelist while side-tx was voted on
+	feeToken, ok := k.GetERC20FeeToken(ctx, msg.Token)
+	if !ok {
+		k.Logger(ctx).Error("Token is not whitelisted erc20 fee token", "token", msg.Token)
+		return types.ErrTokenNotWhitelisted(k.Codespace()).Result()
+	}
+
+	k.Logger(ctx).Debug("Persisting erc20 topup state", "sideTxResult", sideTxResult)
+
+	user := msg.User
+
+	// convert deposited amount to fee token
+	feeAmount := feeToken.FeeAmount(msg.Amount)
+	topupAmount := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: feeAmount}}
+
+	// increase coins in account
+	if _, err := k.bk.AddCoins(ctx, user, topupAmount); err != nil {
+		k.Logger(ctx).Error("Error while adding coins to user", "user", user, "topupAmount", topupAmount, "error", err)
+		return err.Result()
+	}
+
+	// transfer fees to sender (proposer)
+	if err := k.bk.SendCoins(ctx, user, msg.FromAddress, auth.DefaultFeeWantedPerTx); err != nil {
+		return err.Result()
+	}
+
+	k.Logger(ctx).Debug("Persisted erc20 topup state for", "user", user, "token", msg.Token, "amount", msg.Amount.String(), "topupAmount", topupAmount.String())
+
+	// save topup
+	k.SetTopupSequence(ctx, sequence.String())
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTopupERC20,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.User.String()),
+			sdk.NewAttribute(types.AttributeKeyToken, msg.Token.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTopupAmount, feeAmount.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
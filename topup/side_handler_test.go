@@ -19,6 +19,7 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/contracts/erc20"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/topup"
@@ -471,3 +472,91 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgTopup() {
 		require.False(t, result.IsOK(), "Post handler should fail while replaying same tx")
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestSideAndPostHandleMsgTopupERC20() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	_, _, addr1 := sdkAuth.KeyTestPubAddr()
+	_, _, addr2 := sdkAuth.KeyTestPubAddr()
+
+	token := hmTypes.EthToTronAddress(ethCommon.HexToAddress("0x00000000000000000000000000000000000000aa"))
+	deposit := hmTypes.EthToTronAddress(ethCommon.HexToAddress("0x00000000000000000000000000000000000000bb"))
+	app.TopupKeeper.SetERC20FeeTokens(ctx, types.ERC20FeeTokens{
+		{Symbol: "USDT", Token: token, DepositAddress: deposit, Rate: sdk.NewDec(2)},
+	})
+
+	logIndex := uint64(3)
+	blockNumber := uint64(700)
+	txReceipt := &ethTypes.Receipt{
+		BlockNumber: new(big.Int).SetUint64(blockNumber),
+	}
+	amount := sdk.NewIntWithDecimal(1000, 18)
+
+	msg := types.NewMsgTopupERC20(
+		hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+		hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+		token,
+		amount,
+		hmTypes.HexToHeimdallHash("erc20 hash"),
+		logIndex,
+		blockNumber,
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		event := &erc20.Erc20Transfer{
+			From:  ethCommon.BytesToAddress(addr1.Bytes()),
+			To:    deposit.EthAddress(),
+			Value: amount.BigInt(),
+		}
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeERC20TransferEvent", token.EthAddress(), txReceipt, logIndex).Return(event, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	t.Run("WrongDepositAddress", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		event := &erc20.Erc20Transfer{
+			From:  ethCommon.BytesToAddress(addr1.Bytes()),
+			To:    ethCommon.BytesToAddress(addr2.Bytes()),
+			Value: amount.BigInt(),
+		}
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeERC20TransferEvent", token.EthAddress(), txReceipt, logIndex).Return(event, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+		require.Equal(t, uint32(common.CodeInvalidMsg), result.Code)
+	})
+
+	t.Run("NotWhitelisted", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		other := msg
+		other.Token = deposit
+
+		result := suite.sideHandler(ctx, other)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+		require.Equal(t, uint32(types.CodeTokenNotWhitelisted), result.Code)
+	})
+
+	t.Run("PostHandler", func(t *testing.T) {
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "Post handler should succeed")
+
+		// deposited amount is credited at token rate
+		acc1 := app.AccountKeeper.GetAccount(ctx, hmTypes.AccAddressToHeimdallAddress(addr1))
+		require.NotNil(t, acc1)
+		require.Equal(t, amount.MulRaw(2), acc1.GetCoins().AmountOf(authTypes.FeeToken))
+
+		// same transfer can't be credited twice
+		result = suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK(), "Post handler should fail")
+		require.Equal(t, common.CodeOldTx, result.Code)
+	})
+}
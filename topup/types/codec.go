@@ -8,6 +8,8 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgTopup{}, "topup/MsgTopup", nil)
 	cdc.RegisterConcrete(MsgWithdrawFee{}, "topup/MsgWithdrawFee", nil)
+	cdc.RegisterConcrete(MsgTopupSweep{}, "topup/MsgTopupSweep", nil)
+	cdc.RegisterConcrete(MsgTopupERC20{}, "topup/MsgTopupERC20", nil)
 }
 
 // ModuleCdc module cdc
@@ -10,6 +10,8 @@ const (
 	CodeInvalidInputsOutputs sdk.CodeType = 102
 	CodeNoValidatorTopup     sdk.CodeType = 103
 	CodeNoBalanceToWithdraw  sdk.CodeType = 104
+	CodeNothingToSweep       sdk.CodeType = 105
+	CodeTokenNotWhitelisted  sdk.CodeType = 106
 )
 
 // ErrNoInputs is an error
@@ -41,3 +43,13 @@ func ErrNoValidatorTopup(codespace sdk.CodespaceType) sdk.Error {
 func ErrNoBalanceToWithdraw(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeNoBalanceToWithdraw, "No balance to withdraw")
 }
+
+// ErrNothingToSweep is an error for topup sweep without dust balances
+func ErrNothingToSweep(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNothingToSweep, "No dust balance to sweep")
+}
+
+// ErrTokenNotWhitelisted is an error for erc20 topup of token which isn't fee token
+func ErrTokenNotWhitelisted(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenNotWhitelisted, "Token is not whitelisted erc20 fee token")
+}
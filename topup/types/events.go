@@ -3,7 +3,9 @@ package types
 // bank module event types
 const (
 	EventTypeTopup       = "topup"
+	EventTypeTopupERC20  = "topup-erc20"
 	EventTypeFeeWithdraw = "fee-withdraw"
+	EventTypeFeeSweep    = "fee-sweep"
 	EventTypeTransfer    = "transfer"
 
 	AttributeKeyRecipient         = "recipient"
@@ -11,6 +13,9 @@ const (
 	AttributeKeyUser              = "user"
 	AttributeKeyTopupAmount       = "topup-amount"
 	AttributeKeyFeeWithdrawAmount = "fee-withdraw-amount"
+	AttributeKeyFeeSweepAmount    = "fee-sweep-amount"
+	AttributeKeyToken             = "token"
+	AttributeKeyTokenAmount       = "token-amount"
 
 	AttributeValueCategory = ModuleName
 )
@@ -12,6 +12,7 @@ import (
 type GenesisState struct {
 	TopupSequences   []string                  `json:"tx_sequences" yaml:"tx_sequences"`
 	DividentAccounts []hmTypes.DividendAccount `json:"dividend_accounts" yaml:"dividend_accounts"`
+	ERC20FeeTokens   ERC20FeeTokens            `json:"erc20_fee_tokens,omitempty" yaml:"erc20_fee_tokens"` // erc20 tokens accepted as fee
 }
 
 // NewGenesisState creates a new genesis state.
@@ -35,7 +36,7 @@ func ValidateGenesis(data GenesisState) error {
 			return errors.New("Invalid Sequence")
 		}
 	}
-	return nil
+	return data.ERC20FeeTokens.Validate()
 }
 
 // GetGenesisStateFromAppState returns staking GenesisState given raw application genesis state
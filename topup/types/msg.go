@@ -89,6 +89,100 @@ func (msg MsgTopup) GetSideSignBytes() []byte {
 	return nil
 }
 
+//
+// ERC20 fee token
+//
+
+// MsgTopupERC20 - topup of fee token by deposit of whitelisted erc20 token on root chain
+type MsgTopupERC20 struct {
+	FromAddress types.HeimdallAddress `json:"from_address"`
+	User        types.HeimdallAddress `json:"user"`
+	Token       types.TronAddress     `json:"token"`
+	Amount      sdk.Int               `json:"amount"`
+	TxHash      types.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                `json:"log_index"`
+	BlockNumber uint64                `json:"block_number"`
+}
+
+var _ sdk.Msg = MsgTopupERC20{}
+
+// NewMsgTopupERC20 - construct erc20 topup msg
+func NewMsgTopupERC20(
+	fromAddr types.HeimdallAddress,
+	user types.HeimdallAddress,
+	token types.TronAddress,
+	amount sdk.Int,
+	txhash types.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgTopupERC20 {
+	return MsgTopupERC20{
+		FromAddress: fromAddr,
+		User:        user,
+		Token:       token,
+		Amount:      amount,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgTopupERC20) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgTopupERC20) Type() string {
+	return "topup-erc20"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgTopupERC20) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+
+	if msg.User.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid user %v", msg.User.String())
+	}
+
+	if err := msg.Token.Validate(); err != nil {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid token %v", msg.Token.String())
+	}
+
+	if !msg.Amount.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTopupERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTopupERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.FromAddress)}
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgTopupERC20) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgTopupERC20) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgTopupERC20) GetSideSignBytes() []byte {
+	return nil
+}
+
 //
 // Fee token withdrawal
 //
@@ -144,3 +238,65 @@ func (msg MsgWithdrawFee) GetSignBytes() []byte {
 func (msg MsgWithdrawFee) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.UserAddress)}
 }
+
+//
+// Topup sweep
+//
+
+// MaxSweepAccounts max number of accounts in single sweep
+const MaxSweepAccounts = 100
+
+// MsgTopupSweep moves dust fee token balances into their owners' dividend accounts
+type MsgTopupSweep struct {
+	From     types.HeimdallAddress   `json:"from"`
+	Accounts []types.HeimdallAddress `json:"accounts"`
+}
+
+var _ sdk.Msg = MsgTopupSweep{}
+
+// NewMsgTopupSweep - construct topup sweep msg
+func NewMsgTopupSweep(from types.HeimdallAddress, accounts []types.HeimdallAddress) MsgTopupSweep {
+	return MsgTopupSweep{
+		From:     from,
+		Accounts: accounts,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgTopupSweep) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgTopupSweep) Type() string {
+	return "sweep"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgTopupSweep) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+
+	if len(msg.Accounts) == 0 || len(msg.Accounts) > MaxSweepAccounts {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid number of accounts %v", len(msg.Accounts))
+	}
+
+	for _, account := range msg.Accounts {
+		if account.Empty() {
+			return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid account %v", account.String())
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTopupSweep) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTopupSweep) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
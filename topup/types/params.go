@@ -1 +1,75 @@
 package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Parameter keys
+var (
+	// KeyERC20FeeTokens param key of erc20 tokens which can be deposited on root chain for fee
+	KeyERC20FeeTokens = []byte("ERC20FeeTokens")
+)
+
+// ParamKeyTable for topup module. Topup has no mandatory params, chains which never set
+// erc20 fee tokens accept native fee token topups only.
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterType(KeyERC20FeeTokens, ERC20FeeTokens{})
+}
+
+// ERC20FeeToken whitelisted erc20 token of stake root chain. Transfers of token to deposit address
+// are credited as fee token, Rate is amount of fee token credited per token unit.
+type ERC20FeeToken struct {
+	Symbol         string              `json:"symbol,omitempty" yaml:"symbol"`
+	Token          hmTypes.TronAddress `json:"token" yaml:"token"`
+	DepositAddress hmTypes.TronAddress `json:"deposit_address" yaml:"deposit_address"`
+	Rate           sdk.Dec             `json:"rate" yaml:"rate"`
+}
+
+// FeeAmount returns fee token amount credited for deposited token amount
+func (t ERC20FeeToken) FeeAmount(amount sdk.Int) sdk.Int {
+	return t.Rate.MulInt(amount).TruncateInt()
+}
+
+// ERC20FeeTokens whitelist of erc20 fee tokens
+type ERC20FeeTokens []ERC20FeeToken
+
+// Get returns whitelisted token by its root chain address
+func (t ERC20FeeTokens) Get(token hmTypes.TronAddress) (ERC20FeeToken, bool) {
+	for _, feeToken := range t {
+		if feeToken.Token.Equals(token) {
+			return feeToken, true
+		}
+	}
+
+	return ERC20FeeToken{}, false
+}
+
+// Validate checks tokens are unique and have deposit address and positive rate
+func (t ERC20FeeTokens) Validate() error {
+	seen := make(map[string]bool)
+	for _, feeToken := range t {
+		if err := feeToken.Token.Validate(); err != nil {
+			return fmt.Errorf("invalid erc20 fee token address: %v", err)
+		}
+
+		if seen[feeToken.Token.String()] {
+			return fmt.Errorf("duplicate erc20 fee token %v", feeToken.Token.String())
+		}
+		seen[feeToken.Token.String()] = true
+
+		if err := feeToken.DepositAddress.Validate(); err != nil {
+			return fmt.Errorf("invalid deposit address of erc20 fee token %v: %v", feeToken.Token.String(), err)
+		}
+
+		if feeToken.Rate.IsNil() || !feeToken.Rate.IsPositive() {
+			return fmt.Errorf("erc20 fee token %v should have positive rate", feeToken.Token.String())
+		}
+	}
+
+	return nil
+}
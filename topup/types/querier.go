@@ -5,9 +5,11 @@ import "github.com/maticnetwork/heimdall/types"
 const (
 	QuerySequence            = "sequence"
 	QueryDividendAccount     = "dividend-account"
+	QueryDividendAccounts    = "dividend-accounts"
 	QueryDividendAccountRoot = "dividend-account-root"
 	QueryAccountProof        = "dividend-account-proof"
 	QueryVerifyAccountProof  = "verify-account-proof"
+	QueryERC20FeeTokens      = "erc20-fee-tokens"
 )
 
 // QuerySequenceParams defines the params for querying an account Sequence.
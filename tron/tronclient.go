@@ -21,8 +21,8 @@ type Client struct {
 }
 
 // NewClient creates a client that uses the given RPC client.
-func NewClient(url string) *Client {
-	conn, err := grpc.Dial(url, grpc.WithInsecure())
+func NewClient(url string, opts ...grpc.DialOption) *Client {
+	conn, err := grpc.Dial(url, append([]grpc.DialOption{grpc.WithInsecure()}, opts...)...)
 	if err != nil {
 		os.Exit(0)
 	}
@@ -81,6 +81,30 @@ func (tc *Client) TriggerConstantContract(contractAddress string, data []byte) (
 	return response.ConstantResult[0], nil
 }
 
+// SimulateContract executes contract call from owner against latest state without broadcasting it,
+// it returns error if call would fail
+func (tc *Client) SimulateContract(ownerAddress, contractAddress string, data []byte) error {
+	response, err := tc.client.TriggerConstantContract(context.Background(),
+		&pb.TriggerSmartContract{
+			OwnerAddress:    common.FromHex("41" + ownerAddress),
+			ContractAddress: common.FromHex(contractAddress),
+			CallValue:       0,
+			Data:            data,
+			CallTokenValue:  0,
+			TokenId:         0,
+		})
+	if err != nil {
+		return err
+	}
+	if response.Result.Code != pb.Return_SUCCESS {
+		return fmt.Errorf("code:%v message:%v", response.Result.Code, string(response.Result.Message))
+	}
+	if ret := response.Transaction.GetRet(); len(ret) != 0 && ret[0].Ret == pb.Transaction_Result_FAILED {
+		return fmt.Errorf("simulation failed: %v", string(response.Result.Message))
+	}
+	return nil
+}
+
 func (tc *Client) GetNowBlock(ctx context.Context) (int64, error) {
 	block, err := tc.client.GetNowBlock2(ctx, &pb.EmptyMessage{})
 	if err != nil {
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressPrefixes bech32 human readable parts of account, validator and consensus addresses.
+// Public key prefixes are address prefixes with "pub" suffix.
+type AddressPrefixes struct {
+	Account   string `json:"account" yaml:"account"`
+	Validator string `json:"validator" yaml:"validator"`
+	Consensus string `json:"consensus" yaml:"consensus"`
+}
+
+// DefaultAddressPrefixes returns default bech32 prefixes of sdk
+func DefaultAddressPrefixes() AddressPrefixes {
+	return AddressPrefixes{
+		Account:   sdk.Bech32PrefixAccAddr,
+		Validator: sdk.Bech32PrefixValAddr,
+		Consensus: sdk.Bech32PrefixConsAddr,
+	}
+}
+
+// WithDefaults returns prefixes with empty ones replaced by defaults
+func (p AddressPrefixes) WithDefaults() AddressPrefixes {
+	defaults := DefaultAddressPrefixes()
+	if p.Account == "" {
+		p.Account = defaults.Account
+	}
+	if p.Validator == "" {
+		p.Validator = defaults.Validator
+	}
+	if p.Consensus == "" {
+		p.Consensus = defaults.Consensus
+	}
+	return p
+}
+
+// Validate checks prefixes are valid and distinct bech32 human readable parts
+func (p AddressPrefixes) Validate() error {
+	prefixes := map[string]string{"account": p.Account, "validator": p.Validator, "consensus": p.Consensus}
+	for name, prefix := range prefixes {
+		if err := validateBech32Prefix(prefix); err != nil {
+			return fmt.Errorf("invalid %s address prefix %q: %v", name, prefix, err)
+		}
+	}
+
+	if p.Account == p.Validator || p.Account == p.Consensus || p.Validator == p.Consensus {
+		return errors.New("account, validator and consensus address prefixes must be distinct")
+	}
+
+	return nil
+}
+
+// maxAddressPrefixLength bech32 strings are limited to 90 chars, amino encoded secp256k1 public key
+// takes 68 of them with separator and checksum, leaving 22 for public key prefix
+const maxAddressPrefixLength = 22 - len(sdk.PrefixPublic)
+
+// validateBech32Prefix checks human readable part is lowercase printable ascii, short enough
+// for public keys to fit in bech32 string
+func validateBech32Prefix(prefix string) error {
+	if prefix == "" {
+		return errors.New("empty prefix")
+	}
+
+	if len(prefix) > maxAddressPrefixLength {
+		return fmt.Errorf("prefix longer than %v chars", maxAddressPrefixLength)
+	}
+
+	for _, c := range prefix {
+		if c < 33 || c > 126 || (c >= 'A' && c <= 'Z') {
+			return fmt.Errorf("invalid character %q", c)
+		}
+	}
+
+	return nil
+}
+
+// GetAddressPrefixes returns bech32 prefixes in use
+func GetAddressPrefixes() AddressPrefixes {
+	config := sdk.GetConfig()
+	return AddressPrefixes{
+		Account:   config.GetBech32AccountAddrPrefix(),
+		Validator: config.GetBech32ValidatorAddrPrefix(),
+		Consensus: config.GetBech32ConsensusAddrPrefix(),
+	}
+}
+
+// SetAddressPrefixes sets bech32 prefixes of addresses and public keys rendered by CLI, REST and events.
+// Prefixes can only be changed until sdk config is sealed, setting prefixes in use is a no-op.
+func SetAddressPrefixes(prefixes AddressPrefixes) (err error) {
+	prefixes = prefixes.WithDefaults()
+	if err := prefixes.Validate(); err != nil {
+		return err
+	}
+
+	if prefixes == GetAddressPrefixes() {
+		return nil
+	}
+
+	// sdk config panics once sealed
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cannot set address prefixes: %v", r)
+		}
+	}()
+
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(prefixes.Account, prefixes.Account+sdk.PrefixPublic)
+	config.SetBech32PrefixForValidator(prefixes.Validator, prefixes.Validator+sdk.PrefixPublic)
+	config.SetBech32PrefixForConsensusNode(prefixes.Consensus, prefixes.Consensus+sdk.PrefixPublic)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressPrefixesValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, DefaultAddressPrefixes().Validate())
+	require.Equal(t, DefaultAddressPrefixes(), AddressPrefixes{}.WithDefaults())
+	require.Equal(t, "dlv", AddressPrefixes{Account: "dlv"}.WithDefaults().Account)
+
+	tests := []AddressPrefixes{
+		{Account: "", Validator: "dlvvaloper", Consensus: "dlvvalcons"},
+		{Account: "DLV", Validator: "dlvvaloper", Consensus: "dlvvalcons"},
+		{Account: "dl v", Validator: "dlvvaloper", Consensus: "dlvvalcons"},
+		{Account: "dlv", Validator: "dlv", Consensus: "dlvvalcons"},
+		{Account: "dlv", Validator: "dlvvaloper", Consensus: "dlvvalconsensusnodes"},
+	}
+	for _, prefixes := range tests {
+		require.Error(t, prefixes.Validate(), prefixes)
+	}
+}
+
+func TestSetAddressPrefixes(t *testing.T) {
+	defer func() {
+		require.NoError(t, SetAddressPrefixes(DefaultAddressPrefixes()))
+	}()
+
+	prefixes := AddressPrefixes{Account: "dlv", Validator: "dlvvaloper", Consensus: "dlvvalcons"}
+	require.NoError(t, SetAddressPrefixes(prefixes))
+	require.Equal(t, prefixes, GetAddressPrefixes())
+	require.Equal(t, "dlvpub", sdk.GetConfig().GetBech32AccountPubPrefix())
+
+	address := sdk.AccAddress(HexToHeimdallAddress("0x5973918275c01f50555d44e92c9d9b353cadad54").Bytes())
+	require.Regexp(t, "^dlv1", address.String())
+
+	parsed, err := sdk.AccAddressFromBech32(address.String())
+	require.NoError(t, err)
+	require.Equal(t, address, parsed)
+
+	require.Error(t, SetAddressPrefixes(AddressPrefixes{Account: "dlv", Validator: "dlv"}))
+}
@@ -1,8 +1,32 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // staking module event types
 const (
 	AttributeKeyTxHash       = "txhash"
 	AttributeKeyTxLogIndex   = "tx-log-index"
 	AttributeKeySideTxResult = "side-tx-result"
+	AttributeKeyRootChain    = "root-chain"
+
+	// AttributeKeyEventRecord key of full event record of compact event
+	AttributeKeyEventRecord = "event-record"
 )
+
+// EssentialEventAttributes attributes kept in compact events: action, tx hash, root chain and number of object changed by tx
+var EssentialEventAttributes = []string{
+	sdk.AttributeKeyAction,
+	sdk.AttributeKeyModule,
+	sdk.AttributeKeySender,
+	AttributeKeyTxHash,
+	AttributeKeySideTxResult,
+	AttributeKeyRootChain,
+	"header-index",
+	"span-id",
+	"record-id",
+	"validator-id",
+	"validator-set-nonce",
+	"proposal_id",
+}
@@ -0,0 +1,111 @@
+package module
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// DefaultConsensusVersion consensus version of modules which were never migrated
+const DefaultConsensusVersion uint64 = 1
+
+// VersionMap consensus version of each module
+type VersionMap map[string]uint64
+
+// MigrationHandler migrates module store from one consensus version to next one
+type MigrationHandler func(ctx sdk.Context) error
+
+// HasConsensusVersion is the interface of modules which version their store layout.
+// Consensus version must be bumped whenever state layout of module changes.
+type HasConsensusVersion interface {
+	ConsensusVersion() uint64
+}
+
+// HasMigrations is the interface of modules which register store migrations
+type HasMigrations interface {
+	RegisterMigrations(cfg *Configurator) error
+}
+
+// Configurator keeps store migrations registered by modules
+type Configurator struct {
+	migrations map[string]map[uint64]MigrationHandler
+}
+
+// NewConfigurator creates empty configurator
+func NewConfigurator() *Configurator {
+	return &Configurator{
+		migrations: make(map[string]map[uint64]MigrationHandler),
+	}
+}
+
+// RegisterMigration registers handler which migrates module store from fromVersion to fromVersion+1
+func (c *Configurator) RegisterMigration(moduleName string, fromVersion uint64, handler MigrationHandler) error {
+	if fromVersion == 0 {
+		return fmt.Errorf("invalid from version 0 of module %v", moduleName)
+	}
+
+	if _, ok := c.migrations[moduleName]; !ok {
+		c.migrations[moduleName] = make(map[uint64]MigrationHandler)
+	}
+
+	if _, ok := c.migrations[moduleName][fromVersion]; ok {
+		return fmt.Errorf("migration of module %v from version %v is already registered", moduleName, fromVersion)
+	}
+
+	c.migrations[moduleName][fromVersion] = handler
+	return nil
+}
+
+// RunMigrations runs registered migrations of all modules from fromVM up to their current
+// consensus version, in init genesis order. Modules missing from fromVM are at default version.
+// Returns new version map which should be stored by upgrade handler.
+func (c *Configurator) RunMigrations(ctx sdk.Context, mm *module.Manager, fromVM VersionMap) (VersionMap, error) {
+	toVM := make(VersionMap)
+
+	for _, moduleName := range mm.OrderInitGenesis {
+		m, ok := mm.Modules[moduleName].(HasConsensusVersion)
+		if !ok {
+			continue
+		}
+
+		fromVersion, ok := fromVM[moduleName]
+		if !ok {
+			fromVersion = DefaultConsensusVersion
+		}
+
+		toVersion := m.ConsensusVersion()
+		if fromVersion > toVersion {
+			return nil, fmt.Errorf("module %v can't be downgraded from version %v to %v", moduleName, fromVersion, toVersion)
+		}
+
+		for v := fromVersion; v < toVersion; v++ {
+			handler, ok := c.migrations[moduleName][v]
+			if !ok {
+				return nil, fmt.Errorf("no migration registered for module %v from version %v", moduleName, v)
+			}
+
+			if err := handler(ctx); err != nil {
+				return nil, fmt.Errorf("migration of module %v from version %v failed: %v", moduleName, v, err)
+			}
+
+			ctx.Logger().Info("Migrated module store", "module", moduleName, "from", v, "to", v+1)
+		}
+
+		toVM[moduleName] = toVersion
+	}
+
+	return toVM, nil
+}
+
+// GetVersionMap returns current consensus version of modules
+func GetVersionMap(mm *module.Manager) VersionMap {
+	vm := make(VersionMap)
+	for name, m := range mm.Modules {
+		if v, ok := m.(HasConsensusVersion); ok {
+			vm[name] = v.ConsensusVersion()
+		}
+	}
+
+	return vm
+}
@@ -20,3 +20,8 @@ type SideModule interface {
 	NewSideTxHandler() types.SideTxHandler
 	NewPostTxHandler() types.PostTxHandler
 }
+
+// VoteExtensionModule is side module which prepares and verifies votes on its side msgs with vote extension
+type VoteExtensionModule interface {
+	NewVoteExtension() types.VoteExtension
+}
@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// RootChainVar path var of chain-scoped routes
+	RootChainVar = "root"
+
+	// RootChainQueryParam query param which selects root chain of chain-scoped routes
+	RootChainQueryParam = "root-chain"
+
+	// DefaultRootChain root chain of chain-scoped routes when none is selected
+	DefaultRootChain = types.RootChainTypeEth
+)
+
+// IsRootChainRegistered returns true if root chain is registered
+func IsRootChainRegistered(rootChain string) bool {
+	_, ok := types.GetRootChainIDMap()[rootChain]
+	return ok
+}
+
+// ParseRootChainOrReturnBadRequest returns root chain selected by {root} path var, ?root-chain= query param
+// or legacy ?root= query param, falling back to defaultRootChain. Empty defaultRootChain makes selection
+// optional, empty root chain is returned then. Writes bad request for unregistered or conflicting root chains.
+func ParseRootChainOrReturnBadRequest(w http.ResponseWriter, r *http.Request, defaultRootChain string) (string, bool) {
+	query := r.URL.Query()
+
+	rootChain := strings.ToLower(query.Get(RootChainQueryParam))
+	if rootChain == "" {
+		rootChain = strings.ToLower(query.Get(RootChainVar))
+	}
+
+	if pathRootChain := strings.ToLower(mux.Vars(r)[RootChainVar]); pathRootChain != "" {
+		if rootChain != "" && rootChain != pathRootChain {
+			WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("conflicting root chains %v and %v", pathRootChain, rootChain))
+			return "", false
+		}
+		rootChain = pathRootChain
+	}
+
+	if rootChain == "" {
+		rootChain = defaultRootChain
+	}
+
+	if rootChain != "" && !IsRootChainRegistered(rootChain) {
+		WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("'%s' is not a registered root chain", rootChain))
+		return "", false
+	}
+
+	return rootChain, true
+}
@@ -15,6 +15,22 @@ var (
 type SideHandlers struct {
 	SideTxHandler SideTxHandler
 	PostTxHandler PostTxHandler
+
+	// VoteExtension prepares and verifies votes on side msgs, side-tx handler is used if not set
+	VoteExtension VoteExtension
+}
+
+// GetVoteExtension returns vote extension of handlers, legacy extension of side-tx handler if none is set
+func (h *SideHandlers) GetVoteExtension() VoteExtension {
+	if h.VoteExtension != nil {
+		return h.VoteExtension
+	}
+
+	if h.SideTxHandler != nil {
+		return NewSideTxVoteExtension(h.SideTxHandler)
+	}
+
+	return nil
 }
 
 // SideRouter implements router.
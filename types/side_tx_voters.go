@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// SideTxVoter validator whose vote on side-tx was counted, along with its voting power at vote height
+type SideTxVoter struct {
+	Address []byte
+	Power   int64
+	Result  abci.SideTxResultType
+}
+
+// sideTxVotersKey context key of counted votes of side-tx being post-handled
+type sideTxVotersKey struct{}
+
+// WithSideTxVoters returns context carrying counted votes of side-tx, for post-tx handlers
+func WithSideTxVoters(ctx sdk.Context, voters []SideTxVoter) sdk.Context {
+	return ctx.WithValue(sideTxVotersKey{}, voters)
+}
+
+// GetSideTxVoters returns counted votes of side-tx being post-handled, nil outside of side-tx processing
+func GetSideTxVoters(ctx sdk.Context) []SideTxVoter {
+	voters, _ := ctx.Value(sideTxVotersKey{}).([]SideTxVoter)
+	return voters
+}
+
+// GetSideTxSigners returns voters which voted yes on side-tx being post-handled
+func GetSideTxSigners(ctx sdk.Context) (signers []SideTxVoter) {
+	for _, voter := range GetSideTxVoters(ctx) {
+		if voter.Result == abci.SideTxResultType_Yes {
+			signers = append(signers, voter)
+		}
+	}
+	return signers
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// SimulatePostTx runs post-tx handler on msg against cache wrapped context and returns result it would
+// produce, state changes are discarded. Handler logs are discarded too, so dry-runs don't flood node logs.
+func SimulatePostTx(ctx sdk.Context, handler PostTxHandler, msg sdk.Msg, sideTxResult abci.SideTxResultType) (result sdk.Result) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = sdk.ErrInternal(fmt.Sprintf("recovered: %v", r)).Result()
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithLogger(log.NewNopLogger()).WithEventManager(sdk.NewEventManager())
+	return handler(cacheCtx, msg, sideTxResult)
+}
+
+// ParseSideTxResult parses side-tx result from its name (yes, no or skip), case-insensitive
+func ParseSideTxResult(name string) (abci.SideTxResultType, error) {
+	for value, resultName := range abci.SideTxResultType_name {
+		if strings.EqualFold(name, resultName) {
+			return abci.SideTxResultType(value), nil
+		}
+	}
+	return abci.SideTxResultType_Skip, fmt.Errorf("invalid side-tx result %q", name)
+}
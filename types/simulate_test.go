@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestParseSideTxResult(t *testing.T) {
+	t.Parallel()
+
+	for name, expected := range map[string]abci.SideTxResultType{
+		"yes":  abci.SideTxResultType_Yes,
+		"No":   abci.SideTxResultType_No,
+		"SKIP": abci.SideTxResultType_Skip,
+	} {
+		result, err := ParseSideTxResult(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, result, name)
+	}
+
+	_, err := ParseSideTxResult("maybe")
+	require.Error(t, err)
+}
@@ -0,0 +1,279 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/maticnetwork/bor/common"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// TronAddrLen defines a valid tron address length, prefix byte followed by 20 address bytes
+	TronAddrLen = 21
+
+	// TronAddressPrefix prefix byte of tron mainnet addresses
+	TronAddressPrefix byte = 0x41
+)
+
+var _ yaml.Marshaler = TronAddress{}
+
+// TronAddress represents tron address, prefix byte followed by eth-style address bytes.
+// It's encoded as hex in JSON to keep existing params readable and shown as base58check.
+type TronAddress [TronAddrLen]byte
+
+// ZeroTronAddress represents zero tron address
+var ZeroTronAddress = TronAddress{}
+
+// EthAddress returns address without tron prefix, as used by contract bindings and logs
+func (ta TronAddress) EthAddress() common.Address {
+	return common.BytesToAddress(ta[1:])
+}
+
+// Empty returns boolean for whether tron address is empty
+func (ta TronAddress) Empty() bool {
+	return ta == ZeroTronAddress
+}
+
+// Equals returns boolean for whether two tron addresses are equal
+func (ta TronAddress) Equals(ta2 TronAddress) bool {
+	return ta == ta2
+}
+
+// Validate checks tron address prefix
+func (ta TronAddress) Validate() error {
+	if ta.Empty() {
+		return errors.New("empty tron address")
+	}
+
+	if ta[0] != TronAddressPrefix {
+		return fmt.Errorf("invalid tron address prefix %x", ta[0])
+	}
+
+	return nil
+}
+
+// Bytes returns the raw address bytes including prefix
+func (ta TronAddress) Bytes() []byte {
+	return ta[:]
+}
+
+// Hex returns hex encoded address including prefix, as expected by tron rpc
+func (ta TronAddress) Hex() string {
+	if ta.Empty() {
+		return ""
+	}
+
+	return hex.EncodeToString(ta[:])
+}
+
+// Base58 returns base58check encoded address
+func (ta TronAddress) Base58() string {
+	if ta.Empty() {
+		return ""
+	}
+
+	checksum := tronChecksum(ta[:])
+	return base58Encode(append(ta.Bytes(), checksum...))
+}
+
+// String implements the Stringer interface.
+func (ta TronAddress) String() string {
+	return ta.Base58()
+}
+
+// MarshalJSON marshals to JSON using hex.
+func (ta TronAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ta.Hex())
+}
+
+// MarshalYAML marshals to YAML using base58check.
+func (ta TronAddress) MarshalYAML() (interface{}, error) {
+	return ta.Base58(), nil
+}
+
+// UnmarshalJSON unmarshals from JSON accepting hex or base58check encoding.
+func (ta *TronAddress) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	addr, err := ParseTronAddress(s)
+	if err != nil {
+		return err
+	}
+
+	*ta = addr
+	return nil
+}
+
+// UnmarshalYAML unmarshals from YAML accepting hex or base58check encoding.
+func (ta *TronAddress) UnmarshalYAML(data []byte) error {
+	var s string
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	addr, err := ParseTronAddress(s)
+	if err != nil {
+		return err
+	}
+
+	*ta = addr
+	return nil
+}
+
+//
+// Tron address utils
+//
+
+// BytesToTronAddress returns tron address with value b. Prefix is added to 20 byte addresses.
+func BytesToTronAddress(b []byte) TronAddress {
+	var ta TronAddress
+	if len(b) == AddrLen {
+		ta[0] = TronAddressPrefix
+		copy(ta[1:], b)
+		return ta
+	}
+
+	if len(b) > TronAddrLen {
+		b = b[len(b)-TronAddrLen:]
+	}
+	copy(ta[TronAddrLen-len(b):], b)
+	return ta
+}
+
+// EthToTronAddress returns tron address of eth-style address
+func EthToTronAddress(addr common.Address) TronAddress {
+	return BytesToTronAddress(addr.Bytes())
+}
+
+// ParseTronAddress parses tron address in base58check ("T...") or hex encoding, with or without prefix byte
+func ParseTronAddress(s string) (TronAddress, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ZeroTronAddress, nil
+	}
+
+	// base58check
+	if strings.HasPrefix(s, "T") {
+		return Base58ToTronAddress(s)
+	}
+
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return ZeroTronAddress, fmt.Errorf("invalid hex tron address %v: %v", s, err)
+	}
+
+	if len(b) != AddrLen && len(b) != TronAddrLen {
+		return ZeroTronAddress, fmt.Errorf("invalid tron address length %v", len(b))
+	}
+
+	ta := BytesToTronAddress(b)
+	if err := ta.Validate(); err != nil {
+		return ZeroTronAddress, err
+	}
+
+	return ta, nil
+}
+
+// MustParseTronAddress parses tron address and panics on error
+func MustParseTronAddress(s string) TronAddress {
+	ta, err := ParseTronAddress(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return ta
+}
+
+// Base58ToTronAddress decodes base58check tron address and verifies its checksum
+func Base58ToTronAddress(s string) (TronAddress, error) {
+	b, err := base58Decode(s)
+	if err != nil {
+		return ZeroTronAddress, err
+	}
+
+	if len(b) != TronAddrLen+4 {
+		return ZeroTronAddress, fmt.Errorf("invalid tron address length %v", len(b))
+	}
+
+	payload, checksum := b[:TronAddrLen], b[TronAddrLen:]
+	if !bytes.Equal(checksum, tronChecksum(payload)) {
+		return ZeroTronAddress, errors.New("invalid tron address checksum")
+	}
+
+	ta := BytesToTronAddress(payload)
+	if err := ta.Validate(); err != nil {
+		return ZeroTronAddress, err
+	}
+
+	return ta, nil
+}
+
+// tronChecksum returns first 4 bytes of double sha256 of payload
+func tronChecksum(payload []byte) []byte {
+	h0 := sha256.Sum256(payload)
+	h1 := sha256.Sum256(h0[:])
+	return h1[:4]
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var bigRadix = big.NewInt(58)
+
+func base58Encode(b []byte) string {
+	x := new(big.Int).SetBytes(b)
+	mod := new(big.Int)
+
+	var out []byte
+	for x.Sign() > 0 {
+		x.DivMod(x, bigRadix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+
+	// leading zero bytes
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+
+	// reverse
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return string(out)
+}
+
+func base58Decode(s string) ([]byte, error) {
+	x := new(big.Int)
+	for _, c := range s {
+		i := strings.IndexRune(base58Alphabet, c)
+		if i < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", c)
+		}
+		x.Mul(x, bigRadix)
+		x.Add(x, big.NewInt(int64(i)))
+	}
+
+	decoded := x.Bytes()
+
+	// leading zero bytes
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	return append(make([]byte, zeros), decoded...), nil
+}
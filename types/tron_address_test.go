@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTronAddress(t *testing.T) {
+	t.Parallel()
+
+	const base58 = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	const hexAddr = "41a614f803b6fd780986a42c78ec9c7f77e6ded13c"
+
+	// base58check and hex forms decode to the same address
+	fromBase58, err := ParseTronAddress(base58)
+	require.NoError(t, err)
+
+	for _, s := range []string{hexAddr, "0x" + hexAddr, "0xa614f803b6fd780986a42c78ec9c7f77e6ded13c"} {
+		addr, err := ParseTronAddress(s)
+		require.NoError(t, err, s)
+		require.Equal(t, fromBase58, addr, s)
+	}
+
+	require.Equal(t, base58, fromBase58.String())
+	require.Equal(t, hexAddr, fromBase58.Hex())
+	require.Equal(t, common.HexToAddress("0xa614f803b6fd780986a42c78ec9c7f77e6ded13c"), fromBase58.EthAddress())
+	require.Equal(t, fromBase58, EthToTronAddress(fromBase58.EthAddress()))
+
+	// json keeps hex encoding and accepts base58check
+	bz, err := json.Marshal(fromBase58)
+	require.NoError(t, err)
+	require.Equal(t, `"`+hexAddr+`"`, string(bz))
+
+	var decoded TronAddress
+	require.NoError(t, json.Unmarshal([]byte(`"`+base58+`"`), &decoded))
+	require.Equal(t, fromBase58, decoded)
+
+	// empty address
+	require.NoError(t, json.Unmarshal([]byte(`""`), &decoded))
+	require.True(t, decoded.Empty())
+
+	// invalid addresses
+	for _, s := range []string{
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u",         // checksum
+		"42a614f803b6fd780986a42c78ec9c7f77e6ded13c", // prefix
+		"41a614f803b6fd78",                           // length
+		"0xzz",
+	} {
+		_, err := ParseTronAddress(s)
+		require.Error(t, err, s)
+	}
+}
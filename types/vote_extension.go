@@ -0,0 +1,132 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// Encoding versions of vote extension data signed by validators
+const (
+	// VoteExtensionVersionLegacy data is payload as is. Side-txs signed for root chain contracts (eg. checkpoints)
+	// must keep this version, contracts verify signatures over raw side sign bytes.
+	VoteExtensionVersionLegacy byte = 0
+
+	// VoteExtensionVersion1 data is version, length prefixed extension name and payload
+	VoteExtensionVersion1 byte = 1
+)
+
+// ExtendedVote is vote of validator on side msg, signature is over vote result and vote extension data.
+// Data is payload of msg decoded from vote data, signature is verified before extension verifies vote.
+type ExtendedVote struct {
+	Result    abci.SideTxResultType
+	Validator []byte
+	Signature []byte
+	Data      []byte
+}
+
+// VoteExtension prepares and verifies externally-validated votes on side msgs of a module.
+// Votes travel in pre-commits of validators along with side-tx results, so extensions can be added
+// without changes to side-tx processing of the app.
+type VoteExtension interface {
+	// Name returns alphanumeric name of extension, carried by versioned vote data
+	Name() string
+
+	// Version returns encoding version of vote data of msg
+	Version(msg sdk.Msg) byte
+
+	// PrepareVote validates msg against external source and returns vote result. Data of result
+	// replaces payload of msg, nodes can't rebuild it so votes on it fail verification.
+	PrepareVote(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx
+
+	// Payload returns payload of msg signed along with vote. Every node rebuilds it to verify
+	// signatures of votes, so it must be deterministic.
+	Payload(msg sdk.Msg) []byte
+
+	// VerifyVote verifies vote of validator on msg before its power is counted, votes failing it
+	// are ignored. It runs on every node while votes are tallied and must be deterministic.
+	VerifyVote(ctx sdk.Context, msg sdk.Msg, vote ExtendedVote) error
+}
+
+// EncodeVoteData encodes payload of msg with encoding version of extension for msg
+func EncodeVoteData(ext VoteExtension, msg sdk.Msg, payload []byte) ([]byte, error) {
+	switch version := ext.Version(msg); version {
+	case VoteExtensionVersionLegacy:
+		return payload, nil
+
+	case VoteExtensionVersion1:
+		name := ext.Name()
+		if len(name) == 0 || len(name) > 255 {
+			return nil, fmt.Errorf("invalid vote extension name %q", name)
+		}
+
+		data := make([]byte, 0, 2+len(name)+len(payload))
+		data = append(data, VoteExtensionVersion1, byte(len(name)))
+		data = append(data, name...)
+		return append(data, payload...), nil
+
+	default:
+		return nil, fmt.Errorf("unknown vote extension version %v", version)
+	}
+}
+
+// DecodeVoteData decodes vote data of given encoding version into extension name and payload.
+// Legacy data has no extension name.
+func DecodeVoteData(version byte, data []byte) (name string, payload []byte, err error) {
+	switch version {
+	case VoteExtensionVersionLegacy:
+		return "", data, nil
+
+	case VoteExtensionVersion1:
+		if len(data) < 2 || data[0] != VoteExtensionVersion1 {
+			return "", nil, errors.New("invalid vote extension data")
+		}
+
+		length := int(data[1])
+		if length == 0 || len(data) < 2+length {
+			return "", nil, errors.New("invalid vote extension name")
+		}
+		return string(data[2 : 2+length]), data[2+length:], nil
+
+	default:
+		return "", nil, fmt.Errorf("unknown vote extension version %v", version)
+	}
+}
+
+// sideTxVoteExtension is legacy vote extension of side-tx handler, votes on side sign bytes of msgs
+// are only verified by signature
+type sideTxVoteExtension struct {
+	handler SideTxHandler
+}
+
+// NewSideTxVoteExtension returns vote extension which prepares votes with side-tx handler and
+// encodes them with legacy encoding, for modules which don't implement their own extension
+func NewSideTxVoteExtension(handler SideTxHandler) VoteExtension {
+	return sideTxVoteExtension{handler: handler}
+}
+
+func (e sideTxVoteExtension) Name() string {
+	return ""
+}
+
+func (e sideTxVoteExtension) Version(_ sdk.Msg) byte {
+	return VoteExtensionVersionLegacy
+}
+
+func (e sideTxVoteExtension) PrepareVote(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+	return e.handler(ctx, msg)
+}
+
+func (e sideTxVoteExtension) Payload(msg sdk.Msg) []byte {
+	if sideMsg, ok := msg.(SideTxMsg); ok {
+		return sideMsg.GetSideSignBytes()
+	}
+
+	return nil
+}
+
+func (e sideTxVoteExtension) VerifyVote(_ sdk.Context, _ sdk.Msg, _ ExtendedVote) error {
+	return nil
+}
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/types"
+)
+
+type testVoteExtension struct {
+	name    string
+	version byte
+}
+
+func (e testVoteExtension) Name() string               { return e.name }
+func (e testVoteExtension) Version(_ sdk.Msg) byte     { return e.version }
+func (e testVoteExtension) Payload(msg sdk.Msg) []byte { return msg.(testSideMsg).payload }
+
+func (e testVoteExtension) PrepareVote(_ sdk.Context, _ sdk.Msg) abci.ResponseDeliverSideTx {
+	return abci.ResponseDeliverSideTx{}
+}
+
+func (e testVoteExtension) VerifyVote(_ sdk.Context, _ sdk.Msg, _ types.ExtendedVote) error {
+	return nil
+}
+
+// testSideMsg is side msg with given side sign bytes
+type testSideMsg struct {
+	sdk.Msg
+	payload []byte
+}
+
+func (msg testSideMsg) GetSideSignBytes() []byte { return msg.payload }
+
+func TestVoteData(t *testing.T) {
+	payload := []byte("payload")
+	msg := testSideMsg{payload: payload}
+
+	// legacy data is side sign bytes as is
+	data, err := types.EncodeVoteData(types.NewSideTxVoteExtension(testSideTxHandler), msg, payload)
+	require.NoError(t, err)
+	require.Equal(t, payload, data)
+
+	name, decoded, err := types.DecodeVoteData(types.VoteExtensionVersionLegacy, data)
+	require.NoError(t, err)
+	require.Empty(t, name)
+	require.Equal(t, payload, decoded)
+
+	// versioned data carries extension name
+	data, err = types.EncodeVoteData(testVoteExtension{name: "milestone", version: types.VoteExtensionVersion1}, msg, payload)
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{types.VoteExtensionVersion1, 9}, append([]byte("milestone"), payload...)...), data)
+
+	name, decoded, err = types.DecodeVoteData(types.VoteExtensionVersion1, data)
+	require.NoError(t, err)
+	require.Equal(t, "milestone", name)
+	require.Equal(t, payload, decoded)
+
+	// invalid data
+	_, _, err = types.DecodeVoteData(types.VoteExtensionVersion1, payload)
+	require.Error(t, err)
+	_, _, err = types.DecodeVoteData(types.VoteExtensionVersion1, []byte{types.VoteExtensionVersion1, 9, 'm'})
+	require.Error(t, err)
+	_, _, err = types.DecodeVoteData(2, data)
+	require.Error(t, err)
+
+	_, err = types.EncodeVoteData(testVoteExtension{version: types.VoteExtensionVersion1}, msg, payload)
+	require.Error(t, err, "versioned extension needs name")
+	_, err = types.EncodeVoteData(testVoteExtension{name: "milestone", version: 2}, msg, payload)
+	require.Error(t, err)
+}
+
+func TestSideHandlersVoteExtension(t *testing.T) {
+	require.Nil(t, (&types.SideHandlers{}).GetVoteExtension())
+
+	ext := (&types.SideHandlers{SideTxHandler: testSideTxHandler}).GetVoteExtension()
+	require.NotNil(t, ext)
+	require.Equal(t, types.VoteExtensionVersionLegacy, ext.Version(testSideMsg{}))
+	require.Equal(t, []byte("payload"), ext.Payload(testSideMsg{payload: []byte("payload")}))
+
+	custom := testVoteExtension{name: "milestone", version: types.VoteExtensionVersion1}
+	require.Equal(t, custom, (&types.SideHandlers{SideTxHandler: testSideTxHandler, VoteExtension: custom}).GetVoteExtension())
+}
@@ -0,0 +1,42 @@
+package upgrade
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// BeginBlocker applies scheduled upgrade plan once its height is reached.
+// If binary has no handler for plan, node halts so operators can switch to upgraded binary.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	plan, ok := k.GetUpgradePlan(ctx)
+	if !ok || !plan.ShouldExecute(ctx) {
+		return
+	}
+
+	if !k.HasUpgradeHandler(plan.Name) {
+		msg := fmt.Sprintf("UPGRADE %q NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+		k.Logger(ctx).Error(msg)
+		panic(msg)
+	}
+
+	k.Logger(ctx).Info("Applying upgrade", "name", plan.Name, "height", plan.Height)
+
+	if err := k.ApplyUpgrade(ctx, plan); err != nil {
+		panic(fmt.Sprintf("upgrade %q failed: %v", plan.Name, err))
+	}
+
+	k.Logger(ctx).Info("✅ Upgrade applied", "name", plan.Name, "height", plan.Height)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(plan.Height, 10)),
+		),
+	})
+}
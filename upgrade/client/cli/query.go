@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryPlan(cdc),
+			GetCmdQueryApplied(cdc),
+			GetCmdQueryModuleVersions(cdc),
+		)...,
+	)
+	return queryCmd
+}
+
+// GetCmdQueryPlan implements the scheduled upgrade plan query command.
+func GetCmdQueryPlan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Args:  cobra.NoArgs,
+		Short: "show scheduled upgrade plan",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentPlan)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(bz) == 0 {
+				return errors.New("no upgrade scheduled")
+			}
+
+			var plan types.Plan
+			if err = json.Unmarshal(bz, &plan); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements the applied upgrade height query command.
+func GetCmdQueryApplied(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [upgrade-name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show height at which upgrade was applied",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query height at which upgrade was applied, 0 if it was not applied.
+
+Example:
+$ %s query upgrade applied v0.4
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, err := cliCtx.Codec.MarshalJSON(types.NewQueryAppliedParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApplied)
+			bz, _, err := cliCtx.QueryWithData(route, params)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			if err = json.Unmarshal(bz, &height); err != nil {
+				return err
+			}
+
+			fmt.Println(height)
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryModuleVersions implements the module consensus versions query command.
+func GetCmdQueryModuleVersions(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "module-versions",
+		Args:  cobra.NoArgs,
+		Short: "show consensus versions of modules",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryModuleVersions)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var vm hmModule.VersionMap
+			if err = json.Unmarshal(bz, &vm); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(vm)
+		},
+	}
+}
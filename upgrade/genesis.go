@@ -0,0 +1,25 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// InitGenesis sets upgrade information for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	if data.Plan != nil {
+		if err := keeper.ScheduleUpgrade(ctx, *data.Plan); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	if plan, ok := keeper.GetUpgradePlan(ctx); ok {
+		return types.NewGenesisState(&plan)
+	}
+
+	return types.DefaultGenesisState()
+}
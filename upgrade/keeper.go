@@ -0,0 +1,181 @@
+package upgrade
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// UpgradeHandler applies upgrade plan, it runs store migrations from fromVM and returns new version map
+type UpgradeHandler func(ctx sdk.Context, plan types.Plan, fromVM hmModule.VersionMap) (hmModule.VersionMap, error)
+
+// Keeper stores all related data
+type Keeper struct {
+	// The (unexposed) key used to access the store from the Context.
+	storeKey sdk.StoreKey
+	// The codec codec for binary encoding/decoding.
+	cdc *codec.Codec
+	// code space
+	codespace sdk.CodespaceType
+	// upgrade handlers by plan name
+	upgradeHandlers map[string]UpgradeHandler
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	codespace sdk.CodespaceType,
+) Keeper {
+	return Keeper{
+		cdc:             cdc,
+		storeKey:        storeKey,
+		codespace:       codespace,
+		upgradeHandlers: make(map[string]UpgradeHandler),
+	}
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// SetUpgradeHandler registers upgrade handler of plan name
+func (k Keeper) SetUpgradeHandler(name string, handler UpgradeHandler) {
+	k.upgradeHandlers[name] = handler
+}
+
+// HasUpgradeHandler returns true if upgrade handler of plan name is registered
+func (k Keeper) HasUpgradeHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+//
+// Plan
+//
+
+// ScheduleUpgrade schedules upgrade plan, it replaces plan which is already scheduled
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if plan.Height <= ctx.BlockHeight() {
+		return hmCommon.ErrInvalidMsg(k.codespace, "Upgrade height %v must be in the future, current height %v", plan.Height, ctx.BlockHeight())
+	}
+
+	if height := k.GetDoneHeight(ctx, plan.Name); height != 0 {
+		return hmCommon.ErrInvalidMsg(k.codespace, "Upgrade %v was already applied at height %v", plan.Name, height)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PlanKey, k.cdc.MustMarshalBinaryBare(plan))
+
+	return nil
+}
+
+// GetUpgradePlan returns scheduled upgrade plan
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+	return plan, true
+}
+
+// ClearUpgradePlan removes scheduled upgrade plan
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PlanKey)
+}
+
+//
+// Applied upgrades
+//
+
+// GetDoneHeight returns height at which upgrade was applied, 0 if it was not applied
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DoneKey(name))
+	if bz == nil {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (k Keeper) setDone(ctx sdk.Context, name string) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DoneKey(name), bz)
+}
+
+//
+// Module versions
+//
+
+// SetModuleVersionMap stores consensus versions of modules
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm hmModule.VersionMap) {
+	store := ctx.KVStore(k.storeKey)
+	for name, version := range vm {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, version)
+		store.Set(types.VersionMapKey(name), bz)
+	}
+}
+
+// GetModuleVersionMap returns stored consensus versions of modules
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) hmModule.VersionMap {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VersionMapKeyPrefix)
+	defer iterator.Close()
+
+	vm := make(hmModule.VersionMap)
+	for ; iterator.Valid(); iterator.Next() {
+		name := string(iterator.Key()[len(types.VersionMapKeyPrefix):])
+		vm[name] = binary.BigEndian.Uint64(iterator.Value())
+	}
+
+	return vm
+}
+
+//
+// Apply
+//
+
+// ApplyUpgrade runs upgrade handler of plan, stores new module versions and marks plan as done
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) error {
+	handler, ok := k.upgradeHandlers[plan.Name]
+	if !ok {
+		return fmt.Errorf("no upgrade handler registered for %v", plan.Name)
+	}
+
+	updatedVM, err := handler(ctx, plan, k.GetModuleVersionMap(ctx))
+	if err != nil {
+		return err
+	}
+
+	k.SetModuleVersionMap(ctx, updatedVM)
+	k.ClearUpgradePlan(ctx)
+	k.setDone(ctx, plan.Name)
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package upgrade_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/upgrade"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+//
+// Test suite
+//
+
+// KeeperTestSuite integrate test suite context object
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 10})
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+//
+// Tests
+//
+
+func (suite *KeeperTestSuite) TestScheduleUpgrade() {
+	t, keeper, ctx := suite.T(), suite.app.UpgradeKeeper, suite.ctx
+
+	err := keeper.ScheduleUpgrade(ctx, types.NewPlan("past", 5, ""))
+	require.NotNil(t, err, "Plan in the past should be rejected")
+
+	err = keeper.ScheduleUpgrade(ctx, types.NewPlan("", 20, ""))
+	require.NotNil(t, err, "Plan without name should be rejected")
+
+	plan := types.NewPlan("v1", 20, "info")
+	err = keeper.ScheduleUpgrade(ctx, plan)
+	require.Nil(t, err)
+
+	result, ok := keeper.GetUpgradePlan(ctx)
+	require.True(t, ok)
+	require.Equal(t, plan, result)
+
+	keeper.ClearUpgradePlan(ctx)
+	_, ok = keeper.GetUpgradePlan(ctx)
+	require.False(t, ok)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlocker() {
+	t, keeper, ctx := suite.T(), suite.app.UpgradeKeeper, suite.ctx
+
+	plan := types.NewPlan("test-upgrade", 20, "")
+	require.Nil(t, keeper.ScheduleUpgrade(ctx, plan))
+
+	// before upgrade height
+	require.NotPanics(t, func() { upgrade.BeginBlocker(ctx.WithBlockHeight(19), keeper) })
+
+	// binary without upgrade handler halts
+	require.Panics(t, func() { upgrade.BeginBlocker(ctx.WithBlockHeight(20), keeper) })
+
+	// failing upgrade handler halts
+	keeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan, fromVM hmModule.VersionMap) (hmModule.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+	require.Panics(t, func() { upgrade.BeginBlocker(ctx.WithBlockHeight(20), keeper) })
+
+	var fromVersion uint64
+	keeper.SetModuleVersionMap(ctx, hmModule.VersionMap{"checkpoint": 1})
+	keeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan, fromVM hmModule.VersionMap) (hmModule.VersionMap, error) {
+		fromVersion = fromVM["checkpoint"]
+		return hmModule.VersionMap{"checkpoint": 2}, nil
+	})
+
+	upgradeCtx := ctx.WithBlockHeight(20)
+	require.NotPanics(t, func() { upgrade.BeginBlocker(upgradeCtx, keeper) })
+
+	require.Equal(t, uint64(1), fromVersion)
+	require.Equal(t, uint64(2), keeper.GetModuleVersionMap(ctx)["checkpoint"])
+	require.Equal(t, int64(20), keeper.GetDoneHeight(ctx, plan.Name))
+
+	_, ok := keeper.GetUpgradePlan(ctx)
+	require.False(t, ok, "Plan should be cleared once applied")
+
+	// applied upgrade can't be scheduled again
+	require.NotNil(t, keeper.ScheduleUpgrade(upgradeCtx, types.NewPlan(plan.Name, 30, "")))
+}
+
+func (suite *KeeperTestSuite) TestRunMigrations() {
+	t, ctx := suite.T(), suite.ctx
+
+	mm := suite.app.GetModuleManager()
+
+	cfg := hmModule.NewConfigurator()
+	require.NotNil(t, cfg.RegisterMigration("checkpoint", 0, nil))
+	require.Nil(t, cfg.RegisterMigration("checkpoint", 1, func(ctx sdk.Context) error { return nil }))
+	require.NotNil(t, cfg.RegisterMigration("checkpoint", 1, func(ctx sdk.Context) error { return nil }))
+	require.Nil(t, cfg.RegisterMigration("checkpoint", 2, func(ctx sdk.Context) error { return nil }))
+	require.Nil(t, cfg.RegisterMigration("checkpoint", 3, func(ctx sdk.Context) error { return nil }))
+	require.Nil(t, cfg.RegisterMigration("liveness", 1, func(ctx sdk.Context) error { return nil }))
+	require.Nil(t, cfg.RegisterMigration("sidechannel", 1, func(ctx sdk.Context) error { return nil }))
+	require.Nil(t, cfg.RegisterMigration("sidechannel", 2, func(ctx sdk.Context) error { return nil }))
+	require.Nil(t, cfg.RegisterMigration("staking", 1, func(ctx sdk.Context) error { return nil }))
+
+	// modules missing from version map are at default version
+	vm, err := cfg.RunMigrations(ctx, mm, hmModule.VersionMap{})
+	require.Nil(t, err)
+	require.Equal(t, hmModule.GetVersionMap(mm), vm)
+
+	// downgrade
+	_, err = cfg.RunMigrations(ctx, mm, hmModule.VersionMap{"staking": 100})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,69 @@
+package upgrade
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// NewSoftwareUpgradeProposalHandler new software upgrade proposal handler
+func NewSoftwareUpgradeProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, k, c)
+
+		case types.CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.SoftwareUpgradeProposal) sdk.Error {
+	if err := k.ScheduleUpgrade(ctx, p.Plan); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("Upgrade scheduled by governance", "name", p.Plan.Name, "height", p.Plan.Height)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyName, p.Plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(p.Plan.Height, 10)),
+		),
+	})
+
+	return nil
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.CancelSoftwareUpgradeProposal) sdk.Error {
+	plan, ok := k.GetUpgradePlan(ctx)
+	if !ok {
+		return sdk.ErrUnknownRequest("no upgrade plan is scheduled")
+	}
+
+	k.ClearUpgradePlan(ctx)
+
+	k.Logger(ctx).Info("Upgrade cancelled by governance", "name", plan.Name, "height", plan.Height)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(plan.Height, 10)),
+		),
+	})
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeScheduleUpgrade = "schedule-upgrade"
+	EventTypeCancelUpgrade   = "cancel-upgrade"
+	EventTypeUpgrade         = "upgrade"
+
+	AttributeKeyName   = "name"
+	AttributeKeyHeight = "height"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,31 @@
+package types
+
+// GenesisState is the upgrade state that must be provided at genesis.
+// Module versions are not part of genesis, new chain always starts at current versions.
+type GenesisState struct {
+	Plan *Plan `json:"plan,omitempty" yaml:"plan"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(plan *Plan) GenesisState {
+	return GenesisState{
+		Plan: plan,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(nil)
+}
+
+// ValidateGenesis performs basic validation of upgrade genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if data.Plan != nil {
+		if err := data.Plan.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
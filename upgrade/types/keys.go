@@ -0,0 +1,36 @@
+package types
+
+const (
+	// ModuleName defines the name of the module
+	ModuleName = "upgrade"
+
+	// StoreKey is the store key string for upgrade
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for upgrade
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for upgrade
+	QuerierRoute = ModuleName
+)
+
+var (
+	// PlanKey key for scheduled upgrade plan
+	PlanKey = []byte{0x01}
+
+	// DoneKeyPrefix prefix for heights of applied upgrades
+	DoneKeyPrefix = []byte{0x02}
+
+	// VersionMapKeyPrefix prefix for consensus versions of modules
+	VersionMapKeyPrefix = []byte{0x03}
+)
+
+// DoneKey returns key used to get height of applied upgrade
+func DoneKey(name string) []byte {
+	return append(append([]byte{}, DoneKeyPrefix...), []byte(name)...)
+}
+
+// VersionMapKey returns key used to get consensus version of module
+func VersionMapKey(moduleName string) []byte {
+	return append(append([]byte{}, VersionMapKeyPrefix...), []byte(moduleName)...)
+}
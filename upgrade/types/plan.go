@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+)
+
+// Plan upgrade which is applied at height by upgrade handler registered under name
+type Plan struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+	Info   string `json:"info" yaml:"info"`
+}
+
+// NewPlan creates new upgrade plan
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// ValidateBasic validates upgrade plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Upgrade plan name cannot be empty")
+	}
+
+	if p.Height <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid upgrade height %v", p.Height)
+	}
+
+	return nil
+}
+
+// ShouldExecute returns true if plan should be applied at current block
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height > 0 && ctx.BlockHeight() >= p.Height
+}
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan:
+  Name:   %s
+  Height: %d
+  Info:   %s
+`, p.Name, p.Height, p.Info)
+}
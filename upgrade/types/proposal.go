@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+)
+
+const (
+	// ProposalTypeSoftwareUpgrade defines the type for a SoftwareUpgradeProposal
+	ProposalTypeSoftwareUpgrade = "SoftwareUpgrade"
+
+	// ProposalTypeCancelSoftwareUpgrade defines the type for a CancelSoftwareUpgradeProposal
+	ProposalTypeCancelSoftwareUpgrade = "CancelSoftwareUpgrade"
+)
+
+// Assert proposals implement govTypes.Content at compile-time
+var (
+	_ govTypes.Content = SoftwareUpgradeProposal{}
+	_ govTypes.Content = CancelSoftwareUpgradeProposal{}
+)
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeSoftwareUpgrade)
+	govTypes.RegisterProposalTypeCodec(SoftwareUpgradeProposal{}, "upgrade/SoftwareUpgradeProposal")
+	govTypes.RegisterProposalType(ProposalTypeCancelSoftwareUpgrade)
+	govTypes.RegisterProposalTypeCodec(CancelSoftwareUpgradeProposal{}, "upgrade/CancelSoftwareUpgradeProposal")
+}
+
+// SoftwareUpgradeProposal governance proposal which schedules upgrade plan
+type SoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Plan        Plan   `json:"plan" yaml:"plan"`
+}
+
+// NewSoftwareUpgradeProposal creates new software upgrade proposal
+func NewSoftwareUpgradeProposal(title string, description string, plan Plan) SoftwareUpgradeProposal {
+	return SoftwareUpgradeProposal{
+		Title:       title,
+		Description: description,
+		Plan:        plan,
+	}
+}
+
+// GetTitle returns the title of software upgrade proposal
+func (sup SoftwareUpgradeProposal) GetTitle() string { return sup.Title }
+
+// GetDescription returns the description of software upgrade proposal
+func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
+
+// ProposalRoute returns the routing key of software upgrade proposal
+func (sup SoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of software upgrade proposal
+func (sup SoftwareUpgradeProposal) ProposalType() string { return ProposalTypeSoftwareUpgrade }
+
+// ValidateBasic validates software upgrade proposal
+func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(hmCommon.DefaultCodespace, sup); err != nil {
+		return err
+	}
+
+	return sup.Plan.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (sup SoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+  Name:        %s
+  Height:      %d
+  Info:        %s
+`, sup.Title, sup.Description, sup.Plan.Name, sup.Plan.Height, sup.Plan.Info)
+}
+
+// CancelSoftwareUpgradeProposal governance proposal which cancels scheduled upgrade plan
+type CancelSoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// NewCancelSoftwareUpgradeProposal creates new cancel software upgrade proposal
+func NewCancelSoftwareUpgradeProposal(title string, description string) CancelSoftwareUpgradeProposal {
+	return CancelSoftwareUpgradeProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+// GetTitle returns the title of cancel software upgrade proposal
+func (csup CancelSoftwareUpgradeProposal) GetTitle() string { return csup.Title }
+
+// GetDescription returns the description of cancel software upgrade proposal
+func (csup CancelSoftwareUpgradeProposal) GetDescription() string { return csup.Description }
+
+// ProposalRoute returns the routing key of cancel software upgrade proposal
+func (csup CancelSoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of cancel software upgrade proposal
+func (csup CancelSoftwareUpgradeProposal) ProposalType() string {
+	return ProposalTypeCancelSoftwareUpgrade
+}
+
+// ValidateBasic validates cancel software upgrade proposal
+func (csup CancelSoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	return govTypes.ValidateAbstract(hmCommon.DefaultCodespace, csup)
+}
+
+// String implements the Stringer interface.
+func (csup CancelSoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Cancel Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+`, csup.Title, csup.Description)
+}
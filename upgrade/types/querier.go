@@ -0,0 +1,18 @@
+package types
+
+// query endpoints supported by the upgrade Querier
+const (
+	QueryCurrentPlan    = "current-plan"
+	QueryApplied        = "applied"
+	QueryModuleVersions = "module-versions"
+)
+
+// QueryAppliedParams defines the params for querying height of applied upgrade
+type QueryAppliedParams struct {
+	Name string `json:"name"`
+}
+
+// NewQueryAppliedParams creates a new instance of QueryAppliedParams.
+func NewQueryAppliedParams(name string) QueryAppliedParams {
+	return QueryAppliedParams{Name: name}
+}